@@ -17,6 +17,11 @@ import (
 const (
 	getBlockByNoncePath = "/by-nonce/:nonce"
 	getBlockByHashPath  = "/by-hash/:hash"
+
+	getRawBlockByNoncePath        = "/raw/by-nonce/:nonce"
+	getRawBlockByHashPath         = "/raw/by-hash/:hash"
+	getRawEpochStartMetaBlockPath = "/raw/epoch-start/:epoch"
+	getRawMiniBlockByHashPath     = "/raw/miniblock/:hash/epoch/:epoch"
 )
 
 var log = logger.GetOrCreate("api/block")
@@ -25,12 +30,20 @@ var log = logger.GetOrCreate("api/block")
 type BlockService interface {
 	GetBlockByHash(hash string, withTxs bool) (*api.Block, error)
 	GetBlockByNonce(nonce uint64, withTxs bool) (*api.Block, error)
+	GetRawBlockByHash(hash string) ([]byte, error)
+	GetRawBlockByNonce(nonce uint64) ([]byte, error)
+	GetRawEpochStartMetaBlock(epoch uint32) ([]byte, error)
+	GetRawMiniBlockByHash(hash string, epoch uint32) ([]byte, error)
 }
 
 // Routes defines block related routes
 func Routes(routes *wrapper.RouterWrapper) {
 	routes.RegisterHandler(http.MethodGet, getBlockByNoncePath, getBlockByNonce)
 	routes.RegisterHandler(http.MethodGet, getBlockByHashPath, getBlockByHash)
+	routes.RegisterHandler(http.MethodGet, getRawBlockByNoncePath, getRawBlockByNonce)
+	routes.RegisterHandler(http.MethodGet, getRawBlockByHashPath, getRawBlockByHash)
+	routes.RegisterHandler(http.MethodGet, getRawEpochStartMetaBlockPath, getRawEpochStartMetaBlock)
+	routes.RegisterHandler(http.MethodGet, getRawMiniBlockByHashPath, getRawMiniBlockByHash)
 }
 
 func getBlockByNonce(c *gin.Context) {
@@ -112,6 +125,124 @@ func getBlockByHash(c *gin.Context) {
 	shared.RespondWith(c, http.StatusOK, gin.H{"block": block}, "", shared.ReturnCodeSuccess)
 }
 
+func getRawBlockByNonce(c *gin.Context) {
+	ef, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	nonce, err := getQueryParamNonce(c)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidBlockNonce.Error()),
+		)
+		return
+	}
+
+	start := time.Now()
+	rawBlock, err := ef.GetRawBlockByNonce(nonce)
+	log.Debug(fmt.Sprintf("GetRawBlockByNonce took %s", time.Since(start)))
+	if err != nil {
+		respondWithGetBlockError(c, errors.ErrGetBlock, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"block": rawBlock}, "", shared.ReturnCodeSuccess)
+}
+
+func getRawBlockByHash(c *gin.Context) {
+	ef, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	hash := c.Param("hash")
+	if hash == "" {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyBlockHash.Error()),
+		)
+		return
+	}
+
+	start := time.Now()
+	rawBlock, err := ef.GetRawBlockByHash(hash)
+	log.Debug(fmt.Sprintf("GetRawBlockByHash took %s", time.Since(start)))
+	if err != nil {
+		respondWithGetBlockError(c, errors.ErrGetBlock, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"block": rawBlock}, "", shared.ReturnCodeSuccess)
+}
+
+func getRawEpochStartMetaBlock(c *gin.Context) {
+	ef, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	epoch, err := getQueryParamEpoch(c)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidEpoch.Error()),
+		)
+		return
+	}
+
+	start := time.Now()
+	rawBlock, err := ef.GetRawEpochStartMetaBlock(epoch)
+	log.Debug(fmt.Sprintf("GetRawEpochStartMetaBlock took %s", time.Since(start)))
+	if err != nil {
+		respondWithGetBlockError(c, errors.ErrGetBlock, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"block": rawBlock}, "", shared.ReturnCodeSuccess)
+}
+
+func getRawMiniBlockByHash(c *gin.Context) {
+	ef, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	hash := c.Param("hash")
+	if hash == "" {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyMiniBlockHash.Error()),
+		)
+		return
+	}
+
+	epoch, err := getQueryParamEpoch(c)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidEpoch.Error()),
+		)
+		return
+	}
+
+	start := time.Now()
+	rawMiniBlock, err := ef.GetRawMiniBlockByHash(hash, epoch)
+	log.Debug(fmt.Sprintf("GetRawMiniBlockByHash took %s", time.Since(start)))
+	if err != nil {
+		respondWithGetBlockError(c, errors.ErrGetMiniBlock, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"miniblock": rawMiniBlock}, "", shared.ReturnCodeSuccess)
+}
+
+func respondWithGetBlockError(c *gin.Context, baseErr error, err error) {
+	shared.RespondWith(
+		c,
+		http.StatusInternalServerError,
+		nil,
+		fmt.Sprintf("%s: %s", baseErr.Error(), err.Error()),
+		shared.ReturnCodeInternalError,
+	)
+}
+
 func getQueryParamWithTxs(c *gin.Context) (bool, error) {
 	withTxsStr := c.Request.URL.Query().Get("withTxs")
 	if withTxsStr == "" {
@@ -130,6 +261,17 @@ func getQueryParamNonce(c *gin.Context) (uint64, error) {
 	return strconv.ParseUint(nonceStr, 10, 64)
 }
 
+func getQueryParamEpoch(c *gin.Context) (uint32, error) {
+	epochStr := c.Param("epoch")
+	if epochStr == "" {
+		return 0, errors.ErrInvalidEpoch
+	}
+
+	epoch, err := strconv.ParseUint(epochStr, 10, 32)
+
+	return uint32(epoch), err
+}
+
 func getFacade(c *gin.Context) (BlockService, bool) {
 	facadeObj, ok := c.Get("facade")
 	if !ok {
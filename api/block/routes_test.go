@@ -253,6 +253,198 @@ func TestGetBlockByHash_ShouldWork(t *testing.T) {
 	assert.Equal(t, expectedBlock, response.Data.Block)
 }
 
+// ---- raw data
+
+type rawBlockResponseData struct {
+	Block     []byte `json:"block"`
+	MiniBlock []byte `json:"miniblock"`
+}
+
+type rawBlockResponse struct {
+	Data  rawBlockResponseData `json:"data"`
+	Error string               `json:"error"`
+	Code  string               `json:"code"`
+}
+
+func TestGetRawBlockByNonce_InvalidNonceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetRawBlockByNonceCalled: func(_ uint64) ([]byte, error) {
+			return nil, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/block/raw/by-nonce/invalid", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := rawBlockResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidBlockNonce.Error()))
+}
+
+func TestGetRawBlockByNonce_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("local err")
+	facade := mock.Facade{
+		GetRawBlockByNonceCalled: func(_ uint64) ([]byte, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/block/raw/by-nonce/37", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := rawBlockResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetRawBlockByNonce_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedBlock := []byte("raw block")
+	facade := mock.Facade{
+		GetRawBlockByNonceCalled: func(nonce uint64) ([]byte, error) {
+			assert.Equal(t, uint64(37), nonce)
+			return expectedBlock, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/block/raw/by-nonce/37", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := rawBlockResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedBlock, response.Data.Block)
+}
+
+func TestGetRawBlockByHash_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedBlock := []byte("raw block")
+	facade := mock.Facade{
+		GetRawBlockByHashCalled: func(hash string) ([]byte, error) {
+			assert.Equal(t, "aaaa", hash)
+			return expectedBlock, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/block/raw/by-hash/aaaa", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := rawBlockResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedBlock, response.Data.Block)
+}
+
+func TestGetRawEpochStartMetaBlock_InvalidEpochShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetRawEpochStartMetaBlockCalled: func(_ uint32) ([]byte, error) {
+			return nil, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/block/raw/epoch-start/4294967296", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := rawBlockResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidEpoch.Error()))
+}
+
+func TestGetRawEpochStartMetaBlock_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedBlock := []byte("raw epoch start block")
+	facade := mock.Facade{
+		GetRawEpochStartMetaBlockCalled: func(epoch uint32) ([]byte, error) {
+			assert.Equal(t, uint32(7), epoch)
+			return expectedBlock, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/block/raw/epoch-start/7", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := rawBlockResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedBlock, response.Data.Block)
+}
+
+func TestGetRawMiniBlockByHash_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("local err")
+	facade := mock.Facade{
+		GetRawMiniBlockByHashCalled: func(_ string, _ uint32) ([]byte, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/block/raw/miniblock/aaaa/epoch/2", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := rawBlockResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetMiniBlock.Error()))
+}
+
+func TestGetRawMiniBlockByHash_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedMiniBlock := []byte("raw miniblock")
+	facade := mock.Facade{
+		GetRawMiniBlockByHashCalled: func(hash string, epoch uint32) ([]byte, error) {
+			assert.Equal(t, "aaaa", hash)
+			assert.Equal(t, uint32(2), epoch)
+			return expectedMiniBlock, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/block/raw/miniblock/aaaa/epoch/2", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := rawBlockResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedMiniBlock, response.Data.MiniBlock)
+}
+
 func startNodeServer(handler block.BlockService) *gin.Engine {
 	ws := gin.New()
 	ws.Use(cors.Default())
@@ -272,6 +464,10 @@ func getRoutesConfig() config.ApiRoutesConfig {
 				Routes: []config.RouteConfig{
 					{Name: "/by-nonce/:nonce", Open: true},
 					{Name: "/by-hash/:hash", Open: true},
+					{Name: "/raw/by-nonce/:nonce", Open: true},
+					{Name: "/raw/by-hash/:hash", Open: true},
+					{Name: "/raw/epoch-start/:epoch", Open: true},
+					{Name: "/raw/miniblock/:hash/epoch/:epoch", Open: true},
 				},
 			},
 		},
@@ -79,12 +79,30 @@ var ErrGetTransaction = errors.New("getting transaction failed")
 // ErrGetBlock signals an error happening when trying to fetch a block
 var ErrGetBlock = errors.New("getting block failed")
 
+// ErrInvalidEpoch signals that an invalid epoch was provided
+var ErrInvalidEpoch = errors.New("invalid epoch")
+
+// ErrValidationEmptyMiniBlockHash signals an empty miniblock hash was provided
+var ErrValidationEmptyMiniBlockHash = errors.New("miniblock hash is empty")
+
+// ErrGetMiniBlock signals an error happening when trying to fetch a miniblock
+var ErrGetMiniBlock = errors.New("getting miniblock failed")
+
 // ErrQueryError signals a general query error
 var ErrQueryError = errors.New("query error")
 
 // ErrGetPidInfo signals that an error occurred while getting peer ID info
 var ErrGetPidInfo = errors.New("error getting peer id info")
 
+// ErrGetConsensusRounds signals that an error occurred while getting the consensus rounds traces
+var ErrGetConsensusRounds = errors.New("error getting consensus rounds")
+
+// ErrGetSlashingEvidences signals that an error occurred while getting the slashing evidences
+var ErrGetSlashingEvidences = errors.New("error getting slashing evidences")
+
+// ErrGetManagedKeysMetrics signals that an error occurred while getting the managed keys metrics
+var ErrGetManagedKeysMetrics = errors.New("error getting managed keys metrics")
+
 // ErrTooManyRequests signals that too many requests were simultaneously received
 var ErrTooManyRequests = errors.New("too many requests")
 
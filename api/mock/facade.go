@@ -9,10 +9,14 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	"github.com/ElrondNetwork/elrond-go-core/data/vm"
+	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
+	trieIteratorsData "github.com/ElrondNetwork/elrond-go/node/trieIterators/data"
 	"github.com/ElrondNetwork/elrond-go/process"
+	gasPriceData "github.com/ElrondNetwork/elrond-go/process/gasprice/data"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
 	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
 	"github.com/ElrondNetwork/elrond-go/state"
 )
@@ -32,6 +36,7 @@ type Facade struct {
 	ValidateTransactionForSimulationHandler func(tx *transaction.Transaction, bypassSignature bool) error
 	SendBulkTransactionsHandler             func(txs []*transaction.Transaction) (uint64, error)
 	ExecuteSCQueryHandler                   func(query *process.SCQuery) (*vm.VMOutputApi, error)
+	ExecuteSCQueryWithTraceHandler          func(query *process.SCQuery) (*vm.VMOutputApi, *tracing.ExecutionTrace, error)
 	StatusMetricsHandler                    func() external.StatusMetricsHandler
 	ValidatorStatisticsHandler              func() (map[string]*state.ValidatorApiResponse, error)
 	ComputeTransactionGasLimitHandler       func(tx *transaction.Transaction) (*transaction.CostResponse, error)
@@ -39,10 +44,14 @@ type Facade struct {
 	GetQueryHandlerCalled                   func(name string) (debug.QueryHandler, error)
 	GetValueForKeyCalled                    func(address string, key string) (string, error)
 	GetPeerInfoCalled                       func(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetConsensusRoundsCalled                func() ([]consensus.RoundTrace, error)
+	GetSlashingEvidencesCalled              func() ([]*consensus.SlashingEvidence, error)
+	GetManagedKeysMetricsCalled             func() ([]*consensus.ManagedKeyMetrics, error)
 	GetThrottlerForEndpointCalled           func(endpoint string) (core.Throttler, bool)
 	GetUsernameCalled                       func(address string) (string, error)
 	GetKeyValuePairsCalled                  func(address string) (map[string]string, error)
 	SimulateTransactionExecutionHandler     func(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	SimulateTransactionsBundleHandler       func(txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error)
 	GetNumCheckpointsFromAccountStateCalled func() uint32
 	GetNumCheckpointsFromPeerStateCalled    func() uint32
 	GetESDTDataCalled                       func(address string, key string, nonce uint64) (*esdt.ESDigitalToken, error)
@@ -52,13 +61,23 @@ type Facade struct {
 	GetNFTTokenIDsRegisteredByAddressCalled func(address string) ([]string, error)
 	GetBlockByHashCalled                    func(hash string, withTxs bool) (*api.Block, error)
 	GetBlockByNonceCalled                   func(nonce uint64, withTxs bool) (*api.Block, error)
+	GetRawBlockByHashCalled                 func(hash string) ([]byte, error)
+	GetRawBlockByNonceCalled                func(nonce uint64) ([]byte, error)
+	GetRawEpochStartMetaBlockCalled         func(epoch uint32) ([]byte, error)
+	GetRawMiniBlockByHashCalled             func(hash string, epoch uint32) ([]byte, error)
 	GetTotalStakedValueHandler              func() (*api.StakeValues, error)
 	GetAllIssuedESDTsCalled                 func(tokenType string) ([]string, error)
 	GetDirectStakedListHandler              func() ([]*api.DirectStakedValue, error)
 	GetDelegatorsListHandler                func() ([]*api.Delegator, error)
+	GetGasPriceEstimatesHandler             func() (*gasPriceData.GasPriceEstimates, error)
+	GetGovernanceInfoHandler                func() (*trieIteratorsData.GovernanceInfo, error)
+	GetTokenInfoHandler                     func(token string) (*trieIteratorsData.TokenInfo, error)
+	GetTokenHoldersHandler                  func(token string, offset uint64, limit uint64) ([]*trieIteratorsData.TokenHolder, error)
 	GetProofCalled                          func(string, string) ([][]byte, error)
 	GetProofCurrentRootHashCalled           func(string) ([][]byte, []byte, error)
 	VerifyProofCalled                       func(string, string, [][]byte) (bool, error)
+
+	SimulateTransactionExecutionWithTraceHandler func(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
 }
 
 // GetProof -
@@ -237,6 +256,16 @@ func (f *Facade) SimulateTransactionExecution(tx *transaction.Transaction) (*txS
 	return f.SimulateTransactionExecutionHandler(tx)
 }
 
+// SimulateTransactionExecutionWithTrace is the mock implementation of a handler's SimulateTransactionExecutionWithTrace method
+func (f *Facade) SimulateTransactionExecutionWithTrace(tx *transaction.Transaction) (*txSimData.SimulationResults, error) {
+	return f.SimulateTransactionExecutionWithTraceHandler(tx)
+}
+
+// SimulateTransactionsBundle is the mock implementation of a handler's SimulateTransactionsBundle method
+func (f *Facade) SimulateTransactionsBundle(txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error) {
+	return f.SimulateTransactionsBundleHandler(txs)
+}
+
 // SendBulkTransactions is the mock implementation of a handler's SendBulkTransactions method
 func (f *Facade) SendBulkTransactions(txs []*transaction.Transaction) (uint64, error) {
 	return f.SendBulkTransactionsHandler(txs)
@@ -262,6 +291,11 @@ func (f *Facade) ExecuteSCQuery(query *process.SCQuery) (*vm.VMOutputApi, error)
 	return f.ExecuteSCQueryHandler(query)
 }
 
+// ExecuteSCQueryWithTrace is a mock implementation.
+func (f *Facade) ExecuteSCQueryWithTrace(query *process.SCQuery) (*vm.VMOutputApi, *tracing.ExecutionTrace, error) {
+	return f.ExecuteSCQueryWithTraceHandler(query)
+}
+
 // StatusMetrics is the mock implementation for the StatusMetrics
 func (f *Facade) StatusMetrics() external.StatusMetricsHandler {
 	return f.StatusMetricsHandler()
@@ -282,6 +316,26 @@ func (f *Facade) GetDelegatorsList() ([]*api.Delegator, error) {
 	return f.GetDelegatorsListHandler()
 }
 
+// GetGovernanceInfo -
+func (f *Facade) GetGovernanceInfo() (*trieIteratorsData.GovernanceInfo, error) {
+	return f.GetGovernanceInfoHandler()
+}
+
+// GetTokenInfo -
+func (f *Facade) GetTokenInfo(token string) (*trieIteratorsData.TokenInfo, error) {
+	return f.GetTokenInfoHandler(token)
+}
+
+// GetTokenHolders -
+func (f *Facade) GetTokenHolders(token string, offset uint64, limit uint64) ([]*trieIteratorsData.TokenHolder, error) {
+	return f.GetTokenHoldersHandler(token, offset, limit)
+}
+
+// GetGasPriceEstimates -
+func (f *Facade) GetGasPriceEstimates() (*gasPriceData.GasPriceEstimates, error) {
+	return f.GetGasPriceEstimatesHandler()
+}
+
 // ComputeTransactionGasLimit -
 func (f *Facade) ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error) {
 	return f.ComputeTransactionGasLimitHandler(tx)
@@ -312,6 +366,21 @@ func (f *Facade) GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error) {
 	return f.GetPeerInfoCalled(pid)
 }
 
+// GetConsensusRounds -
+func (f *Facade) GetConsensusRounds() ([]consensus.RoundTrace, error) {
+	return f.GetConsensusRoundsCalled()
+}
+
+// GetSlashingEvidences -
+func (f *Facade) GetSlashingEvidences() ([]*consensus.SlashingEvidence, error) {
+	return f.GetSlashingEvidencesCalled()
+}
+
+// GetManagedKeysMetrics -
+func (f *Facade) GetManagedKeysMetrics() ([]*consensus.ManagedKeyMetrics, error) {
+	return f.GetManagedKeysMetricsCalled()
+}
+
 // GetNumCheckpointsFromAccountState -
 func (f *Facade) GetNumCheckpointsFromAccountState() uint32 {
 	if f.GetNumCheckpointsFromAccountStateCalled != nil {
@@ -340,6 +409,26 @@ func (f *Facade) GetBlockByHash(hash string, withTxs bool) (*api.Block, error) {
 	return f.GetBlockByHashCalled(hash, withTxs)
 }
 
+// GetRawBlockByHash -
+func (f *Facade) GetRawBlockByHash(hash string) ([]byte, error) {
+	return f.GetRawBlockByHashCalled(hash)
+}
+
+// GetRawBlockByNonce -
+func (f *Facade) GetRawBlockByNonce(nonce uint64) ([]byte, error) {
+	return f.GetRawBlockByNonceCalled(nonce)
+}
+
+// GetRawEpochStartMetaBlock -
+func (f *Facade) GetRawEpochStartMetaBlock(epoch uint32) ([]byte, error) {
+	return f.GetRawEpochStartMetaBlockCalled(epoch)
+}
+
+// GetRawMiniBlockByHash -
+func (f *Facade) GetRawMiniBlockByHash(hash string, epoch uint32) ([]byte, error) {
+	return f.GetRawMiniBlockByHashCalled(hash, epoch)
+}
+
 // Close -
 func (f *Facade) Close() error {
 	return nil
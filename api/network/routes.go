@@ -1,7 +1,9 @@
 package network
 
 import (
+	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/data/api"
@@ -10,6 +12,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/node/external"
+	trieIteratorsData "github.com/ElrondNetwork/elrond-go/node/trieIterators/data"
+	gasPriceData "github.com/ElrondNetwork/elrond-go/process/gasprice/data"
 	"github.com/gin-gonic/gin"
 )
 
@@ -24,6 +28,14 @@ const (
 	getNFTsPath          = "/esdt/non-fungible-tokens"
 	directStakedInfoPath = "/direct-staked-info"
 	delegatedInfoPath    = "/delegated-info"
+	gasPricePath         = "/gas-price"
+	governancePath       = "/governance"
+	tokenInfoPath        = "/esdt/token/:token"
+	tokenHoldersPath     = "/esdt/token/:token/holders"
+
+	queryParamOffset       = "offset"
+	queryParamLimit        = "limit"
+	defaultHoldersPageSize = 100
 )
 
 // FacadeHandler interface defines methods that can be used by the gin webserver
@@ -31,6 +43,10 @@ type FacadeHandler interface {
 	GetTotalStakedValue() (*api.StakeValues, error)
 	GetDirectStakedList() ([]*api.DirectStakedValue, error)
 	GetDelegatorsList() ([]*api.Delegator, error)
+	GetGasPriceEstimates() (*gasPriceData.GasPriceEstimates, error)
+	GetGovernanceInfo() (*trieIteratorsData.GovernanceInfo, error)
+	GetTokenInfo(token string) (*trieIteratorsData.TokenInfo, error)
+	GetTokenHolders(token string, offset uint64, limit uint64) ([]*trieIteratorsData.TokenHolder, error)
 	StatusMetrics() external.StatusMetricsHandler
 	GetAllIssuedESDTs(tokenType string) ([]string, error)
 	IsInterfaceNil() bool
@@ -48,6 +64,10 @@ func Routes(router *wrapper.RouterWrapper) {
 	router.RegisterHandler(http.MethodGet, getNFTsPath, getHandlerFuncForEsdt(core.NonFungibleESDT))
 	router.RegisterHandler(http.MethodGet, directStakedInfoPath, DirectStakedInfo)
 	router.RegisterHandler(http.MethodGet, delegatedInfoPath, DelegatedInfo)
+	router.RegisterHandler(http.MethodGet, gasPricePath, GasPrice)
+	router.RegisterHandler(http.MethodGet, governancePath, GovernanceInfo)
+	router.RegisterHandler(http.MethodGet, tokenInfoPath, TokenInfo)
+	router.RegisterHandler(http.MethodGet, tokenHoldersPath, TokenHolders)
 }
 
 func getFacade(c *gin.Context) (FacadeHandler, bool) {
@@ -258,3 +278,128 @@ func DelegatedInfo(c *gin.Context) {
 		},
 	)
 }
+
+// GasPrice is the endpoint that will return the suggested gas prices for slow, normal and fast inclusion
+func GasPrice(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	estimates, err := facade.GetGasPriceEstimates()
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"gasPrice": estimates},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// GovernanceInfo is the endpoint that will return the governance configuration and the proposals, together with their tally
+func GovernanceInfo(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	info, err := facade.GetGovernanceInfo()
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"governance": info},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// TokenInfo is the endpoint that will return the properties, the special roles and the supply of an ESDT token
+func TokenInfo(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	token := c.Param("token")
+	if token == "" {
+		shared.RespondWithValidationError(c, fmt.Sprintf("%v: %v", errors.ErrValidation, errors.ErrEmptyTokenIdentifier))
+		return
+	}
+
+	tokenInfo, err := facade.GetTokenInfo(token)
+	if err != nil {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), shared.ReturnCodeInternalError)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"token": tokenInfo}, "", shared.ReturnCodeSuccess)
+}
+
+// TokenHolders is the endpoint that will return a page of the holders of an ESDT token from the node's shard
+func TokenHolders(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	token := c.Param("token")
+	if token == "" {
+		shared.RespondWithValidationError(c, fmt.Sprintf("%v: %v", errors.ErrValidation, errors.ErrEmptyTokenIdentifier))
+		return
+	}
+
+	offset, err := getQueryParamUint64(c, queryParamOffset, 0)
+	if err != nil {
+		shared.RespondWithValidationError(c, fmt.Sprintf("%v: %s", errors.ErrInvalidQueryParameter, queryParamOffset))
+		return
+	}
+
+	limit, err := getQueryParamUint64(c, queryParamLimit, defaultHoldersPageSize)
+	if err != nil {
+		shared.RespondWithValidationError(c, fmt.Sprintf("%v: %s", errors.ErrInvalidQueryParameter, queryParamLimit))
+		return
+	}
+
+	holders, err := facade.GetTokenHolders(token, offset, limit)
+	if err != nil {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), shared.ReturnCodeInternalError)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"holders": holders}, "", shared.ReturnCodeSuccess)
+}
+
+func getQueryParamUint64(c *gin.Context, param string, defaultValue uint64) (uint64, error) {
+	valueStr := c.Request.URL.Query().Get(param)
+	if valueStr == "" {
+		return defaultValue, nil
+	}
+
+	return strconv.ParseUint(valueStr, 10, 64)
+}
@@ -22,6 +22,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/node/external"
+	trieIteratorsData "github.com/ElrondNetwork/elrond-go/node/trieIterators/data"
+	gasPriceData "github.com/ElrondNetwork/elrond-go/process/gasprice/data"
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
@@ -423,6 +425,249 @@ func TestDelegatedInfo_CannotGetDelegatedList(t *testing.T) {
 	assert.True(t, strings.Contains(respStr, expectedError.Error()))
 }
 
+func TestGasPrice_NilContextShouldErr(t *testing.T) {
+	ws := startNodeServer(nil)
+	req, _ := http.NewRequest("GET", "/network/gas-price", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
+	assert.True(t, strings.Contains(response.Error, errors.ErrNilAppContext.Error()))
+}
+
+func TestGasPrice_ShouldWork(t *testing.T) {
+	estimates := &gasPriceData.GasPriceEstimates{
+		Slow:        1000000000,
+		Normal:      1500000000,
+		Fast:        2000000000,
+		MinGasPrice: 1000000000,
+		ShardID:     1,
+		NumBlocks:   20,
+	}
+	facade := mock.Facade{
+		GetGasPriceEstimatesHandler: func() (*gasPriceData.GasPriceEstimates, error) {
+			return estimates, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/network/gas-price", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := struct {
+		Data struct {
+			GasPrice gasPriceData.GasPriceEstimates `json:"gasPrice"`
+		} `json:"data"`
+		Error string `json:"error"`
+		Code  string `json:"code"`
+	}{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, *estimates, response.Data.GasPrice)
+}
+
+func TestGasPrice_CannotGetEstimatesShouldErr(t *testing.T) {
+	expectedError := fmt.Errorf("%s", "expected error")
+	facade := mock.Facade{
+		GetGasPriceEstimatesHandler: func() (*gasPriceData.GasPriceEstimates, error) {
+			return nil, expectedError
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/network/gas-price", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	respBytes, _ := ioutil.ReadAll(resp.Body)
+	respStr := string(respBytes)
+
+	assert.Equal(t, resp.Code, http.StatusInternalServerError)
+	assert.True(t, strings.Contains(respStr, expectedError.Error()))
+}
+
+func TestGovernanceInfo_ShouldWork(t *testing.T) {
+	info := &trieIteratorsData.GovernanceInfo{
+		Config: &trieIteratorsData.GovernanceConfig{
+			MinQuorum:        "500",
+			MinPassThreshold: "251",
+			MinVetoThreshold: "249",
+			ProposalFee:      "1000",
+		},
+		Proposals: []*trieIteratorsData.GovernanceProposal{
+			{
+				Issuer:         "erd1issuer",
+				CommitHash:     "commit",
+				StartVoteNonce: 10,
+				EndVoteNonce:   20,
+				Yes:            "600",
+				No:             "100",
+				Veto:           "0",
+				NumVoters:      3,
+				Closed:         true,
+				Outcome:        "passed",
+			},
+		},
+	}
+	facade := mock.Facade{
+		GetGovernanceInfoHandler: func() (*trieIteratorsData.GovernanceInfo, error) {
+			return info, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/network/governance", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := struct {
+		Data struct {
+			Governance trieIteratorsData.GovernanceInfo `json:"governance"`
+		} `json:"data"`
+		Error string `json:"error"`
+		Code  string `json:"code"`
+	}{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, *info, response.Data.Governance)
+}
+
+func TestGovernanceInfo_CannotGetInfoShouldErr(t *testing.T) {
+	expectedError := fmt.Errorf("%s", "expected error")
+	facade := mock.Facade{
+		GetGovernanceInfoHandler: func() (*trieIteratorsData.GovernanceInfo, error) {
+			return nil, expectedError
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/network/governance", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	respBytes, _ := ioutil.ReadAll(resp.Body)
+	respStr := string(respBytes)
+
+	assert.Equal(t, resp.Code, http.StatusInternalServerError)
+	assert.True(t, strings.Contains(respStr, expectedError.Error()))
+}
+
+func TestTokenInfo_ShouldWork(t *testing.T) {
+	tokenInfo := &trieIteratorsData.TokenInfo{
+		Identifier: "TKN-abcdef",
+		Properties: &trieIteratorsData.TokenProperties{
+			Name:        "Token",
+			Type:        "FungibleESDT",
+			NumDecimals: 18,
+			CanMint:     true,
+		},
+		SpecialRoles: []*trieIteratorsData.TokenSpecialRoles{
+			{Address: "erd1alice", Roles: []string{"ESDTRoleLocalMint"}},
+		},
+	}
+	facade := mock.Facade{
+		GetTokenInfoHandler: func(token string) (*trieIteratorsData.TokenInfo, error) {
+			assert.Equal(t, "TKN-abcdef", token)
+			return tokenInfo, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/network/esdt/token/TKN-abcdef", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := struct {
+		Data struct {
+			Token trieIteratorsData.TokenInfo `json:"token"`
+		} `json:"data"`
+		Error string `json:"error"`
+		Code  string `json:"code"`
+	}{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, *tokenInfo, response.Data.Token)
+}
+
+func TestTokenInfo_CannotGetInfoShouldErr(t *testing.T) {
+	expectedError := fmt.Errorf("%s", "expected error")
+	facade := mock.Facade{
+		GetTokenInfoHandler: func(token string) (*trieIteratorsData.TokenInfo, error) {
+			return nil, expectedError
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/network/esdt/token/TKN-abcdef", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	respBytes, _ := ioutil.ReadAll(resp.Body)
+	respStr := string(respBytes)
+
+	assert.Equal(t, resp.Code, http.StatusInternalServerError)
+	assert.True(t, strings.Contains(respStr, expectedError.Error()))
+}
+
+func TestTokenHolders_ShouldWork(t *testing.T) {
+	holders := []*trieIteratorsData.TokenHolder{
+		{Address: "erd1alice", Balance: "60"},
+		{Address: "erd1bob", Balance: "30"},
+	}
+	facade := mock.Facade{
+		GetTokenHoldersHandler: func(token string, offset uint64, limit uint64) ([]*trieIteratorsData.TokenHolder, error) {
+			assert.Equal(t, "TKN-abcdef", token)
+			assert.Equal(t, uint64(20), offset)
+			assert.Equal(t, uint64(100), limit)
+			return holders, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/network/esdt/token/TKN-abcdef/holders?offset=20", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := struct {
+		Data struct {
+			Holders []*trieIteratorsData.TokenHolder `json:"holders"`
+		} `json:"data"`
+		Error string `json:"error"`
+		Code  string `json:"code"`
+	}{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, holders, response.Data.Holders)
+}
+
+func TestTokenHolders_InvalidLimitShouldErr(t *testing.T) {
+	facade := mock.Facade{
+		GetTokenHoldersHandler: func(token string, offset uint64, limit uint64) ([]*trieIteratorsData.TokenHolder, error) {
+			assert.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/network/esdt/token/TKN-abcdef/holders?limit=abc", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	respBytes, _ := ioutil.ReadAll(resp.Body)
+	respStr := string(respBytes)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(respStr, errors.ErrInvalidQueryParameter.Error()))
+}
+
 func TestGetEnableEpochs_NilContextShouldErr(t *testing.T) {
 	t.Parallel()
 	ws := startNodeServer(nil)
@@ -532,6 +777,10 @@ func getRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/enable-epochs", Open: true},
 					{Name: "/direct-staked-info", Open: true},
 					{Name: "/delegated-info", Open: true},
+					{Name: "/gas-price", Open: true},
+					{Name: "/governance", Open: true},
+					{Name: "/esdt/token/:token", Open: true},
+					{Name: "/esdt/token/:token/holders", Open: true},
 				},
 			},
 		},
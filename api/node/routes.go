@@ -8,6 +8,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
@@ -15,13 +16,16 @@ import (
 )
 
 const (
-	pidQueryParam       = "pid"
-	debugPath           = "/debug"
-	heartbeatStatusPath = "/heartbeatstatus"
-	metricsPath         = "/metrics"
-	p2pStatusPath       = "/p2pstatus"
-	peerInfoPath        = "/peerinfo"
-	statusPath          = "/status"
+	pidQueryParam        = "pid"
+	consensusRoundsPath  = "/consensus/rounds"
+	debugPath            = "/debug"
+	heartbeatStatusPath  = "/heartbeatstatus"
+	managedKeysPath      = "/managedkeys"
+	metricsPath          = "/metrics"
+	p2pStatusPath        = "/p2pstatus"
+	peerInfoPath         = "/peerinfo"
+	slashingEvidencePath = "/slashing/evidence"
+	statusPath           = "/status"
 )
 
 // AccStateCheckpointsKey is used as a key for the number of account state checkpoints in the api response
@@ -36,6 +40,9 @@ type FacadeHandler interface {
 	StatusMetrics() external.StatusMetricsHandler
 	GetQueryHandler(name string) (debug.QueryHandler, error)
 	GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetConsensusRounds() ([]consensus.RoundTrace, error)
+	GetSlashingEvidences() ([]*consensus.SlashingEvidence, error)
+	GetManagedKeysMetrics() ([]*consensus.ManagedKeyMetrics, error)
 	GetNumCheckpointsFromAccountState() uint32
 	GetNumCheckpointsFromPeerState() uint32
 	IsInterfaceNil() bool
@@ -55,6 +62,9 @@ func Routes(router *wrapper.RouterWrapper) {
 	router.RegisterHandler(http.MethodGet, metricsPath, PrometheusMetrics)
 	router.RegisterHandler(http.MethodPost, debugPath, QueryDebug)
 	router.RegisterHandler(http.MethodGet, peerInfoPath, PeerInfo)
+	router.RegisterHandler(http.MethodGet, consensusRoundsPath, ConsensusRounds)
+	router.RegisterHandler(http.MethodGet, slashingEvidencePath, SlashingEvidences)
+	router.RegisterHandler(http.MethodGet, managedKeysPath, ManagedKeys)
 	// placeholder for custom routes
 }
 
@@ -237,6 +247,36 @@ func PeerInfo(c *gin.Context) {
 	)
 }
 
+// ConsensusRounds returns the traces of the latest consensus rounds
+func ConsensusRounds(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	rounds, err := facade.GetConsensusRounds()
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetConsensusRounds.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"rounds": rounds},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
 // PrometheusMetrics is the endpoint which will return the data in the way that prometheus expects them
 func PrometheusMetrics(c *gin.Context) {
 	facade, ok := getFacade(c)
@@ -250,3 +290,64 @@ func PrometheusMetrics(c *gin.Context) {
 		metrics,
 	)
 }
+
+// SlashingEvidences returns the collected evidences of validators that signed conflicting data, each one along with
+// the unsigned transaction that can be used to submit it
+func SlashingEvidences(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	evidences, err := facade.GetSlashingEvidences()
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetSlashingEvidences.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"evidence": evidences},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// ManagedKeys returns the validator keys managed by the current node along with their activity counters
+func ManagedKeys(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	metrics, err := facade.GetManagedKeysMetrics()
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetManagedKeysMetrics.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"managedKeys": metrics},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
@@ -392,6 +393,174 @@ func TestPeerInfo_PeerInfoShouldWork(t *testing.T) {
 	assert.NotNil(t, responseInfo["info"])
 }
 
+func TestConsensusRounds_ErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errs.New("expected error")
+	facade := &mock.Facade{
+		GetConsensusRoundsCalled: func() ([]consensus.RoundTrace, error) {
+			return nil, expectedErr
+		},
+	}
+	ws := startNodeServerWithFacade(facade)
+	req, _ := http.NewRequest("GET", "/node/consensus/rounds", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestConsensusRounds_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetConsensusRoundsCalled: func() ([]consensus.RoundTrace, error) {
+			return []consensus.RoundTrace{
+				{
+					Round: 7,
+					Events: []consensus.RoundEvent{
+						{Type: consensus.RoundEventJobStart, Round: 7, Subround: "(BLOCK)"},
+					},
+				},
+			}, nil
+		},
+	}
+	ws := startNodeServerWithFacade(facade)
+	req, _ := http.NewRequest("GET", "/node/consensus/rounds", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "", response.Error)
+
+	responseData, ok := response.Data.(map[string]interface{})
+	require.True(t, ok)
+	rounds, ok := responseData["rounds"].([]interface{})
+	require.True(t, ok)
+	assert.Equal(t, 1, len(rounds))
+}
+
+func TestSlashingEvidences_ErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errs.New("expected error")
+	facade := &mock.Facade{
+		GetSlashingEvidencesCalled: func() ([]*consensus.SlashingEvidence, error) {
+			return nil, expectedErr
+		},
+	}
+	ws := startNodeServerWithFacade(facade)
+	req, _ := http.NewRequest("GET", "/node/slashing/evidence", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestSlashingEvidences_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetSlashingEvidencesCalled: func() ([]*consensus.SlashingEvidence, error) {
+			return []*consensus.SlashingEvidence{
+				{
+					Hash:   "aa",
+					Type:   consensus.DoubleSigningEvidence,
+					PubKey: "bb",
+					Round:  4,
+				},
+			}, nil
+		},
+	}
+	ws := startNodeServerWithFacade(facade)
+	req, _ := http.NewRequest("GET", "/node/slashing/evidence", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "", response.Error)
+
+	responseData, ok := response.Data.(map[string]interface{})
+	require.True(t, ok)
+	evidences, ok := responseData["evidence"].([]interface{})
+	require.True(t, ok)
+	require.Equal(t, 1, len(evidences))
+	evidence, ok := evidences[0].(map[string]interface{})
+	require.True(t, ok)
+	assert.Equal(t, string(consensus.DoubleSigningEvidence), evidence["type"])
+}
+
+func TestManagedKeys_ErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errs.New("expected error")
+	facade := &mock.Facade{
+		GetManagedKeysMetricsCalled: func() ([]*consensus.ManagedKeyMetrics, error) {
+			return nil, expectedErr
+		},
+	}
+	ws := startNodeServerWithFacade(facade)
+	req, _ := http.NewRequest("GET", "/node/managedkeys", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestManagedKeys_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetManagedKeysMetricsCalled: func() ([]*consensus.ManagedKeyMetrics, error) {
+			return []*consensus.ManagedKeyMetrics{
+				{
+					PubKey:                 "aa",
+					RoundsInConsensusGroup: 5,
+					BlocksProposed:         2,
+				},
+			}, nil
+		},
+	}
+	ws := startNodeServerWithFacade(facade)
+	req, _ := http.NewRequest("GET", "/node/managedkeys", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "", response.Error)
+
+	responseData, ok := response.Data.(map[string]interface{})
+	require.True(t, ok)
+	managedKeys, ok := responseData["managedKeys"].([]interface{})
+	require.True(t, ok)
+	require.Equal(t, 1, len(managedKeys))
+	managedKey, ok := managedKeys[0].(map[string]interface{})
+	require.True(t, ok)
+	assert.Equal(t, "aa", managedKey["pubKey"])
+	assert.Equal(t, float64(2), managedKey["blocksProposed"])
+}
+
 func TestPrometheusMetrics_NilContextShouldErr(t *testing.T) {
 	ws := startNodeServer(nil)
 	req, _ := http.NewRequest("GET", "/node/metrics", nil)
@@ -489,6 +658,9 @@ func getRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/p2pstatus", Open: true},
 					{Name: "/debug", Open: true},
 					{Name: "/peerinfo", Open: true},
+					{Name: "/consensus/rounds", Open: true},
+					{Name: "/slashing/evidence", Open: true},
+					{Name: "/managedkeys", Open: true},
 				},
 			},
 		},
@@ -20,16 +20,19 @@ import (
 const (
 	sendTransactionEndpoint          = "/transaction/send"
 	simulateTransactionEndpoint      = "/transaction/simulate"
+	simulateBundleEndpoint           = "/transaction/simulate-bundle"
 	sendMultipleTransactionsEndpoint = "/transaction/send-multiple"
 	getTransactionEndpoint           = "/transaction/:hash"
 	sendTransactionPath              = "/send"
 	simulateTransactionPath          = "/simulate"
+	simulateBundlePath               = "/simulate-bundle"
 	costPath                         = "/cost"
 	sendMultiplePath                 = "/send-multiple"
 	getTransactionPath               = "/:txhash"
 
 	queryParamWithResults    = "withResults"
 	queryParamCheckSignature = "checkSignature"
+	queryParamTrace          = "trace"
 )
 
 // FacadeHandler interface defines methods that can be used by the gin webserver
@@ -40,6 +43,8 @@ type FacadeHandler interface {
 	ValidateTransactionForSimulation(tx *transaction.Transaction, checkSignature bool) error
 	SendBulkTransactions([]*transaction.Transaction) (uint64, error)
 	SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	SimulateTransactionExecutionWithTrace(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	SimulateTransactionsBundle(txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error)
 	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
 	EncodeAddressPubkey(pk []byte) (string, error)
@@ -103,6 +108,12 @@ func Routes(router *wrapper.RouterWrapper) {
 		middleware.CreateEndpointThrottler(simulateTransactionEndpoint),
 		SimulateTransaction,
 	)
+	router.RegisterHandler(
+		http.MethodPost,
+		simulateBundlePath,
+		middleware.CreateEndpointThrottler(simulateBundleEndpoint),
+		SimulateTransactionsBundle,
+	)
 	router.RegisterHandler(http.MethodPost, costPath, ComputeTransactionGasLimit)
 	router.RegisterHandler(
 		http.MethodPost,
@@ -182,6 +193,19 @@ func SimulateTransaction(c *gin.Context) {
 		return
 	}
 
+	withTrace, err := getQueryParamTrace(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: errors.ErrValidation.Error(),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
 	tx, txHash, err := facade.CreateTransaction(
 		gtx.Nonce,
 		gtx.Value,
@@ -222,7 +246,12 @@ func SimulateTransaction(c *gin.Context) {
 		return
 	}
 
-	executionResults, err := facade.SimulateTransactionExecution(tx)
+	var executionResults *txSimData.SimulationResults
+	if withTrace {
+		executionResults, err = facade.SimulateTransactionExecutionWithTrace(tx)
+	} else {
+		executionResults, err = facade.SimulateTransactionExecution(tx)
+	}
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -246,6 +275,106 @@ func SimulateTransaction(c *gin.Context) {
 	)
 }
 
+// SimulateTransactionsBundle will receive an ordered list of transactions from the client and will simulate their
+// execution on the same state and return the results
+func SimulateTransactionsBundle(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	var gtxs []SendTxRequest
+	err := c.ShouldBindJSON(&gtxs)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	checkSignature, err := getQueryParameterCheckSignature(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: errors.ErrValidation.Error(),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	txs := make([]*transaction.Transaction, 0, len(gtxs))
+	txsHashes := make([]string, 0, len(gtxs))
+	for idx, gtx := range gtxs {
+		tx, txHash, errCreate := facade.CreateTransaction(
+			gtx.Nonce,
+			gtx.Value,
+			gtx.Receiver,
+			gtx.ReceiverUsername,
+			gtx.Sender,
+			gtx.SenderUsername,
+			gtx.GasPrice,
+			gtx.GasLimit,
+			gtx.Data,
+			gtx.Signature,
+			gtx.ChainID,
+			gtx.Version,
+			gtx.Options,
+		)
+		if errCreate == nil {
+			errCreate = facade.ValidateTransactionForSimulation(tx, checkSignature)
+		}
+		if errCreate != nil {
+			c.JSON(
+				http.StatusBadRequest,
+				shared.GenericAPIResponse{
+					Data:  nil,
+					Error: fmt.Sprintf("%s for transaction %d: %s", errors.ErrTxGenerationFailed.Error(), idx, errCreate.Error()),
+					Code:  shared.ReturnCodeRequestError,
+				},
+			)
+			return
+		}
+
+		txs = append(txs, tx)
+		txsHashes = append(txsHashes, hex.EncodeToString(txHash))
+	}
+
+	executionResults, err := facade.SimulateTransactionsBundle(txs)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	for idx, txResults := range executionResults.Transactions {
+		if idx < len(txsHashes) {
+			txResults.Hash = txsHashes[idx]
+		}
+	}
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"result": executionResults},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
 // SendTransaction will receive a transaction from the client and propagate it for processing
 func SendTransaction(c *gin.Context) {
 	facade, ok := getFacade(c)
@@ -550,6 +679,15 @@ func getQueryParamWithResults(c *gin.Context) (bool, error) {
 	return strconv.ParseBool(withResultsStr)
 }
 
+func getQueryParamTrace(c *gin.Context) (bool, error) {
+	withTraceStr := c.Request.URL.Query().Get(queryParamTrace)
+	if withTraceStr == "" {
+		return false, nil
+	}
+
+	return strconv.ParseBool(withTraceStr)
+}
+
 func getQueryParameterCheckSignature(c *gin.Context) (bool, error) {
 	bypassSignatureStr := c.Request.URL.Query().Get(queryParamCheckSignature)
 	if bypassSignatureStr == "" {
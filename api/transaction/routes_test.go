@@ -22,10 +22,12 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/transaction"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
 	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type transactionResponseData struct {
@@ -55,6 +57,26 @@ type simulateTxResponse struct {
 	Code  string      `json:"code"`
 }
 
+type simulateTxWithTraceResponseData struct {
+	Result txSimData.SimulationResults `json:"result"`
+}
+
+type simulateTxWithTraceResponse struct {
+	Data  simulateTxWithTraceResponseData `json:"data"`
+	Error string                          `json:"error"`
+	Code  string                          `json:"code"`
+}
+
+type simulateBundleResponseData struct {
+	Result txSimData.BundleSimulationResults `json:"result"`
+}
+
+type simulateBundleResponse struct {
+	Data  simulateBundleResponseData `json:"data"`
+	Error string                     `json:"error"`
+	Code  string                     `json:"code"`
+}
+
 type sendSingleTxResponseData struct {
 	TxHash string `json:"txHash"`
 }
@@ -841,6 +863,201 @@ func TestSimulateTransaction(t *testing.T) {
 	assert.Equal(t, string(shared.ReturnCodeSuccess), simulateResponse.Code)
 }
 
+func TestSimulateTransaction_WithTraceShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedTraces := []*tracing.ExecutionTrace{{TxHash: "hash", Root: &tracing.CallFrame{Function: "function"}}}
+	facade := mock.Facade{
+		SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction) (*txSimData.SimulationResults, error) {
+			assert.Fail(t, "should have not called the simulation without trace")
+			return nil, nil
+		},
+		SimulateTransactionExecutionWithTraceHandler: func(tx *dataTx.Transaction) (*txSimData.SimulationResults, error) {
+			return &txSimData.SimulationResults{
+				Status: "ok",
+				Hash:   "hash",
+				Traces: expectedTraces,
+			}, nil
+		},
+		CreateTransactionHandler: func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64, gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*dataTx.Transaction, []byte, error) {
+			return &dataTx.Transaction{}, []byte("hash"), nil
+		},
+		ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool) error {
+			return nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	tx := transaction.SendTxRequest{
+		Sender:    "sender1",
+		Receiver:  "receiver1",
+		Value:     "100",
+		Data:      make([]byte, 0),
+		Nonce:     0,
+		GasPrice:  0,
+		GasLimit:  0,
+		Signature: "",
+	}
+	jsonBytes, _ := json.Marshal(tx)
+
+	req, _ := http.NewRequest("POST", "/transaction/simulate?trace=true", bytes.NewBuffer(jsonBytes))
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	simulateResponse := simulateTxWithTraceResponse{}
+	loadResponse(resp.Body, &simulateResponse)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, string(shared.ReturnCodeSuccess), simulateResponse.Code)
+	assert.Equal(t, expectedTraces, simulateResponse.Data.Result.Traces)
+}
+
+func TestSimulateTransaction_InvalidTraceParameterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{}
+	ws := startNodeServer(&facade)
+
+	tx := transaction.SendTxRequest{
+		Sender:   "sender1",
+		Receiver: "receiver1",
+		Value:    "100",
+	}
+	jsonBytes, _ := json.Marshal(tx)
+
+	req, _ := http.NewRequest("POST", "/transaction/simulate?trace=tttt", bytes.NewBuffer(jsonBytes))
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	simulateResponse := simulateTxResponse{}
+	loadResponse(resp.Body, &simulateResponse)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Equal(t, apiErrors.ErrValidation.Error(), simulateResponse.Error)
+}
+
+func TestSimulateTransactionsBundle_InvalidTransactionShouldErr(t *testing.T) {
+	t.Parallel()
+
+	bundleWasSimulated := false
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		SimulateTransactionsBundleHandler: func(txs []*dataTx.Transaction) (*txSimData.BundleSimulationResults, error) {
+			bundleWasSimulated = true
+			return &txSimData.BundleSimulationResults{}, nil
+		},
+		CreateTransactionHandler: func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64, gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*dataTx.Transaction, []byte, error) {
+			return &dataTx.Transaction{Nonce: nonce}, []byte("hash"), nil
+		},
+		ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool) error {
+			if tx.Nonce == 1 {
+				return expectedErr
+			}
+			return nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	txs := []transaction.SendTxRequest{
+		{Sender: "sender1", Receiver: "receiver1", Value: "100", Nonce: 0},
+		{Sender: "sender1", Receiver: "receiver1", Value: "100", Nonce: 1},
+	}
+	jsonBytes, _ := json.Marshal(txs)
+
+	req, _ := http.NewRequest("POST", "/transaction/simulate-bundle", bytes.NewBuffer(jsonBytes))
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	simulateResponse := simulateBundleResponse{}
+	loadResponse(resp.Body, &simulateResponse)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.False(t, bundleWasSimulated)
+	assert.Contains(t, simulateResponse.Error, "transaction 1")
+	assert.Contains(t, simulateResponse.Error, expectedErr.Error())
+}
+
+func TestSimulateTransactionsBundle_SimulationErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		SimulateTransactionsBundleHandler: func(txs []*dataTx.Transaction) (*txSimData.BundleSimulationResults, error) {
+			return nil, expectedErr
+		},
+		CreateTransactionHandler: func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64, gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*dataTx.Transaction, []byte, error) {
+			return &dataTx.Transaction{}, []byte("hash"), nil
+		},
+		ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool) error {
+			return nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	jsonBytes, _ := json.Marshal([]transaction.SendTxRequest{{Sender: "sender1", Receiver: "receiver1", Value: "100"}})
+
+	req, _ := http.NewRequest("POST", "/transaction/simulate-bundle", bytes.NewBuffer(jsonBytes))
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	simulateResponse := simulateBundleResponse{}
+	loadResponse(resp.Body, &simulateResponse)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, expectedErr.Error(), simulateResponse.Error)
+}
+
+func TestSimulateTransactionsBundle_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	var simulatedTxs []*dataTx.Transaction
+	facade := mock.Facade{
+		SimulateTransactionsBundleHandler: func(txs []*dataTx.Transaction) (*txSimData.BundleSimulationResults, error) {
+			simulatedTxs = txs
+			return &txSimData.BundleSimulationResults{
+				Transactions: []*txSimData.SimulationResults{
+					{Status: dataTx.TxStatusSuccess},
+					{Status: dataTx.TxStatusPending},
+				},
+			}, nil
+		},
+		CreateTransactionHandler: func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64, gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*dataTx.Transaction, []byte, error) {
+			return &dataTx.Transaction{Nonce: nonce}, []byte(fmt.Sprintf("hash%d", nonce)), nil
+		},
+		ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool) error {
+			return nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	txs := []transaction.SendTxRequest{
+		{Sender: "sender1", Receiver: "receiver1", Value: "100", Nonce: 0},
+		{Sender: "sender1", Receiver: "receiver1", Value: "100", Nonce: 1},
+	}
+	jsonBytes, _ := json.Marshal(txs)
+
+	req, _ := http.NewRequest("POST", "/transaction/simulate-bundle", bytes.NewBuffer(jsonBytes))
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	simulateResponse := simulateBundleResponse{}
+	loadResponse(resp.Body, &simulateResponse)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, string(shared.ReturnCodeSuccess), simulateResponse.Code)
+	require.Equal(t, 2, len(simulatedTxs))
+	assert.Equal(t, uint64(1), simulatedTxs[1].Nonce)
+	require.Equal(t, 2, len(simulateResponse.Data.Result.Transactions))
+	assert.Equal(t, hex.EncodeToString([]byte("hash0")), simulateResponse.Data.Result.Transactions[0].Hash)
+	assert.Equal(t, hex.EncodeToString([]byte("hash1")), simulateResponse.Data.Result.Transactions[1].Hash)
+	assert.Equal(t, dataTx.TxStatusPending, simulateResponse.Data.Result.Transactions[1].Status)
+}
+
 func loadResponse(rsp io.Reader, destination interface{}) {
 	jsonParser := json.NewDecoder(rsp)
 	err := jsonParser.Decode(destination)
@@ -890,6 +1107,7 @@ func getRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/:txhash", Open: true},
 					{Name: "/:txhash/status", Open: true},
 					{Name: "/simulate", Open: true},
+					{Name: "/simulate-bundle", Open: true},
 				},
 			},
 		},
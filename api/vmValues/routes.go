@@ -5,12 +5,14 @@ import (
 	"fmt"
 	"math/big"
 	"net/http"
+	"strconv"
 
 	"github.com/ElrondNetwork/elrond-go-core/data/vm"
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/gin-gonic/gin"
 )
@@ -20,11 +22,14 @@ const (
 	stringPath = "/string"
 	intPath    = "/int"
 	queryPath  = "/query"
+
+	queryParamTrace = "trace"
 )
 
 // FacadeHandler interface defines methods that can be used by the gin webserver
 type FacadeHandler interface {
 	ExecuteSCQuery(*process.SCQuery) (*vm.VMOutputApi, error)
+	ExecuteSCQueryWithTrace(*process.SCQuery) (*vm.VMOutputApi, *tracing.ExecutionTrace, error)
 	DecodeAddressPubkey(pk string) ([]byte, error)
 	IsInterfaceNil() bool
 }
@@ -62,7 +67,7 @@ func getInt(context *gin.Context) {
 }
 
 func doGetVMValue(context *gin.Context, asType vm.ReturnDataKind) {
-	vmOutput, execErrMsg, err := doExecuteQuery(context)
+	vmOutput, _, execErrMsg, err := doExecuteQuery(context, false)
 
 	if err != nil {
 		returnBadRequest(context, "doGetVMValue", err)
@@ -77,42 +82,66 @@ func doGetVMValue(context *gin.Context, asType vm.ReturnDataKind) {
 	returnOkResponse(context, returnData, execErrMsg)
 }
 
-// executeQuery returns the data as string
+// executeQuery returns the data as string. The trace of the execution is also returned if requested
 func executeQuery(context *gin.Context) {
-	vmOutput, execErrMsg, err := doExecuteQuery(context)
+	withTrace, err := getQueryParamTrace(context)
+	if err != nil {
+		returnBadRequest(context, "executeQuery", errors.ErrValidation)
+		return
+	}
+
+	vmOutput, trace, execErrMsg, err := doExecuteQuery(context, withTrace)
 	if err != nil {
 		returnBadRequest(context, "executeQuery", err)
 		return
 	}
 
-	returnOkResponse(context, vmOutput, execErrMsg)
+	if !withTrace {
+		returnOkResponse(context, vmOutput, execErrMsg)
+		return
+	}
+
+	context.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"data": vmOutput, "trace": trace},
+			Error: execErrMsg,
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
 }
 
-func doExecuteQuery(context *gin.Context) (*vm.VMOutputApi, string, error) {
+func doExecuteQuery(context *gin.Context, withTrace bool) (*vm.VMOutputApi, *tracing.ExecutionTrace, string, error) {
 	efObj, ok := context.Get("facade")
 	if !ok {
-		return nil, "", errors.ErrNilAppContext
+		return nil, nil, "", errors.ErrNilAppContext
 	}
 
 	ef, ok := efObj.(FacadeHandler)
 	if !ok {
-		return nil, "", errors.ErrInvalidAppContext
+		return nil, nil, "", errors.ErrInvalidAppContext
 	}
 
 	request := VMValueRequest{}
 	err := context.ShouldBindJSON(&request)
 	if err != nil {
-		return nil, "", errors.ErrInvalidJSONRequest
+		return nil, nil, "", errors.ErrInvalidJSONRequest
 	}
 
 	command, err := createSCQuery(ef, &request)
 	if err != nil {
-		return nil, "", err
+		return nil, nil, "", err
 	}
 
-	vmOutputApi, err := ef.ExecuteSCQuery(command)
+	var vmOutputApi *vm.VMOutputApi
+	var trace *tracing.ExecutionTrace
+	if withTrace {
+		vmOutputApi, trace, err = ef.ExecuteSCQueryWithTrace(command)
+	} else {
+		vmOutputApi, err = ef.ExecuteSCQuery(command)
+	}
 	if err != nil {
-		return nil, "", err
+		return nil, nil, "", err
 	}
 
 	vmExecErrMsg := ""
@@ -120,7 +149,16 @@ func doExecuteQuery(context *gin.Context) (*vm.VMOutputApi, string, error) {
 		vmExecErrMsg = vmOutputApi.ReturnCode + ":" + vmOutputApi.ReturnMessage
 	}
 
-	return vmOutputApi, vmExecErrMsg, nil
+	return vmOutputApi, trace, vmExecErrMsg, nil
+}
+
+func getQueryParamTrace(context *gin.Context) (bool, error) {
+	withTraceStr := context.Request.URL.Query().Get(queryParamTrace)
+	if withTraceStr == "" {
+		return false, nil
+	}
+
+	return strconv.ParseBool(withTraceStr)
 }
 
 func createSCQuery(fh FacadeHandler, request *VMValueRequest) (*process.SCQuery, error) {
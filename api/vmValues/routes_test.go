@@ -19,6 +19,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
@@ -35,6 +36,12 @@ type vmOutputResponse struct {
 	Error string             `json:"error"`
 }
 
+type vmOutputWithTraceResponse struct {
+	Data  *vmcommon.VMOutput      `json:"data"`
+	Trace *tracing.ExecutionTrace `json:"trace"`
+	Error string                  `json:"error"`
+}
+
 func init() {
 	gin.SetMode(gin.TestMode)
 }
@@ -150,6 +157,55 @@ func TestQuery_ShouldWork(t *testing.T) {
 	require.Equal(t, int64(42), big.NewInt(0).SetBytes(response.Data.ReturnData[0]).Int64())
 }
 
+func TestQuery_WithTraceShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedTrace := &tracing.ExecutionTrace{
+		Root: &tracing.CallFrame{
+			Type:     tracing.FrameTypeCall,
+			Callee:   DummyScAddress,
+			Function: "function",
+		},
+	}
+	facade := mock.Facade{
+		ExecuteSCQueryWithTraceHandler: func(query *process.SCQuery) (*vm.VMOutputApi, *tracing.ExecutionTrace, error) {
+			return &vm.VMOutputApi{
+				ReturnData: [][]byte{big.NewInt(42).Bytes()},
+			}, expectedTrace, nil
+		},
+	}
+
+	request := VMValueRequest{
+		ScAddress: DummyScAddress,
+		FuncName:  "function",
+		Args:      []string{},
+	}
+
+	response := vmOutputWithTraceResponse{}
+	statusCode := doPost(&facade, "/vm-values/query?trace=true", request, &response)
+
+	require.Equal(t, http.StatusOK, statusCode)
+	require.Equal(t, "", response.Error)
+	require.Equal(t, int64(42), big.NewInt(0).SetBytes(response.Data.ReturnData[0]).Int64())
+	require.Equal(t, expectedTrace, response.Trace)
+}
+
+func TestQuery_InvalidTraceParameterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	request := VMValueRequest{
+		ScAddress: DummyScAddress,
+		FuncName:  "function",
+		Args:      []string{},
+	}
+
+	response := simpleResponse{}
+	statusCode := doPost(&mock.Facade{}, "/vm-values/query?trace=tttt", request, &response)
+
+	require.Equal(t, http.StatusBadRequest, statusCode)
+	require.Contains(t, response.Error, apiErrors.ErrValidation.Error())
+}
+
 func TestCreateSCQuery_ArgumentIsNotHexShouldErr(t *testing.T) {
 	request := VMValueRequest{
 		ScAddress: DummyScAddress,
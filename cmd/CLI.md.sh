@@ -6,6 +6,11 @@ generate() {
     generateForTermUi
     generateForLogViewer
     generateForSeedNode
+    generateForSignerDaemon
+    generateForEconomicsSimulator
+    generateForCheckpointExporter
+    generateForLightClient
+    generateForGenesisBuilder
 }
 
 generateForNode() {
@@ -78,6 +83,76 @@ $(code)
     echo "$HELP" > ./seednode/CLI.md
 }
 
+generateForSignerDaemon() {
+    HELP="
+# Elrond Signer Daemon CLI
+
+The **Signer daemon** exposes the following Command Line Interface:
+$(code)
+\$ signerdaemon --help
+
+$(./signerdaemon/signerdaemon --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./signerdaemon/CLI.md
+}
+
+generateForEconomicsSimulator() {
+    HELP="
+# Elrond Economics Simulator CLI
+
+The **Economics simulator** exposes the following Command Line Interface:
+$(code)
+\$ economicssimulator --help
+
+$(./economicssimulator/economicssimulator --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./economicssimulator/CLI.md
+}
+
+generateForCheckpointExporter() {
+    HELP="
+# Elrond Checkpoint Exporter CLI
+
+The **Checkpoint exporter** exposes the following Command Line Interface:
+$(code)
+\$ checkpointexporter --help
+
+$(./checkpointexporter/checkpointexporter --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./checkpointexporter/CLI.md
+}
+
+generateForLightClient() {
+    HELP="
+# Elrond Light Client CLI
+
+The **Light client** exposes the following Command Line Interface:
+$(code)
+\$ lightclient --help
+
+$(./lightclient/lightclient --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./lightclient/CLI.md
+}
+
+generateForGenesisBuilder() {
+    HELP="
+# Elrond Genesis Builder CLI
+
+The **Genesis builder** exposes the following Command Line Interface:
+$(code)
+\$ genesisbuilder --help
+
+$(./genesisbuilder/genesisbuilder --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./genesisbuilder/CLI.md
+}
+
 code() {
     printf "\n\`\`\`\n"
 }
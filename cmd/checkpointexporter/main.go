@@ -0,0 +1,292 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	hasherFactory "github.com/ElrondNetwork/elrond-go-core/hashing/factory"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	marshalizerFactory "github.com/ElrondNetwork/elrond-go-core/marshal/factory"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/epochStart/bootstrap/checkpoint"
+	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/trie/factory"
+	"github.com/urfave/cli"
+)
+
+var (
+	checkpointExporterHelpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// workingDirectory defines a flag for the path of the node working directory
+	workingDirectory = cli.StringFlag{
+		Name:  "working-directory",
+		Usage: "The `directory` of the stopped node holding the databases the state is exported from.",
+		Value: "",
+	}
+	// configurationFile defines a flag for the path to the main toml configuration file
+	configurationFile = cli.StringFlag{
+		Name:  "config",
+		Usage: "The `filepath` for the main toml configuration file of the node.",
+		Value: "./config/config.toml",
+	}
+	// shard defines a flag for the shard of the exported state
+	shard = cli.StringFlag{
+		Name:  "shard",
+		Usage: "The shard of the exported state. Possible values: 0, 1, 2, ..., metachain",
+		Value: "0",
+	}
+	// rootHash defines a flag for the accounts state root hash
+	rootHash = cli.StringFlag{
+		Name: "root-hash",
+		Usage: "The hex encoded accounts state root hash to be exported. It should be the root hash of the epoch " +
+			"start block of the shard",
+		Value: "",
+	}
+	// validatorStatsRootHash defines a flag for the validators statistics root hash
+	validatorStatsRootHash = cli.StringFlag{
+		Name: "validator-stats-root-hash",
+		Usage: "The hex encoded validators statistics root hash to be exported. It is required only for the " +
+			"metachain and should be the one of the epoch start meta block",
+		Value: "",
+	}
+	// outputFile defines a flag for the path of the written state snapshot
+	outputFile = cli.StringFlag{
+		Name:  "output-file",
+		Usage: "The `filepath` the state snapshot is written to.",
+		Value: "./state.snapshot",
+	}
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,api:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the api package which will receive a DEBUG" +
+			" log level.",
+		Value: "*:" + logger.LogInfo.String(),
+	}
+)
+
+var log = logger.GetOrCreate("checkpointexporter")
+
+type trieCreator interface {
+	Create(args factory.TrieCreateArgs) (common.StorageManager, common.Trie, error)
+}
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = checkpointExporterHelpTemplate
+	app.Name = "Checkpoint exporter"
+	app.Version = "v1.0.0"
+	app.Usage = "This binary writes the state tries of a stopped node, for the provided root hashes, in a state " +
+		"snapshot file. The snapshot can be used by other nodes to start in epoch from a trusted checkpoint, " +
+		"without syncing the state tries from the network"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+	app.Flags = []cli.Flag{
+		workingDirectory,
+		configurationFile,
+		shard,
+		rootHash,
+		validatorStatsRootHash,
+		outputFile,
+		logLevel,
+	}
+
+	app.Action = func(c *cli.Context) error {
+		return exportState(c)
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func exportState(ctx *cli.Context) error {
+	err := logger.SetLogLevel(ctx.GlobalString(logLevel.Name))
+	if err != nil {
+		return err
+	}
+
+	shardID, err := common.ProcessDestinationShardAsObserver(ctx.GlobalString(shard.Name))
+	if err != nil {
+		return err
+	}
+	if shardID == common.DisabledShardIDAsObserver {
+		return fmt.Errorf("invalid value for flag %s: %s", shard.Name, ctx.GlobalString(shard.Name))
+	}
+
+	header := checkpoint.SnapshotHeader{
+		ShardID: shardID,
+	}
+	header.RootHash, err = decodeHash(ctx, rootHash)
+	if err != nil {
+		return err
+	}
+	if shardID == core.MetachainShardId {
+		header.ValidatorStatsRootHash, err = decodeHash(ctx, validatorStatsRootHash)
+		if err != nil {
+			return err
+		}
+	}
+
+	generalConfig, err := common.LoadMainConfig(ctx.GlobalString(configurationFile.Name))
+	if err != nil {
+		return err
+	}
+	marshalizer, err := marshalizerFactory.NewMarshalizer(generalConfig.Marshalizer.Type)
+	if err != nil {
+		return err
+	}
+
+	trieFactory, err := createTrieFactory(ctx, generalConfig, marshalizer)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(ctx.GlobalString(outputFile.Name), os.O_CREATE|os.O_TRUNC|os.O_WRONLY, core.FileModeReadWrite)
+	if err != nil {
+		return err
+	}
+
+	err = writeSnapshot(f, header, trieFactory, marshalizer, generalConfig)
+	if err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+func decodeHash(ctx *cli.Context, flag cli.StringFlag) ([]byte, error) {
+	hash, err := hex.DecodeString(ctx.GlobalString(flag.Name))
+	if err != nil || len(hash) == 0 {
+		return nil, fmt.Errorf("invalid value for flag %s: %s", flag.Name, ctx.GlobalString(flag.Name))
+	}
+
+	return hash, nil
+}
+
+func createTrieFactory(ctx *cli.Context, generalConfig *config.Config, marshalizer marshal.Marshalizer) (trieCreator, error) {
+	hasher, err := hasherFactory.NewHasher(generalConfig.Hasher.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	pathManager, err := storageFactory.CreatePathManager(
+		storageFactory.ArgCreatePathManager{
+			WorkingDir: ctx.GlobalString(workingDirectory.Name),
+			ChainID:    generalConfig.GeneralSettings.ChainID,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return factory.NewTrieFactory(factory.TrieFactoryArgs{
+		SnapshotDbCfg:            generalConfig.TrieSnapshotDB,
+		Marshalizer:              marshalizer,
+		Hasher:                   hasher,
+		PathManager:              pathManager,
+		TrieStorageManagerConfig: generalConfig.TrieStorageManagerConfig,
+	})
+}
+
+func writeSnapshot(
+	w io.Writer,
+	header checkpoint.SnapshotHeader,
+	trieFactory trieCreator,
+	marshalizer marshal.Marshalizer,
+	generalConfig *config.Config,
+) error {
+	snapshotWriter, err := checkpoint.NewSnapshotWriter(w, header)
+	if err != nil {
+		return err
+	}
+
+	stateExporter, err := checkpoint.NewStateExporter(checkpoint.ArgsStateExporter{
+		Writer:      snapshotWriter,
+		Marshalizer: marshalizer,
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Info("exporting state", "snapshot", header.String())
+
+	shardIDString := core.GetShardIDString(header.ShardID)
+	err = exportTrie(trieFactory, factory.TrieCreateArgs{
+		TrieStorageConfig: generalConfig.AccountsTrieStorage,
+		ShardID:           shardIDString,
+		PruningEnabled:    generalConfig.StateTriesConfig.AccountsStatePruningEnabled,
+		MaxTrieLevelInMem: generalConfig.StateTriesConfig.MaxStateTrieLevelInMemory,
+	}, stateExporter, checkpoint.UserAccountsTrie, header.RootHash)
+	if err != nil {
+		return err
+	}
+
+	if header.ShardID == core.MetachainShardId {
+		err = exportTrie(trieFactory, factory.TrieCreateArgs{
+			TrieStorageConfig: generalConfig.PeerAccountsTrieStorage,
+			ShardID:           shardIDString,
+			PruningEnabled:    generalConfig.StateTriesConfig.PeerStatePruningEnabled,
+			MaxTrieLevelInMem: generalConfig.StateTriesConfig.MaxPeerTrieLevelInMemory,
+		}, stateExporter, checkpoint.PeerAccountsTrie, header.ValidatorStatsRootHash)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = snapshotWriter.Flush()
+	if err != nil {
+		return err
+	}
+
+	log.Info("state exported", "num trie nodes", stateExporter.NumExportedNodes())
+
+	return nil
+}
+
+func exportTrie(
+	trieFactory trieCreator,
+	args factory.TrieCreateArgs,
+	stateExporter checkpoint.StateExporter,
+	trieType checkpoint.TrieType,
+	rootHash []byte,
+) error {
+	trieStorageManager, tr, err := trieFactory.Create(args)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		errClose := trieStorageManager.Close()
+		if errClose != nil {
+			log.Warn("error closing trie storage", "error", errClose)
+		}
+	}()
+
+	return stateExporter.ExportTrie(trieType, tr, rootHash)
+}
@@ -0,0 +1,389 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/pubkeyConverter"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/cmd/economicssimulator/simulation"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/urfave/cli"
+)
+
+const addressLength = 32
+
+var (
+	economicsSimulatorHelpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// economicsConfigFile defines a flag for the path to the economics toml configuration file
+	economicsConfigFile = cli.StringFlag{
+		Name:  "economics-config",
+		Usage: "The `filepath` for the economics toml configuration file holding the proposed parameters.",
+		Value: "./config/economics.toml",
+	}
+	// ratingsConfigFile defines a flag for the path to the ratings toml configuration file
+	ratingsConfigFile = cli.StringFlag{
+		Name:  "ratings-config",
+		Usage: "The `filepath` for the ratings toml configuration file.",
+		Value: "./config/ratings.toml",
+	}
+	// systemSCConfigFile defines a flag for the path to the system smart contracts toml configuration file
+	systemSCConfigFile = cli.StringFlag{
+		Name:  "system-sc-config",
+		Usage: "The `filepath` for the system smart contracts toml configuration file, holding the node price.",
+		Value: "./config/systemSmartContractsConfig.toml",
+	}
+	// epochConfigFile defines a flag for the path to the enable epochs toml configuration file
+	epochConfigFile = cli.StringFlag{
+		Name:  "epoch-config",
+		Usage: "The `filepath` for the enable epochs toml configuration file.",
+		Value: "./config/enableEpochs.toml",
+	}
+	// validatorsFile defines a flag for the path to the JSON file holding the validator set
+	validatorsFile = cli.StringFlag{
+		Name: "validators",
+		Usage: "The `filepath` of the JSON file holding the simulated owners, their top-up and their nodes. If " +
+			"empty, a synthetic validator set is generated",
+		Value: "",
+	}
+	// numShards defines a flag for the number of shards
+	numShards = cli.UintFlag{
+		Name:  "num-shards",
+		Usage: "The number of shards, without the metachain",
+		Value: 3,
+	}
+	// nodesPerShard defines a flag for the number of nodes in each shard of the synthetic validator set
+	nodesPerShard = cli.UintFlag{
+		Name:  "nodes-per-shard",
+		Usage: "The number of eligible nodes in each shard of the synthetic validator set",
+		Value: 400,
+	}
+	// metaNodes defines a flag for the number of metachain nodes of the synthetic validator set
+	metaNodes = cli.UintFlag{
+		Name:  "meta-nodes",
+		Usage: "The number of eligible metachain nodes of the synthetic validator set",
+		Value: 400,
+	}
+	// numOwners defines a flag for the number of owners of the synthetic validator set
+	numOwners = cli.UintFlag{
+		Name:  "num-owners",
+		Usage: "The number of owners the nodes of the synthetic validator set are distributed to",
+		Value: 100,
+	}
+	// topUpPerNode defines a flag for the top-up of each node of the synthetic validator set
+	topUpPerNode = cli.StringFlag{
+		Name:  "top-up-per-node",
+		Usage: "The top-up staked for each node of the synthetic validator set, in denominated units",
+		Value: "0",
+	}
+	// downtime defines a flag for the downtime of the nodes of the synthetic validator set
+	downtime = cli.Float64Flag{
+		Name:  "downtime",
+		Usage: "The fraction of rounds, between 0 and 1, the nodes of the synthetic validator set are offline",
+		Value: 0,
+	}
+	// seed defines a flag for the seed used to generate the synthetic validator set
+	seed = cli.StringFlag{
+		Name:  "seed",
+		Usage: "The seed used to generate the keys and addresses of the synthetic validator set",
+		Value: "simulation",
+	}
+	// consensusGroupSize defines a flag for the shard consensus group size
+	consensusGroupSize = cli.UintFlag{
+		Name:  "consensus-group-size",
+		Usage: "The consensus group size of the shards",
+		Value: 63,
+	}
+	// metaConsensusGroupSize defines a flag for the metachain consensus group size
+	metaConsensusGroupSize = cli.UintFlag{
+		Name:  "meta-consensus-group-size",
+		Usage: "The consensus group size of the metachain",
+		Value: 400,
+	}
+	// minNodesPerShard defines a flag for the minimum number of nodes in a shard
+	minNodesPerShard = cli.UintFlag{
+		Name:  "min-nodes-per-shard",
+		Usage: "The minimum number of nodes in a shard, used to compute the rating steps",
+		Value: 400,
+	}
+	// metaMinNodes defines a flag for the minimum number of nodes in the metachain
+	metaMinNodes = cli.UintFlag{
+		Name:  "meta-min-nodes",
+		Usage: "The minimum number of nodes in the metachain, used to compute the rating steps",
+		Value: 400,
+	}
+	// roundDuration defines a flag for the round duration
+	roundDuration = cli.Uint64Flag{
+		Name:  "round-duration",
+		Usage: "The round duration in milliseconds",
+		Value: 6000,
+	}
+	// roundsPerEpoch defines a flag for the number of rounds in an epoch
+	roundsPerEpoch = cli.Uint64Flag{
+		Name:  "rounds-per-epoch",
+		Usage: "The number of rounds in an epoch",
+		Value: 14400,
+	}
+	// numEpochs defines a flag for the number of simulated epochs
+	numEpochs = cli.UintFlag{
+		Name:  "num-epochs",
+		Usage: "The number of epochs to simulate",
+		Value: 30,
+	}
+	// feesPerEpoch defines a flag for the fees accumulated in each epoch
+	feesPerEpoch = cli.StringFlag{
+		Name:  "fees-per-epoch",
+		Usage: "The transaction fees accumulated in each epoch, in denominated units",
+		Value: "0",
+	}
+	// devFeesPerEpoch defines a flag for the developer fees accumulated in each epoch
+	devFeesPerEpoch = cli.StringFlag{
+		Name:  "dev-fees-per-epoch",
+		Usage: "The part of the fees paid to the smart contract developers in each epoch, in denominated units",
+		Value: "0",
+	}
+	// restakeRewards defines a flag for adding the rewards to the owners top-up
+	restakeRewards = cli.BoolFlag{
+		Name:  "restake-rewards",
+		Usage: "If set, the rewards received by the nodes are added to the top-up of their owners at each epoch",
+	}
+	// report defines a flag for the generated report
+	report = cli.StringFlag{
+		Name: "report",
+		Usage: "The generated CSV report. Available options: " + simulation.EpochsReport + ", " +
+			simulation.ValidatorsReport + ", " + simulation.OwnersReport,
+		Value: simulation.EpochsReport,
+	}
+	// outputFile defines a flag for the path to the generated report
+	outputFile = cli.StringFlag{
+		Name:  "output-file",
+		Usage: "The `filepath` the report is written to. If empty, the report is written to the standard output",
+		Value: "",
+	}
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,api:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the api package which will receive a DEBUG" +
+			" log level.",
+		Value: "*:" + logger.LogError.String(),
+	}
+)
+
+var log = logger.GetOrCreate("economicssimulator")
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = economicsSimulatorHelpTemplate
+	app.Name = "Economics simulator"
+	app.Version = "v1.0.0"
+	app.Usage = "This binary projects the inflation, the validators rewards, the top-up effects and the ratings over a " +
+		"number of epochs, for the provided economics and ratings parameters, using the same components as the " +
+		"metachain nodes. The results are written in CSV format"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+	app.Flags = []cli.Flag{
+		economicsConfigFile,
+		ratingsConfigFile,
+		systemSCConfigFile,
+		epochConfigFile,
+		validatorsFile,
+		numShards,
+		nodesPerShard,
+		metaNodes,
+		numOwners,
+		topUpPerNode,
+		downtime,
+		seed,
+		consensusGroupSize,
+		metaConsensusGroupSize,
+		minNodesPerShard,
+		metaMinNodes,
+		roundDuration,
+		roundsPerEpoch,
+		numEpochs,
+		feesPerEpoch,
+		devFeesPerEpoch,
+		restakeRewards,
+		report,
+		outputFile,
+		logLevel,
+	}
+
+	app.Action = func(c *cli.Context) error {
+		return simulate(c)
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func simulate(ctx *cli.Context) error {
+	err := logger.SetLogLevel(ctx.GlobalString(logLevel.Name))
+	if err != nil {
+		return err
+	}
+
+	reportName := ctx.GlobalString(report.Name)
+	err = simulation.CheckReport(reportName)
+	if err != nil {
+		return err
+	}
+
+	args, err := createSimulatorArgs(ctx)
+	if err != nil {
+		return err
+	}
+
+	sim, err := simulation.NewSimulator(*args)
+	if err != nil {
+		return err
+	}
+
+	results, err := sim.Run(uint32(ctx.GlobalUint(numEpochs.Name)))
+	if err != nil {
+		return err
+	}
+
+	return writeReport(ctx.GlobalString(outputFile.Name), reportName, results)
+}
+
+func createSimulatorArgs(ctx *cli.Context) (*simulation.ArgsSimulator, error) {
+	economicsConfig, err := common.LoadEconomicsConfig(ctx.GlobalString(economicsConfigFile.Name))
+	if err != nil {
+		return nil, err
+	}
+	ratingsConfig, err := common.LoadRatingsConfig(ctx.GlobalString(ratingsConfigFile.Name))
+	if err != nil {
+		return nil, err
+	}
+	systemSCConfig, err := common.LoadSystemSmartContractsConfig(ctx.GlobalString(systemSCConfigFile.Name))
+	if err != nil {
+		return nil, err
+	}
+	epochConfig, err := common.LoadEpochConfig(ctx.GlobalString(epochConfigFile.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	addressConverter, err := pubkeyConverter.NewBech32PubkeyConverter(addressLength, log)
+	if err != nil {
+		return nil, err
+	}
+
+	fees, err := parseBigInt(feesPerEpoch, ctx)
+	if err != nil {
+		return nil, err
+	}
+	devFees, err := parseBigInt(devFeesPerEpoch, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	validatorSet, err := createValidatorSet(ctx, addressConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &simulation.ArgsSimulator{
+		EconomicsConfig:  economicsConfig,
+		RatingsConfig:    ratingsConfig,
+		SystemSCConfig:   systemSCConfig,
+		EpochConfig:      epochConfig,
+		AddressConverter: addressConverter,
+		Network: simulation.NetworkConfig{
+			NumShards:              uint32(ctx.GlobalUint(numShards.Name)),
+			ConsensusGroupSize:     uint32(ctx.GlobalUint(consensusGroupSize.Name)),
+			MetaConsensusGroupSize: uint32(ctx.GlobalUint(metaConsensusGroupSize.Name)),
+			MinNodesPerShard:       uint32(ctx.GlobalUint(minNodesPerShard.Name)),
+			MetaMinNodes:           uint32(ctx.GlobalUint(metaMinNodes.Name)),
+			RoundDurationMs:        ctx.GlobalUint64(roundDuration.Name),
+			RoundsPerEpoch:         ctx.GlobalUint64(roundsPerEpoch.Name),
+		},
+		ValidatorSet:    validatorSet,
+		FeesPerEpoch:    fees,
+		DevFeesPerEpoch: devFees,
+		RestakeRewards:  ctx.GlobalBool(restakeRewards.Name),
+	}, nil
+}
+
+func createValidatorSet(ctx *cli.Context, addressConverter core.PubkeyConverter) ([]*simulation.OwnerData, error) {
+	validatorsFilePath := ctx.GlobalString(validatorsFile.Name)
+	if len(validatorsFilePath) > 0 {
+		log.Info("loading validator set", "file", validatorsFilePath)
+		return simulation.LoadValidatorSet(validatorsFilePath)
+	}
+
+	topUp, err := parseBigInt(topUpPerNode, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return simulation.GenerateValidatorSet(simulation.ArgsSyntheticValidatorSet{
+		AddressConverter: addressConverter,
+		NumShards:        uint32(ctx.GlobalUint(numShards.Name)),
+		NodesPerShard:    uint32(ctx.GlobalUint(nodesPerShard.Name)),
+		MetaNodes:        uint32(ctx.GlobalUint(metaNodes.Name)),
+		NumOwners:        uint32(ctx.GlobalUint(numOwners.Name)),
+		TopUpPerNode:     topUp,
+		Downtime:         ctx.GlobalFloat64(downtime.Name),
+		Seed:             ctx.GlobalString(seed.Name),
+	})
+}
+
+func parseBigInt(flag cli.StringFlag, ctx *cli.Context) (*big.Int, error) {
+	value, ok := big.NewInt(0).SetString(ctx.GlobalString(flag.Name), 10)
+	if !ok || value.Sign() < 0 {
+		return nil, fmt.Errorf("invalid value for flag %s: %s", flag.Name, ctx.GlobalString(flag.Name))
+	}
+
+	return value, nil
+}
+
+func writeReport(outputFilePath string, reportName string, results []*simulation.EpochResult) error {
+	if len(outputFilePath) == 0 {
+		return simulation.WriteReport(os.Stdout, reportName, results)
+	}
+
+	f, err := os.OpenFile(outputFilePath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, core.FileModeReadWrite)
+	if err != nil {
+		return err
+	}
+
+	return writeAndClose(f, reportName, results)
+}
+
+func writeAndClose(f io.WriteCloser, reportName string, results []*simulation.EpochResult) error {
+	err := simulation.WriteReport(f, reportName, results)
+	if err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	return f.Close()
+}
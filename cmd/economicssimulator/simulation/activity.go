@@ -0,0 +1,88 @@
+package simulation
+
+import (
+	"math"
+)
+
+type nodeActivity struct {
+	leaderSuccess              uint32
+	leaderFailure              uint32
+	validatorSuccess           uint32
+	validatorFailure           uint32
+	missedRoundsAppearances    uint32
+	numSelectedInSuccessBlocks uint32
+}
+
+type epochActivity struct {
+	nodes          map[*simulatedNode]*nodeActivity
+	blocksPerShard map[uint32]uint64
+}
+
+// computeEpochActivity estimates how many times each node was selected as leader or as consensus member during an epoch,
+// considering the nodes are selected uniformly. An offline leader misses its round, so no block is produced and the
+// whole consensus group of that round is penalized
+func computeEpochActivity(
+	nodesPerShard map[uint32][]*simulatedNode,
+	consensusProvider *nodesConfigProvider,
+	roundsPerEpoch uint64,
+) *epochActivity {
+	activity := &epochActivity{
+		nodes:          make(map[*simulatedNode]*nodeActivity),
+		blocksPerShard: make(map[uint32]uint64),
+	}
+
+	for shardID, nodes := range nodesPerShard {
+		computeShardActivity(activity, shardID, nodes, consensusProvider.ConsensusGroupSize(shardID), roundsPerEpoch)
+	}
+
+	return activity
+}
+
+func computeShardActivity(
+	activity *epochActivity,
+	shardID uint32,
+	nodes []*simulatedNode,
+	consensusGroupSize int,
+	roundsPerEpoch uint64,
+) {
+	numNodes := float64(len(nodes))
+	if len(nodes) < consensusGroupSize {
+		consensusGroupSize = len(nodes)
+	}
+	validatorsInConsensus := float64(consensusGroupSize - 1)
+
+	leaderRounds := float64(roundsPerEpoch) / numNodes
+	missedRounds := uint64(0)
+	for _, node := range nodes {
+		numLeaderRounds := roundToUint32(leaderRounds)
+		leaderSuccess := roundToUint32(leaderRounds * (1 - node.downtime))
+
+		activity.nodes[node] = &nodeActivity{
+			leaderSuccess: leaderSuccess,
+			leaderFailure: numLeaderRounds - leaderSuccess,
+		}
+		missedRounds += uint64(numLeaderRounds - leaderSuccess)
+	}
+
+	if missedRounds > roundsPerEpoch {
+		missedRounds = roundsPerEpoch
+	}
+	numBlocks := roundsPerEpoch - missedRounds
+	activity.blocksPerShard[shardID] = numBlocks
+
+	validatorRounds := float64(numBlocks) * validatorsInConsensus / numNodes
+	missedValidatorRounds := float64(missedRounds) * validatorsInConsensus / numNodes
+	for _, node := range nodes {
+		nodeStats := activity.nodes[node]
+
+		numValidatorRounds := roundToUint32(validatorRounds)
+		nodeStats.validatorSuccess = roundToUint32(validatorRounds * (1 - node.downtime))
+		nodeStats.validatorFailure = numValidatorRounds - nodeStats.validatorSuccess
+		nodeStats.missedRoundsAppearances = roundToUint32(missedValidatorRounds)
+		nodeStats.numSelectedInSuccessBlocks = nodeStats.leaderSuccess + numValidatorRounds
+	}
+}
+
+func roundToUint32(value float64) uint32 {
+	return uint32(math.Round(value))
+}
@@ -0,0 +1,77 @@
+package simulation
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func createSimulatedNodes(numNodes int, shardID uint32) []*simulatedNode {
+	nodes := make([]*simulatedNode, 0, numNodes)
+	for i := 0; i < numNodes; i++ {
+		nodes = append(nodes, &simulatedNode{
+			blsKey:  []byte{byte(i)},
+			shardID: shardID,
+		})
+	}
+
+	return nodes
+}
+
+func TestComputeEpochActivity(t *testing.T) {
+	t.Parallel()
+
+	t.Run("online nodes should produce all blocks", func(t *testing.T) {
+		t.Parallel()
+
+		nodesPerShard := map[uint32][]*simulatedNode{
+			0:                     createSimulatedNodes(10, 0),
+			core.MetachainShardId: createSimulatedNodes(5, core.MetachainShardId),
+		}
+		consensusProvider := &nodesConfigProvider{shardConsensusGroupSize: 4, metaConsensusGroupSize: 5}
+		activity := computeEpochActivity(nodesPerShard, consensusProvider, 100)
+
+		assert.Equal(t, uint64(100), activity.blocksPerShard[0])
+		assert.Equal(t, uint64(100), activity.blocksPerShard[core.MetachainShardId])
+
+		shardNode := activity.nodes[nodesPerShard[0][0]]
+		assert.Equal(t, &nodeActivity{
+			leaderSuccess:              10,
+			validatorSuccess:           30,
+			numSelectedInSuccessBlocks: 40,
+		}, shardNode)
+
+		metaNode := activity.nodes[nodesPerShard[core.MetachainShardId][0]]
+		assert.Equal(t, &nodeActivity{
+			leaderSuccess:              20,
+			validatorSuccess:           80,
+			numSelectedInSuccessBlocks: 100,
+		}, metaNode)
+	})
+	t.Run("offline leaders should miss rounds", func(t *testing.T) {
+		t.Parallel()
+
+		nodes := createSimulatedNodes(10, 0)
+		nodes[0].downtime = 0.5
+		nodesPerShard := map[uint32][]*simulatedNode{0: nodes}
+		consensusProvider := &nodesConfigProvider{shardConsensusGroupSize: 4}
+		activity := computeEpochActivity(nodesPerShard, consensusProvider, 100)
+
+		assert.Equal(t, uint64(95), activity.blocksPerShard[0])
+
+		offlineNode := activity.nodes[nodes[0]]
+		assert.Equal(t, uint32(5), offlineNode.leaderSuccess)
+		assert.Equal(t, uint32(5), offlineNode.leaderFailure)
+		assert.Equal(t, uint32(14), offlineNode.validatorSuccess)
+		assert.Equal(t, uint32(15), offlineNode.validatorFailure)
+		assert.Equal(t, uint32(2), offlineNode.missedRoundsAppearances)
+
+		onlineNode := activity.nodes[nodes[1]]
+		assert.Equal(t, uint32(10), onlineNode.leaderSuccess)
+		assert.Equal(t, uint32(0), onlineNode.leaderFailure)
+		assert.Equal(t, uint32(29), onlineNode.validatorSuccess)
+		assert.Equal(t, uint32(0), onlineNode.validatorFailure)
+		assert.Equal(t, uint32(2), onlineNode.missedRoundsAppearances)
+	})
+}
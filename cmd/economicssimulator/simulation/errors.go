@@ -0,0 +1,69 @@
+package simulation
+
+import "errors"
+
+// ErrNilEconomicsConfig signals that a nil economics config has been provided
+var ErrNilEconomicsConfig = errors.New("nil economics config")
+
+// ErrNilRatingsConfig signals that a nil ratings config has been provided
+var ErrNilRatingsConfig = errors.New("nil ratings config")
+
+// ErrNilSystemSCConfig signals that a nil system smart contracts config has been provided
+var ErrNilSystemSCConfig = errors.New("nil system smart contracts config")
+
+// ErrNilEpochConfig signals that a nil epoch config has been provided
+var ErrNilEpochConfig = errors.New("nil epoch config")
+
+// ErrNilPubkeyConverter signals that a nil public key converter has been provided
+var ErrNilPubkeyConverter = errors.New("nil public key converter")
+
+// ErrNilValidatorSet signals that a nil validator set has been provided
+var ErrNilValidatorSet = errors.New("nil validator set")
+
+// ErrInvalidNumberOfShards signals that an invalid number of shards has been provided
+var ErrInvalidNumberOfShards = errors.New("invalid number of shards")
+
+// ErrInvalidConsensusGroupSize signals that an invalid consensus group size has been provided
+var ErrInvalidConsensusGroupSize = errors.New("invalid consensus group size")
+
+// ErrInvalidRoundDuration signals that an invalid round duration has been provided
+var ErrInvalidRoundDuration = errors.New("invalid round duration")
+
+// ErrInvalidRoundsPerEpoch signals that an invalid number of rounds per epoch has been provided
+var ErrInvalidRoundsPerEpoch = errors.New("invalid number of rounds per epoch")
+
+// ErrInvalidFees signals that invalid fees values have been provided
+var ErrInvalidFees = errors.New("invalid fees")
+
+// ErrInvalidNodePrice signals that an invalid node price has been provided
+var ErrInvalidNodePrice = errors.New("invalid node price")
+
+// ErrInvalidTopUp signals that an invalid top-up value has been provided
+var ErrInvalidTopUp = errors.New("invalid top-up value")
+
+// ErrInvalidDowntime signals that a downtime outside the [0, 1] interval has been provided
+var ErrInvalidDowntime = errors.New("invalid downtime")
+
+// ErrInvalidShardID signals that a node is assigned to a shard that does not exist
+var ErrInvalidShardID = errors.New("invalid shard ID")
+
+// ErrDuplicatedBlsKey signals that the same BLS key has been provided more than once
+var ErrDuplicatedBlsKey = errors.New("duplicated BLS key")
+
+// ErrEmptyBlsKey signals that an empty BLS key has been provided
+var ErrEmptyBlsKey = errors.New("empty BLS key")
+
+// ErrNoNodesInShard signals that a shard does not have any node assigned
+var ErrNoNodesInShard = errors.New("no nodes in shard")
+
+// ErrOwnerWithoutNodes signals that an owner does not have any node
+var ErrOwnerWithoutNodes = errors.New("owner without nodes")
+
+// ErrInvalidNumberOfOwners signals that an invalid number of owners has been provided
+var ErrInvalidNumberOfOwners = errors.New("invalid number of owners")
+
+// ErrUnknownStakingFunction signals that the staking view was queried for an unsupported function
+var ErrUnknownStakingFunction = errors.New("unknown staking function")
+
+// ErrUnknownReport signals that an unknown report type has been requested
+var ErrUnknownReport = errors.New("unknown report")
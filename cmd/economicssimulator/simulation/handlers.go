@@ -0,0 +1,62 @@
+package simulation
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+)
+
+// roundTimeHandler provides the configured round duration
+type roundTimeHandler struct {
+	duration time.Duration
+}
+
+// TimeDuration returns the round duration
+func (rth *roundTimeHandler) TimeDuration() time.Duration {
+	return rth.duration
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rth *roundTimeHandler) IsInterfaceNil() bool {
+	return rth == nil
+}
+
+// nodesConfigProvider provides the configured consensus group sizes
+type nodesConfigProvider struct {
+	shardConsensusGroupSize int
+	metaConsensusGroupSize  int
+}
+
+// ConsensusGroupSize returns the consensus group size of the provided shard
+func (ncp *nodesConfigProvider) ConsensusGroupSize(shardID uint32) int {
+	if shardID == core.MetachainShardId {
+		return ncp.metaConsensusGroupSize
+	}
+
+	return ncp.shardConsensusGroupSize
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ncp *nodesConfigProvider) IsInterfaceNil() bool {
+	return ncp == nil
+}
+
+// disabledBuiltInFunctionsCost is used as the simulation does not compute transaction fees
+type disabledBuiltInFunctionsCost struct {
+}
+
+// ComputeBuiltInCost returns 0
+func (d *disabledBuiltInFunctionsCost) ComputeBuiltInCost(_ data.TransactionWithFeeHandler) uint64 {
+	return 0
+}
+
+// IsBuiltInFuncCall returns false
+func (d *disabledBuiltInFunctionsCost) IsBuiltInFuncCall(_ data.TransactionWithFeeHandler) bool {
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (d *disabledBuiltInFunctionsCost) IsInterfaceNil() bool {
+	return d == nil
+}
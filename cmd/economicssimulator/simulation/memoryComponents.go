@@ -0,0 +1,114 @@
+package simulation
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/dataPool"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/dataPool/headersCache"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/shardedData"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/state/factory"
+	"github.com/ElrondNetwork/elrond-go/state/storagePruningManager/disabled"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/ElrondNetwork/elrond-go/trie"
+)
+
+const maxTrieLevelInMemory = uint(5)
+const poolsCapacity = 100
+const poolsSizeInBytes = 1000000
+
+// createAccountsDB creates an empty in memory accounts adapter. The simulated validators do not have accounts, so
+// the rewards are never sent to delegation system smart contracts
+func createAccountsDB(marshalizer marshal.Marshalizer, hasher hashing.Hasher) (state.AccountsAdapter, error) {
+	storer, err := createMemoryStorer()
+	if err != nil {
+		return nil, err
+	}
+
+	trieStorage, err := trie.NewTrieStorageManagerWithoutPruning(storer)
+	if err != nil {
+		return nil, err
+	}
+
+	tr, err := trie.NewTrie(trieStorage, marshalizer, hasher, maxTrieLevelInMemory)
+	if err != nil {
+		return nil, err
+	}
+
+	return state.NewAccountsDB(
+		tr,
+		hasher,
+		marshalizer,
+		factory.NewAccountCreator(),
+		disabled.NewDisabledStoragePruningManager(),
+	)
+}
+
+// createDataPool creates small in memory pools, as the simulation neither broadcasts nor cleans up block data
+func createDataPool() (dataRetriever.PoolsHolder, error) {
+	txPoolConfig := storageUnit.CacheConfig{
+		Capacity:    poolsCapacity,
+		SizeInBytes: poolsSizeInBytes,
+		Shards:      1,
+	}
+
+	transactions, err := shardedData.NewShardedData("txPool", txPoolConfig)
+	if err != nil {
+		return nil, err
+	}
+	unsignedTransactions, err := shardedData.NewShardedData("unsignedTxPool", txPoolConfig)
+	if err != nil {
+		return nil, err
+	}
+	rewardTransactions, err := shardedData.NewShardedData("rewardsTxPool", txPoolConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	headers, err := headersCache.NewHeadersPool(config.HeadersPoolConfig{
+		MaxHeadersPerShard:            poolsCapacity,
+		NumElementsToRemoveOnEviction: 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	currentBlockTransactions, err := dataPool.NewCurrentBlockPool()
+	if err != nil {
+		return nil, err
+	}
+
+	args := dataPool.DataPoolArgs{
+		Transactions:             transactions,
+		UnsignedTransactions:     unsignedTransactions,
+		RewardTransactions:       rewardTransactions,
+		Headers:                  headers,
+		CurrentBlockTransactions: currentBlockTransactions,
+	}
+
+	args.MiniBlocks, err = lrucache.NewCache(poolsCapacity)
+	if err != nil {
+		return nil, err
+	}
+	args.PeerChangesBlocks, err = lrucache.NewCache(poolsCapacity)
+	if err != nil {
+		return nil, err
+	}
+	args.TrieNodes, err = lrucache.NewCache(poolsCapacity)
+	if err != nil {
+		return nil, err
+	}
+	args.TrieNodesChunks, err = lrucache.NewCache(poolsCapacity)
+	if err != nil {
+		return nil, err
+	}
+	args.SmartContracts, err = lrucache.NewCache(poolsCapacity)
+	if err != nil {
+		return nil, err
+	}
+
+	return dataPool.NewDataPool(args)
+}
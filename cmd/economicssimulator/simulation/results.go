@@ -0,0 +1,251 @@
+package simulation
+
+import (
+	"encoding/csv"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"math/big"
+	"sort"
+	"strconv"
+)
+
+const (
+	// EpochsReport is the report holding one line for each simulated epoch
+	EpochsReport = "epochs"
+	// ValidatorsReport is the report holding one line for each node in each simulated epoch
+	ValidatorsReport = "validators"
+	// OwnersReport is the report holding one line for each owner in each simulated epoch
+	OwnersReport = "owners"
+)
+
+// EpochResult holds the economics data computed at the start of an epoch, for the activity of the previous epoch
+type EpochResult struct {
+	Epoch                            uint32
+	NumBlocks                        uint64
+	TotalSupply                      *big.Int
+	TotalNewlyMinted                 *big.Int
+	AnnualInflation                  float64
+	TotalToDistribute                *big.Int
+	RewardsPerBlock                  *big.Int
+	RewardsForProtocolSustainability *big.Int
+	LeaderFees                       *big.Int
+	TotalStakeEligible               *big.Int
+	TotalTopUpEligible               *big.Int
+	TotalValidatorRewards            *big.Int
+	Validators                       []*ValidatorResult
+}
+
+// ValidatorResult holds the rewards and the rating of a node, at the start of an epoch
+type ValidatorResult struct {
+	BlsKey           []byte
+	Owner            string
+	ShardID          uint32
+	LeaderSuccess    uint32
+	LeaderFailure    uint32
+	ValidatorSuccess uint32
+	ValidatorFailure uint32
+	Stake            *big.Int
+	TopUpPerNode     *big.Int
+	Reward           *big.Int
+	APR              float64
+	Rating           uint32
+	Chance           uint32
+}
+
+type ownerResult struct {
+	owner    string
+	numNodes int
+	stake    *big.Int
+	topUp    *big.Int
+	reward   *big.Int
+	aprStake *big.Float
+}
+
+// CheckReport returns an error if the provided report is not known
+func CheckReport(report string) error {
+	switch report {
+	case EpochsReport, ValidatorsReport, OwnersReport:
+		return nil
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownReport, report)
+	}
+}
+
+// WriteReport writes the requested report in CSV format
+func WriteReport(w io.Writer, report string, results []*EpochResult) error {
+	err := CheckReport(report)
+	if err != nil {
+		return err
+	}
+
+	var lines [][]string
+	switch report {
+	case EpochsReport:
+		lines = createEpochsLines(results)
+	case ValidatorsReport:
+		lines = createValidatorsLines(results)
+	case OwnersReport:
+		lines = createOwnersLines(results)
+	}
+
+	csvWriter := csv.NewWriter(w)
+	err = csvWriter.WriteAll(lines)
+	if err != nil {
+		return err
+	}
+
+	return csvWriter.Error()
+}
+
+func createEpochsLines(results []*EpochResult) [][]string {
+	lines := [][]string{{
+		"epoch",
+		"numBlocks",
+		"totalSupply",
+		"newlyMinted",
+		"annualInflation",
+		"totalToDistribute",
+		"rewardsPerBlock",
+		"protocolSustainability",
+		"leaderFees",
+		"totalStakeEligible",
+		"totalTopUpEligible",
+		"validatorRewards",
+	}}
+
+	for _, result := range results {
+		lines = append(lines, []string{
+			strconv.FormatUint(uint64(result.Epoch), 10),
+			strconv.FormatUint(result.NumBlocks, 10),
+			result.TotalSupply.String(),
+			result.TotalNewlyMinted.String(),
+			formatFloat(result.AnnualInflation),
+			result.TotalToDistribute.String(),
+			result.RewardsPerBlock.String(),
+			result.RewardsForProtocolSustainability.String(),
+			result.LeaderFees.String(),
+			result.TotalStakeEligible.String(),
+			result.TotalTopUpEligible.String(),
+			result.TotalValidatorRewards.String(),
+		})
+	}
+
+	return lines
+}
+
+func createValidatorsLines(results []*EpochResult) [][]string {
+	lines := [][]string{{
+		"epoch",
+		"blsKey",
+		"owner",
+		"shardID",
+		"leaderSuccess",
+		"leaderFailure",
+		"validatorSuccess",
+		"validatorFailure",
+		"stake",
+		"topUpPerNode",
+		"reward",
+		"apr",
+		"rating",
+		"chance",
+	}}
+
+	for _, result := range results {
+		for _, validator := range result.Validators {
+			lines = append(lines, []string{
+				strconv.FormatUint(uint64(result.Epoch), 10),
+				hex.EncodeToString(validator.BlsKey),
+				validator.Owner,
+				strconv.FormatUint(uint64(validator.ShardID), 10),
+				strconv.FormatUint(uint64(validator.LeaderSuccess), 10),
+				strconv.FormatUint(uint64(validator.LeaderFailure), 10),
+				strconv.FormatUint(uint64(validator.ValidatorSuccess), 10),
+				strconv.FormatUint(uint64(validator.ValidatorFailure), 10),
+				validator.Stake.String(),
+				validator.TopUpPerNode.String(),
+				validator.Reward.String(),
+				formatFloat(validator.APR),
+				strconv.FormatUint(uint64(validator.Rating), 10),
+				strconv.FormatUint(uint64(validator.Chance), 10),
+			})
+		}
+	}
+
+	return lines
+}
+
+// createOwnersLines aggregates the nodes results for each owner. The owner APR is the stake weighted average of its nodes APR
+func createOwnersLines(results []*EpochResult) [][]string {
+	lines := [][]string{{
+		"epoch",
+		"owner",
+		"numNodes",
+		"stake",
+		"topUp",
+		"reward",
+		"apr",
+	}}
+
+	for _, result := range results {
+		owners := make(map[string]*ownerResult)
+		for _, validator := range result.Validators {
+			owner, ok := owners[validator.Owner]
+			if !ok {
+				owner = &ownerResult{
+					owner:    validator.Owner,
+					stake:    big.NewInt(0),
+					topUp:    big.NewInt(0),
+					reward:   big.NewInt(0),
+					aprStake: big.NewFloat(0),
+				}
+				owners[validator.Owner] = owner
+			}
+
+			owner.numNodes++
+			owner.stake.Add(owner.stake, validator.Stake)
+			owner.topUp.Add(owner.topUp, validator.TopUpPerNode)
+			owner.reward.Add(owner.reward, validator.Reward)
+
+			stake := big.NewFloat(0).SetInt(validator.Stake)
+			owner.aprStake.Add(owner.aprStake, stake.Mul(stake, big.NewFloat(validator.APR)))
+		}
+
+		sortedOwners := make([]*ownerResult, 0, len(owners))
+		for _, owner := range owners {
+			sortedOwners = append(sortedOwners, owner)
+		}
+		sort.Slice(sortedOwners, func(i, j int) bool {
+			return sortedOwners[i].owner < sortedOwners[j].owner
+		})
+
+		for _, owner := range sortedOwners {
+			lines = append(lines, []string{
+				strconv.FormatUint(uint64(result.Epoch), 10),
+				owner.owner,
+				strconv.Itoa(owner.numNodes),
+				owner.stake.String(),
+				owner.topUp.String(),
+				owner.reward.String(),
+				formatFloat(owner.apr()),
+			})
+		}
+	}
+
+	return lines
+}
+
+func (or *ownerResult) apr() float64 {
+	if or.stake.Sign() == 0 {
+		return 0
+	}
+
+	apr, _ := big.NewFloat(0).Quo(or.aprStake, big.NewFloat(0).SetInt(or.stake)).Float64()
+
+	return apr
+}
+
+func formatFloat(value float64) string {
+	return strconv.FormatFloat(value, 'f', 6, 64)
+}
@@ -0,0 +1,105 @@
+package simulation
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockEpochResults() []*EpochResult {
+	return []*EpochResult{
+		{
+			Epoch:                            1,
+			NumBlocks:                        100,
+			TotalSupply:                      big.NewInt(1100),
+			TotalNewlyMinted:                 big.NewInt(100),
+			AnnualInflation:                  0.1,
+			TotalToDistribute:                big.NewInt(100),
+			RewardsPerBlock:                  big.NewInt(1),
+			RewardsForProtocolSustainability: big.NewInt(10),
+			LeaderFees:                       big.NewInt(0),
+			TotalStakeEligible:               big.NewInt(400),
+			TotalTopUpEligible:               big.NewInt(100),
+			TotalValidatorRewards:            big.NewInt(90),
+			Validators: []*ValidatorResult{
+				{
+					BlsKey:       []byte("key2"),
+					Owner:        "owner2",
+					Stake:        big.NewInt(100),
+					TopUpPerNode: big.NewInt(0),
+					Reward:       big.NewInt(20),
+					APR:          0.2,
+				},
+				{
+					BlsKey:       []byte("key0"),
+					Owner:        "owner1",
+					Stake:        big.NewInt(100),
+					TopUpPerNode: big.NewInt(0),
+					Reward:       big.NewInt(30),
+					APR:          0.3,
+				},
+				{
+					BlsKey:       []byte("key1"),
+					Owner:        "owner1",
+					Stake:        big.NewInt(200),
+					TopUpPerNode: big.NewInt(100),
+					Reward:       big.NewInt(40),
+					APR:          0.6,
+				},
+			},
+		},
+	}
+}
+
+func TestWriteReport(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unknown report should error", func(t *testing.T) {
+		t.Parallel()
+
+		buff := &bytes.Buffer{}
+		err := WriteReport(buff, "unknown", createMockEpochResults())
+
+		assert.True(t, errors.Is(err, ErrUnknownReport))
+		assert.Equal(t, 0, buff.Len())
+	})
+	t.Run("epochs report", func(t *testing.T) {
+		t.Parallel()
+
+		buff := &bytes.Buffer{}
+		err := WriteReport(buff, EpochsReport, createMockEpochResults())
+		require.Nil(t, err)
+
+		lines := strings.Split(strings.TrimSpace(buff.String()), "\n")
+		require.Equal(t, 2, len(lines))
+		assert.Equal(t, "1,100,1100,100,0.100000,100,1,10,0,400,100,90", lines[1])
+	})
+	t.Run("validators report", func(t *testing.T) {
+		t.Parallel()
+
+		buff := &bytes.Buffer{}
+		err := WriteReport(buff, ValidatorsReport, createMockEpochResults())
+		require.Nil(t, err)
+
+		lines := strings.Split(strings.TrimSpace(buff.String()), "\n")
+		require.Equal(t, 4, len(lines))
+		assert.Equal(t, "1,6b657932,owner2,0,0,0,0,0,100,0,20,0.200000,0,0", lines[1])
+	})
+	t.Run("owners report should aggregate the nodes of each owner", func(t *testing.T) {
+		t.Parallel()
+
+		buff := &bytes.Buffer{}
+		err := WriteReport(buff, OwnersReport, createMockEpochResults())
+		require.Nil(t, err)
+
+		lines := strings.Split(strings.TrimSpace(buff.String()), "\n")
+		require.Equal(t, 3, len(lines))
+		assert.Equal(t, "1,owner1,2,300,100,70,0.500000", lines[1])
+		assert.Equal(t, "1,owner2,1,100,0,20,0.200000", lines[2])
+	})
+}
@@ -0,0 +1,669 @@
+package simulation
+
+import (
+	"bytes"
+	"math"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/common/forking"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/epochStart/metachain"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/economics"
+	"github.com/ElrondNetwork/elrond-go/process/rating"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+)
+
+var log = logger.GetOrCreate("economicssimulator/simulation")
+
+const storerCacheSize = 100
+const millisecondsInYear = 365 * 24 * 3600 * 1000
+
+// NetworkConfig holds the network parameters of the simulation
+type NetworkConfig struct {
+	NumShards              uint32
+	ConsensusGroupSize     uint32
+	MetaConsensusGroupSize uint32
+	MinNodesPerShard       uint32
+	MetaMinNodes           uint32
+	RoundDurationMs        uint64
+	RoundsPerEpoch         uint64
+}
+
+// ArgsSimulator holds the arguments needed to create a simulator
+type ArgsSimulator struct {
+	EconomicsConfig  *config.EconomicsConfig
+	RatingsConfig    *config.RatingsConfig
+	SystemSCConfig   *config.SystemSmartContractsConfig
+	EpochConfig      *config.EpochConfig
+	Network          NetworkConfig
+	AddressConverter core.PubkeyConverter
+	ValidatorSet     []*OwnerData
+	FeesPerEpoch     *big.Int
+	DevFeesPerEpoch  *big.Int
+	RestakeRewards   bool
+}
+
+// simulator projects the end of epoch economics and the validators rewards and ratings over a number of epochs. It
+// drives the same end of epoch economics, rewards and ratings components as the metachain nodes, fed with the block
+// production estimated for the simulated validator set
+type simulator struct {
+	network             NetworkConfig
+	addressConverter    core.PubkeyConverter
+	marshalizer         marshal.Marshalizer
+	hasher              hashing.Hasher
+	store               dataRetriever.StorageService
+	epochNotifier       process.EpochNotifier
+	economicsData       process.EconomicsDataHandler
+	epochEconomics      process.EndOfEpochEconomics
+	economicsStatistics epochStart.EpochEconomicsDataProvider
+	stakingDataProvider epochStart.StakingDataProvider
+	rewardsCreator      epochStart.RewardsCreator
+	rater               sharding.PeerAccountListAndRatingHandler
+	consensusProvider   *nodesConfigProvider
+	owners              []*simulatedOwner
+	nodesPerShard       map[uint32][]*simulatedNode
+	nodesByRewardAddr   map[string]*simulatedNode
+	nodePrice           *big.Int
+	feesPerEpoch        *big.Int
+	devFeesPerEpoch     *big.Int
+	restakeRewards      bool
+	metaNonce           uint64
+	shardNonces         map[uint32]uint64
+}
+
+// NewSimulator creates a new simulator instance
+func NewSimulator(args ArgsSimulator) (*simulator, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	nodePrice, ok := big.NewInt(0).SetString(args.SystemSCConfig.StakingSystemSCConfig.GenesisNodePrice, 10)
+	if !ok || nodePrice.Sign() <= 0 {
+		return nil, ErrInvalidNodePrice
+	}
+
+	s := &simulator{
+		network:          args.Network,
+		addressConverter: args.AddressConverter,
+		marshalizer:      &marshal.GogoProtoMarshalizer{},
+		hasher:           blake2b.NewBlake2b(),
+		consensusProvider: &nodesConfigProvider{
+			shardConsensusGroupSize: int(args.Network.ConsensusGroupSize),
+			metaConsensusGroupSize:  int(args.Network.MetaConsensusGroupSize),
+		},
+		nodePrice:       nodePrice,
+		feesPerEpoch:    big.NewInt(0).Set(args.FeesPerEpoch),
+		devFeesPerEpoch: big.NewInt(0).Set(args.DevFeesPerEpoch),
+		restakeRewards:  args.RestakeRewards,
+		shardNonces:     make(map[uint32]uint64),
+	}
+
+	err = s.createRater(args)
+	if err != nil {
+		return nil, err
+	}
+
+	s.owners, err = createSimulatedOwners(args.ValidatorSet, args.AddressConverter, s.hasher, args.Network.NumShards, s.rater.GetStartRating())
+	if err != nil {
+		return nil, err
+	}
+	s.indexNodes()
+
+	err = s.createEndOfEpochComponents(args)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.saveGenesisBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func checkArgs(args ArgsSimulator) error {
+	if args.EconomicsConfig == nil {
+		return ErrNilEconomicsConfig
+	}
+	if args.RatingsConfig == nil {
+		return ErrNilRatingsConfig
+	}
+	if args.SystemSCConfig == nil {
+		return ErrNilSystemSCConfig
+	}
+	if args.EpochConfig == nil {
+		return ErrNilEpochConfig
+	}
+	if check.IfNil(args.AddressConverter) {
+		return ErrNilPubkeyConverter
+	}
+	if len(args.ValidatorSet) == 0 {
+		return ErrNilValidatorSet
+	}
+	if args.Network.NumShards == 0 {
+		return ErrInvalidNumberOfShards
+	}
+	if args.Network.ConsensusGroupSize == 0 || args.Network.MetaConsensusGroupSize == 0 {
+		return ErrInvalidConsensusGroupSize
+	}
+	if args.Network.RoundDurationMs < uint64(time.Second/time.Millisecond) {
+		return ErrInvalidRoundDuration
+	}
+	if args.Network.RoundsPerEpoch == 0 {
+		return ErrInvalidRoundsPerEpoch
+	}
+	if args.FeesPerEpoch == nil || args.DevFeesPerEpoch == nil {
+		return ErrInvalidFees
+	}
+	if args.DevFeesPerEpoch.Sign() < 0 || args.FeesPerEpoch.Cmp(args.DevFeesPerEpoch) < 0 {
+		return ErrInvalidFees
+	}
+
+	return nil
+}
+
+func (s *simulator) createRater(args ArgsSimulator) error {
+	ratingsData, err := rating.NewRatingsData(rating.RatingsDataArg{
+		Config:                   *args.RatingsConfig,
+		ShardConsensusSize:       args.Network.ConsensusGroupSize,
+		MetaConsensusSize:        args.Network.MetaConsensusGroupSize,
+		ShardMinNodes:            args.Network.MinNodesPerShard,
+		MetaMinNodes:             args.Network.MetaMinNodes,
+		RoundDurationMiliseconds: args.Network.RoundDurationMs,
+	})
+	if err != nil {
+		return err
+	}
+
+	s.rater, err = rating.NewBlockSigningRater(ratingsData)
+
+	return err
+}
+
+func (s *simulator) indexNodes() {
+	s.nodesPerShard = make(map[uint32][]*simulatedNode)
+	s.nodesByRewardAddr = make(map[string]*simulatedNode)
+	for _, owner := range s.owners {
+		for _, node := range owner.nodes {
+			s.nodesPerShard[node.shardID] = append(s.nodesPerShard[node.shardID], node)
+			s.nodesByRewardAddr[string(node.rewardAddress)] = node
+		}
+	}
+
+	for _, nodes := range s.nodesPerShard {
+		sortNodes(nodes)
+	}
+}
+
+func (s *simulator) createEndOfEpochComponents(args ArgsSimulator) error {
+	s.epochNotifier = forking.NewGenericEpochNotifier()
+	economicsData, err := economics.NewEconomicsData(economics.ArgsNewEconomicsData{
+		Economics:                      args.EconomicsConfig,
+		EpochNotifier:                  s.epochNotifier,
+		BuiltInFunctionsCostHandler:    &disabledBuiltInFunctionsCost{},
+		PenalizedTooMuchGasEnableEpoch: args.EpochConfig.EnableEpochs.PenalizedTooMuchGasEnableEpoch,
+		GasPriceModifierEnableEpoch:    args.EpochConfig.EnableEpochs.GasPriceModifierEnableEpoch,
+	})
+	if err != nil {
+		return err
+	}
+	s.economicsData = economicsData
+
+	shardCoordinator, err := sharding.NewMultiShardCoordinator(args.Network.NumShards, core.MetachainShardId)
+	if err != nil {
+		return err
+	}
+
+	metaBlockStorer, err := createMemoryStorer()
+	if err != nil {
+		return err
+	}
+	chainStorer := dataRetriever.NewChainStorer()
+	chainStorer.AddStorer(dataRetriever.MetaBlockUnit, metaBlockStorer)
+	s.store = chainStorer
+
+	s.economicsStatistics = metachain.NewEpochEconomicsStatistics()
+	s.epochEconomics, err = metachain.NewEndOfEpochEconomicsDataCreator(metachain.ArgsNewEpochEconomics{
+		Marshalizer:           s.marshalizer,
+		Hasher:                s.hasher,
+		Store:                 s.store,
+		ShardCoordinator:      shardCoordinator,
+		RewardsHandler:        economicsData,
+		RoundTime:             &roundTimeHandler{duration: time.Duration(args.Network.RoundDurationMs) * time.Millisecond},
+		GenesisEpoch:          0,
+		GenesisNonce:          0,
+		GenesisTotalSupply:    economicsData.GenesisTotalSupply(),
+		EconomicsDataNotified: s.economicsStatistics,
+		StakingV2EnableEpoch:  args.EpochConfig.EnableEpochs.StakingV2EnableEpoch,
+	})
+	if err != nil {
+		return err
+	}
+
+	s.stakingDataProvider, err = metachain.NewStakingDataProvider(newStakingView(s.owners, s.nodePrice), s.nodePrice.String())
+	if err != nil {
+		return err
+	}
+
+	rewardsStorer, err := createMemoryStorer()
+	if err != nil {
+		return err
+	}
+	miniBlocksStorer, err := createMemoryStorer()
+	if err != nil {
+		return err
+	}
+	pools, err := createDataPool()
+	if err != nil {
+		return err
+	}
+	accounts, err := createAccountsDB(s.marshalizer, s.hasher)
+	if err != nil {
+		return err
+	}
+
+	s.rewardsCreator, err = metachain.NewRewardsCreatorProxy(metachain.RewardsCreatorProxyArgs{
+		BaseRewardsCreatorArgs: metachain.BaseRewardsCreatorArgs{
+			ShardCoordinator:              shardCoordinator,
+			PubkeyConverter:               s.addressConverter,
+			RewardsStorage:                rewardsStorer,
+			MiniBlockStorage:              miniBlocksStorer,
+			Hasher:                        s.hasher,
+			Marshalizer:                   s.marshalizer,
+			DataPool:                      pools,
+			ProtocolSustainabilityAddress: economicsData.ProtocolSustainabilityAddress(),
+			NodesConfigProvider:           s.consensusProvider,
+			DelegationSystemSCEnableEpoch: args.EpochConfig.EnableEpochs.StakingV2EnableEpoch,
+			UserAccountsDB:                accounts,
+			RewardsFix1EpochEnable:        args.EpochConfig.EnableEpochs.SwitchJailWaitingEnableEpoch,
+		},
+		StakingDataProvider:   s.stakingDataProvider,
+		EconomicsDataProvider: s.economicsStatistics,
+		RewardsHandler:        economicsData,
+		EpochEnableV2:         args.EpochConfig.EnableEpochs.StakingV2EnableEpoch,
+	})
+
+	return err
+}
+
+func createMemoryStorer() (storage.Storer, error) {
+	cache, err := lrucache.NewCache(storerCacheSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return storageUnit.NewStorageUnit(cache, memorydb.New())
+}
+
+func (s *simulator) saveGenesisBlock() error {
+	genesisBlock := &block.MetaBlock{
+		EpochStart: block.EpochStart{
+			Economics: block.Economics{
+				TotalSupply:                      s.economicsData.GenesisTotalSupply(),
+				TotalToDistribute:                big.NewInt(0),
+				TotalNewlyMinted:                 big.NewInt(0),
+				RewardsPerBlock:                  big.NewInt(0),
+				RewardsForProtocolSustainability: big.NewInt(0),
+				NodePrice:                        big.NewInt(0).Set(s.nodePrice),
+			},
+		},
+		AccumulatedFeesInEpoch: big.NewInt(0),
+		DevFeesInEpoch:         big.NewInt(0),
+	}
+
+	return s.saveEpochStartBlock(genesisBlock)
+}
+
+func (s *simulator) saveEpochStartBlock(metaBlock *block.MetaBlock) error {
+	buff, err := s.marshalizer.Marshal(metaBlock)
+	if err != nil {
+		return err
+	}
+
+	epochStartIdentifier := core.EpochStartIdentifier(metaBlock.Epoch)
+
+	return s.store.Put(dataRetriever.MetaBlockUnit, []byte(epochStartIdentifier), buff)
+}
+
+// Run simulates the provided number of epochs and returns the economics data computed at the start of each of them
+func (s *simulator) Run(numEpochs uint32) ([]*EpochResult, error) {
+	results := make([]*EpochResult, 0, numEpochs)
+	for epoch := uint32(1); epoch <= numEpochs; epoch++ {
+		result, err := s.simulateEpoch(epoch)
+		if err != nil {
+			return nil, err
+		}
+
+		log.Debug("simulated epoch",
+			"epoch", epoch,
+			"total supply", result.TotalSupply,
+			"total to distribute", result.TotalToDistribute,
+		)
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// simulateEpoch creates the start of epoch block for the provided epoch, after the activity of the previous epoch
+func (s *simulator) simulateEpoch(epoch uint32) (*EpochResult, error) {
+	activity := computeEpochActivity(s.nodesPerShard, s.consensusProvider, s.network.RoundsPerEpoch)
+	s.updateRatings(activity)
+
+	metaBlock := s.createEpochStartBlock(epoch, activity)
+	s.epochNotifier.CheckEpoch(metaBlock)
+
+	validatorsInfo := s.createValidatorsInfo(activity)
+	err := s.stakingDataProvider.PrepareStakingDataForRewards(eligibleNodesKeys(validatorsInfo))
+	if err != nil {
+		return nil, err
+	}
+
+	computedEconomics, err := s.epochEconomics.ComputeEndOfEpochEconomics(metaBlock)
+	if err != nil {
+		return nil, err
+	}
+	metaBlock.EpochStart.Economics = *computedEconomics
+
+	miniBlocks, err := s.rewardsCreator.CreateRewardsMiniBlocks(metaBlock, validatorsInfo, &metaBlock.EpochStart.Economics)
+	if err != nil {
+		return nil, err
+	}
+	metaBlock.EpochStart.Economics.RewardsForProtocolSustainability.Set(s.rewardsCreator.GetProtocolSustainabilityRewards())
+
+	err = s.saveEpochStartBlock(metaBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	rewards := s.collectRewards(miniBlocks)
+	result := s.createEpochResult(metaBlock, activity, rewards)
+
+	if s.restakeRewards {
+		for node, reward := range rewards {
+			node.owner.topUp.Add(node.owner.topUp, reward)
+		}
+	}
+
+	return result, nil
+}
+
+func (s *simulator) createEpochStartBlock(epoch uint32, activity *epochActivity) *block.MetaBlock {
+	round := uint64(epoch) * s.network.RoundsPerEpoch
+	s.metaNonce += activity.blocksPerShard[core.MetachainShardId]
+
+	lastFinalizedHeaders := make([]block.EpochStartShardData, 0, s.network.NumShards)
+	for shardID := uint32(0); shardID < s.network.NumShards; shardID++ {
+		s.shardNonces[shardID] += activity.blocksPerShard[shardID]
+		lastFinalizedHeaders = append(lastFinalizedHeaders, block.EpochStartShardData{
+			ShardID: shardID,
+			Epoch:   epoch - 1,
+			Round:   round,
+			Nonce:   s.shardNonces[shardID],
+		})
+	}
+
+	return &block.MetaBlock{
+		Nonce:                  s.metaNonce,
+		Epoch:                  epoch,
+		Round:                  round,
+		AccumulatedFeesInEpoch: big.NewInt(0).Set(s.feesPerEpoch),
+		DevFeesInEpoch:         big.NewInt(0).Set(s.devFeesPerEpoch),
+		EpochStart: block.EpochStart{
+			LastFinalizedHeaders: lastFinalizedHeaders,
+		},
+	}
+}
+
+// updateRatings applies the rating changes the nodes would have received during the epoch and the end of epoch
+// penalty for the nodes that signed too few blocks
+func (s *simulator) updateRatings(activity *epochActivity) {
+	signedBlocksThreshold := s.rater.GetSignedBlocksThreshold()
+	for node, stats := range activity.nodes {
+		s.updateRating(node, stats)
+
+		occurrences := core.MaxUint32(1, stats.validatorSuccess+stats.validatorFailure)
+		signedBlocks := float32(stats.validatorSuccess) / float32(occurrences)
+		if signedBlocks <= signedBlocksThreshold {
+			node.rating = s.rater.RevertIncreaseValidator(node.shardID, node.rating, stats.validatorSuccess)
+		}
+	}
+}
+
+// updateRating interleaves the rating changes evenly, as they would happen during the epoch, so the rating limits
+// are applied in the same way
+func (s *simulator) updateRating(node *simulatedNode, stats *nodeActivity) {
+	changes := []*ratingChange{
+		{
+			count: stats.leaderSuccess,
+			apply: func(rating uint32) uint32 {
+				node.consecutiveMisses = 0
+				return s.rater.ComputeIncreaseProposer(node.shardID, rating)
+			},
+		},
+		{
+			count: stats.leaderFailure,
+			apply: func(rating uint32) uint32 {
+				newRating := s.rater.ComputeDecreaseProposer(node.shardID, rating, node.consecutiveMisses)
+				node.consecutiveMisses++
+				return newRating
+			},
+		},
+		{
+			count: stats.validatorSuccess,
+			apply: func(rating uint32) uint32 {
+				return s.rater.ComputeIncreaseValidator(node.shardID, rating)
+			},
+		},
+		{
+			count: stats.missedRoundsAppearances,
+			apply: func(rating uint32) uint32 {
+				return s.rater.ComputeDecreaseValidator(node.shardID, rating)
+			},
+		},
+	}
+
+	for {
+		next := nextRatingChange(changes)
+		if next == nil {
+			return
+		}
+
+		node.rating = next.apply(node.rating)
+		next.done++
+	}
+}
+
+type ratingChange struct {
+	count uint32
+	done  uint32
+	apply func(rating uint32) uint32
+}
+
+func nextRatingChange(changes []*ratingChange) *ratingChange {
+	var next *ratingChange
+	minPosition := math.MaxFloat64
+	for _, change := range changes {
+		if change.done >= change.count {
+			continue
+		}
+
+		position := (float64(change.done) + 0.5) / float64(change.count)
+		if position < minPosition {
+			minPosition = position
+			next = change
+		}
+	}
+
+	return next
+}
+
+func (s *simulator) createValidatorsInfo(activity *epochActivity) map[uint32][]*state.ValidatorInfo {
+	numBlocks := uint64(0)
+	for _, blocks := range activity.blocksPerShard {
+		numBlocks += blocks
+	}
+
+	feesForValidators := big.NewInt(0).Sub(s.feesPerEpoch, s.devFeesPerEpoch)
+	leaderFees := core.GetIntTrimmedPercentageOfValue(feesForValidators, s.economicsData.LeaderPercentage())
+	leaderFeesPerBlock := big.NewInt(0).Div(leaderFees, big.NewInt(0).SetUint64(core.MaxUint64(1, numBlocks)))
+
+	validatorsInfo := make(map[uint32][]*state.ValidatorInfo)
+	for shardID, nodes := range s.nodesPerShard {
+		validatorsInfo[shardID] = make([]*state.ValidatorInfo, 0, len(nodes))
+		for index, node := range nodes {
+			stats := activity.nodes[node]
+			validatorsInfo[shardID] = append(validatorsInfo[shardID], &state.ValidatorInfo{
+				PublicKey:                  node.blsKey,
+				ShardId:                    shardID,
+				List:                       string(common.EligibleList),
+				Index:                      uint32(index),
+				TempRating:                 node.rating,
+				Rating:                     node.rating,
+				RewardAddress:              node.rewardAddress,
+				LeaderSuccess:              stats.leaderSuccess,
+				LeaderFailure:              stats.leaderFailure,
+				ValidatorSuccess:           stats.validatorSuccess,
+				ValidatorFailure:           stats.validatorFailure,
+				NumSelectedInSuccessBlocks: stats.numSelectedInSuccessBlocks,
+				AccumulatedFees:            big.NewInt(0).Mul(leaderFeesPerBlock, big.NewInt(int64(stats.leaderSuccess))),
+			})
+		}
+	}
+
+	return validatorsInfo
+}
+
+func eligibleNodesKeys(validatorsInfo map[uint32][]*state.ValidatorInfo) map[uint32][][]byte {
+	keys := make(map[uint32][][]byte)
+	for shardID, validators := range validatorsInfo {
+		for _, validator := range validators {
+			keys[shardID] = append(keys[shardID], validator.PublicKey)
+		}
+	}
+
+	return keys
+}
+
+func (s *simulator) collectRewards(miniBlocks block.MiniBlockSlice) map[*simulatedNode]*big.Int {
+	rewards := make(map[*simulatedNode]*big.Int)
+	rewardsTxs := s.rewardsCreator.GetRewardsTxs(&block.Body{MiniBlocks: miniBlocks})
+	for _, rewardTx := range rewardsTxs {
+		node, ok := s.nodesByRewardAddr[string(rewardTx.GetRcvAddr())]
+		if !ok {
+			continue
+		}
+
+		rewards[node] = big.NewInt(0).Set(rewardTx.GetValue())
+	}
+
+	return rewards
+}
+
+func (s *simulator) createEpochResult(
+	metaBlock *block.MetaBlock,
+	activity *epochActivity,
+	rewards map[*simulatedNode]*big.Int,
+) *EpochResult {
+	computedEconomics := metaBlock.EpochStart.Economics
+	epochsPerYear := float64(millisecondsInYear) / float64(s.network.RoundsPerEpoch*s.network.RoundDurationMs)
+
+	numBlocks := uint64(0)
+	for _, blocks := range activity.blocksPerShard {
+		numBlocks += blocks
+	}
+
+	prevTotalSupply := big.NewInt(0).Sub(computedEconomics.TotalSupply, computedEconomics.TotalNewlyMinted)
+	result := &EpochResult{
+		Epoch:                            metaBlock.Epoch,
+		NumBlocks:                        numBlocks,
+		TotalSupply:                      big.NewInt(0).Set(computedEconomics.TotalSupply),
+		TotalNewlyMinted:                 big.NewInt(0).Set(computedEconomics.TotalNewlyMinted),
+		AnnualInflation:                  ratio(computedEconomics.TotalNewlyMinted, prevTotalSupply) * epochsPerYear,
+		TotalToDistribute:                big.NewInt(0).Set(computedEconomics.TotalToDistribute),
+		RewardsPerBlock:                  big.NewInt(0).Set(computedEconomics.RewardsPerBlock),
+		RewardsForProtocolSustainability: big.NewInt(0).Set(computedEconomics.RewardsForProtocolSustainability),
+		LeaderFees:                       big.NewInt(0).Set(s.economicsStatistics.LeaderFees()),
+		TotalStakeEligible:               s.stakingDataProvider.GetTotalStakeEligibleNodes(),
+		TotalTopUpEligible:               s.stakingDataProvider.GetTotalTopUpStakeEligibleNodes(),
+		TotalValidatorRewards:            big.NewInt(0),
+		Validators:                       make([]*ValidatorResult, 0, len(activity.nodes)),
+	}
+
+	for _, owner := range s.owners {
+		ownerAddress := s.addressConverter.Encode(owner.address)
+		for _, node := range owner.nodes {
+			reward, ok := rewards[node]
+			if !ok {
+				reward = big.NewInt(0)
+			}
+
+			topUpPerNode, err := s.stakingDataProvider.GetNodeStakedTopUp(node.blsKey)
+			if err != nil {
+				topUpPerNode = big.NewInt(0)
+			}
+			stake := big.NewInt(0).Add(s.nodePrice, topUpPerNode)
+
+			stats := activity.nodes[node]
+			result.TotalValidatorRewards.Add(result.TotalValidatorRewards, reward)
+			result.Validators = append(result.Validators, &ValidatorResult{
+				BlsKey:           node.blsKey,
+				Owner:            ownerAddress,
+				ShardID:          node.shardID,
+				LeaderSuccess:    stats.leaderSuccess,
+				LeaderFailure:    stats.leaderFailure,
+				ValidatorSuccess: stats.validatorSuccess,
+				ValidatorFailure: stats.validatorFailure,
+				Stake:            stake,
+				TopUpPerNode:     big.NewInt(0).Set(topUpPerNode),
+				Reward:           reward,
+				APR:              ratio(reward, stake) * epochsPerYear,
+				Rating:           node.rating,
+				Chance:           s.rater.GetChance(node.rating),
+			})
+		}
+	}
+
+	return result
+}
+
+func ratio(numerator *big.Int, denominator *big.Int) float64 {
+	if denominator.Sign() == 0 {
+		return 0
+	}
+
+	value, _ := big.NewFloat(0).Quo(big.NewFloat(0).SetInt(numerator), big.NewFloat(0).SetInt(denominator)).Float64()
+
+	return value
+}
+
+func sortNodes(nodes []*simulatedNode) {
+	sort.Slice(nodes, func(i, j int) bool {
+		return bytes.Compare(nodes[i].blsKey, nodes[j].blsKey) < 0
+	})
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (s *simulator) IsInterfaceNil() bool {
+	return s == nil
+}
@@ -0,0 +1,235 @@
+package simulation
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/core/pubkeyConverter"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const nodeConfigPath = "../../node/config/"
+
+func createMockArgsSimulator(t *testing.T) ArgsSimulator {
+	economicsConfig, err := common.LoadEconomicsConfig(nodeConfigPath + "economics.toml")
+	require.Nil(t, err)
+	ratingsConfig, err := common.LoadRatingsConfig(nodeConfigPath + "ratings.toml")
+	require.Nil(t, err)
+	systemSCConfig, err := common.LoadSystemSmartContractsConfig(nodeConfigPath + "systemSmartContractsConfig.toml")
+	require.Nil(t, err)
+	epochConfig, err := common.LoadEpochConfig(nodeConfigPath + "enableEpochs.toml")
+	require.Nil(t, err)
+
+	addressConverter, err := pubkeyConverter.NewBech32PubkeyConverter(addressLength, log)
+	require.Nil(t, err)
+	validatorSet, err := GenerateValidatorSet(ArgsSyntheticValidatorSet{
+		AddressConverter: addressConverter,
+		NumShards:        2,
+		NodesPerShard:    10,
+		MetaNodes:        10,
+		NumOwners:        4,
+		TopUpPerNode:     big.NewInt(0),
+		Seed:             "seed",
+	})
+	require.Nil(t, err)
+
+	return ArgsSimulator{
+		EconomicsConfig:  economicsConfig,
+		RatingsConfig:    ratingsConfig,
+		SystemSCConfig:   systemSCConfig,
+		EpochConfig:      epochConfig,
+		AddressConverter: addressConverter,
+		Network: NetworkConfig{
+			NumShards:              2,
+			ConsensusGroupSize:     5,
+			MetaConsensusGroupSize: 10,
+			MinNodesPerShard:       10,
+			MetaMinNodes:           10,
+			RoundDurationMs:        6000,
+			RoundsPerEpoch:         1000,
+		},
+		ValidatorSet:    validatorSet,
+		FeesPerEpoch:    big.NewInt(0),
+		DevFeesPerEpoch: big.NewInt(0),
+	}
+}
+
+func TestNewSimulator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil economics config should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSimulator(t)
+		args.EconomicsConfig = nil
+		s, err := NewSimulator(args)
+
+		assert.True(t, check.IfNil(s))
+		assert.Equal(t, ErrNilEconomicsConfig, err)
+	})
+	t.Run("nil address converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSimulator(t)
+		args.AddressConverter = nil
+		s, err := NewSimulator(args)
+
+		assert.True(t, check.IfNil(s))
+		assert.Equal(t, ErrNilPubkeyConverter, err)
+	})
+	t.Run("empty validator set should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSimulator(t)
+		args.ValidatorSet = nil
+		s, err := NewSimulator(args)
+
+		assert.True(t, check.IfNil(s))
+		assert.Equal(t, ErrNilValidatorSet, err)
+	})
+	t.Run("invalid round duration should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSimulator(t)
+		args.Network.RoundDurationMs = 10
+		s, err := NewSimulator(args)
+
+		assert.True(t, check.IfNil(s))
+		assert.Equal(t, ErrInvalidRoundDuration, err)
+	})
+	t.Run("dev fees higher than fees should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSimulator(t)
+		args.DevFeesPerEpoch = big.NewInt(1)
+		s, err := NewSimulator(args)
+
+		assert.True(t, check.IfNil(s))
+		assert.Equal(t, ErrInvalidFees, err)
+	})
+	t.Run("invalid node price should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSimulator(t)
+		args.SystemSCConfig.StakingSystemSCConfig.GenesisNodePrice = "-1"
+		s, err := NewSimulator(args)
+
+		assert.True(t, check.IfNil(s))
+		assert.Equal(t, ErrInvalidNodePrice, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		s, err := NewSimulator(createMockArgsSimulator(t))
+
+		assert.False(t, check.IfNil(s))
+		assert.Nil(t, err)
+	})
+}
+
+func TestSimulator_Run(t *testing.T) {
+	t.Parallel()
+
+	t.Run("inflation should be minted and distributed", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSimulator(t)
+		s, err := NewSimulator(args)
+		require.Nil(t, err)
+
+		results, err := s.Run(3)
+		require.Nil(t, err)
+		require.Equal(t, 3, len(results))
+
+		genesisTotalSupply, _ := big.NewInt(0).SetString(args.EconomicsConfig.GlobalSettings.GenesisTotalSupply, 10)
+		expectedTotalSupply := big.NewInt(0).Set(genesisTotalSupply)
+		for i, result := range results {
+			assert.Equal(t, uint32(i+1), result.Epoch)
+			assert.Equal(t, uint64(3000), result.NumBlocks)
+			assert.True(t, result.TotalNewlyMinted.Sign() > 0)
+			assert.True(t, result.AnnualInflation > 0)
+			assert.True(t, result.TotalValidatorRewards.Sign() > 0)
+			assert.Equal(t, 30, len(result.Validators))
+
+			expectedTotalSupply.Add(expectedTotalSupply, result.TotalNewlyMinted)
+			assert.Equal(t, expectedTotalSupply, result.TotalSupply)
+
+			distributed := big.NewInt(0).Add(result.TotalValidatorRewards, result.RewardsForProtocolSustainability)
+			assert.True(t, distributed.Cmp(result.TotalToDistribute) <= 0)
+		}
+	})
+	t.Run("offline nodes should receive lower rewards and ratings", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSimulator(t)
+		args.ValidatorSet[0].Nodes[0].Downtime = 0.5
+		offlineKey := args.ValidatorSet[0].Nodes[0].BlsKey
+		onlineKey := args.ValidatorSet[0].Nodes[1].BlsKey
+		require.Equal(t, args.ValidatorSet[0].Nodes[0].ShardID, args.ValidatorSet[0].Nodes[1].ShardID)
+
+		s, err := NewSimulator(args)
+		require.Nil(t, err)
+		results, err := s.Run(2)
+		require.Nil(t, err)
+
+		for _, result := range results {
+			offline := findValidatorResult(t, result, offlineKey)
+			online := findValidatorResult(t, result, onlineKey)
+
+			assert.True(t, offline.LeaderFailure > 0)
+			assert.True(t, offline.Reward.Cmp(online.Reward) < 0)
+			assert.True(t, offline.Rating < online.Rating)
+			assert.True(t, offline.APR < online.APR)
+		}
+	})
+	t.Run("restaked rewards should increase the top-up", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSimulator(t)
+		args.RestakeRewards = true
+		s, err := NewSimulator(args)
+		require.Nil(t, err)
+
+		results, err := s.Run(2)
+		require.Nil(t, err)
+
+		assert.Equal(t, big.NewInt(0), results[0].TotalTopUpEligible)
+		assert.Equal(t, results[0].TotalValidatorRewards, results[1].TotalTopUpEligible)
+	})
+	t.Run("fees should replace part of the inflation", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSimulator(t)
+		s, err := NewSimulator(args)
+		require.Nil(t, err)
+		resultsWithoutFees, err := s.Run(1)
+		require.Nil(t, err)
+
+		args = createMockArgsSimulator(t)
+		args.FeesPerEpoch = big.NewInt(1000000000)
+		args.DevFeesPerEpoch = big.NewInt(100000000)
+		s, err = NewSimulator(args)
+		require.Nil(t, err)
+		resultsWithFees, err := s.Run(1)
+		require.Nil(t, err)
+
+		assert.True(t, resultsWithFees[0].LeaderFees.Sign() > 0)
+		assert.True(t, resultsWithFees[0].TotalNewlyMinted.Cmp(resultsWithoutFees[0].TotalNewlyMinted) < 0)
+		assert.Equal(t, resultsWithoutFees[0].TotalToDistribute, resultsWithFees[0].TotalToDistribute)
+	})
+}
+
+func findValidatorResult(t *testing.T, result *EpochResult, blsKey string) *ValidatorResult {
+	for _, validator := range result.Validators {
+		if hex.EncodeToString(validator.BlsKey) == blsKey {
+			return validator
+		}
+	}
+
+	require.Fail(t, "validator not found", blsKey)
+	return nil
+}
@@ -0,0 +1,117 @@
+package simulation
+
+import (
+	"fmt"
+	"math/big"
+
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+const getOwnerFunction = "getOwner"
+const getTotalStakedTopUpStakedBlsKeysFunction = "getTotalStakedTopUpStakedBlsKeys"
+
+var _ vmcommon.VMExecutionHandler = (*stakingView)(nil)
+
+// stakingView answers the staking and validator system smart contracts queries issued by the staking data provider, using
+// the simulated validator set instead of the system VM state
+type stakingView struct {
+	ownersByAddress map[string]*simulatedOwner
+	ownersByBlsKey  map[string]*simulatedOwner
+	nodePrice       *big.Int
+}
+
+func newStakingView(owners []*simulatedOwner, nodePrice *big.Int) *stakingView {
+	sv := &stakingView{
+		ownersByAddress: make(map[string]*simulatedOwner),
+		ownersByBlsKey:  make(map[string]*simulatedOwner),
+		nodePrice:       nodePrice,
+	}
+
+	for _, owner := range owners {
+		sv.ownersByAddress[string(owner.address)] = owner
+		for _, node := range owner.nodes {
+			sv.ownersByBlsKey[string(node.blsKey)] = owner
+		}
+	}
+
+	return sv
+}
+
+// RunSmartContractCreate returns an error as the staking view can not deploy contracts
+func (sv *stakingView) RunSmartContractCreate(_ *vmcommon.ContractCreateInput) (*vmcommon.VMOutput, error) {
+	return nil, ErrUnknownStakingFunction
+}
+
+// RunSmartContractCall answers the getOwner and getTotalStakedTopUpStakedBlsKeys queries
+func (sv *stakingView) RunSmartContractCall(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+	if len(input.Arguments) != 1 {
+		return &vmcommon.VMOutput{
+			ReturnCode:    vmcommon.FunctionWrongSignature,
+			ReturnMessage: "exactly one argument expected",
+		}, nil
+	}
+
+	switch input.Function {
+	case getOwnerFunction:
+		return sv.getOwner(input.Arguments[0]), nil
+	case getTotalStakedTopUpStakedBlsKeysFunction:
+		return sv.getTotalStakedTopUpStakedBlsKeys(input.Arguments[0]), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownStakingFunction, input.Function)
+	}
+}
+
+func (sv *stakingView) getOwner(blsKey []byte) *vmcommon.VMOutput {
+	owner, ok := sv.ownersByBlsKey[string(blsKey)]
+	if !ok {
+		return &vmcommon.VMOutput{
+			ReturnCode:    vmcommon.UserError,
+			ReturnMessage: "BLS key not staked",
+		}
+	}
+
+	return &vmcommon.VMOutput{
+		ReturnCode: vmcommon.Ok,
+		ReturnData: [][]byte{owner.address},
+	}
+}
+
+// getTotalStakedTopUpStakedBlsKeys returns the top-up, the total staked value, the number of staked nodes and the BLS
+// keys of the owner, in the same format as the validator system smart contract
+func (sv *stakingView) getTotalStakedTopUpStakedBlsKeys(address []byte) *vmcommon.VMOutput {
+	owner, ok := sv.ownersByAddress[string(address)]
+	if !ok {
+		return &vmcommon.VMOutput{
+			ReturnCode:    vmcommon.UserError,
+			ReturnMessage: "owner not found",
+		}
+	}
+
+	numStakedNodes := big.NewInt(int64(len(owner.nodes)))
+	totalStaked := big.NewInt(0).Mul(sv.nodePrice, numStakedNodes)
+	totalStaked.Add(totalStaked, owner.topUp)
+
+	returnData := [][]byte{owner.topUp.Bytes(), totalStaked.Bytes(), numStakedNodes.Bytes()}
+	for _, node := range owner.nodes {
+		returnData = append(returnData, node.blsKey)
+	}
+
+	return &vmcommon.VMOutput{
+		ReturnCode: vmcommon.Ok,
+		ReturnData: returnData,
+	}
+}
+
+// GasScheduleChange does nothing as the staking view does not consume gas
+func (sv *stakingView) GasScheduleChange(_ map[string]map[string]uint64) {
+}
+
+// GetVersion returns an empty string
+func (sv *stakingView) GetVersion() string {
+	return ""
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sv *stakingView) IsInterfaceNil() bool {
+	return sv == nil
+}
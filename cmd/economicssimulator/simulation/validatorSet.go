@@ -0,0 +1,263 @@
+package simulation
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+)
+
+const blsKeyLength = 96
+const addressLength = 32
+
+// NodeData holds the data of a simulated node, as read from the validator set file
+type NodeData struct {
+	BlsKey   string  `json:"blsKey"`
+	ShardID  uint32  `json:"shardID"`
+	Downtime float64 `json:"downtime"`
+	Rating   uint32  `json:"rating"`
+}
+
+// OwnerData holds the staking data of a simulated validator owner, as read from the validator set file
+type OwnerData struct {
+	Address string      `json:"address"`
+	TopUp   string      `json:"topUp"`
+	Nodes   []*NodeData `json:"nodes"`
+}
+
+// ArgsSyntheticValidatorSet holds the arguments needed to generate a synthetic validator set
+type ArgsSyntheticValidatorSet struct {
+	AddressConverter core.PubkeyConverter
+	NumShards        uint32
+	NodesPerShard    uint32
+	MetaNodes        uint32
+	NumOwners        uint32
+	TopUpPerNode     *big.Int
+	Downtime         float64
+	Seed             string
+}
+
+type simulatedOwner struct {
+	address []byte
+	topUp   *big.Int
+	nodes   []*simulatedNode
+}
+
+// simulatedNode receives the rewards on an address of its own, so the rewards computed for each node can be told apart
+type simulatedNode struct {
+	blsKey            []byte
+	rewardAddress     []byte
+	shardID           uint32
+	downtime          float64
+	rating            uint32
+	consecutiveMisses uint32
+	owner             *simulatedOwner
+}
+
+// LoadValidatorSet reads a validator set from the provided JSON file
+func LoadValidatorSet(path string) ([]*OwnerData, error) {
+	owners := make([]*OwnerData, 0)
+	err := core.LoadJsonFile(&owners, path)
+	if err != nil {
+		return nil, err
+	}
+
+	return owners, nil
+}
+
+// GenerateValidatorSet creates a synthetic validator set. The nodes are distributed round-robin between the owners and
+// each owner receives the top-up for all its nodes. The same seed always generates the same keys and addresses
+func GenerateValidatorSet(args ArgsSyntheticValidatorSet) ([]*OwnerData, error) {
+	if check.IfNil(args.AddressConverter) {
+		return nil, ErrNilPubkeyConverter
+	}
+	if args.NumOwners == 0 {
+		return nil, ErrInvalidNumberOfOwners
+	}
+	if args.TopUpPerNode == nil || args.TopUpPerNode.Sign() < 0 {
+		return nil, ErrInvalidTopUp
+	}
+
+	owners := make([]*OwnerData, 0, args.NumOwners)
+	for i := uint32(0); i < args.NumOwners; i++ {
+		owners = append(owners, &OwnerData{
+			Address: args.AddressConverter.Encode(deriveBytes(args.Seed, "owner", i, addressLength)),
+			Nodes:   make([]*NodeData, 0),
+		})
+	}
+
+	shardIDs := make([]uint32, 0, args.NumShards+1)
+	numNodes := make([]uint32, 0, args.NumShards+1)
+	for shardID := uint32(0); shardID < args.NumShards; shardID++ {
+		shardIDs = append(shardIDs, shardID)
+		numNodes = append(numNodes, args.NodesPerShard)
+	}
+	shardIDs = append(shardIDs, core.MetachainShardId)
+	numNodes = append(numNodes, args.MetaNodes)
+
+	nodeIndex := uint32(0)
+	for i, shardID := range shardIDs {
+		for j := uint32(0); j < numNodes[i]; j++ {
+			owner := owners[nodeIndex%args.NumOwners]
+			owner.Nodes = append(owner.Nodes, &NodeData{
+				BlsKey:   hex.EncodeToString(deriveBytes(args.Seed, "node", nodeIndex, blsKeyLength)),
+				ShardID:  shardID,
+				Downtime: args.Downtime,
+			})
+			nodeIndex++
+		}
+	}
+
+	ownersWithNodes := make([]*OwnerData, 0, len(owners))
+	for _, owner := range owners {
+		if len(owner.Nodes) == 0 {
+			continue
+		}
+
+		topUp := big.NewInt(0).Mul(args.TopUpPerNode, big.NewInt(int64(len(owner.Nodes))))
+		owner.TopUp = topUp.String()
+		ownersWithNodes = append(ownersWithNodes, owner)
+	}
+
+	return ownersWithNodes, nil
+}
+
+func deriveBytes(seed string, domain string, index uint32, length int) []byte {
+	result := make([]byte, 0, length)
+	for counter := 0; len(result) < length; counter++ {
+		h := sha256.Sum256([]byte(fmt.Sprintf("%s-%s-%d-%d", seed, domain, index, counter)))
+		result = append(result, h[:]...)
+	}
+
+	return result[:length]
+}
+
+func createSimulatedOwners(
+	owners []*OwnerData,
+	addressConverter core.PubkeyConverter,
+	hasher hashing.Hasher,
+	numShards uint32,
+	startRating uint32,
+) ([]*simulatedOwner, error) {
+	if len(owners) == 0 {
+		return nil, ErrNilValidatorSet
+	}
+
+	nodesPerShard := make(map[uint32]int)
+	blsKeys := make(map[string]struct{})
+	simulatedOwners := make([]*simulatedOwner, 0, len(owners))
+	for _, ownerData := range owners {
+		owner, err := createSimulatedOwner(ownerData, addressConverter, hasher, numShards, startRating)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, node := range owner.nodes {
+			_, exists := blsKeys[string(node.blsKey)]
+			if exists {
+				return nil, fmt.Errorf("%w: %s", ErrDuplicatedBlsKey, hex.EncodeToString(node.blsKey))
+			}
+
+			blsKeys[string(node.blsKey)] = struct{}{}
+			nodesPerShard[node.shardID]++
+		}
+
+		simulatedOwners = append(simulatedOwners, owner)
+	}
+
+	for shardID := uint32(0); shardID < numShards; shardID++ {
+		if nodesPerShard[shardID] == 0 {
+			return nil, fmt.Errorf("%w: %d", ErrNoNodesInShard, shardID)
+		}
+	}
+	if nodesPerShard[core.MetachainShardId] == 0 {
+		return nil, fmt.Errorf("%w: %d", ErrNoNodesInShard, core.MetachainShardId)
+	}
+
+	return simulatedOwners, nil
+}
+
+func createSimulatedOwner(
+	ownerData *OwnerData,
+	addressConverter core.PubkeyConverter,
+	hasher hashing.Hasher,
+	numShards uint32,
+	startRating uint32,
+) (*simulatedOwner, error) {
+	if ownerData == nil || len(ownerData.Nodes) == 0 {
+		return nil, ErrOwnerWithoutNodes
+	}
+
+	address, err := addressConverter.Decode(ownerData.Address)
+	if err != nil {
+		return nil, fmt.Errorf("%w for owner %s", err, ownerData.Address)
+	}
+
+	topUp := big.NewInt(0)
+	if len(ownerData.TopUp) > 0 {
+		var ok bool
+		topUp, ok = big.NewInt(0).SetString(ownerData.TopUp, 10)
+		if !ok || topUp.Sign() < 0 {
+			return nil, fmt.Errorf("%w for owner %s: %s", ErrInvalidTopUp, ownerData.Address, ownerData.TopUp)
+		}
+	}
+
+	owner := &simulatedOwner{
+		address: address,
+		topUp:   topUp,
+		nodes:   make([]*simulatedNode, 0, len(ownerData.Nodes)),
+	}
+	for _, nodeData := range ownerData.Nodes {
+		node, errCreate := createSimulatedNode(nodeData, hasher, numShards, startRating)
+		if errCreate != nil {
+			return nil, errCreate
+		}
+
+		node.owner = owner
+		owner.nodes = append(owner.nodes, node)
+	}
+
+	return owner, nil
+}
+
+func createSimulatedNode(
+	nodeData *NodeData,
+	hasher hashing.Hasher,
+	numShards uint32,
+	startRating uint32,
+) (*simulatedNode, error) {
+	if nodeData == nil || len(nodeData.BlsKey) == 0 {
+		return nil, ErrEmptyBlsKey
+	}
+
+	blsKey, err := hex.DecodeString(nodeData.BlsKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w for BLS key %s", err, nodeData.BlsKey)
+	}
+	if len(blsKey) == 0 {
+		return nil, ErrEmptyBlsKey
+	}
+	if nodeData.ShardID >= numShards && nodeData.ShardID != core.MetachainShardId {
+		return nil, fmt.Errorf("%w %d for BLS key %s", ErrInvalidShardID, nodeData.ShardID, nodeData.BlsKey)
+	}
+	if nodeData.Downtime < 0 || nodeData.Downtime > 1 {
+		return nil, fmt.Errorf("%w %v for BLS key %s", ErrInvalidDowntime, nodeData.Downtime, nodeData.BlsKey)
+	}
+
+	rating := nodeData.Rating
+	if rating == 0 {
+		rating = startRating
+	}
+
+	return &simulatedNode{
+		blsKey:        blsKey,
+		rewardAddress: hasher.Compute(string(blsKey)),
+		shardID:       nodeData.ShardID,
+		downtime:      nodeData.Downtime,
+		rating:        rating,
+	}, nil
+}
@@ -0,0 +1,202 @@
+package simulation
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testStartRating = 5000001
+
+func createMockArgsSyntheticValidatorSet() ArgsSyntheticValidatorSet {
+	return ArgsSyntheticValidatorSet{
+		AddressConverter: testscommon.NewPubkeyConverterMock(addressLength),
+		NumShards:        2,
+		NodesPerShard:    5,
+		MetaNodes:        4,
+		NumOwners:        3,
+		TopUpPerNode:     big.NewInt(10),
+		Downtime:         0.1,
+		Seed:             "seed",
+	}
+}
+
+func TestGenerateValidatorSet(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil address converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSyntheticValidatorSet()
+		args.AddressConverter = nil
+		owners, err := GenerateValidatorSet(args)
+
+		assert.Nil(t, owners)
+		assert.Equal(t, ErrNilPubkeyConverter, err)
+	})
+	t.Run("no owners should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSyntheticValidatorSet()
+		args.NumOwners = 0
+		owners, err := GenerateValidatorSet(args)
+
+		assert.Nil(t, owners)
+		assert.Equal(t, ErrInvalidNumberOfOwners, err)
+	})
+	t.Run("negative top-up should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSyntheticValidatorSet()
+		args.TopUpPerNode = big.NewInt(-1)
+		owners, err := GenerateValidatorSet(args)
+
+		assert.Nil(t, owners)
+		assert.Equal(t, ErrInvalidTopUp, err)
+	})
+	t.Run("should distribute the nodes between the owners", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSyntheticValidatorSet()
+		owners, err := GenerateValidatorSet(args)
+		require.Nil(t, err)
+		require.Equal(t, 3, len(owners))
+
+		nodesPerShard := make(map[uint32]int)
+		for _, owner := range owners {
+			expectedTopUp := big.NewInt(int64(10 * len(owner.Nodes)))
+			assert.Equal(t, expectedTopUp.String(), owner.TopUp)
+			for _, node := range owner.Nodes {
+				nodesPerShard[node.ShardID]++
+				assert.Equal(t, 0.1, node.Downtime)
+			}
+		}
+
+		assert.Equal(t, 5, nodesPerShard[0])
+		assert.Equal(t, 5, nodesPerShard[1])
+		assert.Equal(t, 4, nodesPerShard[core.MetachainShardId])
+		assert.Equal(t, 5, len(owners[0].Nodes))
+		assert.Equal(t, 5, len(owners[1].Nodes))
+		assert.Equal(t, 4, len(owners[2].Nodes))
+	})
+	t.Run("owners without nodes should be removed", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSyntheticValidatorSet()
+		args.NumOwners = 20
+		owners, err := GenerateValidatorSet(args)
+
+		require.Nil(t, err)
+		assert.Equal(t, 14, len(owners))
+	})
+	t.Run("same seed should generate the same validator set", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSyntheticValidatorSet()
+		owners1, err := GenerateValidatorSet(args)
+		require.Nil(t, err)
+		owners2, err := GenerateValidatorSet(args)
+		require.Nil(t, err)
+		assert.Equal(t, owners1, owners2)
+
+		args.Seed = "other seed"
+		owners3, err := GenerateValidatorSet(args)
+		require.Nil(t, err)
+		assert.NotEqual(t, owners1, owners3)
+	})
+}
+
+func TestCreateSimulatedOwners(t *testing.T) {
+	t.Parallel()
+
+	addressConverter := testscommon.NewPubkeyConverterMock(addressLength)
+	hasher := blake2b.NewBlake2b()
+	createOwners := func() []*OwnerData {
+		owners, _ := GenerateValidatorSet(createMockArgsSyntheticValidatorSet())
+		return owners
+	}
+
+	t.Run("duplicated BLS key should error", func(t *testing.T) {
+		t.Parallel()
+
+		owners := createOwners()
+		owners[1].Nodes[0].BlsKey = owners[0].Nodes[0].BlsKey
+		simulatedOwners, err := createSimulatedOwners(owners, addressConverter, hasher, 2, testStartRating)
+
+		assert.Nil(t, simulatedOwners)
+		assert.True(t, errors.Is(err, ErrDuplicatedBlsKey))
+	})
+	t.Run("empty shard should error", func(t *testing.T) {
+		t.Parallel()
+
+		simulatedOwners, err := createSimulatedOwners(createOwners(), addressConverter, hasher, 3, testStartRating)
+
+		assert.Nil(t, simulatedOwners)
+		assert.True(t, errors.Is(err, ErrNoNodesInShard))
+	})
+	t.Run("invalid shard ID should error", func(t *testing.T) {
+		t.Parallel()
+
+		owners := createOwners()
+		owners[0].Nodes[0].ShardID = 7
+		simulatedOwners, err := createSimulatedOwners(owners, addressConverter, hasher, 2, testStartRating)
+
+		assert.Nil(t, simulatedOwners)
+		assert.True(t, errors.Is(err, ErrInvalidShardID))
+	})
+	t.Run("invalid downtime should error", func(t *testing.T) {
+		t.Parallel()
+
+		owners := createOwners()
+		owners[0].Nodes[0].Downtime = 1.5
+		simulatedOwners, err := createSimulatedOwners(owners, addressConverter, hasher, 2, testStartRating)
+
+		assert.Nil(t, simulatedOwners)
+		assert.True(t, errors.Is(err, ErrInvalidDowntime))
+	})
+	t.Run("invalid top-up should error", func(t *testing.T) {
+		t.Parallel()
+
+		owners := createOwners()
+		owners[0].TopUp = "not a number"
+		simulatedOwners, err := createSimulatedOwners(owners, addressConverter, hasher, 2, testStartRating)
+
+		assert.Nil(t, simulatedOwners)
+		assert.True(t, errors.Is(err, ErrInvalidTopUp))
+	})
+	t.Run("owner without nodes should error", func(t *testing.T) {
+		t.Parallel()
+
+		owners := createOwners()
+		owners[0].Nodes = nil
+		simulatedOwners, err := createSimulatedOwners(owners, addressConverter, hasher, 2, testStartRating)
+
+		assert.Nil(t, simulatedOwners)
+		assert.Equal(t, ErrOwnerWithoutNodes, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		owners := createOwners()
+		owners[0].Nodes[0].Rating = 100
+		simulatedOwners, err := createSimulatedOwners(owners, addressConverter, hasher, 2, testStartRating)
+		require.Nil(t, err)
+		require.Equal(t, 3, len(simulatedOwners))
+
+		assert.Equal(t, uint32(100), simulatedOwners[0].nodes[0].rating)
+		assert.Equal(t, uint32(testStartRating), simulatedOwners[0].nodes[1].rating)
+		assert.Equal(t, big.NewInt(50), simulatedOwners[0].topUp)
+		for _, owner := range simulatedOwners {
+			for _, node := range owner.nodes {
+				assert.True(t, owner == node.owner)
+				assert.Equal(t, hasher.Compute(string(node.blsKey)), node.rewardAddress)
+			}
+		}
+	})
+}
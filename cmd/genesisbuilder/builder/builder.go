@@ -0,0 +1,499 @@
+package builder
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/hashing/keccak"
+	"github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go/genesis"
+	"github.com/ElrondNetwork/elrond-go/genesis/data"
+	"github.com/ElrondNetwork/elrond-go/process/factory"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+// ArgsGenesisBuilder holds the arguments needed to create a genesis builder
+type ArgsGenesisBuilder struct {
+	Spec                     *GenesisSpec
+	AddressPubkeyConverter   core.PubkeyConverter
+	ValidatorPubkeyConverter core.PubkeyConverter
+	WalletKeyGenerator       crypto.KeyGenerator
+	ValidatorKeyGenerator    crypto.KeyGenerator
+}
+
+// Key holds a generated key pair
+type Key struct {
+	PrivateKey []byte
+	PublicKey  []byte
+}
+
+// GenesisBundle holds the generated genesis files contents and the generated keys. The validator keys are in the same
+// order as the initial nodes of the nodes setup
+type GenesisBundle struct {
+	Accounts       []*data.InitialAccount
+	NodesSetup     *sharding.NodesSetup
+	SmartContracts []*data.InitialSmartContract
+	ValidatorKeys  []*Key
+	WalletKeys     []*Key
+	TotalSupply    *big.Int
+	NodePrice      *big.Int
+}
+
+type delegationContract struct {
+	spec    DelegationSpec
+	owner   []byte
+	address []byte
+}
+
+type nodesOwner struct {
+	address       []byte
+	numValidators uint32
+}
+
+type genesisBuilder struct {
+	spec                     *GenesisSpec
+	addressPubkeyConverter   core.PubkeyConverter
+	validatorPubkeyConverter core.PubkeyConverter
+	walletKeyGenerator       crypto.KeyGenerator
+	validatorKeyGenerator    crypto.KeyGenerator
+	shardCoordinator         sharding.Coordinator
+	totalSupply              *big.Int
+	nodePrice                *big.Int
+	walletKeys               []*Key
+}
+
+// NewGenesisBuilder creates a builder able to expand a genesis spec into the genesis files of a network
+func NewGenesisBuilder(args ArgsGenesisBuilder) (*genesisBuilder, error) {
+	if args.Spec == nil {
+		return nil, ErrNilSpec
+	}
+	if check.IfNil(args.AddressPubkeyConverter) {
+		return nil, fmt.Errorf("%w for addresses", ErrNilPubkeyConverter)
+	}
+	if check.IfNil(args.ValidatorPubkeyConverter) {
+		return nil, fmt.Errorf("%w for validators", ErrNilPubkeyConverter)
+	}
+	if check.IfNil(args.WalletKeyGenerator) {
+		return nil, fmt.Errorf("%w for wallets", ErrNilKeyGenerator)
+	}
+	if check.IfNil(args.ValidatorKeyGenerator) {
+		return nil, fmt.Errorf("%w for validators", ErrNilKeyGenerator)
+	}
+
+	totalSupply, nodePrice, err := checkGeneralSpec(args.Spec.General)
+	if err != nil {
+		return nil, err
+	}
+
+	shardCoordinator, err := sharding.NewMultiShardCoordinator(args.Spec.General.NumShards, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return &genesisBuilder{
+		spec:                     args.Spec,
+		addressPubkeyConverter:   args.AddressPubkeyConverter,
+		validatorPubkeyConverter: args.ValidatorPubkeyConverter,
+		walletKeyGenerator:       args.WalletKeyGenerator,
+		validatorKeyGenerator:    args.ValidatorKeyGenerator,
+		shardCoordinator:         shardCoordinator,
+		totalSupply:              totalSupply,
+		nodePrice:                nodePrice,
+	}, nil
+}
+
+func checkGeneralSpec(general GeneralSpec) (*big.Int, *big.Int, error) {
+	if general.NumShards == 0 {
+		return nil, nil, ErrInvalidNumberOfShards
+	}
+	if general.ConsensusGroupSize == 0 || general.ConsensusGroupSize > general.ValidatorsPerShard {
+		return nil, nil, fmt.Errorf("%w for shards: %d, validators per shard %d",
+			ErrInvalidConsensusGroupSize, general.ConsensusGroupSize, general.ValidatorsPerShard)
+	}
+	if general.MetaConsensusGroupSize == 0 || general.MetaConsensusGroupSize > general.MetaValidators {
+		return nil, nil, fmt.Errorf("%w for metachain: %d, metachain validators %d",
+			ErrInvalidConsensusGroupSize, general.MetaConsensusGroupSize, general.MetaValidators)
+	}
+	if general.Hysteresis < 0 || general.Hysteresis > 1 {
+		return nil, nil, fmt.Errorf("%w: %f", ErrInvalidHysteresis, general.Hysteresis)
+	}
+
+	totalSupply, err := parseValue("total supply", general.TotalSupply)
+	if err != nil {
+		return nil, nil, err
+	}
+	if totalSupply.Sign() == 0 {
+		return nil, nil, fmt.Errorf("%w for total supply: 0", ErrInvalidValue)
+	}
+	nodePrice, err := parseValue("node price", general.NodePrice)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return totalSupply, nodePrice, nil
+}
+
+func parseValue(name string, value string) (*big.Int, error) {
+	if len(value) == 0 {
+		return big.NewInt(0), nil
+	}
+
+	result, ok := big.NewInt(0).SetString(value, 10)
+	if !ok || result.Sign() < 0 {
+		return nil, fmt.Errorf("%w for %s: %s", ErrInvalidValue, name, value)
+	}
+
+	return result, nil
+}
+
+// Build generates the validators keys, the missing wallets and the contents of the genesis files. The nodes of each
+// owner are spread as evenly as possible between the shards
+func (gb *genesisBuilder) Build() (*GenesisBundle, error) {
+	gb.walletKeys = make([]*Key, 0)
+
+	delegationContracts, err := gb.createDelegationContracts()
+	if err != nil {
+		return nil, err
+	}
+
+	accounts, owners, err := gb.createAccounts(delegationContracts)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, spec := range gb.spec.DelegationContracts {
+		owners = append(owners, &nodesOwner{
+			address:       delegationContracts[spec.Name].address,
+			numValidators: spec.NumValidators,
+		})
+	}
+
+	nodesSetup, validatorKeys, err := gb.createNodesSetup(owners)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GenesisBundle{
+		Accounts:       accounts,
+		NodesSetup:     nodesSetup,
+		SmartContracts: gb.createSmartContracts(delegationContracts),
+		ValidatorKeys:  validatorKeys,
+		WalletKeys:     gb.walletKeys,
+		TotalSupply:    big.NewInt(0).Set(gb.totalSupply),
+		NodePrice:      big.NewInt(0).Set(gb.nodePrice),
+	}, nil
+}
+
+func (gb *genesisBuilder) createDelegationContracts() (map[string]*delegationContract, error) {
+	contracts := make(map[string]*delegationContract)
+	deploysPerOwner := make(map[string]uint64)
+	for _, spec := range gb.spec.DelegationContracts {
+		_, exists := contracts[spec.Name]
+		if exists {
+			return nil, fmt.Errorf("%w: %s", ErrDuplicatedDelegationContract, spec.Name)
+		}
+
+		owner, err := gb.getOrGenerateAddress(spec.Owner, spec.Shard)
+		if err != nil {
+			return nil, fmt.Errorf("%w for delegation contract %s", err, spec.Name)
+		}
+
+		nonce := deploysPerOwner[string(owner)]
+		deploysPerOwner[string(owner)]++
+
+		contracts[spec.Name] = &delegationContract{
+			spec:    spec,
+			owner:   owner,
+			address: computeContractAddress(owner, nonce, factory.ArwenVirtualMachine),
+		}
+	}
+
+	return contracts, nil
+}
+
+func (gb *genesisBuilder) createAccounts(
+	delegationContracts map[string]*delegationContract,
+) ([]*data.InitialAccount, []*nodesOwner, error) {
+	accounts := make([]*data.InitialAccount, 0, len(gb.spec.Accounts)+1)
+	owners := make([]*nodesOwner, 0, len(gb.spec.Accounts)+len(gb.spec.DelegationContracts))
+	allocatedSupply := big.NewInt(0)
+	for idx, spec := range gb.spec.Accounts {
+		address, err := gb.getOrGenerateAddress(spec.Address, spec.Shard)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w for account at index %d", err, idx)
+		}
+
+		account, err := gb.createAccount(spec, address, delegationContracts)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w for account at index %d", err, idx)
+		}
+
+		accounts = append(accounts, account)
+		allocatedSupply.Add(allocatedSupply, account.Supply)
+		if spec.NumValidators > 0 {
+			owners = append(owners, &nodesOwner{
+				address:       address,
+				numValidators: spec.NumValidators,
+			})
+		}
+	}
+
+	remainingSupply := big.NewInt(0).Sub(gb.totalSupply, allocatedSupply)
+	if remainingSupply.Sign() < 0 {
+		return nil, nil, fmt.Errorf("%w: total supply %s, allocated %s",
+			ErrTotalSupplyExceeded, gb.totalSupply.String(), allocatedSupply.String())
+	}
+	if remainingSupply.Sign() == 0 {
+		return accounts, owners, nil
+	}
+
+	treasury, err := gb.getOrGenerateAddress(gb.spec.General.TreasuryAddress, gb.spec.General.TreasuryShard)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w for treasury", err)
+	}
+	accounts = append(accounts, &data.InitialAccount{
+		Address:      gb.addressPubkeyConverter.Encode(treasury),
+		Supply:       remainingSupply,
+		Balance:      big.NewInt(0).Set(remainingSupply),
+		StakingValue: big.NewInt(0),
+		Delegation: &data.DelegationData{
+			Value: big.NewInt(0),
+		},
+	})
+
+	return accounts, owners, nil
+}
+
+func (gb *genesisBuilder) createAccount(
+	spec AccountSpec,
+	address []byte,
+	delegationContracts map[string]*delegationContract,
+) (*data.InitialAccount, error) {
+	balance, err := parseValue("balance", spec.Balance)
+	if err != nil {
+		return nil, err
+	}
+	delegatedValue, err := parseValue("delegated value", spec.DelegatedValue)
+	if err != nil {
+		return nil, err
+	}
+
+	delegation := &data.DelegationData{
+		Value: delegatedValue,
+	}
+	if len(spec.Delegation) > 0 {
+		contract, ok := delegationContracts[spec.Delegation]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownDelegationContract, spec.Delegation)
+		}
+		delegation.Address = gb.addressPubkeyConverter.Encode(contract.address)
+	}
+
+	stakingValue := big.NewInt(0).Mul(gb.nodePrice, big.NewInt(int64(spec.NumValidators)))
+	supply := big.NewInt(0).Add(balance, stakingValue)
+	supply.Add(supply, delegatedValue)
+
+	return &data.InitialAccount{
+		Address:      gb.addressPubkeyConverter.Encode(address),
+		Supply:       supply,
+		Balance:      balance,
+		StakingValue: stakingValue,
+		Delegation:   delegation,
+	}, nil
+}
+
+// getOrGenerateAddress decodes the provided address or, if empty, generates a new wallet in the provided shard
+func (gb *genesisBuilder) getOrGenerateAddress(address string, shardID uint32) ([]byte, error) {
+	if len(address) > 0 {
+		addressBytes, err := gb.addressPubkeyConverter.Decode(address)
+		if err != nil {
+			return nil, fmt.Errorf("%w %s: %v", ErrInvalidAddress, address, err)
+		}
+
+		return addressBytes, nil
+	}
+
+	if shardID >= gb.shardCoordinator.NumberOfShards() {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidShardID, shardID)
+	}
+
+	for {
+		privateKey, publicKey := gb.walletKeyGenerator.GeneratePair()
+		pkBytes, err := publicKey.ToByteArray()
+		if err != nil {
+			return nil, err
+		}
+		if gb.shardCoordinator.ComputeId(pkBytes) != shardID {
+			continue
+		}
+
+		skBytes, err := privateKey.ToByteArray()
+		if err != nil {
+			return nil, err
+		}
+		gb.walletKeys = append(gb.walletKeys, &Key{
+			PrivateKey: skBytes,
+			PublicKey:  pkBytes,
+		})
+
+		return pkBytes, nil
+	}
+}
+
+func (gb *genesisBuilder) createNodesSetup(owners []*nodesOwner) (*sharding.NodesSetup, []*Key, error) {
+	positionsOwners, err := gb.assignNodes(owners)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	general := gb.spec.General
+	nodesSetup := &sharding.NodesSetup{
+		StartTime:                   general.StartTime,
+		RoundDuration:               general.RoundDuration,
+		ConsensusGroupSize:          general.ConsensusGroupSize,
+		MinNodesPerShard:            general.ValidatorsPerShard,
+		MetaChainConsensusGroupSize: general.MetaConsensusGroupSize,
+		MetaChainMinNodes:           general.MetaValidators,
+		Hysteresis:                  general.Hysteresis,
+		Adaptivity:                  general.Adaptivity,
+		InitialNodes:                make([]*sharding.InitialNode, 0, len(positionsOwners)),
+	}
+	validatorKeys := make([]*Key, 0, len(positionsOwners))
+	for _, owner := range positionsOwners {
+		privateKey, publicKey := gb.validatorKeyGenerator.GeneratePair()
+		skBytes, errSk := privateKey.ToByteArray()
+		if errSk != nil {
+			return nil, nil, errSk
+		}
+		pkBytes, errPk := publicKey.ToByteArray()
+		if errPk != nil {
+			return nil, nil, errPk
+		}
+
+		validatorKeys = append(validatorKeys, &Key{
+			PrivateKey: skBytes,
+			PublicKey:  pkBytes,
+		})
+		nodesSetup.InitialNodes = append(nodesSetup.InitialNodes, &sharding.InitialNode{
+			PubKey:  gb.validatorPubkeyConverter.Encode(pkBytes),
+			Address: gb.addressPubkeyConverter.Encode(owner),
+		})
+	}
+
+	return nodesSetup, validatorKeys, nil
+}
+
+// assignNodes returns the owner of each initial node, in the nodes setup order. The nodes setup places the first
+// nodes in the metachain, the following ones in the shards, in order, and the rest, round-robin, in the waiting lists.
+// The owners nodes are interleaved and dealt round-robin to the shards, so each owner ends up with nodes in as many
+// shards as possible
+func (gb *genesisBuilder) assignNodes(owners []*nodesOwner) ([][]byte, error) {
+	general := gb.spec.General
+	numGroups := general.NumShards + 1
+	metaGroup := general.NumShards
+
+	positionsGroups := make([]uint32, 0)
+	for i := uint32(0); i < general.MetaValidators; i++ {
+		positionsGroups = append(positionsGroups, metaGroup)
+	}
+	for shardID := uint32(0); shardID < general.NumShards; shardID++ {
+		for i := uint32(0); i < general.ValidatorsPerShard; i++ {
+			positionsGroups = append(positionsGroups, shardID)
+		}
+	}
+	currentGroup := uint32(0)
+	for i := uint32(0); i < numGroups*general.WaitingValidatorsPerShard; i++ {
+		currentGroup = (currentGroup + 1) % numGroups
+		positionsGroups = append(positionsGroups, currentGroup)
+	}
+
+	interleavedOwners := interleaveOwners(owners)
+	if len(interleavedOwners) != len(positionsGroups) {
+		return nil, fmt.Errorf("%w: the accounts and the delegation contracts own %d validators, the shards need %d",
+			ErrNumValidatorsMismatch, len(interleavedOwners), len(positionsGroups))
+	}
+
+	freePositions := make([][]int, numGroups)
+	for position, group := range positionsGroups {
+		freePositions[group] = append(freePositions[group], position)
+	}
+
+	positionsOwners := make([][]byte, len(positionsGroups))
+	group := uint32(0)
+	for _, owner := range interleavedOwners {
+		for len(freePositions[group]) == 0 {
+			group = (group + 1) % numGroups
+		}
+
+		positionsOwners[freePositions[group][0]] = owner
+		freePositions[group] = freePositions[group][1:]
+		group = (group + 1) % numGroups
+	}
+
+	return positionsOwners, nil
+}
+
+func interleaveOwners(owners []*nodesOwner) [][]byte {
+	interleaved := make([][]byte, 0)
+	for round := uint32(0); ; round++ {
+		added := false
+		for _, owner := range owners {
+			if round < owner.numValidators {
+				interleaved = append(interleaved, owner.address)
+				added = true
+			}
+		}
+		if !added {
+			return interleaved
+		}
+	}
+}
+
+func (gb *genesisBuilder) createSmartContracts(delegationContracts map[string]*delegationContract) []*data.InitialSmartContract {
+	smartContracts := make([]*data.InitialSmartContract, 0, len(gb.spec.DelegationContracts))
+	for _, spec := range gb.spec.DelegationContracts {
+		contract := delegationContracts[spec.Name]
+		smartContracts = append(smartContracts, &data.InitialSmartContract{
+			Owner:          gb.addressPubkeyConverter.Encode(contract.owner),
+			Filename:       getOrDefault(spec.Filename, defaultDelegationFilename),
+			VmType:         hex.EncodeToString(factory.ArwenVirtualMachine),
+			InitParameters: getOrDefault(spec.InitParameters, defaultDelegationInitParameters),
+			Type:           genesis.DelegationType,
+			Version:        getOrDefault(spec.Version, defaultDelegationVersion),
+		})
+	}
+
+	return smartContracts
+}
+
+func getOrDefault(value string, defaultValue string) string {
+	if len(value) == 0 {
+		return defaultValue
+	}
+
+	return value
+}
+
+// computeContractAddress computes the address of a contract deployed by the owner with the provided nonce, the same
+// way the blockchain hook does it when the genesis contracts are deployed
+func computeContractAddress(owner []byte, nonce uint64, vmType []byte) []byte {
+	buffNonce := make([]byte, 8)
+	binary.LittleEndian.PutUint64(buffNonce, nonce)
+	ownerAndNonce := append(append(make([]byte, 0, len(owner)+len(buffNonce)), owner...), buffNonce...)
+	address := keccak.NewKeccak().Compute(string(ownerAndNonce))
+
+	prefixMask := append(make([]byte, core.NumInitCharactersForScAddress-core.VMTypeLen), vmType...)
+	copy(address[:core.NumInitCharactersForScAddress], prefixMask)
+	copy(address[len(address)-core.ShardIdentiferLen:], owner[len(owner)-core.ShardIdentiferLen:])
+
+	return address
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gb *genesisBuilder) IsInterfaceNil() bool {
+	return gb == nil
+}
@@ -0,0 +1,282 @@
+package builder
+
+import (
+	"errors"
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/pubkeyConverter"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/ed25519"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/mcl"
+	"github.com/ElrondNetwork/elrond-go/process/factory"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const nodePrice = 2500
+
+func createTestArgs(t *testing.T) ArgsGenesisBuilder {
+	addressConverter, err := pubkeyConverter.NewBech32PubkeyConverter(32, log)
+	require.Nil(t, err)
+	validatorConverter, err := pubkeyConverter.NewHexPubkeyConverter(96)
+	require.Nil(t, err)
+
+	return ArgsGenesisBuilder{
+		Spec:                     createTestSpec(),
+		AddressPubkeyConverter:   addressConverter,
+		ValidatorPubkeyConverter: validatorConverter,
+		WalletKeyGenerator:       signing.NewKeyGenerator(ed25519.NewEd25519()),
+		ValidatorKeyGenerator:    signing.NewKeyGenerator(mcl.NewSuiteBLS12()),
+	}
+}
+
+// createTestSpec describes 2 shards of 2 eligible nodes, 2 eligible metachain nodes and 1 waiting node for each shard
+// and the metachain. The 9 nodes are owned by a direct staker (3 nodes) and a delegation contract (6 nodes)
+func createTestSpec() *GenesisSpec {
+	return &GenesisSpec{
+		General: GeneralSpec{
+			RoundDuration:             6000,
+			NumShards:                 2,
+			ValidatorsPerShard:        2,
+			MetaValidators:            2,
+			WaitingValidatorsPerShard: 1,
+			ConsensusGroupSize:        2,
+			MetaConsensusGroupSize:    2,
+			TotalSupply:               "1000000",
+			NodePrice:                 "2500",
+			TreasuryShard:             1,
+		},
+		Accounts: []AccountSpec{
+			{
+				Shard:         0,
+				Balance:       "1000",
+				NumValidators: 3,
+			},
+			{
+				Shard:          1,
+				Balance:        "10",
+				Delegation:     "pool",
+				DelegatedValue: "10000",
+			},
+			{
+				Shard:          0,
+				Delegation:     "pool",
+				DelegatedValue: "5000",
+			},
+		},
+		DelegationContracts: []DelegationSpec{
+			{
+				Name:          "pool",
+				Shard:         1,
+				NumValidators: 6,
+			},
+		},
+	}
+}
+
+func TestNewGenesisBuilder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil spec should err", func(t *testing.T) {
+		args := createTestArgs(t)
+		args.Spec = nil
+		gb, err := NewGenesisBuilder(args)
+		assert.Nil(t, gb)
+		assert.Equal(t, ErrNilSpec, err)
+	})
+	t.Run("nil address converter should err", func(t *testing.T) {
+		args := createTestArgs(t)
+		args.AddressPubkeyConverter = nil
+		gb, err := NewGenesisBuilder(args)
+		assert.Nil(t, gb)
+		assert.True(t, errors.Is(err, ErrNilPubkeyConverter))
+	})
+	t.Run("nil validator key generator should err", func(t *testing.T) {
+		args := createTestArgs(t)
+		args.ValidatorKeyGenerator = nil
+		gb, err := NewGenesisBuilder(args)
+		assert.Nil(t, gb)
+		assert.True(t, errors.Is(err, ErrNilKeyGenerator))
+	})
+	t.Run("zero shards should err", func(t *testing.T) {
+		args := createTestArgs(t)
+		args.Spec.General.NumShards = 0
+		gb, err := NewGenesisBuilder(args)
+		assert.Nil(t, gb)
+		assert.Equal(t, ErrInvalidNumberOfShards, err)
+	})
+	t.Run("consensus larger than the shard should err", func(t *testing.T) {
+		args := createTestArgs(t)
+		args.Spec.General.ConsensusGroupSize = 3
+		gb, err := NewGenesisBuilder(args)
+		assert.Nil(t, gb)
+		assert.True(t, errors.Is(err, ErrInvalidConsensusGroupSize))
+	})
+	t.Run("invalid total supply should err", func(t *testing.T) {
+		args := createTestArgs(t)
+		args.Spec.General.TotalSupply = "-1"
+		gb, err := NewGenesisBuilder(args)
+		assert.Nil(t, gb)
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+	t.Run("should work", func(t *testing.T) {
+		gb, err := NewGenesisBuilder(createTestArgs(t))
+		assert.Nil(t, err)
+		assert.False(t, gb.IsInterfaceNil())
+	})
+}
+
+func TestGenesisBuilder_Build(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should create a valid bundle", func(t *testing.T) {
+		args := createTestArgs(t)
+		gb, _ := NewGenesisBuilder(args)
+
+		bundle, err := gb.Build()
+		require.Nil(t, err)
+
+		require.Equal(t, 4, len(bundle.Accounts))
+		assert.Equal(t, big.NewInt(1000+3*nodePrice), bundle.Accounts[0].Supply)
+		assert.Equal(t, big.NewInt(3*nodePrice), bundle.Accounts[0].StakingValue)
+		treasury := bundle.Accounts[3]
+		assert.Equal(t, big.NewInt(1000000-1000-3*nodePrice-10010-5000), treasury.Balance)
+		assert.Equal(t, 5, len(bundle.WalletKeys), "3 accounts, the delegation owner and the treasury")
+
+		require.Equal(t, 1, len(bundle.SmartContracts))
+		delegationAddress, _ := args.AddressPubkeyConverter.Decode(bundle.Accounts[1].Delegation.Address)
+		owner, _ := args.AddressPubkeyConverter.Decode(bundle.SmartContracts[0].Owner)
+		assert.Equal(t, computeContractAddress(owner, 0, factory.ArwenVirtualMachine), delegationAddress)
+		assert.Equal(t, uint32(1), gb.shardCoordinator.ComputeId(delegationAddress))
+		assert.Equal(t, "0500", bundle.SmartContracts[0].VmType)
+		assert.Equal(t, defaultDelegationFilename, bundle.SmartContracts[0].Filename)
+
+		require.Equal(t, 9, len(bundle.NodesSetup.InitialNodes))
+		require.Equal(t, 9, len(bundle.ValidatorKeys))
+		for idx, key := range bundle.ValidatorKeys {
+			assert.Equal(t, args.ValidatorPubkeyConverter.Encode(key.PublicKey), bundle.NodesSetup.InitialNodes[idx].PubKey)
+		}
+
+		dir := t.TempDir()
+		err = WriteBundle(ArgsBundleWriter{
+			Bundle:                   bundle,
+			OutputDirectory:          dir,
+			AddressPubkeyConverter:   args.AddressPubkeyConverter,
+			ValidatorPubkeyConverter: args.ValidatorPubkeyConverter,
+		})
+		require.Nil(t, err)
+
+		err = ValidateBundle(ArgsBundleValidation{
+			Directory:                dir,
+			NumShards:                2,
+			TotalSupply:              bundle.TotalSupply,
+			NodePrice:                bundle.NodePrice,
+			AddressPubkeyConverter:   args.AddressPubkeyConverter,
+			ValidatorPubkeyConverter: args.ValidatorPubkeyConverter,
+			WalletKeyGenerator:       args.WalletKeyGenerator,
+			ValidatorKeyGenerator:    args.ValidatorKeyGenerator,
+		})
+		assert.Nil(t, err)
+
+		nodesSetup, err := sharding.NewNodesSetup(
+			filepath.Join(dir, NodesSetupFilename),
+			args.AddressPubkeyConverter,
+			args.ValidatorPubkeyConverter,
+			2,
+		)
+		require.Nil(t, err)
+		shardsOfStaker := make(map[uint32]struct{})
+		for _, node := range nodesSetup.AllInitialNodes() {
+			if args.AddressPubkeyConverter.Encode(node.AddressBytes()) == bundle.Accounts[0].Address {
+				shardsOfStaker[node.AssignedShard()] = struct{}{}
+			}
+		}
+		assert.Equal(t, 3, len(shardsOfStaker), "the nodes of the direct staker should be spread in all the shards")
+	})
+	t.Run("validators not filling the shards should err", func(t *testing.T) {
+		args := createTestArgs(t)
+		args.Spec.DelegationContracts[0].NumValidators = 5
+		gb, _ := NewGenesisBuilder(args)
+
+		bundle, err := gb.Build()
+		assert.Nil(t, bundle)
+		assert.True(t, errors.Is(err, ErrNumValidatorsMismatch))
+	})
+	t.Run("accounts exceeding the total supply should err", func(t *testing.T) {
+		args := createTestArgs(t)
+		args.Spec.General.TotalSupply = "10000"
+		gb, _ := NewGenesisBuilder(args)
+
+		bundle, err := gb.Build()
+		assert.Nil(t, bundle)
+		assert.True(t, errors.Is(err, ErrTotalSupplyExceeded))
+	})
+	t.Run("unknown delegation contract should err", func(t *testing.T) {
+		args := createTestArgs(t)
+		args.Spec.Accounts[1].Delegation = "missing"
+		gb, _ := NewGenesisBuilder(args)
+
+		bundle, err := gb.Build()
+		assert.Nil(t, bundle)
+		assert.True(t, errors.Is(err, ErrUnknownDelegationContract))
+	})
+	t.Run("duplicated delegation contract should err", func(t *testing.T) {
+		args := createTestArgs(t)
+		args.Spec.DelegationContracts = append(args.Spec.DelegationContracts, args.Spec.DelegationContracts[0])
+		gb, _ := NewGenesisBuilder(args)
+
+		bundle, err := gb.Build()
+		assert.Nil(t, bundle)
+		assert.True(t, errors.Is(err, ErrDuplicatedDelegationContract))
+	})
+	t.Run("account in a missing shard should err", func(t *testing.T) {
+		args := createTestArgs(t)
+		args.Spec.Accounts[0].Shard = 2
+		gb, _ := NewGenesisBuilder(args)
+
+		bundle, err := gb.Build()
+		assert.Nil(t, bundle)
+		assert.True(t, errors.Is(err, ErrInvalidShardID))
+	})
+}
+
+func TestComputeContractAddress(t *testing.T) {
+	t.Parallel()
+
+	addressConverter, _ := pubkeyConverter.NewBech32PubkeyConverter(32, log)
+	owner, _ := addressConverter.Decode("erd1vxy22x0fj4zv6hktmydg8vpfh6euv02cz4yg0aaws6rrad5a5awqgqky80")
+
+	address := computeContractAddress(owner, 0, factory.ArwenVirtualMachine)
+	assert.Equal(t, "erd1qqqqqqqqqqqqqpgqrchxzx5uu8sv3ceg8nx8cxc0gesezure5awqn46gtd", addressConverter.Encode(address))
+	assert.True(t, core.IsSmartContractAddress(address))
+}
+
+func TestAssignNodes_InterleavesOwners(t *testing.T) {
+	t.Parallel()
+
+	gb := &genesisBuilder{
+		spec: &GenesisSpec{
+			General: GeneralSpec{
+				NumShards:          2,
+				ValidatorsPerShard: 2,
+				MetaValidators:     2,
+			},
+		},
+	}
+	ownerA := []byte("a")
+	ownerB := []byte("b")
+
+	positionsOwners, err := gb.assignNodes([]*nodesOwner{
+		{address: ownerA, numValidators: 3},
+		{address: ownerB, numValidators: 3},
+	})
+	require.Nil(t, err)
+
+	// meta positions 0-1, shard 0 positions 2-3, shard 1 positions 4-5
+	expected := [][]byte{ownerA, ownerB, ownerA, ownerB, ownerB, ownerA}
+	assert.Equal(t, expected, positionsOwners)
+}
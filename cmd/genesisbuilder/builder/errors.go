@@ -0,0 +1,50 @@
+package builder
+
+import "errors"
+
+// ErrNilSpec signals that a nil genesis spec has been provided
+var ErrNilSpec = errors.New("nil genesis spec")
+
+// ErrNilBundle signals that a nil genesis bundle has been provided
+var ErrNilBundle = errors.New("nil genesis bundle")
+
+// ErrNilPubkeyConverter signals that a nil public key converter has been provided
+var ErrNilPubkeyConverter = errors.New("nil public key converter")
+
+// ErrNilKeyGenerator signals that a nil key generator has been provided
+var ErrNilKeyGenerator = errors.New("nil key generator")
+
+// ErrInvalidNumberOfShards signals that an invalid number of shards has been provided
+var ErrInvalidNumberOfShards = errors.New("invalid number of shards")
+
+// ErrInvalidConsensusGroupSize signals that a consensus group size larger than the number of eligible nodes or equal
+// to 0 has been provided
+var ErrInvalidConsensusGroupSize = errors.New("invalid consensus group size")
+
+// ErrInvalidHysteresis signals that a hysteresis outside the [0, 1] interval has been provided
+var ErrInvalidHysteresis = errors.New("invalid hysteresis")
+
+// ErrInvalidValue signals that an invalid big integer value has been provided
+var ErrInvalidValue = errors.New("invalid value")
+
+// ErrInvalidShardID signals that an account is placed in a shard that does not exist
+var ErrInvalidShardID = errors.New("invalid shard ID")
+
+// ErrInvalidAddress signals that an invalid address has been provided
+var ErrInvalidAddress = errors.New("invalid address")
+
+// ErrDuplicatedDelegationContract signals that the same delegation contract name has been used more than once
+var ErrDuplicatedDelegationContract = errors.New("duplicated delegation contract")
+
+// ErrUnknownDelegationContract signals that an account delegates to a contract not defined in the spec
+var ErrUnknownDelegationContract = errors.New("unknown delegation contract")
+
+// ErrNumValidatorsMismatch signals that the validators owned by the accounts and the delegation contracts do not
+// fill the shards exactly
+var ErrNumValidatorsMismatch = errors.New("number of validators mismatch")
+
+// ErrTotalSupplyExceeded signals that the accounts hold more than the total supply
+var ErrTotalSupplyExceeded = errors.New("total supply exceeded")
+
+// ErrNumShardsMismatch signals that the generated nodes setup does not result in the requested number of shards
+var ErrNumShardsMismatch = errors.New("number of shards mismatch")
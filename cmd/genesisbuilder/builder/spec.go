@@ -0,0 +1,71 @@
+package builder
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/core"
+)
+
+const (
+	defaultDelegationFilename       = "./config/genesisContracts/delegation.wasm"
+	defaultDelegationInitParameters = "%validator_sc_address%@03E8@00@030D40@030D40"
+	defaultDelegationVersion        = "0.4.*"
+)
+
+// GeneralSpec holds the network wide settings of the genesis spec
+type GeneralSpec struct {
+	StartTime                 int64
+	RoundDuration             uint64
+	NumShards                 uint32
+	ValidatorsPerShard        uint32
+	MetaValidators            uint32
+	WaitingValidatorsPerShard uint32
+	ConsensusGroupSize        uint32
+	MetaConsensusGroupSize    uint32
+	Hysteresis                float32
+	Adaptivity                bool
+	TotalSupply               string
+	NodePrice                 string
+	TreasuryAddress           string
+	TreasuryShard             uint32
+}
+
+// AccountSpec holds a funded account of the genesis spec. An account with an empty address is generated in the
+// provided shard
+type AccountSpec struct {
+	Address        string
+	Shard          uint32
+	Balance        string
+	NumValidators  uint32
+	Delegation     string
+	DelegatedValue string
+}
+
+// DelegationSpec holds a delegation contract of the genesis spec. The contract is deployed by an owner generated in
+// the provided shard when the owner address is empty. The empty filename, init parameters and version default to the
+// ones of the standard delegation contract
+type DelegationSpec struct {
+	Name           string
+	Owner          string
+	Shard          uint32
+	NumValidators  uint32
+	Filename       string
+	InitParameters string
+	Version        string
+}
+
+// GenesisSpec holds the high level description of the genesis of a network
+type GenesisSpec struct {
+	General             GeneralSpec
+	Accounts            []AccountSpec
+	DelegationContracts []DelegationSpec
+}
+
+// LoadSpec reads a genesis spec from the provided toml file
+func LoadSpec(path string) (*GenesisSpec, error) {
+	spec := &GenesisSpec{}
+	err := core.LoadTomlFile(spec, path)
+	if err != nil {
+		return nil, err
+	}
+
+	return spec, nil
+}
@@ -0,0 +1,80 @@
+package builder
+
+import (
+	"fmt"
+	"math/big"
+	"path/filepath"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-crypto"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/genesis/checking"
+	"github.com/ElrondNetwork/elrond-go/genesis/parsing"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+var log = logger.GetOrCreate("genesisbuilder")
+
+// ArgsBundleValidation holds the arguments needed to validate the genesis files of a directory
+type ArgsBundleValidation struct {
+	Directory                string
+	NumShards                uint32
+	TotalSupply              *big.Int
+	NodePrice                *big.Int
+	AddressPubkeyConverter   core.PubkeyConverter
+	ValidatorPubkeyConverter core.PubkeyConverter
+	WalletKeyGenerator       crypto.KeyGenerator
+	ValidatorKeyGenerator    crypto.KeyGenerator
+}
+
+// ValidateBundle loads the genesis files of the directory with the same parsers and checkers the node uses at startup
+func ValidateBundle(args ArgsBundleValidation) error {
+	if check.IfNil(args.AddressPubkeyConverter) || check.IfNil(args.ValidatorPubkeyConverter) {
+		return ErrNilPubkeyConverter
+	}
+	if check.IfNil(args.WalletKeyGenerator) || check.IfNil(args.ValidatorKeyGenerator) {
+		return ErrNilKeyGenerator
+	}
+
+	accountsParser, err := parsing.NewAccountsParser(
+		filepath.Join(args.Directory, GenesisFilename),
+		args.TotalSupply,
+		args.AddressPubkeyConverter,
+		args.WalletKeyGenerator,
+	)
+	if err != nil {
+		return fmt.Errorf("%w while parsing %s", err, GenesisFilename)
+	}
+
+	nodesSetup, err := sharding.NewNodesSetup(
+		filepath.Join(args.Directory, NodesSetupFilename),
+		args.AddressPubkeyConverter,
+		args.ValidatorPubkeyConverter,
+		args.NumShards,
+	)
+	if err != nil {
+		return fmt.Errorf("%w while parsing %s", err, NodesSetupFilename)
+	}
+	if nodesSetup.NumberOfShards() != args.NumShards {
+		return fmt.Errorf("%w: requested %d, the nodes setup results in %d",
+			ErrNumShardsMismatch, args.NumShards, nodesSetup.NumberOfShards())
+	}
+
+	nodesSetupChecker, err := checking.NewNodesSetupChecker(
+		accountsParser,
+		args.NodePrice,
+		args.ValidatorPubkeyConverter,
+		args.ValidatorKeyGenerator,
+	)
+	if err != nil {
+		return err
+	}
+
+	err = nodesSetupChecker.Check(nodesSetup.AllInitialNodes())
+	if err != nil {
+		return fmt.Errorf("%w while checking %s against %s", err, NodesSetupFilename, GenesisFilename)
+	}
+
+	return nil
+}
@@ -0,0 +1,126 @@
+package builder
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+)
+
+const (
+	// GenesisFilename is the name of the generated initial accounts file
+	GenesisFilename = "genesis.json"
+	// NodesSetupFilename is the name of the generated initial nodes file
+	NodesSetupFilename = "nodesSetup.json"
+	// SmartContractsFilename is the name of the generated initial smart contracts file
+	SmartContractsFilename = "genesisSmartContracts.json"
+	// WalletKeysFilename is the name of the file holding the generated wallets keys
+	WalletKeysFilename = "walletKeys.pem"
+
+	validatorKeyFilename = "validatorKey.pem"
+	nodeFolderPattern    = "node-%d"
+	jsonIndent           = "  "
+)
+
+// ArgsBundleWriter holds the arguments needed to write a genesis bundle
+type ArgsBundleWriter struct {
+	Bundle                   *GenesisBundle
+	OutputDirectory          string
+	AddressPubkeyConverter   core.PubkeyConverter
+	ValidatorPubkeyConverter core.PubkeyConverter
+}
+
+// WriteBundle writes the genesis files and the generated wallets keys in the output directory. Each validator key is
+// written in a node-<index> sub-directory, as expected by the node, index being the position in the nodes setup
+func WriteBundle(args ArgsBundleWriter) error {
+	if args.Bundle == nil {
+		return ErrNilBundle
+	}
+	if check.IfNil(args.AddressPubkeyConverter) || check.IfNil(args.ValidatorPubkeyConverter) {
+		return ErrNilPubkeyConverter
+	}
+
+	err := os.MkdirAll(args.OutputDirectory, os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	err = writeJsonFile(filepath.Join(args.OutputDirectory, GenesisFilename), args.Bundle.Accounts)
+	if err != nil {
+		return err
+	}
+	err = writeJsonFile(filepath.Join(args.OutputDirectory, NodesSetupFilename), args.Bundle.NodesSetup)
+	if err != nil {
+		return err
+	}
+	err = writeJsonFile(filepath.Join(args.OutputDirectory, SmartContractsFilename), args.Bundle.SmartContracts)
+	if err != nil {
+		return err
+	}
+
+	walletKeysPem, err := encodeKeys(args.Bundle.WalletKeys, args.AddressPubkeyConverter)
+	if err != nil {
+		return err
+	}
+	err = writeFile(filepath.Join(args.OutputDirectory, WalletKeysFilename), walletKeysPem, core.FileModeUserReadWrite)
+	if err != nil {
+		return err
+	}
+
+	for idx, key := range args.Bundle.ValidatorKeys {
+		nodeFolder := filepath.Join(args.OutputDirectory, fmt.Sprintf(nodeFolderPattern, idx))
+		err = os.MkdirAll(nodeFolder, os.ModePerm)
+		if err != nil {
+			return err
+		}
+
+		validatorKeyPem, errEncode := encodeKeys([]*Key{key}, args.ValidatorPubkeyConverter)
+		if errEncode != nil {
+			return errEncode
+		}
+		err = writeFile(filepath.Join(nodeFolder, validatorKeyFilename), validatorKeyPem, core.FileModeUserReadWrite)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func writeJsonFile(path string, value interface{}) error {
+	buff, err := json.MarshalIndent(value, "", jsonIndent)
+	if err != nil {
+		return err
+	}
+
+	return writeFile(path, buff, core.FileModeReadWrite)
+}
+
+func writeFile(path string, content []byte, mode os.FileMode) error {
+	log.Info("writing", "file", path)
+
+	return ioutil.WriteFile(path, content, mode)
+}
+
+// encodeKeys encodes the keys in the same PEM format the keygenerator tool uses
+func encodeKeys(keys []*Key, pubkeyConverter core.PubkeyConverter) ([]byte, error) {
+	buff := bytes.NewBuffer(make([]byte, 0))
+	for _, key := range keys {
+		err := pem.Encode(buff, &pem.Block{
+			Type:  "PRIVATE KEY for " + pubkeyConverter.Encode(key.PublicKey),
+			Bytes: []byte(hex.EncodeToString(key.PrivateKey)),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return buff.Bytes(), nil
+}
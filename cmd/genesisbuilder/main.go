@@ -0,0 +1,170 @@
+package main
+
+import (
+	"os"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/pubkeyConverter"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/ed25519"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/mcl"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/cmd/genesisbuilder/builder"
+	"github.com/urfave/cli"
+)
+
+const (
+	addressLength = 32
+	blsPubkeyLen  = 96
+)
+
+var (
+	genesisBuilderHelpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// specFile defines a flag for the path to the genesis spec toml file
+	specFile = cli.StringFlag{
+		Name: "spec",
+		Usage: "The `filepath` for the genesis spec toml file, describing the shards, the funded accounts and the " +
+			"delegation contracts of the network.",
+		Value: "./genesisSpec.toml",
+	}
+	// outputDirectory defines a flag for the directory the genesis bundle is written to
+	outputDirectory = cli.StringFlag{
+		Name: "output-dir",
+		Usage: "The `directory` the genesis files, the generated wallets keys and the validators keys, one node-<index> " +
+			"sub-directory for each node, are written to.",
+		Value: "./genesis",
+	}
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,api:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the api package which will receive a DEBUG" +
+			" log level.",
+		Value: "*:" + logger.LogInfo.String(),
+	}
+)
+
+var log = logger.GetOrCreate("main")
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = genesisBuilderHelpTemplate
+	app.Name = "Genesis builder"
+	app.Version = "v1.0.0"
+	app.Usage = "This binary expands a genesis spec into a consistent genesis bundle: the genesis.json, nodesSetup.json " +
+		"and genesisSmartContracts.json files, the validators keys and the keys of the generated wallets. The " +
+		"generated files are checked with the same parsers the node uses at startup"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+	app.Flags = []cli.Flag{
+		specFile,
+		outputDirectory,
+		logLevel,
+	}
+
+	app.Action = func(c *cli.Context) error {
+		return buildGenesis(c)
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func buildGenesis(ctx *cli.Context) error {
+	err := logger.SetLogLevel(ctx.GlobalString(logLevel.Name))
+	if err != nil {
+		return err
+	}
+
+	spec, err := builder.LoadSpec(ctx.GlobalString(specFile.Name))
+	if err != nil {
+		return err
+	}
+
+	addressConverter, err := pubkeyConverter.NewBech32PubkeyConverter(addressLength, log)
+	if err != nil {
+		return err
+	}
+	validatorConverter, err := pubkeyConverter.NewHexPubkeyConverter(blsPubkeyLen)
+	if err != nil {
+		return err
+	}
+	walletKeyGenerator := signing.NewKeyGenerator(ed25519.NewEd25519())
+	validatorKeyGenerator := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+
+	genesisBuilder, err := builder.NewGenesisBuilder(builder.ArgsGenesisBuilder{
+		Spec:                     spec,
+		AddressPubkeyConverter:   addressConverter,
+		ValidatorPubkeyConverter: validatorConverter,
+		WalletKeyGenerator:       walletKeyGenerator,
+		ValidatorKeyGenerator:    validatorKeyGenerator,
+	})
+	if err != nil {
+		return err
+	}
+
+	bundle, err := genesisBuilder.Build()
+	if err != nil {
+		return err
+	}
+
+	outputDir := ctx.GlobalString(outputDirectory.Name)
+	err = builder.WriteBundle(builder.ArgsBundleWriter{
+		Bundle:                   bundle,
+		OutputDirectory:          outputDir,
+		AddressPubkeyConverter:   addressConverter,
+		ValidatorPubkeyConverter: validatorConverter,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = builder.ValidateBundle(builder.ArgsBundleValidation{
+		Directory:                outputDir,
+		NumShards:                spec.General.NumShards,
+		TotalSupply:              bundle.TotalSupply,
+		NodePrice:                bundle.NodePrice,
+		AddressPubkeyConverter:   addressConverter,
+		ValidatorPubkeyConverter: validatorConverter,
+		WalletKeyGenerator:       walletKeyGenerator,
+		ValidatorKeyGenerator:    validatorKeyGenerator,
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Info("genesis bundle created",
+		"directory", outputDir,
+		"accounts", len(bundle.Accounts),
+		"nodes", len(bundle.ValidatorKeys),
+		"delegation contracts", len(bundle.SmartContracts),
+	)
+	log.Info("the nodes configuration should use the same values",
+		"GenesisTotalSupply in economics.toml", bundle.TotalSupply.String(),
+		"GenesisNodePrice in systemSmartContractsConfig.toml", bundle.NodePrice.String(),
+		"GenesisMaxNumberOfShards in config.toml", spec.General.NumShards,
+	)
+
+	return nil
+}
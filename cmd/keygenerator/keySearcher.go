@@ -0,0 +1,237 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+// candidatesPerBatch is constant so the generated keys do not depend on the number of workers
+const candidatesPerBatch = 4096
+const progressBatchesInterval = 100
+const bech32Charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+const bech32AddressPrefix = "erd1"
+const bech32AddressDataLength = 58
+
+type pkMatcher func(pkBytes []byte) bool
+
+type argsKeySearcher struct {
+	keyGen     crypto.KeyGenerator
+	seed       []byte
+	domain     string
+	matcher    pkMatcher
+	numWorkers int
+}
+
+// keySearcher derives candidate keys from a seed and keeps the ones accepted by the matcher. The candidates are
+// indexed and checked in batches, so the same seed always produces the same keys, regardless of the number of workers
+type keySearcher struct {
+	keyGen     crypto.KeyGenerator
+	seed       []byte
+	domain     string
+	matcher    pkMatcher
+	numWorkers int
+	nextIndex  uint64
+}
+
+func newKeySearcher(args argsKeySearcher) (*keySearcher, error) {
+	if len(args.seed) == 0 {
+		return nil, fmt.Errorf("empty seed")
+	}
+	if args.numWorkers < 1 {
+		return nil, fmt.Errorf("number of workers should be a number greater or equal to 1")
+	}
+
+	return &keySearcher{
+		keyGen:     args.keyGen,
+		seed:       args.seed,
+		domain:     args.domain,
+		matcher:    args.matcher,
+		numWorkers: args.numWorkers,
+	}, nil
+}
+
+func (ks *keySearcher) generate(numKeys int) ([]key, error) {
+	keys := make([]key, 0, numKeys)
+	numBatches := 0
+	for len(keys) < numKeys {
+		found, err := ks.searchBatch()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, k := range found {
+			if len(keys) == numKeys {
+				break
+			}
+			keys = append(keys, k)
+		}
+
+		numBatches++
+		if numBatches%progressBatchesInterval == 0 {
+			log.Info("searching keys",
+				"type", ks.domain,
+				"checked candidates", ks.nextIndex,
+				"found", len(keys),
+				"requested", numKeys,
+			)
+		}
+	}
+
+	return keys, nil
+}
+
+func (ks *keySearcher) searchBatch() ([]key, error) {
+	results := make([]*key, candidatesPerBatch)
+	errs := make([]error, ks.numWorkers)
+	chunkSize := (candidatesPerBatch + ks.numWorkers - 1) / ks.numWorkers
+
+	wg := &sync.WaitGroup{}
+	for w := 0; w < ks.numWorkers; w++ {
+		start := w * chunkSize
+		end := start + chunkSize
+		if end > candidatesPerBatch {
+			end = candidatesPerBatch
+		}
+		if start >= end {
+			continue
+		}
+
+		wg.Add(1)
+		go func(worker int, start int, end int) {
+			defer wg.Done()
+
+			for i := start; i < end; i++ {
+				k, err := ks.checkCandidate(ks.nextIndex + uint64(i))
+				if err != nil {
+					errs[worker] = err
+					return
+				}
+
+				results[i] = k
+			}
+		}(w, start, end)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ks.nextIndex += candidatesPerBatch
+
+	found := make([]key, 0)
+	for _, k := range results {
+		if k != nil {
+			found = append(found, *k)
+		}
+	}
+
+	return found, nil
+}
+
+// checkCandidate returns nil if the candidate is not a valid key or it is not accepted by the matcher
+func (ks *keySearcher) checkCandidate(index uint64) (*key, error) {
+	sk, err := ks.keyGen.PrivateKeyFromByteArray(ks.candidateBytes(index))
+	if err != nil {
+		return nil, nil
+	}
+
+	pkBytes, err := sk.GeneratePublic().ToByteArray()
+	if err != nil {
+		return nil, err
+	}
+	if ks.matcher != nil && !ks.matcher(pkBytes) {
+		return nil, nil
+	}
+
+	skBytes, err := sk.ToByteArray()
+	if err != nil {
+		return nil, err
+	}
+
+	return &key{
+		skBytes: skBytes,
+		pkBytes: pkBytes,
+	}, nil
+}
+
+func (ks *keySearcher) candidateBytes(index uint64) []byte {
+	indexBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(indexBytes, index)
+
+	h := sha256.New()
+	_, _ = h.Write(ks.seed)
+	_, _ = h.Write([]byte(ks.domain))
+	_, _ = h.Write(indexBytes)
+
+	return h.Sum(nil)
+}
+
+func createWalletMatcher(
+	converter core.PubkeyConverter,
+	numShards uint32,
+	shardID int,
+	prefix string,
+	suffix string,
+) (pkMatcher, error) {
+	if shardID < 0 && len(prefix)+len(suffix) == 0 {
+		return nil, nil
+	}
+
+	prefix, err := normalizeBech32Pattern(prefix)
+	if err != nil {
+		return nil, err
+	}
+	suffix, err = normalizeBech32Pattern(suffix)
+	if err != nil {
+		return nil, err
+	}
+
+	var shardCoordinator sharding.Coordinator
+	if shardID >= 0 {
+		if uint32(shardID) >= numShards {
+			return nil, fmt.Errorf("shard %d should be lower than the number of shards %d", shardID, numShards)
+		}
+
+		shardCoordinator, err = sharding.NewMultiShardCoordinator(numShards, uint32(shardID))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return func(pkBytes []byte) bool {
+		if shardCoordinator != nil && shardCoordinator.ComputeId(pkBytes) != shardCoordinator.SelfId() {
+			return false
+		}
+		if len(prefix)+len(suffix) == 0 {
+			return true
+		}
+
+		address := converter.Encode(pkBytes)
+
+		return strings.HasPrefix(address, bech32AddressPrefix+prefix) && strings.HasSuffix(address, suffix)
+	}, nil
+}
+
+func normalizeBech32Pattern(pattern string) (string, error) {
+	pattern = strings.ToLower(pattern)
+	if len(pattern) > bech32AddressDataLength {
+		return "", fmt.Errorf("pattern %s is longer than %d characters", pattern, bech32AddressDataLength)
+	}
+	for _, c := range pattern {
+		if !strings.ContainsRune(bech32Charset, c) {
+			return "", fmt.Errorf("invalid character %q in pattern %s, allowed characters: %s", c, pattern, bech32Charset)
+		}
+	}
+
+	return pattern, nil
+}
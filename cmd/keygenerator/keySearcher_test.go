@@ -0,0 +1,99 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-crypto/signing"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/ed25519"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestKeySearcher(t *testing.T, seed string, matcher pkMatcher, numWorkers int) *keySearcher {
+	searcher, err := newKeySearcher(argsKeySearcher{
+		keyGen:     signing.NewKeyGenerator(ed25519.NewEd25519()),
+		seed:       []byte(seed),
+		domain:     walletType,
+		matcher:    matcher,
+		numWorkers: numWorkers,
+	})
+	require.Nil(t, err)
+
+	return searcher
+}
+
+func TestNewKeySearcher_InvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	searcher, err := newKeySearcher(argsKeySearcher{
+		keyGen:     signing.NewKeyGenerator(ed25519.NewEd25519()),
+		numWorkers: 1,
+	})
+	assert.Nil(t, searcher)
+	assert.NotNil(t, err)
+
+	searcher, err = newKeySearcher(argsKeySearcher{
+		keyGen: signing.NewKeyGenerator(ed25519.NewEd25519()),
+		seed:   []byte("seed"),
+	})
+	assert.Nil(t, searcher)
+	assert.NotNil(t, err)
+}
+
+func TestKeySearcher_GenerateIsDeterministicRegardlessOfWorkers(t *testing.T) {
+	t.Parallel()
+
+	matcher, err := createWalletMatcher(walletPubKeyConverter, 3, 2, "", "")
+	require.Nil(t, err)
+
+	keys1, err := createTestKeySearcher(t, "seed", matcher, 1).generate(5)
+	require.Nil(t, err)
+	keys7, err := createTestKeySearcher(t, "seed", matcher, 7).generate(5)
+	require.Nil(t, err)
+	assert.Equal(t, keys1, keys7)
+
+	otherKeys, err := createTestKeySearcher(t, "other seed", matcher, 7).generate(5)
+	require.Nil(t, err)
+	assert.NotEqual(t, keys1, otherKeys)
+}
+
+func TestKeySearcher_GenerateShouldMatchShardAndPattern(t *testing.T) {
+	t.Parallel()
+
+	matcher, err := createWalletMatcher(walletPubKeyConverter, 3, 1, "Q", "p")
+	require.Nil(t, err)
+
+	shardCoordinator, _ := sharding.NewMultiShardCoordinator(3, 0)
+	keys, err := createTestKeySearcher(t, "seed", matcher, 4).generate(2)
+	require.Nil(t, err)
+	require.Equal(t, 2, len(keys))
+
+	for _, k := range keys {
+		address := walletPubKeyConverter.Encode(k.pkBytes)
+		assert.Equal(t, uint32(1), shardCoordinator.ComputeId(k.pkBytes))
+		assert.True(t, strings.HasPrefix(address, "erd1q"))
+		assert.True(t, strings.HasSuffix(address, "p"))
+	}
+}
+
+func TestCreateWalletMatcher(t *testing.T) {
+	t.Parallel()
+
+	matcher, err := createWalletMatcher(walletPubKeyConverter, 3, -1, "", "")
+	assert.Nil(t, err)
+	assert.Nil(t, matcher)
+
+	_, err = createWalletMatcher(walletPubKeyConverter, 3, 3, "", "")
+	assert.NotNil(t, err)
+
+	_, err = createWalletMatcher(walletPubKeyConverter, 3, -1, "b", "")
+	assert.NotNil(t, err)
+
+	_, err = createWalletMatcher(walletPubKeyConverter, 3, -1, "", "i")
+	assert.NotNil(t, err)
+
+	_, err = createWalletMatcher(walletPubKeyConverter, 3, -1, strings.Repeat("q", bech32AddressDataLength+1), "")
+	assert.NotNil(t, err)
+}
@@ -2,12 +2,15 @@ package main
 
 import (
 	"bytes"
+	"crypto/rand"
 	"encoding/hex"
 	"encoding/pem"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"os"
 	"path/filepath"
+	"runtime"
 	"strings"
 	"time"
 
@@ -19,14 +22,25 @@ import (
 	"github.com/ElrondNetwork/elrond-go-crypto/signing/ed25519"
 	"github.com/ElrondNetwork/elrond-go-crypto/signing/mcl"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/keystore"
 	"github.com/urfave/cli"
 )
 
 type cfg struct {
-	numKeys    int
-	keyType    string
-	consoleOut bool
-	noSplit    bool
+	numKeys        int
+	keyType        string
+	consoleOut     bool
+	noSplit        bool
+	encrypt        bool
+	kdf            string
+	passphraseFile string
+	convertPemFile string
+	shard          int
+	numShards      uint
+	prefix         string
+	suffix         string
+	numWorkers     int
+	seed           string
 }
 
 const validatorType = "validator"
@@ -39,6 +53,7 @@ type key struct {
 }
 
 const keysFolderPattern = "node-%d"
+const keystoreExtension = ".keystore"
 const blsPubkeyLen = 96
 const txSignPubkeyLen = 32
 
@@ -89,11 +104,90 @@ VERSION:
 		Usage:       "Boolean option that will make each generated key added in the same file",
 		Destination: &argsConfig.noSplit,
 	}
+	// encrypt is the flag that, if active, will save the generated keys in encrypted keystores
+	encrypt = cli.BoolFlag{
+		Name: "encrypt",
+		Usage: "Boolean option that will save the generated keys in password protected keystores instead of plain " +
+			"text PEM files",
+		Destination: &argsConfig.encrypt,
+	}
+	// kdf defines a flag for setting the key derivation function used by the keystores
+	kdf = cli.StringFlag{
+		Name: "kdf",
+		Usage: fmt.Sprintf(
+			"The key derivation function used to protect the keystores. Available options: %s, %s",
+			keystore.KDFScrypt,
+			keystore.KDFArgon2id),
+		Value:       keystore.KDFScrypt,
+		Destination: &argsConfig.kdf,
+	}
+	// passphraseFile defines a flag for the path to the file holding the keystore passphrase
+	passphraseFile = cli.StringFlag{
+		Name: "passphrase-file",
+		Usage: "The `filepath` of the file holding the keystore passphrase. The passphrase can also be provided " +
+			"through the " + keystore.EnvPassphraseVariable + " environment variable, otherwise it will be asked for",
+		Destination: &argsConfig.passphraseFile,
+	}
+	// convertPemFile defines a flag for the path of an existing plain text PEM file to be converted into a keystore
+	convertPemFile = cli.StringFlag{
+		Name: "convert-pem-file",
+		Usage: "The `filepath` of an existing plain text PEM file to convert into a password protected keystore. " +
+			"The keystore is written next to it, with the " + keystoreExtension + " extension",
+		Destination: &argsConfig.convertPemFile,
+	}
+	// shard defines a flag for setting the shard the generated wallet keys should belong to
+	shard = cli.IntFlag{
+		Name: "shard",
+		Usage: "The shard the generated wallet keys should belong to, computed for the number of shards set by " +
+			"the num-shards flag. Negative values will not restrict the shard",
+		Value:       -1,
+		Destination: &argsConfig.shard,
+	}
+	// numShards defines a flag for setting the number of shards used when computing the shard of a wallet key
+	numShards = cli.UintFlag{
+		Name:        "num-shards",
+		Usage:       "The number of shards used when computing the shard of a wallet key",
+		Value:       3,
+		Destination: &argsConfig.numShards,
+	}
+	// prefix defines a flag for setting the bech32 pattern the generated wallet addresses should start with
+	prefix = cli.StringFlag{
+		Name: "prefix",
+		Usage: "The bech32 `pattern` the generated wallet addresses should start with, after the " +
+			bech32AddressPrefix + " part. Each extra character makes the search about 32 times longer",
+		Destination: &argsConfig.prefix,
+	}
+	// suffix defines a flag for setting the bech32 pattern the generated wallet addresses should end with
+	suffix = cli.StringFlag{
+		Name: "suffix",
+		Usage: "The bech32 `pattern` the generated wallet addresses should end with. Each extra character makes " +
+			"the search about 32 times longer",
+		Destination: &argsConfig.suffix,
+	}
+	// numWorkers defines a flag for setting how many workers search the keys in parallel
+	numWorkers = cli.IntFlag{
+		Name:        "workers",
+		Usage:       "How many workers should search the keys in parallel. 0 will start a worker for each available CPU",
+		Value:       0,
+		Destination: &argsConfig.numWorkers,
+	}
+	// seed defines a flag for setting the seed the keys are derived from
+	seed = cli.StringFlag{
+		Name: "seed",
+		Usage: "The `seed` the keys are derived from. The same seed and options will always generate the same " +
+			"keys, regardless of the number of workers. To be used only for test fixtures, as anyone knowing the " +
+			"seed can recreate the keys",
+		Destination: &argsConfig.seed,
+	}
 
 	argsConfig = &cfg{}
 
-	walletKeyFilenameTemplate    = "walletKey%s.pem"
-	validatorKeyFilenameTemplate = "validatorKey%s.pem"
+	walletKeyFilenameTemplate         = "walletKey%s.pem"
+	validatorKeyFilenameTemplate      = "validatorKey%s.pem"
+	walletKeystoreFilenameTemplate    = "walletKey%s" + keystoreExtension
+	validatorKeystoreFilenameTemplate = "validatorKey%s" + keystoreExtension
+
+	passphraseProvider keystore.PassphraseProvider
 
 	log = logger.GetOrCreate("keygenerator")
 
@@ -106,7 +200,8 @@ func main() {
 	cli.AppHelpTemplate = fileGenHelpTemplate
 	app.Name = "Key generation Tool"
 	app.Version = "v1.0.0"
-	app.Usage = "This binary will generate a validatorKey.pem and walletKey.pem, each containing private key(s)"
+	app.Usage = "This binary will generate a validatorKey.pem and walletKey.pem, each containing private key(s). " +
+		"The keys can also be saved in password protected keystores"
 	app.Authors = []cli.Author{
 		{
 			Name:  "The Elrond Team",
@@ -118,6 +213,16 @@ func main() {
 		keyType,
 		consoleOut,
 		noSplit,
+		encrypt,
+		kdf,
+		passphraseFile,
+		convertPemFile,
+		shard,
+		numShards,
+		prefix,
+		suffix,
+		numWorkers,
+		seed,
 	}
 
 	app.Action = func(_ *cli.Context) error {
@@ -133,6 +238,19 @@ func main() {
 }
 
 func process() error {
+	passphraseProvider = keystore.NewPassphraseProvider(keystore.ArgsPassphraseProvider{
+		EnvVariable:    keystore.EnvPassphraseVariable,
+		PassphraseFile: argsConfig.passphraseFile,
+		ConfirmPrompt:  true,
+	})
+
+	if len(argsConfig.convertPemFile) > 0 {
+		return convertToKeystore(argsConfig.convertPemFile)
+	}
+	if argsConfig.encrypt && argsConfig.consoleOut {
+		return fmt.Errorf("the encrypted keystores can not be printed on the console")
+	}
+
 	validatorKeys, walletKeys, err := generateKeys(argsConfig.keyType, argsConfig.numKeys)
 	if err != nil {
 		return err
@@ -146,64 +264,85 @@ func generateKeys(typeKey string, numKeys int) ([]key, []key, error) {
 		return nil, nil, fmt.Errorf("number of keys should be a number greater or equal to 1")
 	}
 
-	validatorKeys := make([]key, 0)
-	walletKeys := make([]key, 0)
-	var err error
+	shouldGenerateValidatorKeys := typeKey == validatorType || typeKey == bothType
+	shouldGenerateWalletKeys := typeKey == walletType || typeKey == bothType
+	if !shouldGenerateValidatorKeys && !shouldGenerateWalletKeys {
+		return nil, nil, fmt.Errorf("unknown key type %s", argsConfig.keyType)
+	}
 
-	blockSigningGenerator := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
-	txSigningGenerator := signing.NewKeyGenerator(ed25519.NewEd25519())
+	walletMatcher, err := createWalletMatcher(
+		walletPubKeyConverter,
+		uint32(argsConfig.numShards),
+		argsConfig.shard,
+		argsConfig.prefix,
+		argsConfig.suffix,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	if walletMatcher != nil && !shouldGenerateWalletKeys {
+		return nil, nil, fmt.Errorf("the shard, prefix and suffix options can only be used with wallet keys")
+	}
 
-	for i := 0; i < numKeys; i++ {
-		switch typeKey {
-		case validatorType:
-			validatorKeys, err = generateKey(blockSigningGenerator, validatorKeys)
-			if err != nil {
-				return nil, nil, err
-			}
-		case walletType:
-			walletKeys, err = generateKey(txSigningGenerator, walletKeys)
-			if err != nil {
-				return nil, nil, err
-			}
-		case bothType:
-			validatorKeys, err = generateKey(blockSigningGenerator, validatorKeys)
-			if err != nil {
-				return nil, nil, err
-			}
+	seedBytes, err := getSeed()
+	if err != nil {
+		return nil, nil, err
+	}
 
-			walletKeys, err = generateKey(txSigningGenerator, walletKeys)
-			if err != nil {
-				return nil, nil, err
-			}
-		default:
-			return nil, nil, fmt.Errorf("unknown key type %s", argsConfig.keyType)
+	validatorKeys := make([]key, 0)
+	walletKeys := make([]key, 0)
+
+	if shouldGenerateValidatorKeys {
+		blockSigningGenerator := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+		validatorKeys, err = searchKeys(blockSigningGenerator, seedBytes, validatorType, nil, numKeys)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	if shouldGenerateWalletKeys {
+		txSigningGenerator := signing.NewKeyGenerator(ed25519.NewEd25519())
+		walletKeys, err = searchKeys(txSigningGenerator, seedBytes, walletType, walletMatcher, numKeys)
+		if err != nil {
+			return nil, nil, err
 		}
 	}
 
 	return validatorKeys, walletKeys, nil
 }
 
-func generateKey(keyGen crypto.KeyGenerator, list []key) ([]key, error) {
-	sk, pk := keyGen.GeneratePair()
-	skBytes, err := sk.ToByteArray()
+func getSeed() ([]byte, error) {
+	if len(argsConfig.seed) > 0 {
+		log.Warn("the keys are derived from the provided seed, they should be used only for testing purposes")
+		return []byte(argsConfig.seed), nil
+	}
+
+	seedBytes := make([]byte, 32)
+	_, err := rand.Read(seedBytes)
 	if err != nil {
 		return nil, err
 	}
 
-	pkBytes, err := pk.ToByteArray()
+	return seedBytes, nil
+}
+
+func searchKeys(keyGen crypto.KeyGenerator, seedBytes []byte, domain string, matcher pkMatcher, numKeys int) ([]key, error) {
+	workers := argsConfig.numWorkers
+	if workers == 0 {
+		workers = runtime.NumCPU()
+	}
+
+	searcher, err := newKeySearcher(argsKeySearcher{
+		keyGen:     keyGen,
+		seed:       seedBytes,
+		domain:     domain,
+		matcher:    matcher,
+		numWorkers: workers,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	list = append(
-		list,
-		key{
-			skBytes: skBytes,
-			pkBytes: pkBytes,
-		},
-	)
-
-	return list, nil
+	return searcher.generate(numKeys)
 }
 
 func outputKeys(
@@ -278,15 +417,22 @@ func saveKeys(validatorKeys []key, walletKeys []key, noSplit bool) error {
 		return fmt.Errorf("internal error: no keys to save")
 	}
 
+	validatorTemplate := validatorKeyFilenameTemplate
+	walletTemplate := walletKeyFilenameTemplate
+	if argsConfig.encrypt {
+		validatorTemplate = validatorKeystoreFilenameTemplate
+		walletTemplate = walletKeystoreFilenameTemplate
+	}
+
 	var errFound error
 	if len(validatorKeys) > 0 {
-		err := saveSliceKeys(validatorKeyFilenameTemplate, validatorKeys, validatorPubKeyConverter, noSplit)
+		err := saveSliceKeys(validatorTemplate, validatorKeys, validatorPubKeyConverter, noSplit)
 		if err != nil {
 			errFound = err
 		}
 	}
 	if len(walletKeys) > 0 {
-		err := saveSliceKeys(walletKeyFilenameTemplate, walletKeys, walletPubKeyConverter, noSplit)
+		err := saveSliceKeys(walletTemplate, walletKeys, walletPubKeyConverter, noSplit)
 		if err != nil {
 			errFound = err
 		}
@@ -296,33 +442,101 @@ func saveKeys(validatorKeys []key, walletKeys []key, noSplit bool) error {
 }
 
 func saveSliceKeys(baseFilenameTemplate string, keys []key, pubkeyConverter core.PubkeyConverter, noSplit bool) error {
-	var file *os.File
-	var err error
+	buff := bytes.NewBuffer(make([]byte, 0))
 	for i, k := range keys {
-		shouldCreateFile := !noSplit || i == 0
-		if shouldCreateFile {
-			file, err = generateFile(i, len(keys), noSplit, baseFilenameTemplate)
-			if err != nil {
-				return err
-			}
-		}
-
-		err = writeKeyToStream(file, k, pubkeyConverter)
+		err := writeKeyToStream(buff, k, pubkeyConverter)
 		if err != nil {
 			return err
 		}
 		if !noSplit {
-			err = file.Close()
+			err = saveFile(i, len(keys), noSplit, baseFilenameTemplate, buff.Bytes())
 			if err != nil {
 				return err
 			}
+			buff.Reset()
 		}
 	}
 
 	if noSplit {
-		return file.Close()
+		return saveFile(0, len(keys), noSplit, baseFilenameTemplate, buff.Bytes())
+	}
+
+	return nil
+}
+
+func saveFile(index int, numKeys int, noSplit bool, baseFilenameTemplate string, pemContent []byte) error {
+	content, err := encodeFileContent(pemContent)
+	if err != nil {
+		return err
+	}
+
+	file, err := generateFile(index, numKeys, noSplit, baseFilenameTemplate)
+	if err != nil {
+		return err
 	}
 
+	_, err = file.Write(content)
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+func encodeFileContent(pemContent []byte) ([]byte, error) {
+	if !argsConfig.encrypt {
+		return pemContent, nil
+	}
+
+	passphrase, err := passphraseProvider.Passphrase()
+	if err != nil {
+		return nil, err
+	}
+
+	return keystore.Encrypt(pemContent, passphrase, argsConfig.kdf)
+}
+
+func convertToKeystore(pemFile string) error {
+	pemContent, err := ioutil.ReadFile(pemFile)
+	if err != nil {
+		return err
+	}
+	if keystore.IsKeystore(pemContent) {
+		return fmt.Errorf("%s is already an encrypted keystore", pemFile)
+	}
+	block, _ := pem.Decode(pemContent)
+	if block == nil {
+		return fmt.Errorf("%s is not a valid PEM file", pemFile)
+	}
+
+	passphrase, err := passphraseProvider.Passphrase()
+	if err != nil {
+		return err
+	}
+
+	content, err := keystore.Encrypt(pemContent, passphrase, argsConfig.kdf)
+	if err != nil {
+		return err
+	}
+
+	keystoreBaseFilename := strings.TrimSuffix(pemFile, filepath.Ext(pemFile))
+	keystoreFilename := keystoreBaseFilename + keystoreExtension
+	if _, errStat := os.Stat(keystoreFilename); errStat == nil {
+		backupFilename := keystoreBaseFilename + fmt.Sprintf("_%d", time.Now().Unix()) + keystoreExtension
+		_ = os.Rename(keystoreFilename, backupFilename)
+	}
+
+	err = ioutil.WriteFile(keystoreFilename, content, core.FileModeReadWrite)
+	if err != nil {
+		return err
+	}
+
+	log.Info("keystore created, the plain text PEM file should now be securely removed",
+		"keystore", keystoreFilename,
+		"plain text file", pemFile,
+	)
+
 	return nil
 }
 
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net/http"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/lightclient"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/gin-gonic/gin"
+)
+
+type syncHandler interface {
+	Sync() error
+	Status() lightclient.Status
+}
+
+type accountGetter interface {
+	GetAccount(address []byte) (state.UserAccountHandler, error)
+}
+
+type notarizedShardHeaderResponse struct {
+	ShardID    uint32 `json:"shardID"`
+	Nonce      uint64 `json:"nonce"`
+	HeaderHash string `json:"headerHash"`
+	RootHash   string `json:"rootHash"`
+}
+
+type statusResponse struct {
+	Epoch                  uint32                         `json:"epoch"`
+	Nonce                  uint64                         `json:"nonce"`
+	Round                  uint64                         `json:"round"`
+	HeaderHash             string                         `json:"headerHash"`
+	RootHash               string                         `json:"rootHash"`
+	LastEpochStartHash     string                         `json:"lastEpochStartHash"`
+	NotarizedShardsHeaders []notarizedShardHeaderResponse `json:"notarizedShardsHeaders"`
+}
+
+type accountResponse struct {
+	Address         string `json:"address"`
+	Nonce           uint64 `json:"nonce"`
+	Balance         string `json:"balance"`
+	Username        string `json:"username"`
+	CodeHash        []byte `json:"codeHash"`
+	RootHash        []byte `json:"rootHash"`
+	DeveloperReward string `json:"developerReward"`
+	OwnerAddress    string `json:"ownerAddress"`
+}
+
+func createRouter(lc lightClientHandler, addressPubkeyConverter core.PubkeyConverter) http.Handler {
+	gin.SetMode(gin.ReleaseMode)
+	router := gin.New()
+	router.Use(gin.Recovery())
+
+	router.GET("/status", func(c *gin.Context) {
+		shared.RespondWith(c, http.StatusOK, gin.H{"status": createStatusResponse(lc.Status())}, "", shared.ReturnCodeSuccess)
+	})
+	router.GET("/account/:address", func(c *gin.Context) {
+		getAccount(c, lc, addressPubkeyConverter)
+	})
+
+	return router
+}
+
+func createStatusResponse(status lightclient.Status) *statusResponse {
+	response := &statusResponse{
+		Epoch:                  status.Epoch,
+		Nonce:                  status.Nonce,
+		Round:                  status.Round,
+		HeaderHash:             hex.EncodeToString(status.HeaderHash),
+		RootHash:               hex.EncodeToString(status.RootHash),
+		LastEpochStartHash:     hex.EncodeToString(status.LastEpochStartHash),
+		NotarizedShardsHeaders: make([]notarizedShardHeaderResponse, 0, len(status.NotarizedShardsHeaders)),
+	}
+	for _, notarized := range status.NotarizedShardsHeaders {
+		response.NotarizedShardsHeaders = append(response.NotarizedShardsHeaders, notarizedShardHeaderResponse{
+			ShardID:    notarized.ShardID,
+			Nonce:      notarized.Nonce,
+			HeaderHash: hex.EncodeToString(notarized.HeaderHash),
+			RootHash:   hex.EncodeToString(notarized.RootHash),
+		})
+	}
+
+	return response
+}
+
+func getAccount(c *gin.Context, lc accountGetter, addressPubkeyConverter core.PubkeyConverter) {
+	address := c.Param("address")
+	addressBytes, err := addressPubkeyConverter.Decode(address)
+	if err != nil {
+		shared.RespondWithValidationError(c, fmt.Sprintf("invalid address: %s", err.Error()))
+		return
+	}
+
+	account, err := lc.GetAccount(addressBytes)
+	if err != nil {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), shared.ReturnCodeInternalError)
+		return
+	}
+	if account == nil {
+		shared.RespondWith(c, http.StatusNotFound, nil, "account not found", shared.ReturnCodeRequestError)
+		return
+	}
+
+	ownerAddress := ""
+	if len(account.GetOwnerAddress()) > 0 {
+		ownerAddress = addressPubkeyConverter.Encode(account.GetOwnerAddress())
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"account": accountResponse{
+		Address:         address,
+		Nonce:           account.GetNonce(),
+		Balance:         account.GetBalance().String(),
+		Username:        string(account.GetUserName()),
+		CodeHash:        account.GetCodeHash(),
+		RootHash:        account.GetRootHash(),
+		DeveloperReward: account.GetDeveloperReward().String(),
+		OwnerAddress:    ownerAddress,
+	}}, "", shared.ReturnCodeSuccess)
+}
@@ -0,0 +1,286 @@
+package main
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/nodetype"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/endProcess"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/hashing/blake2b"
+	hasherFactory "github.com/ElrondNetwork/elrond-go-core/hashing/factory"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	marshalizerFactory "github.com/ElrondNetwork/elrond-go-core/marshal/factory"
+	crypto "github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/mcl"
+	mclMultiSig "github.com/ElrondNetwork/elrond-go-crypto/signing/mcl/multisig"
+	mclSig "github.com/ElrondNetwork/elrond-go-crypto/signing/mcl/singlesig"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/multisig"
+	"github.com/ElrondNetwork/elrond-go/common"
+	commonFactory "github.com/ElrondNetwork/elrond-go/common/factory"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/epochStart/bootstrap/disabled"
+	"github.com/ElrondNetwork/elrond-go/lightclient"
+	"github.com/ElrondNetwork/elrond-go/process/headerCheck"
+	"github.com/ElrondNetwork/elrond-go/process/rating"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/urfave/cli"
+)
+
+const consensusGroupCacheSize = 50
+
+type lightClientComponents struct {
+	lightClient            lightClientHandler
+	addressPubkeyConverter core.PubkeyConverter
+}
+
+type lightClientHandler interface {
+	syncHandler
+	accountGetter
+}
+
+// noFallbackHeaderValidator never allows the fallback validation, so the epoch start blocks signed only by the
+// fallback threshold of the consensus group are rejected
+type noFallbackHeaderValidator struct {
+}
+
+// ShouldApplyFallbackValidation returns false
+func (nfhv *noFallbackHeaderValidator) ShouldApplyFallbackValidation(_ data.HeaderHandler) bool {
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (nfhv *noFallbackHeaderValidator) IsInterfaceNil() bool {
+	return nfhv == nil
+}
+
+func createComponents(ctx *cli.Context, shardObserversURLs map[uint32]string) (*lightClientComponents, error) {
+	generalConfig, err := common.LoadMainConfig(ctx.GlobalString(configurationFile.Name))
+	if err != nil {
+		return nil, err
+	}
+	ratingsConfig, err := common.LoadRatingsConfig(ctx.GlobalString(ratingsConfigFile.Name))
+	if err != nil {
+		return nil, err
+	}
+	epochConfig, err := common.LoadEpochConfig(ctx.GlobalString(epochConfigFile.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	hasher, err := hasherFactory.NewHasher(generalConfig.Hasher.Type)
+	if err != nil {
+		return nil, err
+	}
+	marshalizer, err := marshalizerFactory.NewMarshalizer(generalConfig.Marshalizer.Type)
+	if err != nil {
+		return nil, err
+	}
+	addressPubkeyConverter, err := commonFactory.NewPubkeyConverter(generalConfig.AddressPubkeyConverter)
+	if err != nil {
+		return nil, err
+	}
+	validatorPubkeyConverter, err := commonFactory.NewPubkeyConverter(generalConfig.ValidatorPubkeyConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	nodesSetup, err := sharding.NewNodesSetup(
+		ctx.GlobalString(nodesFile.Name),
+		addressPubkeyConverter,
+		validatorPubkeyConverter,
+		generalConfig.GeneralSettings.GenesisMaxNumberOfShards,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	keyGen := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	privateKey, publicKey := keyGen.GeneratePair()
+	publicKeyBytes, err := publicKey.ToByteArray()
+	if err != nil {
+		return nil, err
+	}
+
+	nodesCoordinator, err := createNodesCoordinator(
+		nodesSetup,
+		ratingsConfig,
+		epochConfig,
+		marshalizer,
+		hasher,
+		publicKeyBytes,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	multiSigner, err := createMultiSigner(keyGen, privateKey, publicKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	headerSigVerifier, err := headerCheck.NewHeaderSigVerifier(&headerCheck.ArgsHeaderSigVerifier{
+		Marshalizer:             marshalizer,
+		Hasher:                  hasher,
+		NodesCoordinator:        nodesCoordinator,
+		MultiSigVerifier:        multiSigner,
+		SingleSigVerifier:       &mclSig.BlsSingleSigner{},
+		KeyGen:                  keyGen,
+		FallbackHeaderValidator: &noFallbackHeaderValidator{},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	versionsCache, err := storageUnit.NewCache(storageFactory.GetCacherFromConfig(generalConfig.Versions.Cache))
+	if err != nil {
+		return nil, err
+	}
+	headerIntegrityVerifier, err := headerCheck.NewHeaderIntegrityVerifier(
+		[]byte(generalConfig.GeneralSettings.ChainID),
+		generalConfig.Versions.VersionsByEpochs,
+		generalConfig.Versions.DefaultVersion,
+		versionsCache,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	shardCoordinator, err := sharding.NewMultiShardCoordinator(nodesSetup.NumberOfShards(), core.MetachainShardId)
+	if err != nil {
+		return nil, err
+	}
+
+	dataProvider, err := lightclient.NewHTTPDataProvider(lightclient.ArgsHTTPDataProvider{
+		MetaObserverURL:        ctx.GlobalString(metaObserver.Name),
+		ShardObserversURLs:     shardObserversURLs,
+		AddressPubkeyConverter: addressPubkeyConverter,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	lc, err := lightclient.NewLightClient(lightclient.ArgsLightClient{
+		DataProvider:            dataProvider,
+		NodesCoordinator:        nodesCoordinator,
+		HeaderSigVerifier:       headerSigVerifier,
+		HeaderIntegrityVerifier: headerIntegrityVerifier,
+		ShardCoordinator:        shardCoordinator,
+		Marshalizer:             marshalizer,
+		Hasher:                  hasher,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &lightClientComponents{
+		lightClient:            lc,
+		addressPubkeyConverter: addressPubkeyConverter,
+	}, nil
+}
+
+// createNodesCoordinator creates a metachain observer nodes coordinator starting from the genesis nodes setup. The
+// light client advances it with each verified epoch start block
+func createNodesCoordinator(
+	nodesSetup *sharding.NodesSetup,
+	ratingsConfig *config.RatingsConfig,
+	epochConfig *config.EpochConfig,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+	selfPublicKey []byte,
+) (nodesCoordinatorHandler, error) {
+	ratingsData, err := rating.NewRatingsData(rating.RatingsDataArg{
+		Config:                   *ratingsConfig,
+		ShardConsensusSize:       nodesSetup.ConsensusGroupSize,
+		MetaConsensusSize:        nodesSetup.MetaChainConsensusGroupSize,
+		ShardMinNodes:            nodesSetup.MinNodesPerShard,
+		MetaMinNodes:             nodesSetup.MetaChainMinNodes,
+		RoundDurationMiliseconds: nodesSetup.RoundDuration,
+	})
+	if err != nil {
+		return nil, err
+	}
+	rater, err := rating.NewBlockSigningRater(ratingsData)
+	if err != nil {
+		return nil, err
+	}
+
+	nodesShuffler, err := sharding.NewHashValidatorsShuffler(&sharding.NodesShufflerArgs{
+		NodesShard:                     nodesSetup.MinNumberOfShardNodes(),
+		NodesMeta:                      nodesSetup.MinNumberOfMetaNodes(),
+		Hysteresis:                     nodesSetup.GetHysteresis(),
+		Adaptivity:                     nodesSetup.GetAdaptivity(),
+		ShuffleBetweenShards:           true,
+		MaxNodesEnableConfig:           epochConfig.EnableEpochs.MaxNodesChangeEnableEpoch,
+		BalanceWaitingListsEnableEpoch: epochConfig.EnableEpochs.BalanceWaitingListsEnableEpoch,
+		WaitingListFixEnableEpoch:      epochConfig.EnableEpochs.WaitingListFixEnableEpoch,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	eligibleNodesInfo, waitingNodesInfo := nodesSetup.InitialNodesInfo()
+	eligibleValidators, err := sharding.NodesInfoToValidators(eligibleNodesInfo)
+	if err != nil {
+		return nil, err
+	}
+	waitingValidators, err := sharding.NodesInfoToValidators(waitingNodesInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	consensusGroupCache, err := lrucache.NewCache(consensusGroupCacheSize)
+	if err != nil {
+		return nil, err
+	}
+
+	baseNodesCoordinator, err := sharding.NewIndexHashedNodesCoordinator(sharding.ArgNodesCoordinator{
+		ShardConsensusGroupSize:    int(nodesSetup.GetShardConsensusGroupSize()),
+		MetaConsensusGroupSize:     int(nodesSetup.GetMetaConsensusGroupSize()),
+		Marshalizer:                marshalizer,
+		Hasher:                     hasher,
+		Shuffler:                   nodesShuffler,
+		EpochStartNotifier:         &disabled.EpochStartNotifier{},
+		BootStorer:                 disabled.CreateMemUnit(),
+		ShardIDAsObserver:          core.MetachainShardId,
+		NbShards:                   nodesSetup.NumberOfShards(),
+		EligibleNodes:              eligibleValidators,
+		WaitingNodes:               waitingValidators,
+		SelfPublicKey:              selfPublicKey,
+		ConsensusGroupCache:        consensusGroupCache,
+		ShuffledOutHandler:         disabled.NewShuffledOutHandler(),
+		WaitingListFixEnabledEpoch: epochConfig.EnableEpochs.WaitingListFixEnableEpoch,
+		ChanStopNode:               make(chan endProcess.ArgEndProcess, 1),
+		NodeTypeProvider:           nodetype.NewNodeTypeProvider(core.NodeTypeObserver),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return sharding.NewIndexHashedNodesCoordinatorWithRater(baseNodesCoordinator, rater)
+}
+
+// createMultiSigner creates a BLS multi signer used only for verifying the aggregated signatures, so the key pair it
+// is created with is a random one
+func createMultiSigner(
+	keyGen crypto.KeyGenerator,
+	privateKey crypto.PrivateKey,
+	publicKeyBytes []byte,
+) (crypto.MultiSigner, error) {
+	multiSigHasher, err := blake2b.NewBlake2bWithSize(multisig.BlsHashSize)
+	if err != nil {
+		return nil, err
+	}
+
+	blsSigner := &mclMultiSig.BlsMultiSigner{Hasher: multiSigHasher}
+	return multisig.NewBLSMultisig(blsSigner, []string{string(publicKeyBytes)}, privateKey, keyGen, uint16(0))
+}
+
+type nodesCoordinatorHandler interface {
+	sharding.NodesCoordinator
+	EpochStartPrepare(metaHdr data.HeaderHandler, body data.BodyHandler)
+	EpochStartAction(hdr data.HeaderHandler)
+}
@@ -0,0 +1,215 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/urfave/cli"
+)
+
+var (
+	lightClientHelpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// configurationFile defines a flag for the path to the main toml configuration file
+	configurationFile = cli.StringFlag{
+		Name: "config",
+		Usage: "The `filepath` for the main toml configuration file of the network, used for the marshalizer, " +
+			"hasher, public key converters, chain ID and header versions.",
+		Value: "./config/config.toml",
+	}
+	// ratingsConfigFile defines a flag for the path to the ratings toml configuration file
+	ratingsConfigFile = cli.StringFlag{
+		Name:  "ratings-config",
+		Usage: "The `filepath` for the ratings toml configuration file.",
+		Value: "./config/ratings.toml",
+	}
+	// epochConfigFile defines a flag for the path to the enable epochs toml configuration file
+	epochConfigFile = cli.StringFlag{
+		Name:  "epoch-config",
+		Usage: "The `filepath` for the enable epochs toml configuration file.",
+		Value: "./config/enableEpochs.toml",
+	}
+	// nodesFile defines a flag for the path to the genesis nodes setup file
+	nodesFile = cli.StringFlag{
+		Name:  "nodes-setup-file",
+		Usage: "The `filepath` for the JSON file holding the genesis nodes setup of the network.",
+		Value: "./config/nodesSetup.json",
+	}
+	// metaObserver defines a flag for the metachain observer URL
+	metaObserver = cli.StringFlag{
+		Name: "meta-observer",
+		Usage: "The `URL` of the REST API of a full history metachain observer the metachain headers and the " +
+			"validator sets are fetched from.",
+		Value: "http://127.0.0.1:8080",
+	}
+	// shardObservers defines a flag for the shard observers URLs
+	shardObservers = cli.StringFlag{
+		Name: "shard-observers",
+		Usage: "The comma-separated list of shard observers, in the shard=URL format, the shard headers and the " +
+			"accounts proofs are fetched from. For example: 0=http://127.0.0.1:8081,1=http://127.0.0.1:8082",
+		Value: "",
+	}
+	// syncInterval defines a flag for the interval between two synchronizations
+	syncInterval = cli.DurationFlag{
+		Name:  "sync-interval",
+		Usage: "The interval between two synchronizations with the metachain observer.",
+		Value: 6 * time.Second,
+	}
+	// restApiInterface defines a flag for the interface on which the REST API will try to bind with
+	restApiInterface = cli.StringFlag{
+		Name: "rest-api-interface",
+		Usage: "The interface `address and port` to which the REST API will attempt to bind. " +
+			"To bind to all available interfaces, set this flag to :8080",
+		Value: "localhost:8090",
+	}
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,api:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the api package which will receive a DEBUG" +
+			" log level.",
+		Value: "*:" + logger.LogInfo.String(),
+	}
+)
+
+var log = logger.GetOrCreate("main")
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = lightClientHelpTemplate
+	app.Name = "Elrond light client"
+	app.Version = "v1.0.0"
+	app.Usage = "This binary follows the metachain headers and the epoch start validator sets, verifying the " +
+		"aggregated signatures of the consensus groups, and serves the accounts state read from Merkle proofs " +
+		"provided by untrusted full nodes and checked against the verified state root hashes"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+	app.Flags = []cli.Flag{
+		configurationFile,
+		ratingsConfigFile,
+		epochConfigFile,
+		nodesFile,
+		metaObserver,
+		shardObservers,
+		syncInterval,
+		restApiInterface,
+		logLevel,
+	}
+
+	app.Action = func(c *cli.Context) error {
+		return startLightClient(c)
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func startLightClient(ctx *cli.Context) error {
+	err := logger.SetLogLevel(ctx.GlobalString(logLevel.Name))
+	if err != nil {
+		return err
+	}
+
+	shardObserversURLs, err := parseShardObservers(ctx.GlobalString(shardObservers.Name))
+	if err != nil {
+		return err
+	}
+
+	components, err := createComponents(ctx, shardObserversURLs)
+	if err != nil {
+		return err
+	}
+
+	server := &http.Server{
+		Addr:    ctx.GlobalString(restApiInterface.Name),
+		Handler: createRouter(components.lightClient, components.addressPubkeyConverter),
+	}
+	go func() {
+		errServe := server.ListenAndServe()
+		if errServe != http.ErrServerClosed {
+			log.Error("REST API server stopped", "error", errServe)
+		}
+	}()
+	log.Info("REST API started", "interface", server.Addr)
+
+	chStop := make(chan struct{})
+	go syncPeriodically(components.lightClient, ctx.GlobalDuration(syncInterval.Name), chStop)
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+	<-sigs
+	log.Info("terminating at user's signal...")
+
+	close(chStop)
+
+	return server.Close()
+}
+
+func syncPeriodically(lc syncHandler, interval time.Duration, chStop chan struct{}) {
+	for {
+		err := lc.Sync()
+		if err != nil {
+			log.Warn("light client sync failed", "error", err)
+		} else {
+			status := lc.Status()
+			log.Debug("light client synced", "epoch", status.Epoch, "nonce", status.Nonce)
+		}
+
+		select {
+		case <-chStop:
+			return
+		case <-time.After(interval):
+		}
+	}
+}
+
+func parseShardObservers(value string) (map[uint32]string, error) {
+	shardObserversURLs := make(map[uint32]string)
+	if len(value) == 0 {
+		return shardObserversURLs, nil
+	}
+
+	for _, entry := range strings.Split(value, ",") {
+		parts := strings.SplitN(strings.TrimSpace(entry), "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid shard observer entry %s, expected shard=URL", entry)
+		}
+
+		shardID, err := strconv.ParseUint(parts[0], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("%w for the shard of the observer entry %s", err, entry)
+		}
+
+		shardObserversURLs[uint32(shardID)] = parts[1]
+	}
+
+	return shardObserversURLs, nil
+}
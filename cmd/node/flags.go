@@ -10,6 +10,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/facade"
+	"github.com/ElrondNetwork/elrond-go/keystore"
 	"github.com/urfave/cli"
 )
 
@@ -195,6 +196,23 @@ var (
 		Value: "./config/validatorKey.pem",
 	}
 
+	// allValidatorKeysPemFile defines a flag for the path to the additional validator keys managed by the node
+	allValidatorKeysPemFile = cli.StringFlag{
+		Name: "all-validator-keys-pem-file",
+		Usage: "The `filepath` for the PEM file which contains the secret keys of the additional validators managed " +
+			"by this node. The node will take part in consensus for each of these keys, besides its own key.",
+		Value: "./config/allValidatorsKeys.pem",
+	}
+
+	// keystorePassphraseFile defines a flag for the path to the file holding the passphrase of the encrypted keystores
+	keystorePassphraseFile = cli.StringFlag{
+		Name: "keystore-passphrase-file",
+		Usage: "The `filepath` for the file holding the passphrase of the validator keys, used when the keys are " +
+			"stored in an encrypted keystore. The passphrase can also be provided through the " +
+			keystore.EnvPassphraseVariable + " environment variable, otherwise it will be asked for at startup.",
+		Value: "",
+	}
+
 	// logLevel defines the logger level
 	logLevel = cli.StringFlag{
 		Name: "log-level",
@@ -265,6 +283,21 @@ var (
 			"Should be enabled if data is not available in local disk.",
 	}
 
+	// trustedCheckpointHash defines a flag for the hash of the trusted epoch start meta block used when starting in epoch
+	trustedCheckpointHash = cli.StringFlag{
+		Name: "trusted-checkpoint-hash",
+		Usage: "This flag, if set, will make the node start in epoch from the epoch start meta block with the provided" +
+			" hex encoded hash, loading the state tries from the file provided with the --trusted-checkpoint-snapshot" +
+			" flag instead of syncing them from the network. Overrides the TrustedCheckpoint section from config.toml",
+		Value: "",
+	}
+	// trustedCheckpointSnapshot defines a flag for the state snapshot file used when starting from a trusted checkpoint
+	trustedCheckpointSnapshot = cli.StringFlag{
+		Name:  "trusted-checkpoint-snapshot",
+		Usage: "The `filepath` of the state snapshot, written by the checkpointexporter tool, used together with --trusted-checkpoint-hash",
+		Value: "",
+	}
+
 	// importDbDirectory defines a flag for the optional import DB directory on which the node will re-check the blockchain against
 	importDbDirectory = cli.StringFlag{
 		Name: "import-db",
@@ -318,6 +351,8 @@ func getFlags() []cli.Flag {
 		gasScheduleConfigurationDirectory,
 		validatorKeyIndex,
 		validatorKeyPemFile,
+		allValidatorKeysPemFile,
+		keystorePassphraseFile,
 		port,
 		profileMode,
 		useHealthService,
@@ -339,6 +374,8 @@ func getFlags() []cli.Flag {
 		numEpochsToSave,
 		numActivePersisters,
 		startInEpoch,
+		trustedCheckpointHash,
+		trustedCheckpointSnapshot,
 		importDbDirectory,
 		importDbNoSigCheck,
 		importDbSaveEpochRootHash,
@@ -377,11 +414,22 @@ func applyFlags(ctx *cli.Context, cfgs *config.Configs, flagsConfig *config.Cont
 	cfgs.ConfigurationPathsHolder.GasScheduleDirectoryName = ctx.GlobalString(gasScheduleConfigurationDirectory.Name)
 	cfgs.ConfigurationPathsHolder.SmartContracts = ctx.GlobalString(smartContractsFile.Name)
 	cfgs.ConfigurationPathsHolder.ValidatorKey = ctx.GlobalString(validatorKeyPemFile.Name)
+	cfgs.ConfigurationPathsHolder.AllValidatorKeys = ctx.GlobalString(allValidatorKeysPemFile.Name)
+	cfgs.ConfigurationPathsHolder.KeystorePassphraseFile = ctx.GlobalString(keystorePassphraseFile.Name)
 
 	if ctx.IsSet(startInEpoch.Name) {
 		log.Debug("start in epoch is enabled")
 		cfgs.GeneralConfig.GeneralSettings.StartInEpochEnabled = ctx.GlobalBool(startInEpoch.Name)
 	}
+	if ctx.IsSet(trustedCheckpointHash.Name) {
+		log.Debug("start from trusted checkpoint is enabled")
+		cfgs.GeneralConfig.TrustedCheckpoint.Enabled = true
+		cfgs.GeneralConfig.TrustedCheckpoint.EpochStartMetaBlockHash = ctx.GlobalString(trustedCheckpointHash.Name)
+		cfgs.GeneralConfig.GeneralSettings.StartInEpochEnabled = true
+	}
+	if ctx.IsSet(trustedCheckpointSnapshot.Name) {
+		cfgs.GeneralConfig.TrustedCheckpoint.StateSnapshotFile = ctx.GlobalString(trustedCheckpointSnapshot.Name)
+	}
 
 	if ctx.IsSet(numEpochsToSave.Name) {
 		cfgs.GeneralConfig.StoragePruning.NumEpochsToKeep = ctx.GlobalUint64(numEpochsToSave.Name)
@@ -468,6 +516,7 @@ func processConfigImportDBMode(log logger.Logger, configs *config.Configs) error
 	if importDbFlags.ImportDBStartInEpoch == 0 {
 		generalConfigs.GeneralSettings.StartInEpochEnabled = false
 	}
+	generalConfigs.TrustedCheckpoint.Enabled = false
 
 	generalConfigs.StoragePruning.NumActivePersisters = generalConfigs.StoragePruning.NumEpochsToKeep
 	generalConfigs.TrieStorageManagerConfig.KeepSnapshots = true
@@ -487,6 +536,7 @@ func processConfigImportDBMode(log logger.Logger, configs *config.Configs) error
 
 	log.Warn("the node is in import mode! Will auto-set some config values, including storage config values",
 		"GeneralSettings.StartInEpochEnabled", generalConfigs.GeneralSettings.StartInEpochEnabled,
+		"TrustedCheckpoint.Enabled", generalConfigs.TrustedCheckpoint.Enabled,
 		"StateTriesConfig.CheckpointsEnabled", generalConfigs.StateTriesConfig.CheckpointsEnabled,
 		"StoragePruning.NumActivePersisters", generalConfigs.StoragePruning.NumEpochsToKeep,
 		"TrieStorageManagerConfig.KeepSnapshots", generalConfigs.TrieStorageManagerConfig.KeepSnapshots,
@@ -508,6 +558,7 @@ func processConfigFullArchiveMode(log logger.Logger, configs *config.Configs) er
 	generalConfigs := configs.GeneralConfig
 
 	configs.GeneralConfig.GeneralSettings.StartInEpochEnabled = false
+	configs.GeneralConfig.TrustedCheckpoint.Enabled = false
 	configs.GeneralConfig.StoragePruning.ValidatorCleanOldEpochsData = false
 	configs.GeneralConfig.StoragePruning.ObserverCleanOldEpochsData = false
 	configs.GeneralConfig.StoragePruning.Enabled = true
@@ -515,6 +566,7 @@ func processConfigFullArchiveMode(log logger.Logger, configs *config.Configs) er
 
 	log.Warn("the node is in full archive mode! Will auto-set some config values",
 		"GeneralSettings.StartInEpochEnabled", generalConfigs.GeneralSettings.StartInEpochEnabled,
+		"TrustedCheckpoint.Enabled", generalConfigs.TrustedCheckpoint.Enabled,
 		"StoragePruning.ValidatorCleanOldEpochsData", generalConfigs.StoragePruning.ValidatorCleanOldEpochsData,
 		"StoragePruning.ObserverCleanOldEpochsData", generalConfigs.StoragePruning.ObserverCleanOldEpochsData,
 		"StoragePruning.Enabled", generalConfigs.StoragePruning.Enabled,
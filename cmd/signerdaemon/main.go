@@ -0,0 +1,271 @@
+package main
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/hashing/sha256"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/mcl"
+	mclSig "github.com/ElrondNetwork/elrond-go-crypto/signing/mcl/singlesig"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/factory/peerSignatureHandler"
+	"github.com/ElrondNetwork/elrond-go/keysManagement"
+	"github.com/ElrondNetwork/elrond-go/keystore"
+	"github.com/ElrondNetwork/elrond-go/signerBackend"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/urfave/cli"
+)
+
+const peerSignaturesCacheCapacity = 1000
+
+type keyLoaderHandler interface {
+	LoadKey(relativePath string, skIndex int) ([]byte, string, error)
+}
+
+var (
+	signerDaemonHelpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// keysFile defines a flag for the path to the pem file holding the BLS keys
+	keysFile = cli.StringFlag{
+		Name:  "keys-file",
+		Usage: "The `filepath` of the pem file or encrypted keystore holding the BLS private keys the daemon signs with",
+		Value: "./allValidatorsKeys.pem",
+	}
+	// passphraseFile defines a flag for the path to the file holding the keystore passphrase
+	passphraseFile = cli.StringFlag{
+		Name: "passphrase-file",
+		Usage: "The `filepath` of the file holding the keystore passphrase. The passphrase can also be provided " +
+			"through the " + keystore.EnvPassphraseVariable + " environment variable, otherwise it will be asked for " +
+			"at startup",
+		Value: "",
+	}
+	// network defines a flag for the network the daemon listens on
+	network = cli.StringFlag{
+		Name:  "network",
+		Usage: "The network the daemon listens on. Available options: unix, tcp",
+		Value: "unix",
+	}
+	// address defines a flag for the address the daemon listens on
+	address = cli.StringFlag{
+		Name:  "address",
+		Usage: "The `address` the daemon listens on: a socket file path for unix or host:port for tcp",
+		Value: "./signer.sock",
+	}
+	// auditLog defines a flag for the path to the audit log
+	auditLog = cli.StringFlag{
+		Name: "audit-log",
+		Usage: "The `filepath` of the audit log. Each request is appended as a JSON line and the signed entries are " +
+			"read back at startup to restore the slashing protection state",
+		Value: "./signer-audit.log",
+	}
+	// historyInRounds defines a flag for the number of rounds remembered by the slashing protection
+	historyInRounds = cli.Int64Flag{
+		Name:  "history-rounds",
+		Usage: "The number of rounds the slashing protection remembers. Requests for older rounds are refused",
+		Value: 10000,
+	}
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,api:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the api package which will receive a DEBUG" +
+			" log level.",
+		Value: "*:" + logger.LogInfo.String(),
+	}
+)
+
+var log = logger.GetOrCreate("signerdaemon")
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = signerDaemonHelpTemplate
+	app.Name = "Signer daemon"
+	app.Version = "v1.0.0"
+	app.Usage = "This binary holds the validator BLS keys and signs on behalf of a node configured with the remote " +
+		"signer backend. It refuses to sign two different headers or signature shares for the same round and keeps " +
+		"an audit log of all the requests"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+	app.Flags = []cli.Flag{
+		keysFile,
+		passphraseFile,
+		network,
+		address,
+		auditLog,
+		historyInRounds,
+		logLevel,
+	}
+
+	app.Action = func(c *cli.Context) error {
+		return startSigner(c)
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func startSigner(ctx *cli.Context) error {
+	err := logger.SetLogLevel(ctx.GlobalString(logLevel.Name))
+	if err != nil {
+		return err
+	}
+
+	keyGen := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	keyLoader, err := keystore.NewKeyLoader(keystore.ArgsKeyLoader{
+		PassphraseProvider: keystore.NewPassphraseProvider(keystore.ArgsPassphraseProvider{
+			EnvVariable:    keystore.EnvPassphraseVariable,
+			PassphraseFile: ctx.GlobalString(passphraseFile.Name),
+		}),
+	})
+	if err != nil {
+		return err
+	}
+
+	privateKeys, err := loadPrivateKeys(keyLoader, ctx.GlobalString(keysFile.Name))
+	if err != nil {
+		return err
+	}
+
+	keysHolder, err := keysManagement.NewManagedKeysHolder(keysManagement.ArgsManagedKeysHolder{
+		KeyGenerator: keyGen,
+		PrivateKeys:  privateKeys,
+	})
+	if err != nil {
+		return err
+	}
+
+	singleSigner := &mclSig.BlsSingleSigner{}
+	cache, err := storageUnit.NewCache(storageUnit.CacheConfig{
+		Type:     storageUnit.LRUCache,
+		Capacity: peerSignaturesCacheCapacity,
+	})
+	if err != nil {
+		return err
+	}
+	peerSigHandler, err := peerSignatureHandler.NewPeerSignatureHandler(cache, singleSigner, keyGen)
+	if err != nil {
+		return err
+	}
+
+	backend, err := signerBackend.NewLocalSignerBackend(signerBackend.ArgsLocalSignerBackend{
+		KeysHandler:          keysHolder,
+		SingleSigner:         singleSigner,
+		PeerSignatureHandler: peerSigHandler,
+	})
+	if err != nil {
+		return err
+	}
+
+	auditLogPath := ctx.GlobalString(auditLog.Name)
+	pastEntries, err := readAuditLog(auditLogPath)
+	if err != nil {
+		return err
+	}
+
+	auditFile, err := os.OpenFile(auditLogPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, core.FileModeUserReadWrite)
+	if err != nil {
+		return err
+	}
+	auditLogger, err := signerBackend.NewAuditLogger(auditFile)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		log.LogIfError(auditLogger.Close())
+	}()
+
+	service, err := signerBackend.NewSignerService(signerBackend.ArgsSignerService{
+		Backend:         backend,
+		Hasher:          sha256.NewSha256(),
+		AuditLogger:     auditLogger,
+		HistoryInRounds: ctx.GlobalInt64(historyInRounds.Name),
+		PastEntries:     pastEntries,
+	})
+	if err != nil {
+		return err
+	}
+
+	server, err := signerBackend.NewSignerServer(signerBackend.ArgsSignerServer{
+		Service: service,
+		Network: ctx.GlobalString(network.Name),
+		Address: ctx.GlobalString(address.Name),
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, pkBytes := range keysHolder.ManagedKeys() {
+		log.Info("serving key", "pk", hex.EncodeToString(pkBytes))
+	}
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+	<-sigs
+	log.Info("terminating at user's signal...")
+
+	return server.Close()
+}
+
+func loadPrivateKeys(keyLoader keyLoaderHandler, pemFile string) ([][]byte, error) {
+	privateKeys := make([][]byte, 0)
+	for index := 0; ; index++ {
+		encodedSk, _, err := keyLoader.LoadKey(pemFile, index)
+		if errors.Is(err, core.ErrInvalidIndex) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w while loading key at index %d from %s", err, index, pemFile)
+		}
+
+		skBytes, err := hex.DecodeString(string(encodedSk))
+		if err != nil {
+			return nil, fmt.Errorf("%w for encoded secret key at index %d", err, index)
+		}
+
+		privateKeys = append(privateKeys, skBytes)
+	}
+
+	return privateKeys, nil
+}
+
+func readAuditLog(path string) ([]*signerBackend.AuditEntry, error) {
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+
+	return signerBackend.ReadAuditLog(f)
+}
@@ -330,6 +330,10 @@ const MetricMinTransactionVersion = "erd_min_transaction_version"
 // MetricGasPerDataByte is the metric that specifies the required gas for a data byte
 const MetricGasPerDataByte = "erd_gas_per_data_byte"
 
+// MetricDynamicMinGasPrice is the metric that specifies the minimum gas price carried by the last committed shard header,
+// which the transactions of the next block must pay
+const MetricDynamicMinGasPrice = "erd_dynamic_min_gas_price"
+
 // MetricChainId is the metric that specifies current chain id
 const MetricChainId = "erd_chain_id"
 
@@ -482,6 +486,8 @@ const (
 	NetStatisticsOrder
 	// OldDatabaseCleanOrder defines the order in which oldDatabaseCleaner component is notified of a start of epoch event
 	OldDatabaseCleanOrder
+	// GovernedConfigOrder defines the order in which the governed config tracker is notified of a start of epoch event
+	GovernedConfigOrder
 )
 
 // NodeState specifies what type of state a node could have
@@ -592,6 +598,9 @@ const BuiltInFunctionESDTNFTCreateRoleTransfer = "ESDTNFTCreateRoleTransfer"
 // BuiltInFunctionESDTNFTBurn is the key for the elrond standard digital token NFT burn built-in function
 const BuiltInFunctionESDTNFTBurn = "ESDTNFTBurn"
 
+// BuiltInFunctionSetGuardian is the key for the set guardian built-in function
+const BuiltInFunctionSetGuardian = "SetGuardian"
+
 // ESDTRoleLocalMint is the constant string for the local role of mint for ESDT tokens
 const ESDTRoleLocalMint = "ESDTRoleLocalMint"
 
@@ -6,6 +6,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common/governance"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
 
@@ -16,6 +17,7 @@ type genericEpochNotifier struct {
 	wasInitialized   bool
 	currentEpoch     uint32
 	currentTimestamp uint64
+	governedConfig   *governance.GovernedConfig
 	mutHandler       sync.RWMutex
 	handlers         []vmcommon.EpochSubscriberHandler
 }
@@ -75,10 +77,66 @@ func (gen *genericEpochNotifier) RegisterNotifyHandler(handler vmcommon.EpochSub
 	gen.handlers = append(gen.handlers, handler)
 	gen.mutHandler.Unlock()
 
+	gen.mutData.RLock()
+	governedConfig := gen.governedConfig
+	gen.mutData.RUnlock()
+	notifyGovernedConfig(handler, governedConfig)
+
 	epoch, timestamp := gen.getEpochTimestamp()
 	handler.EpochConfirmed(epoch, timestamp)
 }
 
+// SetGovernedConfig stores the configuration changes activated through governance and delivers them to the registered
+// handlers that apply such changes. Those handlers are then notified again about the current epoch so they can
+// re-evaluate their flags
+func (gen *genericEpochNotifier) SetGovernedConfig(governedConfig *governance.GovernedConfig) {
+	if governedConfig == nil {
+		return
+	}
+
+	gen.mutData.Lock()
+	if gen.governedConfig.Equal(governedConfig) {
+		gen.mutData.Unlock()
+
+		return
+	}
+	gen.governedConfig = governedConfig
+	epoch := gen.currentEpoch
+	timestamp := gen.currentTimestamp
+	gen.mutData.Unlock()
+
+	gen.mutHandler.RLock()
+	handlersCopy := make([]vmcommon.EpochSubscriberHandler, len(gen.handlers))
+	copy(handlersCopy, gen.handlers)
+	gen.mutHandler.RUnlock()
+
+	log.Debug("genericEpochNotifier.SetGovernedConfig",
+		"num changes", len(governedConfig.GetChanges()),
+		"epoch", epoch,
+	)
+
+	for _, handler := range handlersCopy {
+		if notifyGovernedConfig(handler, governedConfig) {
+			handler.EpochConfirmed(epoch, timestamp)
+		}
+	}
+}
+
+func notifyGovernedConfig(handler vmcommon.EpochSubscriberHandler, governedConfig *governance.GovernedConfig) bool {
+	if governedConfig == nil {
+		return false
+	}
+
+	subscriber, ok := handler.(GovernedConfigSubscriberHandler)
+	if !ok {
+		return false
+	}
+
+	subscriber.GovernedConfigChanged(governedConfig)
+
+	return true
+}
+
 func (gen *genericEpochNotifier) getEpochTimestamp() (uint32, uint64) {
 	gen.mutData.RLock()
 	defer gen.mutData.RUnlock()
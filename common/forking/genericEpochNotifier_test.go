@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common/governance"
 	"github.com/ElrondNetwork/elrond-go/common/mock"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/stretchr/testify/assert"
@@ -152,3 +153,71 @@ func TestGenericEpochNotifier_CheckEpochInSyncShouldWork(t *testing.T) {
 	assert.Equal(t, uint32(2), atomic.LoadUint32(&numCalls))
 	assert.True(t, end.Sub(start) >= handlerWait)
 }
+
+type governedConfigSubscriberStub struct {
+	mock.EpochSubscriberHandlerStub
+	GovernedConfigChangedCalled func(governedConfig *governance.GovernedConfig)
+}
+
+func (gcss *governedConfigSubscriberStub) GovernedConfigChanged(governedConfig *governance.GovernedConfig) {
+	if gcss.GovernedConfigChangedCalled != nil {
+		gcss.GovernedConfigChangedCalled(governedConfig)
+	}
+}
+
+func TestGenericEpochNotifier_SetGovernedConfigShouldNotifySubscribers(t *testing.T) {
+	t.Parallel()
+
+	gep := NewGenericEpochNotifier()
+	gep.CheckEpoch(&testscommon.HeaderHandlerStub{
+		EpochField: 5,
+	})
+
+	numEpochConfirmedPlain := uint32(0)
+	gep.RegisterNotifyHandler(&mock.EpochSubscriberHandlerStub{
+		EpochConfirmedCalled: func(epoch uint32, timestamp uint64) {
+			atomic.AddUint32(&numEpochConfirmedPlain, 1)
+		},
+	})
+
+	var receivedConfig *governance.GovernedConfig
+	numEpochConfirmedGoverned := uint32(0)
+	subscriber := &governedConfigSubscriberStub{
+		GovernedConfigChangedCalled: func(governedConfig *governance.GovernedConfig) {
+			receivedConfig = governedConfig
+		},
+	}
+	subscriber.EpochConfirmedCalled = func(epoch uint32, timestamp uint64) {
+		assert.Equal(t, uint32(5), epoch)
+		atomic.AddUint32(&numEpochConfirmedGoverned, 1)
+	}
+	gep.RegisterNotifyHandler(subscriber)
+
+	governedConfig := &governance.GovernedConfig{
+		Changes: []*governance.GovernedConfigChange{
+			{Name: "EnableEpochs.ESDTEnableEpoch", Value: "10", EnableEpoch: 10},
+		},
+	}
+	gep.SetGovernedConfig(governedConfig)
+	assert.True(t, receivedConfig == governedConfig) //pointer testing
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&numEpochConfirmedPlain))
+	assert.Equal(t, uint32(2), atomic.LoadUint32(&numEpochConfirmedGoverned))
+
+	// same config should not trigger the notifications again
+	gep.SetGovernedConfig(&governance.GovernedConfig{
+		Changes: []*governance.GovernedConfigChange{
+			{Name: "EnableEpochs.ESDTEnableEpoch", Value: "10", EnableEpoch: 10},
+		},
+	})
+	assert.True(t, receivedConfig == governedConfig) //pointer testing
+	assert.Equal(t, uint32(2), atomic.LoadUint32(&numEpochConfirmedGoverned))
+
+	// late subscribers should receive the stored config
+	var lateReceivedConfig *governance.GovernedConfig
+	gep.RegisterNotifyHandler(&governedConfigSubscriberStub{
+		GovernedConfigChangedCalled: func(governedConfig *governance.GovernedConfig) {
+			lateReceivedConfig = governedConfig
+		},
+	})
+	assert.True(t, lateReceivedConfig == governedConfig) //pointer testing
+}
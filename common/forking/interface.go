@@ -0,0 +1,9 @@
+package forking
+
+import "github.com/ElrondNetwork/elrond-go/common/governance"
+
+// GovernedConfigSubscriberHandler defines the behavior of an epoch subscriber that applies the configuration
+// changes activated through governance
+type GovernedConfigSubscriberHandler interface {
+	GovernedConfigChanged(governedConfig *governance.GovernedConfig)
+}
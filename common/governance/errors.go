@@ -0,0 +1,10 @@
+package governance
+
+import "errors"
+
+// ErrUnknownGovernedConfigName signals that the provided name does not designate a configuration value that can be
+// changed through governance
+var ErrUnknownGovernedConfigName = errors.New("unknown governed config name")
+
+// ErrInvalidGovernedConfigValue signals that an invalid value was provided for a governed configuration change
+var ErrInvalidGovernedConfigValue = errors.New("invalid governed config value")
@@ -0,0 +1,126 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. governedConfig.proto
+
+package governance
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	// GovernedEnableEpochPrefix is the name prefix of a governed change that sets one of the config.EnableEpochs values
+	GovernedEnableEpochPrefix = "EnableEpochs."
+	// GovernedFeeSettingPrefix is the name prefix of a governed change that sets one of the config.FeeSettings values
+	GovernedFeeSettingPrefix = "FeeSettings."
+	// PenalizedTooMuchGasEnableEpoch is the name of the governed config.EnableEpochs.PenalizedTooMuchGasEnableEpoch value
+	PenalizedTooMuchGasEnableEpoch = "PenalizedTooMuchGasEnableEpoch"
+	// GasPriceModifierEnableEpoch is the name of the governed config.EnableEpochs.GasPriceModifierEnableEpoch value
+	GasPriceModifierEnableEpoch = "GasPriceModifierEnableEpoch"
+
+	gasPriceModifierSetting = "GasPriceModifier"
+)
+
+// governedEnableEpochs holds the enable epochs that can be changed through governance. Only the flags for which all the
+// components reading them apply the governed config changes are accepted, otherwise the components would toggle the
+// same flag in different epochs
+var governedEnableEpochs = map[string]struct{}{
+	PenalizedTooMuchGasEnableEpoch: {},
+	GasPriceModifierEnableEpoch:    {},
+}
+
+var governedUint64FeeSettings = map[string]struct{}{
+	"MaxGasLimitPerBlock":     {},
+	"MaxGasLimitPerMetaBlock": {},
+	"GasPerDataByte":          {},
+	"MinGasPrice":             {},
+	"MinGasLimit":             {},
+}
+
+// CheckGovernedConfigChange verifies that the provided name designates a configuration value that can be changed
+// through governance and that the provided value is valid for it
+func CheckGovernedConfigChange(name string, value string) error {
+	switch {
+	case strings.HasPrefix(name, GovernedEnableEpochPrefix):
+		return checkGovernedEnableEpoch(strings.TrimPrefix(name, GovernedEnableEpochPrefix), value)
+	case strings.HasPrefix(name, GovernedFeeSettingPrefix):
+		return checkGovernedFeeSetting(strings.TrimPrefix(name, GovernedFeeSettingPrefix), value)
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownGovernedConfigName, name)
+	}
+}
+
+func checkGovernedEnableEpoch(flagName string, value string) error {
+	_, ok := governedEnableEpochs[flagName]
+	if !ok {
+		return fmt.Errorf("%w: %s%s", ErrUnknownGovernedConfigName, GovernedEnableEpochPrefix, flagName)
+	}
+
+	_, err := strconv.ParseUint(value, 10, 32)
+	if err != nil {
+		return fmt.Errorf("%w for %s%s: %s", ErrInvalidGovernedConfigValue, GovernedEnableEpochPrefix, flagName, value)
+	}
+
+	return nil
+}
+
+func checkGovernedFeeSetting(setting string, value string) error {
+	if setting == gasPriceModifierSetting {
+		gasPriceModifier, err := strconv.ParseFloat(value, 64)
+		if err != nil || gasPriceModifier <= 0 || gasPriceModifier > 1 {
+			return fmt.Errorf("%w for %s%s: %s", ErrInvalidGovernedConfigValue, GovernedFeeSettingPrefix, setting, value)
+		}
+
+		return nil
+	}
+
+	_, ok := governedUint64FeeSettings[setting]
+	if !ok {
+		return fmt.Errorf("%w: %s%s", ErrUnknownGovernedConfigName, GovernedFeeSettingPrefix, setting)
+	}
+
+	_, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return fmt.Errorf("%w for %s%s: %s", ErrInvalidGovernedConfigValue, GovernedFeeSettingPrefix, setting, value)
+	}
+
+	return nil
+}
+
+// EnableEpochs returns the governed enable epochs, keyed by the config.EnableEpochs field name. A later change
+// of the same flag overrides the previous ones
+func (gc *GovernedConfig) EnableEpochs() map[string]uint32 {
+	enableEpochs := make(map[string]uint32)
+	for _, change := range gc.GetChanges() {
+		if !strings.HasPrefix(change.GetName(), GovernedEnableEpochPrefix) {
+			continue
+		}
+
+		enableEpochs[strings.TrimPrefix(change.GetName(), GovernedEnableEpochPrefix)] = change.GetEnableEpoch()
+	}
+
+	return enableEpochs
+}
+
+// EnableEpoch returns the governed enable epoch of the provided config.EnableEpochs field name or the provided default
+// value, when the flag was not changed through governance
+func (gc *GovernedConfig) EnableEpoch(flagName string, defaultEnableEpoch uint32) uint32 {
+	enableEpoch, ok := gc.EnableEpochs()[flagName]
+	if !ok {
+		return defaultEnableEpoch
+	}
+
+	return enableEpoch
+}
+
+// FeeSettingChanges returns the governed fee settings changes, in activation order
+func (gc *GovernedConfig) FeeSettingChanges() []*GovernedConfigChange {
+	changes := make([]*GovernedConfigChange, 0)
+	for _, change := range gc.GetChanges() {
+		if strings.HasPrefix(change.GetName(), GovernedFeeSettingPrefix) {
+			changes = append(changes, change)
+		}
+	}
+
+	return changes
+}
@@ -0,0 +1,714 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: governedConfig.proto
+
+package governance
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type GovernedConfigChange struct {
+	Name        string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name"`
+	Value       string `protobuf:"bytes,2,opt,name=Value,proto3" json:"Value"`
+	EnableEpoch uint32 `protobuf:"varint,3,opt,name=EnableEpoch,proto3" json:"EnableEpoch"`
+}
+
+func (m *GovernedConfigChange) Reset()      { *m = GovernedConfigChange{} }
+func (*GovernedConfigChange) ProtoMessage() {}
+func (*GovernedConfigChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1d2371fbdbd4cb0, []int{0}
+}
+func (m *GovernedConfigChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GovernedConfigChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *GovernedConfigChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GovernedConfigChange.Merge(m, src)
+}
+func (m *GovernedConfigChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *GovernedConfigChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_GovernedConfigChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GovernedConfigChange proto.InternalMessageInfo
+
+func (m *GovernedConfigChange) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GovernedConfigChange) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *GovernedConfigChange) GetEnableEpoch() uint32 {
+	if m != nil {
+		return m.EnableEpoch
+	}
+	return 0
+}
+
+type GovernedConfig struct {
+	Changes []*GovernedConfigChange `protobuf:"bytes,1,rep,name=Changes,proto3" json:"Changes"`
+}
+
+func (m *GovernedConfig) Reset()      { *m = GovernedConfig{} }
+func (*GovernedConfig) ProtoMessage() {}
+func (*GovernedConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1d2371fbdbd4cb0, []int{1}
+}
+func (m *GovernedConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GovernedConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *GovernedConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GovernedConfig.Merge(m, src)
+}
+func (m *GovernedConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *GovernedConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_GovernedConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GovernedConfig proto.InternalMessageInfo
+
+func (m *GovernedConfig) GetChanges() []*GovernedConfigChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GovernedConfigChange)(nil), "proto.GovernedConfigChange")
+	proto.RegisterType((*GovernedConfig)(nil), "proto.GovernedConfig")
+}
+
+func init() { proto.RegisterFile("governedConfig.proto", fileDescriptor_d1d2371fbdbd4cb0) }
+
+var fileDescriptor_d1d2371fbdbd4cb0 = []byte{
+	// 280 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x49, 0xcf, 0x2f, 0x4b,
+	0x2d, 0xca, 0x4b, 0x4d, 0x71, 0xce, 0xcf, 0x4b, 0xcb, 0x4c, 0xd7, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
+	0x17, 0x62, 0x05, 0x53, 0x52, 0xba, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9,
+	0xfa, 0xe9, 0xf9, 0xe9, 0xf9, 0xfa, 0x60, 0xe1, 0xa4, 0xd2, 0x34, 0x30, 0x0f, 0xcc, 0x01, 0xb3,
+	0x20, 0xba, 0x94, 0x3a, 0x18, 0xb9, 0x44, 0xdc, 0x51, 0x8c, 0x73, 0xce, 0x48, 0xcc, 0x4b, 0x4f,
+	0x15, 0x92, 0xe1, 0x62, 0xf1, 0x4b, 0xcc, 0x4d, 0x95, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x74, 0xe2,
+	0x78, 0x75, 0x4f, 0x1e, 0xcc, 0x0f, 0x02, 0x93, 0x42, 0xf2, 0x5c, 0xac, 0x61, 0x89, 0x39, 0xa5,
+	0xa9, 0x12, 0x4c, 0x60, 0x69, 0xce, 0x57, 0xf7, 0xe4, 0x21, 0x02, 0x41, 0x10, 0x4a, 0xc8, 0x90,
+	0x8b, 0xdb, 0x35, 0x2f, 0x31, 0x29, 0x27, 0xd5, 0xb5, 0x20, 0x3f, 0x39, 0x43, 0x82, 0x59, 0x81,
+	0x51, 0x83, 0xd7, 0x89, 0xff, 0xd5, 0x3d, 0x79, 0x64, 0xe1, 0x20, 0x64, 0x8e, 0x52, 0x08, 0x17,
+	0x1f, 0xaa, 0x4b, 0x84, 0x9c, 0xb8, 0xd8, 0x21, 0xae, 0x29, 0x96, 0x60, 0x54, 0x60, 0xd6, 0xe0,
+	0x36, 0x92, 0x86, 0xb8, 0x5a, 0x0f, 0x9b, 0x8b, 0x9d, 0xb8, 0x5f, 0xdd, 0x93, 0x87, 0xa9, 0x0f,
+	0x82, 0x31, 0x9c, 0x5c, 0x2e, 0x3c, 0x94, 0x63, 0xb8, 0xf1, 0x50, 0x8e, 0xe1, 0xc3, 0x43, 0x39,
+	0xc6, 0x86, 0x47, 0x72, 0x8c, 0x2b, 0x1e, 0xc9, 0x31, 0x9e, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91,
+	0x1c, 0xe3, 0x8d, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0xbe, 0x78, 0x24, 0xc7, 0xf0, 0xe1,
+	0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10,
+	0xc5, 0x05, 0x09, 0xe6, 0xc4, 0xbc, 0xe4, 0xd4, 0x24, 0x36, 0xb0, 0xbd, 0xc6, 0x80, 0x01, 0x00,
+	0x50, 0x87, 0xe7, 0x61, 0x7b, 0x01, 0x00, 0x00,
+}
+
+func (this *GovernedConfigChange) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GovernedConfigChange)
+	if !ok {
+		that2, ok := that.(GovernedConfigChange)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	if this.EnableEpoch != that1.EnableEpoch {
+		return false
+	}
+	return true
+}
+func (this *GovernedConfig) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GovernedConfig)
+	if !ok {
+		that2, ok := that.(GovernedConfig)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Changes) != len(that1.Changes) {
+		return false
+	}
+	for i := range this.Changes {
+		if !this.Changes[i].Equal(that1.Changes[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *GovernedConfigChange) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&governance.GovernedConfigChange{")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
+	s = append(s, "EnableEpoch: "+fmt.Sprintf("%#v", this.EnableEpoch)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GovernedConfig) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&governance.GovernedConfig{")
+	if this.Changes != nil {
+		s = append(s, "Changes: "+fmt.Sprintf("%#v", this.Changes)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringGovernedConfig(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *GovernedConfigChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GovernedConfigChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GovernedConfigChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EnableEpoch != 0 {
+		i = encodeVarintGovernedConfig(dAtA, i, uint64(m.EnableEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintGovernedConfig(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGovernedConfig(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GovernedConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GovernedConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GovernedConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGovernedConfig(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGovernedConfig(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGovernedConfig(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GovernedConfigChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGovernedConfig(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovGovernedConfig(uint64(l))
+	}
+	if m.EnableEpoch != 0 {
+		n += 1 + sovGovernedConfig(uint64(m.EnableEpoch))
+	}
+	return n
+}
+
+func (m *GovernedConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovGovernedConfig(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGovernedConfig(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGovernedConfig(x uint64) (n int) {
+	return sovGovernedConfig(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *GovernedConfigChange) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GovernedConfigChange{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`EnableEpoch:` + fmt.Sprintf("%v", this.EnableEpoch) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GovernedConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForChanges := "[]*GovernedConfigChange{"
+	for _, f := range this.Changes {
+		repeatedStringForChanges += strings.Replace(f.String(), "GovernedConfigChange", "GovernedConfigChange", 1) + ","
+	}
+	repeatedStringForChanges += "}"
+	s := strings.Join([]string{`&GovernedConfig{`,
+		`Changes:` + repeatedStringForChanges + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringGovernedConfig(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *GovernedConfigChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGovernedConfig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GovernedConfigChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GovernedConfigChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernedConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGovernedConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovernedConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernedConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGovernedConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovernedConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableEpoch", wireType)
+			}
+			m.EnableEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernedConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EnableEpoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGovernedConfig(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGovernedConfig
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGovernedConfig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GovernedConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGovernedConfig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GovernedConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GovernedConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernedConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGovernedConfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovernedConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changes = append(m.Changes, &GovernedConfigChange{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGovernedConfig(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGovernedConfig
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGovernedConfig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGovernedConfig(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGovernedConfig
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGovernedConfig
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGovernedConfig
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGovernedConfig
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGovernedConfig
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGovernedConfig
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGovernedConfig        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGovernedConfig          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGovernedConfig = fmt.Errorf("proto: unexpected end of group")
+)
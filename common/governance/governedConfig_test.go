@@ -0,0 +1,69 @@
+package governance
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckGovernedConfigChange(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, CheckGovernedConfigChange("EnableEpochs.PenalizedTooMuchGasEnableEpoch", "100"))
+	assert.Nil(t, CheckGovernedConfigChange("EnableEpochs.GasPriceModifierEnableEpoch", "100"))
+	assert.Nil(t, CheckGovernedConfigChange("FeeSettings.MinGasPrice", "1000000000"))
+	assert.Nil(t, CheckGovernedConfigChange("FeeSettings.GasPriceModifier", "0.01"))
+
+	err := CheckGovernedConfigChange("Unknown.Setting", "1")
+	assert.True(t, errors.Is(err, ErrUnknownGovernedConfigName))
+
+	err = CheckGovernedConfigChange("EnableEpochs.UnknownEnableEpoch", "1")
+	assert.True(t, errors.Is(err, ErrUnknownGovernedConfigName))
+
+	err = CheckGovernedConfigChange("EnableEpochs.MaxNodesChangeEnableEpoch", "1")
+	assert.True(t, errors.Is(err, ErrUnknownGovernedConfigName))
+
+	err = CheckGovernedConfigChange("EnableEpochs.ESDTEnableEpoch", "1")
+	assert.True(t, errors.Is(err, ErrUnknownGovernedConfigName))
+
+	err = CheckGovernedConfigChange("FeeSettings.FeeConfigByEpoch", "1")
+	assert.True(t, errors.Is(err, ErrUnknownGovernedConfigName))
+
+	err = CheckGovernedConfigChange("EnableEpochs.GasPriceModifierEnableEpoch", "-1")
+	assert.True(t, errors.Is(err, ErrInvalidGovernedConfigValue))
+
+	err = CheckGovernedConfigChange("FeeSettings.MinGasLimit", "abc")
+	assert.True(t, errors.Is(err, ErrInvalidGovernedConfigValue))
+
+	err = CheckGovernedConfigChange("FeeSettings.GasPriceModifier", "1.5")
+	assert.True(t, errors.Is(err, ErrInvalidGovernedConfigValue))
+}
+
+func TestGovernedConfig_EnableEpochsAndFeeSettingChanges(t *testing.T) {
+	t.Parallel()
+
+	minGasPriceChange := &GovernedConfigChange{Name: "FeeSettings.MinGasPrice", Value: "2000", EnableEpoch: 4}
+	gc := &GovernedConfig{
+		Changes: []*GovernedConfigChange{
+			{Name: "EnableEpochs.ESDTEnableEpoch", Value: "10", EnableEpoch: 10},
+			minGasPriceChange,
+			{Name: "EnableEpochs.GovernanceEnableEpoch", Value: "3", EnableEpoch: 5},
+			{Name: "EnableEpochs.ESDTEnableEpoch", Value: "20", EnableEpoch: 20},
+		},
+	}
+
+	expectedEnableEpochs := map[string]uint32{
+		"ESDTEnableEpoch":       20,
+		"GovernanceEnableEpoch": 5,
+	}
+	assert.Equal(t, expectedEnableEpochs, gc.EnableEpochs())
+	assert.Equal(t, []*GovernedConfigChange{minGasPriceChange}, gc.FeeSettingChanges())
+	assert.Equal(t, uint32(20), gc.EnableEpoch("ESDTEnableEpoch", 1))
+	assert.Equal(t, uint32(1), gc.EnableEpoch("PenalizedTooMuchGasEnableEpoch", 1))
+
+	var nilConfig *GovernedConfig
+	assert.Equal(t, 0, len(nilConfig.EnableEpochs()))
+	assert.Equal(t, 0, len(nilConfig.FeeSettingChanges()))
+	assert.Equal(t, uint32(1), nilConfig.EnableEpoch("ESDTEnableEpoch", 1))
+}
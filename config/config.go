@@ -68,7 +68,24 @@ type MarshalizerConfig struct {
 
 // ConsensusConfig holds the consensus configuration parameters
 type ConsensusConfig struct {
-	Type string
+	Type             string
+	SlashingDetector SlashingDetectorConfig
+	SignerBackend    SignerBackendConfig
+}
+
+// SlashingDetectorConfig will hold the configuration for the component collecting double signing evidence
+type SlashingDetectorConfig struct {
+	NumRoundsToKeep    int64
+	EvidenceTxGasLimit uint64
+}
+
+// SignerBackendConfig will hold the configuration for the component signing on behalf of the validator BLS keys
+type SignerBackendConfig struct {
+	Type                   string
+	Network                string
+	Address                string
+	MainPublicKey          string
+	RequestTimeoutInMillis uint32
 }
 
 // NTPConfig will hold the configuration for NTP queries
@@ -120,6 +137,7 @@ type Config struct {
 	ShardHdrNonceHashStorage        StorageConfig
 	MetaHdrNonceHashStorage         StorageConfig
 	StatusMetricsStorage            StorageConfig
+	SlashingEvidenceStorage         StorageConfig
 	ReceiptsStorage                 StorageConfig
 	SmartContractsStorage           StorageConfig
 	SmartContractsStorageForSCQuery StorageConfig
@@ -185,8 +203,11 @@ type Config struct {
 	Versions              VersionsConfig
 	Logs                  LogsConfig
 	TrieSync              TrieSyncConfig
+	TrustedCheckpoint     TrustedCheckpointConfig
 	Resolvers             ResolverConfig
 	VMOutputCacher        CacheConfig
+	GasPriceEstimator     GasPriceEstimatorConfig
+	TxBundleSimulator     TxBundleSimulatorConfig
 }
 
 // LogsConfig will hold settings related to the logging sub-system
@@ -194,6 +215,21 @@ type LogsConfig struct {
 	LogFileLifeSpanInSec int
 }
 
+// GasPriceEstimatorConfig will hold the settings used when suggesting gas prices based on the recent blocks
+type GasPriceEstimatorConfig struct {
+	NumBlocks                  uint32
+	SlowPercentile             uint32
+	NormalPercentile           uint32
+	FastPercentile             uint32
+	FullBlockGasUsedPercentage float64
+}
+
+// TxBundleSimulatorConfig will hold the limits used when simulating bundles of transactions
+type TxBundleSimulatorConfig struct {
+	MaxBundleSize        uint32
+	MaxContinuationSteps uint32
+}
+
 // StoragePruningConfig will hold settings related to storage pruning
 type StoragePruningConfig struct {
 	Enabled                        bool
@@ -234,13 +270,14 @@ type MaxNodesChangeConfig struct {
 
 // GeneralSettingsConfig will hold the general settings for a node
 type GeneralSettingsConfig struct {
-	StatusPollingIntervalSec int
-	MaxComputableRounds      uint64
-	StartInEpochEnabled      bool
-	ChainID                  string
-	MinTransactionVersion    uint32
-	GenesisString            string
-	GenesisMaxNumberOfShards uint32
+	StatusPollingIntervalSec      int
+	MaxComputableRounds           uint64
+	StartInEpochEnabled           bool
+	ChainID                       string
+	MinTransactionVersion         uint32
+	GenesisString                 string
+	GenesisMaxNumberOfShards      uint32
+	GuardianActivationEpochsDelay uint32
 }
 
 // FacadeConfig will hold different configuration option that will be passed to the main ElrondFacade
@@ -403,6 +440,8 @@ type DbLookupExtensionsConfig struct {
 	MiniblockHashByTxHashStorageConfig StorageConfig
 	EpochByHashStorageConfig           StorageConfig
 	ResultsHashesByTxHashStorageConfig StorageConfig
+	ESDTRegistryEnabled                bool
+	ESDTRegistryStorageConfig          StorageConfig
 }
 
 // DebugConfig will hold debugging configuration
@@ -411,6 +450,7 @@ type DebugConfig struct {
 	Antiflood           AntifloodDebugConfig
 	ShuffleOut          ShuffleOutDebugConfig
 	EpochStart          EpochStartDebugConfig
+	ConsensusRounds     ConsensusRoundsDebugConfig
 }
 
 // HealthServiceConfig will hold health service (monitoring) configuration
@@ -448,6 +488,13 @@ type ShuffleOutDebugConfig struct {
 	DoProfileOnShuffleOut   bool
 }
 
+// ConsensusRoundsDebugConfig will hold the consensus rounds tracing configuration
+type ConsensusRoundsDebugConfig struct {
+	Enabled         bool
+	NumRoundsToKeep int
+	ExportFilePath  string
+}
+
 // EpochStartDebugConfig will hold the epoch debug configuration
 type EpochStartDebugConfig struct {
 	GoRoutineAnalyserEnabled     bool
@@ -521,6 +568,8 @@ type ConfigurationPathsHolder struct {
 	Genesis                  string
 	SmartContracts           string
 	ValidatorKey             string
+	AllValidatorKeys         string
+	KeystorePassphraseFile   string
 	Epoch                    string
 }
 
@@ -531,6 +580,14 @@ type TrieSyncConfig struct {
 	TrieSyncerVersion         int
 }
 
+// TrustedCheckpointConfig represents the configuration used when starting in epoch from a trusted epoch start
+// meta block and a local state snapshot instead of syncing the state tries from the network
+type TrustedCheckpointConfig struct {
+	Enabled                 bool
+	EpochStartMetaBlockHash string
+	StateSnapshotFile       string
+}
+
 // ResolverConfig represents the config options to be used when setting up the resolver instances
 type ResolverConfig struct {
 	NumCrossShardPeers  uint32
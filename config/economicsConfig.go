@@ -30,7 +30,8 @@ type EpochRewardSettings struct {
 	EpochEnable                      uint32
 }
 
-// FeeSettings will hold economics fee settings
+// FeeSettings will hold economics fee settings. The top level values are active from genesis and can be changed
+// starting with a specific epoch through the FeeConfigByEpoch entries
 type FeeSettings struct {
 	MaxGasLimitPerBlock     string
 	MaxGasLimitPerMetaBlock string
@@ -38,6 +39,29 @@ type FeeSettings struct {
 	MinGasPrice             string
 	MinGasLimit             string
 	GasPriceModifier        float64
+	FeeConfigByEpoch        []EpochFeeSettings
+	DynamicMinGasPrice      DynamicMinGasPriceSettings
+}
+
+// EpochFeeSettings holds the economics fee settings for a specific epoch
+type EpochFeeSettings struct {
+	MaxGasLimitPerBlock     string
+	MaxGasLimitPerMetaBlock string
+	GasPerDataByte          string
+	MinGasPrice             string
+	MinGasLimit             string
+	GasPriceModifier        float64
+	EpochEnable             uint32
+}
+
+// DynamicMinGasPriceSettings holds the settings of the minimum gas price that each shard header carries,
+// adjusted from the previous header depending on the gas used by the block against a target
+type DynamicMinGasPriceSettings struct {
+	Enabled                 bool
+	EnableEpoch             uint32
+	TargetGasUsedPercentage float64
+	MaxChangeDenominator    uint64
+	MaxMinGasPrice          string
 }
 
 // EconomicsConfig will hold economics config
@@ -49,6 +49,12 @@ type EnableEpochs struct {
 	GlobalMintBurnDisableEpoch                  uint32
 	ESDTTransferRoleEnableEpoch                 uint32
 	BuiltInFunctionOnMetaEnableEpoch            uint32
+	GovernedConfigEnableEpoch                   uint32
+	GovernanceV2EnableEpoch                     uint32
+	MultisigSCEnableEpoch                       uint32
+	DelegationLiquidStakingEnableEpoch          uint32
+	ScheduledCallsEnableEpoch                   uint32
+	GuardiansEnableEpoch                        uint32
 }
 
 // GasScheduleByEpochs represents a gas schedule toml entry that will be applied from the provided epoch
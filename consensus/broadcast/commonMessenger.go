@@ -10,7 +10,6 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/hashing"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
-	"github.com/ElrondNetwork/elrond-go-crypto"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/consensus"
@@ -39,9 +38,8 @@ type commonMessenger struct {
 	marshalizer             marshal.Marshalizer
 	hasher                  hashing.Hasher
 	messenger               consensus.P2PMessenger
-	privateKey              crypto.PrivateKey
 	shardCoordinator        sharding.Coordinator
-	peerSignatureHandler    crypto.PeerSignatureHandler
+	signerBackend           consensus.SignerBackend
 	delayedBlockBroadcaster delayedBroadcaster
 }
 
@@ -50,9 +48,8 @@ type CommonMessengerArgs struct {
 	Marshalizer                marshal.Marshalizer
 	Hasher                     hashing.Hasher
 	Messenger                  consensus.P2PMessenger
-	PrivateKey                 crypto.PrivateKey
 	ShardCoordinator           sharding.Coordinator
-	PeerSignatureHandler       crypto.PeerSignatureHandler
+	SignerBackend              consensus.SignerBackend
 	HeadersSubscriber          consensus.HeadersPoolSubscriber
 	InterceptorsContainer      process.InterceptorsContainer
 	MaxDelayCacheSize          uint32
@@ -72,14 +69,11 @@ func checkCommonMessengerNilParameters(
 	if check.IfNil(args.Messenger) {
 		return spos.ErrNilMessenger
 	}
-	if check.IfNil(args.PrivateKey) {
-		return spos.ErrNilPrivateKey
-	}
 	if check.IfNil(args.ShardCoordinator) {
 		return spos.ErrNilShardCoordinator
 	}
-	if check.IfNil(args.PeerSignatureHandler) {
-		return spos.ErrNilPeerSignatureHandler
+	if check.IfNil(args.SignerBackend) {
+		return spos.ErrNilSignerBackend
 	}
 	if check.IfNil(args.InterceptorsContainer) {
 		return spos.ErrNilInterceptorsContainer
@@ -97,9 +91,10 @@ func checkCommonMessengerNilParameters(
 	return nil
 }
 
-// BroadcastConsensusMessage will send on consensus topic the consensus message
+// BroadcastConsensusMessage will send on consensus topic the consensus message. The message is signed with the key
+// of its public key, as a node managing several keys sends consensus messages on behalf of each of them
 func (cm *commonMessenger) BroadcastConsensusMessage(message *consensus.Message) error {
-	signature, err := cm.peerSignatureHandler.GetPeerSignature(cm.privateKey, message.OriginatorPid)
+	signature, err := cm.signerBackend.SignPeerID(message.PubKey, message.OriginatorPid)
 	if err != nil {
 		return err
 	}
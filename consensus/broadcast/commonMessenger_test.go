@@ -1,16 +1,17 @@
 package broadcast_test
 
 import (
+	"bytes"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/data/block"
-	"github.com/ElrondNetwork/elrond-go-crypto"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/consensus/broadcast"
 	"github.com/ElrondNetwork/elrond-go/consensus/mock"
+	"github.com/ElrondNetwork/elrond-go/testscommon/cryptoMocks"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -33,21 +34,18 @@ func TestCommonMessenger_BroadcastConsensusMessageShouldErrWhenSignMessageFail(t
 	err := errors.New("sign message error")
 	marshalizerMock := &mock.MarshalizerMock{}
 	messengerMock := &mock.MessengerStub{}
-	privateKeyMock := &mock.PrivateKeyMock{}
 	shardCoordinatorMock := &mock.ShardCoordinatorMock{}
-	singleSignerMock := &mock.SingleSignerMock{
-		SignStub: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+	signerBackend := &cryptoMocks.SignerBackendStub{
+		SignPeerIDCalled: func(pkBytes []byte, pid []byte) ([]byte, error) {
 			return nil, err
 		},
 	}
-	peerSigHandler := &mock.PeerSignatureHandler{Signer: singleSignerMock}
 
 	cm, _ := broadcast.NewCommonMessenger(
 		marshalizerMock,
 		messengerMock,
-		privateKeyMock,
+		signerBackend,
 		shardCoordinatorMock,
-		peerSigHandler,
 	)
 
 	msg := &consensus.Message{}
@@ -61,47 +59,72 @@ func TestCommonMessenger_BroadcastConsensusMessageShouldWork(t *testing.T) {
 		BroadcastCalled: func(topic string, buff []byte) {
 		},
 	}
-	privateKeyMock := &mock.PrivateKeyMock{}
 	shardCoordinatorMock := &mock.ShardCoordinatorMock{}
-	singleSignerMock := &mock.SingleSignerMock{
-		SignStub: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
-			return []byte(""), nil
+
+	cm, _ := broadcast.NewCommonMessenger(
+		marshalizerMock,
+		messengerMock,
+		&cryptoMocks.SignerBackendStub{},
+		shardCoordinatorMock,
+	)
+
+	msg := &consensus.Message{}
+	err := cm.BroadcastConsensusMessage(msg)
+	assert.Nil(t, err)
+}
+
+func TestCommonMessenger_BroadcastConsensusMessageShouldSignWithManagedKey(t *testing.T) {
+	marshalizerMock := &mock.MarshalizerMock{}
+	messengerMock := &mock.MessengerStub{
+		BroadcastCalled: func(topic string, buff []byte) {
+		},
+	}
+	managedPubKey := []byte("managed pub key")
+	shardCoordinatorMock := &mock.ShardCoordinatorMock{}
+	signerBackend := &cryptoMocks.SignerBackendStub{
+		SignPeerIDCalled: func(pkBytes []byte, pid []byte) ([]byte, error) {
+			if bytes.Equal(pkBytes, managedPubKey) {
+				return []byte("managed signature"), nil
+			}
+
+			return []byte("own signature"), nil
 		},
 	}
-	peerSigHandler := &mock.PeerSignatureHandler{Signer: singleSignerMock}
 
 	cm, _ := broadcast.NewCommonMessenger(
 		marshalizerMock,
 		messengerMock,
-		privateKeyMock,
+		signerBackend,
 		shardCoordinatorMock,
-		peerSigHandler,
 	)
 
-	msg := &consensus.Message{}
+	msg := &consensus.Message{PubKey: managedPubKey}
 	err := cm.BroadcastConsensusMessage(msg)
 	assert.Nil(t, err)
+	assert.Equal(t, []byte("managed signature"), msg.Signature)
+
+	msg = &consensus.Message{PubKey: []byte("own pub key")}
+	err = cm.BroadcastConsensusMessage(msg)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("own signature"), msg.Signature)
 }
 
 func TestCommonMessenger_SignMessageShouldErrWhenSignFail(t *testing.T) {
 	err := errors.New("sign message error")
 	marshalizerMock := &mock.MarshalizerMock{}
 	messengerMock := &mock.MessengerStub{}
-	privateKeyMock := &mock.PrivateKeyMock{}
 	shardCoordinatorMock := &mock.ShardCoordinatorMock{}
-	singleSignerMock := &mock.SingleSignerMock{
-		SignStub: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+	signerBackend := &cryptoMocks.SignerBackendStub{
+		SignPeerIDCalled: func(pkBytes []byte, pid []byte) ([]byte, error) {
 			return nil, err
 		},
 	}
-	peerSigHandler := &mock.PeerSignatureHandler{Signer: singleSignerMock}
 
 	cm, _ := broadcast.NewCommonMessenger(
 		marshalizerMock,
 		messengerMock,
-		privateKeyMock,
+		signerBackend,
 		shardCoordinatorMock,
-		peerSigHandler,
 	)
 
 	msg := &consensus.Message{}
@@ -142,21 +165,13 @@ func TestSubroundEndRound_ExtractMiniBlocksAndTransactionsShouldWork(t *testing.
 		BroadcastCalled: func(topic string, buff []byte) {
 		},
 	}
-	privateKeyMock := &mock.PrivateKeyMock{}
 	shardCoordinatorMock := &mock.ShardCoordinatorMock{}
-	singleSignerMock := &mock.SingleSignerMock{
-		SignStub: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
-			return []byte(""), nil
-		},
-	}
-	peerSigHandler := &mock.PeerSignatureHandler{Signer: singleSignerMock}
 
 	cm, _ := broadcast.NewCommonMessenger(
 		marshalizerMock,
 		messengerMock,
-		privateKeyMock,
+		&cryptoMocks.SignerBackendStub{},
 		shardCoordinatorMock,
-		peerSigHandler,
 	)
 
 	metaMiniBlocks, metaTransactions := cm.ExtractMetaMiniBlocksAndTransactions(miniBlocks, transactions)
@@ -184,21 +199,13 @@ func TestCommonMessenger_BroadcastBlockData(t *testing.T) {
 			mutCounters.Unlock()
 		},
 	}
-	privateKeyMock := &mock.PrivateKeyMock{}
 	shardCoordinatorMock := &mock.ShardCoordinatorMock{}
-	singleSignerMock := &mock.SingleSignerMock{
-		SignStub: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
-			return []byte(""), nil
-		},
-	}
-	peerSigHandler := &mock.PeerSignatureHandler{Signer: singleSignerMock}
 
 	cm, _ := broadcast.NewCommonMessenger(
 		marshalizerMock,
 		messengerMock,
-		privateKeyMock,
+		&cryptoMocks.SignerBackendStub{},
 		shardCoordinatorMock,
-		peerSigHandler,
 	)
 
 	miniBlocks := map[uint32][]byte{0: []byte("mbs data1"), 1: []byte("mbs data2")}
@@ -5,7 +5,6 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
-	"github.com/ElrondNetwork/elrond-go-crypto"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 )
@@ -18,7 +17,7 @@ type HeaderDataForValidator struct {
 
 // SignMessage will sign and return the given message
 func (cm *commonMessenger) SignMessage(message *consensus.Message) ([]byte, error) {
-	return cm.peerSignatureHandler.GetPeerSignature(cm.privateKey, message.OriginatorPid)
+	return cm.signerBackend.SignPeerID(message.PubKey, message.OriginatorPid)
 }
 
 // ExtractMetaMiniBlocksAndTransactions -
@@ -175,16 +174,14 @@ func (dbb *delayedBlockBroadcaster) InterceptedHeaderData(topic string, hash []b
 func NewCommonMessenger(
 	marshalizer marshal.Marshalizer,
 	messenger consensus.P2PMessenger,
-	privateKey crypto.PrivateKey,
+	signerBackend consensus.SignerBackend,
 	shardCoordinator sharding.Coordinator,
-	peerSigHandler crypto.PeerSignatureHandler,
 ) (*commonMessenger, error) {
 
 	return &commonMessenger{
-		marshalizer:          marshalizer,
-		messenger:            messenger,
-		privateKey:           privateKey,
-		shardCoordinator:     shardCoordinator,
-		peerSignatureHandler: peerSigHandler,
+		marshalizer:      marshalizer,
+		messenger:        messenger,
+		signerBackend:    signerBackend,
+		shardCoordinator: shardCoordinator,
 	}, nil
 }
@@ -50,9 +50,8 @@ func NewMetaChainMessenger(
 		marshalizer:             args.Marshalizer,
 		hasher:                  args.Hasher,
 		messenger:               args.Messenger,
-		privateKey:              args.PrivateKey,
 		shardCoordinator:        args.ShardCoordinator,
-		peerSignatureHandler:    args.PeerSignatureHandler,
+		signerBackend:           args.SignerBackend,
 		delayedBlockBroadcaster: dbb,
 	}
 
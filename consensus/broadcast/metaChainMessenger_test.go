@@ -10,6 +10,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/consensus/broadcast"
 	"github.com/ElrondNetwork/elrond-go/consensus/mock"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/testscommon/cryptoMocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -17,13 +18,10 @@ import (
 func createDefaultMetaChainArgs() broadcast.MetaChainMessengerArgs {
 	marshalizerMock := &mock.MarshalizerMock{}
 	messengerMock := &mock.MessengerStub{}
-	privateKeyMock := &mock.PrivateKeyMock{}
 	shardCoordinatorMock := &mock.ShardCoordinatorMock{}
-	singleSignerMock := &mock.SingleSignerMock{}
 	hasher := mock.HasherMock{}
 	headersSubscriber := &mock.HeadersCacherStub{}
 	interceptorsContainer := createInterceptorContainer()
-	peerSigHandler := &mock.PeerSignatureHandler{Signer: singleSignerMock}
 	alarmScheduler := &mock.AlarmSchedulerStub{}
 
 	return broadcast.MetaChainMessengerArgs{
@@ -31,9 +29,8 @@ func createDefaultMetaChainArgs() broadcast.MetaChainMessengerArgs {
 			Marshalizer:                marshalizerMock,
 			Hasher:                     hasher,
 			Messenger:                  messengerMock,
-			PrivateKey:                 privateKeyMock,
 			ShardCoordinator:           shardCoordinatorMock,
-			PeerSignatureHandler:       peerSigHandler,
+			SignerBackend:              &cryptoMocks.SignerBackendStub{},
 			HeadersSubscriber:          headersSubscriber,
 			InterceptorsContainer:      interceptorsContainer,
 			MaxValidatorDelayCacheSize: 2,
@@ -61,15 +58,6 @@ func TestMetaChainMessenger_NewMetaChainMessengerNilMessengerShouldFail(t *testi
 	assert.Equal(t, spos.ErrNilMessenger, err)
 }
 
-func TestMetaChainMessenger_NewMetaChainMessengerNilPrivateKeyShouldFail(t *testing.T) {
-	args := createDefaultMetaChainArgs()
-	args.PrivateKey = nil
-	mcm, err := broadcast.NewMetaChainMessenger(args)
-
-	assert.Nil(t, mcm)
-	assert.Equal(t, spos.ErrNilPrivateKey, err)
-}
-
 func TestMetaChainMessenger_NewMetaChainMessengerNilShardCoordinatorShouldFail(t *testing.T) {
 	args := createDefaultMetaChainArgs()
 	args.ShardCoordinator = nil
@@ -79,13 +67,13 @@ func TestMetaChainMessenger_NewMetaChainMessengerNilShardCoordinatorShouldFail(t
 	assert.Equal(t, spos.ErrNilShardCoordinator, err)
 }
 
-func TestMetaChainMessenger_NewMetaChainMessengerNilPeerSignatureHandlerShouldFail(t *testing.T) {
+func TestMetaChainMessenger_NewMetaChainMessengerNilSignerBackendShouldFail(t *testing.T) {
 	args := createDefaultMetaChainArgs()
-	args.PeerSignatureHandler = nil
+	args.SignerBackend = nil
 	mcm, err := broadcast.NewMetaChainMessenger(args)
 
 	assert.Nil(t, mcm)
-	assert.Equal(t, spos.ErrNilPeerSignatureHandler, err)
+	assert.Equal(t, spos.ErrNilSignerBackend, err)
 }
 
 func TestMetaChainMessenger_NewMetaChainMessengerShouldWork(t *testing.T) {
@@ -37,12 +37,11 @@ func NewShardChainMessenger(
 	}
 
 	cm := &commonMessenger{
-		marshalizer:          args.Marshalizer,
-		hasher:               args.Hasher,
-		messenger:            args.Messenger,
-		privateKey:           args.PrivateKey,
-		shardCoordinator:     args.ShardCoordinator,
-		peerSignatureHandler: args.PeerSignatureHandler,
+		marshalizer:      args.Marshalizer,
+		hasher:           args.Hasher,
+		messenger:        args.Messenger,
+		shardCoordinator: args.ShardCoordinator,
+		signerBackend:    args.SignerBackend,
 	}
 
 	dbbArgs := &ArgsDelayedBlockBroadcaster{
@@ -13,6 +13,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/factory"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/cryptoMocks"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -52,14 +53,9 @@ func createDefaultShardChainArgs() broadcast.ShardChainMessengerArgs {
 	marshalizerMock := &mock.MarshalizerMock{}
 	hasher := &mock.HasherMock{}
 	messengerMock := &mock.MessengerStub{}
-	privateKeyMock := &mock.PrivateKeyMock{}
 	shardCoordinatorMock := &mock.ShardCoordinatorMock{}
-	singleSignerMock := &mock.SingleSignerMock{}
 	headersSubscriber := &mock.HeadersCacherStub{}
 	interceptorsContainer := createInterceptorContainer()
-	peerSigHandler := &mock.PeerSignatureHandler{
-		Signer: singleSignerMock,
-	}
 	alarmScheduler := &mock.AlarmSchedulerStub{}
 
 	return broadcast.ShardChainMessengerArgs{
@@ -67,9 +63,8 @@ func createDefaultShardChainArgs() broadcast.ShardChainMessengerArgs {
 			Marshalizer:                marshalizerMock,
 			Hasher:                     hasher,
 			Messenger:                  messengerMock,
-			PrivateKey:                 privateKeyMock,
 			ShardCoordinator:           shardCoordinatorMock,
-			PeerSignatureHandler:       peerSigHandler,
+			SignerBackend:              &cryptoMocks.SignerBackendStub{},
 			HeadersSubscriber:          headersSubscriber,
 			InterceptorsContainer:      interceptorsContainer,
 			MaxDelayCacheSize:          1,
@@ -97,15 +92,6 @@ func TestShardChainMessenger_NewShardChainMessengerNilMessengerShouldFail(t *tes
 	assert.Equal(t, spos.ErrNilMessenger, err)
 }
 
-func TestShardChainMessenger_NewShardChainMessengerNilPrivateKeyShouldFail(t *testing.T) {
-	args := createDefaultShardChainArgs()
-	args.PrivateKey = nil
-	scm, err := broadcast.NewShardChainMessenger(args)
-
-	assert.Nil(t, scm)
-	assert.Equal(t, spos.ErrNilPrivateKey, err)
-}
-
 func TestShardChainMessenger_NewShardChainMessengerNilShardCoordinatorShouldFail(t *testing.T) {
 	args := createDefaultShardChainArgs()
 	args.ShardCoordinator = nil
@@ -115,13 +101,13 @@ func TestShardChainMessenger_NewShardChainMessengerNilShardCoordinatorShouldFail
 	assert.Equal(t, spos.ErrNilShardCoordinator, err)
 }
 
-func TestShardChainMessenger_NewShardChainMessengerNilPeerSignatureHandlerShouldFail(t *testing.T) {
+func TestShardChainMessenger_NewShardChainMessengerNilSignerBackendShouldFail(t *testing.T) {
 	args := createDefaultShardChainArgs()
-	args.PeerSignatureHandler = nil
+	args.SignerBackend = nil
 	scm, err := broadcast.NewShardChainMessenger(args)
 
 	assert.Nil(t, scm)
-	assert.Equal(t, spos.ErrNilPeerSignatureHandler, err)
+	assert.Equal(t, spos.ErrNilSignerBackend, err)
 }
 
 func TestShardChainMessenger_NewShardChainMessengerNilInterceptorsContainerShouldFail(t *testing.T) {
@@ -12,6 +12,9 @@ import (
 // BlsConsensusType specifies the signature scheme used in the consensus
 const BlsConsensusType = "bls"
 
+// PoAConsensusType specifies the single leader proof of authority consensus which also uses BLS keys
+const PoAConsensusType = "poa"
+
 // RoundHandler defines the actions which should be handled by a round implementation
 type RoundHandler interface {
 	Index() int64
@@ -138,3 +141,43 @@ type NodeRedundancyHandler interface {
 	ObserverPrivateKey() crypto.PrivateKey
 	IsInterfaceNil() bool
 }
+
+// RoundTracer records structured events describing how the consensus rounds unfolded
+type RoundTracer interface {
+	AddEvent(event RoundEvent)
+	RecentRounds() []RoundTrace
+	IsInterfaceNil() bool
+}
+
+// SlashingDetector collects cryptographic evidence of validators that signed conflicting data in the same round
+type SlashingDetector interface {
+	ProcessConsensusMessage(cnsMsg *Message)
+	ProcessHeader(header data.HeaderHandler, headerHash []byte)
+	Evidences() []*SlashingEvidence
+	IsInterfaceNil() bool
+}
+
+// KeysHandler holds the BLS keys managed by the current node and the per key activity counters
+type KeysHandler interface {
+	GetPrivateKey(pkBytes []byte) (crypto.PrivateKey, error)
+	IsKeyManagedByCurrentNode(pkBytes []byte) bool
+	ManagedKeys() [][]byte
+	IncrementRoundsInConsensusGroup(pkBytes []byte)
+	IncrementRoundsAsLeader(pkBytes []byte)
+	IncrementBlocksProposed(pkBytes []byte)
+	IncrementSignaturesSent(pkBytes []byte)
+	IncrementHeartbeatsSent(pkBytes []byte)
+	ManagedKeysMetrics() []*ManagedKeyMetrics
+	IsInterfaceNil() bool
+}
+
+// SignerBackend signs the consensus and peer authentication data on behalf of the BLS keys managed by the current
+// node. The private keys might be held by the node itself or by a remote signer
+type SignerBackend interface {
+	SignBlockHeader(pkBytes []byte, round int64, marshalizedHeader []byte) ([]byte, error)
+	SignRandSeed(pkBytes []byte, round int64, prevRandSeed []byte) ([]byte, error)
+	SignConsensusData(pkBytes []byte, round int64, consensusData []byte) ([]byte, error)
+	SignPeerID(pkBytes []byte, pid []byte) ([]byte, error)
+	PublicKeys() ([][]byte, error)
+	IsInterfaceNil() bool
+}
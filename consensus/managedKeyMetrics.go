@@ -0,0 +1,11 @@
+package consensus
+
+// ManagedKeyMetrics holds the activity counters of a BLS key managed by the current node
+type ManagedKeyMetrics struct {
+	PubKey                 string `json:"pubKey"`
+	RoundsInConsensusGroup uint64 `json:"roundsInConsensusGroup"`
+	RoundsAsLeader         uint64 `json:"roundsAsLeader"`
+	BlocksProposed         uint64 `json:"blocksProposed"`
+	SignaturesSent         uint64 `json:"signaturesSent"`
+	HeartbeatsSent         uint64 `json:"heartbeatsSent"`
+}
@@ -35,6 +35,9 @@ type ConsensusCoreMock struct {
 	headerSigVerifier       consensus.HeaderSigVerifier
 	fallbackHeaderValidator consensus.FallbackHeaderValidator
 	nodeRedundancyHandler   consensus.NodeRedundancyHandler
+	roundTracer             consensus.RoundTracer
+	keysHandler             consensus.KeysHandler
+	signerBackend           consensus.SignerBackend
 }
 
 // GetAntiFloodHandler -
@@ -222,6 +225,36 @@ func (ccm *ConsensusCoreMock) SetNodeRedundancyHandler(nodeRedundancyHandler con
 	ccm.nodeRedundancyHandler = nodeRedundancyHandler
 }
 
+// RoundTracer -
+func (ccm *ConsensusCoreMock) RoundTracer() consensus.RoundTracer {
+	return ccm.roundTracer
+}
+
+// SetRoundTracer -
+func (ccm *ConsensusCoreMock) SetRoundTracer(roundTracer consensus.RoundTracer) {
+	ccm.roundTracer = roundTracer
+}
+
+// KeysHandler -
+func (ccm *ConsensusCoreMock) KeysHandler() consensus.KeysHandler {
+	return ccm.keysHandler
+}
+
+// SetKeysHandler -
+func (ccm *ConsensusCoreMock) SetKeysHandler(keysHandler consensus.KeysHandler) {
+	ccm.keysHandler = keysHandler
+}
+
+// SignerBackend -
+func (ccm *ConsensusCoreMock) SignerBackend() consensus.SignerBackend {
+	return ccm.signerBackend
+}
+
+// SetSignerBackend -
+func (ccm *ConsensusCoreMock) SetSignerBackend(signerBackend consensus.SignerBackend) {
+	ccm.signerBackend = signerBackend
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ccm *ConsensusCoreMock) IsInterfaceNil() bool {
 	return ccm == nil
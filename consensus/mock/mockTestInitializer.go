@@ -136,6 +136,19 @@ func InitConsensusCore() *ConsensusCoreMock {
 	headerSigVerifier := &HeaderSigVerifierStub{}
 	fallbackHeaderValidator := &testscommon.FallBackHeaderValidatorStub{}
 	nodeRedundancyHandler := &NodeRedundancyHandlerStub{}
+	roundTracer := &RoundTracerStub{}
+	keysHandler := &cryptoMocks.KeysHandlerStub{}
+	signerBackend := &cryptoMocks.SignerBackendStub{
+		SignRandSeedCalled: func(pkBytes []byte, round int64, prevRandSeed []byte) ([]byte, error) {
+			return make([]byte, 0), nil
+		},
+		SignBlockHeaderCalled: func(pkBytes []byte, round int64, marshalizedHeader []byte) ([]byte, error) {
+			return make([]byte, 0), nil
+		},
+		SignConsensusDataCalled: func(pkBytes []byte, round int64, consensusData []byte) ([]byte, error) {
+			return make([]byte, 0), nil
+		},
+	}
 
 	container := &ConsensusCoreMock{
 		blockChain:              blockChain,
@@ -159,6 +172,9 @@ func InitConsensusCore() *ConsensusCoreMock {
 		headerSigVerifier:       headerSigVerifier,
 		fallbackHeaderValidator: fallbackHeaderValidator,
 		nodeRedundancyHandler:   nodeRedundancyHandler,
+		roundTracer:             roundTracer,
+		keysHandler:             keysHandler,
+		signerBackend:           signerBackend,
 	}
 
 	return container
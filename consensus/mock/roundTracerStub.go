@@ -0,0 +1,30 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/consensus"
+
+// RoundTracerStub -
+type RoundTracerStub struct {
+	AddEventCalled     func(event consensus.RoundEvent)
+	RecentRoundsCalled func() []consensus.RoundTrace
+}
+
+// AddEvent -
+func (rts *RoundTracerStub) AddEvent(event consensus.RoundEvent) {
+	if rts.AddEventCalled != nil {
+		rts.AddEventCalled(event)
+	}
+}
+
+// RecentRounds -
+func (rts *RoundTracerStub) RecentRounds() []consensus.RoundTrace {
+	if rts.RecentRoundsCalled != nil {
+		return rts.RecentRoundsCalled()
+	}
+
+	return make([]consensus.RoundTrace, 0)
+}
+
+// IsInterfaceNil -
+func (rts *RoundTracerStub) IsInterfaceNil() bool {
+	return rts == nil
+}
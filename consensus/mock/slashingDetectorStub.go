@@ -0,0 +1,41 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+)
+
+// SlashingDetectorStub -
+type SlashingDetectorStub struct {
+	ProcessConsensusMessageCalled func(cnsMsg *consensus.Message)
+	ProcessHeaderCalled           func(header data.HeaderHandler, headerHash []byte)
+	EvidencesCalled               func() []*consensus.SlashingEvidence
+}
+
+// ProcessConsensusMessage -
+func (sds *SlashingDetectorStub) ProcessConsensusMessage(cnsMsg *consensus.Message) {
+	if sds.ProcessConsensusMessageCalled != nil {
+		sds.ProcessConsensusMessageCalled(cnsMsg)
+	}
+}
+
+// ProcessHeader -
+func (sds *SlashingDetectorStub) ProcessHeader(header data.HeaderHandler, headerHash []byte) {
+	if sds.ProcessHeaderCalled != nil {
+		sds.ProcessHeaderCalled(header, headerHash)
+	}
+}
+
+// Evidences -
+func (sds *SlashingDetectorStub) Evidences() []*consensus.SlashingEvidence {
+	if sds.EvidencesCalled != nil {
+		return sds.EvidencesCalled()
+	}
+
+	return make([]*consensus.SlashingEvidence, 0)
+}
+
+// IsInterfaceNil -
+func (sds *SlashingDetectorStub) IsInterfaceNil() bool {
+	return sds == nil
+}
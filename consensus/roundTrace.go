@@ -0,0 +1,44 @@
+package consensus
+
+import "time"
+
+// RoundEventType defines the type of a consensus round event
+type RoundEventType string
+
+const (
+	// RoundEventJobStart is recorded when a subround starts its job
+	RoundEventJobStart RoundEventType = "jobStart"
+	// RoundEventJobEnd is recorded when a subround finished its job
+	RoundEventJobEnd RoundEventType = "jobEnd"
+	// RoundEventMessageReceived is recorded when a valid consensus message was received
+	RoundEventMessageReceived RoundEventType = "messageReceived"
+	// RoundEventThresholdReached is recorded when the consensus of a subround was achieved
+	RoundEventThresholdReached RoundEventType = "thresholdReached"
+	// RoundEventSubroundExtended is recorded when a subround ran out of time
+	RoundEventSubroundExtended RoundEventType = "subroundExtended"
+	// RoundEventSignatureAggregation is recorded after the signature shares were aggregated
+	RoundEventSignatureAggregation RoundEventType = "signatureAggregation"
+)
+
+// RoundEvent holds a structured consensus event. PubKey is the hex encoded key of the validator that produced the
+// event and SenderIndex its index in the consensus group (-1 if not part of it). The round tracer fills the Timestamp
+// and the Offset fields, Offset being measured from the start of the event round
+type RoundEvent struct {
+	Type        RoundEventType `json:"type"`
+	Round       int64          `json:"round"`
+	Subround    string         `json:"subround,omitempty"`
+	MessageType string         `json:"messageType,omitempty"`
+	PubKey      string         `json:"pubKey,omitempty"`
+	SenderIndex int            `json:"senderIndex"`
+	Success     bool           `json:"success"`
+	Duration    time.Duration  `json:"durationNs"`
+	Offset      time.Duration  `json:"offsetNs"`
+	Timestamp   time.Time      `json:"timestamp"`
+}
+
+// RoundTrace holds all the events recorded for one consensus round
+type RoundTrace struct {
+	Round     int64        `json:"round"`
+	StartTime time.Time    `json:"startTime"`
+	Events    []RoundEvent `json:"events"`
+}
@@ -0,0 +1,33 @@
+package slashing
+
+import "errors"
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilStorer signals that a nil storer has been provided
+var ErrNilStorer = errors.New("nil storer")
+
+// ErrNilMultiSigner signals that a nil multi signer has been provided
+var ErrNilMultiSigner = errors.New("nil multi signer")
+
+// ErrNilHeaderSigVerifier signals that a nil header signature verifier has been provided
+var ErrNilHeaderSigVerifier = errors.New("nil header signature verifier")
+
+// ErrNilNodesCoordinator signals that a nil nodes coordinator has been provided
+var ErrNilNodesCoordinator = errors.New("nil nodes coordinator")
+
+// ErrNilPubKeyConverter signals that a nil public key converter has been provided
+var ErrNilPubKeyConverter = errors.New("nil public key converter")
+
+// ErrEmptyEvidenceReceiver signals that an empty evidence transaction receiver has been provided
+var ErrEmptyEvidenceReceiver = errors.New("empty evidence transaction receiver")
+
+// ErrInvalidNumRoundsToKeep signals that an invalid number of rounds to keep has been provided
+var ErrInvalidNumRoundsToKeep = errors.New("invalid number of rounds to keep")
+
+// ErrInvalidEvidence signals that a stored evidence could not be decoded
+var ErrInvalidEvidence = errors.New("invalid evidence")
@@ -0,0 +1,447 @@
+package slashing
+
+import (
+	"bytes"
+	"encoding/hex"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/batch"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go-crypto"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var _ consensus.SlashingDetector = (*slashingDetector)(nil)
+
+var log = logger.GetOrCreate("consensus/slashing")
+
+const slashFunctionName = "slash"
+const numProofsInEvidence = 2
+
+// ArgsSlashingDetector holds the arguments needed to create a slashing detector
+type ArgsSlashingDetector struct {
+	Marshalizer             marshal.Marshalizer
+	Hasher                  hashing.Hasher
+	Storer                  storage.Storer
+	MultiSigner             crypto.MultiSigner
+	HeaderSigVerifier       consensus.HeaderSigVerifier
+	NodesCoordinator        sharding.NodesCoordinator
+	AddressPubKeyConverter  core.PubkeyConverter
+	SelfShardID             uint32
+	ChainID                 []byte
+	MinTransactionVersion   uint32
+	EvidenceReceiverAddress []byte
+	EvidenceTxGasLimit      uint64
+	NumRoundsToKeep         int64
+}
+
+type signedDataKey struct {
+	pubKey       string
+	shardID      uint32
+	round        int64
+	evidenceType consensus.SlashingEvidenceType
+}
+
+type signedData struct {
+	proof  *consensus.Message
+	verify func() error
+}
+
+type slashingDetector struct {
+	marshalizer             marshal.Marshalizer
+	hasher                  hashing.Hasher
+	storer                  storage.Storer
+	multiSigner             crypto.MultiSigner
+	headerSigVerifier       consensus.HeaderSigVerifier
+	nodesCoordinator        sharding.NodesCoordinator
+	addressPubKeyConverter  core.PubkeyConverter
+	selfShardID             uint32
+	chainID                 []byte
+	minTransactionVersion   uint32
+	evidenceReceiverAddress []byte
+	evidenceTxGasLimit      uint64
+	numRoundsToKeep         int64
+
+	mutData     sync.Mutex
+	firstSeen   map[signedDataKey]*signedData
+	latestRound int64
+	evidences   map[string]*consensus.SlashingEvidence
+}
+
+// NewSlashingDetector creates a component that remembers, for a limited number of rounds, the data signed by each
+// validator. When the same key signs two different headers in the same round, both signed proofs are verified,
+// persisted and exposed as a slashing evidence. The evidences are only verified locally, their on-chain verification
+// being left to the slash function of the validator system smart contract, which is not implemented yet
+func NewSlashingDetector(args ArgsSlashingDetector) (*slashingDetector, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	sd := &slashingDetector{
+		marshalizer:             args.Marshalizer,
+		hasher:                  args.Hasher,
+		storer:                  args.Storer,
+		multiSigner:             args.MultiSigner,
+		headerSigVerifier:       args.HeaderSigVerifier,
+		nodesCoordinator:        args.NodesCoordinator,
+		addressPubKeyConverter:  args.AddressPubKeyConverter,
+		selfShardID:             args.SelfShardID,
+		chainID:                 args.ChainID,
+		minTransactionVersion:   args.MinTransactionVersion,
+		evidenceReceiverAddress: args.EvidenceReceiverAddress,
+		evidenceTxGasLimit:      args.EvidenceTxGasLimit,
+		numRoundsToKeep:         args.NumRoundsToKeep,
+		firstSeen:               make(map[signedDataKey]*signedData),
+		evidences:               make(map[string]*consensus.SlashingEvidence),
+	}
+	sd.loadEvidences()
+
+	return sd, nil
+}
+
+func checkArgs(args ArgsSlashingDetector) error {
+	if check.IfNil(args.Marshalizer) {
+		return ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return ErrNilHasher
+	}
+	if check.IfNil(args.Storer) {
+		return ErrNilStorer
+	}
+	if check.IfNil(args.MultiSigner) {
+		return ErrNilMultiSigner
+	}
+	if check.IfNil(args.HeaderSigVerifier) {
+		return ErrNilHeaderSigVerifier
+	}
+	if check.IfNil(args.NodesCoordinator) {
+		return ErrNilNodesCoordinator
+	}
+	if check.IfNil(args.AddressPubKeyConverter) {
+		return ErrNilPubKeyConverter
+	}
+	if len(args.EvidenceReceiverAddress) == 0 {
+		return ErrEmptyEvidenceReceiver
+	}
+	if args.NumRoundsToKeep < 1 {
+		return ErrInvalidNumRoundsToKeep
+	}
+
+	return nil
+}
+
+// ProcessConsensusMessage checks the signature share carried by the provided consensus message against the one
+// previously given by the same key in the same round
+func (sd *slashingDetector) ProcessConsensusMessage(cnsMsg *consensus.Message) {
+	if cnsMsg == nil || len(cnsMsg.SignatureShare) == 0 || len(cnsMsg.BlockHeaderHash) == 0 {
+		return
+	}
+
+	proof := &consensus.Message{
+		BlockHeaderHash: cnsMsg.BlockHeaderHash,
+		SignatureShare:  cnsMsg.SignatureShare,
+		PubKey:          cnsMsg.PubKey,
+		MsgType:         cnsMsg.MsgType,
+		RoundIndex:      cnsMsg.RoundIndex,
+		ChainID:         cnsMsg.ChainID,
+	}
+	key := signedDataKey{
+		pubKey:       string(cnsMsg.PubKey),
+		shardID:      sd.selfShardID,
+		round:        cnsMsg.RoundIndex,
+		evidenceType: consensus.DoubleSigningEvidence,
+	}
+
+	sd.processSignedData(key, &signedData{
+		proof: proof,
+		verify: func() error {
+			return sd.verifySignatureShare(proof)
+		},
+	})
+}
+
+// ProcessHeader checks the leader signature of the provided header against the headers previously proposed by the
+// same leader in the same round
+func (sd *slashingDetector) ProcessHeader(header data.HeaderHandler, headerHash []byte) {
+	if check.IfNil(header) || len(header.GetLeaderSignature()) == 0 || len(headerHash) == 0 {
+		return
+	}
+
+	leaderPubKey, err := sd.getLeader(header)
+	if err != nil {
+		log.Trace("slashingDetector.ProcessHeader: cannot compute leader", "error", err.Error())
+		return
+	}
+
+	headerBytes, err := sd.marshalizer.Marshal(header)
+	if err != nil {
+		log.Trace("slashingDetector.ProcessHeader: cannot marshal header", "error", err.Error())
+		return
+	}
+
+	proof := &consensus.Message{
+		BlockHeaderHash: headerHash,
+		Header:          headerBytes,
+		PubKey:          leaderPubKey,
+		RoundIndex:      int64(header.GetRound()),
+		ChainID:         header.GetChainID(),
+		LeaderSignature: header.GetLeaderSignature(),
+	}
+	key := signedDataKey{
+		pubKey:       string(leaderPubKey),
+		shardID:      header.GetShardID(),
+		round:        proof.RoundIndex,
+		evidenceType: consensus.DoubleProposingEvidence,
+	}
+
+	sd.processSignedData(key, &signedData{
+		proof: proof,
+		verify: func() error {
+			return sd.headerSigVerifier.VerifyLeaderSignature(header)
+		},
+	})
+}
+
+func (sd *slashingDetector) getLeader(header data.HeaderHandler) ([]byte, error) {
+	epoch := header.GetEpoch()
+	if header.IsStartOfEpochBlock() && epoch > 0 {
+		epoch = epoch - 1
+	}
+
+	consensusGroup, err := sd.nodesCoordinator.ComputeConsensusGroup(header.GetPrevRandSeed(), header.GetRound(), header.GetShardID(), epoch)
+	if err != nil {
+		return nil, err
+	}
+	if len(consensusGroup) == 0 {
+		return nil, sharding.ErrInvalidConsensusGroupSize
+	}
+
+	return consensusGroup[0].PubKey(), nil
+}
+
+func (sd *slashingDetector) verifySignatureShare(proof *consensus.Message) error {
+	verifier, err := sd.multiSigner.Create([]string{string(proof.PubKey)}, 0)
+	if err != nil {
+		return err
+	}
+
+	return verifier.VerifySignatureShare(0, proof.SignatureShare, proof.BlockHeaderHash, nil)
+}
+
+// processSignedData only verifies signatures when a conflict is found so the common path stays cheap
+func (sd *slashingDetector) processSignedData(key signedDataKey, newData *signedData) {
+	sd.mutData.Lock()
+	defer sd.mutData.Unlock()
+
+	sd.removeOldRounds(key.round)
+	if key.round+sd.numRoundsToKeep <= sd.latestRound {
+		return
+	}
+
+	existingData, found := sd.firstSeen[key]
+	if !found {
+		sd.firstSeen[key] = newData
+		return
+	}
+	if bytes.Equal(existingData.proof.BlockHeaderHash, newData.proof.BlockHeaderHash) {
+		return
+	}
+
+	err := newData.verify()
+	if err != nil {
+		log.Debug("slashingDetector: conflicting signed data with invalid signature",
+			"type", key.evidenceType,
+			"round", key.round,
+			"error", err.Error())
+		return
+	}
+
+	err = existingData.verify()
+	if err != nil {
+		log.Debug("slashingDetector: replaced previously received signed data with invalid signature",
+			"type", key.evidenceType,
+			"round", key.round,
+			"error", err.Error())
+		sd.firstSeen[key] = newData
+		return
+	}
+
+	sd.addEvidence(key.evidenceType, existingData.proof, newData.proof)
+}
+
+func (sd *slashingDetector) removeOldRounds(round int64) {
+	if round <= sd.latestRound {
+		return
+	}
+
+	sd.latestRound = round
+	for key := range sd.firstSeen {
+		if key.round+sd.numRoundsToKeep <= sd.latestRound {
+			delete(sd.firstSeen, key)
+		}
+	}
+}
+
+func (sd *slashingDetector) addEvidence(evidenceType consensus.SlashingEvidenceType, first *consensus.Message, second *consensus.Message) {
+	// proofs are sorted so that all nodes observing the same misbehavior build the same evidence
+	if bytes.Compare(first.BlockHeaderHash, second.BlockHeaderHash) > 0 {
+		first, second = second, first
+	}
+
+	proofs := make([][]byte, 0, numProofsInEvidence)
+	for _, proof := range []*consensus.Message{first, second} {
+		proofBytes, err := sd.marshalizer.Marshal(proof)
+		if err != nil {
+			log.Warn("slashingDetector.addEvidence: cannot marshal proof", "error", err.Error())
+			return
+		}
+		proofs = append(proofs, proofBytes)
+	}
+
+	evidence, err := sd.createEvidence(evidenceType, proofs)
+	if err != nil {
+		log.Warn("slashingDetector.addEvidence: cannot create evidence", "error", err.Error())
+		return
+	}
+	if _, found := sd.evidences[evidence.Hash]; found {
+		return
+	}
+
+	log.Warn("slashable misbehavior detected",
+		"type", evidence.Type,
+		"public key", evidence.PubKey,
+		"round", evidence.Round,
+		"evidence hash", evidence.Hash)
+
+	sd.evidences[evidence.Hash] = evidence
+	sd.persistEvidence(evidence, proofs)
+}
+
+func (sd *slashingDetector) persistEvidence(evidence *consensus.SlashingEvidence, proofs [][]byte) {
+	record := &batch.Batch{
+		Data: append([][]byte{[]byte(evidence.Type)}, proofs...),
+	}
+	recordBytes, err := sd.marshalizer.Marshal(record)
+	if err != nil {
+		log.Warn("slashingDetector.persistEvidence: cannot marshal evidence", "error", err.Error())
+		return
+	}
+
+	hash, err := hex.DecodeString(evidence.Hash)
+	if err != nil {
+		log.Warn("slashingDetector.persistEvidence: cannot decode evidence hash", "error", err.Error())
+		return
+	}
+
+	err = sd.storer.Put(hash, recordBytes)
+	if err != nil {
+		log.Warn("slashingDetector.persistEvidence: cannot store evidence", "error", err.Error())
+	}
+}
+
+func (sd *slashingDetector) loadEvidences() {
+	sd.storer.RangeKeys(func(key []byte, value []byte) bool {
+		record := &batch.Batch{}
+		err := sd.marshalizer.Unmarshal(record, value)
+		if err != nil || len(record.Data) != numProofsInEvidence+1 {
+			log.Warn("slashingDetector.loadEvidences: cannot decode evidence", "key", key)
+			return true
+		}
+
+		evidence, err := sd.createEvidence(consensus.SlashingEvidenceType(record.Data[0]), record.Data[1:])
+		if err != nil {
+			log.Warn("slashingDetector.loadEvidences: cannot create evidence", "key", key, "error", err.Error())
+			return true
+		}
+
+		sd.evidences[evidence.Hash] = evidence
+		return true
+	})
+
+	log.Debug("slashingDetector: loaded evidences from storage", "num evidences", len(sd.evidences))
+}
+
+// createEvidence rebuilds all the evidence fields from the marshalized proofs so that a persisted evidence only
+// needs to hold its type and its proofs
+func (sd *slashingDetector) createEvidence(evidenceType consensus.SlashingEvidenceType, proofs [][]byte) (*consensus.SlashingEvidence, error) {
+	evidence := &consensus.SlashingEvidence{
+		Type:         evidenceType,
+		HeaderHashes: make([]string, 0, len(proofs)),
+		Proofs:       make([]string, 0, len(proofs)),
+	}
+
+	txDataParts := []string{
+		slashFunctionName,
+		hex.EncodeToString([]byte(evidenceType)),
+	}
+	for i, proofBytes := range proofs {
+		proof := &consensus.Message{}
+		err := sd.marshalizer.Unmarshal(proof, proofBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		if i == 0 {
+			evidence.PubKey = hex.EncodeToString(proof.PubKey)
+			evidence.Round = proof.RoundIndex
+			txDataParts = append(txDataParts, evidence.PubKey)
+		}
+		if hex.EncodeToString(proof.PubKey) != evidence.PubKey || proof.RoundIndex != evidence.Round {
+			return nil, ErrInvalidEvidence
+		}
+
+		evidence.HeaderHashes = append(evidence.HeaderHashes, hex.EncodeToString(proof.BlockHeaderHash))
+		evidence.Proofs = append(evidence.Proofs, hex.EncodeToString(proofBytes))
+		txDataParts = append(txDataParts, hex.EncodeToString(proofBytes))
+	}
+
+	txData := []byte(strings.Join(txDataParts, "@"))
+	evidence.Hash = hex.EncodeToString(sd.hasher.Compute(string(txData)))
+	evidence.Transaction = &transaction.FrontendTransaction{
+		Value:    "0",
+		Receiver: sd.addressPubKeyConverter.Encode(sd.evidenceReceiverAddress),
+		GasLimit: sd.evidenceTxGasLimit,
+		Data:     txData,
+		ChainID:  string(sd.chainID),
+		Version:  sd.minTransactionVersion,
+	}
+
+	return evidence, nil
+}
+
+// Evidences returns all the known evidences, sorted by round
+func (sd *slashingDetector) Evidences() []*consensus.SlashingEvidence {
+	sd.mutData.Lock()
+	evidences := make([]*consensus.SlashingEvidence, 0, len(sd.evidences))
+	for _, evidence := range sd.evidences {
+		evidences = append(evidences, evidence)
+	}
+	sd.mutData.Unlock()
+
+	sort.Slice(evidences, func(i, j int) bool {
+		if evidences[i].Round == evidences[j].Round {
+			return evidences[i].Hash < evidences[j].Hash
+		}
+
+		return evidences[i].Round < evidences[j].Round
+	})
+
+	return evidences
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sd *slashingDetector) IsInterfaceNil() bool {
+	return sd == nil
+}
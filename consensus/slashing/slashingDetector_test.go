@@ -0,0 +1,266 @@
+package slashing_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/mock"
+	"github.com/ElrondNetwork/elrond-go/consensus/slashing"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/cryptoMocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var receiverAddress = []byte("validator SC address............")
+
+func createMemUnit() storage.Storer {
+	cache, _ := lrucache.NewCache(10)
+	unit, _ := storageUnit.NewStorageUnit(cache, memorydb.New())
+
+	return unit
+}
+
+func createMockArgs() slashing.ArgsSlashingDetector {
+	return slashing.ArgsSlashingDetector{
+		Marshalizer:             &mock.MarshalizerMock{},
+		Hasher:                  &mock.HasherMock{},
+		Storer:                  createMemUnit(),
+		MultiSigner:             cryptoMocks.NewMultiSigner(1),
+		HeaderSigVerifier:       &mock.HeaderSigVerifierStub{},
+		NodesCoordinator:        &mock.NodesCoordinatorMock{},
+		AddressPubKeyConverter:  testscommon.NewPubkeyConverterMock(32),
+		SelfShardID:             0,
+		ChainID:                 []byte("chain"),
+		MinTransactionVersion:   1,
+		EvidenceReceiverAddress: receiverAddress,
+		EvidenceTxGasLimit:      1000,
+		NumRoundsToKeep:         3,
+	}
+}
+
+func createSignatureMessage(pubKey string, round int64, headerHash string) *consensus.Message {
+	return &consensus.Message{
+		BlockHeaderHash: []byte(headerHash),
+		SignatureShare:  []byte("share of " + headerHash),
+		PubKey:          []byte(pubKey),
+		RoundIndex:      round,
+		OriginatorPid:   []byte("pid"),
+	}
+}
+
+func createSignedHeader(round uint64, rootHash string) data.HeaderHandler {
+	return &block.Header{
+		Round:           round,
+		RootHash:        []byte(rootHash),
+		PrevRandSeed:    []byte("prev rand seed"),
+		LeaderSignature: []byte("leader signature"),
+	}
+}
+
+func TestNewSlashingDetector_InvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		modifier    func(args *slashing.ArgsSlashingDetector)
+		expectedErr error
+	}{
+		{name: "nil marshalizer", modifier: func(args *slashing.ArgsSlashingDetector) { args.Marshalizer = nil }, expectedErr: slashing.ErrNilMarshalizer},
+		{name: "nil hasher", modifier: func(args *slashing.ArgsSlashingDetector) { args.Hasher = nil }, expectedErr: slashing.ErrNilHasher},
+		{name: "nil storer", modifier: func(args *slashing.ArgsSlashingDetector) { args.Storer = nil }, expectedErr: slashing.ErrNilStorer},
+		{name: "nil multi signer", modifier: func(args *slashing.ArgsSlashingDetector) { args.MultiSigner = nil }, expectedErr: slashing.ErrNilMultiSigner},
+		{name: "nil header sig verifier", modifier: func(args *slashing.ArgsSlashingDetector) { args.HeaderSigVerifier = nil }, expectedErr: slashing.ErrNilHeaderSigVerifier},
+		{name: "nil nodes coordinator", modifier: func(args *slashing.ArgsSlashingDetector) { args.NodesCoordinator = nil }, expectedErr: slashing.ErrNilNodesCoordinator},
+		{name: "nil pub key converter", modifier: func(args *slashing.ArgsSlashingDetector) { args.AddressPubKeyConverter = nil }, expectedErr: slashing.ErrNilPubKeyConverter},
+		{name: "empty receiver", modifier: func(args *slashing.ArgsSlashingDetector) { args.EvidenceReceiverAddress = nil }, expectedErr: slashing.ErrEmptyEvidenceReceiver},
+		{name: "invalid num rounds", modifier: func(args *slashing.ArgsSlashingDetector) { args.NumRoundsToKeep = 0 }, expectedErr: slashing.ErrInvalidNumRoundsToKeep},
+	}
+
+	for _, tt := range tests {
+		args := createMockArgs()
+		tt.modifier(&args)
+
+		sd, err := slashing.NewSlashingDetector(args)
+		assert.True(t, check.IfNil(sd), tt.name)
+		assert.Equal(t, tt.expectedErr, err, tt.name)
+	}
+}
+
+func TestNewSlashingDetector_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	sd, err := slashing.NewSlashingDetector(createMockArgs())
+
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(sd))
+	assert.Equal(t, 0, len(sd.Evidences()))
+}
+
+func TestSlashingDetector_ProcessConsensusMessageSameHeaderShouldNotCreateEvidence(t *testing.T) {
+	t.Parallel()
+
+	sd, _ := slashing.NewSlashingDetector(createMockArgs())
+
+	sd.ProcessConsensusMessage(createSignatureMessage("A", 5, "hash1"))
+	sd.ProcessConsensusMessage(createSignatureMessage("A", 5, "hash1"))
+	sd.ProcessConsensusMessage(createSignatureMessage("B", 5, "hash2"))
+	sd.ProcessConsensusMessage(createSignatureMessage("A", 6, "hash2"))
+	sd.ProcessConsensusMessage(&consensus.Message{PubKey: []byte("A"), RoundIndex: 5, BlockHeaderHash: []byte("hash3")})
+
+	assert.Equal(t, 0, len(sd.Evidences()))
+}
+
+func TestSlashingDetector_ProcessConsensusMessageDoubleSigningShouldCreateEvidence(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	storer := args.Storer
+	sd, _ := slashing.NewSlashingDetector(args)
+
+	sd.ProcessConsensusMessage(createSignatureMessage("A", 5, "hash2"))
+	sd.ProcessConsensusMessage(createSignatureMessage("A", 5, "hash1"))
+
+	evidences := sd.Evidences()
+	require.Equal(t, 1, len(evidences))
+	evidence := evidences[0]
+	assert.Equal(t, consensus.DoubleSigningEvidence, evidence.Type)
+	assert.Equal(t, hex.EncodeToString([]byte("A")), evidence.PubKey)
+	assert.Equal(t, int64(5), evidence.Round)
+	assert.Equal(t, []string{hex.EncodeToString([]byte("hash1")), hex.EncodeToString([]byte("hash2"))}, evidence.HeaderHashes)
+	assert.Equal(t, 2, len(evidence.Proofs))
+
+	tx := evidence.Transaction
+	require.NotNil(t, tx)
+	assert.Equal(t, hex.EncodeToString(receiverAddress), tx.Receiver)
+	assert.Equal(t, "0", tx.Value)
+	assert.Equal(t, uint64(1000), tx.GasLimit)
+	assert.Equal(t, "chain", tx.ChainID)
+	assert.Equal(t, uint32(1), tx.Version)
+	txDataParts := strings.Split(string(tx.Data), "@")
+	require.Equal(t, 5, len(txDataParts))
+	assert.Equal(t, "slash", txDataParts[0])
+	assert.Equal(t, hex.EncodeToString([]byte(consensus.DoubleSigningEvidence)), txDataParts[1])
+	assert.Equal(t, evidence.PubKey, txDataParts[2])
+	assert.Equal(t, evidence.Proofs, txDataParts[3:])
+
+	hash, _ := hex.DecodeString(evidence.Hash)
+	assert.Nil(t, storer.Has(hash))
+
+	// the same misbehavior seen again, in reversed order, should not duplicate the evidence
+	sd.ProcessConsensusMessage(createSignatureMessage("A", 5, "hash2"))
+	assert.Equal(t, 1, len(sd.Evidences()))
+}
+
+func TestSlashingDetector_ProcessConsensusMessageInvalidSharesShouldNotCreateEvidence(t *testing.T) {
+	t.Parallel()
+
+	invalidShare := []byte("share of hash2")
+	multiSigner := cryptoMocks.NewMultiSigner(1)
+	multiSigner.CreateCalled = func(pubKeys []string, index uint16) (crypto.MultiSigner, error) {
+		verifier := cryptoMocks.NewMultiSigner(1)
+		verifier.VerifySignatureShareCalled = func(index uint16, sig []byte, msg []byte, bitmap []byte) error {
+			if string(sig) == string(invalidShare) {
+				return errors.New("invalid share")
+			}
+
+			return nil
+		}
+
+		return verifier, nil
+	}
+	args := createMockArgs()
+	args.MultiSigner = multiSigner
+	sd, _ := slashing.NewSlashingDetector(args)
+
+	sd.ProcessConsensusMessage(createSignatureMessage("A", 5, "hash1"))
+	sd.ProcessConsensusMessage(createSignatureMessage("A", 5, "hash2"))
+	assert.Equal(t, 0, len(sd.Evidences()))
+
+	// an invalid first share is replaced by the valid conflicting one
+	sd.ProcessConsensusMessage(createSignatureMessage("B", 6, "hash2"))
+	sd.ProcessConsensusMessage(createSignatureMessage("B", 6, "hash1"))
+	sd.ProcessConsensusMessage(createSignatureMessage("B", 6, "hash3"))
+	evidences := sd.Evidences()
+	require.Equal(t, 1, len(evidences))
+	assert.Equal(t, []string{hex.EncodeToString([]byte("hash1")), hex.EncodeToString([]byte("hash3"))}, evidences[0].HeaderHashes)
+}
+
+func TestSlashingDetector_ProcessConsensusMessageOldRoundsShouldBeForgotten(t *testing.T) {
+	t.Parallel()
+
+	sd, _ := slashing.NewSlashingDetector(createMockArgs())
+
+	sd.ProcessConsensusMessage(createSignatureMessage("A", 5, "hash1"))
+	sd.ProcessConsensusMessage(createSignatureMessage("B", 8, "hash2"))
+	sd.ProcessConsensusMessage(createSignatureMessage("A", 5, "hash3"))
+
+	assert.Equal(t, 0, len(sd.Evidences()))
+}
+
+func TestSlashingDetector_ProcessHeaderDoubleProposingShouldCreateEvidence(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.NodesCoordinator = &mock.NodesCoordinatorMock{
+		ComputeValidatorsGroupCalled: func(randomness []byte, round uint64, shardId uint32, epoch uint32) ([]sharding.Validator, error) {
+			return []sharding.Validator{mock.NewValidator([]byte("leader"), 1, 1)}, nil
+		},
+	}
+	numVerifications := 0
+	args.HeaderSigVerifier = &mock.HeaderSigVerifierStub{
+		VerifyLeaderSignatureCalled: func(header data.HeaderHandler) error {
+			numVerifications++
+			return nil
+		},
+	}
+	sd, _ := slashing.NewSlashingDetector(args)
+
+	sd.ProcessHeader(createSignedHeader(7, "root hash 1"), []byte("hash1"))
+	sd.ProcessHeader(createSignedHeader(7, "root hash 1"), []byte("hash1"))
+	assert.Equal(t, 0, numVerifications)
+
+	unsignedHeader := createSignedHeader(7, "root hash 3")
+	unsignedHeader.SetLeaderSignature(nil)
+	sd.ProcessHeader(unsignedHeader, []byte("hash3"))
+	assert.Equal(t, 0, len(sd.Evidences()))
+
+	sd.ProcessHeader(createSignedHeader(7, "root hash 2"), []byte("hash2"))
+	assert.Equal(t, 2, numVerifications)
+
+	evidences := sd.Evidences()
+	require.Equal(t, 1, len(evidences))
+	assert.Equal(t, consensus.DoubleProposingEvidence, evidences[0].Type)
+	assert.Equal(t, hex.EncodeToString([]byte("leader")), evidences[0].PubKey)
+	assert.Equal(t, int64(7), evidences[0].Round)
+}
+
+func TestSlashingDetector_EvidencesShouldBeLoadedFromStorage(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	sd, _ := slashing.NewSlashingDetector(args)
+	sd.ProcessConsensusMessage(createSignatureMessage("A", 5, "hash1"))
+	sd.ProcessConsensusMessage(createSignatureMessage("A", 5, "hash2"))
+	sd.ProcessConsensusMessage(createSignatureMessage("B", 4, "hash1"))
+	sd.ProcessConsensusMessage(createSignatureMessage("B", 4, "hash2"))
+	expectedEvidences := sd.Evidences()
+	require.Equal(t, 2, len(expectedEvidences))
+	assert.Equal(t, int64(4), expectedEvidences[0].Round)
+
+	reloaded, err := slashing.NewSlashingDetector(args)
+	require.Nil(t, err)
+	assert.Equal(t, expectedEvidences, reloaded.Evidences())
+}
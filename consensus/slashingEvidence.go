@@ -0,0 +1,28 @@
+package consensus
+
+import "github.com/ElrondNetwork/elrond-go-core/data/transaction"
+
+// SlashingEvidenceType defines the kind of misbehavior proven by a slashing evidence
+type SlashingEvidenceType string
+
+const (
+	// DoubleSigningEvidence is built from two signature shares given by the same key for different headers
+	// in the same round
+	DoubleSigningEvidence SlashingEvidenceType = "doubleSigning"
+	// DoubleProposingEvidence is built from two different headers signed by the same leader in the same round
+	DoubleProposingEvidence SlashingEvidenceType = "doubleProposing"
+)
+
+// SlashingEvidence holds the signed proofs of a slashable misbehavior. Each proof is a marshalized consensus Message
+// holding the signed data along with its signature. Transaction is the unsigned evidence transaction that can be
+// completed (sender, nonce, gas price, signature) and submitted on-chain once the validator system smart contract
+// implements the verification of the evidences in its slash function, which is currently a placeholder
+type SlashingEvidence struct {
+	Hash         string                           `json:"hash"`
+	Type         SlashingEvidenceType             `json:"type"`
+	PubKey       string                           `json:"pubKey"`
+	Round        int64                            `json:"round"`
+	HeaderHashes []string                         `json:"headerHashes"`
+	Proofs       []string                         `json:"proofs"`
+	Transaction  *transaction.FrontendTransaction `json:"transaction"`
+}
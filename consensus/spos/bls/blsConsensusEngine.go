@@ -0,0 +1,52 @@
+package bls
+
+import (
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+)
+
+var _ spos.ConsensusEngine = (*consensusEngine)(nil)
+
+// consensusEngine is the BLS multi-signature engine: the leader proposes a block, the consensus group members
+// send their signature shares and the leader aggregates them and broadcasts the final info
+type consensusEngine struct {
+}
+
+// NewConsensusEngine creates the BLS consensus engine
+func NewConsensusEngine() *consensusEngine {
+	return &consensusEngine{}
+}
+
+// Name returns the BLS consensus type
+func (ce *consensusEngine) Name() string {
+	return consensus.BlsConsensusType
+}
+
+// CreateConsensusService creates the BLS consensus service
+func (ce *consensusEngine) CreateConsensusService() (spos.ConsensusService, error) {
+	return NewConsensusService()
+}
+
+// CreateSubroundsFactory creates the factory for the StartRound, Block, Signature and EndRound subrounds
+func (ce *consensusEngine) CreateSubroundsFactory(args spos.ArgsSubroundsFactory) (spos.SubroundsFactory, error) {
+	fct, err := NewSubroundsFactory(
+		args.ConsensusDataContainer,
+		args.ConsensusState,
+		args.Worker,
+		args.ChainID,
+		args.CurrentPid,
+		args.AppStatusHandler,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	fct.SetOutportHandler(args.OutportHandler)
+
+	return fct, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ce *consensusEngine) IsInterfaceNil() bool {
+	return ce == nil
+}
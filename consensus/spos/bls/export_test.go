@@ -180,7 +180,7 @@ func (sr *subroundBlock) IsBlockReceived(threshold int) bool {
 
 // CreateHeader method creates the proposed block header in the subround Block
 func (sr *subroundBlock) CreateHeader() (data.HeaderHandler, error) {
-	return sr.createHeader()
+	return sr.createHeader(sr.SelfPubKey())
 }
 
 // CreateBody method creates the proposed block body in the subround Block
@@ -190,12 +190,12 @@ func (sr *subroundBlock) CreateBlock(hdr data.HeaderHandler) (data.HeaderHandler
 
 // SendBlockBody method sends the proposed block body in the subround Block
 func (sr *subroundBlock) SendBlockBody(body data.BodyHandler, marshalizedBody []byte) bool {
-	return sr.sendBlockBody(body, marshalizedBody)
+	return sr.sendBlockBody(body, marshalizedBody, sr.SelfPubKey())
 }
 
 // SendBlockHeader method sends the proposed block header in the subround Block
 func (sr *subroundBlock) SendBlockHeader(header data.HeaderHandler, marshalizedHeader []byte) bool {
-	return sr.sendBlockHeader(header, marshalizedHeader)
+	return sr.sendBlockHeader(header, marshalizedHeader, sr.SelfPubKey())
 }
 
 // ComputeSubroundProcessingMetric computes processing metric related to the subround Block
@@ -267,7 +267,7 @@ func (sr *subroundEndRound) HaveConsensusHeaderWithFullInfo(cnsDta *consensus.Me
 }
 
 func (sr *subroundEndRound) CreateAndBroadcastHeaderFinalInfo() {
-	sr.createAndBroadcastHeaderFinalInfo()
+	sr.createAndBroadcastHeaderFinalInfo(sr.SelfPubKey())
 }
 
 func (sr *subroundEndRound) ReceivedBlockHeaderFinalInfo(cnsDta *consensus.Message) bool {
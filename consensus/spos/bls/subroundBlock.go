@@ -62,7 +62,14 @@ func checkNewSubroundBlockParams(
 
 // doBlockJob method does the job of the subround Block
 func (sr *subroundBlock) doBlockJob() bool {
-	if !sr.IsSelfLeaderInCurrentRound() { // is NOT self leader in this round?
+	isSelfLeader := sr.IsSelfLeaderInCurrentRound()
+	if !isSelfLeader && !sr.IsMultiKeyLeaderInCurrentRound() { // is NOT self leader in this round?
+		return false
+	}
+
+	leader, err := sr.GetLeader()
+	if err != nil {
+		log.Debug("doBlockJob.GetLeader", "error", err.Error())
 		return false
 	}
 
@@ -70,7 +77,7 @@ func (sr *subroundBlock) doBlockJob() bool {
 		return false
 	}
 
-	if sr.IsSelfJobDone(sr.Current()) {
+	if sr.IsJobDone(leader, sr.Current()) {
 		return false
 	}
 
@@ -81,7 +88,7 @@ func (sr *subroundBlock) doBlockJob() bool {
 	metricStatTime := time.Now()
 	defer sr.computeSubroundProcessingMetric(metricStatTime, common.MetricCreatedProposedBlock)
 
-	header, err := sr.createHeader()
+	header, err := sr.createHeader(leader)
 	if err != nil {
 		log.Debug("doBlockJob.createHeader", "error", err.Error())
 		return false
@@ -93,21 +100,25 @@ func (sr *subroundBlock) doBlockJob() bool {
 		return false
 	}
 
-	sentWithSuccess := sr.sendBlock(body, header)
+	sentWithSuccess := sr.sendBlock(body, header, leader)
 	if !sentWithSuccess {
 		return false
 	}
 
-	err = sr.SetSelfJobDone(sr.Current(), true)
+	err = sr.SetJobDone(leader, sr.Current(), true)
 	if err != nil {
-		log.Debug("doBlockJob.SetSelfJobDone", "error", err.Error())
+		log.Debug("doBlockJob.SetJobDone", "error", err.Error())
 		return false
 	}
 
+	if !isSelfLeader {
+		log.Debug("step 1: block proposed on behalf of a managed key", "leader", []byte(leader))
+	}
+
 	return true
 }
 
-func (sr *subroundBlock) sendBlock(body data.BodyHandler, header data.HeaderHandler) bool {
+func (sr *subroundBlock) sendBlock(body data.BodyHandler, header data.HeaderHandler, leader string) bool {
 	marshalizedBody, err := sr.Marshalizer().Marshal(body)
 	if err != nil {
 		log.Debug("sendBlock.Marshal: body", "error", err.Error())
@@ -121,10 +132,10 @@ func (sr *subroundBlock) sendBlock(body data.BodyHandler, header data.HeaderHand
 	}
 
 	if sr.couldBeSentTogether(marshalizedBody, marshalizedHeader) {
-		return sr.sendBlockBodyAndHeader(body, header, marshalizedBody, marshalizedHeader)
+		return sr.sendBlockBodyAndHeader(body, header, marshalizedBody, marshalizedHeader, leader)
 	}
 
-	if !sr.sendBlockBody(body, marshalizedBody) || !sr.sendBlockHeader(header, marshalizedHeader) {
+	if !sr.sendBlockBody(body, marshalizedBody, leader) || !sr.sendBlockHeader(header, marshalizedHeader, leader) {
 		return false
 	}
 
@@ -165,6 +176,7 @@ func (sr *subroundBlock) sendBlockBodyAndHeader(
 	headerHandler data.HeaderHandler,
 	marshalizedBody []byte,
 	marshalizedHeader []byte,
+	leader string,
 ) bool {
 	headerHash := sr.Hasher().Compute(string(marshalizedHeader))
 
@@ -173,7 +185,7 @@ func (sr *subroundBlock) sendBlockBodyAndHeader(
 		nil,
 		marshalizedBody,
 		marshalizedHeader,
-		[]byte(sr.SelfPubKey()),
+		[]byte(leader),
 		nil,
 		int(MtBlockBodyAndHeader),
 		sr.RoundHandler().Index(),
@@ -202,13 +214,13 @@ func (sr *subroundBlock) sendBlockBodyAndHeader(
 }
 
 // sendBlockBody method sends the proposed block body in the subround Block
-func (sr *subroundBlock) sendBlockBody(bodyHandler data.BodyHandler, marshalizedBody []byte) bool {
+func (sr *subroundBlock) sendBlockBody(bodyHandler data.BodyHandler, marshalizedBody []byte, leader string) bool {
 	cnsMsg := consensus.NewConsensusMessage(
 		nil,
 		nil,
 		marshalizedBody,
 		nil,
-		[]byte(sr.SelfPubKey()),
+		[]byte(leader),
 		nil,
 		int(MtBlockBody),
 		sr.RoundHandler().Index(),
@@ -233,7 +245,7 @@ func (sr *subroundBlock) sendBlockBody(bodyHandler data.BodyHandler, marshalized
 }
 
 // sendBlockHeader method sends the proposed block header in the subround Block
-func (sr *subroundBlock) sendBlockHeader(headerHandler data.HeaderHandler, marshalizedHeader []byte) bool {
+func (sr *subroundBlock) sendBlockHeader(headerHandler data.HeaderHandler, marshalizedHeader []byte, leader string) bool {
 	headerHash := sr.Hasher().Compute(string(marshalizedHeader))
 
 	cnsMsg := consensus.NewConsensusMessage(
@@ -241,7 +253,7 @@ func (sr *subroundBlock) sendBlockHeader(headerHandler data.HeaderHandler, marsh
 		nil,
 		nil,
 		marshalizedHeader,
-		[]byte(sr.SelfPubKey()),
+		[]byte(leader),
 		nil,
 		int(MtBlockHeader),
 		sr.RoundHandler().Index(),
@@ -268,7 +280,7 @@ func (sr *subroundBlock) sendBlockHeader(headerHandler data.HeaderHandler, marsh
 	return true
 }
 
-func (sr *subroundBlock) createHeader() (data.HeaderHandler, error) {
+func (sr *subroundBlock) createHeader(leader string) (data.HeaderHandler, error) {
 	var nonce uint64
 	var prevHash []byte
 	var prevRandSeed []byte
@@ -288,7 +300,7 @@ func (sr *subroundBlock) createHeader() (data.HeaderHandler, error) {
 	hdr := sr.BlockProcessor().CreateNewHeader(round, nonce)
 	hdr.SetPrevHash(prevHash)
 
-	randSeed, err := sr.SingleSigner().Sign(sr.PrivateKey(), prevRandSeed)
+	randSeed, err := sr.SignerBackend().SignRandSeed([]byte(leader), int64(round), prevRandSeed)
 	if err != nil {
 		return nil, err
 	}
@@ -9,10 +9,12 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-crypto"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/consensus/mock"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
+	"github.com/ElrondNetwork/elrond-go/testscommon/cryptoMocks"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -318,6 +320,54 @@ func TestSubroundBlock_DoBlockJob(t *testing.T) {
 	assert.Equal(t, uint64(1), sr.Header.GetNonce())
 }
 
+func TestSubroundBlock_DoBlockJobWithManagedLeaderShouldWork(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	managedPrivateKey := &mock.PrivateKeyMock{}
+	keysHandler := &cryptoMocks.KeysHandlerStub{
+		IsKeyManagedByCurrentNodeCalled: func(pkBytes []byte) bool {
+			return string(pkBytes) == "A"
+		},
+		GetPrivateKeyCalled: func(pkBytes []byte) (crypto.PrivateKey, error) {
+			return managedPrivateKey, nil
+		},
+	}
+	container.SetKeysHandler(keysHandler)
+	container.SetBlockchain(&mock.BlockChainMock{
+		GetGenesisHeaderCalled: func() data.HeaderHandler {
+			return &block.Header{}
+		},
+	})
+	container.SetRoundHandler(&mock.RoundHandlerMock{
+		RoundIndex: 1,
+	})
+	container.SetSingleSigner(&mock.SingleSignerMock{
+		SignStub: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+			assert.True(t, private == managedPrivateKey)
+			return []byte("rand seed"), nil
+		},
+	})
+	broadcastPubKeys := make([]string, 0)
+	container.SetBroadcastMessenger(&mock.BroadcastMessengerMock{
+		BroadcastConsensusMessageCalled: func(message *consensus.Message) error {
+			broadcastPubKeys = append(broadcastPubKeys, string(message.PubKey))
+			return nil
+		},
+	})
+
+	consensusState := initConsensusStateWithKeysHandler(keysHandler)
+	baseSr, _ := defaultSubroundForSRBlock(consensusState, make(chan bool, 1), container, &mock.AppStatusHandlerStub{})
+	srBlock, _ := defaultSubroundBlockFromSubround(baseSr)
+	sr := *srBlock
+
+	r := sr.DoBlockJob()
+	assert.True(t, r)
+	assert.Equal(t, []string{"A"}, broadcastPubKeys)
+	assert.True(t, sr.IsJobDone("A", bls.SrBlock))
+	assert.False(t, sr.IsSelfJobDone(bls.SrBlock))
+}
+
 func TestSubroundBlock_ReceivedBlock(t *testing.T) {
 	t.Parallel()
 	container := mock.InitConsensusCore()
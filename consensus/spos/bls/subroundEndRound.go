@@ -85,7 +85,7 @@ func (sr *subroundEndRound) receivedBlockHeaderFinalInfo(cnsDta *consensus.Messa
 		return false
 	}
 
-	if sr.IsSelfLeaderInCurrentRound() {
+	if sr.IsSelfLeaderInCurrentRound() || sr.IsMultiKeyLeaderInCurrentRound() {
 		return false
 	}
 
@@ -141,7 +141,7 @@ func (sr *subroundEndRound) isBlockHeaderFinalInfoValid(cnsDta *consensus.Messag
 }
 
 func (sr *subroundEndRound) receivedHeader(headerHandler data.HeaderHandler) {
-	if sr.ConsensusGroup() == nil || sr.IsSelfLeaderInCurrentRound() {
+	if sr.ConsensusGroup() == nil || sr.IsSelfLeaderInCurrentRound() || sr.IsMultiKeyLeaderInCurrentRound() {
 		return
 	}
 
@@ -152,7 +152,7 @@ func (sr *subroundEndRound) receivedHeader(headerHandler data.HeaderHandler) {
 
 // doEndRoundJob method does the job of the subround EndRound
 func (sr *subroundEndRound) doEndRoundJob() bool {
-	if !sr.IsSelfLeaderInCurrentRound() {
+	if !sr.IsSelfLeaderInCurrentRound() && !sr.IsMultiKeyLeaderInCurrentRound() {
 		if sr.IsNodeInConsensusGroup(sr.SelfPubKey()) {
 			err := sr.prepareBroadcastBlockDataForValidator()
 			if err != nil {
@@ -168,15 +168,28 @@ func (sr *subroundEndRound) doEndRoundJob() bool {
 }
 
 func (sr *subroundEndRound) doEndRoundJobByLeader() bool {
+	leader, err := sr.GetLeader()
+	if err != nil {
+		log.Debug("doEndRoundJob.GetLeader", "error", err.Error())
+		return false
+	}
+
 	bitmap := sr.GenerateBitmap(SrSignature)
-	err := sr.checkSignaturesValidity(bitmap)
+	err = sr.checkSignaturesValidity(bitmap)
 	if err != nil {
 		log.Debug("doEndRoundJob.checkSignaturesValidity", "error", err.Error())
 		return false
 	}
 
 	// Aggregate sig and add it to the block
+	aggregationStartTime := time.Now()
 	sig, err := sr.MultiSigner().AggregateSigs(bitmap)
+	sr.TraceSelfEvent(consensus.RoundEvent{
+		Type:     consensus.RoundEventSignatureAggregation,
+		Round:    sr.RoundHandler().Index(),
+		Success:  err == nil,
+		Duration: time.Since(aggregationStartTime),
+	})
 	if err != nil {
 		log.Debug("doEndRoundJob.AggregateSigs", "error", err.Error())
 		return false
@@ -186,7 +199,7 @@ func (sr *subroundEndRound) doEndRoundJobByLeader() bool {
 	sr.Header.SetSignature(sig)
 
 	// Header is complete so the leader can sign it
-	leaderSignature, err := sr.signBlockHeader()
+	leaderSignature, err := sr.signBlockHeader(leader)
 	if err != nil {
 		log.Error(err.Error())
 		return false
@@ -204,7 +217,7 @@ func (sr *subroundEndRound) doEndRoundJobByLeader() bool {
 	// broadcast header and final info section
 
 	// create and broadcast header final info
-	sr.createAndBroadcastHeaderFinalInfo()
+	sr.createAndBroadcastHeaderFinalInfo(leader)
 
 	// broadcast header
 	err = sr.BroadcastMessenger().BroadcastHeader(sr.Header)
@@ -241,18 +254,18 @@ func (sr *subroundEndRound) doEndRoundJobByLeader() bool {
 	msg := fmt.Sprintf("Added proposed block with nonce  %d  in blockchain", sr.Header.GetNonce())
 	log.Debug(display.Headline(msg, sr.SyncTimer().FormattedCurrentTime(), "+"))
 
-	sr.updateMetricsForLeader()
+	sr.updateMetricsForLeader(leader)
 
 	return true
 }
 
-func (sr *subroundEndRound) createAndBroadcastHeaderFinalInfo() {
+func (sr *subroundEndRound) createAndBroadcastHeaderFinalInfo(leader string) {
 	cnsMsg := consensus.NewConsensusMessage(
 		sr.GetData(),
 		nil,
 		nil,
 		nil,
-		[]byte(sr.SelfPubKey()),
+		[]byte(leader),
 		nil,
 		int(MtBlockHeaderFinalInfo),
 		sr.RoundHandler().Index(),
@@ -407,7 +420,7 @@ func (sr *subroundEndRound) isConsensusHeaderReceived() (bool, data.HeaderHandle
 	return false, nil
 }
 
-func (sr *subroundEndRound) signBlockHeader() ([]byte, error) {
+func (sr *subroundEndRound) signBlockHeader(leader string) ([]byte, error) {
 	headerClone := sr.Header.Clone()
 	headerClone.SetLeaderSignature(nil)
 
@@ -416,10 +429,12 @@ func (sr *subroundEndRound) signBlockHeader() ([]byte, error) {
 		return nil, err
 	}
 
-	return sr.SingleSigner().Sign(sr.PrivateKey(), marshalizedHdr)
+	return sr.SignerBackend().SignBlockHeader([]byte(leader), sr.RoundHandler().Index(), marshalizedHdr)
 }
 
-func (sr *subroundEndRound) updateMetricsForLeader() {
+func (sr *subroundEndRound) updateMetricsForLeader(leader string) {
+	sr.KeysHandler().IncrementBlocksProposed([]byte(leader))
+
 	sr.appStatusHandler.Increment(common.MetricCountAcceptedBlocks)
 	sr.appStatusHandler.SetStringValue(common.MetricConsensusRoundState,
 		fmt.Sprintf("valid block produced in %f sec", time.Since(sr.RoundHandler().TimeStamp()).Seconds()))
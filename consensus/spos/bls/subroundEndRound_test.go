@@ -14,6 +14,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/blockchain"
+	"github.com/ElrondNetwork/elrond-go/testscommon/cryptoMocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -533,14 +534,14 @@ func TestSubroundEndRound_CheckIfSignatureIsFilled(t *testing.T) {
 
 	expectedSignature := []byte("signature")
 	container := mock.InitConsensusCore()
-	singleSigner := &mock.SingleSignerMock{
-		SignStub: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+	container.SetSignerBackend(&cryptoMocks.SignerBackendStub{
+		SignBlockHeaderCalled: func(pkBytes []byte, round int64, marshalizedHeader []byte) ([]byte, error) {
 			var receivedHdr block.Header
-			_ = container.Marshalizer().Unmarshal(&receivedHdr, msg)
+			_ = container.Marshalizer().Unmarshal(&receivedHdr, marshalizedHeader)
+			assert.Equal(t, uint64(5), receivedHdr.Nonce)
 			return expectedSignature, nil
 		},
-	}
-	container.SetSingleSigner(singleSigner)
+	})
 	bm := &mock.BroadcastMessengerMock{
 		BroadcastBlockCalled: func(handler data.BodyHandler, handler2 data.HeaderHandler) error {
 			return errors.New("error")
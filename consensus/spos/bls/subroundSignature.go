@@ -60,46 +60,44 @@ func checkNewSubroundSignatureParams(
 
 // doSignatureJob method does the job of the subround Signature
 func (sr *subroundSignature) doSignatureJob() bool {
-	if !sr.IsNodeInConsensusGroup(sr.SelfPubKey()) {
+	isSelfInConsensusGroup := sr.IsNodeInConsensusGroup(sr.SelfPubKey())
+	if !isSelfInConsensusGroup && !sr.IsMultiKeyInConsensusGroup() {
 		return true
 	}
 	if !sr.CanDoSubroundJob(sr.Current()) {
 		return false
 	}
 
-	signatureShare, err := sr.MultiSigner().CreateSignatureShare(sr.GetData(), nil)
-	if err != nil {
-		log.Debug("doSignatureJob.CreateSignatureShare", "error", err.Error())
+	isLeaderManaged := sr.IsSelfLeaderInCurrentRound() || sr.IsMultiKeyLeaderInCurrentRound()
+
+	if isSelfInConsensusGroup {
+		if !sr.doSelfSignatureJob(isLeaderManaged) {
+			return false
+		}
+	}
+
+	if !sr.doMultiKeySignatureJob(isLeaderManaged) {
 		return false
 	}
 
-	isSelfLeader := sr.IsSelfLeaderInCurrentRound()
-
-	if !isSelfLeader {
-		//TODO: Analyze it is possible to send message only to leader with O(1) instead of O(n)
-		cnsMsg := consensus.NewConsensusMessage(
-			sr.GetData(),
-			signatureShare,
-			nil,
-			nil,
-			[]byte(sr.SelfPubKey()),
-			nil,
-			int(MtSignature),
-			sr.RoundHandler().Index(),
-			sr.ChainID(),
-			nil,
-			nil,
-			nil,
-			sr.CurrentPid(),
-		)
+	if isLeaderManaged {
+		go sr.waitAllSignatures()
+	}
 
-		err = sr.BroadcastMessenger().BroadcastConsensusMessage(cnsMsg)
-		if err != nil {
-			log.Debug("doSignatureJob.BroadcastConsensusMessage", "error", err.Error())
+	return true
+}
+
+func (sr *subroundSignature) doSelfSignatureJob(isLeaderManaged bool) bool {
+	signatureShare, err := sr.CreateSignatureShareForKey(sr.GetData(), sr.SelfPubKey())
+	if err != nil {
+		log.Debug("doSignatureJob.CreateSignatureShareForKey", "error", err.Error())
+		return false
+	}
+
+	if !isLeaderManaged {
+		if !sr.sendSignature(sr.SelfPubKey(), signatureShare) {
 			return false
 		}
-
-		log.Debug("step 2: signature has been sent")
 	}
 
 	err = sr.SetSelfJobDone(sr.Current(), true)
@@ -110,10 +108,72 @@ func (sr *subroundSignature) doSignatureJob() bool {
 		return false
 	}
 
-	if isSelfLeader {
-		go sr.waitAllSignatures()
+	sr.KeysHandler().IncrementSignaturesSent([]byte(sr.SelfPubKey()))
+
+	return true
+}
+
+// doMultiKeySignatureJob signs the consensus data with every managed key, other than the self public key, found in
+// the consensus group. The signature shares are stored directly in the multi signer and are broadcast only if the
+// leader is not managed by the current node
+func (sr *subroundSignature) doMultiKeySignatureJob(isLeaderManaged bool) bool {
+	for _, pubKey := range sr.MultiKeysInConsensusGroup() {
+		if sr.IsJobDone(pubKey, sr.Current()) {
+			continue
+		}
+
+		signatureShare, err := sr.CreateSignatureShareForKey(sr.GetData(), pubKey)
+		if err != nil {
+			log.Debug("doSignatureJob.CreateSignatureShareForKey", "error", err.Error())
+			return false
+		}
+
+		if !isLeaderManaged {
+			if !sr.sendSignature(pubKey, signatureShare) {
+				return false
+			}
+		}
+
+		err = sr.SetJobDone(pubKey, sr.Current(), true)
+		if err != nil {
+			log.Debug("doSignatureJob.SetJobDone",
+				"subround", sr.Name(),
+				"error", err.Error())
+			return false
+		}
+
+		sr.KeysHandler().IncrementSignaturesSent([]byte(pubKey))
+	}
+
+	return true
+}
+
+func (sr *subroundSignature) sendSignature(pubKey string, signatureShare []byte) bool {
+	//TODO: Analyze it is possible to send message only to leader with O(1) instead of O(n)
+	cnsMsg := consensus.NewConsensusMessage(
+		sr.GetData(),
+		signatureShare,
+		nil,
+		nil,
+		[]byte(pubKey),
+		nil,
+		int(MtSignature),
+		sr.RoundHandler().Index(),
+		sr.ChainID(),
+		nil,
+		nil,
+		nil,
+		sr.CurrentPid(),
+	)
+
+	err := sr.BroadcastMessenger().BroadcastConsensusMessage(cnsMsg)
+	if err != nil {
+		log.Debug("doSignatureJob.BroadcastConsensusMessage", "error", err.Error())
+		return false
 	}
 
+	log.Debug("step 2: signature has been sent", "pk", []byte(pubKey))
+
 	return true
 }
 
@@ -137,7 +197,7 @@ func (sr *subroundSignature) receivedSignature(cnsDta *consensus.Message) bool {
 		return false
 	}
 
-	if !sr.IsSelfLeaderInCurrentRound() {
+	if !sr.IsSelfLeaderInCurrentRound() && !sr.IsMultiKeyLeaderInCurrentRound() {
 		return false
 	}
 
@@ -197,8 +257,8 @@ func (sr *subroundSignature) doSignatureConsensusCheck() bool {
 		return true
 	}
 
-	isSelfLeader := sr.IsSelfLeaderInCurrentRound()
-	isSelfInConsensusGroup := sr.IsNodeInConsensusGroup(sr.SelfPubKey())
+	isSelfLeader := sr.IsSelfLeaderInCurrentRound() || sr.IsMultiKeyLeaderInCurrentRound()
+	isSelfInConsensusGroup := sr.IsNodeInConsensusGroup(sr.SelfPubKey()) || sr.IsMultiKeyInConsensusGroup()
 
 	threshold := sr.Threshold(sr.Current())
 	if sr.FallbackHeaderValidator().ShouldApplyFallbackValidation(sr.Header) {
@@ -213,7 +273,7 @@ func (sr *subroundSignature) doSignatureConsensusCheck() bool {
 	areAllSignaturesCollected := numSigs == sr.ConsensusGroupSize()
 
 	isJobDoneByLeader := isSelfLeader && (areAllSignaturesCollected || (areSignaturesCollected && sr.WaitingAllSignaturesTimeOut))
-	isJobDoneByConsensusNode := !isSelfLeader && isSelfInConsensusGroup && sr.IsSelfJobDone(sr.Current())
+	isJobDoneByConsensusNode := !isSelfLeader && isSelfInConsensusGroup && sr.areManagedKeysJobDone()
 
 	isSubroundFinished := !isSelfInConsensusGroup || isJobDoneByConsensusNode || isJobDoneByLeader
 
@@ -236,6 +296,14 @@ func (sr *subroundSignature) doSignatureConsensusCheck() bool {
 	return false
 }
 
+// areManagedKeysJobDone returns true if the self public key, when part of the consensus group, and all the other
+// managed keys found in the consensus group have sent their signatures
+func (sr *subroundSignature) areManagedKeysJobDone() bool {
+	isSelfJobDone := !sr.IsNodeInConsensusGroup(sr.SelfPubKey()) || sr.IsSelfJobDone(sr.Current())
+
+	return isSelfJobDone && sr.IsMultiKeyJobDone(sr.Current())
+}
+
 // areSignaturesCollected method checks if the signatures received from the nodes, belonging to the current
 // jobDone group, are more than the necessary given threshold
 func (sr *subroundSignature) areSignaturesCollected(threshold int) (bool, int) {
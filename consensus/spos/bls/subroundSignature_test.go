@@ -4,17 +4,25 @@ import (
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-crypto"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/consensus/mock"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/cryptoMocks"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 )
 
 func initSubroundSignatureWithContainer(container *mock.ConsensusCoreMock) bls.SubroundSignature {
-	consensusState := initConsensusState()
+	return initSubroundSignatureWithConsensusState(container, initConsensusState())
+}
+
+func initSubroundSignatureWithConsensusState(
+	container *mock.ConsensusCoreMock,
+	consensusState *spos.ConsensusState,
+) bls.SubroundSignature {
 	ch := make(chan bool, 1)
 
 	sr, _ := spos.NewSubround(
@@ -272,27 +280,29 @@ func TestSubroundSignature_DoSignatureJob(t *testing.T) {
 
 	sr.Data = []byte("X")
 
-	multiSignerMock := mock.InitMultiSignerMock()
-
 	err := errors.New("create signature share error")
-	multiSignerMock.CreateSignatureShareCalled = func(msg []byte, bitmap []byte) ([]byte, error) {
-		return nil, err
-	}
-
-	container.SetMultiSigner(multiSignerMock)
+	container.SetSignerBackend(&cryptoMocks.SignerBackendStub{
+		SignConsensusDataCalled: func(pkBytes []byte, round int64, consensusData []byte) ([]byte, error) {
+			return nil, err
+		},
+	})
 
 	r = sr.DoSignatureJob()
 	assert.False(t, r)
 
-	multiSignerMock = mock.InitMultiSignerMock()
-
-	multiSignerMock.CreateSignatureShareCalled = func(msg []byte, bitmap []byte) ([]byte, error) {
-		return []byte("SIG"), nil
-	}
-	container.SetMultiSigner(multiSignerMock)
+	container.SetSignerBackend(&cryptoMocks.SignerBackendStub{
+		SignConsensusDataCalled: func(pkBytes []byte, round int64, consensusData []byte) ([]byte, error) {
+			assert.Equal(t, []byte(sr.SelfPubKey()), pkBytes)
+			assert.Equal(t, []byte("X"), consensusData)
+			return []byte("SIG"), nil
+		},
+	})
 
 	r = sr.DoSignatureJob()
 	assert.True(t, r)
+	selfIndex, _ := sr.SelfConsensusGroupIndex()
+	storedShare, _ := container.MultiSigner().SignatureShare(uint16(selfIndex))
+	assert.Equal(t, []byte("SIG"), storedShare)
 
 	_ = sr.SetJobDone(sr.SelfPubKey(), bls.SrSignature, false)
 	sr.RoundCanceled = false
@@ -302,6 +312,113 @@ func TestSubroundSignature_DoSignatureJob(t *testing.T) {
 	assert.False(t, sr.RoundCanceled)
 }
 
+func createManagedKeysHandler(managedKeys ...string) *cryptoMocks.KeysHandlerStub {
+	keys := make(map[string]struct{})
+	for _, key := range managedKeys {
+		keys[key] = struct{}{}
+	}
+
+	return &cryptoMocks.KeysHandlerStub{
+		IsKeyManagedByCurrentNodeCalled: func(pkBytes []byte) bool {
+			_, found := keys[string(pkBytes)]
+			return found
+		},
+		GetPrivateKeyCalled: func(pkBytes []byte) (crypto.PrivateKey, error) {
+			return &mock.PrivateKeyMock{}, nil
+		},
+	}
+}
+
+func TestSubroundSignature_DoSignatureJobWithMultiKeyShouldSignForAllManagedKeys(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	keysHandler := createManagedKeysHandler("C", "D")
+	signaturesSent := make(map[string]int)
+	keysHandler.IncrementSignaturesSentCalled = func(pkBytes []byte) {
+		signaturesSent[string(pkBytes)]++
+	}
+	container.SetKeysHandler(keysHandler)
+
+	signedForKeys := make([]string, 0)
+	container.SetSignerBackend(&cryptoMocks.SignerBackendStub{
+		SignConsensusDataCalled: func(pkBytes []byte, round int64, consensusData []byte) ([]byte, error) {
+			signedForKeys = append(signedForKeys, string(pkBytes))
+			return []byte("SIG " + string(pkBytes)), nil
+		},
+	})
+
+	broadcastPubKeys := make([]string, 0)
+	container.SetBroadcastMessenger(&mock.BroadcastMessengerMock{
+		BroadcastConsensusMessageCalled: func(message *consensus.Message) error {
+			broadcastPubKeys = append(broadcastPubKeys, string(message.PubKey))
+			return nil
+		},
+	})
+
+	sr := *initSubroundSignatureWithConsensusState(container, initConsensusStateWithKeysHandler(keysHandler))
+
+	r := sr.DoSignatureJob()
+	assert.True(t, r)
+	assert.Equal(t, []string{"B", "C", "D"}, signedForKeys)
+	assert.Equal(t, []string{"B", "C", "D"}, broadcastPubKeys)
+	assert.True(t, sr.IsMultiKeyJobDone(bls.SrSignature))
+	assert.True(t, sr.IsSelfJobDone(bls.SrSignature))
+	assert.Equal(t, 1, signaturesSent["C"])
+	assert.Equal(t, 1, signaturesSent["D"])
+}
+
+func TestSubroundSignature_DoSignatureJobWithManagedLeaderShouldNotBroadcast(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	keysHandler := createManagedKeysHandler("A", "C")
+	container.SetKeysHandler(keysHandler)
+	container.SetBroadcastMessenger(&mock.BroadcastMessengerMock{
+		BroadcastConsensusMessageCalled: func(message *consensus.Message) error {
+			assert.Fail(t, "should have not broadcast the signatures")
+			return nil
+		},
+	})
+
+	sr := *initSubroundSignatureWithConsensusState(container, initConsensusStateWithKeysHandler(keysHandler))
+
+	assert.True(t, sr.IsMultiKeyLeaderInCurrentRound())
+	r := sr.DoSignatureJob()
+	assert.True(t, r)
+	assert.True(t, sr.IsJobDone("A", bls.SrSignature))
+	assert.True(t, sr.IsJobDone("C", bls.SrSignature))
+}
+
+func TestSubroundSignature_ReceivedSignatureWithManagedLeaderShouldWork(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	keysHandler := createManagedKeysHandler("A")
+	container.SetKeysHandler(keysHandler)
+	sr := *initSubroundSignatureWithConsensusState(container, initConsensusStateWithKeysHandler(keysHandler))
+
+	cnsMsg := consensus.NewConsensusMessage(
+		sr.Data,
+		[]byte("signature"),
+		nil,
+		nil,
+		[]byte(sr.ConsensusGroup()[2]),
+		[]byte("sig"),
+		int(bls.MtSignature),
+		0,
+		chainID,
+		nil,
+		nil,
+		nil,
+		currentPid,
+	)
+
+	r := sr.ReceivedSignature(cnsMsg)
+	assert.True(t, r)
+	assert.True(t, sr.IsJobDone(sr.ConsensusGroup()[2], bls.SrSignature))
+}
+
 func TestSubroundSignature_ReceivedSignature(t *testing.T) {
 	t.Parallel()
 
@@ -135,7 +135,7 @@ func (sr *subroundStartRound) initCurrentRound() bool {
 
 	if sr.NodeRedundancyHandler().IsRedundancyNode() {
 		sr.NodeRedundancyHandler().AdjustInactivityIfNeeded(
-			sr.SelfPubKey(),
+			sr.getRedundancyPubKey(),
 			sr.ConsensusGroup(),
 			sr.RoundHandler().Index(),
 		)
@@ -154,7 +154,7 @@ func (sr *subroundStartRound) initCurrentRound() bool {
 	}
 
 	msg := ""
-	if leader == sr.SelfPubKey() {
+	if leader == sr.SelfPubKey() || sr.IsMultiKeyLeaderInCurrentRound() {
 		sr.AppStatusHandler().Increment(common.MetricCountLeader)
 		sr.AppStatusHandler().SetStringValue(common.MetricConsensusRoundState, "proposed")
 		sr.AppStatusHandler().SetStringValue(common.MetricConsensusState, "proposer")
@@ -170,16 +170,19 @@ func (sr *subroundStartRound) initCurrentRound() bool {
 	sr.indexRoundIfNeeded(pubKeys)
 
 	selfIndex, err := sr.SelfConsensusGroupIndex()
-	if err != nil {
+	isInConsensusGroup := err == nil || sr.IsMultiKeyInConsensusGroup()
+	if !isInConsensusGroup {
 		log.Debug("not in consensus group")
 		sr.AppStatusHandler().SetStringValue(common.MetricConsensusState, "not in consensus group")
 	} else {
-		if leader != sr.SelfPubKey() {
+		if leader != sr.SelfPubKey() && !sr.IsMultiKeyLeaderInCurrentRound() {
 			sr.AppStatusHandler().Increment(common.MetricCountConsensus)
 		}
 		sr.AppStatusHandler().SetStringValue(common.MetricConsensusState, "participant")
 	}
 
+	sr.updateManagedKeysMetrics(pubKeys, leader)
+
 	err = sr.MultiSigner().Reset(pubKeys, uint16(selfIndex))
 	if err != nil {
 		log.Debug("initCurrentRound.Reset", "error", err.Error())
@@ -209,6 +212,33 @@ func (sr *subroundStartRound) initCurrentRound() bool {
 	return true
 }
 
+// getRedundancyPubKey returns the key used to track the activity of the main machine: the self public key or, when
+// only other managed keys were selected, the first of them found in the consensus group
+func (sr *subroundStartRound) getRedundancyPubKey() string {
+	if sr.IsNodeInConsensusGroup(sr.SelfPubKey()) {
+		return sr.SelfPubKey()
+	}
+
+	multiKeys := sr.MultiKeysInConsensusGroup()
+	if len(multiKeys) > 0 {
+		return multiKeys[0]
+	}
+
+	return sr.SelfPubKey()
+}
+
+func (sr *subroundStartRound) updateManagedKeysMetrics(pubKeys []string, leader string) {
+	for _, pubKey := range pubKeys {
+		if sr.IsKeyManagedByCurrentNode(pubKey) {
+			sr.KeysHandler().IncrementRoundsInConsensusGroup([]byte(pubKey))
+		}
+	}
+
+	if sr.IsKeyManagedByCurrentNode(leader) {
+		sr.KeysHandler().IncrementRoundsAsLeader([]byte(leader))
+	}
+}
+
 func (sr *subroundStartRound) indexRoundIfNeeded(pubKeys []string) {
 	sr.outportMutex.RLock()
 	defer sr.outportMutex.RUnlock()
@@ -35,6 +35,9 @@ type ConsensusCore struct {
 	headerSigVerifier             consensus.HeaderSigVerifier
 	fallbackHeaderValidator       consensus.FallbackHeaderValidator
 	nodeRedundancyHandler         consensus.NodeRedundancyHandler
+	roundTracer                   consensus.RoundTracer
+	keysHandler                   consensus.KeysHandler
+	signerBackend                 consensus.SignerBackend
 }
 
 // ConsensusCoreArgs store all arguments that are needed to create a ConsensusCore object
@@ -59,6 +62,9 @@ type ConsensusCoreArgs struct {
 	HeaderSigVerifier             consensus.HeaderSigVerifier
 	FallbackHeaderValidator       consensus.FallbackHeaderValidator
 	NodeRedundancyHandler         consensus.NodeRedundancyHandler
+	RoundTracer                   consensus.RoundTracer
+	KeysHandler                   consensus.KeysHandler
+	SignerBackend                 consensus.SignerBackend
 }
 
 // NewConsensusCore creates a new ConsensusCore instance
@@ -86,6 +92,9 @@ func NewConsensusCore(
 		headerSigVerifier:             args.HeaderSigVerifier,
 		fallbackHeaderValidator:       args.FallbackHeaderValidator,
 		nodeRedundancyHandler:         args.NodeRedundancyHandler,
+		roundTracer:                   args.RoundTracer,
+		keysHandler:                   args.KeysHandler,
+		signerBackend:                 args.SignerBackend,
 	}
 
 	err := ValidateConsensusCore(consensusCore)
@@ -196,6 +205,21 @@ func (cc *ConsensusCore) NodeRedundancyHandler() consensus.NodeRedundancyHandler
 	return cc.nodeRedundancyHandler
 }
 
+// RoundTracer will return the round tracer which will be used in subrounds
+func (cc *ConsensusCore) RoundTracer() consensus.RoundTracer {
+	return cc.roundTracer
+}
+
+// KeysHandler will return the holder of all the keys managed by the current node
+func (cc *ConsensusCore) KeysHandler() consensus.KeysHandler {
+	return cc.keysHandler
+}
+
+// SignerBackend will return the component signing on behalf of the keys managed by the current node
+func (cc *ConsensusCore) SignerBackend() consensus.SignerBackend {
+	return cc.signerBackend
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (cc *ConsensusCore) IsInterfaceNil() bool {
 	return cc == nil
@@ -64,6 +64,15 @@ func ValidateConsensusCore(container ConsensusCoreHandler) error {
 	if check.IfNil(container.NodeRedundancyHandler()) {
 		return ErrNilNodeRedundancyHandler
 	}
+	if check.IfNil(container.RoundTracer()) {
+		return ErrNilRoundTracer
+	}
+	if check.IfNil(container.KeysHandler()) {
+		return ErrNilKeysHandler
+	}
+	if check.IfNil(container.SignerBackend()) {
+		return ErrNilSignerBackend
+	}
 
 	return nil
 }
@@ -29,6 +29,9 @@ func initConsensusDataContainer() *ConsensusCore {
 	headerSigVerifier := &mock.HeaderSigVerifierStub{}
 	fallbackHeaderValidator := &testscommon.FallBackHeaderValidatorStub{}
 	nodeRedundancyHandler := &mock.NodeRedundancyHandlerStub{}
+	roundTracer := &mock.RoundTracerStub{}
+	keysHandler := &cryptoMocks.KeysHandlerStub{}
+	signerBackend := &cryptoMocks.SignerBackendStub{}
 
 	return &ConsensusCore{
 		blockChain:              blockChain,
@@ -50,6 +53,9 @@ func initConsensusDataContainer() *ConsensusCore {
 		headerSigVerifier:       headerSigVerifier,
 		fallbackHeaderValidator: fallbackHeaderValidator,
 		nodeRedundancyHandler:   nodeRedundancyHandler,
+		roundTracer:             roundTracer,
+		keysHandler:             keysHandler,
+		signerBackend:           signerBackend,
 	}
 }
 
@@ -229,6 +235,39 @@ func TestConsensusContainerValidator_ValidateNilNodeRedundancyHandlerShouldFail(
 	assert.Equal(t, ErrNilNodeRedundancyHandler, err)
 }
 
+func TestConsensusContainerValidator_ValidateNilRoundTracerShouldFail(t *testing.T) {
+	t.Parallel()
+
+	container := initConsensusDataContainer()
+	container.roundTracer = nil
+
+	err := ValidateConsensusCore(container)
+
+	assert.Equal(t, ErrNilRoundTracer, err)
+}
+
+func TestConsensusContainerValidator_ValidateNilKeysHandlerShouldFail(t *testing.T) {
+	t.Parallel()
+
+	container := initConsensusDataContainer()
+	container.keysHandler = nil
+
+	err := ValidateConsensusCore(container)
+
+	assert.Equal(t, ErrNilKeysHandler, err)
+}
+
+func TestConsensusContainerValidator_ValidateNilSignerBackendShouldFail(t *testing.T) {
+	t.Parallel()
+
+	container := initConsensusDataContainer()
+	container.signerBackend = nil
+
+	err := ValidateConsensusCore(container)
+
+	assert.Equal(t, ErrNilSignerBackend, err)
+}
+
 func TestConsensusContainerValidator_ShouldWork(t *testing.T) {
 	t.Parallel()
 
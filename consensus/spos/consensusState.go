@@ -110,6 +110,18 @@ func (cns *ConsensusState) IsSelfLeaderInCurrentRound() bool {
 	return cns.IsNodeLeaderInCurrentRound(cns.selfPubKey)
 }
 
+// IsMultiKeyLeaderInCurrentRound method checks if the leader of the current round is one of the keys managed by the
+// current node, other than the self public key
+func (cns *ConsensusState) IsMultiKeyLeaderInCurrentRound() bool {
+	leader, err := cns.GetLeader()
+	if err != nil {
+		log.Debug("GetLeader", "error", err.Error())
+		return false
+	}
+
+	return leader != cns.selfPubKey && cns.IsKeyManagedByCurrentNode(leader)
+}
+
 // GetLeader method gets the leader of the current round
 func (cns *ConsensusState) GetLeader() (string, error) {
 	if cns.consensusGroup == nil {
@@ -186,6 +198,18 @@ func (cns *ConsensusState) IsSelfJobDone(currentSubroundId int) bool {
 	return cns.IsJobDone(cns.selfPubKey, currentSubroundId)
 }
 
+// IsMultiKeyJobDone method returns true if all the keys managed by the current node which are part of the consensus
+// group, other than the self public key, have done their job for the current subround and false otherwise
+func (cns *ConsensusState) IsMultiKeyJobDone(currentSubroundId int) bool {
+	for _, pubKey := range cns.MultiKeysInConsensusGroup() {
+		if !cns.IsJobDone(pubKey, currentSubroundId) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // IsSubroundFinished method returns true if the current subround is finished and false otherwise
 func (cns *ConsensusState) IsSubroundFinished(subroundID int) bool {
 	isSubroundFinished := cns.Status(subroundID) == SsFinished
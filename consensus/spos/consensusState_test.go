@@ -10,6 +10,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
 	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/testscommon/cryptoMocks"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -24,7 +25,9 @@ func internalInitConsensusState() *spos.ConsensusState {
 	rcns := spos.NewRoundConsensus(
 		eligibleNodesPubKeys,
 		3,
-		"2")
+		"2",
+		&cryptoMocks.KeysHandlerStub{},
+	)
 
 	rcns.SetConsensusGroup(eligibleList)
 	rcns.ResetRoundState()
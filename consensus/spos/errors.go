@@ -216,3 +216,15 @@ var ErrNilFallbackHeaderValidator = errors.New("nil fallback header validator")
 
 // ErrNilNodeRedundancyHandler signals that provided node redundancy handler is nil
 var ErrNilNodeRedundancyHandler = errors.New("nil node redundancy handler")
+
+// ErrNilRoundTracer signals that a nil round tracer has been provided
+var ErrNilRoundTracer = errors.New("nil round tracer")
+
+// ErrNilKeysHandler signals that a nil keys handler has been provided
+var ErrNilKeysHandler = errors.New("nil keys handler")
+
+// ErrNilSlashingDetector signals that a nil slashing detector has been provided
+var ErrNilSlashingDetector = errors.New("nil slashing detector")
+
+// ErrNilSignerBackend signals that a nil signer backend has been provided
+var ErrNilSignerBackend = errors.New("nil signer backend")
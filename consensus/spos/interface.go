@@ -10,6 +10,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
 	"github.com/ElrondNetwork/elrond-go/ntp"
+	"github.com/ElrondNetwork/elrond-go/outport"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/sharding"
@@ -57,6 +58,12 @@ type ConsensusCoreHandler interface {
 	FallbackHeaderValidator() consensus.FallbackHeaderValidator
 	// NodeRedundancyHandler returns the node redundancy handler which will be used in subrounds
 	NodeRedundancyHandler() consensus.NodeRedundancyHandler
+	// RoundTracer returns the round tracer which will record the subrounds events
+	RoundTracer() consensus.RoundTracer
+	// KeysHandler returns the holder of all the keys managed by the current node
+	KeysHandler() consensus.KeysHandler
+	// SignerBackend returns the component signing on behalf of the keys managed by the current node
+	SignerBackend() consensus.SignerBackend
 	// IsInterfaceNil returns true if there is no value under the interface
 	IsInterfaceNil() bool
 }
@@ -103,6 +110,33 @@ type SubroundsFactory interface {
 	IsInterfaceNil() bool
 }
 
+// ArgsSubroundsFactory holds the components a consensus engine can use when creating its subrounds factory
+type ArgsSubroundsFactory struct {
+	ConsensusDataContainer ConsensusCoreHandler
+	ConsensusState         *ConsensusState
+	Worker                 WorkerHandler
+	AppStatusHandler       core.AppStatusHandler
+	OutportHandler         outport.OutportHandler
+	ChainID                []byte
+	CurrentPid             core.PeerID
+}
+
+// ConsensusEngine defines a consensus algorithm that can be selected through the [Consensus] Type option.
+// An engine provides the ConsensusService used by the spos worker to validate and dispatch the consensus
+// messages and the SubroundsFactory which adds the engine's subrounds to the chronology. All engines share
+// the components held by the ConsensusCoreHandler (chronology, broadcast messenger, block processor,
+// bootstrapper, signers and so on), so an engine only defines the steps done inside a round.
+type ConsensusEngine interface {
+	// Name returns the value used in the [Consensus] Type option to select this engine
+	Name() string
+	// CreateConsensusService creates the consensus service used by the spos worker
+	CreateConsensusService() (ConsensusService, error)
+	// CreateSubroundsFactory creates the factory which will add the engine's subrounds to the chronology
+	CreateSubroundsFactory(args ArgsSubroundsFactory) (SubroundsFactory, error)
+	// IsInterfaceNil returns true if there is no value under the interface
+	IsInterfaceNil() bool
+}
+
 // WorkerHandler represents the interface for the SposWorker
 type WorkerHandler interface {
 	Close() error
@@ -0,0 +1,52 @@
+package poa
+
+import (
+	"github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+)
+
+var log = logger.GetOrCreate("consensus/spos/poa")
+
+const (
+	// SrStartRound defines ID of Subround "Start round"
+	SrStartRound = iota
+	// SrBlock defines ID of Subround "block"
+	SrBlock
+)
+
+const (
+	// MtUnknown defines ID of a message that has unknown data inside. The PoA engine does not exchange any
+	// consensus messages as the blocks are sealed by the leader alone and propagated on the headers topics
+	MtUnknown consensus.MessageType = iota
+)
+
+// peerMaxMessagesPerSec defines how many messages can be propagated by a pid in a round. No consensus message
+// is expected but the value is kept above 0 so the antiflood components can be created
+const peerMaxMessagesPerSec = uint32(1)
+
+// processingThresholdPercent specifies the max allocated time for processing the block as a percentage of the total time of the round
+const processingThresholdPercent = 85
+
+// srStartStartTime specifies the start time, from the total time of the round, of Subround Start
+const srStartStartTime = 0.0
+
+// srStartEndTime specifies the end time, from the total time of the round, of Subround Start
+const srStartEndTime = 0.05
+
+// srBlockStartTime specifies the start time, from the total time of the round, of Subround Block
+const srBlockStartTime = 0.05
+
+// srBlockEndTime specifies the end time, from the total time of the round, of Subround Block
+const srBlockEndTime = 0.95
+
+// getSubroundName returns the name of each Subround from a given Subround ID
+func getSubroundName(subroundId int) string {
+	switch subroundId {
+	case SrStartRound:
+		return "(START_ROUND)"
+	case SrBlock:
+		return "(BLOCK)"
+	default:
+		return "Undefined subround"
+	}
+}
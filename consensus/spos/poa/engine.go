@@ -0,0 +1,38 @@
+package poa
+
+import (
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+)
+
+var _ spos.ConsensusEngine = (*consensusEngine)(nil)
+
+// consensusEngine is a single leader proof of authority engine: the leader of each round produces and seals
+// the block alone while the other nodes follow the chain through the bootstrapper
+type consensusEngine struct {
+}
+
+// NewConsensusEngine creates a new PoA consensus engine
+func NewConsensusEngine() *consensusEngine {
+	return &consensusEngine{}
+}
+
+// Name returns the consensus type handled by this engine
+func (ce *consensusEngine) Name() string {
+	return consensus.PoAConsensusType
+}
+
+// CreateConsensusService creates the PoA consensus service
+func (ce *consensusEngine) CreateConsensusService() (spos.ConsensusService, error) {
+	return NewConsensusService()
+}
+
+// CreateSubroundsFactory creates the PoA subrounds factory
+func (ce *consensusEngine) CreateSubroundsFactory(args spos.ArgsSubroundsFactory) (spos.SubroundsFactory, error) {
+	return NewSubroundsFactory(args)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ce *consensusEngine) IsInterfaceNil() bool {
+	return ce == nil
+}
@@ -0,0 +1,6 @@
+package poa
+
+import "errors"
+
+// ErrInvalidConsensusGroupSize signals that the consensus group size is not supported by the PoA engine
+var ErrInvalidConsensusGroupSize = errors.New("invalid consensus group size, the PoA engine requires a single leader")
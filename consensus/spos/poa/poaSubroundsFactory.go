@@ -0,0 +1,166 @@
+package poa
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
+	"github.com/ElrondNetwork/elrond-go/outport"
+)
+
+// factory defines the data needed by this factory to create the subrounds of the PoA engine
+type factory struct {
+	consensusCore  spos.ConsensusCoreHandler
+	consensusState *spos.ConsensusState
+	worker         spos.WorkerHandler
+
+	appStatusHandler core.AppStatusHandler
+	outportHandler   outport.OutportHandler
+	chainID          []byte
+	currentPid       core.PeerID
+}
+
+// NewSubroundsFactory creates a new PoA subrounds factory
+func NewSubroundsFactory(args spos.ArgsSubroundsFactory) (*factory, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	fct := factory{
+		consensusCore:    args.ConsensusDataContainer,
+		consensusState:   args.ConsensusState,
+		worker:           args.Worker,
+		appStatusHandler: args.AppStatusHandler,
+		outportHandler:   args.OutportHandler,
+		chainID:          args.ChainID,
+		currentPid:       args.CurrentPid,
+	}
+
+	return &fct, nil
+}
+
+func checkArgs(args spos.ArgsSubroundsFactory) error {
+	err := spos.ValidateConsensusCore(args.ConsensusDataContainer)
+	if err != nil {
+		return err
+	}
+	if args.ConsensusState == nil {
+		return spos.ErrNilConsensusState
+	}
+	if args.ConsensusState.ConsensusGroupSize() != 1 {
+		return ErrInvalidConsensusGroupSize
+	}
+	if check.IfNil(args.Worker) {
+		return spos.ErrNilWorker
+	}
+	if check.IfNil(args.AppStatusHandler) {
+		return spos.ErrNilAppStatusHandler
+	}
+	if check.IfNil(args.OutportHandler) {
+		return outport.ErrNilDriver
+	}
+	if len(args.ChainID) == 0 {
+		return spos.ErrInvalidChainID
+	}
+
+	return nil
+}
+
+// GenerateSubrounds will generate the subrounds used by the PoA engine
+func (fct *factory) GenerateSubrounds() error {
+	fct.consensusState.SetThreshold(SrBlock, 1)
+	fct.consensusState.SetFallbackThreshold(SrBlock, 1)
+	fct.consensusCore.Chronology().RemoveAllSubrounds()
+	fct.worker.RemoveAllReceivedMessagesCalls()
+
+	err := fct.generateStartRoundSubround()
+	if err != nil {
+		return err
+	}
+
+	return fct.generateBlockSubround()
+}
+
+func (fct *factory) getTimeDuration() time.Duration {
+	return fct.consensusCore.RoundHandler().TimeDuration()
+}
+
+func (fct *factory) generateStartRoundSubround() error {
+	subround, err := spos.NewSubround(
+		-1,
+		SrStartRound,
+		SrBlock,
+		int64(float64(fct.getTimeDuration())*srStartStartTime),
+		int64(float64(fct.getTimeDuration())*srStartEndTime),
+		getSubroundName(SrStartRound),
+		fct.consensusState,
+		fct.worker.GetConsensusStateChangedChannel(),
+		fct.worker.ExecuteStoredMessages,
+		fct.consensusCore,
+		fct.chainID,
+		fct.currentPid,
+		fct.appStatusHandler,
+	)
+	if err != nil {
+		return err
+	}
+
+	// the start round subround is engine agnostic: it syncs the node, computes the consensus group and resets the signer
+	subroundStartRound, err := bls.NewSubroundStartRound(
+		subround,
+		fct.worker.Extend,
+		processingThresholdPercent,
+		fct.worker.ExecuteStoredMessages,
+		fct.worker.ResetConsensusMessages,
+	)
+	if err != nil {
+		return err
+	}
+
+	err = subroundStartRound.SetOutportHandler(fct.outportHandler)
+	if err != nil {
+		return err
+	}
+
+	fct.consensusCore.Chronology().AddSubround(subroundStartRound)
+
+	return nil
+}
+
+func (fct *factory) generateBlockSubround() error {
+	subround, err := spos.NewSubround(
+		SrStartRound,
+		SrBlock,
+		-1,
+		int64(float64(fct.getTimeDuration())*srBlockStartTime),
+		int64(float64(fct.getTimeDuration())*srBlockEndTime),
+		getSubroundName(SrBlock),
+		fct.consensusState,
+		fct.worker.GetConsensusStateChangedChannel(),
+		fct.worker.ExecuteStoredMessages,
+		fct.consensusCore,
+		fct.chainID,
+		fct.currentPid,
+		fct.appStatusHandler,
+	)
+	if err != nil {
+		return err
+	}
+
+	subroundBlockObject, err := NewSubroundBlock(subround, fct.worker.Extend)
+	if err != nil {
+		return err
+	}
+
+	fct.consensusCore.Chronology().AddSubround(subroundBlockObject)
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (fct *factory) IsInterfaceNil() bool {
+	return fct == nil
+}
@@ -0,0 +1,150 @@
+package poa_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/mock"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/poa"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/cryptoMocks"
+	"github.com/stretchr/testify/assert"
+)
+
+var chainID = []byte("chain ID")
+
+const currentPid = core.PeerID("pid")
+
+const roundTimeDuration = 100 * time.Millisecond
+
+func initConsensusState(consensusGroup []string, selfPubKey string) *spos.ConsensusState {
+	eligibleNodesPubKeys := make(map[string]struct{})
+	for _, key := range consensusGroup {
+		eligibleNodesPubKeys[key] = struct{}{}
+	}
+
+	rcns := spos.NewRoundConsensus(eligibleNodesPubKeys, len(consensusGroup), selfPubKey, &cryptoMocks.KeysHandlerStub{})
+	rcns.SetConsensusGroup(consensusGroup)
+	rcns.ResetRoundState()
+
+	rthr := spos.NewRoundThreshold()
+	rthr.SetThreshold(poa.SrBlock, 1)
+
+	rstatus := spos.NewRoundStatus()
+	rstatus.ResetRoundStatus()
+
+	return spos.NewConsensusState(rcns, rthr, rstatus)
+}
+
+func initWorker() *mock.SposWorkerMock {
+	return &mock.SposWorkerMock{
+		GetConsensusStateChangedChannelsCalled: func() chan bool {
+			return make(chan bool)
+		},
+		RemoveAllReceivedMessagesCallsCalled: func() {},
+	}
+}
+
+func initRoundHandlerMock() *mock.RoundHandlerMock {
+	return &mock.RoundHandlerMock{
+		RoundIndex: 1,
+		TimeStampCalled: func() time.Time {
+			return time.Unix(0, 0)
+		},
+		TimeDurationCalled: func() time.Duration {
+			return roundTimeDuration
+		},
+	}
+}
+
+func createArgsSubroundsFactory() spos.ArgsSubroundsFactory {
+	container := mock.InitConsensusCore()
+	container.SetRoundHandler(initRoundHandlerMock())
+
+	return spos.ArgsSubroundsFactory{
+		ConsensusDataContainer: container,
+		ConsensusState:         initConsensusState([]string{"A"}, "A"),
+		Worker:                 initWorker(),
+		AppStatusHandler:       &mock.AppStatusHandlerStub{},
+		OutportHandler:         &testscommon.OutportStub{},
+		ChainID:                chainID,
+		CurrentPid:             currentPid,
+	}
+}
+
+func TestNewSubroundsFactory_NilConsensusCoreShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsSubroundsFactory()
+	args.ConsensusDataContainer = nil
+	fct, err := poa.NewSubroundsFactory(args)
+
+	assert.True(t, check.IfNil(fct))
+	assert.Equal(t, spos.ErrNilConsensusCore, err)
+}
+
+func TestNewSubroundsFactory_NilConsensusStateShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsSubroundsFactory()
+	args.ConsensusState = nil
+	fct, err := poa.NewSubroundsFactory(args)
+
+	assert.True(t, check.IfNil(fct))
+	assert.Equal(t, spos.ErrNilConsensusState, err)
+}
+
+func TestNewSubroundsFactory_MoreThanOneValidatorInGroupShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsSubroundsFactory()
+	args.ConsensusState = initConsensusState([]string{"A", "B"}, "A")
+	fct, err := poa.NewSubroundsFactory(args)
+
+	assert.True(t, check.IfNil(fct))
+	assert.Equal(t, poa.ErrInvalidConsensusGroupSize, err)
+}
+
+func TestNewSubroundsFactory_NilWorkerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsSubroundsFactory()
+	args.Worker = nil
+	fct, err := poa.NewSubroundsFactory(args)
+
+	assert.True(t, check.IfNil(fct))
+	assert.Equal(t, spos.ErrNilWorker, err)
+}
+
+func TestNewSubroundsFactory_EmptyChainIDShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsSubroundsFactory()
+	args.ChainID = nil
+	fct, err := poa.NewSubroundsFactory(args)
+
+	assert.True(t, check.IfNil(fct))
+	assert.Equal(t, spos.ErrInvalidChainID, err)
+}
+
+func TestFactory_GenerateSubroundsShouldAddStartRoundAndBlock(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsSubroundsFactory()
+	added := make([]int, 0)
+	args.ConsensusDataContainer.(*mock.ConsensusCoreMock).SetChronology(&mock.ChronologyHandlerMock{
+		AddSubroundCalled: func(handler consensus.SubroundHandler) {
+			added = append(added, handler.Current())
+		},
+	})
+	fct, err := poa.NewSubroundsFactory(args)
+	assert.Nil(t, err)
+
+	err = fct.GenerateSubrounds()
+	assert.Nil(t, err)
+	assert.Equal(t, []int{poa.SrStartRound, poa.SrBlock}, added)
+}
@@ -0,0 +1,91 @@
+package poa
+
+import (
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+)
+
+// worker defines the consensus service of the PoA engine. As the leader seals the blocks alone, there are
+// no consensus messages to be validated or dispatched
+type worker struct {
+}
+
+// NewConsensusService creates a new worker object
+func NewConsensusService() (*worker, error) {
+	return &worker{}, nil
+}
+
+// InitReceivedMessages returns an empty map as the PoA engine does not use consensus messages
+func (wrk *worker) InitReceivedMessages() map[consensus.MessageType][]*consensus.Message {
+	return make(map[consensus.MessageType][]*consensus.Message)
+}
+
+// GetMaxMessagesInARoundPerPeer returns the maximum number of messages a peer can send per round for PoA
+func (wrk *worker) GetMaxMessagesInARoundPerPeer() uint32 {
+	return peerMaxMessagesPerSec
+}
+
+// GetStringValue gets the name of the messageType
+func (wrk *worker) GetStringValue(_ consensus.MessageType) string {
+	return "(UNKNOWN)"
+}
+
+// GetSubroundName gets the subround name for the subround id provided
+func (wrk *worker) GetSubroundName(subroundId int) string {
+	return getSubroundName(subroundId)
+}
+
+// IsMessageWithBlockBodyAndHeader returns false as the PoA engine does not use consensus messages
+func (wrk *worker) IsMessageWithBlockBodyAndHeader(_ consensus.MessageType) bool {
+	return false
+}
+
+// IsMessageWithBlockBody returns false as the PoA engine does not use consensus messages
+func (wrk *worker) IsMessageWithBlockBody(_ consensus.MessageType) bool {
+	return false
+}
+
+// IsMessageWithBlockHeader returns false as the PoA engine does not use consensus messages
+func (wrk *worker) IsMessageWithBlockHeader(_ consensus.MessageType) bool {
+	return false
+}
+
+// IsMessageWithSignature returns false as the PoA engine does not use consensus messages
+func (wrk *worker) IsMessageWithSignature(_ consensus.MessageType) bool {
+	return false
+}
+
+// IsMessageWithFinalInfo returns false as the PoA engine does not use consensus messages
+func (wrk *worker) IsMessageWithFinalInfo(_ consensus.MessageType) bool {
+	return false
+}
+
+// IsMessageTypeValid returns false as the PoA engine does not use consensus messages
+func (wrk *worker) IsMessageTypeValid(_ consensus.MessageType) bool {
+	return false
+}
+
+// IsSubroundSignature returns false as the PoA engine does not have a signature subround
+func (wrk *worker) IsSubroundSignature(_ int) bool {
+	return false
+}
+
+// IsSubroundStartRound returns if the current subround is about start round
+func (wrk *worker) IsSubroundStartRound(subroundId int) bool {
+	return subroundId == SrStartRound
+}
+
+// GetMessageRange returns an empty range as the PoA engine does not use consensus messages
+func (wrk *worker) GetMessageRange() []consensus.MessageType {
+	return make([]consensus.MessageType, 0)
+}
+
+// CanProceed returns false as the PoA engine does not use consensus messages
+func (wrk *worker) CanProceed(_ *spos.ConsensusState, _ consensus.MessageType) bool {
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (wrk *worker) IsInterfaceNil() bool {
+	return wrk == nil
+}
@@ -0,0 +1,257 @@
+package poa
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/display"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+)
+
+// subroundBlock defines the data needed by the subround Block. In this subround the leader creates the
+// block, seals it with its own key, commits it and broadcasts it. The other nodes do nothing as they
+// receive the block through the usual interceptors and the bootstrapper
+type subroundBlock struct {
+	*spos.Subround
+}
+
+// NewSubroundBlock creates a subroundBlock object
+func NewSubroundBlock(
+	baseSubround *spos.Subround,
+	extend func(subroundId int),
+) (*subroundBlock, error) {
+	if baseSubround == nil {
+		return nil, spos.ErrNilSubround
+	}
+	if baseSubround.ConsensusState == nil {
+		return nil, spos.ErrNilConsensusState
+	}
+	err := spos.ValidateConsensusCore(baseSubround.ConsensusCoreHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	srBlock := subroundBlock{
+		Subround: baseSubround,
+	}
+	srBlock.Job = srBlock.doBlockJob
+	srBlock.Check = srBlock.doBlockConsensusCheck
+	srBlock.Extend = extend
+
+	return &srBlock, nil
+}
+
+// doBlockJob method does the job of the subround Block
+func (sr *subroundBlock) doBlockJob() bool {
+	if !sr.IsSelfLeaderInCurrentRound() {
+		return false
+	}
+	if sr.RoundHandler().Index() <= sr.getRoundInLastCommittedBlock() {
+		return false
+	}
+	if sr.IsSelfJobDone(sr.Current()) {
+		return false
+	}
+
+	header, err := sr.createHeader()
+	if err != nil {
+		log.Debug("doBlockJob.createHeader", "error", err.Error())
+		return false
+	}
+
+	header, body, err := sr.createBlock(header)
+	if err != nil {
+		log.Debug("doBlockJob.createBlock", "error", err.Error())
+		return false
+	}
+
+	err = sr.sealBlock(header)
+	if err != nil {
+		log.Debug("doBlockJob.sealBlock", "error", err.Error())
+		return false
+	}
+
+	sr.Header = header
+	sr.Body = body
+	if sr.isOutOfTime() {
+		return false
+	}
+
+	err = sr.BroadcastMessenger().BroadcastHeader(header)
+	if err != nil {
+		log.Debug("doBlockJob.BroadcastHeader", "error", err.Error())
+	}
+
+	startTime := time.Now()
+	err = sr.BlockProcessor().CommitBlock(header, body)
+	elapsedTime := time.Since(startTime)
+	if elapsedTime >= common.CommitMaxTime {
+		log.Warn("doBlockJob.CommitBlock", "elapsed time", elapsedTime)
+	}
+	if err != nil {
+		log.Debug("doBlockJob.CommitBlock", "error", err)
+		return false
+	}
+
+	err = sr.broadcastBlockDataLeader()
+	if err != nil {
+		log.Debug("doBlockJob.broadcastBlockDataLeader", "error", err.Error())
+	}
+
+	sr.AppStatusHandler().Increment(common.MetricCountAcceptedBlocks)
+	msg := fmt.Sprintf("Added sealed block with nonce  %d  in blockchain", header.GetNonce())
+	log.Debug(display.Headline(msg, sr.SyncTimer().FormattedCurrentTime(), "+"))
+
+	return true
+}
+
+func (sr *subroundBlock) createHeader() (data.HeaderHandler, error) {
+	var nonce uint64
+	var prevHash []byte
+	var prevRandSeed []byte
+
+	currentHeader := sr.Blockchain().GetCurrentBlockHeader()
+	if check.IfNil(currentHeader) {
+		nonce = sr.Blockchain().GetGenesisHeader().GetNonce() + 1
+		prevHash = sr.Blockchain().GetGenesisHeaderHash()
+		prevRandSeed = sr.Blockchain().GetGenesisHeader().GetRandSeed()
+	} else {
+		nonce = currentHeader.GetNonce() + 1
+		prevHash = sr.Blockchain().GetCurrentBlockHeaderHash()
+		prevRandSeed = currentHeader.GetRandSeed()
+	}
+
+	round := uint64(sr.RoundHandler().Index())
+	hdr := sr.BlockProcessor().CreateNewHeader(round, nonce)
+	hdr.SetPrevHash(prevHash)
+
+	randSeed, err := sr.SignerBackend().SignRandSeed([]byte(sr.SelfPubKey()), int64(round), prevRandSeed)
+	if err != nil {
+		return nil, err
+	}
+
+	hdr.SetShardID(sr.ShardCoordinator().SelfId())
+	hdr.SetTimeStamp(uint64(sr.RoundHandler().TimeStamp().Unix()))
+	hdr.SetPrevRandSeed(prevRandSeed)
+	hdr.SetRandSeed(randSeed)
+	hdr.SetChainID(sr.ChainID())
+
+	return hdr, nil
+}
+
+func (sr *subroundBlock) createBlock(header data.HeaderHandler) (data.HeaderHandler, data.BodyHandler, error) {
+	startTime := sr.RoundTimeStamp
+	maxTime := time.Duration(sr.EndTime())
+	haveTimeInCurrentSubround := func() bool {
+		return sr.RoundHandler().RemainingTime(startTime, maxTime) > 0
+	}
+
+	return sr.BlockProcessor().CreateBlock(header, haveTimeInCurrentSubround)
+}
+
+// sealBlock adds on the header the aggregated signature of the one member consensus group and the leader signature,
+// so the header can be verified by the same header signature verifier used for the BLS engine
+func (sr *subroundBlock) sealBlock(header data.HeaderHandler) error {
+	marshalizedHeader, err := sr.Marshalizer().Marshal(header)
+	if err != nil {
+		return err
+	}
+
+	headerHash := sr.Hasher().Compute(string(marshalizedHeader))
+	_, err = sr.CreateSignatureShareForKey(headerHash, sr.SelfPubKey())
+	if err != nil {
+		return err
+	}
+
+	err = sr.SetSelfJobDone(sr.Current(), true)
+	if err != nil {
+		return err
+	}
+
+	bitmap := sr.GenerateBitmap(sr.Current())
+	aggregationStartTime := time.Now()
+	aggregatedSig, err := sr.MultiSigner().AggregateSigs(bitmap)
+	sr.TraceSelfEvent(consensus.RoundEvent{
+		Type:     consensus.RoundEventSignatureAggregation,
+		Round:    sr.RoundHandler().Index(),
+		Success:  err == nil,
+		Duration: time.Since(aggregationStartTime),
+	})
+	if err != nil {
+		return err
+	}
+
+	header.SetPubKeysBitmap(bitmap)
+	header.SetSignature(aggregatedSig)
+
+	headerClone := header.Clone()
+	headerClone.SetLeaderSignature(nil)
+	marshalizedHeader, err = sr.Marshalizer().Marshal(headerClone)
+	if err != nil {
+		return err
+	}
+
+	leaderSignature, err := sr.SignerBackend().SignBlockHeader([]byte(sr.SelfPubKey()), int64(header.GetRound()), marshalizedHeader)
+	if err != nil {
+		return err
+	}
+
+	header.SetLeaderSignature(leaderSignature)
+
+	return nil
+}
+
+func (sr *subroundBlock) broadcastBlockDataLeader() error {
+	miniBlocks, transactions, err := sr.BlockProcessor().MarshalizedDataToBroadcast(sr.Header, sr.Body)
+	if err != nil {
+		return err
+	}
+
+	return sr.BroadcastMessenger().BroadcastBlockDataLeader(sr.Header, miniBlocks, transactions)
+}
+
+func (sr *subroundBlock) getRoundInLastCommittedBlock() int64 {
+	currentHeader := sr.Blockchain().GetCurrentBlockHeader()
+	if check.IfNil(currentHeader) {
+		return 0
+	}
+
+	return int64(currentHeader.GetRound())
+}
+
+func (sr *subroundBlock) isOutOfTime() bool {
+	startTime := sr.RoundTimeStamp
+	maxTime := sr.RoundHandler().TimeDuration() * time.Duration(processingThresholdPercent) / 100
+	if sr.RoundHandler().RemainingTime(startTime, maxTime) < 0 {
+		log.Debug("canceled round, time is out",
+			"round", sr.SyncTimer().FormattedCurrentTime(), sr.RoundHandler().Index(),
+			"subround", sr.Name())
+
+		sr.RoundCanceled = true
+		return true
+	}
+
+	return false
+}
+
+// doBlockConsensusCheck method checks if the block has been produced. Nodes that are not leaders in the
+// current round have nothing to wait for
+func (sr *subroundBlock) doBlockConsensusCheck() bool {
+	if sr.RoundCanceled {
+		return false
+	}
+	if sr.IsSubroundFinished(sr.Current()) {
+		return true
+	}
+	if sr.IsSelfLeaderInCurrentRound() && !sr.IsSelfJobDone(sr.Current()) {
+		return false
+	}
+
+	sr.SetStatus(sr.Current(), spos.SsFinished)
+
+	return true
+}
@@ -0,0 +1,106 @@
+package poa_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/mock"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/poa"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createSubroundBlock(t *testing.T, container *mock.ConsensusCoreMock, consensusState *spos.ConsensusState) consensus.SubroundHandler {
+	subround, err := spos.NewSubround(
+		poa.SrStartRound,
+		poa.SrBlock,
+		-1,
+		int64(5*roundTimeDuration/100),
+		int64(95*roundTimeDuration/100),
+		"(BLOCK)",
+		consensusState,
+		make(chan bool, 1),
+		func() {},
+		container,
+		chainID,
+		currentPid,
+		&mock.AppStatusHandlerStub{},
+	)
+	require.Nil(t, err)
+
+	sr, err := poa.NewSubroundBlock(subround, func(int) {})
+	require.Nil(t, err)
+
+	return sr
+}
+
+func TestNewSubroundBlock_NilSubroundShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sr, err := poa.NewSubroundBlock(nil, func(int) {})
+
+	assert.Nil(t, sr)
+	assert.Equal(t, spos.ErrNilSubround, err)
+}
+
+func TestSubroundBlock_DoWorkAsLeaderShouldSealCommitAndBroadcast(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	container.SetRoundHandler(initRoundHandlerMock())
+
+	var committedHeader data.HeaderHandler
+	blockProcessor := mock.InitBlockProcessorMock()
+	blockProcessor.CommitBlockCalled = func(header data.HeaderHandler, body data.BodyHandler) error {
+		committedHeader = header
+		return nil
+	}
+	container.SetBlockProcessor(blockProcessor)
+
+	numHeaderBroadcasts := 0
+	numBlockDataBroadcasts := 0
+	container.SetBroadcastMessenger(&mock.BroadcastMessengerMock{
+		BroadcastHeaderCalled: func(handler data.HeaderHandler) error {
+			numHeaderBroadcasts++
+			return nil
+		},
+		BroadcastBlockDataLeaderCalled: func(_ data.HeaderHandler, _ map[uint32][]byte, _ map[string][][]byte) error {
+			numBlockDataBroadcasts++
+			return nil
+		},
+	})
+
+	consensusState := initConsensusState([]string{"A"}, "A")
+	sr := createSubroundBlock(t, container, consensusState)
+
+	assert.True(t, sr.DoWork(container.RoundHandler()))
+	require.NotNil(t, committedHeader)
+	assert.Equal(t, []byte{1}, committedHeader.GetPubKeysBitmap())
+	assert.NotNil(t, committedHeader.GetSignature())
+	assert.NotNil(t, committedHeader.GetLeaderSignature())
+	assert.Equal(t, 1, numHeaderBroadcasts)
+	assert.Equal(t, 1, numBlockDataBroadcasts)
+	assert.True(t, consensusState.IsSubroundFinished(poa.SrBlock))
+}
+
+func TestSubroundBlock_DoWorkAsNonLeaderShouldNotProduceBlock(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	container.SetRoundHandler(initRoundHandlerMock())
+
+	blockProcessor := mock.InitBlockProcessorMock()
+	blockProcessor.CommitBlockCalled = func(header data.HeaderHandler, body data.BodyHandler) error {
+		assert.Fail(t, "should not commit a block")
+		return nil
+	}
+	container.SetBlockProcessor(blockProcessor)
+
+	consensusState := initConsensusState([]string{"A"}, "B")
+	sr := createSubroundBlock(t, container, consensusState)
+
+	assert.True(t, sr.DoWork(container.RoundHandler()))
+	assert.True(t, consensusState.IsSubroundFinished(poa.SrBlock))
+}
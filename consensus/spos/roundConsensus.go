@@ -2,6 +2,8 @@ package spos
 
 import (
 	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
 )
 
 // roundConsensus defines the data needed by spos to do the consensus in each round
@@ -11,6 +13,7 @@ type roundConsensus struct {
 	consensusGroup       []string
 	consensusGroupSize   int
 	selfPubKey           string
+	keysHandler          consensus.KeysHandler
 	validatorRoundStates map[string]*roundState
 	mut                  sync.RWMutex
 }
@@ -20,12 +23,14 @@ func NewRoundConsensus(
 	eligibleNodes map[string]struct{},
 	consensusGroupSize int,
 	selfId string,
+	keysHandler consensus.KeysHandler,
 ) *roundConsensus {
 
 	rcns := roundConsensus{
 		eligibleNodes:      eligibleNodes,
 		consensusGroupSize: consensusGroupSize,
 		selfPubKey:         selfId,
+		keysHandler:        keysHandler,
 		mutEligible:        sync.RWMutex{},
 	}
 
@@ -152,6 +157,33 @@ func (rcns *roundConsensus) IsNodeInConsensusGroup(node string) bool {
 	return false
 }
 
+// IsKeyManagedByCurrentNode method checks if the current node is able to sign on behalf of the given public key
+func (rcns *roundConsensus) IsKeyManagedByCurrentNode(pubKey string) bool {
+	return rcns.keysHandler.IsKeyManagedByCurrentNode([]byte(pubKey))
+}
+
+// MultiKeysInConsensusGroup returns the public keys from the consensus group of the current round, other than
+// the self public key, which are managed by the current node
+func (rcns *roundConsensus) MultiKeysInConsensusGroup() []string {
+	multiKeys := make([]string, 0)
+	for _, pubKey := range rcns.consensusGroup {
+		if pubKey == rcns.selfPubKey {
+			continue
+		}
+		if rcns.IsKeyManagedByCurrentNode(pubKey) {
+			multiKeys = append(multiKeys, pubKey)
+		}
+	}
+
+	return multiKeys
+}
+
+// IsMultiKeyInConsensusGroup method checks if at least one of the keys managed by the current node, other than the
+// self public key, is part of the consensus group of the current round
+func (rcns *roundConsensus) IsMultiKeyInConsensusGroup() bool {
+	return len(rcns.MultiKeysInConsensusGroup()) > 0
+}
+
 // IsNodeInEligibleList method checks if the node is part of the eligible list
 func (rcns *roundConsensus) IsNodeInEligibleList(node string) bool {
 	rcns.mutEligible.RLock()
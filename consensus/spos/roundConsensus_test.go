@@ -5,6 +5,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
+	"github.com/ElrondNetwork/elrond-go/testscommon/cryptoMocks"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -19,7 +20,9 @@ func initRoundConsensus() *spos.RoundConsensus {
 	rcns := spos.NewRoundConsensus(
 		eligibleNodes,
 		len(eligibleNodes),
-		"2")
+		"2",
+		&cryptoMocks.KeysHandlerStub{},
+	)
 
 	rcns.SetConsensusGroup(pubKeys)
 
@@ -49,7 +52,7 @@ func TestRoundConsensus_ConsensusGroupIndexFound(t *testing.T) {
 		eligibleNodes[pubKeys[i]] = struct{}{}
 	}
 
-	rcns := spos.NewRoundConsensus(eligibleNodes, 3, "key3")
+	rcns := spos.NewRoundConsensus(eligibleNodes, 3, "key3", &cryptoMocks.KeysHandlerStub{})
 	rcns.SetConsensusGroup(pubKeys)
 	index, err := rcns.ConsensusGroupIndex("key3")
 
@@ -67,7 +70,7 @@ func TestRoundConsensus_ConsensusGroupIndexNotFound(t *testing.T) {
 		eligibleNodes[pubKeys[i]] = struct{}{}
 	}
 
-	rcns := spos.NewRoundConsensus(eligibleNodes, 3, "key4")
+	rcns := spos.NewRoundConsensus(eligibleNodes, 3, "key4", &cryptoMocks.KeysHandlerStub{})
 	rcns.SetConsensusGroup(pubKeys)
 	index, err := rcns.ConsensusGroupIndex("key4")
 
@@ -85,7 +88,7 @@ func TestRoundConsensus_IndexSelfConsensusGroupInConsesus(t *testing.T) {
 		eligibleNodes[pubKeys[i]] = struct{}{}
 	}
 
-	rcns := spos.NewRoundConsensus(eligibleNodes, 3, "key2")
+	rcns := spos.NewRoundConsensus(eligibleNodes, 3, "key2", &cryptoMocks.KeysHandlerStub{})
 	rcns.SetConsensusGroup(pubKeys)
 	index, err := rcns.SelfConsensusGroupIndex()
 
@@ -103,7 +106,7 @@ func TestRoundConsensus_IndexSelfConsensusGroupNotFound(t *testing.T) {
 		eligibleNodes[pubKeys[i]] = struct{}{}
 	}
 
-	rcns := spos.NewRoundConsensus(eligibleNodes, 3, "key4")
+	rcns := spos.NewRoundConsensus(eligibleNodes, 3, "key4", &cryptoMocks.KeysHandlerStub{})
 	rcns.SetConsensusGroup(pubKeys)
 	index, err := rcns.SelfConsensusGroupIndex()
 
@@ -111,6 +114,36 @@ func TestRoundConsensus_IndexSelfConsensusGroupNotFound(t *testing.T) {
 	assert.Equal(t, spos.ErrNotFoundInConsensus, err)
 }
 
+func TestRoundConsensus_MultiKeysInConsensusGroup(t *testing.T) {
+	t.Parallel()
+
+	pubKeys := []string{"key1", "key2", "key3", "key4"}
+	eligibleNodes := make(map[string]struct{})
+
+	for i := range pubKeys {
+		eligibleNodes[pubKeys[i]] = struct{}{}
+	}
+
+	managedKeys := map[string]struct{}{"key1": {}, "key2": {}, "key4": {}, "key5": {}}
+	keysHandler := &cryptoMocks.KeysHandlerStub{
+		IsKeyManagedByCurrentNodeCalled: func(pkBytes []byte) bool {
+			_, found := managedKeys[string(pkBytes)]
+			return found
+		},
+	}
+	rcns := spos.NewRoundConsensus(eligibleNodes, 4, "key2", keysHandler)
+	rcns.SetConsensusGroup(pubKeys)
+
+	assert.True(t, rcns.IsKeyManagedByCurrentNode("key5"))
+	assert.False(t, rcns.IsKeyManagedByCurrentNode("key3"))
+	assert.Equal(t, []string{"key1", "key4"}, rcns.MultiKeysInConsensusGroup())
+	assert.True(t, rcns.IsMultiKeyInConsensusGroup())
+
+	rcns.SetConsensusGroup([]string{"key2", "key3"})
+	assert.Equal(t, 0, len(rcns.MultiKeysInConsensusGroup()))
+	assert.False(t, rcns.IsMultiKeyInConsensusGroup())
+}
+
 func TestRoundConsensus_SetEligibleListShouldChangeTheEligibleList(t *testing.T) {
 	t.Parallel()
 
@@ -1,4 +1,3 @@
 package sposFactory
 
-const blsConsensusType = "bls"
 const maxDelayCacheSize = 20
@@ -0,0 +1,65 @@
+package sposFactory
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/poa"
+)
+
+var mutEngines sync.RWMutex
+var engines = map[string]spos.ConsensusEngine{}
+
+func init() {
+	_ = RegisterConsensusEngine(bls.NewConsensusEngine())
+	_ = RegisterConsensusEngine(poa.NewConsensusEngine())
+}
+
+// RegisterConsensusEngine makes a consensus engine available under its name, so it can be selected
+// through the Type option from the [Consensus] config section
+func RegisterConsensusEngine(engine spos.ConsensusEngine) error {
+	if check.IfNil(engine) {
+		return ErrNilConsensusEngine
+	}
+
+	mutEngines.Lock()
+	defer mutEngines.Unlock()
+
+	_, exists := engines[engine.Name()]
+	if exists {
+		return ErrConsensusEngineAlreadyRegistered
+	}
+	engines[engine.Name()] = engine
+
+	return nil
+}
+
+// GetConsensusEngine returns the consensus engine registered under the provided name
+func GetConsensusEngine(consensusType string) (spos.ConsensusEngine, error) {
+	mutEngines.RLock()
+	defer mutEngines.RUnlock()
+
+	engine, exists := engines[consensusType]
+	if !exists {
+		return nil, ErrInvalidConsensusType
+	}
+
+	return engine, nil
+}
+
+// RegisteredConsensusEngines returns the sorted names of all registered consensus engines
+func RegisteredConsensusEngines() []string {
+	mutEngines.RLock()
+	defer mutEngines.RUnlock()
+
+	names := make([]string, 0, len(engines))
+	for name := range engines {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
@@ -0,0 +1,66 @@
+package sposFactory_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/sposFactory"
+	"github.com/stretchr/testify/assert"
+)
+
+type consensusEngineStub struct {
+	name string
+}
+
+func (ces *consensusEngineStub) Name() string {
+	return ces.name
+}
+
+func (ces *consensusEngineStub) CreateConsensusService() (spos.ConsensusService, error) {
+	return bls.NewConsensusService()
+}
+
+func (ces *consensusEngineStub) CreateSubroundsFactory(_ spos.ArgsSubroundsFactory) (spos.SubroundsFactory, error) {
+	return nil, nil
+}
+
+func (ces *consensusEngineStub) IsInterfaceNil() bool {
+	return ces == nil
+}
+
+func TestRegisterConsensusEngine_NilEngineShouldErr(t *testing.T) {
+	t.Parallel()
+
+	err := sposFactory.RegisterConsensusEngine(nil)
+	assert.Equal(t, sposFactory.ErrNilConsensusEngine, err)
+}
+
+func TestRegisterConsensusEngine_DuplicatedNameShouldErr(t *testing.T) {
+	t.Parallel()
+
+	err := sposFactory.RegisterConsensusEngine(&consensusEngineStub{name: consensus.BlsConsensusType})
+	assert.Equal(t, sposFactory.ErrConsensusEngineAlreadyRegistered, err)
+}
+
+func TestRegisterConsensusEngine_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	engine := &consensusEngineStub{name: "custom engine"}
+	err := sposFactory.RegisterConsensusEngine(engine)
+	assert.Nil(t, err)
+
+	registeredEngine, err := sposFactory.GetConsensusEngine("custom engine")
+	assert.Nil(t, err)
+	assert.True(t, engine == registeredEngine)
+	assert.Contains(t, sposFactory.RegisteredConsensusEngines(), "custom engine")
+}
+
+func TestRegisteredConsensusEngines_ShouldContainDefaultEngines(t *testing.T) {
+	t.Parallel()
+
+	names := sposFactory.RegisteredConsensusEngines()
+	assert.Contains(t, names, consensus.BlsConsensusType)
+	assert.Contains(t, names, consensus.PoAConsensusType)
+}
@@ -9,3 +9,9 @@ var ErrInvalidConsensusType = errors.New("invalid consensus type")
 
 // ErrInvalidShardId signals that an invalid shard id has been provided
 var ErrInvalidShardId = errors.New("invalid shard id")
+
+// ErrNilConsensusEngine signals that a nil consensus engine has been provided
+var ErrNilConsensusEngine = errors.New("nil consensus engine")
+
+// ErrConsensusEngineAlreadyRegistered signals that a consensus engine with the same name was already registered
+var ErrConsensusEngineAlreadyRegistered = errors.New("consensus engine already registered")
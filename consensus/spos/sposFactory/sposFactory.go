@@ -5,11 +5,9 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/hashing"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
-	"github.com/ElrondNetwork/elrond-go-crypto"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/consensus/broadcast"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
-	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
 	"github.com/ElrondNetwork/elrond-go/outport"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/sharding"
@@ -26,36 +24,30 @@ func GetSubroundsFactory(
 	chainID []byte,
 	currentPid core.PeerID,
 ) (spos.SubroundsFactory, error) {
-	switch consensusType {
-	case blsConsensusType:
-		subRoundFactoryBls, err := bls.NewSubroundsFactory(
-			consensusDataContainer,
-			consensusState,
-			worker,
-			chainID,
-			currentPid,
-			appStatusHandler,
-		)
-		if err != nil {
-			return nil, err
-		}
-
-		subRoundFactoryBls.SetOutportHandler(outportHandler)
-
-		return subRoundFactoryBls, nil
-	default:
-		return nil, ErrInvalidConsensusType
+	engine, err := GetConsensusEngine(consensusType)
+	if err != nil {
+		return nil, err
 	}
+
+	return engine.CreateSubroundsFactory(spos.ArgsSubroundsFactory{
+		ConsensusDataContainer: consensusDataContainer,
+		ConsensusState:         consensusState,
+		Worker:                 worker,
+		AppStatusHandler:       appStatusHandler,
+		OutportHandler:         outportHandler,
+		ChainID:                chainID,
+		CurrentPid:             currentPid,
+	})
 }
 
 // GetConsensusCoreFactory returns a consensus service depending of the given parameter
 func GetConsensusCoreFactory(consensusType string) (spos.ConsensusService, error) {
-	switch consensusType {
-	case blsConsensusType:
-		return bls.NewConsensusService()
-	default:
-		return nil, ErrInvalidConsensusType
+	engine, err := GetConsensusEngine(consensusType)
+	if err != nil {
+		return nil, err
 	}
+
+	return engine.CreateConsensusService()
 }
 
 // GetBroadcastMessenger returns a consensus service depending of the given parameter
@@ -64,8 +56,7 @@ func GetBroadcastMessenger(
 	hasher hashing.Hasher,
 	messenger consensus.P2PMessenger,
 	shardCoordinator sharding.Coordinator,
-	privateKey crypto.PrivateKey,
-	peerSignatureHandler crypto.PeerSignatureHandler,
+	signerBackend consensus.SignerBackend,
 	headersSubscriber consensus.HeadersPoolSubscriber,
 	interceptorsContainer process.InterceptorsContainer,
 	alarmScheduler core.TimersScheduler,
@@ -79,9 +70,8 @@ func GetBroadcastMessenger(
 		Marshalizer:                marshalizer,
 		Hasher:                     hasher,
 		Messenger:                  messenger,
-		PrivateKey:                 privateKey,
 		ShardCoordinator:           shardCoordinator,
-		PeerSignatureHandler:       peerSignatureHandler,
+		SignerBackend:              signerBackend,
 		HeadersSubscriber:          headersSubscriber,
 		MaxDelayCacheSize:          maxDelayCacheSize,
 		MaxValidatorDelayCacheSize: maxDelayCacheSize,
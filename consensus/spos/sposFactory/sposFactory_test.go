@@ -10,6 +10,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/sposFactory"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/cryptoMocks"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -33,6 +34,15 @@ func TestGetConsensusCoreFactory_BlsShouldWork(t *testing.T) {
 	assert.False(t, check.IfNil(csf))
 }
 
+func TestGetConsensusCoreFactory_PoAShouldWork(t *testing.T) {
+	t.Parallel()
+
+	csf, err := sposFactory.GetConsensusCoreFactory(consensus.PoAConsensusType)
+
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(csf))
+}
+
 func TestGetSubroundsFactory_BlsNilConsensusCoreShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -131,8 +141,7 @@ func TestGetBroadcastMessenger_ShardShouldWork(t *testing.T) {
 	shardCoord.SelfIDCalled = func() uint32 {
 		return 0
 	}
-	privateKey := &mock.PrivateKeyMock{}
-	peerSigHandler := &mock.PeerSignatureHandler{}
+	signerBackend := &cryptoMocks.SignerBackendStub{}
 	headersSubscriber := &mock.HeadersCacherStub{}
 	interceptosContainer := &testscommon.InterceptorsContainerStub{}
 	alarmSchedulerStub := &mock.AlarmSchedulerStub{}
@@ -142,8 +151,7 @@ func TestGetBroadcastMessenger_ShardShouldWork(t *testing.T) {
 		hasher,
 		messenger,
 		shardCoord,
-		privateKey,
-		peerSigHandler,
+		signerBackend,
 		headersSubscriber,
 		interceptosContainer,
 		alarmSchedulerStub,
@@ -163,8 +171,7 @@ func TestGetBroadcastMessenger_MetachainShouldWork(t *testing.T) {
 	shardCoord.SelfIDCalled = func() uint32 {
 		return core.MetachainShardId
 	}
-	privateKey := &mock.PrivateKeyMock{}
-	peerSigHandler := &mock.PeerSignatureHandler{}
+	signerBackend := &cryptoMocks.SignerBackendStub{}
 	headersSubscriber := &mock.HeadersCacherStub{}
 	interceptosContainer := &testscommon.InterceptorsContainerStub{}
 	alarmSchedulerStub := &mock.AlarmSchedulerStub{}
@@ -174,8 +181,7 @@ func TestGetBroadcastMessenger_MetachainShouldWork(t *testing.T) {
 		hasher,
 		messenger,
 		shardCoord,
-		privateKey,
-		peerSigHandler,
+		signerBackend,
 		headersSubscriber,
 		interceptosContainer,
 		alarmSchedulerStub,
@@ -198,7 +204,6 @@ func TestGetBroadcastMessenger_NilShardCoordinatorShouldErr(t *testing.T) {
 		nil,
 		nil,
 		nil,
-		nil,
 		headersSubscriber,
 		interceptosContainer,
 		alarmSchedulerStub,
@@ -225,7 +230,6 @@ func TestGetBroadcastMessenger_InvalidShardIdShouldErr(t *testing.T) {
 		nil,
 		shardCoord,
 		nil,
-		nil,
 		headersSubscriber,
 		interceptosContainer,
 		alarmSchedulerStub,
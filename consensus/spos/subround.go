@@ -1,6 +1,7 @@
 package spos
 
 import (
+	"encoding/hex"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
@@ -131,8 +132,14 @@ func (sr *Subround) DoWork(roundHandler consensus.RoundHandler) bool {
 	startTime := roundHandler.TimeStamp()
 	maxTime := roundHandler.TimeDuration() * MaxThresholdPercent / 100
 
-	sr.Job()
+	roundIndex := roundHandler.Index()
+	sr.traceEvent(roundIndex, consensus.RoundEventJobStart, true, 0)
+	jobStartTime := time.Now()
+	jobDone := sr.Job()
+	sr.traceEvent(roundIndex, consensus.RoundEventJobEnd, jobDone, time.Since(jobStartTime))
+
 	if sr.Check() {
+		sr.traceEvent(roundIndex, consensus.RoundEventThresholdReached, true, 0)
 		return true
 	}
 
@@ -140,9 +147,11 @@ func (sr *Subround) DoWork(roundHandler consensus.RoundHandler) bool {
 		select {
 		case <-sr.consensusStateChangedChannel:
 			if sr.Check() {
+				sr.traceEvent(roundIndex, consensus.RoundEventThresholdReached, true, 0)
 				return true
 			}
 		case <-time.After(roundHandler.RemainingTime(startTime, maxTime)):
+			sr.traceEvent(roundIndex, consensus.RoundEventSubroundExtended, false, 0)
 			if sr.Extend != nil {
 				sr.RoundCanceled = true
 				sr.Extend(sr.current)
@@ -153,6 +162,50 @@ func (sr *Subround) DoWork(roundHandler consensus.RoundHandler) bool {
 	}
 }
 
+func (sr *Subround) traceEvent(roundIndex int64, eventType consensus.RoundEventType, success bool, duration time.Duration) {
+	sr.TraceSelfEvent(consensus.RoundEvent{
+		Type:     eventType,
+		Round:    roundIndex,
+		Success:  success,
+		Duration: duration,
+	})
+}
+
+// TraceSelfEvent adds the subround name and the self public key and consensus index on the provided event
+// and records it in the round tracer
+func (sr *Subround) TraceSelfEvent(event consensus.RoundEvent) {
+	selfIndex, err := sr.SelfConsensusGroupIndex()
+	if err != nil {
+		selfIndex = -1
+	}
+
+	event.Subround = sr.name
+	event.PubKey = hex.EncodeToString([]byte(sr.SelfPubKey()))
+	event.SenderIndex = selfIndex
+	sr.RoundTracer().AddEvent(event)
+}
+
+// CreateSignatureShareForKey asks the signer backend for the signature share of the given public key on the
+// consensus data and stores it in the multi signer, at the position of the key in the consensus group
+func (sr *Subround) CreateSignatureShareForKey(consensusData []byte, pubKey string) ([]byte, error) {
+	index, err := sr.ConsensusGroupIndex(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	signatureShare, err := sr.SignerBackend().SignConsensusData([]byte(pubKey), sr.RoundHandler().Index(), consensusData)
+	if err != nil {
+		return nil, err
+	}
+
+	err = sr.MultiSigner().StoreSignatureShare(uint16(index), signatureShare)
+	if err != nil {
+		return nil, err
+	}
+
+	return signatureShare, nil
+}
+
 // Previous method returns the ID of the previous Subround
 func (sr *Subround) Previous() int {
 	return sr.previous
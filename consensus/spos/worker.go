@@ -71,6 +71,8 @@ type Worker struct {
 	cancelFunc                func()
 	consensusMessageValidator *consensusMessageValidator
 	nodeRedundancyHandler     consensus.NodeRedundancyHandler
+	roundTracer               consensus.RoundTracer
+	slashingDetector          consensus.SlashingDetector
 	closer                    core.SafeCloser
 }
 
@@ -99,6 +101,8 @@ type WorkerArgs struct {
 	PublicKeySize            int
 	AppStatusHandler         core.AppStatusHandler
 	NodeRedundancyHandler    consensus.NodeRedundancyHandler
+	RoundTracer              consensus.RoundTracer
+	SlashingDetector         consensus.SlashingDetector
 }
 
 // NewWorker creates a new Worker object
@@ -144,6 +148,8 @@ func NewWorker(args *WorkerArgs) (*Worker, error) {
 		antifloodHandler:         args.AntifloodHandler,
 		poolAdder:                args.PoolAdder,
 		nodeRedundancyHandler:    args.NodeRedundancyHandler,
+		roundTracer:              args.RoundTracer,
+		slashingDetector:         args.SlashingDetector,
 		closer:                   closing.NewSafeChanCloser(),
 	}
 
@@ -239,6 +245,12 @@ func checkNewWorkerParams(args *WorkerArgs) error {
 	if check.IfNil(args.NodeRedundancyHandler) {
 		return ErrNilNodeRedundancyHandler
 	}
+	if check.IfNil(args.RoundTracer) {
+		return ErrNilRoundTracer
+	}
+	if check.IfNil(args.SlashingDetector) {
+		return ErrNilSlashingDetector
+	}
 
 	return nil
 }
@@ -253,7 +265,10 @@ func (wrk *Worker) receivedSyncState(isNodeSynchronized bool) {
 }
 
 // ReceivedHeader process the received header, calling each received header handler registered in worker instance
-func (wrk *Worker) ReceivedHeader(headerHandler data.HeaderHandler, _ []byte) {
+func (wrk *Worker) ReceivedHeader(headerHandler data.HeaderHandler, headerHash []byte) {
+	// all the received headers are checked for double proposing, not only the ones processed by the consensus
+	wrk.slashingDetector.ProcessHeader(headerHandler, headerHash)
+
 	isHeaderForOtherShard := headerHandler.GetShardID() != wrk.shardCoordinator.SelfId()
 	isHeaderForOtherRound := int64(headerHandler.GetRound()) != wrk.roundHandler.Index()
 	headerCanNotBeProcessed := isHeaderForOtherShard || isHeaderForOtherRound
@@ -352,7 +367,7 @@ func (wrk *Worker) ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedP
 
 	if wrk.nodeRedundancyHandler.IsRedundancyNode() {
 		wrk.nodeRedundancyHandler.ResetInactivityIfNeeded(
-			wrk.consensusState.SelfPubKey(),
+			wrk.getRedundancyPubKey(string(cnsMsg.PubKey)),
 			string(cnsMsg.PubKey),
 			message.Peer(),
 		)
@@ -370,10 +385,16 @@ func (wrk *Worker) ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedP
 
 	err = wrk.consensusMessageValidator.checkConsensusMessageValidity(cnsMsg, message.Peer())
 	if err != nil {
+		if errors.Is(err, ErrMessageTypeLimitReached) {
+			// a second message of the same type from the same key is exactly what a double signer sends
+			wrk.slashingDetector.ProcessConsensusMessage(cnsMsg)
+		}
 		return err
 	}
 
 	wrk.networkShardingCollector.UpdatePeerIDInfo(message.Peer(), cnsMsg.PubKey, wrk.shardCoordinator.SelfId())
+	wrk.traceReceivedMessage(cnsMsg)
+	wrk.slashingDetector.ProcessConsensusMessage(cnsMsg)
 
 	isMessageWithBlockBody := wrk.consensusService.IsMessageWithBlockBody(msgType)
 	isMessageWithBlockHeader := wrk.consensusService.IsMessageWithBlockHeader(msgType)
@@ -407,6 +428,22 @@ func (wrk *Worker) ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedP
 	return nil
 }
 
+func (wrk *Worker) traceReceivedMessage(cnsMsg *consensus.Message) {
+	senderIndex, err := wrk.consensusState.ConsensusGroupIndex(string(cnsMsg.PubKey))
+	if err != nil {
+		senderIndex = -1
+	}
+
+	wrk.roundTracer.AddEvent(consensus.RoundEvent{
+		Type:        consensus.RoundEventMessageReceived,
+		Round:       cnsMsg.RoundIndex,
+		MessageType: wrk.consensusService.GetStringValue(consensus.MessageType(cnsMsg.MsgType)),
+		PubKey:      hex.EncodeToString(cnsMsg.PubKey),
+		SenderIndex: senderIndex,
+		Success:     true,
+	})
+}
+
 func (wrk *Worker) shouldBlacklistPeer(err error) bool {
 	if err == nil ||
 		errors.Is(err, ErrMessageForPastRound) ||
@@ -508,6 +545,10 @@ func (wrk *Worker) checkSelfState(cnsDta *consensus.Message) error {
 		return ErrMessageFromItself
 	}
 
+	if wrk.consensusState.IsKeyManagedByCurrentNode(string(cnsDta.PubKey)) {
+		return ErrMessageFromItself
+	}
+
 	if wrk.consensusState.RoundCanceled && wrk.consensusState.RoundIndex == cnsDta.RoundIndex {
 		return ErrRoundCanceled
 	}
@@ -515,6 +556,16 @@ func (wrk *Worker) checkSelfState(cnsDta *consensus.Message) error {
 	return nil
 }
 
+// getRedundancyPubKey returns the key the main machine activity is checked against: the message public key if it is
+// managed by the current node or the self public key otherwise
+func (wrk *Worker) getRedundancyPubKey(msgPubKey string) string {
+	if wrk.consensusState.IsKeyManagedByCurrentNode(msgPubKey) {
+		return msgPubKey
+	}
+
+	return wrk.consensusState.SelfPubKey()
+}
+
 func (wrk *Worker) executeReceivedMessages(cnsDta *consensus.Message) {
 	wrk.mutReceivedMessages.Lock()
 
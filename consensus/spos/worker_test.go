@@ -15,11 +15,14 @@ import (
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/consensus/mock"
+	"github.com/ElrondNetwork/elrond-go/consensus/slashing"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/cryptoMocks"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericMocks"
 	"github.com/ElrondNetwork/elrond-go/testscommon/p2pmocks"
 	"github.com/stretchr/testify/assert"
 )
@@ -99,6 +102,8 @@ func createDefaultWorkerArgs(appStatusHandler core.AppStatusHandler) *spos.Worke
 		PublicKeySize:            PublicKeySize,
 		AppStatusHandler:         appStatusHandler,
 		NodeRedundancyHandler:    &mock.NodeRedundancyHandlerStub{},
+		RoundTracer:              &mock.RoundTracerStub{},
+		SlashingDetector:         &mock.SlashingDetectorStub{},
 	}
 
 	return workerArgs
@@ -353,6 +358,28 @@ func TestWorker_NewWorkerNodeRedundancyHandlerShouldFail(t *testing.T) {
 	assert.Equal(t, spos.ErrNilNodeRedundancyHandler, err)
 }
 
+func TestWorker_NewWorkerNilRoundTracerShouldFail(t *testing.T) {
+	t.Parallel()
+
+	workerArgs := createDefaultWorkerArgs(&mock.AppStatusHandlerMock{})
+	workerArgs.RoundTracer = nil
+	wrk, err := spos.NewWorker(workerArgs)
+
+	assert.Nil(t, wrk)
+	assert.Equal(t, spos.ErrNilRoundTracer, err)
+}
+
+func TestWorker_NewWorkerNilSlashingDetectorShouldFail(t *testing.T) {
+	t.Parallel()
+
+	workerArgs := createDefaultWorkerArgs(&mock.AppStatusHandlerMock{})
+	workerArgs.SlashingDetector = nil
+	wrk, err := spos.NewWorker(workerArgs)
+
+	assert.Nil(t, wrk)
+	assert.Equal(t, spos.ErrNilSlashingDetector, err)
+}
+
 func TestWorker_NewWorkerShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -811,6 +838,48 @@ func TestWorker_ProcessReceivedMessageTypeLimitReachedShouldErr(t *testing.T) {
 	assert.True(t, errors.Is(err, spos.ErrMessageTypeLimitReached))
 }
 
+func TestWorker_ProcessReceivedMessageTypeLimitReachedShouldStillCheckForSlashing(t *testing.T) {
+	t.Parallel()
+
+	numProcessedMessages := uint32(0)
+	workerArgs := createDefaultWorkerArgs(&mock.AppStatusHandlerStub{})
+	workerArgs.SlashingDetector = &mock.SlashingDetectorStub{
+		ProcessConsensusMessageCalled: func(cnsMsg *consensus.Message) {
+			atomic.AddUint32(&numProcessedMessages, 1)
+		},
+	}
+	wrk, _ := spos.NewWorker(workerArgs)
+	blk := &block.Body{}
+	blkStr, _ := mock.MarshalizerMock{}.Marshal(blk)
+	cnsMsg := consensus.NewConsensusMessage(
+		nil,
+		nil,
+		blkStr,
+		nil,
+		[]byte(wrk.ConsensusState().ConsensusGroup()[0]),
+		signature,
+		int(bls.MtBlockBody),
+		0,
+		chainID,
+		nil,
+		nil,
+		nil,
+		currentPid,
+	)
+	buff, _ := wrk.Marshalizer().Marshal(cnsMsg)
+	msg := &mock.P2PMessageMock{
+		DataField: buff,
+		PeerField: currentPid,
+	}
+
+	err := wrk.ProcessReceivedMessage(msg, fromConnectedPeerId)
+	assert.Nil(t, err)
+	err = wrk.ProcessReceivedMessage(msg, fromConnectedPeerId)
+	assert.True(t, errors.Is(err, spos.ErrMessageTypeLimitReached))
+
+	assert.Equal(t, uint32(2), atomic.LoadUint32(&numProcessedMessages))
+}
+
 func TestWorker_ProcessReceivedMessageInvalidSignatureShouldErr(t *testing.T) {
 	t.Parallel()
 	wrk := *initWorker(&mock.AppStatusHandlerStub{})
@@ -1076,6 +1145,35 @@ func TestWorker_CheckSelfStateShouldErrMessageFromItself(t *testing.T) {
 	assert.Equal(t, spos.ErrMessageFromItself, err)
 }
 
+func TestWorker_CheckSelfStateManagedKeyShouldErrMessageFromItself(t *testing.T) {
+	t.Parallel()
+
+	workerArgs := createDefaultWorkerArgs(&mock.AppStatusHandlerStub{})
+	workerArgs.ConsensusState = initConsensusStateWithKeysHandler(&cryptoMocks.KeysHandlerStub{
+		IsKeyManagedByCurrentNodeCalled: func(pkBytes []byte) bool {
+			return string(pkBytes) == "C"
+		},
+	})
+	wrk, _ := spos.NewWorker(workerArgs)
+	cnsMsg := consensus.NewConsensusMessage(
+		nil,
+		nil,
+		nil,
+		nil,
+		[]byte("C"),
+		nil,
+		0,
+		0,
+		chainID,
+		nil,
+		nil,
+		nil,
+		currentPid,
+	)
+	err := wrk.CheckSelfState(cnsMsg)
+	assert.Equal(t, spos.ErrMessageFromItself, err)
+}
+
 func TestWorker_CheckSelfStateShouldErrRoundCanceled(t *testing.T) {
 	t.Parallel()
 	wrk := *initWorker(&mock.AppStatusHandlerStub{})
@@ -1561,3 +1659,60 @@ func TestWorker_ProcessReceivedMessageWrongHeaderShouldErr(t *testing.T) {
 	err := wrk.ProcessReceivedMessage(msg, "")
 	assert.True(t, errors.Is(err, spos.ErrInvalidHeader))
 }
+
+func TestWorker_ReceivedHeaderShouldCheckAllHeadersForSlashing(t *testing.T) {
+	t.Parallel()
+
+	var processedHashes [][]byte
+	workerArgs := createDefaultWorkerArgs(&mock.AppStatusHandlerStub{})
+	workerArgs.SlashingDetector = &mock.SlashingDetectorStub{
+		ProcessHeaderCalled: func(header data.HeaderHandler, headerHash []byte) {
+			processedHashes = append(processedHashes, headerHash)
+		},
+	}
+	wrk, _ := spos.NewWorker(workerArgs)
+
+	wrk.ReceivedHeader(&block.Header{Round: 100, ShardID: 1}, []byte("other shard and round"))
+
+	assert.Equal(t, [][]byte{[]byte("other shard and round")}, processedHashes)
+}
+
+func TestWorker_ReceivedHeaderDoubleProposingShouldCreateEvidence(t *testing.T) {
+	t.Parallel()
+
+	argsSlashingDetector := slashing.ArgsSlashingDetector{
+		Marshalizer:             &mock.MarshalizerMock{},
+		Hasher:                  &mock.HasherMock{},
+		Storer:                  genericMocks.NewStorerMock("slashing", 0),
+		MultiSigner:             cryptoMocks.NewMultiSigner(1),
+		HeaderSigVerifier:       &mock.HeaderSigVerifierStub{},
+		NodesCoordinator:        &mock.NodesCoordinatorMock{},
+		AddressPubKeyConverter:  testscommon.NewPubkeyConverterMock(32),
+		ChainID:                 chainID,
+		MinTransactionVersion:   1,
+		EvidenceReceiverAddress: make([]byte, 32),
+		EvidenceTxGasLimit:      1000,
+		NumRoundsToKeep:         3,
+	}
+	slashingDetector, _ := slashing.NewSlashingDetector(argsSlashingDetector)
+
+	workerArgs := createDefaultWorkerArgs(&mock.AppStatusHandlerStub{})
+	workerArgs.SlashingDetector = slashingDetector
+	wrk, _ := spos.NewWorker(workerArgs)
+
+	for _, rootHash := range []string{"root hash 1", "root hash 2"} {
+		header := &block.Header{
+			Round:           5,
+			RootHash:        []byte(rootHash),
+			PrevRandSeed:    []byte("prev rand seed"),
+			LeaderSignature: []byte("leader signature"),
+		}
+		wrk.ReceivedHeader(header, []byte("hash of "+rootHash))
+	}
+
+	evidences := slashingDetector.Evidences()
+	if assert.Equal(t, 1, len(evidences)) {
+		assert.Equal(t, consensus.DoubleProposingEvidence, evidences[0].Type)
+		assert.Equal(t, int64(5), evidences[0].Round)
+	}
+}
@@ -43,6 +43,10 @@ func (ut UnitType) String() string {
 		return "ReceiptsUnit"
 	case TrieEpochRootHashUnit:
 		return "TrieEpochRootHashUnit"
+	case SlashingEvidenceUnit:
+		return "SlashingEvidenceUnit"
+	case ESDTRegistryUnit:
+		return "ESDTRegistryUnit"
 	}
 
 	if ut < ShardHdrNonceHashDataUnit {
@@ -89,6 +93,10 @@ const (
 	ResultsHashesByTxHashUnit UnitType = 16
 	// TrieEpochRootHashUnit is the trie epoch <-> root hash storage unit identifier
 	TrieEpochRootHashUnit UnitType = 17
+	// SlashingEvidenceUnit is the slashing evidence storage unit identifier
+	SlashingEvidenceUnit UnitType = 18
+	// ESDTRegistryUnit is the ESDT supplies and holders storage unit identifier
+	ESDTRegistryUnit UnitType = 19
 
 	// ShardHdrNonceHashDataUnit is the header nonce-hash pair data unit identifier
 	//TODO: Add only unit types lower than 100
@@ -8,6 +8,9 @@ import (
 
 var errCannotCastToBlockBody = errors.New("cannot cast to block body")
 
+// ErrNilESDTRegistry signals that a nil ESDT registry has been provided
+var ErrNilESDTRegistry = errors.New("nil esdt registry")
+
 func newErrCannotSaveEpochByHash(what string, hash []byte, originalErr error) error {
 	return fmt.Errorf("cannot save epoch num for [%s] hash [%s]: %w", what, hex.EncodeToString(hash), originalErr)
 }
@@ -0,0 +1,325 @@
+package esdtRegistry
+
+import (
+	"math/big"
+	"sort"
+)
+
+type holderChange struct {
+	address   []byte
+	holder    *HolderBalance
+	wasHolder bool
+}
+
+type tokenChanges struct {
+	supply          *TokenSupply
+	holders         map[string]*holderChange
+	holderAddresses map[uint64][]byte
+	removedIndexes  map[uint64]struct{}
+}
+
+// blockChanges accumulates the changes generated by the events of a block, so that the registry storage is updated
+// only once for each changed token and holder
+type blockChanges struct {
+	registry       *esdtRegistry
+	tokens         map[string]*tokenChanges
+	previousValues []*StorageEntry
+	changedKeys    map[string]struct{}
+}
+
+func newBlockChanges(registry *esdtRegistry) *blockChanges {
+	return &blockChanges{
+		registry:       registry,
+		tokens:         make(map[string]*tokenChanges),
+		previousValues: make([]*StorageEntry, 0),
+		changedKeys:    make(map[string]struct{}),
+	}
+}
+
+func (bc *blockChanges) getToken(token string) (*tokenChanges, error) {
+	tc, ok := bc.tokens[token]
+	if ok {
+		return tc, nil
+	}
+
+	supply, err := bc.registry.getSupply(token)
+	if err != nil {
+		return nil, err
+	}
+
+	tc = &tokenChanges{
+		supply:          supply,
+		holders:         make(map[string]*holderChange),
+		holderAddresses: make(map[uint64][]byte),
+		removedIndexes:  make(map[uint64]struct{}),
+	}
+	bc.tokens[token] = tc
+
+	return tc, nil
+}
+
+func (bc *blockChanges) getHolder(token string, tc *tokenChanges, address []byte) (*holderChange, error) {
+	hc, ok := tc.holders[string(address)]
+	if ok {
+		return hc, nil
+	}
+
+	holder, err := bc.registry.getHolder(token, address)
+	if err != nil {
+		return nil, err
+	}
+
+	hc = &holderChange{
+		address:   address,
+		holder:    holder,
+		wasHolder: holder.Balance.Sign() > 0,
+	}
+	tc.holders[string(address)] = hc
+
+	return hc, nil
+}
+
+func (bc *blockChanges) getHolderAt(token string, tc *tokenChanges, index uint64) ([]byte, error) {
+	address, ok := tc.holderAddresses[index]
+	if ok {
+		return address, nil
+	}
+
+	return bc.registry.storer.Get(holderAtKey(token, index))
+}
+
+func (bc *blockChanges) addToBalance(token string, address []byte, value *big.Int) error {
+	tc, err := bc.getToken(token)
+	if err != nil {
+		return err
+	}
+
+	hc, err := bc.getHolder(token, tc, address)
+	if err != nil {
+		return err
+	}
+
+	hc.holder.Balance.Add(hc.holder.Balance, value)
+	tc.supply.Supply.Add(tc.supply.Supply, value)
+
+	return nil
+}
+
+func (bc *blockChanges) wipe(token string, address []byte) error {
+	tc, err := bc.getToken(token)
+	if err != nil {
+		return err
+	}
+
+	hc, err := bc.getHolder(token, tc, address)
+	if err != nil {
+		return err
+	}
+
+	wipedValue := hc.holder.Balance
+	if wipedValue.Sign() > 0 {
+		tc.supply.Burned.Add(tc.supply.Burned, wipedValue)
+	}
+	tc.supply.Supply.Sub(tc.supply.Supply, wipedValue)
+	hc.holder.Balance = big.NewInt(0)
+
+	return nil
+}
+
+func (bc *blockChanges) addMinted(token string, value *big.Int) error {
+	tc, err := bc.getToken(token)
+	if err != nil {
+		return err
+	}
+
+	tc.supply.Minted.Add(tc.supply.Minted, value)
+
+	return nil
+}
+
+func (bc *blockChanges) addBurned(token string, value *big.Int) error {
+	tc, err := bc.getToken(token)
+	if err != nil {
+		return err
+	}
+
+	tc.supply.Burned.Add(tc.supply.Burned, value)
+
+	return nil
+}
+
+func (bc *blockChanges) save() error {
+	for _, token := range sortedTokens(bc.tokens) {
+		tc := bc.tokens[token]
+
+		err := bc.updateHoldersList(token, tc)
+		if err != nil {
+			return err
+		}
+
+		err = bc.saveToken(token, tc)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (bc *blockChanges) updateHoldersList(token string, tc *tokenChanges) error {
+	for _, address := range sortedAddresses(tc.holders) {
+		hc := tc.holders[address]
+		isHolder := hc.holder.Balance.Sign() > 0
+
+		if hc.wasHolder && !isHolder {
+			err := bc.removeHolder(token, tc, hc)
+			if err != nil {
+				return err
+			}
+		}
+		if !hc.wasHolder && isHolder {
+			bc.addHolder(tc, hc)
+		}
+		hc.wasHolder = isHolder
+	}
+
+	return nil
+}
+
+func (bc *blockChanges) addHolder(tc *tokenChanges, hc *holderChange) {
+	index := tc.supply.NumHolders
+	hc.holder.Index = index
+	tc.holderAddresses[index] = hc.address
+	delete(tc.removedIndexes, index)
+	tc.supply.NumHolders++
+}
+
+// removeHolder moves the last holder from the list in the position of the removed one
+func (bc *blockChanges) removeHolder(token string, tc *tokenChanges, hc *holderChange) error {
+	lastIndex := tc.supply.NumHolders - 1
+	index := hc.holder.Index
+	if index != lastIndex {
+		lastAddress, err := bc.getHolderAt(token, tc, lastIndex)
+		if err != nil {
+			return err
+		}
+
+		lastHolder, err := bc.getHolder(token, tc, lastAddress)
+		if err != nil {
+			return err
+		}
+
+		lastHolder.holder.Index = index
+		tc.holderAddresses[index] = lastAddress
+	}
+
+	delete(tc.holderAddresses, lastIndex)
+	tc.removedIndexes[lastIndex] = struct{}{}
+	hc.holder.Index = 0
+	tc.supply.NumHolders--
+
+	return nil
+}
+
+func (bc *blockChanges) saveToken(token string, tc *tokenChanges) error {
+	marshalizer := bc.registry.marshalizer
+
+	for _, address := range sortedAddresses(tc.holders) {
+		hc := tc.holders[address]
+		if hc.holder.Balance.Sign() == 0 {
+			bc.remove(holderKey(token, hc.address))
+			continue
+		}
+
+		holderBytes, err := marshalizer.Marshal(hc.holder)
+		if err != nil {
+			return err
+		}
+
+		err = bc.put(holderKey(token, hc.address), holderBytes)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, index := range sortedIndexes(tc.holderAddresses) {
+		err := bc.put(holderAtKey(token, index), tc.holderAddresses[index])
+		if err != nil {
+			return err
+		}
+	}
+
+	for index := range tc.removedIndexes {
+		bc.remove(holderAtKey(token, index))
+	}
+
+	supplyBytes, err := marshalizer.Marshal(tc.supply)
+	if err != nil {
+		return err
+	}
+
+	return bc.put(supplyKey(token), supplyBytes)
+}
+
+func (bc *blockChanges) put(key []byte, value []byte) error {
+	bc.savePreviousValue(key)
+
+	return bc.registry.storer.Put(key, value)
+}
+
+func (bc *blockChanges) remove(key []byte) {
+	bc.savePreviousValue(key)
+
+	_ = bc.registry.storer.Remove(key)
+}
+
+// savePreviousValue keeps the value the provided key had before the block, the first time the key is changed
+func (bc *blockChanges) savePreviousValue(key []byte) {
+	_, ok := bc.changedKeys[string(key)]
+	if ok {
+		return
+	}
+	bc.changedKeys[string(key)] = struct{}{}
+
+	value, err := bc.registry.storer.Get(key)
+	if err != nil {
+		value = nil
+	}
+
+	bc.previousValues = append(bc.previousValues, &StorageEntry{
+		Key:   key,
+		Value: value,
+	})
+}
+
+func sortedTokens(tokens map[string]*tokenChanges) []string {
+	keys := make([]string, 0, len(tokens))
+	for key := range tokens {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+func sortedIndexes(addresses map[uint64][]byte) []uint64 {
+	keys := make([]uint64, 0, len(addresses))
+	for key := range addresses {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i] < keys[j]
+	})
+
+	return keys
+}
+
+func sortedAddresses(holders map[string]*holderChange) []string {
+	keys := make([]string, 0, len(holders))
+	for key := range holders {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
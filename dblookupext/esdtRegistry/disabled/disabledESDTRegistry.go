@@ -0,0 +1,33 @@
+package disabled
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go/dblookupext/esdtRegistry"
+)
+
+type disabledESDTRegistry struct{}
+
+// NewDisabledESDTRegistry returns a disabled implementation to be used when the ESDT registry is not enabled
+func NewDisabledESDTRegistry() *disabledESDTRegistry {
+	return &disabledESDTRegistry{}
+}
+
+// ProcessLogs does nothing
+func (der *disabledESDTRegistry) ProcessLogs(_ []byte, _ data.HeaderHandler, _ map[string]data.LogHandler) error {
+	return nil
+}
+
+// GetESDTSupply returns nil
+func (der *disabledESDTRegistry) GetESDTSupply(_ string) (*esdtRegistry.TokenSupply, error) {
+	return nil, nil
+}
+
+// GetESDTHolders returns the ErrESDTRegistryNotEnabled error
+func (der *disabledESDTRegistry) GetESDTHolders(_ string, _ uint64, _ uint64) ([]*esdtRegistry.TokenHolder, error) {
+	return nil, esdtRegistry.ErrESDTRegistryNotEnabled
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (der *disabledESDTRegistry) IsInterfaceNil() bool {
+	return der == nil
+}
@@ -0,0 +1,15 @@
+package esdtRegistry
+
+import "errors"
+
+// ErrNilShardCoordinator signals that a nil shard coordinator has been provided
+var ErrNilShardCoordinator = errors.New("nil shard coordinator")
+
+// ErrNilBlockHeader signals that a nil block header has been provided
+var ErrNilBlockHeader = errors.New("nil block header")
+
+// ErrInvalidHoldersPageSize signals that an invalid holders page size has been provided
+var ErrInvalidHoldersPageSize = errors.New("invalid holders page size")
+
+// ErrESDTRegistryNotEnabled signals that the ESDT registry is not enabled on the node
+var ErrESDTRegistryNotEnabled = errors.New("esdt registry is not enabled")
@@ -0,0 +1,432 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. esdtRegistry.proto
+
+package esdtRegistry
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var log = logger.GetOrCreate("dblookupext/esdtRegistry")
+
+const (
+	lastProcessedHashKey = "lastProcessedBlockHash"
+	supplyKeyPrefix      = "supply_"
+	holderKeyPrefix      = "holder_"
+	holderAtKeyPrefix    = "holderAt_"
+	undoKeyPrefix        = "undo_"
+	undoAtNonceKeyPrefix = "undoAtNonce_"
+
+	// maxRevertibleBlocks is the number of blocks, behind the last processed one, for which the undo data is kept
+	maxRevertibleBlocks = 100
+
+	// MaxHoldersPerPage is the maximum number of holders that can be fetched at once
+	MaxHoldersPerPage = 1000
+)
+
+const (
+	minTopicsForChange   = 3
+	receiverTopicIndex   = 3
+	minTopicsForReceiver = 4
+)
+
+// ArgsESDTRegistry holds the arguments needed to create an ESDT registry
+type ArgsESDTRegistry struct {
+	Storer           storage.Storer
+	Marshalizer      marshal.Marshalizer
+	ShardCoordinator sharding.Coordinator
+}
+
+// TokenHolder holds the address of a token holder and its balance
+type TokenHolder struct {
+	Address []byte
+	Balance *big.Int
+}
+
+// esdtRegistry maintains, from the ESDT events generated in the committed blocks, the supply and the holders of the
+// tokens held by the accounts of the node's shard. For each processed block it keeps the previous values of the changed
+// entries, so that the blocks replaced by a fork are reverted before their replacements are processed.
+// The supply, the minted and the burned quantities only count the events processed since the registry was enabled:
+// there is no backfill, as the ESDT system smart contract, which holds the minted and the burnt values, is only
+// available on the metachain and does not split them by shard. The index is only accurate if it is maintained
+// starting with the genesis block
+type esdtRegistry struct {
+	storer            storage.Storer
+	marshalizer       marshal.Marshalizer
+	shardCoordinator  sharding.Coordinator
+	lastProcessedHash []byte
+	mutex             sync.RWMutex
+}
+
+// NewESDTRegistry creates a new ESDT registry instance
+func NewESDTRegistry(args ArgsESDTRegistry) (*esdtRegistry, error) {
+	if check.IfNil(args.Storer) {
+		return nil, core.ErrNilStore
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, core.ErrNilMarshalizer
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, ErrNilShardCoordinator
+	}
+
+	er := &esdtRegistry{
+		storer:           args.Storer,
+		marshalizer:      args.Marshalizer,
+		shardCoordinator: args.ShardCoordinator,
+	}
+
+	lastProcessedHash, err := er.storer.Get([]byte(lastProcessedHashKey))
+	if err == nil {
+		er.lastProcessedHash = lastProcessedHash
+	}
+
+	return er, nil
+}
+
+// ProcessLogs updates the supply and the holders of the tokens changed by the ESDT events from the provided logs.
+// The last processed block is ignored, so that a replayed block is not counted twice, while the processed blocks which
+// are not ancestors of the provided one are reverted first, as they were replaced by a fork
+func (er *esdtRegistry) ProcessLogs(blockHeaderHash []byte, blockHeader data.HeaderHandler, logs map[string]data.LogHandler) error {
+	if check.IfNil(blockHeader) {
+		return ErrNilBlockHeader
+	}
+
+	er.mutex.Lock()
+	defer er.mutex.Unlock()
+
+	if bytes.Equal(blockHeaderHash, er.lastProcessedHash) {
+		log.Debug("esdtRegistry.ProcessLogs: block already processed", "nonce", blockHeader.GetNonce(), "hash", blockHeaderHash)
+		return nil
+	}
+
+	err := er.revertUntil(blockHeader.GetPrevHash())
+	if err != nil {
+		return err
+	}
+
+	changes := newBlockChanges(er)
+	for _, txHash := range sortedLogKeys(logs) {
+		txLog := logs[txHash]
+		if check.IfNil(txLog) {
+			continue
+		}
+
+		for _, event := range txLog.GetLogEvents() {
+			if check.IfNil(event) {
+				continue
+			}
+
+			err := er.processEvent(changes, event)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	err = changes.save()
+	if err != nil {
+		return err
+	}
+
+	undo := &BlockUndo{
+		PrevHash: blockHeader.GetPrevHash(),
+		Nonce:    blockHeader.GetNonce(),
+		Entries:  changes.previousValues,
+	}
+	err = er.saveBlockUndo(blockHeaderHash, undo)
+	if err != nil {
+		return err
+	}
+
+	return er.setLastProcessedHash(blockHeaderHash)
+}
+
+// revertUntil reverts the processed blocks, starting with the last one, until the provided block becomes the last
+// processed one. If the undo data of a block is not available, the registry continues from its current state
+func (er *esdtRegistry) revertUntil(blockHeaderHash []byte) error {
+	for len(er.lastProcessedHash) > 0 && !bytes.Equal(er.lastProcessedHash, blockHeaderHash) {
+		undo, err := er.getBlockUndo(er.lastProcessedHash)
+		if err != nil {
+			log.Warn("esdtRegistry: cannot revert block, the registry might not be accurate",
+				"hash", er.lastProcessedHash, "error", err)
+			return er.setLastProcessedHash(nil)
+		}
+
+		err = er.revertBlock(er.lastProcessedHash, undo)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (er *esdtRegistry) revertBlock(blockHeaderHash []byte, undo *BlockUndo) error {
+	log.Debug("esdtRegistry: reverting block", "nonce", undo.Nonce, "hash", blockHeaderHash)
+
+	for _, entry := range undo.Entries {
+		if len(entry.Value) == 0 {
+			_ = er.storer.Remove(entry.Key)
+			continue
+		}
+
+		err := er.storer.Put(entry.Key, entry.Value)
+		if err != nil {
+			return err
+		}
+	}
+
+	_ = er.storer.Remove(undoKey(blockHeaderHash))
+
+	return er.setLastProcessedHash(undo.PrevHash)
+}
+
+// saveBlockUndo saves the undo data of the provided block and removes the undo data of the block which is no longer
+// revertible
+func (er *esdtRegistry) saveBlockUndo(blockHeaderHash []byte, undo *BlockUndo) error {
+	undoBytes, err := er.marshalizer.Marshal(undo)
+	if err != nil {
+		return err
+	}
+
+	err = er.storer.Put(undoKey(blockHeaderHash), undoBytes)
+	if err != nil {
+		return err
+	}
+
+	err = er.storer.Put(undoAtNonceKey(undo.Nonce), blockHeaderHash)
+	if err != nil {
+		return err
+	}
+
+	if undo.Nonce < maxRevertibleBlocks {
+		return nil
+	}
+
+	expiredNonce := undo.Nonce - maxRevertibleBlocks
+	expiredHash, err := er.storer.Get(undoAtNonceKey(expiredNonce))
+	if err != nil {
+		return nil
+	}
+
+	_ = er.storer.Remove(undoKey(expiredHash))
+	_ = er.storer.Remove(undoAtNonceKey(expiredNonce))
+
+	return nil
+}
+
+func (er *esdtRegistry) getBlockUndo(blockHeaderHash []byte) (*BlockUndo, error) {
+	undoBytes, err := er.storer.Get(undoKey(blockHeaderHash))
+	if err != nil {
+		return nil, err
+	}
+
+	undo := &BlockUndo{}
+	err = er.marshalizer.Unmarshal(undo, undoBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return undo, nil
+}
+
+func (er *esdtRegistry) setLastProcessedHash(blockHeaderHash []byte) error {
+	er.lastProcessedHash = blockHeaderHash
+	if len(blockHeaderHash) == 0 {
+		_ = er.storer.Remove([]byte(lastProcessedHashKey))
+		return nil
+	}
+
+	return er.storer.Put([]byte(lastProcessedHashKey), blockHeaderHash)
+}
+
+func (er *esdtRegistry) processEvent(changes *blockChanges, event data.EventHandler) error {
+	topics := event.GetTopics()
+	if len(topics) < minTopicsForChange {
+		return nil
+	}
+
+	token := computeTokenIdentifier(topics[0], topics[1])
+	value := big.NewInt(0).SetBytes(topics[2])
+	caller := event.GetAddress()
+
+	switch string(event.GetIdentifier()) {
+	case core.BuiltInFunctionESDTTransfer, core.BuiltInFunctionESDTNFTTransfer, core.BuiltInFunctionMultiESDTNFTTransfer:
+		if len(topics) < minTopicsForReceiver {
+			return nil
+		}
+
+		err := er.addToBalanceIfSelfShard(changes, token, caller, big.NewInt(0).Neg(value))
+		if err != nil {
+			return err
+		}
+
+		return er.addToBalanceIfSelfShard(changes, token, topics[receiverTopicIndex], value)
+	case core.BuiltInFunctionESDTLocalMint, core.BuiltInFunctionESDTNFTCreate, core.BuiltInFunctionESDTNFTAddQuantity:
+		err := changes.addMinted(token, value)
+		if err != nil {
+			return err
+		}
+
+		return er.addToBalanceIfSelfShard(changes, token, caller, value)
+	case core.BuiltInFunctionESDTLocalBurn, core.BuiltInFunctionESDTNFTBurn, core.BuiltInFunctionESDTBurn:
+		err := changes.addBurned(token, value)
+		if err != nil {
+			return err
+		}
+
+		return er.addToBalanceIfSelfShard(changes, token, caller, big.NewInt(0).Neg(value))
+	case core.BuiltInFunctionESDTWipe:
+		if len(topics) < minTopicsForReceiver {
+			return nil
+		}
+
+		return changes.wipe(token, topics[receiverTopicIndex])
+	}
+
+	return nil
+}
+
+func (er *esdtRegistry) addToBalanceIfSelfShard(changes *blockChanges, token string, address []byte, value *big.Int) error {
+	if len(address) == 0 || er.shardCoordinator.ComputeId(address) != er.shardCoordinator.SelfId() {
+		return nil
+	}
+
+	return changes.addToBalance(token, address, value)
+}
+
+// GetESDTSupply returns the supply of the provided token held by the accounts of the node's shard
+func (er *esdtRegistry) GetESDTSupply(token string) (*TokenSupply, error) {
+	er.mutex.RLock()
+	defer er.mutex.RUnlock()
+
+	return er.getSupply(token)
+}
+
+// GetESDTHolders returns a page of the holders of the provided token from the node's shard. The holders are not
+// returned in a particular order and the order can change as accounts stop holding the token
+func (er *esdtRegistry) GetESDTHolders(token string, offset uint64, limit uint64) ([]*TokenHolder, error) {
+	if limit == 0 || limit > MaxHoldersPerPage {
+		return nil, fmt.Errorf("%w, maximum is %d", ErrInvalidHoldersPageSize, MaxHoldersPerPage)
+	}
+
+	er.mutex.RLock()
+	defer er.mutex.RUnlock()
+
+	supply, err := er.getSupply(token)
+	if err != nil {
+		return nil, err
+	}
+
+	holders := make([]*TokenHolder, 0)
+	for index := offset; index < supply.NumHolders && index < offset+limit; index++ {
+		address, errGet := er.storer.Get(holderAtKey(token, index))
+		if errGet != nil {
+			return nil, errGet
+		}
+
+		holder, errGet := er.getHolder(token, address)
+		if errGet != nil {
+			return nil, errGet
+		}
+
+		holders = append(holders, &TokenHolder{
+			Address: address,
+			Balance: holder.Balance,
+		})
+	}
+
+	return holders, nil
+}
+
+func (er *esdtRegistry) getSupply(token string) (*TokenSupply, error) {
+	supply := &TokenSupply{
+		Supply: big.NewInt(0),
+		Minted: big.NewInt(0),
+		Burned: big.NewInt(0),
+	}
+
+	supplyBytes, err := er.storer.Get(supplyKey(token))
+	if err != nil {
+		return supply, nil
+	}
+
+	err = er.marshalizer.Unmarshal(supply, supplyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return supply, nil
+}
+
+func (er *esdtRegistry) getHolder(token string, address []byte) (*HolderBalance, error) {
+	holder := &HolderBalance{Balance: big.NewInt(0)}
+
+	holderBytes, err := er.storer.Get(holderKey(token, address))
+	if err != nil {
+		return holder, nil
+	}
+
+	err = er.marshalizer.Unmarshal(holder, holderBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return holder, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (er *esdtRegistry) IsInterfaceNil() bool {
+	return er == nil
+}
+
+func computeTokenIdentifier(tokenID []byte, nonceBytes []byte) string {
+	if len(nonceBytes) == 0 {
+		return string(tokenID)
+	}
+
+	return fmt.Sprintf("%s-%s", tokenID, hex.EncodeToString(nonceBytes))
+}
+
+func sortedLogKeys(logs map[string]data.LogHandler) []string {
+	keys := make([]string, 0, len(logs))
+	for key := range logs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+func supplyKey(token string) []byte {
+	return []byte(supplyKeyPrefix + token)
+}
+
+func holderKey(token string, address []byte) []byte {
+	return append([]byte(holderKeyPrefix+token+"_"), address...)
+}
+
+func holderAtKey(token string, index uint64) []byte {
+	return []byte(fmt.Sprintf("%s%s_%d", holderAtKeyPrefix, token, index))
+}
+
+func undoKey(blockHeaderHash []byte) []byte {
+	return append([]byte(undoKeyPrefix), blockHeaderHash...)
+}
+
+func undoAtNonceKey(nonce uint64) []byte {
+	return []byte(fmt.Sprintf("%s%d", undoAtNonceKeyPrefix, nonce))
+}
@@ -0,0 +1,1463 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: esdtRegistry.proto
+
+package esdtRegistry
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	github_com_ElrondNetwork_elrond_go_core_data "github.com/ElrondNetwork/elrond-go-core/data"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_big "math/big"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type TokenSupply struct {
+	Supply     *math_big.Int `protobuf:"bytes,1,opt,name=Supply,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go-core/data.BigIntCaster" json:"Supply"`
+	Minted     *math_big.Int `protobuf:"bytes,2,opt,name=Minted,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go-core/data.BigIntCaster" json:"Minted"`
+	Burned     *math_big.Int `protobuf:"bytes,3,opt,name=Burned,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go-core/data.BigIntCaster" json:"Burned"`
+	NumHolders uint64        `protobuf:"varint,4,opt,name=NumHolders,proto3" json:"NumHolders"`
+}
+
+func (m *TokenSupply) Reset()      { *m = TokenSupply{} }
+func (*TokenSupply) ProtoMessage() {}
+func (*TokenSupply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b619dace041a27, []int{0}
+}
+func (m *TokenSupply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenSupply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TokenSupply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenSupply.Merge(m, src)
+}
+func (m *TokenSupply) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenSupply) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenSupply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenSupply proto.InternalMessageInfo
+
+func (m *TokenSupply) GetSupply() *math_big.Int {
+	if m != nil {
+		return m.Supply
+	}
+	return nil
+}
+
+func (m *TokenSupply) GetMinted() *math_big.Int {
+	if m != nil {
+		return m.Minted
+	}
+	return nil
+}
+
+func (m *TokenSupply) GetBurned() *math_big.Int {
+	if m != nil {
+		return m.Burned
+	}
+	return nil
+}
+
+func (m *TokenSupply) GetNumHolders() uint64 {
+	if m != nil {
+		return m.NumHolders
+	}
+	return 0
+}
+
+type HolderBalance struct {
+	Balance *math_big.Int `protobuf:"bytes,1,opt,name=Balance,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go-core/data.BigIntCaster" json:"Balance"`
+	Index   uint64        `protobuf:"varint,2,opt,name=Index,proto3" json:"Index"`
+}
+
+func (m *HolderBalance) Reset()      { *m = HolderBalance{} }
+func (*HolderBalance) ProtoMessage() {}
+func (*HolderBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b619dace041a27, []int{1}
+}
+func (m *HolderBalance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HolderBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HolderBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HolderBalance.Merge(m, src)
+}
+func (m *HolderBalance) XXX_Size() int {
+	return m.Size()
+}
+func (m *HolderBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_HolderBalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HolderBalance proto.InternalMessageInfo
+
+func (m *HolderBalance) GetBalance() *math_big.Int {
+	if m != nil {
+		return m.Balance
+	}
+	return nil
+}
+
+func (m *HolderBalance) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+type StorageEntry struct {
+	Key   []byte `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key"`
+	Value []byte `protobuf:"bytes,2,opt,name=Value,proto3" json:"Value"`
+}
+
+func (m *StorageEntry) Reset()      { *m = StorageEntry{} }
+func (*StorageEntry) ProtoMessage() {}
+func (*StorageEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b619dace041a27, []int{2}
+}
+func (m *StorageEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StorageEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *StorageEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageEntry.Merge(m, src)
+}
+func (m *StorageEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *StorageEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageEntry proto.InternalMessageInfo
+
+func (m *StorageEntry) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *StorageEntry) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+type BlockUndo struct {
+	PrevHash []byte          `protobuf:"bytes,1,opt,name=PrevHash,proto3" json:"PrevHash"`
+	Nonce    uint64          `protobuf:"varint,2,opt,name=Nonce,proto3" json:"Nonce"`
+	Entries  []*StorageEntry `protobuf:"bytes,3,rep,name=Entries,proto3" json:"Entries"`
+}
+
+func (m *BlockUndo) Reset()      { *m = BlockUndo{} }
+func (*BlockUndo) ProtoMessage() {}
+func (*BlockUndo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b619dace041a27, []int{3}
+}
+func (m *BlockUndo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockUndo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *BlockUndo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockUndo.Merge(m, src)
+}
+func (m *BlockUndo) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockUndo) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockUndo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockUndo proto.InternalMessageInfo
+
+func (m *BlockUndo) GetPrevHash() []byte {
+	if m != nil {
+		return m.PrevHash
+	}
+	return nil
+}
+
+func (m *BlockUndo) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *BlockUndo) GetEntries() []*StorageEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*TokenSupply)(nil), "proto.TokenSupply")
+	proto.RegisterType((*HolderBalance)(nil), "proto.HolderBalance")
+	proto.RegisterType((*StorageEntry)(nil), "proto.StorageEntry")
+	proto.RegisterType((*BlockUndo)(nil), "proto.BlockUndo")
+}
+
+func init() { proto.RegisterFile("esdtRegistry.proto", fileDescriptor_e0b619dace041a27) }
+
+var fileDescriptor_e0b619dace041a27 = []byte{
+	// 473 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xb1, 0x8e, 0xd3, 0x30,
+	0x18, 0xc7, 0x63, 0xd2, 0x6b, 0x39, 0xb7, 0x30, 0x84, 0x25, 0x30, 0x38, 0x55, 0xa7, 0x2e, 0x4d,
+	0x24, 0xd8, 0x60, 0x0b, 0xba, 0xd3, 0x15, 0x44, 0x85, 0x7c, 0x77, 0x0c, 0x6c, 0x6e, 0x62, 0xd2,
+	0xa8, 0xa9, 0x5d, 0x39, 0x0e, 0xd0, 0x8d, 0x8d, 0x15, 0xf1, 0x06, 0x6c, 0x88, 0x27, 0x61, 0xec,
+	0xd8, 0x29, 0xd0, 0x74, 0x41, 0x99, 0xee, 0x11, 0x50, 0xec, 0xb4, 0xcd, 0x03, 0x74, 0xc9, 0xf7,
+	0xff, 0x7e, 0x4a, 0xfe, 0xff, 0x4f, 0xf9, 0x6c, 0x68, 0xd1, 0x34, 0x94, 0x98, 0x46, 0x71, 0x2a,
+	0xc5, 0xca, 0x5d, 0x0a, 0x2e, 0xb9, 0x75, 0xa6, 0xca, 0x93, 0x51, 0x14, 0xcb, 0x59, 0x36, 0x75,
+	0x03, 0xbe, 0xf0, 0x22, 0x1e, 0x71, 0x4f, 0xe1, 0x69, 0xf6, 0x41, 0x75, 0xaa, 0x51, 0x4a, 0x7f,
+	0x35, 0xf8, 0x6a, 0xc2, 0xee, 0x0d, 0x9f, 0x53, 0x76, 0x9d, 0x2d, 0x97, 0xc9, 0xca, 0x4a, 0x60,
+	0x5b, 0x2b, 0x1b, 0xf4, 0xc1, 0xb0, 0xe7, 0xdf, 0x94, 0xb9, 0x53, 0x93, 0x5f, 0x7f, 0x9c, 0xcb,
+	0x05, 0x91, 0x33, 0x6f, 0x1a, 0x47, 0xee, 0x98, 0xc9, 0x17, 0x8d, 0xa4, 0x8b, 0x44, 0x70, 0x16,
+	0x4e, 0xa8, 0xfc, 0xc4, 0xc5, 0xdc, 0xa3, 0xaa, 0x1b, 0x45, 0x7c, 0x14, 0x70, 0x41, 0xbd, 0x90,
+	0x48, 0xe2, 0xfa, 0x71, 0x34, 0x66, 0xf2, 0x25, 0x49, 0x25, 0x15, 0xb8, 0x7d, 0x4c, 0x7b, 0x13,
+	0x33, 0x49, 0x43, 0xfb, 0xde, 0x31, 0x4d, 0x93, 0x53, 0xa6, 0x69, 0xc7, 0x2a, 0xcd, 0xcf, 0x04,
+	0xa3, 0xa1, 0x6d, 0x1e, 0xd3, 0x34, 0x39, 0x65, 0x9a, 0x76, 0xb4, 0x5c, 0x08, 0x27, 0xd9, 0xe2,
+	0x8a, 0x27, 0x21, 0x15, 0xa9, 0xdd, 0xea, 0x83, 0x61, 0xcb, 0x7f, 0x58, 0xe6, 0x4e, 0x83, 0xe2,
+	0x86, 0x1e, 0xfc, 0x00, 0xf0, 0x81, 0xd6, 0x3e, 0x49, 0x08, 0x0b, 0xa8, 0xc5, 0x61, 0xa7, 0x96,
+	0xf5, 0x32, 0x6e, 0xcb, 0xdc, 0xd9, 0xa3, 0x13, 0x4e, 0xbc, 0xb7, 0xb4, 0x1c, 0x78, 0x36, 0x66,
+	0x21, 0xfd, 0xac, 0xb6, 0xd1, 0xf2, 0xcf, 0xcb, 0xdc, 0xd1, 0x00, 0xeb, 0x32, 0x78, 0x05, 0x7b,
+	0xd7, 0x92, 0x0b, 0x12, 0xd1, 0x0b, 0x26, 0xc5, 0xca, 0x7a, 0x0c, 0xcd, 0xd7, 0x74, 0x7f, 0x54,
+	0x3a, 0x65, 0xee, 0x54, 0x2d, 0xae, 0x1e, 0x95, 0xd7, 0x3b, 0x92, 0x64, 0xb4, 0xde, 0xac, 0xf2,
+	0x52, 0x00, 0xeb, 0x32, 0xf8, 0x0e, 0xe0, 0xb9, 0x9f, 0xf0, 0x60, 0x7e, 0xcb, 0x42, 0x6e, 0x0d,
+	0xe1, 0xfd, 0xb7, 0x82, 0x7e, 0xbc, 0x22, 0xe9, 0xac, 0xb6, 0xeb, 0x95, 0xb9, 0x73, 0x60, 0xf8,
+	0xa0, 0x2a, 0xe3, 0x09, 0x67, 0x81, 0x36, 0xae, 0x87, 0x54, 0x00, 0xeb, 0x62, 0x3d, 0x87, 0x9d,
+	0x6a, 0xba, 0x98, 0xa6, 0xb6, 0xd9, 0x37, 0x87, 0xdd, 0xa7, 0x8f, 0xf4, 0x59, 0x77, 0x9b, 0xa3,
+	0xfb, 0xdd, 0xea, 0x5f, 0xd6, 0xef, 0xe1, 0xbd, 0xf0, 0x2f, 0xd7, 0x5b, 0x64, 0x6c, 0xb6, 0xc8,
+	0xb8, 0xdb, 0x22, 0xf0, 0xa5, 0x40, 0xe0, 0x67, 0x81, 0xc0, 0xef, 0x02, 0x81, 0x75, 0x81, 0xc0,
+	0xa6, 0x40, 0xe0, 0x6f, 0x81, 0xc0, 0xbf, 0x02, 0x19, 0x77, 0x05, 0x02, 0xdf, 0x76, 0xc8, 0x58,
+	0xef, 0x90, 0xb1, 0xd9, 0x21, 0xe3, 0x7d, 0xaf, 0x79, 0x25, 0xa7, 0x6d, 0x95, 0xf8, 0xec, 0xff,
+	0x00, 0xf0, 0xeb, 0xf1, 0x68, 0xa9, 0x03, 0x00, 0x00,
+}
+
+func (this *TokenSupply) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TokenSupply)
+	if !ok {
+		that2, ok := that.(TokenSupply)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_core_data.BigIntCaster{}
+		if !__caster.Equal(this.Supply, that1.Supply) {
+			return false
+		}
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_core_data.BigIntCaster{}
+		if !__caster.Equal(this.Minted, that1.Minted) {
+			return false
+		}
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_core_data.BigIntCaster{}
+		if !__caster.Equal(this.Burned, that1.Burned) {
+			return false
+		}
+	}
+	if this.NumHolders != that1.NumHolders {
+		return false
+	}
+	return true
+}
+func (this *HolderBalance) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HolderBalance)
+	if !ok {
+		that2, ok := that.(HolderBalance)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_core_data.BigIntCaster{}
+		if !__caster.Equal(this.Balance, that1.Balance) {
+			return false
+		}
+	}
+	if this.Index != that1.Index {
+		return false
+	}
+	return true
+}
+func (this *StorageEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StorageEntry)
+	if !ok {
+		that2, ok := that.(StorageEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Key, that1.Key) {
+		return false
+	}
+	if !bytes.Equal(this.Value, that1.Value) {
+		return false
+	}
+	return true
+}
+func (this *BlockUndo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BlockUndo)
+	if !ok {
+		that2, ok := that.(BlockUndo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.PrevHash, that1.PrevHash) {
+		return false
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
+	if len(this.Entries) != len(that1.Entries) {
+		return false
+	}
+	for i := range this.Entries {
+		if !this.Entries[i].Equal(that1.Entries[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *TokenSupply) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&esdtRegistry.TokenSupply{")
+	s = append(s, "Supply: "+fmt.Sprintf("%#v", this.Supply)+",\n")
+	s = append(s, "Minted: "+fmt.Sprintf("%#v", this.Minted)+",\n")
+	s = append(s, "Burned: "+fmt.Sprintf("%#v", this.Burned)+",\n")
+	s = append(s, "NumHolders: "+fmt.Sprintf("%#v", this.NumHolders)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *HolderBalance) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&esdtRegistry.HolderBalance{")
+	s = append(s, "Balance: "+fmt.Sprintf("%#v", this.Balance)+",\n")
+	s = append(s, "Index: "+fmt.Sprintf("%#v", this.Index)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *StorageEntry) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&esdtRegistry.StorageEntry{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *BlockUndo) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&esdtRegistry.BlockUndo{")
+	s = append(s, "PrevHash: "+fmt.Sprintf("%#v", this.PrevHash)+",\n")
+	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
+	if this.Entries != nil {
+		s = append(s, "Entries: "+fmt.Sprintf("%#v", this.Entries)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringEsdtRegistry(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *TokenSupply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenSupply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenSupply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumHolders != 0 {
+		i = encodeVarintEsdtRegistry(dAtA, i, uint64(m.NumHolders))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_core_data.BigIntCaster{}
+		size := __caster.Size(m.Burned)
+		i -= size
+		if _, err := __caster.MarshalTo(m.Burned, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEsdtRegistry(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_core_data.BigIntCaster{}
+		size := __caster.Size(m.Minted)
+		i -= size
+		if _, err := __caster.MarshalTo(m.Minted, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEsdtRegistry(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_core_data.BigIntCaster{}
+		size := __caster.Size(m.Supply)
+		i -= size
+		if _, err := __caster.MarshalTo(m.Supply, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEsdtRegistry(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *HolderBalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HolderBalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HolderBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Index != 0 {
+		i = encodeVarintEsdtRegistry(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_core_data.BigIntCaster{}
+		size := __caster.Size(m.Balance)
+		i -= size
+		if _, err := __caster.MarshalTo(m.Balance, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEsdtRegistry(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *StorageEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StorageEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StorageEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintEsdtRegistry(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintEsdtRegistry(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockUndo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockUndo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockUndo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEsdtRegistry(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintEsdtRegistry(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PrevHash) > 0 {
+		i -= len(m.PrevHash)
+		copy(dAtA[i:], m.PrevHash)
+		i = encodeVarintEsdtRegistry(dAtA, i, uint64(len(m.PrevHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEsdtRegistry(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEsdtRegistry(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TokenSupply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_core_data.BigIntCaster{}
+		l = __caster.Size(m.Supply)
+		n += 1 + l + sovEsdtRegistry(uint64(l))
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_core_data.BigIntCaster{}
+		l = __caster.Size(m.Minted)
+		n += 1 + l + sovEsdtRegistry(uint64(l))
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_core_data.BigIntCaster{}
+		l = __caster.Size(m.Burned)
+		n += 1 + l + sovEsdtRegistry(uint64(l))
+	}
+	if m.NumHolders != 0 {
+		n += 1 + sovEsdtRegistry(uint64(m.NumHolders))
+	}
+	return n
+}
+
+func (m *HolderBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_core_data.BigIntCaster{}
+		l = __caster.Size(m.Balance)
+		n += 1 + l + sovEsdtRegistry(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovEsdtRegistry(uint64(m.Index))
+	}
+	return n
+}
+
+func (m *StorageEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovEsdtRegistry(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovEsdtRegistry(uint64(l))
+	}
+	return n
+}
+
+func (m *BlockUndo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PrevHash)
+	if l > 0 {
+		n += 1 + l + sovEsdtRegistry(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovEsdtRegistry(uint64(m.Nonce))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovEsdtRegistry(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovEsdtRegistry(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEsdtRegistry(x uint64) (n int) {
+	return sovEsdtRegistry(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *TokenSupply) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TokenSupply{`,
+		`Supply:` + fmt.Sprintf("%v", this.Supply) + `,`,
+		`Minted:` + fmt.Sprintf("%v", this.Minted) + `,`,
+		`Burned:` + fmt.Sprintf("%v", this.Burned) + `,`,
+		`NumHolders:` + fmt.Sprintf("%v", this.NumHolders) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HolderBalance) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HolderBalance{`,
+		`Balance:` + fmt.Sprintf("%v", this.Balance) + `,`,
+		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StorageEntry) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StorageEntry{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BlockUndo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForEntries := "[]*StorageEntry{"
+	for _, f := range this.Entries {
+		repeatedStringForEntries += strings.Replace(f.String(), "StorageEntry", "StorageEntry", 1) + ","
+	}
+	repeatedStringForEntries += "}"
+	s := strings.Join([]string{`&BlockUndo{`,
+		`PrevHash:` + fmt.Sprintf("%v", this.PrevHash) + `,`,
+		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
+		`Entries:` + repeatedStringForEntries + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringEsdtRegistry(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *TokenSupply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEsdtRegistry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenSupply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenSupply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEsdtRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEsdtRegistry
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEsdtRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_core_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.Supply = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEsdtRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEsdtRegistry
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEsdtRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_core_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.Minted = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEsdtRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEsdtRegistry
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEsdtRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_core_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.Burned = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumHolders", wireType)
+			}
+			m.NumHolders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEsdtRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumHolders |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEsdtRegistry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEsdtRegistry
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEsdtRegistry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HolderBalance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEsdtRegistry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HolderBalance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HolderBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEsdtRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEsdtRegistry
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEsdtRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_core_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.Balance = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEsdtRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEsdtRegistry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEsdtRegistry
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEsdtRegistry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StorageEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEsdtRegistry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StorageEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StorageEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEsdtRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEsdtRegistry
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEsdtRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEsdtRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEsdtRegistry
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEsdtRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEsdtRegistry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEsdtRegistry
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEsdtRegistry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockUndo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEsdtRegistry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockUndo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockUndo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEsdtRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEsdtRegistry
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEsdtRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrevHash = append(m.PrevHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.PrevHash == nil {
+				m.PrevHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEsdtRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEsdtRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEsdtRegistry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEsdtRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, &StorageEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEsdtRegistry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEsdtRegistry
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEsdtRegistry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEsdtRegistry(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEsdtRegistry
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEsdtRegistry
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEsdtRegistry
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEsdtRegistry
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEsdtRegistry
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEsdtRegistry
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEsdtRegistry        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEsdtRegistry          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEsdtRegistry = fmt.Errorf("proto: unexpected end of group")
+)
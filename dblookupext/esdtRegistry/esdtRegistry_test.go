@@ -0,0 +1,302 @@
+package esdtRegistry_test
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dblookupext/esdtRegistry"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericMocks"
+	"github.com/stretchr/testify/require"
+)
+
+const testToken = "TKN-abcdef"
+
+var (
+	alice = []byte("alice")
+	bob   = []byte("bob")
+	carol = []byte("carol")
+	other = []byte("other")
+)
+
+func createMockArgsESDTRegistry() esdtRegistry.ArgsESDTRegistry {
+	shardCoordinator := testscommon.NewMultiShardsCoordinatorMock(2)
+	shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
+		if string(address) == string(other) {
+			return 1
+		}
+		return 0
+	}
+
+	return esdtRegistry.ArgsESDTRegistry{
+		Storer:           genericMocks.NewStorerMock("ESDTRegistry", 0),
+		Marshalizer:      &testscommon.ProtoMarshalizerMock{},
+		ShardCoordinator: shardCoordinator,
+	}
+}
+
+func createEvent(identifier string, address []byte, value int64, receiver []byte) *transaction.Event {
+	topics := [][]byte{[]byte(testToken), nil, big.NewInt(value).Bytes()}
+	if len(receiver) > 0 {
+		topics = append(topics, receiver)
+	}
+
+	return &transaction.Event{
+		Address:    address,
+		Identifier: []byte(identifier),
+		Topics:     topics,
+	}
+}
+
+func createHeader(nonce uint64, prevHash string) *block.Header {
+	return &block.Header{
+		Nonce:    nonce,
+		PrevHash: []byte(prevHash),
+	}
+}
+
+func createLogs(events ...*transaction.Event) map[string]data.LogHandler {
+	return map[string]data.LogHandler{
+		"txHash": &transaction.Log{Events: events},
+	}
+}
+
+func holdersToMap(holders []*esdtRegistry.TokenHolder) map[string]int64 {
+	balances := make(map[string]int64)
+	for _, holder := range holders {
+		balances[string(holder.Address)] = holder.Balance.Int64()
+	}
+
+	return balances
+}
+
+func TestNewESDTRegistry(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsESDTRegistry()
+	args.Storer = nil
+	registry, err := esdtRegistry.NewESDTRegistry(args)
+	require.Nil(t, registry)
+	require.Equal(t, core.ErrNilStore, err)
+
+	args = createMockArgsESDTRegistry()
+	args.Marshalizer = nil
+	registry, err = esdtRegistry.NewESDTRegistry(args)
+	require.Nil(t, registry)
+	require.Equal(t, core.ErrNilMarshalizer, err)
+
+	args = createMockArgsESDTRegistry()
+	args.ShardCoordinator = nil
+	registry, err = esdtRegistry.NewESDTRegistry(args)
+	require.Nil(t, registry)
+	require.Equal(t, esdtRegistry.ErrNilShardCoordinator, err)
+
+	args = createMockArgsESDTRegistry()
+	registry, err = esdtRegistry.NewESDTRegistry(args)
+	require.Nil(t, err)
+	require.False(t, check.IfNil(registry))
+}
+
+func TestESDTRegistry_ProcessLogsShouldTrackSupplyAndHolders(t *testing.T) {
+	t.Parallel()
+
+	registry, _ := esdtRegistry.NewESDTRegistry(createMockArgsESDTRegistry())
+
+	err := registry.ProcessLogs([]byte("hash1"), createHeader(1, ""), createLogs(
+		createEvent(core.BuiltInFunctionESDTLocalMint, alice, 100, nil),
+		createEvent(core.BuiltInFunctionESDTTransfer, alice, 30, bob),
+		createEvent(core.BuiltInFunctionESDTTransfer, alice, 10, other),
+	))
+	require.Nil(t, err)
+
+	supply, err := registry.GetESDTSupply(testToken)
+	require.Nil(t, err)
+	// the tokens sent cross-shard are no longer held in the node's shard
+	require.Equal(t, big.NewInt(90), supply.Supply)
+	require.Equal(t, big.NewInt(100), supply.Minted)
+	require.Equal(t, big.NewInt(0), supply.Burned)
+	require.Equal(t, uint64(2), supply.NumHolders)
+
+	holders, err := registry.GetESDTHolders(testToken, 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, map[string]int64{"alice": 60, "bob": 30}, holdersToMap(holders))
+
+	err = registry.ProcessLogs([]byte("hash2"), createHeader(2, "hash1"), createLogs(
+		createEvent(core.BuiltInFunctionESDTTransfer, bob, 30, carol),
+		createEvent(core.BuiltInFunctionESDTLocalBurn, alice, 20, nil),
+	))
+	require.Nil(t, err)
+
+	supply, _ = registry.GetESDTSupply(testToken)
+	require.Equal(t, big.NewInt(70), supply.Supply)
+	require.Equal(t, big.NewInt(20), supply.Burned)
+	require.Equal(t, uint64(2), supply.NumHolders)
+
+	holders, _ = registry.GetESDTHolders(testToken, 0, 10)
+	require.Equal(t, map[string]int64{"alice": 40, "carol": 30}, holdersToMap(holders))
+}
+
+func TestESDTRegistry_ProcessLogsNilHeaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	registry, _ := esdtRegistry.NewESDTRegistry(createMockArgsESDTRegistry())
+
+	err := registry.ProcessLogs([]byte("hash1"), nil, createLogs())
+	require.Equal(t, esdtRegistry.ErrNilBlockHeader, err)
+}
+
+func TestESDTRegistry_ProcessLogsWipeShouldRemoveHolder(t *testing.T) {
+	t.Parallel()
+
+	registry, _ := esdtRegistry.NewESDTRegistry(createMockArgsESDTRegistry())
+
+	_ = registry.ProcessLogs([]byte("hash1"), createHeader(1, ""), createLogs(
+		createEvent(core.BuiltInFunctionESDTLocalMint, alice, 100, nil),
+		createEvent(core.BuiltInFunctionESDTTransfer, alice, 40, bob),
+	))
+	err := registry.ProcessLogs([]byte("hash2"), createHeader(2, "hash1"), createLogs(
+		createEvent(core.BuiltInFunctionESDTWipe, []byte("issuer"), 40, bob),
+	))
+	require.Nil(t, err)
+
+	supply, _ := registry.GetESDTSupply(testToken)
+	require.Equal(t, big.NewInt(60), supply.Supply)
+	require.Equal(t, big.NewInt(40), supply.Burned)
+	require.Equal(t, uint64(1), supply.NumHolders)
+
+	holders, _ := registry.GetESDTHolders(testToken, 0, 10)
+	require.Equal(t, map[string]int64{"alice": 60}, holdersToMap(holders))
+}
+
+func TestESDTRegistry_ProcessLogsShouldIgnoreAlreadyProcessedBlocks(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsESDTRegistry()
+	registry, _ := esdtRegistry.NewESDTRegistry(args)
+
+	logs := createLogs(createEvent(core.BuiltInFunctionESDTLocalMint, alice, 100, nil))
+	_ = registry.ProcessLogs([]byte("hash5"), createHeader(5, "hash4"), logs)
+	err := registry.ProcessLogs([]byte("hash5"), createHeader(5, "hash4"), logs)
+	require.Nil(t, err)
+
+	supply, _ := registry.GetESDTSupply(testToken)
+	require.Equal(t, big.NewInt(100), supply.Supply)
+
+	// the last processed block is loaded from the storage after a restart
+	reloadedRegistry, _ := esdtRegistry.NewESDTRegistry(args)
+	_ = reloadedRegistry.ProcessLogs([]byte("hash5"), createHeader(5, "hash4"), logs)
+
+	supply, _ = reloadedRegistry.GetESDTSupply(testToken)
+	require.Equal(t, big.NewInt(100), supply.Supply)
+}
+
+func TestESDTRegistry_ProcessLogsShouldRevertBlocksReplacedByFork(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsESDTRegistry()
+	registry, _ := esdtRegistry.NewESDTRegistry(args)
+
+	_ = registry.ProcessLogs([]byte("hash1"), createHeader(1, ""), createLogs(
+		createEvent(core.BuiltInFunctionESDTLocalMint, alice, 100, nil),
+	))
+	_ = registry.ProcessLogs([]byte("hash2"), createHeader(2, "hash1"), createLogs(
+		createEvent(core.BuiltInFunctionESDTTransfer, alice, 40, bob),
+	))
+	_ = registry.ProcessLogs([]byte("hash3"), createHeader(3, "hash2"), createLogs(
+		createEvent(core.BuiltInFunctionESDTTransfer, bob, 10, carol),
+	))
+
+	// the blocks with the nonces 2 and 3 are replaced by a fork, after a restart
+	reloadedRegistry, _ := esdtRegistry.NewESDTRegistry(args)
+	err := reloadedRegistry.ProcessLogs([]byte("forkHash2"), createHeader(2, "hash1"), createLogs(
+		createEvent(core.BuiltInFunctionESDTLocalBurn, alice, 10, nil),
+	))
+	require.Nil(t, err)
+
+	supply, _ := reloadedRegistry.GetESDTSupply(testToken)
+	require.Equal(t, big.NewInt(90), supply.Supply)
+	require.Equal(t, big.NewInt(100), supply.Minted)
+	require.Equal(t, big.NewInt(10), supply.Burned)
+	require.Equal(t, uint64(1), supply.NumHolders)
+
+	holders, _ := reloadedRegistry.GetESDTHolders(testToken, 0, 10)
+	require.Equal(t, map[string]int64{"alice": 90}, holdersToMap(holders))
+
+	_, err = args.Storer.Get([]byte("undo_hash3"))
+	require.NotNil(t, err)
+
+	err = reloadedRegistry.ProcessLogs([]byte("forkHash3"), createHeader(3, "forkHash2"), createLogs(
+		createEvent(core.BuiltInFunctionESDTTransfer, alice, 30, carol),
+	))
+	require.Nil(t, err)
+
+	holders, _ = reloadedRegistry.GetESDTHolders(testToken, 0, 10)
+	require.Equal(t, map[string]int64{"alice": 60, "carol": 30}, holdersToMap(holders))
+}
+
+func TestESDTRegistry_ProcessLogsShouldRemoveExpiredUndoData(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsESDTRegistry()
+	registry, _ := esdtRegistry.NewESDTRegistry(args)
+
+	prevHash := ""
+	for nonce := uint64(1); nonce <= 101; nonce++ {
+		hash := fmt.Sprintf("hash%d", nonce)
+		err := registry.ProcessLogs([]byte(hash), createHeader(nonce, prevHash), createLogs(
+			createEvent(core.BuiltInFunctionESDTLocalMint, alice, 1, nil),
+		))
+		require.Nil(t, err)
+		prevHash = hash
+	}
+
+	_, err := args.Storer.Get([]byte("undo_hash1"))
+	require.NotNil(t, err)
+	_, err = args.Storer.Get([]byte("undo_hash2"))
+	require.Nil(t, err)
+
+	supply, _ := registry.GetESDTSupply(testToken)
+	require.Equal(t, big.NewInt(101), supply.Supply)
+}
+
+func TestESDTRegistry_GetESDTHolders(t *testing.T) {
+	t.Parallel()
+
+	registry, _ := esdtRegistry.NewESDTRegistry(createMockArgsESDTRegistry())
+
+	holders, err := registry.GetESDTHolders(testToken, 0, 0)
+	require.Nil(t, holders)
+	require.True(t, errors.Is(err, esdtRegistry.ErrInvalidHoldersPageSize))
+
+	holders, err = registry.GetESDTHolders(testToken, 0, esdtRegistry.MaxHoldersPerPage+1)
+	require.Nil(t, holders)
+	require.True(t, errors.Is(err, esdtRegistry.ErrInvalidHoldersPageSize))
+
+	_ = registry.ProcessLogs([]byte("hash1"), createHeader(1, ""), createLogs(
+		createEvent(core.BuiltInFunctionESDTLocalMint, alice, 100, nil),
+		createEvent(core.BuiltInFunctionESDTTransfer, alice, 10, bob),
+		createEvent(core.BuiltInFunctionESDTTransfer, alice, 10, carol),
+	))
+
+	firstPage, err := registry.GetESDTHolders(testToken, 0, 2)
+	require.Nil(t, err)
+	require.Equal(t, 2, len(firstPage))
+
+	secondPage, err := registry.GetESDTHolders(testToken, 2, 2)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(secondPage))
+
+	allHolders := holdersToMap(append(firstPage, secondPage...))
+	require.Equal(t, map[string]int64{"alice": 80, "bob": 10, "carol": 10}, allHolders)
+
+	holders, err = registry.GetESDTHolders("MISSING-123456", 0, 10)
+	require.Nil(t, err)
+	require.Empty(t, holders)
+}
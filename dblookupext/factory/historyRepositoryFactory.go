@@ -8,16 +8,20 @@ import (
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/dblookupext/esdtRegistry"
+	"github.com/ElrondNetwork/elrond-go/dblookupext/esdtRegistry/disabled"
+	"github.com/ElrondNetwork/elrond-go/sharding"
 )
 
 // ArgsHistoryRepositoryFactory holds all dependencies required by the history processor factory in order to create
 // new instances
 type ArgsHistoryRepositoryFactory struct {
-	SelfShardID uint32
-	Config      config.DbLookupExtensionsConfig
-	Store       dataRetriever.StorageService
-	Marshalizer marshal.Marshalizer
-	Hasher      hashing.Hasher
+	SelfShardID      uint32
+	Config           config.DbLookupExtensionsConfig
+	Store            dataRetriever.StorageService
+	Marshalizer      marshal.Marshalizer
+	Hasher           hashing.Hasher
+	ShardCoordinator sharding.Coordinator
 }
 
 type historyRepositoryFactory struct {
@@ -26,6 +30,7 @@ type historyRepositoryFactory struct {
 	store                    dataRetriever.StorageService
 	marshalizer              marshal.Marshalizer
 	hasher                   hashing.Hasher
+	shardCoordinator         sharding.Coordinator
 }
 
 // NewHistoryRepositoryFactory creates an instance of historyRepositoryFactory
@@ -39,6 +44,9 @@ func NewHistoryRepositoryFactory(args *ArgsHistoryRepositoryFactory) (dblookupex
 	if check.IfNil(args.Store) {
 		return nil, core.ErrNilStore
 	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, esdtRegistry.ErrNilShardCoordinator
+	}
 
 	return &historyRepositoryFactory{
 		selfShardID:              args.SelfShardID,
@@ -46,6 +54,7 @@ func NewHistoryRepositoryFactory(args *ArgsHistoryRepositoryFactory) (dblookupex
 		store:                    args.Store,
 		marshalizer:              args.Marshalizer,
 		hasher:                   args.Hasher,
+		shardCoordinator:         args.ShardCoordinator,
 	}, nil
 }
 
@@ -55,6 +64,11 @@ func (hpf *historyRepositoryFactory) Create() (dblookupext.HistoryRepository, er
 		return dblookupext.NewNilHistoryRepository()
 	}
 
+	esdtRegistryHandler, err := hpf.createESDTRegistry()
+	if err != nil {
+		return nil, err
+	}
+
 	historyRepArgs := dblookupext.HistoryRepositoryArguments{
 		SelfShardID:                 hpf.selfShardID,
 		Hasher:                      hpf.hasher,
@@ -63,10 +77,24 @@ func (hpf *historyRepositoryFactory) Create() (dblookupext.HistoryRepository, er
 		EpochByHashStorer:           hpf.store.GetStorer(dataRetriever.EpochByHashUnit),
 		MiniblockHashByTxHashStorer: hpf.store.GetStorer(dataRetriever.MiniblockHashByTxHashUnit),
 		EventsHashesByTxHashStorer:  hpf.store.GetStorer(dataRetriever.ResultsHashesByTxHashUnit),
+		ESDTRegistry:                esdtRegistryHandler,
 	}
 	return dblookupext.NewHistoryRepository(historyRepArgs)
 }
 
+func (hpf *historyRepositoryFactory) createESDTRegistry() (dblookupext.ESDTRegistryHandler, error) {
+	if !hpf.dbLookupExtensionsConfig.ESDTRegistryEnabled {
+		return disabled.NewDisabledESDTRegistry(), nil
+	}
+
+	argsESDTRegistry := esdtRegistry.ArgsESDTRegistry{
+		Storer:           hpf.store.GetStorer(dataRetriever.ESDTRegistryUnit),
+		Marshalizer:      hpf.marshalizer,
+		ShardCoordinator: hpf.shardCoordinator,
+	}
+	return esdtRegistry.NewESDTRegistry(argsESDTRegistry)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (hpf *historyRepositoryFactory) IsInterfaceNil() bool {
 	return hpf == nil
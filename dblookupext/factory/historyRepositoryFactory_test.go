@@ -8,6 +8,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/common/mock"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dblookupext/esdtRegistry"
 	"github.com/ElrondNetwork/elrond-go/dblookupext/factory"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
@@ -35,6 +36,12 @@ func TestNewHistoryRepositoryFactory(t *testing.T) {
 	require.Equal(t, core.ErrNilHasher, err)
 	require.Nil(t, hrf)
 
+	argsNilShardCoordinator := getArgs()
+	argsNilShardCoordinator.ShardCoordinator = nil
+	hrf, err = factory.NewHistoryRepositoryFactory(argsNilShardCoordinator)
+	require.Equal(t, esdtRegistry.ErrNilShardCoordinator, err)
+	require.Nil(t, hrf)
+
 	hrf, err = factory.NewHistoryRepositoryFactory(args)
 	require.NoError(t, err)
 	require.False(t, check.IfNil(hrf))
@@ -66,12 +73,33 @@ func TestHistoryRepositoryFactory_CreateShouldCreateRegularRepository(t *testing
 	require.True(t, repository.IsEnabled())
 }
 
+func TestHistoryRepositoryFactory_CreateWithESDTRegistryShouldWork(t *testing.T) {
+	args := getArgs()
+	args.Config.Enabled = true
+	args.Config.ESDTRegistryEnabled = true
+	requestedUnits := make(map[dataRetriever.UnitType]struct{})
+	args.Store = &mock.ChainStorerMock{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+			requestedUnits[unitType] = struct{}{}
+			return &testscommon.StorerStub{}
+		},
+	}
+
+	hrf, _ := factory.NewHistoryRepositoryFactory(args)
+
+	repository, err := hrf.Create()
+	require.NoError(t, err)
+	require.True(t, repository.IsEnabled())
+	require.Contains(t, requestedUnits, dataRetriever.ESDTRegistryUnit)
+}
+
 func getArgs() *factory.ArgsHistoryRepositoryFactory {
 	return &factory.ArgsHistoryRepositoryFactory{
-		SelfShardID: 0,
-		Config:      config.DbLookupExtensionsConfig{},
-		Store:       &mock.ChainStorerMock{},
-		Marshalizer: &mock.MarshalizerMock{},
-		Hasher:      &mock.HasherMock{},
+		SelfShardID:      0,
+		Config:           config.DbLookupExtensionsConfig{},
+		Store:            &mock.ChainStorerMock{},
+		Marshalizer:      &mock.MarshalizerMock{},
+		Hasher:           &mock.HasherMock{},
+		ShardCoordinator: testscommon.NewMultiShardsCoordinatorMock(2),
 	}
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/hashing"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/dblookupext/esdtRegistry"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
 )
@@ -29,6 +30,7 @@ type HistoryRepositoryArguments struct {
 	MiniblockHashByTxHashStorer storage.Storer
 	EpochByHashStorer           storage.Storer
 	EventsHashesByTxHashStorer  storage.Storer
+	ESDTRegistry                ESDTRegistryHandler
 	Marshalizer                 marshal.Marshalizer
 	Hasher                      hashing.Hasher
 }
@@ -39,6 +41,7 @@ type historyRepository struct {
 	miniblockHashByTxHashIndex storage.Storer
 	epochByHashIndex           *epochByHashIndex
 	eventsHashesByTxHashIndex  *eventsHashesByTxHash
+	esdtRegistry               ESDTRegistryHandler
 	marshalizer                marshal.Marshalizer
 	hasher                     hashing.Hasher
 
@@ -81,6 +84,9 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 	if check.IfNil(arguments.EventsHashesByTxHashStorer) {
 		return nil, core.ErrNilStore
 	}
+	if check.IfNil(arguments.ESDTRegistry) {
+		return nil, ErrNilESDTRegistry
+	}
 
 	hashToEpochIndex := newHashToEpochIndex(arguments.EpochByHashStorer, arguments.Marshalizer)
 	deduplicationCacheForInsertMiniblockMetadata, _ := lrucache.NewCache(sizeOfDeduplicationCache)
@@ -99,6 +105,7 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 		pendingNotarizedAtBothNotifications:          container.NewMutexMap(),
 		deduplicationCacheForInsertMiniblockMetadata: deduplicationCacheForInsertMiniblockMetadata,
 		eventsHashesByTxHashIndex:                    eventsHashesToTxHashIndex,
+		esdtRegistry:                                 arguments.ESDTRegistry,
 	}, nil
 }
 
@@ -110,6 +117,7 @@ func (hr *historyRepository) RecordBlock(
 	blockBody data.BodyHandler,
 	scrResultsFromPool map[string]data.TransactionHandler,
 	receiptsFromPool map[string]data.TransactionHandler,
+	logs map[string]data.LogHandler,
 ) error {
 	hr.recordBlockMutex.Lock()
 	defer hr.recordBlockMutex.Unlock()
@@ -144,7 +152,7 @@ func (hr *historyRepository) RecordBlock(
 		return err
 	}
 
-	return nil
+	return hr.esdtRegistry.ProcessLogs(blockHeaderHash, blockHeader, logs)
 }
 
 func (hr *historyRepository) recordMiniblock(blockHeaderHash []byte, blockHeader data.HeaderHandler, miniblock *block.MiniBlock, epoch uint32) error {
@@ -264,6 +272,16 @@ func (hr *historyRepository) GetEpochByHash(hash []byte) (uint32, error) {
 	return hr.epochByHashIndex.getEpochByHash(hash)
 }
 
+// GetESDTSupply will return the supply of the provided token held by the accounts of the node's shard
+func (hr *historyRepository) GetESDTSupply(token string) (*esdtRegistry.TokenSupply, error) {
+	return hr.esdtRegistry.GetESDTSupply(token)
+}
+
+// GetESDTHolders will return a page of the holders of the provided token from the node's shard
+func (hr *historyRepository) GetESDTHolders(token string, offset uint64, limit uint64) ([]*esdtRegistry.TokenHolder, error) {
+	return hr.esdtRegistry.GetESDTHolders(token, offset, limit)
+}
+
 // OnNotarizedBlocks notifies the history repository about notarized blocks
 func (hr *historyRepository) OnNotarizedBlocks(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte) {
 	for i, headerHandler := range headers {
@@ -9,6 +9,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/block"
 	"github.com/ElrondNetwork/elrond-go/common/mock"
+	"github.com/ElrondNetwork/elrond-go/dblookupext/esdtRegistry/disabled"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/ElrondNetwork/elrond-go/testscommon/genericMocks"
 	"github.com/stretchr/testify/assert"
@@ -24,6 +25,7 @@ func createMockHistoryRepoArgs(epoch uint32) HistoryRepositoryArguments {
 		EventsHashesByTxHashStorer:  genericMocks.NewStorerMock("EventsHashesByTxHash", epoch),
 		Marshalizer:                 &mock.MarshalizerMock{},
 		Hasher:                      &mock.HasherMock{},
+		ESDTRegistry:                disabled.NewDisabledESDTRegistry(),
 	}
 
 	return args
@@ -68,6 +70,12 @@ func TestNewHistoryRepository(t *testing.T) {
 	require.Nil(t, repo)
 	require.Equal(t, core.ErrNilMarshalizer, err)
 
+	args = createMockHistoryRepoArgs(0)
+	args.ESDTRegistry = nil
+	repo, err = NewHistoryRepository(args)
+	require.Nil(t, repo)
+	require.Equal(t, ErrNilESDTRegistry, err)
+
 	args = createMockHistoryRepoArgs(0)
 	repo, err = NewHistoryRepository(args)
 	require.Nil(t, err)
@@ -100,7 +108,7 @@ func TestHistoryRepository_RecordBlock(t *testing.T) {
 		},
 	}
 
-	err = repo.RecordBlock(headerHash, blockHeader, blockBody, nil, nil)
+	err = repo.RecordBlock(headerHash, blockHeader, blockBody, nil, nil, nil)
 	require.Nil(t, err)
 	// Two miniblocks
 	require.Equal(t, 2, repo.miniblocksMetadataStorer.(*genericMocks.StorerMock).GetCurrentEpochData().Len())
@@ -134,7 +142,7 @@ func TestHistoryRepository_GetMiniblockMetadata(t *testing.T) {
 				miniblockB,
 			},
 		},
-		nil, nil,
+		nil, nil, nil,
 	)
 
 	metadata, err := repo.GetMiniblockMetadataByTxHash([]byte("txA"))
@@ -172,7 +180,7 @@ func TestHistoryRepository_GetEpochForHash(t *testing.T) {
 			miniblockA,
 			miniblockB,
 		},
-	}, nil, nil)
+	}, nil, nil, nil)
 
 	// Get epoch by block hash
 	epoch, err := repo.GetEpochByHash([]byte("fooblock"))
@@ -230,7 +238,7 @@ func TestHistoryRepository_OnNotarizedBlocks(t *testing.T) {
 				miniblockB,
 				miniblockC,
 			},
-		}, nil, nil,
+		}, nil, nil, nil,
 	)
 
 	// Check "notarization coordinates"
@@ -430,7 +438,7 @@ func TestHistoryRepository_OnNotarizedBlocksAtSourceBeforeCommittingAtDestinatio
 			MiniBlocks: []*block.MiniBlock{
 				miniblockA,
 			},
-		}, nil, nil,
+		}, nil, nil, nil,
 	)
 	_ = repo.RecordBlock([]byte("barBlock"),
 		&block.Header{Epoch: 42, Round: 4322},
@@ -438,7 +446,7 @@ func TestHistoryRepository_OnNotarizedBlocksAtSourceBeforeCommittingAtDestinatio
 			MiniBlocks: []*block.MiniBlock{
 				miniblockB,
 			},
-		}, nil, nil,
+		}, nil, nil, nil,
 	)
 
 	// Notifications have not been cleared after record block
@@ -485,7 +493,7 @@ func TestHistoryRepository_OnNotarizedBlocksCrossEpoch(t *testing.T) {
 			MiniBlocks: []*block.MiniBlock{
 				miniblockA,
 			},
-		}, nil, nil,
+		}, nil, nil, nil,
 	)
 
 	// Now let's receive a metablock and the "notarized" notification, in the next epoch
@@ -542,7 +550,7 @@ func TestHistoryRepository_CommitOnForkThenNewEpochThenCommit(t *testing.T) {
 			MiniBlocks: []*block.MiniBlock{
 				miniblock,
 			},
-		}, nil, nil,
+		}, nil, nil, nil,
 	)
 
 	// Let's go to next epoch
@@ -555,7 +563,7 @@ func TestHistoryRepository_CommitOnForkThenNewEpochThenCommit(t *testing.T) {
 			MiniBlocks: []*block.MiniBlock{
 				miniblock,
 			},
-		}, nil, nil,
+		}, nil, nil, nil,
 	)
 
 	// Now let's receive a metablock and the "notarized" notification
@@ -669,7 +677,7 @@ func TestHistoryRepository_ConcurrentlyRecordAndNotarizeSameBlockMultipleTimes(t
 					MiniBlocks: []*block.MiniBlock{
 						miniblock,
 					},
-				}, nil, nil,
+				}, nil, nil, nil,
 			)
 		}
 
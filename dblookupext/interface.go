@@ -2,6 +2,7 @@ package dblookupext
 
 import (
 	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go/dblookupext/esdtRegistry"
 )
 
 // HistoryRepositoryFactory can create new instances of HistoryRepository
@@ -17,16 +18,27 @@ type HistoryRepository interface {
 		blockBody data.BodyHandler,
 		scrResultsFromPool map[string]data.TransactionHandler,
 		receiptsFromPool map[string]data.TransactionHandler,
+		logs map[string]data.LogHandler,
 	) error
 
 	OnNotarizedBlocks(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte)
 	GetMiniblockMetadataByTxHash(hash []byte) (*MiniblockMetadata, error)
 	GetEpochByHash(hash []byte) (uint32, error)
 	GetResultsHashesByTxHash(txHash []byte, epoch uint32) (*ResultsHashesByTxHash, error)
+	GetESDTSupply(token string) (*esdtRegistry.TokenSupply, error)
+	GetESDTHolders(token string, offset uint64, limit uint64) ([]*esdtRegistry.TokenHolder, error)
 	IsEnabled() bool
 	IsInterfaceNil() bool
 }
 
+// ESDTRegistryHandler defines the behavior of a component that indexes the supply and the holders of the ESDT tokens
+type ESDTRegistryHandler interface {
+	ProcessLogs(blockHeaderHash []byte, blockHeader data.HeaderHandler, logs map[string]data.LogHandler) error
+	GetESDTSupply(token string) (*esdtRegistry.TokenSupply, error)
+	GetESDTHolders(token string, offset uint64, limit uint64) ([]*esdtRegistry.TokenHolder, error)
+	IsInterfaceNil() bool
+}
+
 // BlockTracker defines the interface of the block tracker
 type BlockTracker interface {
 	RegisterCrossNotarizedHeadersHandler(func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte))
@@ -2,6 +2,7 @@ package dblookupext
 
 import (
 	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go/dblookupext/esdtRegistry"
 )
 
 type nilHistoryRepository struct {
@@ -13,7 +14,7 @@ func NewNilHistoryRepository() (*nilHistoryRepository, error) {
 }
 
 // RecordBlock returns a not implemented error
-func (nhr *nilHistoryRepository) RecordBlock(_ []byte, _ data.HeaderHandler, _ data.BodyHandler, _, _ map[string]data.TransactionHandler) error {
+func (nhr *nilHistoryRepository) RecordBlock(_ []byte, _ data.HeaderHandler, _ data.BodyHandler, _, _ map[string]data.TransactionHandler, _ map[string]data.LogHandler) error {
 	return nil
 }
 
@@ -41,6 +42,16 @@ func (nhr *nilHistoryRepository) GetResultsHashesByTxHash(_ []byte, _ uint32) (*
 	return nil, nil
 }
 
+// GetESDTSupply returns nil
+func (nhr *nilHistoryRepository) GetESDTSupply(_ string) (*esdtRegistry.TokenSupply, error) {
+	return nil, nil
+}
+
+// GetESDTHolders returns the ErrESDTRegistryNotEnabled error
+func (nhr *nilHistoryRepository) GetESDTHolders(_ string, _ uint64, _ uint64) ([]*esdtRegistry.TokenHolder, error) {
+	return nil, esdtRegistry.ErrESDTRegistryNotEnabled
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (nhr *nilHistoryRepository) IsInterfaceNil() bool {
 	return nhr == nil
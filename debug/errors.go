@@ -4,3 +4,9 @@ import "errors"
 
 // ErrInvalidValue signals that the provided value is invalid
 var ErrInvalidValue = errors.New("invalid value")
+
+// ErrNilRoundHandler signals that a nil round handler has been provided
+var ErrNilRoundHandler = errors.New("nil round handler")
+
+// ErrNilSyncTimer signals that a nil sync timer has been provided
+var ErrNilSyncTimer = errors.New("nil sync timer")
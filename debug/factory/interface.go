@@ -1,5 +1,7 @@
 package factory
 
+import "github.com/ElrondNetwork/elrond-go/consensus"
+
 // InterceptorResolverDebugHandler hold information about requested and received information
 type InterceptorResolverDebugHandler interface {
 	LogRequestedData(topic string, hashes [][]byte, numReqIntra int, numReqCross int)
@@ -11,3 +13,11 @@ type InterceptorResolverDebugHandler interface {
 	Close() error
 	IsInterfaceNil() bool
 }
+
+// RoundTracerHandler records the consensus rounds events and can be closed
+type RoundTracerHandler interface {
+	AddEvent(event consensus.RoundEvent)
+	RecentRounds() []consensus.RoundTrace
+	Close() error
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,46 @@
+package factory
+
+import (
+	"os"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/debug/roundTracer"
+	"github.com/ElrondNetwork/elrond-go/ntp"
+)
+
+const exportFilePermissions = 0644
+
+// NewRoundTracerFactory will instantiate a RoundTracerHandler based on the provided config
+func NewRoundTracerFactory(
+	config config.ConsensusRoundsDebugConfig,
+	roundHandler consensus.RoundHandler,
+	syncTimer ntp.SyncTimer,
+) (RoundTracerHandler, error) {
+	if !config.Enabled {
+		return roundTracer.NewDisabledRoundTracer(), nil
+	}
+
+	args := roundTracer.ArgsRoundTracer{
+		NumRoundsToKeep: config.NumRoundsToKeep,
+		RoundHandler:    roundHandler,
+		SyncTimer:       syncTimer,
+	}
+	if len(config.ExportFilePath) == 0 {
+		return roundTracer.NewRoundTracer(args)
+	}
+
+	file, err := os.OpenFile(config.ExportFilePath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, exportFilePermissions)
+	if err != nil {
+		return nil, err
+	}
+
+	args.Exporter = file
+	tracer, err := roundTracer.NewRoundTracer(args)
+	if err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+
+	return tracer, nil
+}
@@ -0,0 +1,77 @@
+package factory
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/debug/roundTracer"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRoundTracerFactory_DisabledShouldWork(t *testing.T) {
+	t.Parallel()
+
+	rth, err := NewRoundTracerFactory(
+		config.ConsensusRoundsDebugConfig{
+			Enabled: false,
+		},
+		&testscommon.RoundHandlerMock{},
+		&testscommon.SyncTimerStub{},
+	)
+
+	assert.Nil(t, err)
+	assert.IsType(t, roundTracer.NewDisabledRoundTracer(), rth)
+}
+
+func TestNewRoundTracerFactory_InvalidConfigShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rth, err := NewRoundTracerFactory(
+		config.ConsensusRoundsDebugConfig{
+			Enabled:         true,
+			NumRoundsToKeep: 0,
+			ExportFilePath:  "",
+		},
+		&testscommon.RoundHandlerMock{},
+		&testscommon.SyncTimerStub{},
+	)
+
+	assert.Nil(t, rth)
+	assert.NotNil(t, err)
+}
+
+func TestNewRoundTracerFactory_WithExportFileShouldWork(t *testing.T) {
+	t.Parallel()
+
+	exportDirectory, err := ioutil.TempDir("", "roundTracer")
+	require.Nil(t, err)
+	defer func() {
+		_ = os.RemoveAll(exportDirectory)
+	}()
+
+	exportFile := filepath.Join(exportDirectory, "rounds.jsonl")
+	rth, err := NewRoundTracerFactory(
+		config.ConsensusRoundsDebugConfig{
+			Enabled:         true,
+			NumRoundsToKeep: 10,
+			ExportFilePath:  exportFile,
+		},
+		&testscommon.RoundHandlerMock{},
+		&testscommon.SyncTimerStub{},
+	)
+	require.Nil(t, err)
+
+	rth.AddEvent(consensus.RoundEvent{Type: consensus.RoundEventJobStart, Round: 1})
+	err = rth.Close()
+	assert.Nil(t, err)
+
+	content, err := ioutil.ReadFile(exportFile)
+	assert.Nil(t, err)
+	assert.Contains(t, string(content), `"round":1`)
+}
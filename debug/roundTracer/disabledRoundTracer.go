@@ -0,0 +1,32 @@
+package roundTracer
+
+import "github.com/ElrondNetwork/elrond-go/consensus"
+
+var _ consensus.RoundTracer = (*disabledRoundTracer)(nil)
+
+type disabledRoundTracer struct {
+}
+
+// NewDisabledRoundTracer returns a round tracer that does not record anything
+func NewDisabledRoundTracer() *disabledRoundTracer {
+	return &disabledRoundTracer{}
+}
+
+// AddEvent does nothing
+func (drt *disabledRoundTracer) AddEvent(_ consensus.RoundEvent) {
+}
+
+// RecentRounds returns an empty slice
+func (drt *disabledRoundTracer) RecentRounds() []consensus.RoundTrace {
+	return make([]consensus.RoundTrace, 0)
+}
+
+// Close returns nil
+func (drt *disabledRoundTracer) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (drt *disabledRoundTracer) IsInterfaceNil() bool {
+	return drt == nil
+}
@@ -0,0 +1,190 @@
+package roundTracer
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/debug"
+	"github.com/ElrondNetwork/elrond-go/ntp"
+)
+
+// exportDelayInRounds defines after how many rounds a traced round is written to the exporter. Consensus messages
+// for a round can still arrive after the next round started so the rounds are not exported right away
+const exportDelayInRounds = 2
+
+const minNumRoundsToKeep = 1
+
+var log = logger.GetOrCreate("debug/roundtracer")
+
+var _ consensus.RoundTracer = (*roundTracer)(nil)
+
+// ArgsRoundTracer is the DTO used to create a new round tracer
+type ArgsRoundTracer struct {
+	NumRoundsToKeep int
+	RoundHandler    consensus.RoundHandler
+	SyncTimer       ntp.SyncTimer
+	// Exporter is optional. If set, each traced round is written as one JSON line. The exporter is closed
+	// on Close if it implements io.Closer
+	Exporter io.Writer
+}
+
+type roundTracer struct {
+	mut               sync.RWMutex
+	numRoundsToKeep   int
+	roundHandler      consensus.RoundHandler
+	syncTimer         ntp.SyncTimer
+	exporter          io.Writer
+	rounds            map[int64]*consensus.RoundTrace
+	orderedRounds     []int64
+	lastExportedRound int64
+}
+
+// NewRoundTracer creates a round tracer that keeps the latest NumRoundsToKeep rounds in a ring buffer
+func NewRoundTracer(args ArgsRoundTracer) (*roundTracer, error) {
+	if args.NumRoundsToKeep < minNumRoundsToKeep {
+		return nil, fmt.Errorf("%w for NumRoundsToKeep, minimum is %d", debug.ErrInvalidValue, minNumRoundsToKeep)
+	}
+	if check.IfNil(args.RoundHandler) {
+		return nil, debug.ErrNilRoundHandler
+	}
+	if check.IfNil(args.SyncTimer) {
+		return nil, debug.ErrNilSyncTimer
+	}
+
+	return &roundTracer{
+		numRoundsToKeep:   args.NumRoundsToKeep,
+		roundHandler:      args.RoundHandler,
+		syncTimer:         args.SyncTimer,
+		exporter:          args.Exporter,
+		rounds:            make(map[int64]*consensus.RoundTrace),
+		orderedRounds:     make([]int64, 0, args.NumRoundsToKeep+1),
+		lastExportedRound: math.MinInt64,
+	}, nil
+}
+
+// AddEvent records the event in the trace of its round
+func (rt *roundTracer) AddEvent(event consensus.RoundEvent) {
+	event.Timestamp = rt.syncTimer.CurrentTime()
+	roundStartTime := rt.roundHandler.TimeStamp().Add(
+		rt.roundHandler.TimeDuration() * time.Duration(event.Round-rt.roundHandler.Index()))
+	event.Offset = event.Timestamp.Sub(roundStartTime)
+
+	rt.mut.Lock()
+	defer rt.mut.Unlock()
+
+	trace, found := rt.rounds[event.Round]
+	if !found {
+		if event.Round <= rt.lastExportedRound {
+			log.Trace("roundTracer.AddEvent: event for an already exported round", "round", event.Round, "type", event.Type)
+			return
+		}
+
+		trace = &consensus.RoundTrace{
+			Round:     event.Round,
+			StartTime: roundStartTime,
+			Events:    make([]consensus.RoundEvent, 0),
+		}
+		rt.addRound(trace)
+	}
+
+	trace.Events = append(trace.Events, event)
+}
+
+func (rt *roundTracer) addRound(trace *consensus.RoundTrace) {
+	rt.rounds[trace.Round] = trace
+	rt.orderedRounds = append(rt.orderedRounds, trace.Round)
+	sort.Slice(rt.orderedRounds, func(i, j int) bool {
+		return rt.orderedRounds[i] < rt.orderedRounds[j]
+	})
+
+	newestRound := rt.orderedRounds[len(rt.orderedRounds)-1]
+	rt.exportRounds(newestRound - exportDelayInRounds)
+
+	for len(rt.orderedRounds) > rt.numRoundsToKeep {
+		oldestRound := rt.orderedRounds[0]
+		rt.exportRounds(oldestRound)
+		rt.orderedRounds = rt.orderedRounds[1:]
+		delete(rt.rounds, oldestRound)
+	}
+}
+
+// exportRounds writes all the not yet exported rounds up to, and including, the provided round
+func (rt *roundTracer) exportRounds(upToRound int64) {
+	for _, round := range rt.orderedRounds {
+		if round > upToRound {
+			return
+		}
+		if round <= rt.lastExportedRound {
+			continue
+		}
+
+		rt.exportRound(rt.rounds[round])
+		rt.lastExportedRound = round
+	}
+}
+
+func (rt *roundTracer) exportRound(trace *consensus.RoundTrace) {
+	if rt.exporter == nil {
+		return
+	}
+
+	buff, err := json.Marshal(trace)
+	if err != nil {
+		log.Warn("roundTracer.exportRound: marshal", "round", trace.Round, "error", err)
+		return
+	}
+
+	_, err = rt.exporter.Write(append(buff, '\n'))
+	if err != nil {
+		log.Warn("roundTracer.exportRound: write", "round", trace.Round, "error", err)
+	}
+}
+
+// RecentRounds returns a copy of the traced rounds, ordered ascending
+func (rt *roundTracer) RecentRounds() []consensus.RoundTrace {
+	rt.mut.RLock()
+	defer rt.mut.RUnlock()
+
+	traces := make([]consensus.RoundTrace, 0, len(rt.orderedRounds))
+	for _, round := range rt.orderedRounds {
+		trace := rt.rounds[round]
+		events := make([]consensus.RoundEvent, len(trace.Events))
+		copy(events, trace.Events)
+
+		traces = append(traces, consensus.RoundTrace{
+			Round:     trace.Round,
+			StartTime: trace.StartTime,
+			Events:    events,
+		})
+	}
+
+	return traces
+}
+
+// Close exports the remaining rounds and closes the exporter
+func (rt *roundTracer) Close() error {
+	rt.mut.Lock()
+	defer rt.mut.Unlock()
+
+	rt.exportRounds(math.MaxInt64)
+
+	closer, ok := rt.exporter.(io.Closer)
+	if !ok {
+		return nil
+	}
+
+	return closer.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rt *roundTracer) IsInterfaceNil() bool {
+	return rt == nil
+}
@@ -0,0 +1,177 @@
+package roundTracer
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/debug"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var genesisTime = time.Unix(1600000000, 0)
+
+const roundDuration = time.Second
+
+type closableBuffer struct {
+	bytes.Buffer
+	closed bool
+}
+
+func (cb *closableBuffer) Close() error {
+	cb.closed = true
+	return nil
+}
+
+func createMockArgs(currentRound *int64, currentTime *time.Time) ArgsRoundTracer {
+	return ArgsRoundTracer{
+		NumRoundsToKeep: 3,
+		RoundHandler: &testscommon.RoundHandlerMock{
+			IndexCalled: func() int64 {
+				return *currentRound
+			},
+			TimeStampCalled: func() time.Time {
+				return genesisTime.Add(roundDuration * time.Duration(*currentRound))
+			},
+			TimeDurationCalled: func() time.Duration {
+				return roundDuration
+			},
+		},
+		SyncTimer: &testscommon.SyncTimerStub{
+			CurrentTimeCalled: func() time.Time {
+				return *currentTime
+			},
+		},
+	}
+}
+
+func readExportedRounds(t *testing.T, buff *bytes.Buffer) []int64 {
+	rounds := make([]int64, 0)
+	scanner := bufio.NewScanner(bytes.NewReader(buff.Bytes()))
+	for scanner.Scan() {
+		trace := &consensus.RoundTrace{}
+		err := json.Unmarshal(scanner.Bytes(), trace)
+		require.Nil(t, err)
+		rounds = append(rounds, trace.Round)
+	}
+
+	return rounds
+}
+
+func TestNewRoundTracer(t *testing.T) {
+	t.Parallel()
+
+	currentRound := int64(0)
+	currentTime := genesisTime
+
+	t.Run("invalid number of rounds should error", func(t *testing.T) {
+		args := createMockArgs(&currentRound, &currentTime)
+		args.NumRoundsToKeep = 0
+		rt, err := NewRoundTracer(args)
+		assert.True(t, check.IfNil(rt))
+		assert.True(t, errors.Is(err, debug.ErrInvalidValue))
+	})
+	t.Run("nil round handler should error", func(t *testing.T) {
+		args := createMockArgs(&currentRound, &currentTime)
+		args.RoundHandler = nil
+		rt, err := NewRoundTracer(args)
+		assert.True(t, check.IfNil(rt))
+		assert.Equal(t, debug.ErrNilRoundHandler, err)
+	})
+	t.Run("nil sync timer should error", func(t *testing.T) {
+		args := createMockArgs(&currentRound, &currentTime)
+		args.SyncTimer = nil
+		rt, err := NewRoundTracer(args)
+		assert.True(t, check.IfNil(rt))
+		assert.Equal(t, debug.ErrNilSyncTimer, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		rt, err := NewRoundTracer(createMockArgs(&currentRound, &currentTime))
+		assert.False(t, check.IfNil(rt))
+		assert.Nil(t, err)
+	})
+}
+
+func TestRoundTracer_AddEventShouldComputeOffsetFromTheEventRound(t *testing.T) {
+	t.Parallel()
+
+	currentRound := int64(5)
+	currentTime := genesisTime.Add(roundDuration*5 + time.Millisecond*300)
+	rt, _ := NewRoundTracer(createMockArgs(&currentRound, &currentTime))
+
+	rt.AddEvent(consensus.RoundEvent{Type: consensus.RoundEventJobStart, Round: 5})
+	// late message for the previous round
+	rt.AddEvent(consensus.RoundEvent{Type: consensus.RoundEventMessageReceived, Round: 4, SenderIndex: 3})
+
+	rounds := rt.RecentRounds()
+	require.Equal(t, 2, len(rounds))
+	assert.Equal(t, int64(4), rounds[0].Round)
+	assert.Equal(t, genesisTime.Add(roundDuration*4), rounds[0].StartTime)
+	assert.Equal(t, time.Millisecond*1300, rounds[0].Events[0].Offset)
+	assert.Equal(t, 3, rounds[0].Events[0].SenderIndex)
+	assert.Equal(t, int64(5), rounds[1].Round)
+	assert.Equal(t, time.Millisecond*300, rounds[1].Events[0].Offset)
+	assert.Equal(t, currentTime, rounds[1].Events[0].Timestamp)
+}
+
+func TestRoundTracer_ShouldKeepOnlyTheLatestRounds(t *testing.T) {
+	t.Parallel()
+
+	currentRound := int64(0)
+	currentTime := genesisTime
+	rt, _ := NewRoundTracer(createMockArgs(&currentRound, &currentTime))
+
+	for round := int64(1); round <= 5; round++ {
+		rt.AddEvent(consensus.RoundEvent{Type: consensus.RoundEventJobStart, Round: round})
+	}
+
+	rounds := rt.RecentRounds()
+	require.Equal(t, 3, len(rounds))
+	assert.Equal(t, int64(3), rounds[0].Round)
+	assert.Equal(t, int64(5), rounds[2].Round)
+}
+
+func TestRoundTracer_ShouldExportRoundsAsJSONLines(t *testing.T) {
+	t.Parallel()
+
+	currentRound := int64(0)
+	currentTime := genesisTime
+	args := createMockArgs(&currentRound, &currentTime)
+	exporter := &closableBuffer{}
+	args.Exporter = exporter
+	rt, _ := NewRoundTracer(args)
+
+	rt.AddEvent(consensus.RoundEvent{Type: consensus.RoundEventJobStart, Round: 1})
+	rt.AddEvent(consensus.RoundEvent{Type: consensus.RoundEventJobStart, Round: 2})
+	assert.Equal(t, 0, exporter.Len())
+
+	rt.AddEvent(consensus.RoundEvent{Type: consensus.RoundEventJobStart, Round: 3})
+	assert.Equal(t, []int64{1}, readExportedRounds(t, &exporter.Buffer))
+
+	// late events are kept in the buffer but are not exported again
+	rt.AddEvent(consensus.RoundEvent{Type: consensus.RoundEventMessageReceived, Round: 1})
+	rounds := rt.RecentRounds()
+	assert.Equal(t, []int64{1, 2, 3}, roundsIndexes(rounds))
+	assert.Equal(t, 2, len(rounds[0].Events))
+
+	err := rt.Close()
+	assert.Nil(t, err)
+	assert.True(t, exporter.closed)
+	assert.Equal(t, []int64{1, 2, 3}, readExportedRounds(t, &exporter.Buffer))
+}
+
+func roundsIndexes(traces []consensus.RoundTrace) []int64 {
+	indexes := make([]int64, 0, len(traces))
+	for _, trace := range traces {
+		indexes = append(indexes, trace.Round)
+	}
+
+	return indexes
+}
@@ -0,0 +1,36 @@
+package checkpoint
+
+import "errors"
+
+// ErrNilWriter signals that a nil writer has been provided
+var ErrNilWriter = errors.New("nil writer")
+
+// ErrNilReader signals that a nil reader has been provided
+var ErrNilReader = errors.New("nil reader")
+
+// ErrNilTrie signals that a nil trie has been provided
+var ErrNilTrie = errors.New("nil trie")
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilTrieStorage signals that a nil trie storage has been provided
+var ErrNilTrieStorage = errors.New("nil trie storage")
+
+// ErrInvalidSnapshotFile signals that the provided file is not a state snapshot
+var ErrInvalidSnapshotFile = errors.New("invalid state snapshot file")
+
+// ErrUnsupportedSnapshotVersion signals that the state snapshot was written with an unsupported version
+var ErrUnsupportedSnapshotVersion = errors.New("unsupported state snapshot version")
+
+// ErrUnknownTrieType signals that a trie node of an unknown trie type has been found
+var ErrUnknownTrieType = errors.New("unknown trie type")
+
+// ErrRecordTooLarge signals that a record from the state snapshot exceeds the maximum allowed size
+var ErrRecordTooLarge = errors.New("state snapshot record too large")
+
+// ErrEmptyTrieNode signals that an empty trie node has been provided
+var ErrEmptyTrieNode = errors.New("empty trie node")
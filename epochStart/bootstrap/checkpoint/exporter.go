@@ -0,0 +1,145 @@
+package checkpoint
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/state"
+)
+
+var log = logger.GetOrCreate("epochStart/bootstrap/checkpoint")
+
+// ArgsStateExporter holds the arguments needed to create a state exporter
+type ArgsStateExporter struct {
+	Writer      SnapshotWriter
+	Marshalizer marshal.Marshalizer
+}
+
+type stateExporter struct {
+	writer           SnapshotWriter
+	marshalizer      marshal.Marshalizer
+	exportedDataRoot map[string]struct{}
+	numExportedNodes int
+}
+
+// NewStateExporter creates a component able to write the nodes of the state tries to a snapshot
+func NewStateExporter(args ArgsStateExporter) (*stateExporter, error) {
+	if check.IfNil(args.Writer) {
+		return nil, ErrNilWriter
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+
+	return &stateExporter{
+		writer:           args.Writer,
+		marshalizer:      args.Marshalizer,
+		exportedDataRoot: make(map[string]struct{}),
+	}, nil
+}
+
+// ExportTrie writes all the nodes of the trie with the provided root hash. For the user accounts trie, the nodes of
+// all the accounts data tries are written as well
+func (se *stateExporter) ExportTrie(trieType TrieType, tr common.Trie, rootHash []byte) error {
+	if check.IfNil(tr) {
+		return ErrNilTrie
+	}
+	err := checkTrieType(trieType)
+	if err != nil {
+		return err
+	}
+
+	recreatedTrie, err := tr.Recreate(rootHash)
+	if err != nil {
+		return err
+	}
+
+	err = se.exportTrieNodes(trieType, recreatedTrie)
+	if err != nil {
+		return err
+	}
+
+	if trieType != UserAccountsTrie {
+		return nil
+	}
+
+	return se.exportDataTries(recreatedTrie, rootHash)
+}
+
+func (se *stateExporter) exportDataTries(mainTrie common.Trie, rootHash []byte) error {
+	leavesChannel, err := mainTrie.GetAllLeavesOnChannel(rootHash)
+	if err != nil {
+		return err
+	}
+
+	dataTriesRootHashes := make([][]byte, 0)
+	for leaf := range leavesChannel {
+		account := state.NewEmptyUserAccount()
+		errUnmarshal := se.marshalizer.Unmarshal(account, leaf.Value())
+		if errUnmarshal != nil {
+			log.Trace("this must be a leaf with code", "error", errUnmarshal)
+			continue
+		}
+		if len(account.RootHash) == 0 {
+			continue
+		}
+
+		_, exported := se.exportedDataRoot[string(account.RootHash)]
+		if exported {
+			continue
+		}
+
+		se.exportedDataRoot[string(account.RootHash)] = struct{}{}
+		dataTriesRootHashes = append(dataTriesRootHashes, account.RootHash)
+	}
+
+	for _, dataTrieRootHash := range dataTriesRootHashes {
+		dataTrie, errRecreate := mainTrie.Recreate(dataTrieRootHash)
+		if errRecreate != nil {
+			return errRecreate
+		}
+
+		err = se.exportTrieNodes(UserAccountsTrie, dataTrie)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Debug("exported data tries", "num data tries", len(dataTriesRootHashes))
+
+	return nil
+}
+
+func (se *stateExporter) exportTrieNodes(trieType TrieType, tr common.Trie) error {
+	hashes, err := tr.GetAllHashes()
+	if err != nil {
+		return err
+	}
+
+	for _, hash := range hashes {
+		serializedNode, errGet := tr.GetSerializedNode(hash)
+		if errGet != nil {
+			return errGet
+		}
+
+		err = se.writer.WriteTrieNode(trieType, serializedNode)
+		if err != nil {
+			return err
+		}
+	}
+
+	se.numExportedNodes += len(hashes)
+
+	return nil
+}
+
+// NumExportedNodes returns the number of trie nodes written so far
+func (se *stateExporter) NumExportedNodes() int {
+	return se.numExportedNodes
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (se *stateExporter) IsInterfaceNil() bool {
+	return se == nil
+}
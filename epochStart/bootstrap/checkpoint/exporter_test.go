@@ -0,0 +1,141 @@
+package checkpoint_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/epochStart/bootstrap/checkpoint"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const maxTrieLevelInMemory = 5
+
+var testMarshalizer = &testscommon.ProtobufMarshalizerMock{}
+var testHasher = &testscommon.KeccakMock{}
+
+func createTrie(db common.DBWriteCacher) common.Trie {
+	storageManager, _ := trie.NewTrieStorageManagerWithoutPruning(db)
+	tr, _ := trie.NewTrie(storageManager, testMarshalizer, testHasher, maxTrieLevelInMemory)
+
+	return tr
+}
+
+// createUserAccountsTrie creates a committed accounts trie in which every other account has a data trie
+func createUserAccountsTrie(t *testing.T, db common.DBWriteCacher, numAccounts int) ([]byte, [][]byte) {
+	accountsTrie := createTrie(db)
+	dataTriesRootHashes := make([][]byte, 0)
+	for i := 0; i < numAccounts; i++ {
+		account := state.NewEmptyUserAccount()
+		if i%2 == 0 {
+			dataTrie := createTrie(db)
+			_ = dataTrie.Update([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i)))
+			require.Nil(t, dataTrie.Commit())
+			account.RootHash, _ = dataTrie.RootHash()
+			dataTriesRootHashes = append(dataTriesRootHashes, account.RootHash)
+		}
+
+		serializedAccount, err := testMarshalizer.Marshal(account)
+		require.Nil(t, err)
+		_ = accountsTrie.Update([]byte(fmt.Sprintf("address%d", i)), serializedAccount)
+	}
+	require.Nil(t, accountsTrie.Commit())
+	rootHash, _ := accountsTrie.RootHash()
+
+	return rootHash, dataTriesRootHashes
+}
+
+func TestNewStateExporter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil writer should err", func(t *testing.T) {
+		se, err := checkpoint.NewStateExporter(checkpoint.ArgsStateExporter{
+			Marshalizer: testMarshalizer,
+		})
+		assert.Nil(t, se)
+		assert.Equal(t, checkpoint.ErrNilWriter, err)
+	})
+	t.Run("nil marshalizer should err", func(t *testing.T) {
+		sw, _ := checkpoint.NewSnapshotWriter(&bytes.Buffer{}, checkpoint.SnapshotHeader{})
+		se, err := checkpoint.NewStateExporter(checkpoint.ArgsStateExporter{
+			Writer: sw,
+		})
+		assert.Nil(t, se)
+		assert.Equal(t, checkpoint.ErrNilMarshalizer, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		sw, _ := checkpoint.NewSnapshotWriter(&bytes.Buffer{}, checkpoint.SnapshotHeader{})
+		se, err := checkpoint.NewStateExporter(checkpoint.ArgsStateExporter{
+			Writer:      sw,
+			Marshalizer: testMarshalizer,
+		})
+		assert.Nil(t, err)
+		assert.False(t, se.IsInterfaceNil())
+	})
+}
+
+func TestStateExporter_ExportTrieInvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sw, _ := checkpoint.NewSnapshotWriter(&bytes.Buffer{}, checkpoint.SnapshotHeader{})
+	se, _ := checkpoint.NewStateExporter(checkpoint.ArgsStateExporter{
+		Writer:      sw,
+		Marshalizer: testMarshalizer,
+	})
+
+	err := se.ExportTrie(checkpoint.UserAccountsTrie, nil, []byte("root hash"))
+	assert.Equal(t, checkpoint.ErrNilTrie, err)
+
+	err = se.ExportTrie(checkpoint.TrieType(0), createTrie(testscommon.NewMemDbMock()), []byte("root hash"))
+	assert.True(t, errors.Is(err, checkpoint.ErrUnknownTrieType))
+}
+
+func TestStateExporter_ExportTrieShouldWriteAllTheNodes(t *testing.T) {
+	t.Parallel()
+
+	db := testscommon.NewMemDbMock()
+	rootHash, _ := createUserAccountsTrie(t, db, 20)
+
+	buff := &bytes.Buffer{}
+	header := checkpoint.SnapshotHeader{
+		ShardID:  1,
+		RootHash: rootHash,
+	}
+	sw, _ := checkpoint.NewSnapshotWriter(buff, header)
+	se, _ := checkpoint.NewStateExporter(checkpoint.ArgsStateExporter{
+		Writer:      sw,
+		Marshalizer: testMarshalizer,
+	})
+
+	err := se.ExportTrie(checkpoint.UserAccountsTrie, createTrie(db), rootHash)
+	require.Nil(t, err)
+	require.Nil(t, sw.Flush())
+
+	sr, _ := checkpoint.NewSnapshotReader(buff)
+	assert.Equal(t, header.ShardID, sr.Header().ShardID)
+	assert.Equal(t, header.RootHash, sr.Header().RootHash)
+
+	numNodes := 0
+	for {
+		trieType, serializedNode, errRead := sr.ReadTrieNode()
+		if errRead == io.EOF {
+			break
+		}
+		require.Nil(t, errRead)
+		assert.Equal(t, checkpoint.UserAccountsTrie, trieType)
+
+		// every written node must be found in the source storage under its hash
+		_, errGet := db.Get(testHasher.Compute(string(serializedNode)))
+		assert.Nil(t, errGet)
+		numNodes++
+	}
+	assert.Equal(t, se.NumExportedNodes(), numNodes)
+	assert.True(t, numNodes > 20)
+}
@@ -0,0 +1,86 @@
+package checkpoint
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+const numNodesBetweenProgressLogs = 1000000
+
+// ArgsStateImporter holds the arguments needed to create a state importer
+type ArgsStateImporter struct {
+	Reader       SnapshotReader
+	Hasher       hashing.Hasher
+	TrieStorages map[TrieType]common.DBWriteCacher
+}
+
+type stateImporter struct {
+	reader       SnapshotReader
+	hasher       hashing.Hasher
+	trieStorages map[TrieType]common.DBWriteCacher
+}
+
+// NewStateImporter creates a component able to copy the trie nodes of a state snapshot into the tries storage
+func NewStateImporter(args ArgsStateImporter) (*stateImporter, error) {
+	if check.IfNil(args.Reader) {
+		return nil, ErrNilReader
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if len(args.TrieStorages) == 0 {
+		return nil, ErrNilTrieStorage
+	}
+	for trieType, trieStorage := range args.TrieStorages {
+		if check.IfNil(trieStorage) {
+			return nil, fmt.Errorf("%w for trie type %d", ErrNilTrieStorage, trieType)
+		}
+	}
+
+	return &stateImporter{
+		reader:       args.Reader,
+		hasher:       args.Hasher,
+		trieStorages: args.TrieStorages,
+	}, nil
+}
+
+// ImportTrieNodes copies all the trie nodes of the snapshot into the tries storage and returns the number of
+// imported nodes. Each node is saved under the hash of its serialized form, so the snapshot content does not need to
+// be trusted: a node not matching the expected hash will simply not be found when the tries are verified
+func (si *stateImporter) ImportTrieNodes() (int, error) {
+	numImportedNodes := 0
+	for {
+		trieType, serializedNode, err := si.reader.ReadTrieNode()
+		if err == io.EOF {
+			return numImportedNodes, nil
+		}
+		if err != nil {
+			return numImportedNodes, err
+		}
+
+		trieStorage, ok := si.trieStorages[trieType]
+		if !ok {
+			return numImportedNodes, fmt.Errorf("%w for trie type %d", ErrNilTrieStorage, trieType)
+		}
+
+		hash := si.hasher.Compute(string(serializedNode))
+		err = trieStorage.Put(hash, serializedNode)
+		if err != nil {
+			return numImportedNodes, err
+		}
+
+		numImportedNodes++
+		if numImportedNodes%numNodesBetweenProgressLogs == 0 {
+			log.Debug("importing state snapshot", "num imported trie nodes", numImportedNodes)
+		}
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (si *stateImporter) IsInterfaceNil() bool {
+	return si == nil
+}
@@ -0,0 +1,137 @@
+package checkpoint_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/epochStart/bootstrap/checkpoint"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createSnapshot(t *testing.T, db common.DBWriteCacher, rootHash []byte) *bytes.Buffer {
+	buff := &bytes.Buffer{}
+	sw, _ := checkpoint.NewSnapshotWriter(buff, checkpoint.SnapshotHeader{RootHash: rootHash})
+	se, _ := checkpoint.NewStateExporter(checkpoint.ArgsStateExporter{
+		Writer:      sw,
+		Marshalizer: testMarshalizer,
+	})
+	require.Nil(t, se.ExportTrie(checkpoint.UserAccountsTrie, createTrie(db), rootHash))
+	require.Nil(t, sw.Flush())
+
+	return buff
+}
+
+func TestNewStateImporter(t *testing.T) {
+	t.Parallel()
+
+	sr, _ := checkpoint.NewSnapshotReader(createSnapshot(t, testscommon.NewMemDbMock(), nil))
+	createArgs := func() checkpoint.ArgsStateImporter {
+		return checkpoint.ArgsStateImporter{
+			Reader: sr,
+			Hasher: testHasher,
+			TrieStorages: map[checkpoint.TrieType]common.DBWriteCacher{
+				checkpoint.UserAccountsTrie: testscommon.NewMemDbMock(),
+			},
+		}
+	}
+
+	t.Run("nil reader should err", func(t *testing.T) {
+		args := createArgs()
+		args.Reader = nil
+		si, err := checkpoint.NewStateImporter(args)
+		assert.Nil(t, si)
+		assert.Equal(t, checkpoint.ErrNilReader, err)
+	})
+	t.Run("nil hasher should err", func(t *testing.T) {
+		args := createArgs()
+		args.Hasher = nil
+		si, err := checkpoint.NewStateImporter(args)
+		assert.Nil(t, si)
+		assert.Equal(t, checkpoint.ErrNilHasher, err)
+	})
+	t.Run("no trie storage should err", func(t *testing.T) {
+		args := createArgs()
+		args.TrieStorages = nil
+		si, err := checkpoint.NewStateImporter(args)
+		assert.Nil(t, si)
+		assert.Equal(t, checkpoint.ErrNilTrieStorage, err)
+	})
+	t.Run("nil trie storage should err", func(t *testing.T) {
+		args := createArgs()
+		args.TrieStorages[checkpoint.PeerAccountsTrie] = nil
+		si, err := checkpoint.NewStateImporter(args)
+		assert.Nil(t, si)
+		assert.True(t, errors.Is(err, checkpoint.ErrNilTrieStorage))
+	})
+	t.Run("should work", func(t *testing.T) {
+		si, err := checkpoint.NewStateImporter(createArgs())
+		assert.Nil(t, err)
+		assert.False(t, si.IsInterfaceNil())
+	})
+}
+
+func TestStateImporter_ImportTrieNodesMissingTrieStorageShouldErr(t *testing.T) {
+	t.Parallel()
+
+	db := testscommon.NewMemDbMock()
+	rootHash, _ := createUserAccountsTrie(t, db, 5)
+	sr, _ := checkpoint.NewSnapshotReader(createSnapshot(t, db, rootHash))
+
+	si, _ := checkpoint.NewStateImporter(checkpoint.ArgsStateImporter{
+		Reader: sr,
+		Hasher: testHasher,
+		TrieStorages: map[checkpoint.TrieType]common.DBWriteCacher{
+			checkpoint.PeerAccountsTrie: testscommon.NewMemDbMock(),
+		},
+	})
+
+	numImportedNodes, err := si.ImportTrieNodes()
+	assert.True(t, errors.Is(err, checkpoint.ErrNilTrieStorage))
+	assert.Equal(t, 0, numImportedNodes)
+}
+
+func TestStateImporter_ImportTrieNodesShouldRecreateTheState(t *testing.T) {
+	t.Parallel()
+
+	numAccounts := 50
+	sourceDb := testscommon.NewMemDbMock()
+	rootHash, dataTriesRootHashes := createUserAccountsTrie(t, sourceDb, numAccounts)
+	sr, _ := checkpoint.NewSnapshotReader(createSnapshot(t, sourceDb, rootHash))
+
+	destinationDb := testscommon.NewMemDbMock()
+	si, _ := checkpoint.NewStateImporter(checkpoint.ArgsStateImporter{
+		Reader: sr,
+		Hasher: testHasher,
+		TrieStorages: map[checkpoint.TrieType]common.DBWriteCacher{
+			checkpoint.UserAccountsTrie: destinationDb,
+		},
+	})
+
+	numImportedNodes, err := si.ImportTrieNodes()
+	require.Nil(t, err)
+	assert.True(t, numImportedNodes > numAccounts)
+
+	accountsTrie, err := createTrie(destinationDb).Recreate(rootHash)
+	require.Nil(t, err)
+	leavesChannel, err := accountsTrie.GetAllLeavesOnChannel(rootHash)
+	require.Nil(t, err)
+	numLeaves := 0
+	for range leavesChannel {
+		numLeaves++
+	}
+	assert.Equal(t, numAccounts, numLeaves)
+
+	for i, dataTrieRootHash := range dataTriesRootHashes {
+		dataTrie, errRecreate := accountsTrie.Recreate(dataTrieRootHash)
+		require.Nil(t, errRecreate)
+
+		value, errGet := dataTrie.Get([]byte(fmt.Sprintf("key%d", i*2)))
+		assert.Nil(t, errGet)
+		assert.Equal(t, []byte(fmt.Sprintf("value%d", i*2)), value)
+	}
+}
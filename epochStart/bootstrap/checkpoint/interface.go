@@ -0,0 +1,23 @@
+package checkpoint
+
+import "github.com/ElrondNetwork/elrond-go/common"
+
+// SnapshotWriter defines the operations of a component able to append trie nodes to a state snapshot
+type SnapshotWriter interface {
+	WriteTrieNode(trieType TrieType, serializedNode []byte) error
+	IsInterfaceNil() bool
+}
+
+// SnapshotReader defines the operations of a component able to read the trie nodes of a state snapshot
+type SnapshotReader interface {
+	Header() SnapshotHeader
+	ReadTrieNode() (TrieType, []byte, error)
+	IsInterfaceNil() bool
+}
+
+// StateExporter defines the operations of a component able to write the state tries to a snapshot
+type StateExporter interface {
+	ExportTrie(trieType TrieType, tr common.Trie, rootHash []byte) error
+	NumExportedNodes() int
+	IsInterfaceNil() bool
+}
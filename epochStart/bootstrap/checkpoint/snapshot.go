@@ -0,0 +1,254 @@
+package checkpoint
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+)
+
+// TrieType defines the state trie a snapshot trie node belongs to
+type TrieType byte
+
+const (
+	// UserAccountsTrie identifies the nodes of the user accounts trie and of the accounts data tries
+	UserAccountsTrie TrieType = 1
+	// PeerAccountsTrie identifies the nodes of the validators (peer accounts) trie
+	PeerAccountsTrie TrieType = 2
+)
+
+// snapshotMagic prefixes every state snapshot file
+var snapshotMagic = []byte("ERDSTATE")
+
+const snapshotVersion = uint32(1)
+const maxRecordSize = 64 * core.MegabyteSize
+const lengthPrefixSize = 4
+
+// SnapshotHeader identifies the state contained in a snapshot
+type SnapshotHeader struct {
+	ShardID                uint32
+	RootHash               []byte
+	ValidatorStatsRootHash []byte
+}
+
+// String returns the human readable form of the snapshot header
+func (sh *SnapshotHeader) String() string {
+	return fmt.Sprintf("shard %d, root hash %x, validator statistics root hash %x",
+		sh.ShardID, sh.RootHash, sh.ValidatorStatsRootHash)
+}
+
+type snapshotWriter struct {
+	writer *bufio.Writer
+}
+
+// NewSnapshotWriter creates a state snapshot writer and writes the provided header. The snapshot holds the header
+// followed by the serialized trie nodes, each prefixed by its trie type and its length
+func NewSnapshotWriter(w io.Writer, header SnapshotHeader) (*snapshotWriter, error) {
+	if w == nil {
+		return nil, ErrNilWriter
+	}
+
+	sw := &snapshotWriter{
+		writer: bufio.NewWriter(w),
+	}
+
+	_, err := sw.writer.Write(snapshotMagic)
+	if err != nil {
+		return nil, err
+	}
+
+	err = sw.writeUint32(snapshotVersion)
+	if err != nil {
+		return nil, err
+	}
+	err = sw.writeUint32(header.ShardID)
+	if err != nil {
+		return nil, err
+	}
+	err = sw.writeBuffer(header.RootHash)
+	if err != nil {
+		return nil, err
+	}
+	err = sw.writeBuffer(header.ValidatorStatsRootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return sw, nil
+}
+
+// WriteTrieNode appends a serialized trie node to the snapshot
+func (sw *snapshotWriter) WriteTrieNode(trieType TrieType, serializedNode []byte) error {
+	err := checkTrieType(trieType)
+	if err != nil {
+		return err
+	}
+	if len(serializedNode) == 0 {
+		return ErrEmptyTrieNode
+	}
+
+	err = sw.writer.WriteByte(byte(trieType))
+	if err != nil {
+		return err
+	}
+
+	return sw.writeBuffer(serializedNode)
+}
+
+// Flush writes the buffered trie nodes to the underlying writer
+func (sw *snapshotWriter) Flush() error {
+	return sw.writer.Flush()
+}
+
+func (sw *snapshotWriter) writeUint32(value uint32) error {
+	buff := make([]byte, lengthPrefixSize)
+	binary.BigEndian.PutUint32(buff, value)
+	_, err := sw.writer.Write(buff)
+
+	return err
+}
+
+func (sw *snapshotWriter) writeBuffer(buff []byte) error {
+	if len(buff) > maxRecordSize {
+		return ErrRecordTooLarge
+	}
+
+	err := sw.writeUint32(uint32(len(buff)))
+	if err != nil {
+		return err
+	}
+
+	_, err = sw.writer.Write(buff)
+
+	return err
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sw *snapshotWriter) IsInterfaceNil() bool {
+	return sw == nil
+}
+
+type snapshotReader struct {
+	reader *bufio.Reader
+	header SnapshotHeader
+}
+
+// NewSnapshotReader creates a state snapshot reader, reading and validating the snapshot header
+func NewSnapshotReader(r io.Reader) (*snapshotReader, error) {
+	if r == nil {
+		return nil, ErrNilReader
+	}
+
+	sr := &snapshotReader{
+		reader: bufio.NewReader(r),
+	}
+
+	magic := make([]byte, len(snapshotMagic))
+	_, err := io.ReadFull(sr.reader, magic)
+	if err != nil || !bytes.Equal(magic, snapshotMagic) {
+		return nil, ErrInvalidSnapshotFile
+	}
+
+	version, err := sr.readUint32()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSnapshotFile, err)
+	}
+	if version != snapshotVersion {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedSnapshotVersion, version)
+	}
+
+	sr.header.ShardID, err = sr.readUint32()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSnapshotFile, err)
+	}
+	sr.header.RootHash, err = sr.readBuffer()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSnapshotFile, err)
+	}
+	sr.header.ValidatorStatsRootHash, err = sr.readBuffer()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSnapshotFile, err)
+	}
+
+	return sr, nil
+}
+
+// Header returns the header of the snapshot
+func (sr *snapshotReader) Header() SnapshotHeader {
+	return sr.header
+}
+
+// ReadTrieNode returns the next trie node from the snapshot. It returns io.EOF after the last trie node
+func (sr *snapshotReader) ReadTrieNode() (TrieType, []byte, error) {
+	trieTypeByte, err := sr.reader.ReadByte()
+	if err != nil {
+		return 0, nil, err
+	}
+
+	trieType := TrieType(trieTypeByte)
+	err = checkTrieType(trieType)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	serializedNode, err := sr.readBuffer()
+	if err == io.EOF {
+		return 0, nil, io.ErrUnexpectedEOF
+	}
+	if err != nil {
+		return 0, nil, err
+	}
+	if len(serializedNode) == 0 {
+		return 0, nil, ErrEmptyTrieNode
+	}
+
+	return trieType, serializedNode, nil
+}
+
+func (sr *snapshotReader) readUint32() (uint32, error) {
+	buff := make([]byte, lengthPrefixSize)
+	_, err := io.ReadFull(sr.reader, buff)
+	if err != nil {
+		return 0, err
+	}
+
+	return binary.BigEndian.Uint32(buff), nil
+}
+
+func (sr *snapshotReader) readBuffer() ([]byte, error) {
+	length, err := sr.readUint32()
+	if err != nil {
+		return nil, err
+	}
+	if length > maxRecordSize {
+		return nil, ErrRecordTooLarge
+	}
+
+	buff := make([]byte, length)
+	_, err = io.ReadFull(sr.reader, buff)
+	if err == io.EOF {
+		return nil, io.ErrUnexpectedEOF
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return buff, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sr *snapshotReader) IsInterfaceNil() bool {
+	return sr == nil
+}
+
+func checkTrieType(trieType TrieType) error {
+	switch trieType {
+	case UserAccountsTrie, PeerAccountsTrie:
+		return nil
+	default:
+		return fmt.Errorf("%w: %d", ErrUnknownTrieType, trieType)
+	}
+}
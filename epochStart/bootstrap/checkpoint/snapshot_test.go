@@ -0,0 +1,123 @@
+package checkpoint
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createSnapshotHeader() SnapshotHeader {
+	return SnapshotHeader{
+		ShardID:                2,
+		RootHash:               []byte("root hash"),
+		ValidatorStatsRootHash: []byte("validator stats root hash"),
+	}
+}
+
+func TestNewSnapshotWriter_NilWriterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sw, err := NewSnapshotWriter(nil, createSnapshotHeader())
+	assert.Nil(t, sw)
+	assert.Equal(t, ErrNilWriter, err)
+}
+
+func TestNewSnapshotReader_NilReaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sr, err := NewSnapshotReader(nil)
+	assert.Nil(t, sr)
+	assert.Equal(t, ErrNilReader, err)
+}
+
+func TestSnapshotWriter_WriteTrieNodeInvalidDataShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sw, _ := NewSnapshotWriter(&bytes.Buffer{}, createSnapshotHeader())
+
+	err := sw.WriteTrieNode(TrieType(0), []byte("node"))
+	assert.True(t, errors.Is(err, ErrUnknownTrieType))
+
+	err = sw.WriteTrieNode(UserAccountsTrie, nil)
+	assert.Equal(t, ErrEmptyTrieNode, err)
+}
+
+func TestSnapshot_WriteAndReadShouldWork(t *testing.T) {
+	t.Parallel()
+
+	buff := &bytes.Buffer{}
+	header := createSnapshotHeader()
+	sw, err := NewSnapshotWriter(buff, header)
+	require.Nil(t, err)
+	assert.False(t, sw.IsInterfaceNil())
+
+	_ = sw.WriteTrieNode(UserAccountsTrie, []byte("user node"))
+	_ = sw.WriteTrieNode(PeerAccountsTrie, []byte("peer node"))
+	err = sw.Flush()
+	require.Nil(t, err)
+
+	sr, err := NewSnapshotReader(buff)
+	require.Nil(t, err)
+	assert.False(t, sr.IsInterfaceNil())
+	assert.Equal(t, header, sr.Header())
+
+	trieType, node, err := sr.ReadTrieNode()
+	assert.Nil(t, err)
+	assert.Equal(t, UserAccountsTrie, trieType)
+	assert.Equal(t, []byte("user node"), node)
+
+	trieType, node, err = sr.ReadTrieNode()
+	assert.Nil(t, err)
+	assert.Equal(t, PeerAccountsTrie, trieType)
+	assert.Equal(t, []byte("peer node"), node)
+
+	_, _, err = sr.ReadTrieNode()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestNewSnapshotReader_InvalidHeaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid magic", func(t *testing.T) {
+		sr, err := NewSnapshotReader(bytes.NewReader([]byte("NOTSTATE")))
+		assert.Nil(t, sr)
+		assert.Equal(t, ErrInvalidSnapshotFile, err)
+	})
+	t.Run("unsupported version", func(t *testing.T) {
+		buff := append([]byte{}, snapshotMagic...)
+		version := make([]byte, lengthPrefixSize)
+		binary.BigEndian.PutUint32(version, snapshotVersion+1)
+		buff = append(buff, version...)
+
+		sr, err := NewSnapshotReader(bytes.NewReader(buff))
+		assert.Nil(t, sr)
+		assert.True(t, errors.Is(err, ErrUnsupportedSnapshotVersion))
+	})
+	t.Run("truncated header", func(t *testing.T) {
+		buff := &bytes.Buffer{}
+		sw, _ := NewSnapshotWriter(buff, createSnapshotHeader())
+		_ = sw.Flush()
+
+		sr, err := NewSnapshotReader(bytes.NewReader(buff.Bytes()[:buff.Len()-1]))
+		assert.Nil(t, sr)
+		assert.True(t, errors.Is(err, ErrInvalidSnapshotFile))
+	})
+}
+
+func TestSnapshotReader_ReadTrieNodeTruncatedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	buff := &bytes.Buffer{}
+	sw, _ := NewSnapshotWriter(buff, createSnapshotHeader())
+	_ = sw.WriteTrieNode(UserAccountsTrie, []byte("user node"))
+	_ = sw.Flush()
+
+	sr, _ := NewSnapshotReader(bytes.NewReader(buff.Bytes()[:buff.Len()-1]))
+	_, _, err := sr.ReadTrieNode()
+	assert.Equal(t, io.ErrUnexpectedEOF, err)
+}
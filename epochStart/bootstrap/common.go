@@ -1,9 +1,11 @@
 package bootstrap
 
 import (
+	"encoding/hex"
 	"fmt"
 
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
 )
 
@@ -103,6 +105,21 @@ func checkArguments(args ArgsEpochStartBootstrap) error {
 	if args.GeneralConfig.TrieSync.NumConcurrentTrieSyncers < 1 {
 		return fmt.Errorf("%s: %w", baseErrorMessage, epochStart.ErrInvalidNumConcurrentTrieSyncers)
 	}
+	if args.GeneralConfig.TrustedCheckpoint.Enabled {
+		return checkTrustedCheckpointConfig(args.GeneralConfig.TrustedCheckpoint)
+	}
+
+	return nil
+}
+
+func checkTrustedCheckpointConfig(cfg config.TrustedCheckpointConfig) error {
+	hash, err := hex.DecodeString(cfg.EpochStartMetaBlockHash)
+	if err != nil || len(hash) == 0 {
+		return fmt.Errorf("%s: %w", baseErrorMessage, epochStart.ErrInvalidTrustedCheckpointHash)
+	}
+	if len(cfg.StateSnapshotFile) == 0 {
+		return fmt.Errorf("%s: %w", baseErrorMessage, epochStart.ErrEmptyStateSnapshotFile)
+	}
 
 	return nil
 }
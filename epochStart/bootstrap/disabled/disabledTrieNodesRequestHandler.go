@@ -0,0 +1,28 @@
+package disabled
+
+import "time"
+
+const requestInterval = time.Second
+
+type trieNodesRequestHandler struct {
+}
+
+// NewTrieNodesRequestHandler returns a new instance of a disabled trie nodes request handler, used when all the trie
+// nodes are expected to be already found in the local storage
+func NewTrieNodesRequestHandler() *trieNodesRequestHandler {
+	return &trieNodesRequestHandler{}
+}
+
+// RequestTrieNodes won't do anything
+func (t *trieNodesRequestHandler) RequestTrieNodes(_ uint32, _ [][]byte, _ string) {
+}
+
+// RequestInterval returns the default request interval
+func (t *trieNodesRequestHandler) RequestInterval() time.Duration {
+	return requestInterval
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (t *trieNodesRequestHandler) IsInterfaceNil() bool {
+	return t == nil
+}
@@ -331,18 +331,13 @@ func (e *epochStartBootstrap) Bootstrap() (Parameters, error) {
 		return Parameters{}, err
 	}
 
-	e.epochStartMeta, err = e.epochStartMetaBlockSyncer.SyncEpochStartMeta(DefaultTimeToWaitForRequestedData)
+	err = e.syncEpochStartMetaAndCreateSyncers()
 	if err != nil {
 		return Parameters{}, err
 	}
 	log.Debug("start in epoch bootstrap: got epoch start meta header", "epoch", e.epochStartMeta.Epoch, "nonce", e.epochStartMeta.Nonce)
 	e.setEpochStartMetrics()
 
-	err = e.createSyncers()
-	if err != nil {
-		return Parameters{}, err
-	}
-
 	defer func() {
 		errClose := e.interceptorContainer.Close()
 		if errClose != nil {
@@ -358,6 +353,27 @@ func (e *epochStartBootstrap) Bootstrap() (Parameters, error) {
 	return params, nil
 }
 
+func (e *epochStartBootstrap) syncEpochStartMetaAndCreateSyncers() error {
+	var err error
+	if e.generalConfig.TrustedCheckpoint.Enabled {
+		// the trusted epoch start meta block is requested by hash, through the headers syncer
+		err = e.createSyncers()
+		if err != nil {
+			return err
+		}
+
+		e.epochStartMeta, err = e.syncTrustedEpochStartMeta()
+		return err
+	}
+
+	e.epochStartMeta, err = e.epochStartMetaBlockSyncer.SyncEpochStartMeta(DefaultTimeToWaitForRequestedData)
+	if err != nil {
+		return err
+	}
+
+	return e.createSyncers()
+}
+
 func (e *epochStartBootstrap) bootstrapFromLocalStorage() (Parameters, error) {
 	log.Warn("fast bootstrap is disabled")
 
@@ -700,6 +716,13 @@ func (e *epochStartBootstrap) processNodesConfig(pubKey []byte) error {
 func (e *epochStartBootstrap) requestAndProcessForMeta() error {
 	var err error
 
+	if e.generalConfig.TrustedCheckpoint.Enabled {
+		err = e.importTrustedCheckpointState(e.epochStartMeta.RootHash, e.epochStartMeta.ValidatorStatsRootHash)
+		if err != nil {
+			return err
+		}
+	}
+
 	log.Debug("start in epoch bootstrap: started syncValidatorAccountsState")
 	err = e.syncValidatorAccountsState(e.epochStartMeta.ValidatorStatsRootHash)
 	if err != nil {
@@ -804,6 +827,13 @@ func (e *epochStartBootstrap) requestAndProcessForShard() error {
 		return epochStart.ErrWrongTypeAssertion
 	}
 
+	if e.generalConfig.TrustedCheckpoint.Enabled {
+		err = e.importTrustedCheckpointState(ownShardHdr.RootHash, nil)
+		if err != nil {
+			return err
+		}
+	}
+
 	log.Debug("start in epoch bootstrap: started syncUserAccountsState")
 	err = e.syncUserAccountsState(ownShardHdr.RootHash)
 	if err != nil {
@@ -854,13 +884,15 @@ func (e *epochStartBootstrap) syncUserAccountsState(rootHash []byte) error {
 	trieStorageManager := e.trieStorageManagers[factory.UserAccountTrie]
 	e.mutTrieStorageManagers.RUnlock()
 
+	requestHandler, timeout := e.trieNodesRequestArgs()
+
 	argsUserAccountsSyncer := syncer.ArgsNewUserAccountsSyncer{
 		ArgsNewBaseAccountsSyncer: syncer.ArgsNewBaseAccountsSyncer{
 			Hasher:                    e.coreComponentsHolder.Hasher(),
 			Marshalizer:               e.coreComponentsHolder.InternalMarshalizer(),
 			TrieStorageManager:        trieStorageManager,
-			RequestHandler:            e.requestHandler,
-			Timeout:                   common.TimeoutGettingTrieNodes,
+			RequestHandler:            requestHandler,
+			Timeout:                   timeout,
 			Cacher:                    e.dataPool.TrieNodes(),
 			MaxTrieLevelInMemory:      e.generalConfig.StateTriesConfig.MaxStateTrieLevelInMemory,
 			MaxHardCapForMissingNodes: e.maxHardCapForMissingNodes,
@@ -876,7 +908,7 @@ func (e *epochStartBootstrap) syncUserAccountsState(rootHash []byte) error {
 
 	err = accountsDBSyncer.SyncAccounts(rootHash)
 	if err != nil {
-		return err
+		return e.trieSyncError(err)
 	}
 
 	return nil
@@ -952,13 +984,15 @@ func (e *epochStartBootstrap) syncValidatorAccountsState(rootHash []byte) error
 	peerTrieStorageManager := e.trieStorageManagers[factory.PeerAccountTrie]
 	e.mutTrieStorageManagers.RUnlock()
 
+	requestHandler, timeout := e.trieNodesRequestArgs()
+
 	argsValidatorAccountsSyncer := syncer.ArgsNewValidatorAccountsSyncer{
 		ArgsNewBaseAccountsSyncer: syncer.ArgsNewBaseAccountsSyncer{
 			Hasher:                    e.coreComponentsHolder.Hasher(),
 			Marshalizer:               e.coreComponentsHolder.InternalMarshalizer(),
 			TrieStorageManager:        peerTrieStorageManager,
-			RequestHandler:            e.requestHandler,
-			Timeout:                   common.TimeoutGettingTrieNodes,
+			RequestHandler:            requestHandler,
+			Timeout:                   timeout,
 			Cacher:                    e.dataPool.TrieNodes(),
 			MaxTrieLevelInMemory:      e.generalConfig.StateTriesConfig.MaxPeerTrieLevelInMemory,
 			MaxHardCapForMissingNodes: e.maxHardCapForMissingNodes,
@@ -972,7 +1006,7 @@ func (e *epochStartBootstrap) syncValidatorAccountsState(rootHash []byte) error
 
 	err = accountsDBSyncer.SyncAccounts(rootHash)
 	if err != nil {
-		return err
+		return e.trieSyncError(err)
 	}
 
 	return nil
@@ -0,0 +1,163 @@
+package bootstrap
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/epochStart/bootstrap/checkpoint"
+	"github.com/ElrondNetwork/elrond-go/epochStart/bootstrap/disabled"
+	"github.com/ElrondNetwork/elrond-go/trie"
+	"github.com/ElrondNetwork/elrond-go/trie/factory"
+)
+
+// timeToWaitForLocalTrieNodes is the time the accounts syncers wait for a missing trie node when starting from a
+// trusted checkpoint. All the trie nodes are expected to be found in the local storage, so a missing node means
+// that the state snapshot is incomplete
+const timeToWaitForLocalTrieNodes = 10 * time.Second
+
+// syncTrustedEpochStartMeta requests the epoch start meta block with the configured hash. The meta block is accepted
+// only if it hashes to the trusted value, so there is no need to wait for the confirmation from the connected peers
+func (e *epochStartBootstrap) syncTrustedEpochStartMeta() (*block.MetaBlock, error) {
+	trustedHash, err := hex.DecodeString(e.generalConfig.TrustedCheckpoint.EpochStartMetaBlockHash)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", epochStart.ErrInvalidTrustedCheckpointHash, err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultTimeToWaitForRequestedData)
+	err = e.headersSyncer.SyncMissingHeadersByHash([]uint32{core.MetachainShardId}, [][]byte{trustedHash}, ctx)
+	cancel()
+	if err != nil {
+		return nil, err
+	}
+
+	syncedHeaders, err := e.headersSyncer.GetHeaders()
+	if err != nil {
+		return nil, err
+	}
+	e.headersSyncer.ClearFields()
+
+	metaBlock, ok := syncedHeaders[string(trustedHash)].(*block.MetaBlock)
+	if !ok {
+		return nil, epochStart.ErrWrongTypeAssertion
+	}
+	if !metaBlock.IsStartOfEpochBlock() {
+		return nil, fmt.Errorf("%w: meta block %x is not an epoch start block",
+			epochStart.ErrTrustedCheckpointMismatch, trustedHash)
+	}
+
+	computedHash, err := core.CalculateHash(e.coreComponentsHolder.InternalMarshalizer(), e.coreComponentsHolder.Hasher(), metaBlock)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(computedHash, trustedHash) {
+		return nil, fmt.Errorf("%w: received meta block hash %x, trusted hash %x",
+			epochStart.ErrTrustedCheckpointMismatch, computedHash, trustedHash)
+	}
+
+	return metaBlock, nil
+}
+
+// importTrustedCheckpointState copies the trie nodes from the configured state snapshot into the tries storage. The
+// accounts syncers will afterwards walk the tries from the provided root hashes, proving that the imported state is
+// complete and matches the epoch start headers
+func (e *epochStartBootstrap) importTrustedCheckpointState(rootHash []byte, validatorStatsRootHash []byte) error {
+	snapshotFile := e.generalConfig.TrustedCheckpoint.StateSnapshotFile
+	file, err := os.Open(snapshotFile)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		errClose := file.Close()
+		if errClose != nil {
+			log.Warn("error closing state snapshot file", "file", snapshotFile, "error", errClose)
+		}
+	}()
+
+	reader, err := checkpoint.NewSnapshotReader(file)
+	if err != nil {
+		return err
+	}
+
+	selfShardID := e.shardCoordinator.SelfId()
+	err = checkSnapshotHeader(reader.Header(), selfShardID, rootHash, validatorStatsRootHash)
+	if err != nil {
+		return err
+	}
+
+	e.mutTrieStorageManagers.RLock()
+	trieStorages := map[checkpoint.TrieType]common.DBWriteCacher{
+		checkpoint.UserAccountsTrie: e.trieStorageManagers[factory.UserAccountTrie].Database(),
+	}
+	if selfShardID == core.MetachainShardId {
+		trieStorages[checkpoint.PeerAccountsTrie] = e.trieStorageManagers[factory.PeerAccountTrie].Database()
+	}
+	e.mutTrieStorageManagers.RUnlock()
+
+	argsStateImporter := checkpoint.ArgsStateImporter{
+		Reader:       reader,
+		Hasher:       e.coreComponentsHolder.Hasher(),
+		TrieStorages: trieStorages,
+	}
+	stateImporter, err := checkpoint.NewStateImporter(argsStateImporter)
+	if err != nil {
+		return err
+	}
+
+	numImportedNodes, err := stateImporter.ImportTrieNodes()
+	if err != nil {
+		return err
+	}
+
+	log.Info("start in epoch bootstrap: imported trusted checkpoint state",
+		"file", snapshotFile,
+		"num trie nodes", numImportedNodes,
+	)
+
+	return nil
+}
+
+func checkSnapshotHeader(header checkpoint.SnapshotHeader, shardID uint32, rootHash []byte, validatorStatsRootHash []byte) error {
+	if header.ShardID != shardID {
+		return fmt.Errorf("%w: state snapshot for shard %d, node in shard %d",
+			epochStart.ErrTrustedCheckpointMismatch, header.ShardID, shardID)
+	}
+	if !bytes.Equal(header.RootHash, rootHash) {
+		return fmt.Errorf("%w: state snapshot root hash %x, epoch start root hash %x",
+			epochStart.ErrTrustedCheckpointMismatch, header.RootHash, rootHash)
+	}
+	if shardID != core.MetachainShardId {
+		return nil
+	}
+	if !bytes.Equal(header.ValidatorStatsRootHash, validatorStatsRootHash) {
+		return fmt.Errorf("%w: state snapshot validator statistics root hash %x, epoch start validator statistics root hash %x",
+			epochStart.ErrTrustedCheckpointMismatch, header.ValidatorStatsRootHash, validatorStatsRootHash)
+	}
+
+	return nil
+}
+
+// trieNodesRequestArgs returns the request handler and the timeout used by the accounts syncers. When starting from a
+// trusted checkpoint, the trie nodes are not requested from the network
+func (e *epochStartBootstrap) trieNodesRequestArgs() (trie.RequestHandler, time.Duration) {
+	if e.generalConfig.TrustedCheckpoint.Enabled {
+		return disabled.NewTrieNodesRequestHandler(), timeToWaitForLocalTrieNodes
+	}
+
+	return e.requestHandler, common.TimeoutGettingTrieNodes
+}
+
+func (e *epochStartBootstrap) trieSyncError(err error) error {
+	if !e.generalConfig.TrustedCheckpoint.Enabled {
+		return err
+	}
+
+	return fmt.Errorf("%w: %v", epochStart.ErrIncompleteCheckpointState, err)
+}
@@ -0,0 +1,107 @@
+package bootstrap
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/epochStart/bootstrap/checkpoint"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewEpochStartBootstrap_InvalidTrustedCheckpointShouldErr(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid meta block hash", func(t *testing.T) {
+		coreComp, cryptoComp := createComponentsForEpochStart()
+		args := createMockEpochStartBootstrapArgs(coreComp, cryptoComp)
+		args.GeneralConfig.TrustedCheckpoint.Enabled = true
+		args.GeneralConfig.TrustedCheckpoint.EpochStartMetaBlockHash = "not hex"
+		args.GeneralConfig.TrustedCheckpoint.StateSnapshotFile = "state.snapshot"
+
+		epochStartProvider, err := NewEpochStartBootstrap(args)
+		assert.Nil(t, epochStartProvider)
+		assert.True(t, errors.Is(err, epochStart.ErrInvalidTrustedCheckpointHash))
+	})
+	t.Run("empty meta block hash", func(t *testing.T) {
+		coreComp, cryptoComp := createComponentsForEpochStart()
+		args := createMockEpochStartBootstrapArgs(coreComp, cryptoComp)
+		args.GeneralConfig.TrustedCheckpoint.Enabled = true
+		args.GeneralConfig.TrustedCheckpoint.StateSnapshotFile = "state.snapshot"
+
+		epochStartProvider, err := NewEpochStartBootstrap(args)
+		assert.Nil(t, epochStartProvider)
+		assert.True(t, errors.Is(err, epochStart.ErrInvalidTrustedCheckpointHash))
+	})
+	t.Run("empty state snapshot file", func(t *testing.T) {
+		coreComp, cryptoComp := createComponentsForEpochStart()
+		args := createMockEpochStartBootstrapArgs(coreComp, cryptoComp)
+		args.GeneralConfig.TrustedCheckpoint.Enabled = true
+		args.GeneralConfig.TrustedCheckpoint.EpochStartMetaBlockHash = "abcd"
+
+		epochStartProvider, err := NewEpochStartBootstrap(args)
+		assert.Nil(t, epochStartProvider)
+		assert.True(t, errors.Is(err, epochStart.ErrEmptyStateSnapshotFile))
+	})
+	t.Run("disabled trusted checkpoint is not checked", func(t *testing.T) {
+		coreComp, cryptoComp := createComponentsForEpochStart()
+		args := createMockEpochStartBootstrapArgs(coreComp, cryptoComp)
+		args.GeneralConfig.TrustedCheckpoint.EpochStartMetaBlockHash = "not hex"
+
+		epochStartProvider, err := NewEpochStartBootstrap(args)
+		assert.Nil(t, err)
+		assert.NotNil(t, epochStartProvider)
+	})
+}
+
+func TestCheckSnapshotHeader(t *testing.T) {
+	t.Parallel()
+
+	rootHash := []byte("root hash")
+	validatorStatsRootHash := []byte("validator stats root hash")
+
+	header := checkpoint.SnapshotHeader{
+		ShardID:  1,
+		RootHash: rootHash,
+	}
+	assert.Nil(t, checkSnapshotHeader(header, 1, rootHash, nil))
+
+	err := checkSnapshotHeader(header, 0, rootHash, nil)
+	assert.True(t, errors.Is(err, epochStart.ErrTrustedCheckpointMismatch))
+
+	err = checkSnapshotHeader(header, 1, []byte("other root hash"), nil)
+	assert.True(t, errors.Is(err, epochStart.ErrTrustedCheckpointMismatch))
+
+	header = checkpoint.SnapshotHeader{
+		ShardID:                core.MetachainShardId,
+		RootHash:               rootHash,
+		ValidatorStatsRootHash: validatorStatsRootHash,
+	}
+	assert.Nil(t, checkSnapshotHeader(header, core.MetachainShardId, rootHash, validatorStatsRootHash))
+
+	err = checkSnapshotHeader(header, core.MetachainShardId, rootHash, []byte("other root hash"))
+	assert.True(t, errors.Is(err, epochStart.ErrTrustedCheckpointMismatch))
+}
+
+func TestEpochStartBootstrap_TrieNodesRequestArgs(t *testing.T) {
+	t.Parallel()
+
+	coreComp, cryptoComp := createComponentsForEpochStart()
+	args := createMockEpochStartBootstrapArgs(coreComp, cryptoComp)
+	epochStartProvider, _ := NewEpochStartBootstrap(args)
+	_ = epochStartProvider.createRequestHandler()
+
+	requestHandler, timeout := epochStartProvider.trieNodesRequestArgs()
+	assert.Equal(t, epochStartProvider.requestHandler, requestHandler)
+	assert.Equal(t, common.TimeoutGettingTrieNodes, timeout)
+	err := errors.New("sync error")
+	assert.Equal(t, err, epochStartProvider.trieSyncError(err))
+
+	epochStartProvider.generalConfig.TrustedCheckpoint.Enabled = true
+	requestHandler, timeout = epochStartProvider.trieNodesRequestArgs()
+	assert.NotEqual(t, epochStartProvider.requestHandler, requestHandler)
+	assert.Equal(t, timeToWaitForLocalTrieNodes, timeout)
+	assert.True(t, errors.Is(epochStartProvider.trieSyncError(err), epochStart.ErrIncompleteCheckpointState))
+}
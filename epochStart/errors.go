@@ -316,3 +316,15 @@ var ErrEmptyESDTOwnerAddress = errors.New("empty ESDT owner address")
 
 // ErrNilCurrentNetworkEpochSetter signals that a nil current network epoch setter has been provided
 var ErrNilCurrentNetworkEpochSetter = errors.New("nil current network epoch setter")
+
+// ErrInvalidTrustedCheckpointHash signals that an invalid trusted checkpoint epoch start meta block hash was provided
+var ErrInvalidTrustedCheckpointHash = errors.New("invalid trusted checkpoint epoch start meta block hash")
+
+// ErrEmptyStateSnapshotFile signals that the trusted checkpoint state snapshot file was not provided
+var ErrEmptyStateSnapshotFile = errors.New("empty trusted checkpoint state snapshot file")
+
+// ErrTrustedCheckpointMismatch signals that the trusted checkpoint data does not match the epoch start data
+var ErrTrustedCheckpointMismatch = errors.New("trusted checkpoint mismatch")
+
+// ErrIncompleteCheckpointState signals that the state snapshot does not hold all the trie nodes of the trusted checkpoint
+var ErrIncompleteCheckpointState = errors.New("incomplete trusted checkpoint state")
@@ -7,6 +7,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go/common/governance"
 	"github.com/ElrondNetwork/elrond-go/state"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
@@ -68,6 +69,12 @@ type ActionHandler interface {
 	NotifyOrder() uint32
 }
 
+// GovernedConfigNotifier defines the component able to propagate the configuration changes activated through governance
+type GovernedConfigNotifier interface {
+	SetGovernedConfig(governedConfig *governance.GovernedConfig)
+	IsInterfaceNil() bool
+}
+
 // RegistrationHandler provides Register and Unregister functionality for the end of epoch events
 type RegistrationHandler interface {
 	RegisterHandler(handler ActionHandler)
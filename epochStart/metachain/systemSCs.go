@@ -71,6 +71,7 @@ type systemSCProcessor struct {
 	esdtEnableEpoch                uint32
 	saveJailedAlwaysEnableEpoch    uint32
 	governanceEnableEpoch          uint32
+	governedConfigEnableEpoch      uint32
 	maxNodesEnableConfig           []config.MaxNodesChangeConfig
 	maxNodes                       uint32
 	flagSwitchJailedWaiting        atomic.Flag
@@ -84,6 +85,8 @@ type systemSCProcessor struct {
 	flagESDTEnabled                atomic.Flag
 	flagSaveJailedAlwaysEnabled    atomic.Flag
 	flagGovernanceEnabled          atomic.Flag
+	flagGovernedConfigEnabled      atomic.Flag
+	governedConfigData             []byte
 	esdtOwnerAddressBytes          []byte
 	mapNumSwitchedPerShard         map[uint32]uint32
 	mapNumSwitchablePerShard       map[uint32]uint32
@@ -179,6 +182,7 @@ func NewSystemSCProcessor(args ArgsNewEpochStartSystemSCProcessing) (*systemSCPr
 		esdtOwnerAddressBytes:       args.ESDTOwnerAddressBytes,
 		saveJailedAlwaysEnableEpoch: args.EpochConfig.EnableEpochs.SaveJailedAlwaysEnableEpoch,
 		governanceEnableEpoch:       args.EpochConfig.EnableEpochs.GovernanceEnableEpoch,
+		governedConfigEnableEpoch:   args.EpochConfig.EnableEpochs.GovernedConfigEnableEpoch,
 	}
 
 	log.Debug("systemSC: enable epoch for switch jail waiting", "epoch", s.switchEnableEpoch)
@@ -189,6 +193,7 @@ func NewSystemSCProcessor(args ArgsNewEpochStartSystemSCProcessing) (*systemSCPr
 	log.Debug("systemSC: enable epoch for correct last unjailed", "epoch", s.correctLastUnJailEpoch)
 	log.Debug("systemSC: enable epoch for save jailed always", "epoch", s.saveJailedAlwaysEnableEpoch)
 	log.Debug("systemSC: enable epoch for governanceV2 init", "epoch", s.governanceEnableEpoch)
+	log.Debug("systemSC: enable epoch for governed config", "epoch", s.governedConfigEnableEpoch)
 
 	s.maxNodesEnableConfig = make([]config.MaxNodesChangeConfig, len(args.MaxNodesEnableConfig))
 	copy(s.maxNodesEnableConfig, args.MaxNodesEnableConfig)
@@ -297,9 +302,23 @@ func (s *systemSCProcessor) ProcessSystemSmartContract(
 		}
 	}
 
+	s.governedConfigData = nil
+	if s.flagGovernedConfigEnabled.IsSet() {
+		err := s.activateGovernedConfigChanges()
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// GovernedConfigData returns the marshalled governed config computed by the last ProcessSystemSmartContract call.
+// It will be saved in the epoch start meta block so that all shards will apply the same configuration changes
+func (s *systemSCProcessor) GovernedConfigData() []byte {
+	return s.governedConfigData
+}
+
 // ToggleUnStakeUnBond will pause/unPause the unStake/unBond functions on the validator system sc
 func (s *systemSCProcessor) ToggleUnStakeUnBond(value bool) error {
 	if !s.flagStakingV2Enabled.IsSet() {
@@ -1101,6 +1120,36 @@ func (s *systemSCProcessor) updateToGovernanceV2() error {
 	return nil
 }
 
+func (s *systemSCProcessor) activateGovernedConfigChanges() error {
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr: s.endOfEpochCallerAddress,
+			CallValue:  big.NewInt(0),
+			Arguments:  [][]byte{},
+		},
+		RecipientAddr: vm.GovernanceSCAddress,
+		Function:      "activateConfigChanges",
+	}
+	vmOutput, errRun := s.systemVM.RunSmartContractCall(vmInput)
+	if errRun != nil {
+		return fmt.Errorf("%w when activating the governed config changes", errRun)
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return fmt.Errorf("got return code %s when activating the governed config changes", vmOutput.ReturnCode)
+	}
+
+	err := s.processSCOutputAccounts(vmOutput)
+	if err != nil {
+		return err
+	}
+
+	if len(vmOutput.ReturnData) > 0 {
+		s.governedConfigData = vmOutput.ReturnData[0]
+	}
+
+	return nil
+}
+
 func (s *systemSCProcessor) getValidatorSystemAccount() (state.UserAccountHandler, error) {
 	validatorAccount, err := s.userAccountsDB.LoadAccount(vm.ValidatorSCAddress)
 	if err != nil {
@@ -1494,4 +1543,7 @@ func (s *systemSCProcessor) EpochConfirmed(epoch uint32, _ uint64) {
 
 	s.flagGovernanceEnabled.Toggle(epoch == s.governanceEnableEpoch)
 	log.Debug("systemProcessor: governanceV2", "enabled", s.flagGovernanceEnabled.IsSet())
+
+	s.flagGovernedConfigEnabled.Toggle(epoch >= s.governedConfigEnableEpoch && epoch >= s.governanceEnableEpoch)
+	log.Debug("systemProcessor: governed config", "enabled", s.flagGovernedConfigEnabled.IsSet())
 }
@@ -22,6 +22,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/common/forking"
+	"github.com/ElrondNetwork/elrond-go/common/governance"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/blockchain"
@@ -913,6 +914,7 @@ func createFullArgumentsForSystemSCProcessing(stakingV2EnableEpoch uint32, trieS
 		DataPool:           testDataPool,
 		CompiledSCPool:     testDataPool.SmartContracts(),
 		NilCompiledSCStore: true,
+		VMTracer:           &testscommon.VMTracerStub{},
 	}
 
 	gasSchedule := arwenConfig.MakeGasMapForTests()
@@ -1871,3 +1873,45 @@ func TestSystemSCProcessor_ProcessSystemSmartContractJailAndUnStake(t *testing.T
 		assert.Equal(t, peerAcc.GetList(), string(common.LeavingList))
 	}
 }
+
+func TestSystemSCProcessor_ProcessSystemSmartContractShouldActivateGovernedConfigChanges(t *testing.T) {
+	t.Parallel()
+
+	args, _ := createFullArgumentsForSystemSCProcessing(0, createMemUnit())
+	args.EpochConfig.EnableEpochs.GovernedConfigEnableEpoch = 1
+	args.EpochNotifier.CheckEpoch(&block.MetaBlock{Epoch: 1})
+	s, _ := NewSystemSCProcessor(args)
+
+	err := s.ProcessSystemSmartContract(nil, 1, 1)
+	require.Nil(t, err)
+	assert.Nil(t, s.GovernedConfigData())
+
+	pendingConfig := &governance.GovernedConfig{
+		Changes: []*governance.GovernedConfigChange{
+			{Name: "FeeSettings.MinGasPrice", Value: "2000"},
+		},
+	}
+	marshaledData, _ := args.Marshalizer.Marshal(pendingConfig)
+	governanceAcc := loadSCAccount(args.UserAccountsDB, vm.GovernanceSCAddress)
+	_ = governanceAcc.DataTrieTracker().SaveKeyValue([]byte("pendingConfigChanges"), marshaledData)
+	_ = args.UserAccountsDB.SaveAccount(governanceAcc)
+
+	err = s.ProcessSystemSmartContract(nil, 1, 1)
+	require.Nil(t, err)
+
+	governedConfig := &governance.GovernedConfig{}
+	err = args.Marshalizer.Unmarshal(governedConfig, s.GovernedConfigData())
+	require.Nil(t, err)
+	// no header was set on the blockchain hook so the changes are activated starting with epoch 1
+	expectedConfig := &governance.GovernedConfig{
+		Changes: []*governance.GovernedConfigChange{
+			{Name: "FeeSettings.MinGasPrice", Value: "2000", EnableEpoch: 1},
+		},
+	}
+	assert.Equal(t, expectedConfig, governedConfig)
+
+	s.EpochConfirmed(0, 0)
+	err = s.ProcessSystemSmartContract(nil, 1, 0)
+	require.Nil(t, err)
+	assert.Nil(t, s.GovernedConfigData())
+}
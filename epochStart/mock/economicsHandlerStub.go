@@ -33,6 +33,10 @@ type EconomicsHandlerStub struct {
 	RewardsTopUpGradientPointCalled              func() *big.Int
 	RewardsTopUpFactorCalled                     func() float64
 	ComputeGasLimitBasedOnBalanceCalled          func(tx data.TransactionWithFeeHandler, balance *big.Int) (uint64, error)
+	IsDynamicMinGasPriceEnabledCalled            func() bool
+	ComputeDynamicMinGasPriceCalled              func(previousPrice uint64, gasUsed uint64) uint64
+	SetDynamicMinGasPriceCalled                  func(price uint64)
+	DynamicMinGasPriceCalled                     func() uint64
 }
 
 // ComputeGasLimitBasedOnBalance -
@@ -221,6 +225,37 @@ func (fhs *EconomicsHandlerStub) RewardsTopUpFactor() float64 {
 	return 0
 }
 
+// IsDynamicMinGasPriceEnabled -
+func (fhs *EconomicsHandlerStub) IsDynamicMinGasPriceEnabled() bool {
+	if fhs.IsDynamicMinGasPriceEnabledCalled != nil {
+		return fhs.IsDynamicMinGasPriceEnabledCalled()
+	}
+	return false
+}
+
+// ComputeDynamicMinGasPrice -
+func (fhs *EconomicsHandlerStub) ComputeDynamicMinGasPrice(previousPrice uint64, gasUsed uint64) uint64 {
+	if fhs.ComputeDynamicMinGasPriceCalled != nil {
+		return fhs.ComputeDynamicMinGasPriceCalled(previousPrice, gasUsed)
+	}
+	return 0
+}
+
+// SetDynamicMinGasPrice -
+func (fhs *EconomicsHandlerStub) SetDynamicMinGasPrice(price uint64) {
+	if fhs.SetDynamicMinGasPriceCalled != nil {
+		fhs.SetDynamicMinGasPriceCalled(price)
+	}
+}
+
+// DynamicMinGasPrice -
+func (fhs *EconomicsHandlerStub) DynamicMinGasPrice() uint64 {
+	if fhs.DynamicMinGasPriceCalled != nil {
+		return fhs.DynamicMinGasPriceCalled()
+	}
+	return 0
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (fhs *EconomicsHandlerStub) IsInterfaceNil() bool {
 	return fhs == nil
@@ -3,6 +3,7 @@ package mock
 import (
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go/common/governance"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
@@ -13,6 +14,7 @@ type EpochNotifierStub struct {
 	CheckEpochCalled            func(header data.HeaderHandler)
 	CurrentEpochCalled          func() uint32
 	RegisterNotifyHandlerCalled func(handler vmcommon.EpochSubscriberHandler)
+	SetGovernedConfigCalled     func(governedConfig *governance.GovernedConfig)
 	RegisterHandlerCalled       func(handler epochStart.ActionHandler)
 }
 
@@ -57,6 +59,13 @@ func (ens *EpochNotifierStub) CurrentEpoch() uint32 {
 	return 0
 }
 
+// SetGovernedConfig -
+func (ens *EpochNotifierStub) SetGovernedConfig(governedConfig *governance.GovernedConfig) {
+	if ens.SetGovernedConfigCalled != nil {
+		ens.SetGovernedConfigCalled(governedConfig)
+	}
+}
+
 // IsInterfaceNil -
 func (ens *EpochNotifierStub) IsInterfaceNil() bool {
 	return ens == nil
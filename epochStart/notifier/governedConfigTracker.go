@@ -0,0 +1,114 @@
+package notifier
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/common/governance"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var _ epochStart.ActionHandler = (*governedConfigTracker)(nil)
+
+// ArgsGovernedConfigTracker holds the arguments needed to create a governed config tracker
+type ArgsGovernedConfigTracker struct {
+	Marshalizer          marshal.Marshalizer
+	EpochNotifier        epochStart.GovernedConfigNotifier
+	EpochStartMetaStorer storage.Storer
+}
+
+// governedConfigTracker reads the configuration changes activated through governance from the epoch start meta blocks
+// and propagates them to the epoch notifier subscribers
+type governedConfigTracker struct {
+	marshalizer          marshal.Marshalizer
+	epochNotifier        epochStart.GovernedConfigNotifier
+	epochStartMetaStorer storage.Storer
+}
+
+// NewGovernedConfigTracker creates a new governed config tracker instance
+func NewGovernedConfigTracker(args ArgsGovernedConfigTracker) (*governedConfigTracker, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, epochStart.ErrNilMarshalizer
+	}
+	if check.IfNil(args.EpochNotifier) {
+		return nil, epochStart.ErrNilEpochNotifier
+	}
+	if check.IfNil(args.EpochStartMetaStorer) {
+		return nil, epochStart.ErrNilMetaBlockStorage
+	}
+
+	return &governedConfigTracker{
+		marshalizer:          args.Marshalizer,
+		epochNotifier:        args.EpochNotifier,
+		epochStartMetaStorer: args.EpochStartMetaStorer,
+	}, nil
+}
+
+// LoadEpochStartMetaBlock applies the governed config held by the stored epoch start meta block of the provided epoch.
+// Should be called once, when the node starts from an already synchronized epoch
+func (gct *governedConfigTracker) LoadEpochStartMetaBlock(epoch uint32) error {
+	epochStartIdentifier := core.EpochStartIdentifier(epoch)
+	metaBlockBytes, err := gct.epochStartMetaStorer.SearchFirst([]byte(epochStartIdentifier))
+	if err != nil {
+		return err
+	}
+
+	metaBlock := &block.MetaBlock{}
+	err = gct.marshalizer.Unmarshal(metaBlock, metaBlockBytes)
+	if err != nil {
+		return err
+	}
+
+	return gct.applyGovernedConfig(metaBlock)
+}
+
+// EpochStartPrepare applies the governed config held by the epoch start meta block
+func (gct *governedConfigTracker) EpochStartPrepare(metaHdr data.HeaderHandler, _ data.BodyHandler) {
+	if check.IfNil(metaHdr) {
+		return
+	}
+
+	err := gct.applyGovernedConfig(metaHdr)
+	if err != nil {
+		log.Warn("governedConfigTracker.EpochStartPrepare", "epoch", metaHdr.GetEpoch(), "error", err)
+	}
+}
+
+func (gct *governedConfigTracker) applyGovernedConfig(metaHdr data.HeaderHandler) error {
+	governedConfigData := metaHdr.GetReserved()
+	if len(governedConfigData) == 0 {
+		return nil
+	}
+
+	governedConfig := &governance.GovernedConfig{}
+	err := gct.marshalizer.Unmarshal(governedConfig, governedConfigData)
+	if err != nil {
+		return err
+	}
+
+	log.Debug("governedConfigTracker: applying governed config",
+		"epoch", metaHdr.GetEpoch(),
+		"num changes", len(governedConfig.Changes),
+	)
+	gct.epochNotifier.SetGovernedConfig(governedConfig)
+
+	return nil
+}
+
+// EpochStartAction does nothing as the governed config is applied when preparing for the new epoch
+func (gct *governedConfigTracker) EpochStartAction(_ data.HeaderHandler) {
+}
+
+// NotifyOrder returns the notification order for a start of epoch event
+func (gct *governedConfigTracker) NotifyOrder() uint32 {
+	return common.GovernedConfigOrder
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gct *governedConfigTracker) IsInterfaceNil() bool {
+	return gct == nil
+}
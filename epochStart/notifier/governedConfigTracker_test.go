@@ -0,0 +1,128 @@
+package notifier_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/common/governance"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/epochStart/mock"
+	"github.com/ElrondNetwork/elrond-go/epochStart/notifier"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsGovernedConfigTracker() notifier.ArgsGovernedConfigTracker {
+	return notifier.ArgsGovernedConfigTracker{
+		Marshalizer:          &mock.MarshalizerMock{},
+		EpochNotifier:        &mock.EpochNotifierStub{},
+		EpochStartMetaStorer: &testscommon.StorerStub{},
+	}
+}
+
+func createGovernedConfig() *governance.GovernedConfig {
+	return &governance.GovernedConfig{
+		Changes: []*governance.GovernedConfigChange{
+			{Name: "FeeSettings.MinGasPrice", Value: "2000", EnableEpoch: 4},
+		},
+	}
+}
+
+func TestNewGovernedConfigTracker(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsGovernedConfigTracker()
+	args.Marshalizer = nil
+	gct, err := notifier.NewGovernedConfigTracker(args)
+	assert.True(t, check.IfNil(gct))
+	assert.Equal(t, epochStart.ErrNilMarshalizer, err)
+
+	args = createMockArgsGovernedConfigTracker()
+	args.EpochNotifier = nil
+	gct, err = notifier.NewGovernedConfigTracker(args)
+	assert.True(t, check.IfNil(gct))
+	assert.Equal(t, epochStart.ErrNilEpochNotifier, err)
+
+	args = createMockArgsGovernedConfigTracker()
+	args.EpochStartMetaStorer = nil
+	gct, err = notifier.NewGovernedConfigTracker(args)
+	assert.True(t, check.IfNil(gct))
+	assert.Equal(t, epochStart.ErrNilMetaBlockStorage, err)
+
+	gct, err = notifier.NewGovernedConfigTracker(createMockArgsGovernedConfigTracker())
+	assert.False(t, check.IfNil(gct))
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(common.GovernedConfigOrder), gct.NotifyOrder())
+}
+
+func TestGovernedConfigTracker_EpochStartPrepare(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	governedConfig := createGovernedConfig()
+	governedConfigData, _ := marshalizer.Marshal(governedConfig)
+
+	var receivedConfig *governance.GovernedConfig
+	numCalls := 0
+	args := createMockArgsGovernedConfigTracker()
+	args.Marshalizer = marshalizer
+	args.EpochNotifier = &mock.EpochNotifierStub{
+		SetGovernedConfigCalled: func(config *governance.GovernedConfig) {
+			receivedConfig = config
+			numCalls++
+		},
+	}
+	gct, _ := notifier.NewGovernedConfigTracker(args)
+
+	gct.EpochStartPrepare(&block.MetaBlock{Epoch: 3}, nil)
+	assert.Equal(t, 0, numCalls)
+
+	gct.EpochStartPrepare(&block.MetaBlock{Epoch: 3, Reserved: []byte("invalid")}, nil)
+	assert.Equal(t, 0, numCalls)
+
+	gct.EpochStartPrepare(&block.MetaBlock{Epoch: 3, Reserved: governedConfigData}, nil)
+	assert.Equal(t, 1, numCalls)
+	assert.Equal(t, governedConfig, receivedConfig)
+}
+
+func TestGovernedConfigTracker_LoadEpochStartMetaBlock(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	governedConfig := createGovernedConfig()
+	governedConfigData, _ := marshalizer.Marshal(governedConfig)
+	metaBlockData, _ := marshalizer.Marshal(&block.MetaBlock{Epoch: 7, Reserved: governedConfigData})
+
+	expectedErr := errors.New("expected error")
+	var receivedConfig *governance.GovernedConfig
+	args := createMockArgsGovernedConfigTracker()
+	args.Marshalizer = marshalizer
+	args.EpochNotifier = &mock.EpochNotifierStub{
+		SetGovernedConfigCalled: func(config *governance.GovernedConfig) {
+			receivedConfig = config
+		},
+	}
+	args.EpochStartMetaStorer = &testscommon.StorerStub{
+		SearchFirstCalled: func(key []byte) ([]byte, error) {
+			if string(key) != core.EpochStartIdentifier(7) {
+				return nil, expectedErr
+			}
+
+			return metaBlockData, nil
+		},
+	}
+	gct, _ := notifier.NewGovernedConfigTracker(args)
+
+	err := gct.LoadEpochStartMetaBlock(6)
+	assert.Equal(t, expectedErr, err)
+	assert.Nil(t, receivedConfig)
+
+	err = gct.LoadEpochStartMetaBlock(7)
+	require.Nil(t, err)
+	assert.Equal(t, governedConfig, receivedConfig)
+}
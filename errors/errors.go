@@ -221,6 +221,9 @@ var ErrNilMarshalizer = errors.New("nil marshalizer provided")
 // ErrNilMessageSignVerifier signals that a nil message signiature verifier was provided
 var ErrNilMessageSignVerifier = errors.New("nil message sign verifier")
 
+// ErrNilKeysHandler signals that a nil keys handler was provided
+var ErrNilKeysHandler = errors.New("nil keys handler")
+
 // ErrNilMessenger signals that a nil messenger was provided
 var ErrNilMessenger = errors.New("nil messenger")
 
@@ -457,3 +460,21 @@ var ErrNilLocker = errors.New("nil locker")
 
 // ErrNilCurrentEpochProvider signals that a nil current epoch provider was provided
 var ErrNilCurrentEpochProvider = errors.New("nil current epoch provider")
+
+// ErrNilRoundTracer signals that a nil round tracer was provided
+var ErrNilRoundTracer = errors.New("nil round tracer")
+
+// ErrNilSlashingDetector signals that a nil slashing detector was provided
+var ErrNilSlashingDetector = errors.New("nil slashing detector")
+
+// ErrNilSignerBackend signals that a nil signer backend was provided
+var ErrNilSignerBackend = errors.New("nil signer backend")
+
+// ErrInvalidSignerBackendType signals that an invalid signer backend type was configured
+var ErrInvalidSignerBackendType = errors.New("invalid signer backend type")
+
+// ErrEmptyRemoteSignerKeys signals that the remote signer does not hold any key
+var ErrEmptyRemoteSignerKeys = errors.New("the remote signer does not hold any key")
+
+// ErrMainPublicKeyNotInRemoteSigner signals that the configured main public key is not held by the remote signer
+var ErrMainPublicKeyNotInRemoteSigner = errors.New("the main public key is not held by the remote signer")
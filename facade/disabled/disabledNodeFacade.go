@@ -17,11 +17,15 @@ import (
 	transactionApi "github.com/ElrondNetwork/elrond-go/api/transaction"
 	"github.com/ElrondNetwork/elrond-go/api/validator"
 	"github.com/ElrondNetwork/elrond-go/api/vmValues"
+	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
+	trieIteratorsData "github.com/ElrondNetwork/elrond-go/node/trieIterators/data"
 	"github.com/ElrondNetwork/elrond-go/ntp"
 	"github.com/ElrondNetwork/elrond-go/process"
+	gasPriceData "github.com/ElrondNetwork/elrond-go/process/gasprice/data"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
 	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
 	"github.com/ElrondNetwork/elrond-go/state"
 )
@@ -140,11 +144,21 @@ func (nf *disabledNodeFacade) SendBulkTransactions(_ []*transaction.Transaction)
 	return uint64(0), errNodeStarting
 }
 
+// SimulateTransactionExecutionWithTrace returns nil and error
+func (nf *disabledNodeFacade) SimulateTransactionExecutionWithTrace(_ *transaction.Transaction) (*txSimData.SimulationResults, error) {
+	return nil, errNodeStarting
+}
+
 // SimulateTransactionExecution returns nil and error
 func (nf *disabledNodeFacade) SimulateTransactionExecution(_ *transaction.Transaction) (*txSimData.SimulationResults, error) {
 	return nil, errNodeStarting
 }
 
+// SimulateTransactionsBundle returns nil and error
+func (nf *disabledNodeFacade) SimulateTransactionsBundle(_ []*transaction.Transaction) (*txSimData.BundleSimulationResults, error) {
+	return nil, errNodeStarting
+}
+
 // GetTransaction returns nil and error
 func (nf *disabledNodeFacade) GetTransaction(_ string, _ bool) (*transaction.ApiTransactionResult, error) {
 	return nil, errNodeStarting
@@ -185,6 +199,31 @@ func (nf *disabledNodeFacade) GetTotalStakedValue() (*api.StakeValues, error) {
 	return nil, errNodeStarting
 }
 
+// GetGovernanceInfo returns nil and error
+func (nf *disabledNodeFacade) GetGovernanceInfo() (*trieIteratorsData.GovernanceInfo, error) {
+	return nil, errNodeStarting
+}
+
+// GetTokenInfo returns nil and error
+func (nf *disabledNodeFacade) GetTokenInfo(_ string) (*trieIteratorsData.TokenInfo, error) {
+	return nil, errNodeStarting
+}
+
+// GetTokenHolders returns nil and error
+func (nf *disabledNodeFacade) GetTokenHolders(_ string, _ uint64, _ uint64) ([]*trieIteratorsData.TokenHolder, error) {
+	return nil, errNodeStarting
+}
+
+// GetGasPriceEstimates returns nil and error
+func (nf *disabledNodeFacade) GetGasPriceEstimates() (*gasPriceData.GasPriceEstimates, error) {
+	return nil, errNodeStarting
+}
+
+// ExecuteSCQueryWithTrace returns nil and error
+func (nf *disabledNodeFacade) ExecuteSCQueryWithTrace(_ *process.SCQuery) (*vm.VMOutputApi, *tracing.ExecutionTrace, error) {
+	return nil, nil, errNodeStarting
+}
+
 // ExecuteSCQuery returns nil and error
 func (nf *disabledNodeFacade) ExecuteSCQuery(_ *process.SCQuery) (*vm.VMOutputApi, error) {
 	return nil, errNodeStarting
@@ -225,6 +264,21 @@ func (nf *disabledNodeFacade) GetPeerInfo(_ string) ([]core.QueryP2PPeerInfo, er
 	return nil, errNodeStarting
 }
 
+// GetConsensusRounds returns nil and error
+func (nf *disabledNodeFacade) GetConsensusRounds() ([]consensus.RoundTrace, error) {
+	return nil, errNodeStarting
+}
+
+// GetSlashingEvidences returns nil and error
+func (nf *disabledNodeFacade) GetSlashingEvidences() ([]*consensus.SlashingEvidence, error) {
+	return nil, errNodeStarting
+}
+
+// GetManagedKeysMetrics returns nil and error
+func (nf *disabledNodeFacade) GetManagedKeysMetrics() ([]*consensus.ManagedKeyMetrics, error) {
+	return nil, errNodeStarting
+}
+
 // GetThrottlerForEndpoint returns nil and false
 func (nf *disabledNodeFacade) GetThrottlerForEndpoint(_ string) (core.Throttler, bool) {
 	return nil, false
@@ -240,6 +294,26 @@ func (nf *disabledNodeFacade) GetBlockByNonce(_ uint64, _ bool) (*api.Block, err
 	return nil, errNodeStarting
 }
 
+// GetRawBlockByHash returns nil and error
+func (nf *disabledNodeFacade) GetRawBlockByHash(_ string) ([]byte, error) {
+	return nil, errNodeStarting
+}
+
+// GetRawBlockByNonce returns nil and error
+func (nf *disabledNodeFacade) GetRawBlockByNonce(_ uint64) ([]byte, error) {
+	return nil, errNodeStarting
+}
+
+// GetRawEpochStartMetaBlock returns nil and error
+func (nf *disabledNodeFacade) GetRawEpochStartMetaBlock(_ uint32) ([]byte, error) {
+	return nil, errNodeStarting
+}
+
+// GetRawMiniBlockByHash returns nil and error
+func (nf *disabledNodeFacade) GetRawMiniBlockByHash(_ string, _ uint32) ([]byte, error) {
+	return nil, errNodeStarting
+}
+
 // Close returns error
 func (nf *disabledNodeFacade) Close() error {
 	return errNodeStarting
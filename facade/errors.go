@@ -26,6 +26,9 @@ var ErrNilAccountState = errors.New("nil account state")
 // ErrNilTransactionSimulatorProcessor signals that a nil transaction simulator processor has been provided
 var ErrNilTransactionSimulatorProcessor = errors.New("nil transaction simulator processor")
 
+// ErrNilTransactionBundleSimulator signals that a nil transactions bundle simulator has been provided
+var ErrNilTransactionBundleSimulator = errors.New("nil transactions bundle simulator")
+
 // ErrNilBlockchain signals that a nil blockchain has been provided
 var ErrNilBlockchain = errors.New("nil blockchain")
 
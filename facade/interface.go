@@ -7,10 +7,14 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/api"
 	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
+	trieIteratorsData "github.com/ElrondNetwork/elrond-go/node/trieIterators/data"
 	"github.com/ElrondNetwork/elrond-go/process"
+	gasPriceData "github.com/ElrondNetwork/elrond-go/process/gasprice/data"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
 	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
 	"github.com/ElrondNetwork/elrond-go/state"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
@@ -85,25 +89,44 @@ type NodeHandler interface {
 
 	GetQueryHandler(name string) (debug.QueryHandler, error)
 	GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetConsensusRounds() ([]consensus.RoundTrace, error)
+	GetSlashingEvidences() ([]*consensus.SlashingEvidence, error)
+	GetManagedKeysMetrics() ([]*consensus.ManagedKeyMetrics, error)
 
 	GetBlockByHash(hash string, withTxs bool) (*api.Block, error)
 	GetBlockByNonce(nonce uint64, withTxs bool) (*api.Block, error)
+	GetRawBlockByHash(hash string) ([]byte, error)
+	GetRawBlockByNonce(nonce uint64) ([]byte, error)
+	GetRawEpochStartMetaBlock(epoch uint32) ([]byte, error)
+	GetRawMiniBlockByHash(hash string, epoch uint32) ([]byte, error)
 }
 
 // TransactionSimulatorProcessor defines the actions which a transaction simulator processor has to implement
 type TransactionSimulatorProcessor interface {
 	ProcessTx(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	ProcessTxWithTrace(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	IsInterfaceNil() bool
+}
+
+// TransactionBundleSimulator defines the actions which a transactions bundle simulator needs to implement
+type TransactionBundleSimulator interface {
+	ProcessBundle(txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error)
 	IsInterfaceNil() bool
 }
 
 // ApiResolver defines a structure capable of resolving REST API requests
 type ApiResolver interface {
 	ExecuteSCQuery(query *process.SCQuery) (*vmcommon.VMOutput, error)
+	ExecuteSCQueryWithTrace(query *process.SCQuery) (*vmcommon.VMOutput, *tracing.ExecutionTrace, error)
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
 	StatusMetrics() external.StatusMetricsHandler
 	GetTotalStakedValue() (*api.StakeValues, error)
 	GetDirectStakedList() ([]*api.DirectStakedValue, error)
 	GetDelegatorsList() ([]*api.Delegator, error)
+	GetGovernanceInfo() (*trieIteratorsData.GovernanceInfo, error)
+	GetTokenInfo(token string) (*trieIteratorsData.TokenInfo, error)
+	GetTokenHolders(token string, offset uint64, limit uint64) ([]*trieIteratorsData.TokenHolder, error)
+	GetGasPriceEstimates() (*gasPriceData.GasPriceEstimates, error)
 	Close() error
 	IsInterfaceNil() bool
 }
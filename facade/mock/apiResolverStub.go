@@ -4,18 +4,26 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/api"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/node/external"
+	trieIteratorsData "github.com/ElrondNetwork/elrond-go/node/trieIterators/data"
 	"github.com/ElrondNetwork/elrond-go/process"
+	gasPriceData "github.com/ElrondNetwork/elrond-go/process/gasprice/data"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
 
 // ApiResolverStub -
 type ApiResolverStub struct {
 	ExecuteSCQueryHandler             func(query *process.SCQuery) (*vmcommon.VMOutput, error)
+	ExecuteSCQueryWithTraceHandler    func(query *process.SCQuery) (*vmcommon.VMOutput, *tracing.ExecutionTrace, error)
 	StatusMetricsHandler              func() external.StatusMetricsHandler
 	ComputeTransactionGasLimitHandler func(tx *transaction.Transaction) (*transaction.CostResponse, error)
 	GetTotalStakedValueHandler        func() (*api.StakeValues, error)
 	GetDirectStakedListHandler        func() ([]*api.DirectStakedValue, error)
 	GetDelegatorsListHandler          func() ([]*api.Delegator, error)
+	GetGovernanceInfoHandler          func() (*trieIteratorsData.GovernanceInfo, error)
+	GetTokenInfoHandler               func(token string) (*trieIteratorsData.TokenInfo, error)
+	GetTokenHoldersHandler            func(token string, offset uint64, limit uint64) ([]*trieIteratorsData.TokenHolder, error)
+	GetGasPriceEstimatesHandler       func() (*gasPriceData.GasPriceEstimates, error)
 }
 
 // ExecuteSCQuery -
@@ -23,6 +31,11 @@ func (ars *ApiResolverStub) ExecuteSCQuery(query *process.SCQuery) (*vmcommon.VM
 	return ars.ExecuteSCQueryHandler(query)
 }
 
+// ExecuteSCQueryWithTrace -
+func (ars *ApiResolverStub) ExecuteSCQueryWithTrace(query *process.SCQuery) (*vmcommon.VMOutput, *tracing.ExecutionTrace, error) {
+	return ars.ExecuteSCQueryWithTraceHandler(query)
+}
+
 // StatusMetrics -
 func (ars *ApiResolverStub) StatusMetrics() external.StatusMetricsHandler {
 	return ars.StatusMetricsHandler()
@@ -56,6 +69,42 @@ func (ars *ApiResolverStub) GetDelegatorsList() ([]*api.Delegator, error) {
 	return nil, nil
 }
 
+// GetGovernanceInfo -
+func (ars *ApiResolverStub) GetGovernanceInfo() (*trieIteratorsData.GovernanceInfo, error) {
+	if ars.GetGovernanceInfoHandler != nil {
+		return ars.GetGovernanceInfoHandler()
+	}
+
+	return nil, nil
+}
+
+// GetTokenInfo -
+func (ars *ApiResolverStub) GetTokenInfo(token string) (*trieIteratorsData.TokenInfo, error) {
+	if ars.GetTokenInfoHandler != nil {
+		return ars.GetTokenInfoHandler(token)
+	}
+
+	return nil, nil
+}
+
+// GetTokenHolders -
+func (ars *ApiResolverStub) GetTokenHolders(token string, offset uint64, limit uint64) ([]*trieIteratorsData.TokenHolder, error) {
+	if ars.GetTokenHoldersHandler != nil {
+		return ars.GetTokenHoldersHandler(token, offset, limit)
+	}
+
+	return nil, nil
+}
+
+// GetGasPriceEstimates -
+func (ars *ApiResolverStub) GetGasPriceEstimates() (*gasPriceData.GasPriceEstimates, error) {
+	if ars.GetGasPriceEstimatesHandler != nil {
+		return ars.GetGasPriceEstimatesHandler()
+	}
+
+	return &gasPriceData.GasPriceEstimates{}, nil
+}
+
 // Close -
 func (ars *ApiResolverStub) Close() error {
 	return nil
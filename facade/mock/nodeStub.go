@@ -8,6 +8,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/api"
 	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/state"
@@ -37,8 +38,15 @@ type NodeStub struct {
 	GetQueryHandlerCalled                          func(name string) (debug.QueryHandler, error)
 	GetValueForKeyCalled                           func(address string, key string) (string, error)
 	GetPeerInfoCalled                              func(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetConsensusRoundsCalled                       func() ([]consensus.RoundTrace, error)
+	GetSlashingEvidencesCalled                     func() ([]*consensus.SlashingEvidence, error)
+	GetManagedKeysMetricsCalled                    func() ([]*consensus.ManagedKeyMetrics, error)
 	GetBlockByHashCalled                           func(hash string, withTxs bool) (*api.Block, error)
 	GetBlockByNonceCalled                          func(nonce uint64, withTxs bool) (*api.Block, error)
+	GetRawBlockByHashCalled                        func(hash string) ([]byte, error)
+	GetRawBlockByNonceCalled                       func(nonce uint64) ([]byte, error)
+	GetRawEpochStartMetaBlockCalled                func(epoch uint32) ([]byte, error)
+	GetRawMiniBlockByHashCalled                    func(hash string, epoch uint32) ([]byte, error)
 	GetUsernameCalled                              func(address string) (string, error)
 	GetESDTDataCalled                              func(address string, key string, nonce uint64) (*esdt.ESDigitalToken, error)
 	GetAllESDTTokensCalled                         func(address string) (map[string]*esdt.ESDigitalToken, error)
@@ -91,6 +99,42 @@ func (ns *NodeStub) GetBlockByNonce(nonce uint64, withTxs bool) (*api.Block, err
 	return ns.GetBlockByNonceCalled(nonce, withTxs)
 }
 
+// GetRawBlockByHash -
+func (ns *NodeStub) GetRawBlockByHash(hash string) ([]byte, error) {
+	if ns.GetRawBlockByHashCalled != nil {
+		return ns.GetRawBlockByHashCalled(hash)
+	}
+
+	return nil, nil
+}
+
+// GetRawBlockByNonce -
+func (ns *NodeStub) GetRawBlockByNonce(nonce uint64) ([]byte, error) {
+	if ns.GetRawBlockByNonceCalled != nil {
+		return ns.GetRawBlockByNonceCalled(nonce)
+	}
+
+	return nil, nil
+}
+
+// GetRawEpochStartMetaBlock -
+func (ns *NodeStub) GetRawEpochStartMetaBlock(epoch uint32) ([]byte, error) {
+	if ns.GetRawEpochStartMetaBlockCalled != nil {
+		return ns.GetRawEpochStartMetaBlockCalled(epoch)
+	}
+
+	return nil, nil
+}
+
+// GetRawMiniBlockByHash -
+func (ns *NodeStub) GetRawMiniBlockByHash(hash string, epoch uint32) ([]byte, error) {
+	if ns.GetRawMiniBlockByHashCalled != nil {
+		return ns.GetRawMiniBlockByHashCalled(hash, epoch)
+	}
+
+	return nil, nil
+}
+
 // DecodeAddressPubkey -
 func (ns *NodeStub) DecodeAddressPubkey(pk string) ([]byte, error) {
 	return hex.DecodeString(pk)
@@ -180,6 +224,33 @@ func (ns *NodeStub) GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error) {
 	return make([]core.QueryP2PPeerInfo, 0), nil
 }
 
+// GetConsensusRounds -
+func (ns *NodeStub) GetConsensusRounds() ([]consensus.RoundTrace, error) {
+	if ns.GetConsensusRoundsCalled != nil {
+		return ns.GetConsensusRoundsCalled()
+	}
+
+	return make([]consensus.RoundTrace, 0), nil
+}
+
+// GetSlashingEvidences -
+func (ns *NodeStub) GetSlashingEvidences() ([]*consensus.SlashingEvidence, error) {
+	if ns.GetSlashingEvidencesCalled != nil {
+		return ns.GetSlashingEvidencesCalled()
+	}
+
+	return make([]*consensus.SlashingEvidence, 0), nil
+}
+
+// GetManagedKeysMetrics -
+func (ns *NodeStub) GetManagedKeysMetrics() ([]*consensus.ManagedKeyMetrics, error) {
+	if ns.GetManagedKeysMetricsCalled != nil {
+		return ns.GetManagedKeysMetricsCalled()
+	}
+
+	return make([]*consensus.ManagedKeyMetrics, 0), nil
+}
+
 // GetESDTData -
 func (ns *NodeStub) GetESDTData(address, tokenID string, nonce uint64) (*esdt.ESDigitalToken, error) {
 	if ns.GetESDTDataCalled != nil {
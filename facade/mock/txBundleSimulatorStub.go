@@ -0,0 +1,25 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
+)
+
+// TxBundleSimulatorStub -
+type TxBundleSimulatorStub struct {
+	ProcessBundleCalled func(txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error)
+}
+
+// ProcessBundle -
+func (t *TxBundleSimulatorStub) ProcessBundle(txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error) {
+	if t.ProcessBundleCalled != nil {
+		return t.ProcessBundleCalled(txs)
+	}
+
+	return &txSimData.BundleSimulationResults{}, nil
+}
+
+// IsInterfaceNil -
+func (t *TxBundleSimulatorStub) IsInterfaceNil() bool {
+	return t == nil
+}
@@ -7,7 +7,8 @@ import (
 
 // TxExecutionSimulatorStub -
 type TxExecutionSimulatorStub struct {
-	ProcessTxCalled func(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	ProcessTxCalled          func(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	ProcessTxWithTraceCalled func(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
 }
 
 // ProcessTx -
@@ -19,6 +20,15 @@ func (t *TxExecutionSimulatorStub) ProcessTx(tx *transaction.Transaction) (*txSi
 	return &txSimData.SimulationResults{}, nil
 }
 
+// ProcessTxWithTrace -
+func (t *TxExecutionSimulatorStub) ProcessTxWithTrace(tx *transaction.Transaction) (*txSimData.SimulationResults, error) {
+	if t.ProcessTxWithTraceCalled != nil {
+		return t.ProcessTxWithTraceCalled(tx)
+	}
+
+	return &txSimData.SimulationResults{}, nil
+}
+
 // IsInterfaceNil -
 func (t *TxExecutionSimulatorStub) IsInterfaceNil() bool {
 	return t == nil
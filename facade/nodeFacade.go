@@ -23,11 +23,15 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/validator"
 	"github.com/ElrondNetwork/elrond-go/api/vmValues"
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
+	trieIteratorsData "github.com/ElrondNetwork/elrond-go/node/trieIterators/data"
 	"github.com/ElrondNetwork/elrond-go/ntp"
 	"github.com/ElrondNetwork/elrond-go/process"
+	gasPriceData "github.com/ElrondNetwork/elrond-go/process/gasprice/data"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
 	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
 	"github.com/ElrondNetwork/elrond-go/state"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
@@ -54,6 +58,7 @@ type ArgNodeFacade struct {
 	Node                   NodeHandler
 	ApiResolver            ApiResolver
 	TxSimulatorProcessor   TransactionSimulatorProcessor
+	TxBundleSimulator      TransactionBundleSimulator
 	RestAPIServerDebugMode bool
 	WsAntifloodConfig      config.WebServerAntifloodConfig
 	FacadeConfig           config.FacadeConfig
@@ -69,6 +74,7 @@ type nodeFacade struct {
 	apiResolver            ApiResolver
 	syncer                 ntp.SyncTimer
 	txSimulatorProc        TransactionSimulatorProcessor
+	txBundleSimulator      TransactionBundleSimulator
 	config                 config.FacadeConfig
 	apiRoutesConfig        config.ApiRoutesConfig
 	endpointsThrottlers    map[string]core.Throttler
@@ -92,6 +98,9 @@ func NewNodeFacade(arg ArgNodeFacade) (*nodeFacade, error) {
 	if check.IfNil(arg.TxSimulatorProcessor) {
 		return nil, ErrNilTransactionSimulatorProcessor
 	}
+	if check.IfNil(arg.TxBundleSimulator) {
+		return nil, ErrNilTransactionBundleSimulator
+	}
 	if len(arg.ApiRoutesConfig.APIPackages) == 0 {
 		return nil, ErrNoApiRoutesConfig
 	}
@@ -121,6 +130,7 @@ func NewNodeFacade(arg ArgNodeFacade) (*nodeFacade, error) {
 		apiResolver:            arg.ApiResolver,
 		restAPIServerDebugMode: arg.RestAPIServerDebugMode,
 		txSimulatorProc:        arg.TxSimulatorProcessor,
+		txBundleSimulator:      arg.TxBundleSimulator,
 		wsAntifloodConfig:      arg.WsAntifloodConfig,
 		config:                 arg.FacadeConfig,
 		apiRoutesConfig:        arg.ApiRoutesConfig,
@@ -268,6 +278,18 @@ func (nf *nodeFacade) SimulateTransactionExecution(tx *transaction.Transaction)
 	return nf.txSimulatorProc.ProcessTx(tx)
 }
 
+// SimulateTransactionExecutionWithTrace will simulate a transaction's execution and will return the results together
+// with the traces of the smart contract executions
+func (nf *nodeFacade) SimulateTransactionExecutionWithTrace(tx *transaction.Transaction) (*txSimData.SimulationResults, error) {
+	return nf.txSimulatorProc.ProcessTxWithTrace(tx)
+}
+
+// SimulateTransactionsBundle will simulate, in order and on the same state, the execution of the transactions and
+// will return the results
+func (nf *nodeFacade) SimulateTransactionsBundle(txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error) {
+	return nf.txBundleSimulator.ProcessBundle(txs)
+}
+
 // GetTransaction gets the transaction with a specified hash
 func (nf *nodeFacade) GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
 	return nf.node.GetTransaction(hash, withResults)
@@ -321,6 +343,26 @@ func (nf *nodeFacade) GetDelegatorsList() ([]*apiData.Delegator, error) {
 	return nf.apiResolver.GetDelegatorsList()
 }
 
+// GetGovernanceInfo will output the governance configuration and the proposals, together with their tally
+func (nf *nodeFacade) GetGovernanceInfo() (*trieIteratorsData.GovernanceInfo, error) {
+	return nf.apiResolver.GetGovernanceInfo()
+}
+
+// GetTokenInfo will output the properties, the special roles and the supply of an ESDT token, as known by the node
+func (nf *nodeFacade) GetTokenInfo(token string) (*trieIteratorsData.TokenInfo, error) {
+	return nf.apiResolver.GetTokenInfo(token)
+}
+
+// GetTokenHolders will output a page of the holders of an ESDT token from the node's shard
+func (nf *nodeFacade) GetTokenHolders(token string, offset uint64, limit uint64) ([]*trieIteratorsData.TokenHolder, error) {
+	return nf.apiResolver.GetTokenHolders(token, offset, limit)
+}
+
+// GetGasPriceEstimates will output the suggested gas prices for slow, normal and fast inclusion
+func (nf *nodeFacade) GetGasPriceEstimates() (*gasPriceData.GasPriceEstimates, error) {
+	return nf.apiResolver.GetGasPriceEstimates()
+}
+
 // ExecuteSCQuery retrieves data from existing SC trie
 func (nf *nodeFacade) ExecuteSCQuery(query *process.SCQuery) (*vm.VMOutputApi, error) {
 	vmOutput, err := nf.apiResolver.ExecuteSCQuery(query)
@@ -331,6 +373,16 @@ func (nf *nodeFacade) ExecuteSCQuery(query *process.SCQuery) (*vm.VMOutputApi, e
 	return nf.convertVmOutputToApiResponse(vmOutput), nil
 }
 
+// ExecuteSCQueryWithTrace retrieves data from existing SC trie together with the trace of the execution
+func (nf *nodeFacade) ExecuteSCQueryWithTrace(query *process.SCQuery) (*vm.VMOutputApi, *tracing.ExecutionTrace, error) {
+	vmOutput, trace, err := nf.apiResolver.ExecuteSCQueryWithTrace(query)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return nf.convertVmOutputToApiResponse(vmOutput), trace, nil
+}
+
 // PprofEnabled returns if profiling mode should be active or not on the application
 func (nf *nodeFacade) PprofEnabled() bool {
 	return nf.config.PprofEnabled
@@ -366,6 +418,21 @@ func (nf *nodeFacade) GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error) {
 	return nf.node.GetPeerInfo(pid)
 }
 
+// GetConsensusRounds returns the traces of the latest consensus rounds
+func (nf *nodeFacade) GetConsensusRounds() ([]consensus.RoundTrace, error) {
+	return nf.node.GetConsensusRounds()
+}
+
+// GetSlashingEvidences returns the collected evidences of validators that signed conflicting data
+func (nf *nodeFacade) GetSlashingEvidences() ([]*consensus.SlashingEvidence, error) {
+	return nf.node.GetSlashingEvidences()
+}
+
+// GetManagedKeysMetrics returns the activity counters of the validator keys managed by the current node
+func (nf *nodeFacade) GetManagedKeysMetrics() ([]*consensus.ManagedKeyMetrics, error) {
+	return nf.node.GetManagedKeysMetrics()
+}
+
 // GetThrottlerForEndpoint returns the throttler for a given endpoint if found
 func (nf *nodeFacade) GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool) {
 	throttlerForEndpoint, ok := nf.endpointsThrottlers[endpoint]
@@ -384,6 +451,26 @@ func (nf *nodeFacade) GetBlockByNonce(nonce uint64, withTxs bool) (*apiData.Bloc
 	return nf.node.GetBlockByNonce(nonce, withTxs)
 }
 
+// GetRawBlockByHash returns the marshalized block for a given hash
+func (nf *nodeFacade) GetRawBlockByHash(hash string) ([]byte, error) {
+	return nf.node.GetRawBlockByHash(hash)
+}
+
+// GetRawBlockByNonce returns the marshalized block for a given nonce
+func (nf *nodeFacade) GetRawBlockByNonce(nonce uint64) ([]byte, error) {
+	return nf.node.GetRawBlockByNonce(nonce)
+}
+
+// GetRawEpochStartMetaBlock returns the marshalized epoch start meta block of the given epoch
+func (nf *nodeFacade) GetRawEpochStartMetaBlock(epoch uint32) ([]byte, error) {
+	return nf.node.GetRawEpochStartMetaBlock(epoch)
+}
+
+// GetRawMiniBlockByHash returns the marshalized miniblock for a given hash, saved in the given epoch
+func (nf *nodeFacade) GetRawMiniBlockByHash(hash string, epoch uint32) ([]byte, error) {
+	return nf.node.GetRawMiniBlockByHash(hash, epoch)
+}
+
 // Close will cleanup started go routines
 func (nf *nodeFacade) Close() error {
 	log.LogIfError(nf.apiResolver.Close())
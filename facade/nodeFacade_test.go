@@ -19,6 +19,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/facade/mock"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
@@ -40,6 +41,7 @@ func createMockArguments() ArgNodeFacade {
 		ApiResolver:            &mock.ApiResolverStub{},
 		RestAPIServerDebugMode: false,
 		TxSimulatorProcessor:   &mock.TxExecutionSimulatorStub{},
+		TxBundleSimulator:      &mock.TxBundleSimulatorStub{},
 		WsAntifloodConfig: config.WebServerAntifloodConfig{
 			SimultaneousRequests:         1,
 			SameSourceRequests:           1,
@@ -86,6 +88,17 @@ func TestNewNodeFacade_WithNilApiResolverShouldErr(t *testing.T) {
 	assert.Equal(t, ErrNilApiResolver, err)
 }
 
+func TestNewNodeFacade_WithNilTxBundleSimulatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	arg.TxBundleSimulator = nil
+	nf, err := NewNodeFacade(arg)
+
+	assert.True(t, check.IfNil(nf))
+	assert.Equal(t, ErrNilTransactionBundleSimulator, err)
+}
+
 func TestNewNodeFacade_WithInvalidSimultaneousRequestsShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -602,6 +615,60 @@ func TestNodeFacade_GetPeerInfo(t *testing.T) {
 	assert.Equal(t, []core.QueryP2PPeerInfo{pinfo}, val)
 }
 
+func TestNodeFacade_GetConsensusRounds(t *testing.T) {
+	t.Parallel()
+
+	rounds := []consensus.RoundTrace{{Round: 3}}
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetConsensusRoundsCalled: func() ([]consensus.RoundTrace, error) {
+			return rounds, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	val, err := nf.GetConsensusRounds()
+
+	assert.Nil(t, err)
+	assert.Equal(t, rounds, val)
+}
+
+func TestNodeFacade_GetSlashingEvidences(t *testing.T) {
+	t.Parallel()
+
+	evidences := []*consensus.SlashingEvidence{{Hash: "hash"}}
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetSlashingEvidencesCalled: func() ([]*consensus.SlashingEvidence, error) {
+			return evidences, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	val, err := nf.GetSlashingEvidences()
+
+	assert.Nil(t, err)
+	assert.Equal(t, evidences, val)
+}
+
+func TestNodeFacade_GetManagedKeysMetrics(t *testing.T) {
+	t.Parallel()
+
+	metrics := []*consensus.ManagedKeyMetrics{{PubKey: "pk"}}
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetManagedKeysMetricsCalled: func() ([]*consensus.ManagedKeyMetrics, error) {
+			return metrics, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	val, err := nf.GetManagedKeysMetrics()
+
+	assert.Nil(t, err)
+	assert.Equal(t, metrics, val)
+}
+
 func TestNodeFacade_GetThrottlerForEndpointNoConfigShouldReturnNilAndFalse(t *testing.T) {
 	t.Parallel()
 
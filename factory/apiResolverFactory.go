@@ -20,6 +20,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
 	"github.com/ElrondNetwork/elrond-go/process/transaction"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/state"
@@ -102,6 +103,8 @@ func CreateApiResolver(args *ApiResolverArgs) (facade.ApiResolver, error) {
 		args.Configs.EpochConfig.EnableEpochs.GlobalMintBurnDisableEpoch,
 		args.Configs.EpochConfig.EnableEpochs.ESDTTransferRoleEnableEpoch,
 		args.Configs.EpochConfig.EnableEpochs.BuiltInFunctionOnMetaEnableEpoch,
+		args.Configs.EpochConfig.EnableEpochs.GuardiansEnableEpoch,
+		args.Configs.GeneralConfig.GeneralSettings.GuardianActivationEpochsDelay,
 	)
 	if err != nil {
 		return nil, err
@@ -164,6 +167,16 @@ func CreateApiResolver(args *ApiResolverArgs) (facade.ApiResolver, error) {
 		return nil, err
 	}
 
+	governanceInfoHandler, err := trieIteratorsFactory.CreateGovernanceInfoHandler(argsProcessors)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenPropertiesHandler, err := trieIteratorsFactory.CreateTokenPropertiesHandler(argsProcessors)
+	if err != nil {
+		return nil, err
+	}
+
 	argsApiResolver := external.ArgNodeApiResolver{
 		SCQueryService:          scQueryService,
 		StatusMetricsHandler:    args.CoreComponents.StatusHandlerUtils().Metrics(),
@@ -171,6 +184,11 @@ func CreateApiResolver(args *ApiResolverArgs) (facade.ApiResolver, error) {
 		TotalStakedValueHandler: totalStakedValueHandler,
 		DirectStakedListHandler: directStakedListHandler,
 		DelegatedListHandler:    delegatedListHandler,
+		GovernanceInfoHandler:   governanceInfoHandler,
+		TokenPropertiesHandler:  tokenPropertiesHandler,
+		ESDTRegistryHandler:     args.ProcessComponents.HistoryRepository(),
+		AddressPubKeyConverter:  args.CoreComponents.AddressPubKeyConverter(),
+		GasPriceEstimator:       args.ProcessComponents.GasPriceEstimator(),
 	}
 
 	return external.NewNodeApiResolver(argsApiResolver)
@@ -236,6 +254,8 @@ func createScQueryElement(
 		args.epochConfig.EnableEpochs.GlobalMintBurnDisableEpoch,
 		args.epochConfig.EnableEpochs.ESDTTransferRoleEnableEpoch,
 		args.epochConfig.EnableEpochs.BuiltInFunctionOnMetaEnableEpoch,
+		args.epochConfig.EnableEpochs.GuardiansEnableEpoch,
+		args.generalConfig.GeneralSettings.GuardianActivationEpochsDelay,
 	)
 	if err != nil {
 		return nil, err
@@ -247,6 +267,11 @@ func createScQueryElement(
 		return nil, err
 	}
 
+	vmTracer, err := tracing.NewVMTracer(args.coreComponents.AddressPubKeyConverter())
+	if err != nil {
+		return nil, err
+	}
+
 	scStorage := args.generalConfig.SmartContractsStorageForSCQuery
 	scStorage.DB.FilePath += fmt.Sprintf("%d", args.index)
 	argsHook := hooks.ArgBlockChainHook{
@@ -263,6 +288,7 @@ func createScQueryElement(
 		CompiledSCPool:     smartContractsCache,
 		WorkingDir:         args.workingDir,
 		NilCompiledSCStore: true,
+		VMTracer:           vmTracer,
 	}
 
 	if args.processComponents.ShardCoordinator().SelfId() == core.MetachainShardId {
@@ -330,6 +356,7 @@ func createScQueryElement(
 		BlockChainHook:    vmFactory.BlockChainHookImpl(),
 		BlockChain:        args.dataComponents.Blockchain(),
 		ArwenChangeLocker: args.processComponents.ArwenChangeLocker(),
+		VMTracer:          vmTracer,
 	}
 	scQueryService, err := smartContract.NewSCQueryService(argsNewSCQueryService)
 
@@ -346,18 +373,22 @@ func createBuiltinFuncs(
 	esdtGlobalMintBurnDisableEpoch uint32,
 	esdtTransferRoleEnableEpoch uint32,
 	transferToMetaEnableEpoch uint32,
+	guardiansEnableEpoch uint32,
+	guardianActivationEpochsDelay uint32,
 ) (vmcommon.BuiltInFunctionContainer, error) {
 	argsBuiltIn := builtInFunctions.ArgsCreateBuiltInFunctionContainer{
-		GasSchedule:                  gasScheduleNotifier,
-		MapDNSAddresses:              make(map[string]struct{}),
-		Marshalizer:                  marshalizer,
-		Accounts:                     accnts,
-		ShardCoordinator:             shardCoordinator,
-		EpochNotifier:                epochNotifier,
-		ESDTMultiTransferEnableEpoch: esdtMultiTransferEnableEpoch,
-		ESDTTransferRoleEnableEpoch:  esdtTransferRoleEnableEpoch,
-		GlobalMintBurnDisableEpoch:   esdtGlobalMintBurnDisableEpoch,
-		ESDTTransferMetaEnableEpoch:  transferToMetaEnableEpoch,
+		GasSchedule:                   gasScheduleNotifier,
+		MapDNSAddresses:               make(map[string]struct{}),
+		Marshalizer:                   marshalizer,
+		Accounts:                      accnts,
+		ShardCoordinator:              shardCoordinator,
+		EpochNotifier:                 epochNotifier,
+		ESDTMultiTransferEnableEpoch:  esdtMultiTransferEnableEpoch,
+		ESDTTransferRoleEnableEpoch:   esdtTransferRoleEnableEpoch,
+		GlobalMintBurnDisableEpoch:    esdtGlobalMintBurnDisableEpoch,
+		ESDTTransferMetaEnableEpoch:   transferToMetaEnableEpoch,
+		GuardiansEnableEpoch:          guardiansEnableEpoch,
+		GuardianActivationEpochsDelay: guardianActivationEpochsDelay,
 	}
 	builtInFuncs, err := builtInFunctions.CreateBuiltInFunctionContainer(argsBuiltIn)
 	if err != nil {
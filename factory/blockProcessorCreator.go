@@ -20,15 +20,21 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/factory"
 	"github.com/ElrondNetwork/elrond-go/process/factory/metachain"
 	"github.com/ElrondNetwork/elrond-go/process/factory/shard"
+	"github.com/ElrondNetwork/elrond-go/process/guardian"
 	"github.com/ElrondNetwork/elrond-go/process/rewardTransaction"
 	"github.com/ElrondNetwork/elrond-go/process/scToProtocol"
+	"github.com/ElrondNetwork/elrond-go/process/scheduledCalls"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
+	tracingDisabled "github.com/ElrondNetwork/elrond-go/process/smartContract/tracing/disabled"
 	"github.com/ElrondNetwork/elrond-go/process/throttle"
 	"github.com/ElrondNetwork/elrond-go/process/transaction"
+	"github.com/ElrondNetwork/elrond-go/process/transactionLog"
 	"github.com/ElrondNetwork/elrond-go/process/txsimulator"
 	"github.com/ElrondNetwork/elrond-go/state"
+	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/ElrondNetwork/elrond-go/storage/txcache"
 	"github.com/ElrondNetwork/elrond-go/vm"
 	vmcommonBuiltInFunctions "github.com/ElrondNetwork/elrond-vm-common/builtInFunctions"
@@ -46,6 +52,7 @@ func (pcf *processComponentsFactory) newBlockProcessor(
 	pendingMiniBlocksHandler process.PendingMiniBlocksHandler,
 	txSimulatorProcessorArgs *txsimulator.ArgsTxSimulator,
 	arwenChangeLocker process.Locker,
+	gasPriceTracker process.GasPriceTracker,
 ) (process.BlockProcessor, error) {
 	if pcf.bootstrapComponents.ShardCoordinator().SelfId() < pcf.bootstrapComponents.ShardCoordinator().NumberOfShards() {
 		return pcf.newShardBlockProcessor(
@@ -58,6 +65,7 @@ func (pcf *processComponentsFactory) newBlockProcessor(
 			pcf.smartContractParser,
 			txSimulatorProcessorArgs,
 			arwenChangeLocker,
+			gasPriceTracker,
 		)
 	}
 	if pcf.bootstrapComponents.ShardCoordinator().SelfId() == core.MetachainShardId {
@@ -72,6 +80,7 @@ func (pcf *processComponentsFactory) newBlockProcessor(
 			pendingMiniBlocksHandler,
 			txSimulatorProcessorArgs,
 			arwenChangeLocker,
+			gasPriceTracker,
 		)
 	}
 
@@ -88,6 +97,7 @@ func (pcf *processComponentsFactory) newShardBlockProcessor(
 	smartContractParser genesis.InitialSmartContractParser,
 	txSimulatorProcessorArgs *txsimulator.ArgsTxSimulator,
 	arwenChangeLocker process.Locker,
+	gasPriceTracker process.GasPriceTracker,
 ) (process.BlockProcessor, error) {
 	argsParser := smartContract.NewArgumentParser()
 
@@ -97,16 +107,18 @@ func (pcf *processComponentsFactory) newShardBlockProcessor(
 	}
 
 	argsBuiltIn := builtInFunctions.ArgsCreateBuiltInFunctionContainer{
-		GasSchedule:                  pcf.gasSchedule,
-		MapDNSAddresses:              mapDNSAddresses,
-		Marshalizer:                  pcf.coreData.InternalMarshalizer(),
-		Accounts:                     pcf.state.AccountsAdapter(),
-		ShardCoordinator:             pcf.bootstrapComponents.ShardCoordinator(),
-		EpochNotifier:                pcf.epochNotifier,
-		ESDTMultiTransferEnableEpoch: pcf.epochConfig.EnableEpochs.ESDTMultiTransferEnableEpoch,
-		ESDTTransferRoleEnableEpoch:  pcf.epochConfig.EnableEpochs.ESDTTransferRoleEnableEpoch,
-		GlobalMintBurnDisableEpoch:   pcf.epochConfig.EnableEpochs.GlobalMintBurnDisableEpoch,
-		ESDTTransferMetaEnableEpoch:  pcf.epochConfig.EnableEpochs.BuiltInFunctionOnMetaEnableEpoch,
+		GasSchedule:                   pcf.gasSchedule,
+		MapDNSAddresses:               mapDNSAddresses,
+		Marshalizer:                   pcf.coreData.InternalMarshalizer(),
+		Accounts:                      pcf.state.AccountsAdapter(),
+		ShardCoordinator:              pcf.bootstrapComponents.ShardCoordinator(),
+		EpochNotifier:                 pcf.epochNotifier,
+		ESDTMultiTransferEnableEpoch:  pcf.epochConfig.EnableEpochs.ESDTMultiTransferEnableEpoch,
+		ESDTTransferRoleEnableEpoch:   pcf.epochConfig.EnableEpochs.ESDTTransferRoleEnableEpoch,
+		GlobalMintBurnDisableEpoch:    pcf.epochConfig.EnableEpochs.GlobalMintBurnDisableEpoch,
+		ESDTTransferMetaEnableEpoch:   pcf.epochConfig.EnableEpochs.BuiltInFunctionOnMetaEnableEpoch,
+		GuardiansEnableEpoch:          pcf.epochConfig.EnableEpochs.GuardiansEnableEpoch,
+		GuardianActivationEpochsDelay: pcf.config.GeneralSettings.GuardianActivationEpochsDelay,
 	}
 
 	builtInFuncs, err := builtInFunctions.CreateBuiltInFunctionContainer(argsBuiltIn)
@@ -128,6 +140,7 @@ func (pcf *processComponentsFactory) newShardBlockProcessor(
 		WorkingDir:         pcf.workingDir,
 		NilCompiledSCStore: false,
 		ConfigSCStorage:    pcf.config.SmartContractsStorage,
+		VMTracer:           tracingDisabled.NewDisabledVMTracer(),
 	}
 
 	esdtTransferParser, err := parsers.NewESDTTransferParser(pcf.coreData.InternalMarshalizer())
@@ -259,6 +272,7 @@ func (pcf *processComponentsFactory) newShardBlockProcessor(
 		BuiltInFunctionOnMetachainEnableEpoch: pcf.epochConfig.EnableEpochs.BuiltInFunctionOnMetaEnableEpoch,
 		VMOutputCacher:                        txcache.NewDisabledCache(),
 		ArwenChangeLocker:                     arwenChangeLocker,
+		VMTracer:                              tracingDisabled.NewDisabledVMTracer(),
 
 		IncrementSCRNonceInMultiTransferEnableEpoch: enableEpochs.IncrementSCRNonceInMultiTransferEnableEpoch,
 	}
@@ -276,6 +290,11 @@ func (pcf *processComponentsFactory) newShardBlockProcessor(
 		return nil, err
 	}
 
+	guardedAccounts, err := guardian.NewGuardedAccount(pcf.coreData.InternalMarshalizer(), pcf.epochNotifier)
+	if err != nil {
+		return nil, err
+	}
+
 	argsNewTxProcessor := transaction.ArgsNewTxProcessor{
 		Accounts:                       pcf.state.AccountsAdapter(),
 		Hasher:                         pcf.coreData.Hasher(),
@@ -291,18 +310,20 @@ func (pcf *processComponentsFactory) newShardBlockProcessor(
 		BadTxForwarder:                 badTxInterim,
 		ArgsParser:                     argsParser,
 		ScrForwarder:                   scForwarder,
+		GuardedAccountHandler:          guardedAccounts,
 		RelayedTxEnableEpoch:           enableEpochs.RelayedTransactionsEnableEpoch,
 		PenalizedTooMuchGasEnableEpoch: enableEpochs.PenalizedTooMuchGasEnableEpoch,
 		MetaProtectionEnableEpoch:      enableEpochs.MetaProtectionEnableEpoch,
 		EpochNotifier:                  pcf.epochNotifier,
 		RelayedTxV2EnableEpoch:         enableEpochs.RelayedTransactionsV2EnableEpoch,
+		GuardiansEnableEpoch:           enableEpochs.GuardiansEnableEpoch,
 	}
 	transactionProcessor, err := transaction.NewTxProcessor(argsNewTxProcessor)
 	if err != nil {
 		return nil, errors.New("could not create transaction statisticsProcessor: " + err.Error())
 	}
 
-	err = pcf.createShardTxSimulatorProcessor(txSimulatorProcessorArgs, argsNewScProcessor, argsNewTxProcessor)
+	err = pcf.createShardTxSimulatorProcessor(txSimulatorProcessorArgs, argsNewScProcessor, argsNewTxProcessor, argsBuiltIn, argsNewVMFactory)
 	if err != nil {
 		return nil, err
 	}
@@ -406,6 +427,9 @@ func (pcf *processComponentsFactory) newShardBlockProcessor(
 		EpochNotifier:       pcf.epochNotifier,
 		VMContainersFactory: vmFactory,
 		VmContainer:         vmContainer,
+
+		DynamicGasPriceHandler: pcf.coreData.EconomicsData(),
+		GasPriceTracker:        gasPriceTracker,
 	}
 	arguments := block.ArgShardProcessor{
 		ArgBaseProcessor: argumentsBaseProcessor,
@@ -430,19 +454,22 @@ func (pcf *processComponentsFactory) newMetaBlockProcessor(
 	pendingMiniBlocksHandler process.PendingMiniBlocksHandler,
 	txSimulatorProcessorArgs *txsimulator.ArgsTxSimulator,
 	arwenChangeLocker process.Locker,
+	gasPriceTracker process.GasPriceTracker,
 ) (process.BlockProcessor, error) {
 
 	argsBuiltIn := builtInFunctions.ArgsCreateBuiltInFunctionContainer{
-		GasSchedule:                  pcf.gasSchedule,
-		MapDNSAddresses:              make(map[string]struct{}), // no dns for meta
-		Marshalizer:                  pcf.coreData.InternalMarshalizer(),
-		Accounts:                     pcf.state.AccountsAdapter(),
-		ShardCoordinator:             pcf.bootstrapComponents.ShardCoordinator(),
-		EpochNotifier:                pcf.epochNotifier,
-		ESDTMultiTransferEnableEpoch: pcf.epochConfig.EnableEpochs.ESDTMultiTransferEnableEpoch,
-		ESDTTransferRoleEnableEpoch:  pcf.epochConfig.EnableEpochs.ESDTTransferRoleEnableEpoch,
-		GlobalMintBurnDisableEpoch:   pcf.epochConfig.EnableEpochs.GlobalMintBurnDisableEpoch,
-		ESDTTransferMetaEnableEpoch:  pcf.epochConfig.EnableEpochs.BuiltInFunctionOnMetaEnableEpoch,
+		GasSchedule:                   pcf.gasSchedule,
+		MapDNSAddresses:               make(map[string]struct{}), // no dns for meta
+		Marshalizer:                   pcf.coreData.InternalMarshalizer(),
+		Accounts:                      pcf.state.AccountsAdapter(),
+		ShardCoordinator:              pcf.bootstrapComponents.ShardCoordinator(),
+		EpochNotifier:                 pcf.epochNotifier,
+		ESDTMultiTransferEnableEpoch:  pcf.epochConfig.EnableEpochs.ESDTMultiTransferEnableEpoch,
+		ESDTTransferRoleEnableEpoch:   pcf.epochConfig.EnableEpochs.ESDTTransferRoleEnableEpoch,
+		GlobalMintBurnDisableEpoch:    pcf.epochConfig.EnableEpochs.GlobalMintBurnDisableEpoch,
+		ESDTTransferMetaEnableEpoch:   pcf.epochConfig.EnableEpochs.BuiltInFunctionOnMetaEnableEpoch,
+		GuardiansEnableEpoch:          pcf.epochConfig.EnableEpochs.GuardiansEnableEpoch,
+		GuardianActivationEpochsDelay: pcf.config.GeneralSettings.GuardianActivationEpochsDelay,
 	}
 	builtInFuncs, err := builtInFunctions.CreateBuiltInFunctionContainer(argsBuiltIn)
 	if err != nil {
@@ -463,6 +490,7 @@ func (pcf *processComponentsFactory) newMetaBlockProcessor(
 		ConfigSCStorage:    pcf.config.SmartContractsStorage,
 		WorkingDir:         pcf.workingDir,
 		NilCompiledSCStore: false,
+		VMTracer:           tracingDisabled.NewDisabledVMTracer(),
 	}
 
 	argsNewVMContainer := metachain.ArgsNewVMContainerFactory{
@@ -584,6 +612,7 @@ func (pcf *processComponentsFactory) newMetaBlockProcessor(
 		BuiltInFunctionOnMetachainEnableEpoch: pcf.epochConfig.EnableEpochs.BuiltInFunctionOnMetaEnableEpoch,
 		VMOutputCacher:                        txcache.NewDisabledCache(),
 		ArwenChangeLocker:                     arwenChangeLocker,
+		VMTracer:                              tracingDisabled.NewDisabledVMTracer(),
 
 		IncrementSCRNonceInMultiTransferEnableEpoch: enableEpochs.IncrementSCRNonceInMultiTransferEnableEpoch,
 	}
@@ -814,6 +843,9 @@ func (pcf *processComponentsFactory) newMetaBlockProcessor(
 		EpochNotifier:       pcf.epochNotifier,
 		VMContainersFactory: vmFactory,
 		VmContainer:         vmContainer,
+
+		DynamicGasPriceHandler: pcf.coreData.EconomicsData(),
+		GasPriceTracker:        gasPriceTracker,
 	}
 
 	esdtOwnerAddress, err := pcf.coreData.AddressPubKeyConverter().Decode(pcf.systemSCConfig.ESDTSystemSCConfig.OwnerAddress)
@@ -846,6 +878,23 @@ func (pcf *processComponentsFactory) newMetaBlockProcessor(
 		return nil, err
 	}
 
+	argsScheduledCallsExecutor := scheduledCalls.ArgsScheduledCallsExecutor{
+		SystemVM:                systemVM,
+		AccountsDB:              pcf.state.AccountsAdapter(),
+		SCRForwarder:            scForwarder,
+		TxFeeHandler:            txFeeHandler,
+		ArgumentsParser:         argsParser,
+		Marshalizer:             pcf.coreData.InternalMarshalizer(),
+		EpochNotifier:           pcf.coreData.EpochNotifier(),
+		EndOfEpochCallerAddress: vm.EndOfEpochAddress,
+		SchedulerSCAddress:      vm.SchedulerSCAddress,
+		EpochConfig:             pcf.epochConfig,
+	}
+	scheduledCallsExecutor, err := scheduledCalls.NewScheduledCallsExecutor(argsScheduledCallsExecutor)
+	if err != nil {
+		return nil, err
+	}
+
 	arguments := block.ArgMetaProcessor{
 		ArgBaseProcessor:             argumentsBaseProcessor,
 		SCToProtocol:                 smartContractToProtocol,
@@ -856,6 +905,7 @@ func (pcf *processComponentsFactory) newMetaBlockProcessor(
 		EpochValidatorInfoCreator:    validatorInfoCreator,
 		ValidatorStatisticsProcessor: validatorStatisticsProcessor,
 		EpochSystemSCProcessor:       epochStartSystemSCProcessor,
+		ScheduledCallsExecutor:       scheduledCallsExecutor,
 		RewardsV2EnableEpoch:         pcf.epochConfig.EnableEpochs.StakingV2EnableEpoch,
 	}
 
@@ -871,8 +921,27 @@ func (pcf *processComponentsFactory) createShardTxSimulatorProcessor(
 	txSimulatorProcessorArgs *txsimulator.ArgsTxSimulator,
 	scProcArgs smartContract.ArgsNewSmartContractProcessor,
 	txProcArgs transaction.ArgsNewTxProcessor,
+	argsBuiltIn builtInFunctions.ArgsCreateBuiltInFunctionContainer,
+	argsNewVMFactory shard.ArgVMContainerFactory,
 ) error {
-	readOnlyAccountsDB, err := txsimulator.NewReadOnlyAccountsDB(pcf.state.AccountsAdapter())
+	readOnlyAccountsDB, err := txsimulator.NewReadOnlyAccountsDB(pcf.state.AccountsAdapter(), pcf.coreData.Hasher())
+	if err != nil {
+		return err
+	}
+
+	vmFactory, err := pcf.createShardTxSimulatorVMFactory(readOnlyAccountsDB, argsBuiltIn, argsNewVMFactory, txSimulatorProcessorArgs.VMTracer)
+	if err != nil {
+		return err
+	}
+
+	vmContainer, err := vmFactory.Create()
+	if err != nil {
+		return err
+	}
+	scProcArgs.VmContainer = vmContainer
+	scProcArgs.BlockChainHook = vmFactory.BlockChainHookImpl()
+
+	txLogsProcessor, err := pcf.createTxSimulatorLogsProcessor()
 	if err != nil {
 		return err
 	}
@@ -918,7 +987,9 @@ func (pcf *processComponentsFactory) createShardTxSimulatorProcessor(
 	txProcArgs.TxFeeHandler = &processDisabled.FeeHandler{}
 
 	scProcArgs.AccountsDB = readOnlyAccountsDB
+	scProcArgs.TxLogsProcessor = txLogsProcessor
 	scProcArgs.VMOutputCacher = txSimulatorProcessorArgs.VMOutputCacher
+	scProcArgs.VMTracer = txSimulatorProcessorArgs.VMTracer
 	scProcessor, err := smartContract.NewSmartContractProcessor(scProcArgs)
 	if err != nil {
 		return err
@@ -933,15 +1004,67 @@ func (pcf *processComponentsFactory) createShardTxSimulatorProcessor(
 	}
 
 	txSimulatorProcessorArgs.IntermediateProcContainer = interimProcContainer
+	txSimulatorProcessorArgs.SCRProcessor = scProcessor
+	txSimulatorProcessorArgs.TxLogsProcessor = txLogsProcessor
+	txSimulatorProcessorArgs.AccountsChangesHandler = readOnlyAccountsDB
 
 	return nil
 }
 
+// createShardTxSimulatorVMFactory creates the VM factory of the transactions simulator. The blockchain hook and the
+// built-in functions read the accounts through the read only accounts db, so the contracts executed by the simulation
+// observe the ephemeral state of the previously simulated transactions, including the contracts deployed by them
+func (pcf *processComponentsFactory) createShardTxSimulatorVMFactory(
+	accounts state.AccountsAdapter,
+	argsBuiltIn builtInFunctions.ArgsCreateBuiltInFunctionContainer,
+	argsNewVMFactory shard.ArgVMContainerFactory,
+	vmTracer process.VMTracer,
+) (process.VirtualMachinesContainerFactory, error) {
+	argsBuiltIn.Accounts = accounts
+	builtInFuncs, err := builtInFunctions.CreateBuiltInFunctionContainer(argsBuiltIn)
+	if err != nil {
+		return nil, err
+	}
+
+	cacherCfg := storageFactory.GetCacherFromConfig(pcf.config.SmartContractDataPool)
+	smartContractsCache, err := storageUnit.NewCache(cacherCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	argsNewVMFactory.ArgBlockChainHook.Accounts = accounts
+	argsNewVMFactory.ArgBlockChainHook.BuiltInFunctions = builtInFuncs
+	argsNewVMFactory.ArgBlockChainHook.CompiledSCPool = smartContractsCache
+	argsNewVMFactory.ArgBlockChainHook.NilCompiledSCStore = true
+	argsNewVMFactory.ArgBlockChainHook.VMTracer = vmTracer
+	vmFactory, err := shard.NewVMContainerFactory(argsNewVMFactory)
+	if err != nil {
+		return nil, err
+	}
+
+	err = vmcommonBuiltInFunctions.SetPayableHandler(builtInFuncs, vmFactory.BlockChainHookImpl())
+	if err != nil {
+		return nil, err
+	}
+
+	return vmFactory, nil
+}
+
 func (pcf *processComponentsFactory) createMetaTxSimulatorProcessor(
 	txSimulatorProcessorArgs *txsimulator.ArgsTxSimulator,
 	scProcArgs smartContract.ArgsNewSmartContractProcessor,
 	txTypeHandler process.TxTypeHandler,
 ) error {
+	accountsWrapper, err := txsimulator.NewReadOnlyAccountsDB(pcf.state.AccountsAdapter(), pcf.coreData.Hasher())
+	if err != nil {
+		return err
+	}
+
+	txLogsProcessor, err := pcf.createTxSimulatorLogsProcessor()
+	if err != nil {
+		return err
+	}
+
 	interimProcFactory, err := shard.NewIntermediateProcessorsContainerFactory(
 		pcf.bootstrapComponents.ShardCoordinator(),
 		pcf.coreData.InternalMarshalizer(),
@@ -975,17 +1098,15 @@ func (pcf *processComponentsFactory) createMetaTxSimulatorProcessor(
 
 	scProcArgs.TxFeeHandler = &processDisabled.FeeHandler{}
 
+	scProcArgs.AccountsDB = accountsWrapper
+	scProcArgs.TxLogsProcessor = txLogsProcessor
 	scProcArgs.VMOutputCacher = txSimulatorProcessorArgs.VMOutputCacher
+	scProcArgs.VMTracer = txSimulatorProcessorArgs.VMTracer
 	scProcessor, err := smartContract.NewSmartContractProcessor(scProcArgs)
 	if err != nil {
 		return err
 	}
 
-	accountsWrapper, err := txsimulator.NewReadOnlyAccountsDB(pcf.state.AccountsAdapter())
-	if err != nil {
-		return err
-	}
-
 	argsNewMetaTx := transaction.ArgsNewMetaTxProcessor{
 		Hasher:                                pcf.coreData.Hasher(),
 		Marshalizer:                           pcf.coreData.InternalMarshalizer(),
@@ -1006,6 +1127,18 @@ func (pcf *processComponentsFactory) createMetaTxSimulatorProcessor(
 	}
 
 	txSimulatorProcessorArgs.IntermediateProcContainer = interimProcContainer
+	txSimulatorProcessorArgs.SCRProcessor = scProcessor
+	txSimulatorProcessorArgs.TxLogsProcessor = txLogsProcessor
+	txSimulatorProcessorArgs.AccountsChangesHandler = accountsWrapper
 
 	return nil
 }
+
+// createTxSimulatorLogsProcessor creates a logs processor used only by the transaction simulator, so the logs of the
+// simulated transactions are neither mixed with the logs of the processed blocks nor saved in storage
+func (pcf *processComponentsFactory) createTxSimulatorLogsProcessor() (process.TransactionLogProcessor, error) {
+	return transactionLog.NewTxLogProcessor(transactionLog.ArgTxLogProcessor{
+		Marshalizer:          pcf.coreData.InternalMarshalizer(),
+		SaveInStorageEnabled: false,
+	})
+}
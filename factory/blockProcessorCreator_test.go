@@ -44,8 +44,10 @@ func Test_newBlockProcessorCreatorForShard(t *testing.T) {
 		&mock.PendingMiniBlocksHandlerStub{},
 		&txsimulator.ArgsTxSimulator{
 			VMOutputCacher: txcache.NewDisabledCache(),
+			VMTracer:       &testscommon.VMTracerStub{},
 		},
 		&sync.RWMutex{},
+		&testscommon.GasPriceTrackerStub{},
 	)
 
 	require.NoError(t, err)
@@ -147,8 +149,10 @@ func Test_newBlockProcessorCreatorForMeta(t *testing.T) {
 		&mock.PendingMiniBlocksHandlerStub{},
 		&txsimulator.ArgsTxSimulator{
 			VMOutputCacher: txcache.NewDisabledCache(),
+			VMTracer:       &testscommon.VMTracerStub{},
 		},
 		&sync.RWMutex{},
+		&testscommon.GasPriceTrackerStub{},
 	)
 
 	require.NoError(t, err)
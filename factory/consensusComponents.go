@@ -10,8 +10,11 @@ import (
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/consensus/chronology"
+	"github.com/ElrondNetwork/elrond-go/consensus/slashing"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/sposFactory"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	debugFactory "github.com/ElrondNetwork/elrond-go/debug/factory"
 	"github.com/ElrondNetwork/elrond-go/errors"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/sync"
@@ -20,6 +23,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/state/syncer"
 	"github.com/ElrondNetwork/elrond-go/trie/factory"
 	"github.com/ElrondNetwork/elrond-go/update"
+	"github.com/ElrondNetwork/elrond-go/vm"
 )
 
 // ConsensusComponentsFactoryArgs holds the arguments needed to create a consensus components factory
@@ -56,6 +60,8 @@ type consensusComponents struct {
 	bootstrapper       process.Bootstrapper
 	broadcastMessenger consensus.BroadcastMessenger
 	worker             ConsensusWorker
+	roundTracer        debugFactory.RoundTracerHandler
+	slashingDetector   consensus.SlashingDetector
 	hardforkTrigger    HardforkTrigger
 	consensusTopic     string
 	consensusGroupSize int
@@ -151,13 +157,21 @@ func (ccf *consensusComponentsFactory) Create() (*consensusComponents, error) {
 		return nil, err
 	}
 
+	cc.roundTracer, err = debugFactory.NewRoundTracerFactory(
+		ccf.config.Debug.ConsensusRounds,
+		ccf.processComponents.RoundHandler(),
+		ccf.coreComponents.SyncTimer(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	cc.broadcastMessenger, err = sposFactory.GetBroadcastMessenger(
 		ccf.coreComponents.InternalMarshalizer(),
 		ccf.coreComponents.Hasher(),
 		ccf.networkComponents.NetworkMessenger(),
 		ccf.processComponents.ShardCoordinator(),
-		ccf.cryptoComponents.PrivateKey(),
-		ccf.cryptoComponents.PeerSignatureHandler(),
+		ccf.cryptoComponents.SignerBackend(),
 		ccf.dataComponents.Datapool().Headers(),
 		ccf.processComponents.InterceptorsContainer(),
 		ccf.coreComponents.AlarmScheduler(),
@@ -166,6 +180,11 @@ func (ccf *consensusComponentsFactory) Create() (*consensusComponents, error) {
 		return nil, err
 	}
 
+	cc.slashingDetector, err = ccf.createSlashingDetector()
+	if err != nil {
+		return nil, err
+	}
+
 	marshalizer := ccf.coreComponents.InternalMarshalizer()
 	sizeCheckDelta := ccf.config.Marshalizer.SizeCheckDelta
 	if sizeCheckDelta > 0 {
@@ -196,6 +215,8 @@ func (ccf *consensusComponentsFactory) Create() (*consensusComponents, error) {
 		PublicKeySize:            ccf.config.ValidatorPubkeyConverter.Length,
 		AppStatusHandler:         ccf.coreComponents.StatusHandler(),
 		NodeRedundancyHandler:    ccf.processComponents.NodeRedundancyHandler(),
+		RoundTracer:              cc.roundTracer,
+		SlashingDetector:         cc.slashingDetector,
 	}
 
 	cc.worker, err = spos.NewWorker(workerArgs)
@@ -237,6 +258,9 @@ func (ccf *consensusComponentsFactory) Create() (*consensusComponents, error) {
 		HeaderSigVerifier:             ccf.processComponents.HeaderSigVerifier(),
 		FallbackHeaderValidator:       ccf.processComponents.FallbackHeaderValidator(),
 		NodeRedundancyHandler:         ccf.processComponents.NodeRedundancyHandler(),
+		RoundTracer:                   cc.roundTracer,
+		KeysHandler:                   ccf.cryptoComponents.KeysHandler(),
+		SignerBackend:                 ccf.cryptoComponents.SignerBackend(),
 	}
 
 	consensusDataContainer, err := spos.NewConsensusCore(
@@ -290,10 +314,34 @@ func (cc *consensusComponents) Close() error {
 	if err != nil {
 		return err
 	}
+	err = cc.roundTracer.Close()
+	if err != nil {
+		return err
+	}
 
 	return nil
 }
 
+func (ccf *consensusComponentsFactory) createSlashingDetector() (consensus.SlashingDetector, error) {
+	argsSlashingDetector := slashing.ArgsSlashingDetector{
+		Marshalizer:             ccf.coreComponents.InternalMarshalizer(),
+		Hasher:                  ccf.coreComponents.Hasher(),
+		Storer:                  ccf.dataComponents.StorageService().GetStorer(dataRetriever.SlashingEvidenceUnit),
+		MultiSigner:             ccf.cryptoComponents.MultiSigner(),
+		HeaderSigVerifier:       ccf.processComponents.HeaderSigVerifier(),
+		NodesCoordinator:        ccf.processComponents.NodesCoordinator(),
+		AddressPubKeyConverter:  ccf.coreComponents.AddressPubKeyConverter(),
+		SelfShardID:             ccf.processComponents.ShardCoordinator().SelfId(),
+		ChainID:                 []byte(ccf.coreComponents.ChainID()),
+		MinTransactionVersion:   ccf.coreComponents.MinTransactionVersion(),
+		EvidenceReceiverAddress: vm.ValidatorSCAddress,
+		EvidenceTxGasLimit:      ccf.config.Consensus.SlashingDetector.EvidenceTxGasLimit,
+		NumRoundsToKeep:         ccf.config.Consensus.SlashingDetector.NumRoundsToKeep,
+	}
+
+	return slashing.NewSlashingDetector(argsSlashingDetector)
+}
+
 func (ccf *consensusComponentsFactory) createChronology() (consensus.ChronologyHandler, error) {
 	wd := ccf.coreComponents.Watchdog()
 	if ccf.statusComponents.OutportHandler().HasDrivers() {
@@ -356,7 +404,9 @@ func (ccf *consensusComponentsFactory) createConsensusState(epoch uint32, consen
 		eligibleNodesPubKeys,
 		// TODO: move the consensus data from nodesSetup json to config
 		consensusGroupSize,
-		string(selfId))
+		string(selfId),
+		ccf.cryptoComponents.KeysHandler(),
+	)
 
 	roundConsensus.ResetRoundState()
 
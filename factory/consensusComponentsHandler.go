@@ -128,6 +128,12 @@ func (mcc *managedConsensusComponents) CheckSubcomponents() error {
 	if check.IfNil(mcc.broadcastMessenger) {
 		return errors.ErrNilBroadcastMessenger
 	}
+	if check.IfNil(mcc.roundTracer) {
+		return errors.ErrNilRoundTracer
+	}
+	if check.IfNil(mcc.slashingDetector) {
+		return errors.ErrNilSlashingDetector
+	}
 
 	return nil
 }
@@ -144,6 +150,30 @@ func (mcc *managedConsensusComponents) HardforkTrigger() HardforkTrigger {
 	return mcc.consensusComponents.hardforkTrigger
 }
 
+// RoundTracer returns the consensus round tracer
+func (mcc *managedConsensusComponents) RoundTracer() consensus.RoundTracer {
+	mcc.mutConsensusComponents.RLock()
+	defer mcc.mutConsensusComponents.RUnlock()
+
+	if mcc.consensusComponents == nil {
+		return nil
+	}
+
+	return mcc.consensusComponents.roundTracer
+}
+
+// SlashingDetector returns the component collecting the double signing evidences
+func (mcc *managedConsensusComponents) SlashingDetector() consensus.SlashingDetector {
+	mcc.mutConsensusComponents.RLock()
+	defer mcc.mutConsensusComponents.RUnlock()
+
+	if mcc.consensusComponents == nil {
+		return nil
+	}
+
+	return mcc.consensusComponents.slashingDetector
+}
+
 // IsInterfaceNil returns true if the underlying object is nil
 func (mcc *managedConsensusComponents) IsInterfaceNil() bool {
 	return mcc == nil
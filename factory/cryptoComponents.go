@@ -3,8 +3,13 @@ package factory
 import (
 	"bytes"
 	"encoding/hex"
+	stdErrors "errors"
 	"fmt"
+	"io"
+	"os"
+	"time"
 
+	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/hashing"
 	"github.com/ElrondNetwork/elrond-go-core/hashing/blake2b"
@@ -25,6 +30,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go/errors"
 	"github.com/ElrondNetwork/elrond-go/factory/peerSignatureHandler"
 	"github.com/ElrondNetwork/elrond-go/genesis/process/disabled"
+	"github.com/ElrondNetwork/elrond-go/keysManagement"
+	"github.com/ElrondNetwork/elrond-go/signerBackend"
 	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/ElrondNetwork/elrond-go/vm"
@@ -33,9 +40,15 @@ import (
 
 const disabledSigChecking = "disabled"
 
+const (
+	localSignerBackendType  = "local"
+	remoteSignerBackendType = "remote"
+)
+
 // CryptoComponentsFactoryArgs holds the arguments needed for creating crypto components
 type CryptoComponentsFactoryArgs struct {
 	ValidatorKeyPemFileName              string
+	AllValidatorKeysPemFileName          string
 	SkIndex                              int
 	Config                               config.Config
 	CoreComponentsHolder                 CoreComponentsHolder
@@ -48,6 +61,7 @@ type CryptoComponentsFactoryArgs struct {
 type cryptoComponentsFactory struct {
 	consensusType                        string
 	validatorKeyPemFileName              string
+	allValidatorKeysPemFileName          string
 	skIndex                              int
 	config                               config.Config
 	coreComponentsHolder                 CoreComponentsHolder
@@ -75,6 +89,8 @@ type cryptoComponents struct {
 	blockSignKeyGen     crypto.KeyGenerator
 	txSignKeyGen        crypto.KeyGenerator
 	messageSignVerifier vm.MessageSignVerifier
+	keysHandler         consensus.KeysHandler
+	signerBackend       consensus.SignerBackend
 	cryptoParams
 }
 
@@ -93,6 +109,7 @@ func NewCryptoComponentsFactory(args CryptoComponentsFactoryArgs) (*cryptoCompon
 	ccf := &cryptoComponentsFactory{
 		consensusType:                        args.Config.Consensus.Type,
 		validatorKeyPemFileName:              args.ValidatorKeyPemFileName,
+		allValidatorKeysPemFileName:          args.AllValidatorKeysPemFileName,
 		skIndex:                              args.SkIndex,
 		config:                               args.Config,
 		coreComponentsHolder:                 args.CoreComponentsHolder,
@@ -113,7 +130,12 @@ func (ccf *cryptoComponentsFactory) Create() (*cryptoComponents, error) {
 	}
 
 	blockSignKeyGen := signing.NewKeyGenerator(suite)
-	cp, err := ccf.createCryptoParams(blockSignKeyGen)
+	remoteSigner, err := ccf.createRemoteSignerBackend()
+	if err != nil {
+		return nil, err
+	}
+
+	cp, err := ccf.createCryptoParams(blockSignKeyGen, remoteSigner)
 	if err != nil {
 		return nil, err
 	}
@@ -164,6 +186,23 @@ func (ccf *cryptoComponentsFactory) Create() (*cryptoComponents, error) {
 		return nil, err
 	}
 
+	keysHandler, err := ccf.createKeysHandler(blockSignKeyGen, cp, remoteSigner)
+	if err != nil {
+		return nil, err
+	}
+
+	signer := remoteSigner
+	if check.IfNil(signer) {
+		signer, err = signerBackend.NewLocalSignerBackend(signerBackend.ArgsLocalSignerBackend{
+			KeysHandler:          keysHandler,
+			SingleSigner:         interceptSingleSigner,
+			PeerSignatureHandler: peerSigHandler,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	log.Debug("block sign pubkey", "value", cp.publicKeyString)
 
 	return &cryptoComponents{
@@ -174,6 +213,8 @@ func (ccf *cryptoComponentsFactory) Create() (*cryptoComponents, error) {
 		blockSignKeyGen:     blockSignKeyGen,
 		txSignKeyGen:        txSignKeyGen,
 		messageSignVerifier: messageSignVerifier,
+		keysHandler:         keysHandler,
+		signerBackend:       signer,
 		cryptoParams:        *cp,
 	}, nil
 }
@@ -185,7 +226,7 @@ func (ccf *cryptoComponentsFactory) createSingleSigner(importModeNoSigCheck bool
 	}
 
 	switch ccf.consensusType {
-	case consensus.BlsConsensusType:
+	case consensus.BlsConsensusType, consensus.PoAConsensusType:
 		return &mclSig.BlsSingleSigner{}, nil
 	case disabledSigChecking:
 		log.Warn("using disabled single signer")
@@ -196,7 +237,7 @@ func (ccf *cryptoComponentsFactory) createSingleSigner(importModeNoSigCheck bool
 }
 
 func (ccf *cryptoComponentsFactory) getMultiSigHasherFromConfig() (hashing.Hasher, error) {
-	if ccf.consensusType == consensus.BlsConsensusType && ccf.config.MultisigHasher.Type != "blake2b" {
+	if ccf.usesBlsSignatures() && ccf.config.MultisigHasher.Type != "blake2b" {
 		return nil, errors.ErrMultiSigHasherMissmatch
 	}
 
@@ -204,7 +245,7 @@ func (ccf *cryptoComponentsFactory) getMultiSigHasherFromConfig() (hashing.Hashe
 	case "sha256":
 		return sha256.NewSha256(), nil
 	case "blake2b":
-		if ccf.consensusType == consensus.BlsConsensusType {
+		if ccf.usesBlsSignatures() {
 			return blake2b.NewBlake2bWithSize(multisig.BlsHashSize)
 		}
 		return blake2b.NewBlake2b(), nil
@@ -213,6 +254,11 @@ func (ccf *cryptoComponentsFactory) getMultiSigHasherFromConfig() (hashing.Hashe
 	return nil, errors.ErrMissingMultiHasherConfig
 }
 
+// usesBlsSignatures returns true if the configured consensus engine seals the blocks with BLS signatures
+func (ccf *cryptoComponentsFactory) usesBlsSignatures() bool {
+	return ccf.consensusType == consensus.BlsConsensusType || ccf.consensusType == consensus.PoAConsensusType
+}
+
 func (ccf *cryptoComponentsFactory) createMultiSigner(
 	hasher hashing.Hasher,
 	cp *cryptoParams,
@@ -225,7 +271,7 @@ func (ccf *cryptoComponentsFactory) createMultiSigner(
 	}
 
 	switch ccf.consensusType {
-	case consensus.BlsConsensusType:
+	case consensus.BlsConsensusType, consensus.PoAConsensusType:
 		blsSigner := &mclMultiSig.BlsMultiSigner{Hasher: hasher}
 		return multisig.NewBLSMultisig(blsSigner, []string{string(cp.publicKeyBytes)}, cp.privateKey, blSignKeyGen, uint16(0))
 	case disabledSigChecking:
@@ -250,15 +296,96 @@ func (ccf *cryptoComponentsFactory) getSuite() (crypto.Suite, error) {
 
 func (ccf *cryptoComponentsFactory) createCryptoParams(
 	keygen crypto.KeyGenerator,
+	remoteSigner consensus.SignerBackend,
 ) (*cryptoParams, error) {
 
 	if ccf.isInImportMode {
 		return ccf.generateCryptoParams(keygen)
 	}
+	if !check.IfNil(remoteSigner) {
+		return ccf.createRemoteCryptoParams(keygen, remoteSigner)
+	}
 
 	return ccf.readCryptoParams(keygen)
 }
 
+// createRemoteSignerBackend connects to the remote signer, if configured. It returns nil if the node holds its keys
+func (ccf *cryptoComponentsFactory) createRemoteSignerBackend() (consensus.SignerBackend, error) {
+	signerConfig := ccf.config.Consensus.SignerBackend
+	switch signerConfig.Type {
+	case localSignerBackendType, "":
+		return nil, nil
+	case remoteSignerBackendType:
+	default:
+		return nil, fmt.Errorf("%w: %s", errors.ErrInvalidSignerBackendType, signerConfig.Type)
+	}
+
+	if ccf.isInImportMode {
+		log.Warn("the remote signer backend is not used in import mode")
+		return nil, nil
+	}
+
+	log.Debug("connecting to the remote signer", "network", signerConfig.Network, "address", signerConfig.Address)
+
+	return signerBackend.NewRemoteSignerBackend(signerBackend.ArgsRemoteSignerBackend{
+		Network:        signerConfig.Network,
+		Address:        signerConfig.Address,
+		RequestTimeout: time.Millisecond * time.Duration(signerConfig.RequestTimeoutInMillis),
+	})
+}
+
+// createRemoteCryptoParams uses the configured main public key, or the first key held by the remote signer, as the
+// node's own key. The private key is only a stand in as the key material never leaves the remote signer
+func (ccf *cryptoComponentsFactory) createRemoteCryptoParams(
+	keygen crypto.KeyGenerator,
+	remoteSigner consensus.SignerBackend,
+) (*cryptoParams, error) {
+	publicKeys, err := remoteSigner.PublicKeys()
+	if err != nil {
+		return nil, err
+	}
+	if len(publicKeys) == 0 {
+		return nil, errors.ErrEmptyRemoteSignerKeys
+	}
+
+	validatorKeyConverter := ccf.coreComponentsHolder.ValidatorPubKeyConverter()
+	pkBytes := publicKeys[0]
+	mainPublicKey := ccf.config.Consensus.SignerBackend.MainPublicKey
+	if len(mainPublicKey) > 0 {
+		pkBytes, err = validatorKeyConverter.Decode(mainPublicKey)
+		if err != nil {
+			return nil, fmt.Errorf("%w for the main public key %s", err, mainPublicKey)
+		}
+		if !containsKey(publicKeys, pkBytes) {
+			return nil, fmt.Errorf("%w: %s", errors.ErrMainPublicKeyNotInRemoteSigner, mainPublicKey)
+		}
+	}
+
+	cp := &cryptoParams{}
+	cp.publicKey, err = keygen.PublicKeyFromByteArray(pkBytes)
+	if err != nil {
+		return nil, err
+	}
+	cp.privateKey, err = signerBackend.NewRemotePrivateKey(cp.publicKey)
+	if err != nil {
+		return nil, err
+	}
+	cp.publicKeyBytes = pkBytes
+	cp.publicKeyString = validatorKeyConverter.Encode(cp.publicKeyBytes)
+
+	return cp, nil
+}
+
+func containsKey(keys [][]byte, key []byte) bool {
+	for _, k := range keys {
+		if bytes.Equal(k, key) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (ccf *cryptoComponentsFactory) readCryptoParams(keygen crypto.KeyGenerator) (*cryptoParams, error) {
 	cp := &cryptoParams{}
 	sk, readPk, err := ccf.getSkPk()
@@ -307,7 +434,11 @@ func (ccf *cryptoComponentsFactory) generateCryptoParams(keygen crypto.KeyGenera
 }
 
 func (ccf *cryptoComponentsFactory) getSkPk() ([]byte, []byte, error) {
-	encodedSk, pkString, err := ccf.keyLoader.LoadKey(ccf.validatorKeyPemFileName, ccf.skIndex)
+	return ccf.loadSkPk(ccf.validatorKeyPemFileName, ccf.skIndex)
+}
+
+func (ccf *cryptoComponentsFactory) loadSkPk(pemFileName string, skIndex int) ([]byte, []byte, error) {
+	encodedSk, pkString, err := ccf.keyLoader.LoadKey(pemFileName, skIndex)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -326,7 +457,116 @@ func (ccf *cryptoComponentsFactory) getSkPk() ([]byte, []byte, error) {
 	return skBytes, pkBytes, nil
 }
 
+// createKeysHandler creates the holder of all the BLS keys this node signs with: its own key and, if the all validator
+// keys file exists, each of the keys defined there. With a remote signer, the keys are the ones held by the remote signer
+func (ccf *cryptoComponentsFactory) createKeysHandler(
+	keygen crypto.KeyGenerator,
+	cp *cryptoParams,
+	remoteSigner consensus.SignerBackend,
+) (consensus.KeysHandler, error) {
+	if !check.IfNil(remoteSigner) {
+		return ccf.createRemoteKeysHandler(keygen, remoteSigner)
+	}
+
+	ownSk, err := cp.privateKey.ToByteArray()
+	if err != nil {
+		return nil, err
+	}
+
+	privateKeys := [][]byte{ownSk}
+	if !ccf.isInImportMode {
+		additionalKeys, errLoad := ccf.loadAllValidatorKeys(keygen, ownSk)
+		if errLoad != nil {
+			return nil, errLoad
+		}
+
+		privateKeys = append(privateKeys, additionalKeys...)
+	}
+
+	log.Debug("managed validator keys", "num keys", len(privateKeys))
+
+	return keysManagement.NewManagedKeysHolder(keysManagement.ArgsManagedKeysHolder{
+		KeyGenerator: keygen,
+		PrivateKeys:  privateKeys,
+	})
+}
+
+func (ccf *cryptoComponentsFactory) createRemoteKeysHandler(
+	keygen crypto.KeyGenerator,
+	remoteSigner consensus.SignerBackend,
+) (consensus.KeysHandler, error) {
+	publicKeys, err := remoteSigner.PublicKeys()
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debug("validator keys held by the remote signer", "num keys", len(publicKeys))
+
+	return keysManagement.NewManagedKeysHolder(keysManagement.ArgsManagedKeysHolder{
+		KeyGenerator: keygen,
+		PublicKeys:   publicKeys,
+	})
+}
+
+func (ccf *cryptoComponentsFactory) loadAllValidatorKeys(keygen crypto.KeyGenerator, ownSk []byte) ([][]byte, error) {
+	if len(ccf.allValidatorKeysPemFileName) == 0 {
+		return nil, nil
+	}
+	_, err := os.Stat(ccf.allValidatorKeysPemFileName)
+	if os.IsNotExist(err) {
+		log.Debug("no additional validator keys file, the node will only manage its own key",
+			"file", ccf.allValidatorKeysPemFileName)
+		return nil, nil
+	}
+
+	privateKeys := make([][]byte, 0)
+	for index := 0; ; index++ {
+		sk, readPk, errLoad := ccf.loadSkPk(ccf.allValidatorKeysPemFileName, index)
+		if stdErrors.Is(errLoad, core.ErrInvalidIndex) || stdErrors.Is(errLoad, core.ErrEmptyFile) {
+			break
+		}
+		if errLoad != nil {
+			return nil, fmt.Errorf("%w while loading key at index %d from %s", errLoad, index, ccf.allValidatorKeysPemFileName)
+		}
+		if bytes.Equal(sk, ownSk) {
+			log.Debug("skipping the node's own key found in the additional validator keys file", "index", index)
+			continue
+		}
+
+		err = checkPublicKeyMatches(keygen, sk, readPk)
+		if err != nil {
+			return nil, fmt.Errorf("%w for key at index %d from %s", err, index, ccf.allValidatorKeysPemFileName)
+		}
+
+		privateKeys = append(privateKeys, sk)
+	}
+
+	return privateKeys, nil
+}
+
+func checkPublicKeyMatches(keygen crypto.KeyGenerator, sk []byte, readPk []byte) error {
+	privateKey, err := keygen.PrivateKeyFromByteArray(sk)
+	if err != nil {
+		return err
+	}
+
+	pkBytes, err := privateKey.GeneratePublic().ToByteArray()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(pkBytes, readPk) {
+		return errors.ErrPublicKeyMismatch
+	}
+
+	return nil
+}
+
 // Close closes all underlying components that need closing
 func (cc *cryptoComponents) Close() error {
-	return nil
+	closer, ok := cc.signerBackend.(io.Closer)
+	if !ok {
+		return nil
+	}
+
+	return closer.Close()
 }
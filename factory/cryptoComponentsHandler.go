@@ -6,6 +6,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/errors"
 	"github.com/ElrondNetwork/elrond-go/vm"
 )
@@ -104,6 +105,12 @@ func (mcc *managedCryptoComponents) CheckSubcomponents() error {
 	if check.IfNil(mcc.cryptoComponents.messageSignVerifier) {
 		return errors.ErrNilMessageSignVerifier
 	}
+	if check.IfNil(mcc.cryptoComponents.keysHandler) {
+		return errors.ErrNilKeysHandler
+	}
+	if check.IfNil(mcc.cryptoComponents.signerBackend) {
+		return errors.ErrNilSignerBackend
+	}
 
 	return nil
 }
@@ -265,6 +272,30 @@ func (mcc *managedCryptoComponents) MessageSignVerifier() vm.MessageSignVerifier
 	return mcc.cryptoComponents.messageSignVerifier
 }
 
+// KeysHandler returns the holder of all the BLS keys managed by the current node
+func (mcc *managedCryptoComponents) KeysHandler() consensus.KeysHandler {
+	mcc.mutCryptoComponents.RLock()
+	defer mcc.mutCryptoComponents.RUnlock()
+
+	if mcc.cryptoComponents == nil {
+		return nil
+	}
+
+	return mcc.cryptoComponents.keysHandler
+}
+
+// SignerBackend returns the component signing on behalf of the BLS keys managed by the current node
+func (mcc *managedCryptoComponents) SignerBackend() consensus.SignerBackend {
+	mcc.mutCryptoComponents.RLock()
+	defer mcc.mutCryptoComponents.RUnlock()
+
+	if mcc.cryptoComponents == nil {
+		return nil
+	}
+
+	return mcc.cryptoComponents.signerBackend
+}
+
 // Clone creates a shallow clone of a managedCryptoComponents
 func (mcc *managedCryptoComponents) Clone() interface{} {
 	cryptoComp := (*cryptoComponents)(nil)
@@ -277,6 +308,8 @@ func (mcc *managedCryptoComponents) Clone() interface{} {
 			blockSignKeyGen:     mcc.BlockSignKeyGen(),
 			txSignKeyGen:        mcc.TxSignKeyGen(),
 			messageSignVerifier: mcc.MessageSignVerifier(),
+			keysHandler:         mcc.KeysHandler(),
+			signerBackend:       mcc.SignerBackend(),
 			cryptoParams:        mcc.cryptoParams,
 		}
 	}
@@ -3,14 +3,21 @@ package factory_test
 import (
 	"encoding/hex"
 	"errors"
+	"io/ioutil"
+	"os"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-crypto"
 	"github.com/ElrondNetwork/elrond-go-crypto/signing"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/mcl"
 	"github.com/ElrondNetwork/elrond-go/config"
 	errErd "github.com/ElrondNetwork/elrond-go/errors"
 	"github.com/ElrondNetwork/elrond-go/factory"
 	"github.com/ElrondNetwork/elrond-go/factory/mock"
+	"github.com/ElrondNetwork/elrond-go/signerBackend"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/cryptoMocks"
 	"github.com/stretchr/testify/require"
 )
 
@@ -132,6 +139,78 @@ func TestCryptoComponentsFactory_CreateOK(t *testing.T) {
 	require.NotNil(t, cc)
 }
 
+func TestCryptoComponentsFactory_CreateInvalidSignerBackendTypeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	coreComponents := getCoreComponents()
+	args := getCryptoArgs(coreComponents)
+	args.Config.Consensus.SignerBackend.Type = "invalid"
+	ccf, _ := factory.NewCryptoComponentsFactory(args)
+
+	cc, err := ccf.Create()
+	require.Nil(t, cc)
+	require.True(t, errors.Is(err, errErd.ErrInvalidSignerBackendType))
+}
+
+// not run in parallel as the calls to the remote signer are time bounded
+func TestCryptoComponentsFactory_CreateWithRemoteSignerBackend(t *testing.T) {
+	pkBytes, _ := hex.DecodeString(dummyPk)
+	backend := &cryptoMocks.SignerBackendStub{
+		PublicKeysCalled: func() ([][]byte, error) {
+			return [][]byte{pkBytes}, nil
+		},
+		SignPeerIDCalled: func(pk []byte, pid []byte) ([]byte, error) {
+			return []byte("signature"), nil
+		},
+	}
+	auditLogger, _ := signerBackend.NewAuditLogger(ioutil.Discard)
+	service, _ := signerBackend.NewSignerService(signerBackend.ArgsSignerService{
+		Backend:         backend,
+		Hasher:          &testscommon.HasherMock{},
+		AuditLogger:     auditLogger,
+		HistoryInRounds: 100,
+	})
+	server, err := signerBackend.NewSignerServer(signerBackend.ArgsSignerServer{
+		Service: service,
+		Network: "tcp",
+		Address: "127.0.0.1:0",
+	})
+	require.Nil(t, err)
+	defer func() {
+		_ = server.Close()
+	}()
+
+	coreComponents := getCoreComponents()
+	args := getCryptoArgs(coreComponents)
+	args.KeyLoader = &mock.KeyLoaderStub{
+		LoadKeyCalled: func(_ string, _ int) ([]byte, string, error) {
+			require.Fail(t, "should have not loaded the validator key")
+			return nil, "", nil
+		},
+	}
+	args.Config.Consensus.SignerBackend = config.SignerBackendConfig{
+		Type:                   "remote",
+		Network:                "tcp",
+		Address:                server.Address(),
+		RequestTimeoutInMillis: 5000,
+	}
+	ccf, _ := factory.NewCryptoComponentsFactory(args)
+	managedCryptoComponents, _ := factory.NewManagedCryptoComponents(ccf)
+
+	err = managedCryptoComponents.Create()
+	require.Nil(t, err)
+	defer func() {
+		_ = managedCryptoComponents.Close()
+	}()
+
+	require.Equal(t, pkBytes, managedCryptoComponents.PublicKeyBytes())
+	require.Equal(t, [][]byte{pkBytes}, managedCryptoComponents.KeysHandler().ManagedKeys())
+
+	signature, err := managedCryptoComponents.SignerBackend().SignPeerID(pkBytes, []byte("pid"))
+	require.Nil(t, err)
+	require.Equal(t, []byte("signature"), signature)
+}
+
 func TestCryptoComponentsFactory_CreateWithDisabledSig(t *testing.T) {
 	t.Parallel()
 
@@ -383,6 +462,91 @@ func TestCryptoComponentsFactory_GetSkPkOK(t *testing.T) {
 	require.Equal(t, expectedPk, pk)
 }
 
+func TestCryptoComponentsFactory_LoadAllValidatorKeysMissingFileShouldReturnEmpty(t *testing.T) {
+	t.Parallel()
+
+	coreComponents := getCoreComponents()
+	args := getCryptoArgs(coreComponents)
+	args.AllValidatorKeysPemFileName = "missing_all_validator_keys_file.pem"
+	ccf, _ := factory.NewCryptoComponentsFactory(args)
+
+	keys, err := ccf.LoadAllValidatorKeys(signing.NewKeyGenerator(mcl.NewSuiteBLS12()), []byte(dummySk))
+	require.Nil(t, err)
+	require.Equal(t, 0, len(keys))
+}
+
+func TestCryptoComponentsFactory_LoadAllValidatorKeysShouldSkipOwnKey(t *testing.T) {
+	t.Parallel()
+
+	keygen := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	sk, pk := keygen.GeneratePair()
+	skBytes, _ := sk.ToByteArray()
+	pkBytes, _ := pk.ToByteArray()
+	ownSk, _ := hex.DecodeString(dummySk)
+
+	coreComponents := getCoreComponents()
+	args := getCryptoArgs(coreComponents)
+	args.AllValidatorKeysPemFileName = createTempFile(t)
+	defer func() {
+		_ = os.Remove(args.AllValidatorKeysPemFileName)
+	}()
+	args.KeyLoader = &mock.KeyLoaderStub{
+		LoadKeyCalled: func(_ string, index int) ([]byte, string, error) {
+			switch index {
+			case 0:
+				return []byte(dummySk), dummyPk, nil
+			case 1:
+				return []byte(hex.EncodeToString(skBytes)), hex.EncodeToString(pkBytes), nil
+			default:
+				return nil, "", core.ErrInvalidIndex
+			}
+		},
+	}
+	ccf, _ := factory.NewCryptoComponentsFactory(args)
+
+	keys, err := ccf.LoadAllValidatorKeys(keygen, ownSk)
+	require.Nil(t, err)
+	require.Equal(t, [][]byte{skBytes}, keys)
+}
+
+func TestCryptoComponentsFactory_LoadAllValidatorKeysPublicKeyMismatchShouldErr(t *testing.T) {
+	t.Parallel()
+
+	keygen := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	sk, _ := keygen.GeneratePair()
+	skBytes, _ := sk.ToByteArray()
+	ownSk, _ := hex.DecodeString(dummySk)
+
+	coreComponents := getCoreComponents()
+	args := getCryptoArgs(coreComponents)
+	args.AllValidatorKeysPemFileName = createTempFile(t)
+	defer func() {
+		_ = os.Remove(args.AllValidatorKeysPemFileName)
+	}()
+	args.KeyLoader = &mock.KeyLoaderStub{
+		LoadKeyCalled: func(_ string, index int) ([]byte, string, error) {
+			if index == 0 {
+				return []byte(hex.EncodeToString(skBytes)), dummyPk, nil
+			}
+
+			return nil, "", core.ErrInvalidIndex
+		},
+	}
+	ccf, _ := factory.NewCryptoComponentsFactory(args)
+
+	keys, err := ccf.LoadAllValidatorKeys(keygen, ownSk)
+	require.Nil(t, keys)
+	require.True(t, errors.Is(err, errErd.ErrPublicKeyMismatch))
+}
+
+func createTempFile(t *testing.T) string {
+	file, err := ioutil.TempFile("", "allValidatorsKeys*.pem")
+	require.Nil(t, err)
+	_ = file.Close()
+
+	return file.Name()
+}
+
 func getCryptoArgs(coreComponents factory.CoreComponentsHolder) factory.CryptoComponentsFactoryArgs {
 	args := factory.CryptoComponentsFactoryArgs{
 		Config: config.Config{
@@ -31,7 +31,7 @@ func (ccf *cryptoComponentsFactory) CreateDummyCryptoParams() *cryptoParams {
 
 // CreateCryptoParams -
 func (ccf *cryptoComponentsFactory) CreateCryptoParams(blockSignKeyGen crypto.KeyGenerator) (*cryptoParams, error) {
-	return ccf.createCryptoParams(blockSignKeyGen)
+	return ccf.createCryptoParams(blockSignKeyGen, nil)
 }
 
 // CreateMultiSigner -
@@ -46,6 +46,11 @@ func (ccf *cryptoComponentsFactory) GetSuite() (crypto.Suite, error) {
 	return ccf.getSuite()
 }
 
+// LoadAllValidatorKeys -
+func (ccf *cryptoComponentsFactory) LoadAllValidatorKeys(keygen crypto.KeyGenerator, ownSk []byte) ([][]byte, error) {
+	return ccf.loadAllValidatorKeys(keygen, ownSk)
+}
+
 // SetListenAddress -
 func (ncf *networkComponentsFactory) SetListenAddress(address string) {
 	ncf.listenAddress = address
@@ -63,6 +68,7 @@ func (pcf *processComponentsFactory) NewBlockProcessor(
 	pendingMiniBlocksHandler process.PendingMiniBlocksHandler,
 	txSimulatorProcessorArgs *txsimulator.ArgsTxSimulator,
 	arwenChangeLocker process.Locker,
+	gasPriceTracker process.GasPriceTracker,
 ) (process.BlockProcessor, error) {
 	return pcf.newBlockProcessor(
 		requestHandler,
@@ -75,6 +81,7 @@ func (pcf *processComponentsFactory) NewBlockProcessor(
 		pendingMiniBlocksHandler,
 		txSimulatorProcessorArgs,
 		arwenChangeLocker,
+		gasPriceTracker,
 	)
 }
 
@@ -151,6 +151,8 @@ func (hcf *heartbeatComponentsFactory) Create() (*heartbeatComponents, error) {
 		HardforkTrigger:      hcf.hardforkTrigger,
 		CurrentBlockProvider: hcf.dataComponents.Blockchain(),
 		RedundancyHandler:    hcf.redundancyHandler,
+		KeysHandler:          hcf.cryptoComponents.KeysHandler(),
+		SignerBackend:        hcf.cryptoComponents.SignerBackend(),
 	}
 
 	hbc.sender, err = heartbeatProcess.NewSender(argSender)
@@ -27,6 +27,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/outport"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
+	gasPriceData "github.com/ElrondNetwork/elrond-go/process/gasprice/data"
 	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/state"
@@ -152,6 +153,8 @@ type CryptoComponentsHolder interface {
 	BlockSignKeyGen() crypto.KeyGenerator
 	TxSignKeyGen() crypto.KeyGenerator
 	MessageSignVerifier() vm.MessageSignVerifier
+	KeysHandler() consensus.KeysHandler
+	SignerBackend() consensus.SignerBackend
 	Clone() interface{}
 	IsInterfaceNil() bool
 }
@@ -220,6 +223,20 @@ type NetworkComponentsHandler interface {
 // TransactionSimulatorProcessor defines the actions which a transaction simulator processor has to implement
 type TransactionSimulatorProcessor interface {
 	ProcessTx(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	ProcessTxWithTrace(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	IsInterfaceNil() bool
+}
+
+// TransactionBundleSimulator defines the actions which a transactions bundle simulator has to implement
+type TransactionBundleSimulator interface {
+	ProcessBundle(txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error)
+	IsInterfaceNil() bool
+}
+
+// GasPriceEstimator defines the actions which a gas price estimator has to implement
+type GasPriceEstimator interface {
+	AddBlockGasPrices(gasUsed uint64, gasPrices []uint64)
+	GetGasPriceEstimates() *gasPriceData.GasPriceEstimates
 	IsInterfaceNil() bool
 }
 
@@ -248,6 +265,8 @@ type ProcessComponentsHolder interface {
 	PeerShardMapper() process.NetworkShardingCollector
 	FallbackHeaderValidator() process.FallbackHeaderValidator
 	TransactionSimulatorProcessor() TransactionSimulatorProcessor
+	TransactionBundleSimulator() TransactionBundleSimulator
+	GasPriceEstimator() GasPriceEstimator
 	WhiteListHandler() process.WhiteListHandler
 	WhiteListerVerifiedTxs() process.WhiteListHandler
 	HistoryRepository() dblookupext.HistoryRepository
@@ -383,6 +402,8 @@ type ConsensusComponentsHolder interface {
 	BroadcastMessenger() consensus.BroadcastMessenger
 	ConsensusGroupSize() (int, error)
 	HardforkTrigger() HardforkTrigger
+	RoundTracer() consensus.RoundTracer
+	SlashingDetector() consensus.SlashingDetector
 	IsInterfaceNil() bool
 }
 
@@ -3,6 +3,7 @@ package mock
 import (
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go/common/governance"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
@@ -14,6 +15,7 @@ type EpochNotifierStub struct {
 	CheckEpochCalled            func(header data.HeaderHandler)
 	CurrentEpochCalled          func() uint32
 	RegisterNotifyHandlerCalled func(handler vmcommon.EpochSubscriberHandler)
+	SetGovernedConfigCalled     func(governedConfig *governance.GovernedConfig)
 }
 
 // NewEpoch -
@@ -57,6 +59,13 @@ func (ens *EpochNotifierStub) CurrentEpoch() uint32 {
 	return 0
 }
 
+// SetGovernedConfig -
+func (ens *EpochNotifierStub) SetGovernedConfig(governedConfig *governance.GovernedConfig) {
+	if ens.SetGovernedConfigCalled != nil {
+		ens.SetGovernedConfigCalled(governedConfig)
+	}
+}
+
 // IsInterfaceNil -
 func (ens *EpochNotifierStub) IsInterfaceNil() bool {
 	return ens == nil
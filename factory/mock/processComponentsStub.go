@@ -35,6 +35,8 @@ type ProcessComponentsMock struct {
 	HeaderConstructValidator       process.HeaderConstructionValidator
 	PeerMapper                     process.NetworkShardingCollector
 	TxSimulatorProcessor           factory.TransactionSimulatorProcessor
+	TxBundleSimulator              factory.TransactionBundleSimulator
+	GasPriceEstimatorInternal      factory.GasPriceEstimator
 	FallbackHdrValidator           process.FallbackHeaderValidator
 	WhiteListHandlerInternal       process.WhiteListHandler
 	WhiteListerVerifiedTxsInternal process.WhiteListHandler
@@ -176,6 +178,16 @@ func (pcm *ProcessComponentsMock) TransactionSimulatorProcessor() factory.Transa
 	return pcm.TxSimulatorProcessor
 }
 
+// TransactionBundleSimulator -
+func (pcm *ProcessComponentsMock) TransactionBundleSimulator() factory.TransactionBundleSimulator {
+	return pcm.TxBundleSimulator
+}
+
+// GasPriceEstimator -
+func (pcm *ProcessComponentsMock) GasPriceEstimator() factory.GasPriceEstimator {
+	return pcm.GasPriceEstimatorInternal
+}
+
 // WhiteListHandler -
 func (pcm *ProcessComponentsMock) WhiteListHandler() process.WhiteListHandler {
 	return pcm.WhiteListHandlerInternal
@@ -40,9 +40,11 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/block/pendingMb"
 	"github.com/ElrondNetwork/elrond-go/process/block/poolsCleaner"
 	"github.com/ElrondNetwork/elrond-go/process/factory/interceptorscontainer"
+	"github.com/ElrondNetwork/elrond-go/process/gasprice"
 	"github.com/ElrondNetwork/elrond-go/process/headerCheck"
 	"github.com/ElrondNetwork/elrond-go/process/peer"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
 	"github.com/ElrondNetwork/elrond-go/process/sync"
 	"github.com/ElrondNetwork/elrond-go/process/track"
 	"github.com/ElrondNetwork/elrond-go/process/transactionLog"
@@ -87,6 +89,7 @@ type processComponents struct {
 	headerConstructionValidator process.HeaderConstructionValidator
 	peerShardMapper             process.NetworkShardingCollector
 	txSimulatorProcessor        TransactionSimulatorProcessor
+	txBundleSimulator           TransactionBundleSimulator
 	miniBlocksPoolCleaner       process.PoolsCleaner
 	txsPoolCleaner              process.PoolsCleaner
 	fallbackHeaderValidator     process.FallbackHeaderValidator
@@ -99,6 +102,7 @@ type processComponents struct {
 	nodeRedundancyHandler       consensus.NodeRedundancyHandler
 	currentEpochProvider        dataRetriever.CurrentNetworkEpochProviderHandler
 	arwenChangeLocker           process.Locker
+	gasPriceEstimator           GasPriceEstimator
 }
 
 // ProcessComponentsFactoryArgs holds the arguments needed to create a process components factory
@@ -303,6 +307,11 @@ func (pcf *processComponentsFactory) Create() (*processComponents, error) {
 		return nil, err
 	}
 
+	err = pcf.createGovernedConfigTracker()
+	if err != nil {
+		return nil, err
+	}
+
 	validatorStatisticsProcessor, err := pcf.newValidatorStatisticsProcessor()
 	if err != nil {
 		return nil, err
@@ -450,12 +459,29 @@ func (pcf *processComponentsFactory) Create() (*processComponents, error) {
 		return nil, err
 	}
 
+	txSimulatorVMTracer, err := tracing.NewVMTracer(pcf.coreData.AddressPubKeyConverter())
+	if err != nil {
+		return nil, err
+	}
+
 	txSimulatorProcessorArgs := &txsimulator.ArgsTxSimulator{
 		AddressPubKeyConverter: pcf.coreData.AddressPubKeyConverter(),
 		ShardCoordinator:       pcf.bootstrapComponents.ShardCoordinator(),
 		VMOutputCacher:         vmOutputCacher,
 		Hasher:                 pcf.coreData.Hasher(),
 		Marshalizer:            pcf.coreData.InternalMarshalizer(),
+		VMTracer:               txSimulatorVMTracer,
+	}
+
+	argsGasPriceEstimator := gasprice.ArgsGasPriceEstimator{
+		Config:           pcf.config.GasPriceEstimator,
+		EconomicsHandler: pcf.coreData.EconomicsData(),
+		ShardCoordinator: pcf.bootstrapComponents.ShardCoordinator(),
+		TxPool:           pcf.data.Datapool().Transactions(),
+	}
+	gasPriceEstimator, err := gasprice.NewGasPriceEstimator(argsGasPriceEstimator)
+	if err != nil {
+		return nil, err
 	}
 
 	blockProcessor, err := pcf.newBlockProcessor(
@@ -469,6 +495,7 @@ func (pcf *processComponentsFactory) Create() (*processComponents, error) {
 		pendingMiniBlocksHandler,
 		txSimulatorProcessorArgs,
 		arwenChangeLocker,
+		gasPriceEstimator,
 	)
 	if err != nil {
 		return nil, err
@@ -506,6 +533,16 @@ func (pcf *processComponentsFactory) Create() (*processComponents, error) {
 		return nil, err
 	}
 
+	txBundleSimulator, err := txsimulator.NewBundleSimulator(txsimulator.ArgsBundleSimulator{
+		ShardSimulators:      []txsimulator.ShardSimulator{txSimulator},
+		ShardCoordinator:     pcf.bootstrapComponents.ShardCoordinator(),
+		MaxBundleSize:        pcf.config.TxBundleSimulator.MaxBundleSize,
+		MaxContinuationSteps: pcf.config.TxBundleSimulator.MaxContinuationSteps,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	nodeRedundancyArg := redundancy.ArgNodeRedundancy{
 		RedundancyLevel:    pcf.prefConfigs.RedundancyLevel,
 		Messenger:          pcf.network.NetworkMessenger(),
@@ -539,6 +576,7 @@ func (pcf *processComponentsFactory) Create() (*processComponents, error) {
 		headerIntegrityVerifier:     pcf.bootstrapComponents.HeaderIntegrityVerifier(),
 		peerShardMapper:             peerShardMapper,
 		txSimulatorProcessor:        txSimulator,
+		txBundleSimulator:           txBundleSimulator,
 		miniBlocksPoolCleaner:       mbsPoolsCleaner,
 		txsPoolCleaner:              txsPoolsCleaner,
 		fallbackHeaderValidator:     fallbackHeaderValidator,
@@ -551,9 +589,32 @@ func (pcf *processComponentsFactory) Create() (*processComponents, error) {
 		nodeRedundancyHandler:       nodeRedundancyHandler,
 		currentEpochProvider:        currentEpochProvider,
 		arwenChangeLocker:           arwenChangeLocker,
+		gasPriceEstimator:           gasPriceEstimator,
 	}, nil
 }
 
+func (pcf *processComponentsFactory) createGovernedConfigTracker() error {
+	argsTracker := notifier.ArgsGovernedConfigTracker{
+		Marshalizer:          pcf.coreData.InternalMarshalizer(),
+		EpochNotifier:        pcf.coreData.EpochNotifier(),
+		EpochStartMetaStorer: pcf.data.StorageService().GetStorer(dataRetriever.MetaBlockUnit),
+	}
+	governedConfigTracker, err := notifier.NewGovernedConfigTracker(argsTracker)
+	if err != nil {
+		return err
+	}
+
+	startEpoch := pcf.bootstrapComponents.EpochBootstrapParams().Epoch()
+	err = governedConfigTracker.LoadEpochStartMetaBlock(startEpoch)
+	if err != nil {
+		log.Debug("no governed config loaded", "epoch", startEpoch, "error", err)
+	}
+
+	pcf.coreData.EpochStartNotifierWithConfirm().RegisterHandler(governedConfigTracker)
+
+	return nil
+}
+
 func (pcf *processComponentsFactory) newValidatorStatisticsProcessor() (process.ValidatorStatisticsProcessor, error) {
 
 	storageService := pcf.data.StorageService()
@@ -844,7 +905,7 @@ func (pcf *processComponentsFactory) indexGenesisBlocks(genesisBlocks map[uint32
 		}
 
 		log.Info("indexGenesisBlocks(): historyRepo.RecordBlock", "shardID", shardID, "hash", genesisBlockHash)
-		err = pcf.historyRepo.RecordBlock(genesisBlockHash, genesisBlockHeader, &dataBlock.Body{}, nil, nil)
+		err = pcf.historyRepo.RecordBlock(genesisBlockHash, genesisBlockHeader, &dataBlock.Body{}, nil, nil, nil)
 		if err != nil {
 			return err
 		}
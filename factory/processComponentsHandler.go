@@ -431,6 +431,30 @@ func (m *managedProcessComponents) TransactionSimulatorProcessor() TransactionSi
 	return m.processComponents.txSimulatorProcessor
 }
 
+// TransactionBundleSimulator returns the transactions bundle simulator
+func (m *managedProcessComponents) TransactionBundleSimulator() TransactionBundleSimulator {
+	m.mutProcessComponents.RLock()
+	defer m.mutProcessComponents.RUnlock()
+
+	if m.processComponents == nil {
+		return nil
+	}
+
+	return m.processComponents.txBundleSimulator
+}
+
+// GasPriceEstimator returns the gas price estimator
+func (m *managedProcessComponents) GasPriceEstimator() GasPriceEstimator {
+	m.mutProcessComponents.RLock()
+	defer m.mutProcessComponents.RUnlock()
+
+	if m.processComponents == nil {
+		return nil
+	}
+
+	return m.processComponents.gasPriceEstimator
+}
+
 // WhiteListHandler returns the white list handler
 func (m *managedProcessComponents) WhiteListHandler() process.WhiteListHandler {
 	m.mutProcessComponents.RLock()
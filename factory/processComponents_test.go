@@ -228,6 +228,9 @@ func FillGasMapMetaChainSystemSCsCosts(value uint64) map[string]uint64 {
 	gasMap["UnBondTokens"] = value
 	gasMap["DelegationMgrOps"] = value
 	gasMap["GetAllNodeStates"] = value
+	gasMap["MultisigCreate"] = value
+	gasMap["MultisigOps"] = value
+	gasMap["SchedulerOps"] = value
 	gasMap["ValidatorToDelegation"] = value
 
 	return gasMap
@@ -3,6 +3,7 @@ package mock
 import (
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
 
@@ -10,6 +11,7 @@ import (
 type QueryServiceStub struct {
 	ComputeScCallGasLimitCalled func(tx *transaction.Transaction) (uint64, error)
 	ExecuteQueryCalled          func(query *process.SCQuery) (*vmcommon.VMOutput, error)
+	ExecuteQueryWithTraceCalled func(query *process.SCQuery) (*vmcommon.VMOutput, *tracing.ExecutionTrace, error)
 	CloseCalled                 func() error
 }
 
@@ -31,6 +33,15 @@ func (qss *QueryServiceStub) ExecuteQuery(query *process.SCQuery) (*vmcommon.VMO
 	return &vmcommon.VMOutput{}, nil
 }
 
+// ExecuteQueryWithTrace -
+func (qss *QueryServiceStub) ExecuteQueryWithTrace(query *process.SCQuery) (*vmcommon.VMOutput, *tracing.ExecutionTrace, error) {
+	if qss.ExecuteQueryWithTraceCalled != nil {
+		return qss.ExecuteQueryWithTraceCalled(query)
+	}
+
+	return &vmcommon.VMOutput{}, nil, nil
+}
+
 // Close -
 func (qss *QueryServiceStub) Close() error {
 	if qss.CloseCalled != nil {
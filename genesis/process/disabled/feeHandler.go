@@ -36,6 +36,11 @@ func (fh *FeeHandler) MinGasPrice() uint64 {
 	return 0
 }
 
+// DynamicMinGasPrice returns 0
+func (fh *FeeHandler) DynamicMinGasPrice() uint64 {
+	return 0
+}
+
 // MinGasLimit returns 0
 func (fh *FeeHandler) MinGasLimit() uint64 {
 	return 0
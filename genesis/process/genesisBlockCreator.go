@@ -18,6 +18,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/genesis/process/intermediate"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
+	tracingDisabled "github.com/ElrondNetwork/elrond-go/process/smartContract/tracing/disabled"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	factoryState "github.com/ElrondNetwork/elrond-go/state/factory"
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
@@ -417,6 +418,7 @@ func (gbc *genesisBlockCreator) computeDNSAddresses() error {
 		DataPool:           gbc.arg.Data.Datapool(),
 		CompiledSCPool:     gbc.arg.Data.Datapool().SmartContracts(),
 		NilCompiledSCStore: true,
+		VMTracer:           tracingDisabled.NewDisabledVMTracer(),
 	}
 	blockChainHook, err := hooks.NewBlockChainHookImpl(argsHook)
 	if err != nil {
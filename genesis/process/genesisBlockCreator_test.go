@@ -1,3 +1,4 @@
+//go:build !race
 // +build !race
 
 package process
@@ -37,7 +38,7 @@ import (
 
 var nodePrice = big.NewInt(5000)
 
-//TODO improve code coverage of this package
+// TODO improve code coverage of this package
 func createMockArgument(
 	t *testing.T,
 	genesisFilename string,
@@ -21,10 +21,12 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/block/preprocess"
 	"github.com/ElrondNetwork/elrond-go/process/coordinator"
 	"github.com/ElrondNetwork/elrond-go/process/factory/shard"
+	"github.com/ElrondNetwork/elrond-go/process/guardian"
 	"github.com/ElrondNetwork/elrond-go/process/rewardTransaction"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
+	tracingDisabled "github.com/ElrondNetwork/elrond-go/process/smartContract/tracing/disabled"
 	"github.com/ElrondNetwork/elrond-go/process/transaction"
 	"github.com/ElrondNetwork/elrond-go/state"
 	"github.com/ElrondNetwork/elrond-go/storage/txcache"
@@ -60,6 +62,7 @@ func createGenesisConfig() config.EnableEpochs {
 		RelayedTransactionsV2EnableEpoch:            unreachableEpoch,
 		BuiltInFunctionOnMetaEnableEpoch:            unreachableEpoch,
 		IncrementSCRNonceInMultiTransferEnableEpoch: unreachableEpoch,
+		GuardiansEnableEpoch:                        unreachableEpoch,
 	}
 }
 
@@ -280,6 +283,7 @@ func createProcessorsForShardGenesisBlock(arg ArgsGenesisBlockCreator, enableEpo
 		ESDTTransferRoleEnableEpoch:  unreachableEpoch,
 		GlobalMintBurnDisableEpoch:   unreachableEpoch,
 		ESDTTransferMetaEnableEpoch:  unreachableEpoch,
+		GuardiansEnableEpoch:         unreachableEpoch,
 	}
 	builtInFuncs, err := builtInFunctions.CreateBuiltInFunctionContainer(argsBuiltIn)
 	if err != nil {
@@ -298,6 +302,7 @@ func createProcessorsForShardGenesisBlock(arg ArgsGenesisBlockCreator, enableEpo
 		DataPool:           arg.Data.Datapool(),
 		CompiledSCPool:     arg.Data.Datapool().SmartContracts(),
 		NilCompiledSCStore: true,
+		VMTracer:           tracingDisabled.NewDisabledVMTracer(),
 	}
 	esdtTransferParser, err := parsers.NewESDTTransferParser(arg.Core.InternalMarshalizer())
 	if err != nil {
@@ -423,6 +428,7 @@ func createProcessorsForShardGenesisBlock(arg ArgsGenesisBlockCreator, enableEpo
 		StakingV2EnableEpoch:                  arg.EpochConfig.EnableEpochs.StakingV2EnableEpoch,
 		VMOutputCacher:                        txcache.NewDisabledCache(),
 		ArwenChangeLocker:                     genesisArwenLocker,
+		VMTracer:                              tracingDisabled.NewDisabledVMTracer(),
 
 		IncrementSCRNonceInMultiTransferEnableEpoch: enableEpochs.IncrementSCRNonceInMultiTransferEnableEpoch,
 	}
@@ -440,6 +446,11 @@ func createProcessorsForShardGenesisBlock(arg ArgsGenesisBlockCreator, enableEpo
 		return nil, err
 	}
 
+	guardedAccounts, err := guardian.NewGuardedAccount(arg.Core.InternalMarshalizer(), epochNotifier)
+	if err != nil {
+		return nil, err
+	}
+
 	argsNewTxProcessor := transaction.ArgsNewTxProcessor{
 		Accounts:                       arg.Accounts,
 		Hasher:                         arg.Core.Hasher(),
@@ -455,11 +466,13 @@ func createProcessorsForShardGenesisBlock(arg ArgsGenesisBlockCreator, enableEpo
 		BadTxForwarder:                 badTxInterim,
 		ArgsParser:                     smartContract.NewArgumentParser(),
 		ScrForwarder:                   scForwarder,
+		GuardedAccountHandler:          guardedAccounts,
 		EpochNotifier:                  epochNotifier,
 		RelayedTxEnableEpoch:           enableEpochs.RelayedTransactionsEnableEpoch,
 		PenalizedTooMuchGasEnableEpoch: enableEpochs.PenalizedTooMuchGasEnableEpoch,
 		MetaProtectionEnableEpoch:      enableEpochs.MetaProtectionEnableEpoch,
 		RelayedTxV2EnableEpoch:         enableEpochs.RelayedTransactionsV2EnableEpoch,
+		GuardiansEnableEpoch:           enableEpochs.GuardiansEnableEpoch,
 	}
 	transactionProcessor, err := transaction.NewTxProcessor(argsNewTxProcessor)
 	if err != nil {
@@ -528,6 +541,7 @@ func createProcessorsForShardGenesisBlock(arg ArgsGenesisBlockCreator, enableEpo
 		BlockChainHook:    vmFactoryImpl.BlockChainHookImpl(),
 		BlockChain:        arg.Data.Blockchain(),
 		ArwenChangeLocker: genesisArwenLocker,
+		VMTracer:          tracingDisabled.NewDisabledVMTracer(),
 	}
 	queryService, err := smartContract.NewSCQueryService(argsNewSCQueryService)
 	if err != nil {
@@ -104,3 +104,9 @@ var ErrNilCurrentBlockProvider = errors.New("nil current block provider")
 
 // ErrNilRedundancyHandler signals that a nil redundancy handler was provided
 var ErrNilRedundancyHandler = errors.New("nil redundancy handler")
+
+// ErrNilKeysHandler signals that a nil keys handler was provided
+var ErrNilKeysHandler = errors.New("nil keys handler")
+
+// ErrNilSignerBackend signals that a nil signer backend was provided
+var ErrNilSignerBackend = errors.New("nil signer backend")
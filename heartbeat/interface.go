@@ -120,3 +120,16 @@ type NodeRedundancyHandler interface {
 	ObserverPrivateKey() crypto.PrivateKey
 	IsInterfaceNil() bool
 }
+
+// KeysHandler defines the subset of the managed keys holder used when sending heartbeat messages
+type KeysHandler interface {
+	ManagedKeys() [][]byte
+	IncrementHeartbeatsSent(pkBytes []byte)
+	IsInterfaceNil() bool
+}
+
+// SignerBackend defines the component able to sign the peer ID on behalf of a validator key
+type SignerBackend interface {
+	SignPeerID(pkBytes []byte, pid []byte) ([]byte, error)
+	IsInterfaceNil() bool
+}
@@ -1,6 +1,7 @@
 package process
 
 import (
+	"bytes"
 	"fmt"
 	"time"
 
@@ -33,13 +34,14 @@ type ArgHeartbeatSender struct {
 	HardforkTrigger      heartbeat.HardforkTrigger
 	CurrentBlockProvider heartbeat.CurrentBlockProvider
 	RedundancyHandler    heartbeat.NodeRedundancyHandler
+	KeysHandler          heartbeat.KeysHandler
+	SignerBackend        heartbeat.SignerBackend
 }
 
 // Sender periodically sends heartbeat messages on a pubsub topic
 type Sender struct {
 	peerMessenger        heartbeat.P2PMessenger
 	peerSignatureHandler crypto.PeerSignatureHandler
-	privKey              crypto.PrivateKey
 	publicKey            crypto.PublicKey
 	observerPublicKey    crypto.PublicKey
 	marshalizer          marshal.Marshalizer
@@ -54,6 +56,8 @@ type Sender struct {
 	hardforkTrigger      heartbeat.HardforkTrigger
 	currentBlockProvider heartbeat.CurrentBlockProvider
 	redundancy           heartbeat.NodeRedundancyHandler
+	keysHandler          heartbeat.KeysHandler
+	signerBackend        heartbeat.SignerBackend
 }
 
 // NewSender will create a new sender instance
@@ -88,6 +92,12 @@ func NewSender(arg ArgHeartbeatSender) (*Sender, error) {
 	if check.IfNil(arg.RedundancyHandler) {
 		return nil, heartbeat.ErrNilRedundancyHandler
 	}
+	if check.IfNil(arg.KeysHandler) {
+		return nil, heartbeat.ErrNilKeysHandler
+	}
+	if check.IfNil(arg.SignerBackend) {
+		return nil, heartbeat.ErrNilSignerBackend
+	}
 	err := VerifyHeartbeatPropertyLen("application version string", []byte(arg.VersionNumber))
 	if err != nil {
 		return nil, err
@@ -101,7 +111,6 @@ func NewSender(arg ArgHeartbeatSender) (*Sender, error) {
 	sender := &Sender{
 		peerMessenger:        arg.PeerMessenger,
 		peerSignatureHandler: arg.PeerSignatureHandler,
-		privKey:              arg.PrivKey,
 		publicKey:            arg.PrivKey.GeneratePublic(),
 		observerPublicKey:    observerPrivateKey.GeneratePublic(),
 		marshalizer:          arg.Marshalizer,
@@ -116,6 +125,8 @@ func NewSender(arg ArgHeartbeatSender) (*Sender, error) {
 		hardforkTrigger:      arg.HardforkTrigger,
 		currentBlockProvider: arg.CurrentBlockProvider,
 		redundancy:           arg.RedundancyHandler,
+		keysHandler:          arg.KeysHandler,
+		signerBackend:        arg.SignerBackend,
 	}
 
 	return sender, nil
@@ -170,12 +181,72 @@ func (s *Sender) SendHeartbeat() error {
 	}
 
 	s.peerMessenger.Broadcast(s.topic, buffToSend)
+	s.keysHandler.IncrementHeartbeatsSent(hb.Pubkey)
+
+	// the managed keys heartbeats are sent after the main one because the receivers map the peer ID on the last
+	// received public key and the main key might belong to an observer
+	s.sendManagedKeysHeartbeats(hb)
+
+	return nil
+}
+
+func (s *Sender) sendManagedKeysHeartbeats(mainHeartbeat *heartbeatData.Heartbeat) {
+	isMainMachineActive := s.redundancy.IsRedundancyNode() && s.redundancy.IsMainMachineActive()
+	if isMainMachineActive {
+		return
+	}
+
+	for _, pkBytes := range s.keysHandler.ManagedKeys() {
+		if bytes.Equal(pkBytes, mainHeartbeat.Pubkey) {
+			continue
+		}
+
+		err := s.sendManagedKeyHeartbeat(mainHeartbeat, pkBytes)
+		if err != nil {
+			log.Warn("sender: send managed key heartbeat",
+				"pk", pkBytes,
+				"error", err.Error(),
+			)
+		}
+	}
+}
+
+func (s *Sender) sendManagedKeyHeartbeat(mainHeartbeat *heartbeatData.Heartbeat, pkBytes []byte) error {
+	hb := &heartbeatData.Heartbeat{
+		Payload:         mainHeartbeat.Payload,
+		Pubkey:          pkBytes,
+		ShardID:         mainHeartbeat.ShardID,
+		VersionNumber:   mainHeartbeat.VersionNumber,
+		NodeDisplayName: mainHeartbeat.NodeDisplayName,
+		Identity:        mainHeartbeat.Identity,
+		Pid:             mainHeartbeat.Pid,
+		Nonce:           mainHeartbeat.Nonce,
+		PeerSubType:     mainHeartbeat.PeerSubType,
+	}
+
+	var err error
+	hb.Signature, err = s.signerBackend.SignPeerID(pkBytes, hb.Pid)
+	if err != nil {
+		return err
+	}
+
+	buffToSend, err := s.marshalizer.Marshal(hb)
+	if err != nil {
+		return err
+	}
+
+	s.peerMessenger.Broadcast(s.topic, buffToSend)
+	s.keysHandler.IncrementHeartbeatsSent(pkBytes)
 
 	return nil
 }
 
 func (s *Sender) finalizeMessageConstruction(hb *heartbeatData.Heartbeat) error {
-	sk, pk := s.getCurrentPrivateAndPublicKeys()
+	shouldUseOriginalKeys := s.shouldUseOriginalKeys()
+	pk := s.publicKey
+	if !shouldUseOriginalKeys {
+		pk = s.observerPublicKey
+	}
 
 	var err error
 	hb.Pubkey, err = pk.ToByteArray()
@@ -191,18 +262,19 @@ func (s *Sender) finalizeMessageConstruction(hb *heartbeatData.Heartbeat) error
 		trimLengths(hb)
 	}
 
-	hb.Signature, err = s.peerSignatureHandler.GetPeerSignature(sk, hb.Pid)
+	if shouldUseOriginalKeys {
+		hb.Signature, err = s.signerBackend.SignPeerID(hb.Pubkey, hb.Pid)
+		return err
+	}
+
+	// the observer key of a backup node is always held locally
+	hb.Signature, err = s.peerSignatureHandler.GetPeerSignature(s.redundancy.ObserverPrivateKey(), hb.Pid)
 
 	return err
 }
 
-func (s *Sender) getCurrentPrivateAndPublicKeys() (crypto.PrivateKey, crypto.PublicKey) {
-	shouldUseOriginalKeys := !s.redundancy.IsRedundancyNode() || (s.redundancy.IsRedundancyNode() && !s.redundancy.IsMainMachineActive())
-	if shouldUseOriginalKeys {
-		return s.privKey, s.publicKey
-	}
-
-	return s.redundancy.ObserverPrivateKey(), s.observerPublicKey
+func (s *Sender) shouldUseOriginalKeys() bool {
+	return !s.redundancy.IsRedundancyNode() || (s.redundancy.IsRedundancyNode() && !s.redundancy.IsMainMachineActive())
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
@@ -13,6 +13,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/mock"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/process"
+	"github.com/ElrondNetwork/elrond-go/testscommon/cryptoMocks"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -39,6 +40,8 @@ func createMockArgHeartbeatSender() process.ArgHeartbeatSender {
 		HardforkTrigger:      &mock.HardforkTriggerStub{},
 		CurrentBlockProvider: &mock.CurrentBlockProviderStub{},
 		RedundancyHandler:    &mock.RedundancyHandlerStub{},
+		KeysHandler:          &cryptoMocks.KeysHandlerStub{},
+		SignerBackend:        &cryptoMocks.SignerBackendStub{},
 	}
 }
 
@@ -163,6 +166,28 @@ func TestNewSender_NilRedundancyHandlerShouldErr(t *testing.T) {
 	assert.True(t, errors.Is(err, heartbeat.ErrNilRedundancyHandler))
 }
 
+func TestNewSender_NilKeysHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgHeartbeatSender()
+	arg.KeysHandler = nil
+	sender, err := process.NewSender(arg)
+
+	assert.Nil(t, sender)
+	assert.Equal(t, heartbeat.ErrNilKeysHandler, err)
+}
+
+func TestNewSender_NilSignerBackendShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgHeartbeatSender()
+	arg.SignerBackend = nil
+	sender, err := process.NewSender(arg)
+
+	assert.Nil(t, sender)
+	assert.Equal(t, heartbeat.ErrNilSignerBackend, err)
+}
+
 func TestNewSender_RedundancyHandlerReturnsANilObserverPrivateKeyShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -231,13 +256,12 @@ func testSendHeartbeat(t *testing.T, pubKeyErr, signErr, marshalErr error) {
 		},
 	}
 
-	singleSigner := &mock.SinglesignStub{
-		SignCalled: func(private crypto.PrivateKey, msg []byte) (i []byte, e error) {
+	arg.SignerBackend = &cryptoMocks.SignerBackendStub{
+		SignPeerIDCalled: func(pkBytes []byte, pid []byte) ([]byte, error) {
 			expectedErr = signErr
 			return nil, signErr
 		},
 	}
-	arg.PeerSignatureHandler = &mock.PeerSignatureHandler{Signer: singleSigner}
 
 	arg.Marshalizer = &mock.MarshalizerStub{
 		MarshalHandler: func(obj interface{}) (i []byte, e error) {
@@ -279,13 +303,12 @@ func TestSender_SendHeartbeatShouldWork(t *testing.T) {
 			}
 		},
 	}
-	singleSigner := &mock.SinglesignStub{
-		SignCalled: func(private crypto.PrivateKey, msg []byte) (i []byte, e error) {
+	arg.SignerBackend = &cryptoMocks.SignerBackendStub{
+		SignPeerIDCalled: func(pkBytes []byte, pid []byte) ([]byte, error) {
 			signCalled = true
 			return signature, nil
 		},
 	}
-	arg.PeerSignatureHandler = &mock.PeerSignatureHandler{Signer: singleSigner}
 
 	arg.PrivKey = &mock.PrivateKeyStub{
 		GeneratePublicHandler: func() crypto.PublicKey {
@@ -348,13 +371,12 @@ func TestSender_SendHeartbeatNotABackupNodeShouldWork(t *testing.T) {
 			}
 		},
 	}
-	singleSigner := &mock.SinglesignStub{
-		SignCalled: func(private crypto.PrivateKey, msg []byte) (i []byte, e error) {
+	arg.SignerBackend = &cryptoMocks.SignerBackendStub{
+		SignPeerIDCalled: func(pkBytes []byte, pid []byte) ([]byte, error) {
 			signCalled = true
 			return signature, nil
 		},
 	}
-	arg.PeerSignatureHandler = &mock.PeerSignatureHandler{Signer: singleSigner}
 
 	arg.PrivKey = &mock.PrivateKeyStub{
 		GeneratePublicHandler: func() crypto.PublicKey {
@@ -492,13 +514,12 @@ func TestSender_SendHeartbeatIsBackupNodeButMainIsNotActiveShouldWork(t *testing
 			}
 		},
 	}
-	singleSigner := &mock.SinglesignStub{
-		SignCalled: func(private crypto.PrivateKey, msg []byte) (i []byte, e error) {
+	arg.SignerBackend = &cryptoMocks.SignerBackendStub{
+		SignPeerIDCalled: func(pkBytes []byte, pid []byte) ([]byte, error) {
 			signCalled = true
 			return signature, nil
 		},
 	}
-	arg.PeerSignatureHandler = &mock.PeerSignatureHandler{Signer: singleSigner}
 
 	arg.PrivKey = &mock.PrivateKeyStub{
 		GeneratePublicHandler: func() crypto.PublicKey {
@@ -546,13 +567,12 @@ func TestSender_SendHeartbeatAfterTriggerShouldWork(t *testing.T) {
 			}
 		},
 	}
-	singleSigner := &mock.SinglesignStub{
-		SignCalled: func(private crypto.PrivateKey, msg []byte) (i []byte, e error) {
+	arg.SignerBackend = &cryptoMocks.SignerBackendStub{
+		SignPeerIDCalled: func(pkBytes []byte, pid []byte) ([]byte, error) {
 			signCalled = true
 			return signature, nil
 		},
 	}
-	arg.PeerSignatureHandler = &mock.PeerSignatureHandler{Signer: singleSigner}
 
 	arg.PrivKey = &mock.PrivateKeyStub{
 		GeneratePublicHandler: func() crypto.PublicKey {
@@ -630,13 +650,12 @@ func TestSender_SendHeartbeatAfterTriggerWithRecorededPayloadShouldWork(t *testi
 			}
 		},
 	}
-	singleSigner := &mock.SinglesignStub{
-		SignCalled: func(private crypto.PrivateKey, msg []byte) (i []byte, e error) {
+	arg.SignerBackend = &cryptoMocks.SignerBackendStub{
+		SignPeerIDCalled: func(pkBytes []byte, pid []byte) ([]byte, error) {
 			signCalled = true
 			return signature, nil
 		},
 	}
-	arg.PeerSignatureHandler = &mock.PeerSignatureHandler{Signer: singleSigner}
 
 	arg.PrivKey = &mock.PrivateKeyStub{
 		GeneratePublicHandler: func() crypto.PublicKey {
@@ -676,3 +695,102 @@ func TestSender_SendHeartbeatAfterTriggerWithRecorededPayloadShouldWork(t *testi
 	assert.True(t, genPubKeyCalled)
 	assert.True(t, marshalCalled)
 }
+
+func TestSender_SendHeartbeatShouldSendForManagedKeys(t *testing.T) {
+	t.Parallel()
+
+	mainPkBytes := []byte("main pub key")
+	managedPkBytes := []byte("managed pub key")
+
+	arg := createMockArgHeartbeatSender()
+	arg.Marshalizer = &mock.MarshalizerMock{}
+	arg.PrivKey = &mock.PrivateKeyStub{
+		GeneratePublicHandler: func() crypto.PublicKey {
+			return &mock.PublicKeyMock{
+				ToByteArrayHandler: func() (i []byte, e error) {
+					return mainPkBytes, nil
+				},
+			}
+		},
+	}
+	sentPubKeys := make([][]byte, 0)
+	arg.PeerMessenger = &mock.MessengerStub{
+		BroadcastCalled: func(topic string, buff []byte) {
+			hb := &data.Heartbeat{}
+			_ = arg.Marshalizer.Unmarshal(hb, buff)
+			sentPubKeys = append(sentPubKeys, hb.Pubkey)
+			assert.Equal(t, append([]byte("signature of "), hb.Pubkey...), hb.Signature)
+		},
+	}
+	arg.SignerBackend = &cryptoMocks.SignerBackendStub{
+		SignPeerIDCalled: func(pkBytes []byte, pid []byte) ([]byte, error) {
+			return append([]byte("signature of "), pkBytes...), nil
+		},
+	}
+	heartbeatsSent := make(map[string]int)
+	arg.KeysHandler = &cryptoMocks.KeysHandlerStub{
+		ManagedKeysCalled: func() [][]byte {
+			return [][]byte{mainPkBytes, managedPkBytes}
+		},
+		IncrementHeartbeatsSentCalled: func(pkBytes []byte) {
+			heartbeatsSent[string(pkBytes)]++
+		},
+	}
+	sender, _ := process.NewSender(arg)
+
+	err := sender.SendHeartbeat()
+
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{mainPkBytes, managedPkBytes}, sentPubKeys)
+	assert.Equal(t, 1, heartbeatsSent[string(mainPkBytes)])
+	assert.Equal(t, 1, heartbeatsSent[string(managedPkBytes)])
+}
+
+func TestSender_SendHeartbeatBackupNodeWithActiveMainShouldNotSendForManagedKeys(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgHeartbeatSender()
+	arg.Marshalizer = &mock.MarshalizerMock{}
+	arg.RedundancyHandler = &mock.RedundancyHandlerStub{
+		IsRedundancyNodeCalled: func() bool {
+			return true
+		},
+		IsMainMachineActiveCalled: func() bool {
+			return true
+		},
+		ObserverPrivateKeyCalled: func() crypto.PrivateKey {
+			return &mock.PrivateKeyStub{
+				GeneratePublicHandler: func() crypto.PublicKey {
+					return &mock.PublicKeyMock{
+						ToByteArrayHandler: func() (i []byte, e error) {
+							return []byte("observer pub key"), nil
+						},
+					}
+				},
+			}
+		},
+	}
+	numBroadcasts := 0
+	arg.PeerMessenger = &mock.MessengerStub{
+		BroadcastCalled: func(topic string, buff []byte) {
+			numBroadcasts++
+		},
+	}
+	arg.KeysHandler = &cryptoMocks.KeysHandlerStub{
+		ManagedKeysCalled: func() [][]byte {
+			return [][]byte{[]byte("managed pub key")}
+		},
+	}
+	arg.SignerBackend = &cryptoMocks.SignerBackendStub{
+		SignPeerIDCalled: func(pkBytes []byte, pid []byte) ([]byte, error) {
+			assert.Fail(t, "should have not called SignPeerID")
+			return nil, nil
+		},
+	}
+	sender, _ := process.NewSender(arg)
+
+	err := sender.SendHeartbeat()
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, numBroadcasts)
+}
@@ -7,11 +7,15 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/data"
+	dataBlock "github.com/ElrondNetwork/elrond-go-core/data/block"
 	"github.com/ElrondNetwork/elrond-go-crypto"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/factory"
 	"github.com/ElrondNetwork/elrond-go/integrationTests"
+	"github.com/ElrondNetwork/elrond-go/integrationTests/simulation"
+	"github.com/ElrondNetwork/elrond-go/p2p/memp2p"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/stretchr/testify/assert"
 )
@@ -84,7 +88,7 @@ func initNodesAndTest(
 	return nodes[0], concMap
 }
 
-func startNodesWithCommitBlock(nodes []*testNode, mutex *sync.Mutex, nonceForRoundMap map[uint64]uint64, totalCalled *int) error {
+func startNodesWithCommitBlock(nodes []*testNode, consensusType string, mutex *sync.Mutex, nonceForRoundMap map[uint64]uint64, totalCalled *int) error {
 	for _, n := range nodes {
 		nCopy := n
 		n.blkProcessor.CommitBlockCalled = func(header data.HeaderHandler, body data.BodyHandler) error {
@@ -104,7 +108,10 @@ func startNodesWithCommitBlock(nodes []*testNode, mutex *sync.Mutex, nonceForRou
 		consensusArgs := factory.ConsensusComponentsFactoryArgs{
 			Config: config.Config{
 				Consensus: config.ConsensusConfig{
-					Type: blsConsensusType,
+					Type: consensusType,
+					SlashingDetector: config.SlashingDetectorConfig{
+						NumRoundsToKeep: 10,
+					},
 				},
 				ValidatorPubkeyConverter: config.PubkeyConfig{
 					Length:          96,
@@ -206,7 +213,7 @@ func runFullConsensusTest(t *testing.T, consensusType string) {
 
 	nonceForRoundMap := make(map[uint64]uint64)
 	totalCalled := 0
-	err := startNodesWithCommitBlock(nodes, mutex, nonceForRoundMap, &totalCalled)
+	err := startNodesWithCommitBlock(nodes, consensusType, mutex, nonceForRoundMap, &totalCalled)
 	assert.Nil(t, err)
 
 	chDone := make(chan bool)
@@ -233,6 +240,179 @@ func TestConsensusBLSFullTest(t *testing.T) {
 	runFullConsensusTest(t, blsConsensusType)
 }
 
+type simulatedConsensusResult struct {
+	nonceForRoundMap    map[uint64]uint64
+	headerHashesByRound map[uint64]map[string]struct{}
+}
+
+func runConsensusOnSimulator(
+	t *testing.T,
+	consensusType string,
+	numNodes uint32,
+	consensusSize uint32,
+	seed int64,
+	numRounds uint64,
+	setup func(sim *simulation.Simulator, nodes []*testNode),
+) *simulatedConsensusResult {
+	roundTime := uint64(1000)
+
+	sim, err := simulation.NewSimulator(simulation.ArgsSimulator{
+		Seed:          seed,
+		GenesisTime:   time.Unix(1600000000, 0),
+		StepDuration:  time.Millisecond * 10,
+		StepRealDelay: time.Millisecond * 5,
+	})
+	assert.Nil(t, err)
+	sim.Network().SetDefaultLinkConditions(memp2p.LinkConditions{
+		Latency: time.Millisecond * 30,
+	})
+
+	nodes := createNodesOnSimulator(int(numNodes), int(consensusSize), roundTime, consensusType, sim)[0]
+	defer func() {
+		for _, n := range nodes {
+			_ = n.messenger.Close()
+		}
+	}()
+
+	mutex := &sync.Mutex{}
+	result := &simulatedConsensusResult{
+		nonceForRoundMap:    make(map[uint64]uint64),
+		headerHashesByRound: make(map[uint64]map[string]struct{}),
+	}
+	totalCalled := 0
+	err = startNodesWithCommitBlock(nodes, consensusType, mutex, result.nonceForRoundMap, &totalCalled)
+	assert.Nil(t, err)
+
+	for _, n := range nodes {
+		recordCommittedHeaderHashes(n, mutex, result)
+	}
+	if setup != nil {
+		setup(sim, nodes)
+	}
+
+	sim.RunFor(time.Duration(roundTime) * time.Duration(numRounds) * time.Millisecond)
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	fmt.Println("saved nonces for rounds: \n", result.nonceForRoundMap)
+
+	return result
+}
+
+func recordCommittedHeaderHashes(n *testNode, mutex *sync.Mutex, result *simulatedConsensusResult) {
+	commitBlock := n.blkProcessor.CommitBlockCalled
+	n.blkProcessor.CommitBlockCalled = func(header data.HeaderHandler, body data.BodyHandler) error {
+		headerBuff, _ := integrationTests.TestMarshalizer.Marshal(header)
+
+		mutex.Lock()
+		hashes, found := result.headerHashesByRound[header.GetRound()]
+		if !found {
+			hashes = make(map[string]struct{})
+			result.headerHashesByRound[header.GetRound()] = hashes
+		}
+		hashes[string(headerBuff)] = struct{}{}
+		mutex.Unlock()
+
+		return commitBlock(header, body)
+	}
+}
+
+// assertNoForks checks that all the nodes committed the same header in each round. The check is not done by nonce
+// because the consensus only nodes have no bootstrapper: a node that missed a block will propose the same nonce
+// again in a later round and the mocked block processor of the other nodes will accept it
+func assertNoForks(t *testing.T, result *simulatedConsensusResult) {
+	for round, hashes := range result.headerHashesByRound {
+		assert.Equal(t, 1, len(hashes), fmt.Sprintf("different headers committed in round %d", round))
+	}
+}
+
+func TestConsensusBLSOnSimulatedNetwork(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	numCommBlock := 8
+	result := runConsensusOnSimulator(t, blsConsensusType, 4, 4, 1, uint64(numCommBlock+2), nil)
+
+	assert.True(t, len(result.nonceForRoundMap) >= numCommBlock, "consensus too slow")
+	assertNoForks(t, result)
+}
+
+func TestConsensusPoAOnSimulatedNetwork(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	// the consensus only nodes do not have a bootstrapper, so a single authority is used as followers would
+	// not be able to sync the sealed blocks
+	numCommBlock := 8
+	result := runConsensusOnSimulator(t, poaConsensusType, 1, 1, 4, uint64(numCommBlock+2), nil)
+
+	assert.True(t, len(result.nonceForRoundMap) >= numCommBlock, "consensus too slow")
+	assertNoForks(t, result)
+}
+
+func TestConsensusBLSOnSimulatedNetworkWithPartition(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	partitionStart := time.Second * 3
+	partitionEnd := time.Second * 7
+	result := runConsensusOnSimulator(t, blsConsensusType, 4, 4, 2, 12, func(sim *simulation.Simulator, nodes []*testNode) {
+		_ = sim.At(partitionStart, func() {
+			sim.Network().Partition(
+				[]core.PeerID{nodes[0].messenger.ID(), nodes[1].messenger.ID()},
+				[]core.PeerID{nodes[2].messenger.ID(), nodes[3].messenger.ID()},
+			)
+		})
+		_ = sim.At(partitionEnd, func() {
+			sim.Network().HealPartitions()
+		})
+	})
+
+	// rounds are counted from genesis: no group can reach the 2/3+1 threshold while partitioned
+	firstPartitionedRound := uint64(partitionStart/time.Second) + 1
+	lastPartitionedRound := uint64(partitionEnd/time.Second) - 1
+	numCommittedAfterHeal := 0
+	for round := range result.nonceForRoundMap {
+		isPartitioned := round >= firstPartitionedRound && round <= lastPartitionedRound
+		assert.False(t, isPartitioned, fmt.Sprintf("block committed in partitioned round %d", round))
+		if round > lastPartitionedRound+1 {
+			numCommittedAfterHeal++
+		}
+	}
+	assert.True(t, numCommittedAfterHeal > 0, "consensus did not recover after the partition was healed")
+	assertNoForks(t, result)
+}
+
+func TestConsensusBLSOnSimulatedNetworkWithEquivocatingLeader(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	var equivocator *simulation.ConsensusEquivocator
+	result := runConsensusOnSimulator(t, blsConsensusType, 4, 4, 3, 10, func(sim *simulation.Simulator, nodes []*testNode) {
+		var err error
+		equivocator, err = simulation.NewConsensusEquivocator(simulation.ArgsConsensusEquivocator{
+			Marshalizer: integrationTests.TestMarshalizer,
+			Hasher:      createHasher(blsConsensusType),
+			CreateEmptyHeader: func() data.HeaderHandler {
+				return &dataBlock.Header{}
+			},
+			ConflictingReceivers: []core.PeerID{nodes[2].messenger.ID(), nodes[3].messenger.ID()},
+		})
+		assert.Nil(t, err)
+
+		sim.Network().SetMessageTamperer(nodes[0].messenger.ID(), equivocator)
+	})
+
+	assert.True(t, len(equivocator.Equivocations()) > 0, "the leader did not get the chance to equivocate")
+	assert.True(t, len(result.nonceForRoundMap) > 0, "honest leaders should still produce blocks")
+	assertNoForks(t, result)
+}
+
 func runConsensusWithNotEnoughValidators(t *testing.T, consensusType string) {
 	numNodes := uint32(4)
 	consensusSize := uint32(4)
@@ -253,7 +433,7 @@ func runConsensusWithNotEnoughValidators(t *testing.T, consensusType string) {
 
 	nonceForRoundMap := make(map[uint64]uint64)
 	totalCalled := 0
-	err := startNodesWithCommitBlock(nodes, mutex, nonceForRoundMap, &totalCalled)
+	err := startNodesWithCommitBlock(nodes, consensusType, mutex, nonceForRoundMap, &totalCalled)
 	assert.Nil(t, err)
 
 	waitTime := time.Second * 30
@@ -31,12 +31,15 @@ import (
 	"github.com/ElrondNetwork/elrond-go/factory/peerSignatureHandler"
 	"github.com/ElrondNetwork/elrond-go/integrationTests"
 	"github.com/ElrondNetwork/elrond-go/integrationTests/mock"
+	"github.com/ElrondNetwork/elrond-go/integrationTests/simulation"
+	"github.com/ElrondNetwork/elrond-go/keysManagement"
 	"github.com/ElrondNetwork/elrond-go/node"
 	"github.com/ElrondNetwork/elrond-go/ntp"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process/factory"
 	syncFork "github.com/ElrondNetwork/elrond-go/process/sync"
 	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/signerBackend"
 	"github.com/ElrondNetwork/elrond-go/state"
 	"github.com/ElrondNetwork/elrond-go/state/storagePruningManager"
 	"github.com/ElrondNetwork/elrond-go/state/storagePruningManager/evictionWaitingList"
@@ -55,6 +58,7 @@ import (
 )
 
 const blsConsensusType = "bls"
+const poaConsensusType = "poa"
 const signatureSize = 48
 const publicKeySize = 96
 
@@ -150,6 +154,7 @@ func createTestStore() dataRetriever.StorageService {
 	store.AddStorer(dataRetriever.BlockHeaderUnit, createMemUnit())
 	store.AddStorer(dataRetriever.BootstrapUnit, createMemUnit())
 	store.AddStorer(dataRetriever.ReceiptsUnit, createMemUnit())
+	store.AddStorer(dataRetriever.SlashingEvidenceUnit, createMemUnit())
 	return store
 }
 
@@ -240,7 +245,7 @@ func createCryptoParams(nodesPerShard int, nbMetaNodes int, nbShards int) *crypt
 }
 
 func createHasher(consensusType string) hashing.Hasher {
-	if consensusType == blsConsensusType {
+	if consensusType == blsConsensusType || consensusType == poaConsensusType {
 		hasher, _ := blake2b.NewBlake2bWithSize(32)
 		return hasher
 	}
@@ -259,6 +264,9 @@ func createConsensusOnlyNode(
 	testKeyGen crypto.KeyGenerator,
 	consensusType string,
 	epochStartRegistrationHandler mainFactory.EpochStartNotifier,
+	messenger p2p.Messenger,
+	syncer ntp.SyncTimer,
+	startTime int64,
 ) (
 	*node.Node,
 	p2p.Messenger,
@@ -268,7 +276,6 @@ func createConsensusOnlyNode(
 	testHasher := createHasher(consensusType)
 	testMarshalizer := &marshal.GogoProtoMarshalizer{}
 
-	messenger := integrationTests.CreateMessengerWithNoDiscovery()
 	rootHash := []byte("roothash")
 
 	blockChain := createTestBlockChain()
@@ -317,14 +324,9 @@ func createConsensusOnlyNode(
 	hdrMarshalized, _ := testMarshalizer.Marshal(header)
 	blockChain.SetGenesisHeaderHash(testHasher.Compute(string(hdrMarshalized)))
 
-	startTime := time.Now().Unix()
-
 	singlesigner := &ed25519SingleSig.Ed25519Signer{}
 	singleBlsSigner := &mclsinglesig.BlsSingleSigner{}
 
-	syncer := ntp.NewSyncTime(ntp.NewNTPGoogleConfig(), nil)
-	syncer.StartSyncingTime()
-
 	roundHandler, _ := round.NewRound(
 		time.Unix(startTime, 0),
 		syncer.CurrentTime(),
@@ -417,6 +419,18 @@ func createConsensusOnlyNode(
 	cryptoComponents.BlKeyGen = testKeyGen
 	cryptoComponents.PeerSignHandler = peerSigHandler
 
+	privKeyBytes, _ := privKey.ToByteArray()
+	keysHandler, _ := keysManagement.NewManagedKeysHolder(keysManagement.ArgsManagedKeysHolder{
+		KeyGenerator: testKeyGen,
+		PrivateKeys:  [][]byte{privKeyBytes},
+	})
+	cryptoComponents.KeysHolder = keysHandler
+	cryptoComponents.SignBackend, _ = signerBackend.NewLocalSignerBackend(signerBackend.ArgsLocalSignerBackend{
+		KeysHandler:          keysHandler,
+		SingleSigner:         singleBlsSigner,
+		PeerSignatureHandler: peerSigHandler,
+	})
+
 	processComponents := integrationTests.GetDefaultProcessComponents()
 	processComponents.ForkDetect = forkDetector
 	processComponents.ShardCoord = shardCoordinator
@@ -482,6 +496,50 @@ func createNodes(
 	roundTime uint64,
 	consensusType string,
 ) map[uint32][]*testNode {
+	syncer := ntp.NewSyncTime(ntp.NewNTPGoogleConfig(), nil)
+	syncer.StartSyncingTime()
+
+	createMessenger := func() p2p.Messenger {
+		return integrationTests.CreateMessengerWithNoDiscovery()
+	}
+
+	nodes := createNodesWithNetwork(nodesPerShard, consensusSize, roundTime, consensusType, createMessenger, syncer, time.Now().Unix())
+
+	connectableNodes := make([]integrationTests.Connectable, 0)
+	for _, n := range nodes[0] {
+		connectableNodes = append(connectableNodes, &messengerWrapper{n.messenger})
+	}
+	integrationTests.ConnectNodes(connectableNodes)
+
+	return nodes
+}
+
+// createNodesOnSimulator creates the consensus nodes on the in-memory network of the simulator. All nodes
+// will share the simulator's virtual clock
+func createNodesOnSimulator(
+	nodesPerShard int,
+	consensusSize int,
+	roundTime uint64,
+	consensusType string,
+	sim *simulation.Simulator,
+) map[uint32][]*testNode {
+	createMessenger := func() p2p.Messenger {
+		messenger, _ := sim.CreateMessenger()
+		return messenger
+	}
+
+	return createNodesWithNetwork(nodesPerShard, consensusSize, roundTime, consensusType, createMessenger, sim.Clock(), sim.GenesisTime().Unix())
+}
+
+func createNodesWithNetwork(
+	nodesPerShard int,
+	consensusSize int,
+	roundTime uint64,
+	consensusType string,
+	createMessenger func() p2p.Messenger,
+	syncer ntp.SyncTimer,
+	startTime int64,
+) map[uint32][]*testNode {
 
 	nodes := make(map[uint32][]*testNode)
 	cp := createCryptoParams(nodesPerShard, 1, 1)
@@ -489,7 +547,6 @@ func createNodes(
 	eligibleMap := genValidatorsFromPubKeys(keysMap)
 	waitingMap := make(map[uint32][]sharding.Validator)
 	nodesList := make([]*testNode, nodesPerShard)
-	connectableNodes := make([]integrationTests.Connectable, 0)
 
 	nodeShuffler := &mock.NodeShufflerMock{}
 
@@ -542,6 +599,9 @@ func createNodes(
 			cp.keyGen,
 			consensusType,
 			epochStartRegistrationHandler,
+			createMessenger(),
+			syncer,
+			startTime,
 		)
 
 		testNodeObject.node = n
@@ -552,11 +612,8 @@ func createNodes(
 		testNodeObject.blkc = blkc
 
 		nodesList[i] = testNodeObject
-		connectableNodes = append(connectableNodes, &messengerWrapper{mes})
 	}
 	nodes[0] = nodesList
 
-	integrationTests.ConnectNodes(connectableNodes)
-
 	return nodes
 }
@@ -4,6 +4,7 @@ import (
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/vm"
 )
 
@@ -21,6 +22,8 @@ type CryptoComponentsStub struct {
 	BlKeyGen        crypto.KeyGenerator
 	TxKeyGen        crypto.KeyGenerator
 	MsgSigVerifier  vm.MessageSignVerifier
+	KeysHolder      consensus.KeysHandler
+	SignBackend     consensus.SignerBackend
 	mutMultiSig     sync.RWMutex
 }
 
@@ -114,6 +117,16 @@ func (ccs *CryptoComponentsStub) MessageSignVerifier() vm.MessageSignVerifier {
 	return ccs.MsgSigVerifier
 }
 
+// KeysHandler -
+func (ccs *CryptoComponentsStub) KeysHandler() consensus.KeysHandler {
+	return ccs.KeysHolder
+}
+
+// SignerBackend -
+func (ccs *CryptoComponentsStub) SignerBackend() consensus.SignerBackend {
+	return ccs.SignBackend
+}
+
 // Clone -
 func (ccs *CryptoComponentsStub) Clone() interface{} {
 	return &CryptoComponentsStub{
@@ -129,6 +142,8 @@ func (ccs *CryptoComponentsStub) Clone() interface{} {
 		BlKeyGen:        ccs.BlKeyGen,
 		TxKeyGen:        ccs.TxKeyGen,
 		MsgSigVerifier:  ccs.MsgSigVerifier,
+		KeysHolder:      ccs.KeysHolder,
+		SignBackend:     ccs.SignBackend,
 		mutMultiSig:     sync.RWMutex{},
 	}
 }
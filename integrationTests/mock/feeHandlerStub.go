@@ -28,6 +28,7 @@ type FeeHandlerStub struct {
 	ComputeGasUsedAndFeeBasedOnRefundValueCalled func(tx data.TransactionWithFeeHandler, refundValue *big.Int) (uint64, *big.Int)
 	ComputeTxFeeBasedOnGasUsedCalled             func(tx data.TransactionWithFeeHandler, gasUsed uint64) *big.Int
 	ComputeGasLimitBasedOnBalanceCalled          func(tx data.TransactionWithFeeHandler, balance *big.Int) (uint64, error)
+	DynamicMinGasPriceCalled                     func() uint64
 }
 
 // ComputeGasLimitBasedOnBalance -
@@ -190,6 +191,14 @@ func (fhs *FeeHandlerStub) ComputeTxFeeBasedOnGasUsed(tx data.TransactionWithFee
 	return big.NewInt(0)
 }
 
+// DynamicMinGasPrice -
+func (fhs *FeeHandlerStub) DynamicMinGasPrice() uint64 {
+	if fhs.DynamicMinGasPriceCalled != nil {
+		return fhs.DynamicMinGasPriceCalled()
+	}
+	return 0
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (fhs *FeeHandlerStub) IsInterfaceNil() bool {
 	return fhs == nil
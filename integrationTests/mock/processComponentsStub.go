@@ -35,6 +35,8 @@ type ProcessComponentsStub struct {
 	HeaderConstructValidator       process.HeaderConstructionValidator
 	PeerMapper                     process.NetworkShardingCollector
 	TxSimulatorProcessor           factory.TransactionSimulatorProcessor
+	TxBundleSimulator              factory.TransactionBundleSimulator
+	GasPriceEstimatorInternal      factory.GasPriceEstimator
 	FallbackHdrValidator           process.FallbackHeaderValidator
 	WhiteListHandlerInternal       process.WhiteListHandler
 	WhiteListerVerifiedTxsInternal process.WhiteListHandler
@@ -176,6 +178,16 @@ func (pcs *ProcessComponentsStub) TransactionSimulatorProcessor() factory.Transa
 	return pcs.TxSimulatorProcessor
 }
 
+// TransactionBundleSimulator -
+func (pcs *ProcessComponentsStub) TransactionBundleSimulator() factory.TransactionBundleSimulator {
+	return pcs.TxBundleSimulator
+}
+
+// GasPriceEstimator -
+func (pcs *ProcessComponentsStub) GasPriceEstimator() factory.GasPriceEstimator {
+	return pcs.GasPriceEstimatorInternal
+}
+
 // WhiteListHandler -
 func (pcs *ProcessComponentsStub) WhiteListHandler() process.WhiteListHandler {
 	return pcs.WhiteListHandlerInternal
@@ -22,6 +22,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/integrationTests/mock"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/testscommon/cryptoMocks"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -207,10 +208,11 @@ func createSenderWithName(messenger p2p.Messenger, topic string, nodeName string
 	keyGen := signing.NewKeyGenerator(suite)
 	sk, pk := keyGen.GeneratePair()
 	version := "v01"
+	peerSigHandler := &mock2.PeerSignatureHandler{Signer: signer}
 
 	argSender := process.ArgHeartbeatSender{
 		PeerMessenger:        messenger,
-		PeerSignatureHandler: &mock2.PeerSignatureHandler{Signer: signer},
+		PeerSignatureHandler: peerSigHandler,
 		PrivKey:              sk,
 		Marshalizer:          integrationTests.TestMarshalizer,
 		Topic:                topic,
@@ -222,6 +224,12 @@ func createSenderWithName(messenger p2p.Messenger, topic string, nodeName string
 		HardforkTrigger:      &mock.HardforkTriggerStub{},
 		CurrentBlockProvider: &mock.BlockChainMock{},
 		RedundancyHandler:    &mock.RedundancyHandlerStub{},
+		KeysHandler:          &cryptoMocks.KeysHandlerStub{},
+		SignerBackend: &cryptoMocks.SignerBackendStub{
+			SignPeerIDCalled: func(_ []byte, pid []byte) ([]byte, error) {
+				return peerSigHandler.GetPeerSignature(sk, pid)
+			},
+		},
 	}
 
 	sender, _ := process.NewSender(argSender)
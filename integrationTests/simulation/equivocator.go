@@ -0,0 +1,230 @@
+package simulation
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
+	"github.com/ElrondNetwork/elrond-go/p2p/memp2p"
+)
+
+var _ memp2p.MessageTamperer = (*ConsensusEquivocator)(nil)
+
+// Equivocation holds the two conflicting consensus messages sent by the same key in the same round
+type Equivocation struct {
+	Round            int64
+	MsgType          consensus.MessageType
+	PubKey           []byte
+	OriginalHash     []byte
+	ConflictingHash  []byte
+	OriginalShare    []byte
+	ConflictingShare []byte
+}
+
+// ArgsConsensusEquivocator is the DTO used to create a new consensus equivocator
+type ArgsConsensusEquivocator struct {
+	Marshalizer marshal.Marshalizer
+	Hasher      hashing.Hasher
+	// CreateEmptyHeader is used to decode the headers proposed by the equivocating node
+	CreateEmptyHeader func() data.HeaderHandler
+	// SignShare is used to create a conflicting signature share with the equivocating node's key.
+	// If nil, the signature messages are not altered
+	SignShare func(message []byte) ([]byte, error)
+	// ConflictingReceivers are the peers that will receive the conflicting messages. All the other
+	// peers receive the original messages
+	ConflictingReceivers []core.PeerID
+}
+
+// ConsensusEquivocator is a memp2p.MessageTamperer that makes the node which uses it double-sign: the
+// proposed headers and the signature shares sent to a part of the network are replaced by conflicting ones
+type ConsensusEquivocator struct {
+	marshalizer          marshal.Marshalizer
+	hasher               hashing.Hasher
+	createEmptyHeader    func() data.HeaderHandler
+	signShare            func(message []byte) ([]byte, error)
+	conflictingReceivers map[core.PeerID]struct{}
+
+	mutEquivocations sync.RWMutex
+	equivocations    map[string]*Equivocation
+	conflictingBuffs map[string][]byte
+}
+
+// NewConsensusEquivocator creates a new consensus equivocator
+func NewConsensusEquivocator(args ArgsConsensusEquivocator) (*ConsensusEquivocator, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if args.CreateEmptyHeader == nil {
+		return nil, ErrNilHeaderCreator
+	}
+
+	ce := &ConsensusEquivocator{
+		marshalizer:          args.Marshalizer,
+		hasher:               args.Hasher,
+		createEmptyHeader:    args.CreateEmptyHeader,
+		signShare:            args.SignShare,
+		conflictingReceivers: make(map[core.PeerID]struct{}),
+		equivocations:        make(map[string]*Equivocation),
+		conflictingBuffs:     make(map[string][]byte),
+	}
+	for _, pid := range args.ConflictingReceivers {
+		ce.conflictingReceivers[pid] = struct{}{}
+	}
+
+	return ce, nil
+}
+
+// Tamper replaces the consensus messages sent to the conflicting receivers with conflicting versions
+func (ce *ConsensusEquivocator) Tamper(topic string, buff []byte, receiver core.PeerID) [][]byte {
+	_, isConflictingReceiver := ce.conflictingReceivers[receiver]
+	if !isConflictingReceiver || !strings.HasPrefix(topic, common.ConsensusTopic) {
+		return [][]byte{buff}
+	}
+
+	conflictingBuff, err := ce.conflictingMessage(buff)
+	if err != nil {
+		log.Debug("ConsensusEquivocator.Tamper", "error", err)
+		return [][]byte{buff}
+	}
+
+	return [][]byte{conflictingBuff}
+}
+
+func (ce *ConsensusEquivocator) conflictingMessage(buff []byte) ([]byte, error) {
+	ce.mutEquivocations.Lock()
+	defer ce.mutEquivocations.Unlock()
+
+	conflictingBuff, found := ce.conflictingBuffs[string(buff)]
+	if found {
+		return conflictingBuff, nil
+	}
+
+	cnsMsg := &consensus.Message{}
+	err := ce.marshalizer.Unmarshal(cnsMsg, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	var equivocation *Equivocation
+	switch consensus.MessageType(cnsMsg.MsgType) {
+	case bls.MtBlockBodyAndHeader, bls.MtBlockHeader:
+		equivocation, err = ce.equivocateOnHeader(cnsMsg)
+	case bls.MtSignature:
+		equivocation, err = ce.equivocateOnSignature(cnsMsg)
+	default:
+		return buff, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	conflictingBuff, err = ce.marshalizer.Marshal(cnsMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	ce.conflictingBuffs[string(buff)] = conflictingBuff
+	ce.equivocations[equivocationKey(equivocation)] = equivocation
+
+	return conflictingBuff, nil
+}
+
+func (ce *ConsensusEquivocator) equivocateOnHeader(cnsMsg *consensus.Message) (*Equivocation, error) {
+	header := ce.createEmptyHeader()
+	err := ce.marshalizer.Unmarshal(header, cnsMsg.Header)
+	if err != nil {
+		return nil, err
+	}
+
+	header.SetTimeStamp(header.GetTimeStamp() + 1)
+	headerBuff, err := ce.marshalizer.Marshal(header)
+	if err != nil {
+		return nil, err
+	}
+
+	equivocation := &Equivocation{
+		Round:           cnsMsg.RoundIndex,
+		MsgType:         consensus.MessageType(cnsMsg.MsgType),
+		PubKey:          cnsMsg.PubKey,
+		OriginalHash:    cnsMsg.BlockHeaderHash,
+		ConflictingHash: ce.hasher.Compute(string(headerBuff)),
+	}
+
+	cnsMsg.Header = headerBuff
+	cnsMsg.BlockHeaderHash = equivocation.ConflictingHash
+
+	return equivocation, nil
+}
+
+func (ce *ConsensusEquivocator) equivocateOnSignature(cnsMsg *consensus.Message) (*Equivocation, error) {
+	if ce.signShare == nil {
+		return nil, ErrNilSignShareHandler
+	}
+
+	conflictingHash := ce.conflictingHashForRound(cnsMsg.RoundIndex, cnsMsg.BlockHeaderHash)
+	conflictingShare, err := ce.signShare(conflictingHash)
+	if err != nil {
+		return nil, err
+	}
+
+	equivocation := &Equivocation{
+		Round:            cnsMsg.RoundIndex,
+		MsgType:          consensus.MessageType(cnsMsg.MsgType),
+		PubKey:           cnsMsg.PubKey,
+		OriginalHash:     cnsMsg.BlockHeaderHash,
+		ConflictingHash:  conflictingHash,
+		OriginalShare:    cnsMsg.SignatureShare,
+		ConflictingShare: conflictingShare,
+	}
+
+	cnsMsg.BlockHeaderHash = conflictingHash
+	cnsMsg.SignatureShare = conflictingShare
+
+	return equivocation, nil
+}
+
+// conflictingHashForRound reuses the conflicting header hash if this node has already equivocated
+// on the proposed header in the same round
+func (ce *ConsensusEquivocator) conflictingHashForRound(round int64, originalHash []byte) []byte {
+	for _, equivocation := range ce.equivocations {
+		isHeaderEquivocation := equivocation.MsgType != bls.MtSignature
+		if equivocation.Round == round && isHeaderEquivocation {
+			return equivocation.ConflictingHash
+		}
+	}
+
+	return ce.hasher.Compute(string(originalHash) + "equivocation")
+}
+
+// Equivocations returns all the equivocations done so far
+func (ce *ConsensusEquivocator) Equivocations() []*Equivocation {
+	ce.mutEquivocations.RLock()
+	defer ce.mutEquivocations.RUnlock()
+
+	equivocations := make([]*Equivocation, 0, len(ce.equivocations))
+	for _, equivocation := range ce.equivocations {
+		equivocations = append(equivocations, equivocation)
+	}
+
+	return equivocations
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ce *ConsensusEquivocator) IsInterfaceNil() bool {
+	return ce == nil
+}
+
+func equivocationKey(equivocation *Equivocation) string {
+	return fmt.Sprintf("%d_%d_%x", equivocation.Round, equivocation.MsgType, equivocation.PubKey)
+}
@@ -0,0 +1,165 @@
+package simulation
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/hashing/sha256"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const conflictingPid = core.PeerID("conflicting")
+const consensusTopic = "consensus_0"
+
+func createMockArgsConsensusEquivocator() ArgsConsensusEquivocator {
+	return ArgsConsensusEquivocator{
+		Marshalizer: &marshal.GogoProtoMarshalizer{},
+		Hasher:      sha256.NewSha256(),
+		CreateEmptyHeader: func() data.HeaderHandler {
+			return &block.Header{}
+		},
+		SignShare: func(message []byte) ([]byte, error) {
+			return append([]byte("signed "), message...), nil
+		},
+		ConflictingReceivers: []core.PeerID{conflictingPid},
+	}
+}
+
+func createHeaderMessage(t *testing.T, args ArgsConsensusEquivocator) (*consensus.Message, []byte) {
+	header := &block.Header{Nonce: 3, Round: 4, TimeStamp: 100}
+	headerBuff, err := args.Marshalizer.Marshal(header)
+	require.Nil(t, err)
+
+	cnsMsg := &consensus.Message{
+		BlockHeaderHash: args.Hasher.Compute(string(headerBuff)),
+		Header:          headerBuff,
+		PubKey:          []byte("leader"),
+		MsgType:         int64(bls.MtBlockBodyAndHeader),
+		RoundIndex:      4,
+	}
+	buff, err := args.Marshalizer.Marshal(cnsMsg)
+	require.Nil(t, err)
+
+	return cnsMsg, buff
+}
+
+func TestNewConsensusEquivocator(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsConsensusEquivocator()
+	args.Marshalizer = nil
+	ce, err := NewConsensusEquivocator(args)
+	assert.Nil(t, ce)
+	assert.Equal(t, ErrNilMarshalizer, err)
+
+	args = createMockArgsConsensusEquivocator()
+	args.Hasher = nil
+	ce, err = NewConsensusEquivocator(args)
+	assert.Nil(t, ce)
+	assert.Equal(t, ErrNilHasher, err)
+
+	args = createMockArgsConsensusEquivocator()
+	args.CreateEmptyHeader = nil
+	ce, err = NewConsensusEquivocator(args)
+	assert.Nil(t, ce)
+	assert.Equal(t, ErrNilHeaderCreator, err)
+
+	ce, err = NewConsensusEquivocator(createMockArgsConsensusEquivocator())
+	assert.Nil(t, err)
+	assert.False(t, ce.IsInterfaceNil())
+}
+
+func TestConsensusEquivocator_TamperShouldNotAlterOtherReceiversOrTopics(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsConsensusEquivocator()
+	ce, _ := NewConsensusEquivocator(args)
+	_, buff := createHeaderMessage(t, args)
+
+	assert.Equal(t, [][]byte{buff}, ce.Tamper(consensusTopic, buff, "honest"))
+	assert.Equal(t, [][]byte{buff}, ce.Tamper("transactions", buff, conflictingPid))
+	assert.Equal(t, 0, len(ce.Equivocations()))
+}
+
+func TestConsensusEquivocator_TamperShouldProposeConflictingHeader(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsConsensusEquivocator()
+	ce, _ := NewConsensusEquivocator(args)
+	original, buff := createHeaderMessage(t, args)
+
+	result := ce.Tamper(consensusTopic, buff, conflictingPid)
+	require.Equal(t, 1, len(result))
+
+	conflicting := &consensus.Message{}
+	_ = args.Marshalizer.Unmarshal(conflicting, result[0])
+	conflictingHeader := &block.Header{}
+	_ = args.Marshalizer.Unmarshal(conflictingHeader, conflicting.Header)
+
+	assert.NotEqual(t, original.BlockHeaderHash, conflicting.BlockHeaderHash)
+	assert.Equal(t, args.Hasher.Compute(string(conflicting.Header)), conflicting.BlockHeaderHash)
+	assert.Equal(t, uint64(101), conflictingHeader.TimeStamp)
+	assert.Equal(t, uint64(3), conflictingHeader.Nonce)
+
+	// same message sent again should produce the same conflicting message
+	assert.Equal(t, result, ce.Tamper(consensusTopic, buff, conflictingPid))
+
+	equivocations := ce.Equivocations()
+	require.Equal(t, 1, len(equivocations))
+	assert.Equal(t, original.BlockHeaderHash, equivocations[0].OriginalHash)
+	assert.Equal(t, conflicting.BlockHeaderHash, equivocations[0].ConflictingHash)
+}
+
+func TestConsensusEquivocator_TamperShouldSignTheConflictingHeader(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsConsensusEquivocator()
+	ce, _ := NewConsensusEquivocator(args)
+	_, headerBuff := createHeaderMessage(t, args)
+	headerResult := ce.Tamper(consensusTopic, headerBuff, conflictingPid)
+	conflictingHeaderMsg := &consensus.Message{}
+	_ = args.Marshalizer.Unmarshal(conflictingHeaderMsg, headerResult[0])
+
+	sigMsg := &consensus.Message{
+		BlockHeaderHash: []byte("original hash"),
+		SignatureShare:  []byte("original share"),
+		PubKey:          []byte("leader"),
+		MsgType:         int64(bls.MtSignature),
+		RoundIndex:      4,
+	}
+	sigBuff, _ := args.Marshalizer.Marshal(sigMsg)
+
+	result := ce.Tamper(consensusTopic, sigBuff, conflictingPid)
+	conflicting := &consensus.Message{}
+	_ = args.Marshalizer.Unmarshal(conflicting, result[0])
+
+	assert.Equal(t, conflictingHeaderMsg.BlockHeaderHash, conflicting.BlockHeaderHash)
+	assert.Equal(t, append([]byte("signed "), conflictingHeaderMsg.BlockHeaderHash...), conflicting.SignatureShare)
+	assert.Equal(t, 2, len(ce.Equivocations()))
+}
+
+func TestConsensusEquivocator_TamperSignErrorShouldSendOriginal(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsConsensusEquivocator()
+	args.SignShare = func(message []byte) ([]byte, error) {
+		return nil, errors.New("expected error")
+	}
+	ce, _ := NewConsensusEquivocator(args)
+
+	sigMsg := &consensus.Message{
+		BlockHeaderHash: []byte("original hash"),
+		MsgType:         int64(bls.MtSignature),
+	}
+	sigBuff, _ := args.Marshalizer.Marshal(sigMsg)
+
+	assert.Equal(t, [][]byte{sigBuff}, ce.Tamper(consensusTopic, sigBuff, conflictingPid))
+	assert.Equal(t, 0, len(ce.Equivocations()))
+}
@@ -0,0 +1,24 @@
+package simulation
+
+import "errors"
+
+// ErrInvalidStepDuration signals that an invalid step duration has been provided
+var ErrInvalidStepDuration = errors.New("invalid step duration")
+
+// ErrNilStepHandler signals that a nil step handler has been provided
+var ErrNilStepHandler = errors.New("nil step handler")
+
+// ErrNilAction signals that a nil action has been provided
+var ErrNilAction = errors.New("nil action")
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilHeaderCreator signals that a nil header creator function has been provided
+var ErrNilHeaderCreator = errors.New("nil header creator")
+
+// ErrNilSignShareHandler signals that a nil sign share handler has been provided
+var ErrNilSignShareHandler = errors.New("nil sign share handler")
@@ -0,0 +1,198 @@
+package simulation
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/p2p/memp2p"
+)
+
+var log = logger.GetOrCreate("integrationtests/simulation")
+
+// ArgsSimulator is the DTO used to create a new simulator
+type ArgsSimulator struct {
+	Seed         int64
+	GenesisTime  time.Time
+	StepDuration time.Duration
+	// StepRealDelay is the real time given to the nodes' go routines to react after each virtual step
+	StepRealDelay time.Duration
+}
+
+// StepHandler is called after each step with the current virtual time
+type StepHandler func(currentTime time.Time)
+
+// Simulator drives a set of nodes connected through a memp2p.Network using a shared virtual clock.
+// Each step advances the clock with a fixed duration and then delivers the delayed messages that
+// became due, so the latency, partitions and drops are replayed identically for the same seed
+type Simulator struct {
+	clock         *VirtualClock
+	network       *memp2p.Network
+	stepDuration  time.Duration
+	stepRealDelay time.Duration
+	genesisTime   time.Time
+
+	mutStepHandlers sync.RWMutex
+	stepHandlers    []StepHandler
+	actions         []*scheduledAction
+	numSteps        uint64
+}
+
+type scheduledAction struct {
+	at     time.Time
+	action func()
+}
+
+// NewSimulator creates a new simulator instance
+func NewSimulator(args ArgsSimulator) (*Simulator, error) {
+	if args.StepDuration <= 0 {
+		return nil, ErrInvalidStepDuration
+	}
+
+	clock := NewVirtualClock(args.GenesisTime)
+	network, err := memp2p.NewNetworkWithArgs(memp2p.ArgsNetwork{
+		Seed:  args.Seed,
+		Clock: clock,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Simulator{
+		clock:         clock,
+		network:       network,
+		stepDuration:  args.StepDuration,
+		stepRealDelay: args.StepRealDelay,
+		genesisTime:   args.GenesisTime,
+		stepHandlers:  make([]StepHandler, 0),
+		actions:       make([]*scheduledAction, 0),
+	}, nil
+}
+
+// CreateMessenger creates a new in-memory messenger connected to the simulated network
+func (s *Simulator) CreateMessenger() (*memp2p.Messenger, error) {
+	return memp2p.NewMessenger(s.network)
+}
+
+// Clock returns the virtual clock that should be used by all simulated nodes as their sync timer
+func (s *Simulator) Clock() *VirtualClock {
+	return s.clock
+}
+
+// Network returns the simulated network
+func (s *Simulator) Network() *memp2p.Network {
+	return s.network
+}
+
+// GenesisTime returns the genesis time of the simulation
+func (s *Simulator) GenesisTime() time.Time {
+	return s.genesisTime
+}
+
+// AddStepHandler registers a handler that will be called after each step
+func (s *Simulator) AddStepHandler(handler StepHandler) error {
+	if handler == nil {
+		return ErrNilStepHandler
+	}
+
+	s.mutStepHandlers.Lock()
+	s.stepHandlers = append(s.stepHandlers, handler)
+	s.mutStepHandlers.Unlock()
+
+	return nil
+}
+
+// At schedules an action (for example a partition, a topic rule or a node starting to equivocate) to be
+// executed at the first step that reaches the provided offset from the genesis time
+func (s *Simulator) At(offsetFromGenesis time.Duration, action func()) error {
+	if action == nil {
+		return ErrNilAction
+	}
+
+	s.mutStepHandlers.Lock()
+	s.actions = append(s.actions, &scheduledAction{
+		at:     s.genesisTime.Add(offsetFromGenesis),
+		action: action,
+	})
+	s.mutStepHandlers.Unlock()
+
+	return nil
+}
+
+// Step advances the virtual clock with one step duration, executes the scheduled actions that became due
+// and delivers the messages that became due
+func (s *Simulator) Step() {
+	currentTime := s.clock.Advance(s.stepDuration)
+	for _, action := range s.extractDueActions(currentTime) {
+		action()
+	}
+
+	numDelivered := s.network.DeliverPendingMessages()
+	s.numSteps++
+
+	if s.stepRealDelay > 0 {
+		time.Sleep(s.stepRealDelay)
+	}
+
+	s.mutStepHandlers.RLock()
+	handlers := make([]StepHandler, len(s.stepHandlers))
+	copy(handlers, s.stepHandlers)
+	s.mutStepHandlers.RUnlock()
+
+	for _, handler := range handlers {
+		handler(currentTime)
+	}
+
+	log.Trace("simulation step",
+		"step", s.numSteps,
+		"time", s.clock.FormattedCurrentTime(),
+		"delivered", numDelivered,
+		"pending", s.network.NumPendingMessages(),
+	)
+}
+
+func (s *Simulator) extractDueActions(currentTime time.Time) []func() {
+	s.mutStepHandlers.Lock()
+	defer s.mutStepHandlers.Unlock()
+
+	dueActions := make([]func(), 0)
+	remaining := make([]*scheduledAction, 0, len(s.actions))
+	for _, sa := range s.actions {
+		if sa.at.After(currentTime) {
+			remaining = append(remaining, sa)
+			continue
+		}
+
+		dueActions = append(dueActions, sa.action)
+	}
+	s.actions = remaining
+
+	return dueActions
+}
+
+// RunFor steps the simulation until the provided virtual duration has passed
+func (s *Simulator) RunFor(duration time.Duration) {
+	endTime := s.clock.CurrentTime().Add(duration)
+	for s.clock.CurrentTime().Before(endTime) {
+		s.Step()
+	}
+}
+
+// RunUntil steps the simulation until the condition is met or the provided virtual duration has passed.
+// Returns true if the condition was met
+func (s *Simulator) RunUntil(condition func() bool, maxDuration time.Duration) bool {
+	endTime := s.clock.CurrentTime().Add(maxDuration)
+	for s.clock.CurrentTime().Before(endTime) {
+		if condition() {
+			return true
+		}
+		s.Step()
+	}
+
+	return condition()
+}
+
+// NumSteps returns the number of steps executed so far
+func (s *Simulator) NumSteps() uint64 {
+	return s.numSteps
+}
@@ -0,0 +1,120 @@
+package simulation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/p2p/memp2p"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsSimulator() ArgsSimulator {
+	return ArgsSimulator{
+		Seed:          11,
+		GenesisTime:   time.Unix(1000, 0),
+		StepDuration:  time.Millisecond * 100,
+		StepRealDelay: time.Millisecond,
+	}
+}
+
+func TestNewSimulator_InvalidStepDurationShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSimulator()
+	args.StepDuration = 0
+	sim, err := NewSimulator(args)
+
+	assert.Nil(t, sim)
+	assert.Equal(t, ErrInvalidStepDuration, err)
+}
+
+func TestSimulator_AddStepHandlerNilShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sim, _ := NewSimulator(createMockArgsSimulator())
+	err := sim.AddStepHandler(nil)
+
+	assert.Equal(t, ErrNilStepHandler, err)
+}
+
+func TestSimulator_RunForShouldAdvanceTheVirtualClock(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSimulator()
+	sim, _ := NewSimulator(args)
+
+	numCalls := 0
+	_ = sim.AddStepHandler(func(_ time.Time) {
+		numCalls++
+	})
+	sim.RunFor(time.Second)
+
+	assert.Equal(t, args.GenesisTime.Add(time.Second), sim.Clock().CurrentTime())
+	assert.Equal(t, uint64(10), sim.NumSteps())
+	assert.Equal(t, 10, numCalls)
+}
+
+func TestSimulator_RunUntilShouldStopWhenConditionIsMet(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSimulator()
+	sim, _ := NewSimulator(args)
+
+	target := args.GenesisTime.Add(time.Millisecond * 500)
+	met := sim.RunUntil(func() bool {
+		return !sim.Clock().CurrentTime().Before(target)
+	}, time.Second)
+
+	assert.True(t, met)
+	assert.Equal(t, uint64(5), sim.NumSteps())
+}
+
+func TestSimulator_LatencyShouldBeDrivenByTheVirtualClock(t *testing.T) {
+	t.Parallel()
+
+	sim, _ := NewSimulator(createMockArgsSimulator())
+	messengers := make([]*memp2p.Messenger, 0, 3)
+	for i := 0; i < 3; i++ {
+		messenger, err := sim.CreateMessenger()
+		require.Nil(t, err)
+		_ = messenger.CreateTopic("topic", false)
+		messengers = append(messengers, messenger)
+	}
+
+	sim.Network().SetLinkConditions(messengers[0].ID(), messengers[1].ID(), memp2p.LinkConditions{Latency: time.Millisecond * 250})
+	sim.Network().Partition([]core.PeerID{messengers[0].ID(), messengers[1].ID()}, []core.PeerID{messengers[2].ID()})
+
+	_ = messengers[0].BroadcastOnChannelBlocking("topic", "topic", []byte("data"))
+	sim.Step()
+	sim.Step()
+	assert.Equal(t, 1, sim.Network().NumPendingMessages())
+
+	sim.Step()
+	time.Sleep(time.Millisecond * 50)
+	assert.Equal(t, 0, sim.Network().NumPendingMessages())
+	assert.Equal(t, uint64(1), messengers[0].NumMessagesReceived())
+	assert.Equal(t, uint64(1), messengers[1].NumMessagesReceived())
+	assert.Equal(t, uint64(0), messengers[2].NumMessagesReceived())
+}
+
+func TestSimulator_AtShouldExecuteActionsWhenDue(t *testing.T) {
+	t.Parallel()
+
+	sim, _ := NewSimulator(createMockArgsSimulator())
+	assert.Equal(t, ErrNilAction, sim.At(time.Second, nil))
+
+	executedAt := make([]time.Time, 0)
+	_ = sim.At(time.Millisecond*250, func() {
+		executedAt = append(executedAt, sim.Clock().CurrentTime())
+	})
+	_ = sim.At(time.Millisecond*50, func() {
+		executedAt = append(executedAt, sim.Clock().CurrentTime())
+	})
+
+	sim.RunFor(time.Second)
+
+	genesis := createMockArgsSimulator().GenesisTime
+	assert.Equal(t, []time.Time{genesis.Add(time.Millisecond * 100), genesis.Add(time.Millisecond * 300)}, executedAt)
+}
@@ -0,0 +1,75 @@
+package simulation
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/ntp"
+	"github.com/ElrondNetwork/elrond-go/p2p/memp2p"
+)
+
+var _ ntp.SyncTimer = (*VirtualClock)(nil)
+var _ memp2p.TimeProvider = (*VirtualClock)(nil)
+
+// VirtualClock is a ntp.SyncTimer implementation that only moves forward when it is told to do so.
+// It can be shared by all the simulated nodes so the chronology and round handlers
+// will observe the exact same time
+type VirtualClock struct {
+	mut         sync.RWMutex
+	currentTime time.Time
+}
+
+// NewVirtualClock creates a new virtual clock that starts at the provided time
+func NewVirtualClock(startTime time.Time) *VirtualClock {
+	return &VirtualClock{
+		currentTime: startTime,
+	}
+}
+
+// Advance moves the clock forward with the provided duration
+func (vc *VirtualClock) Advance(duration time.Duration) time.Time {
+	vc.mut.Lock()
+	defer vc.mut.Unlock()
+
+	if duration > 0 {
+		vc.currentTime = vc.currentTime.Add(duration)
+	}
+
+	return vc.currentTime
+}
+
+// StartSyncingTime does nothing as the virtual clock does not need syncing
+func (vc *VirtualClock) StartSyncingTime() {
+}
+
+// ClockOffset returns 0 as the virtual clock is always in sync
+func (vc *VirtualClock) ClockOffset() time.Duration {
+	return 0
+}
+
+// FormattedCurrentTime returns the formatted current virtual time
+func (vc *VirtualClock) FormattedCurrentTime() string {
+	t := vc.CurrentTime()
+
+	return fmt.Sprintf("%.4d-%.2d-%.2d %.2d:%.2d:%.2d.%.9d ",
+		t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond())
+}
+
+// CurrentTime returns the current virtual time
+func (vc *VirtualClock) CurrentTime() time.Time {
+	vc.mut.RLock()
+	defer vc.mut.RUnlock()
+
+	return vc.currentTime
+}
+
+// Close does nothing
+func (vc *VirtualClock) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (vc *VirtualClock) IsInterfaceNil() bool {
+	return vc == nil
+}
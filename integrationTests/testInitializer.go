@@ -341,6 +341,7 @@ func CreateStore(numOfShards uint32) dataRetriever.StorageService {
 	store.AddStorer(dataRetriever.HeartbeatUnit, CreateMemUnit())
 	store.AddStorer(dataRetriever.BootstrapUnit, CreateMemUnit())
 	store.AddStorer(dataRetriever.StatusMetricsUnit, CreateMemUnit())
+	store.AddStorer(dataRetriever.SlashingEvidenceUnit, CreateMemUnit())
 	store.AddStorer(dataRetriever.ReceiptsUnit, CreateMemUnit())
 
 	for i := uint32(0); i < numOfShards; i++ {
@@ -935,11 +936,12 @@ func CreateSimpleTxProcessor(accnts state.AccountsAdapter) process.TransactionPr
 				return fee
 			},
 		},
-		ReceiptForwarder: &mock.IntermediateTransactionHandlerMock{},
-		BadTxForwarder:   &mock.IntermediateTransactionHandlerMock{},
-		ArgsParser:       smartContract.NewArgumentParser(),
-		ScrForwarder:     &mock.IntermediateTransactionHandlerMock{},
-		EpochNotifier:    forking.NewGenericEpochNotifier(),
+		ReceiptForwarder:      &mock.IntermediateTransactionHandlerMock{},
+		BadTxForwarder:        &mock.IntermediateTransactionHandlerMock{},
+		ArgsParser:            smartContract.NewArgumentParser(),
+		ScrForwarder:          &mock.IntermediateTransactionHandlerMock{},
+		GuardedAccountHandler: &testscommon.GuardedAccountHandlerStub{},
+		EpochNotifier:         forking.NewGenericEpochNotifier(),
 	}
 	txProcessor, _ := txProc.NewTxProcessor(argsNewTxProcessor)
 
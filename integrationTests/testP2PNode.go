@@ -163,6 +163,7 @@ func (tP2pNode *TestP2PNode) initNode() {
 	cryptoComponents.PubKey = tP2pNode.NodeKeys.Pk
 	cryptoComponents.BlockSig = tP2pNode.SingleSigner
 	cryptoComponents.PeerSignHandler = psh
+	cryptoComponents.SignBackend = createPeerIDSignerBackend(tP2pNode.NodeKeys.Sk, psh)
 
 	processComponents := GetDefaultProcessComponents()
 	processComponents.ShardCoord = tP2pNode.ShardCoordinator
@@ -64,14 +64,18 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/factory/interceptorscontainer"
 	metaProcess "github.com/ElrondNetwork/elrond-go/process/factory/metachain"
 	"github.com/ElrondNetwork/elrond-go/process/factory/shard"
+	"github.com/ElrondNetwork/elrond-go/process/gasprice"
+	"github.com/ElrondNetwork/elrond-go/process/guardian"
 	"github.com/ElrondNetwork/elrond-go/process/interceptors"
 	"github.com/ElrondNetwork/elrond-go/process/peer"
 	"github.com/ElrondNetwork/elrond-go/process/rating"
 	"github.com/ElrondNetwork/elrond-go/process/rewardTransaction"
 	"github.com/ElrondNetwork/elrond-go/process/scToProtocol"
+	"github.com/ElrondNetwork/elrond-go/process/scheduledCalls"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
+	tracingDisabled "github.com/ElrondNetwork/elrond-go/process/smartContract/tracing/disabled"
 	sync2 "github.com/ElrondNetwork/elrond-go/process/sync"
 	"github.com/ElrondNetwork/elrond-go/process/track"
 	"github.com/ElrondNetwork/elrond-go/process/transaction"
@@ -240,8 +244,9 @@ type TestProcessorNode struct {
 	BlockChain          data.ChainHandler
 	GenesisBlocks       map[uint32]data.HeaderHandler
 
-	EconomicsData *economics.TestEconomicsData
-	RatingsData   *rating.RatingsData
+	EconomicsData     *economics.TestEconomicsData
+	RatingsData       *rating.RatingsData
+	GasPriceEstimator mainFactory.GasPriceEstimator
 
 	BlockBlackListHandler process.TimeCacher
 	HeaderValidator       process.HeaderConstructionValidator
@@ -530,6 +535,7 @@ func NewTestProcessorNodeWithFullGenesis(
 		BlockChainHook:    tpn.BlockchainHook,
 		BlockChain:        tpn.BlockChain,
 		ArwenChangeLocker: tpn.ArwenChangeLocker,
+		VMTracer:          tracingDisabled.NewDisabledVMTracer(),
 	}
 	tpn.SCQueryService, _ = smartContract.NewSCQueryService(argsNewScQueryService)
 	tpn.initBlockProcessor(stateCheckpointModulus)
@@ -538,8 +544,7 @@ func NewTestProcessorNodeWithFullGenesis(
 		TestHasher,
 		tpn.Messenger,
 		tpn.ShardCoordinator,
-		tpn.OwnAccount.SkTxSign,
-		tpn.OwnAccount.PeerSigHandler,
+		createPeerIDSignerBackend(tpn.OwnAccount.SkTxSign, tpn.OwnAccount.PeerSigHandler),
 		tpn.DataPool.Headers(),
 		tpn.InterceptorsContainer,
 		&testscommon.AlarmSchedulerStub{},
@@ -701,6 +706,7 @@ func (tpn *TestProcessorNode) initTestNode() {
 		BlockChainHook:    tpn.BlockchainHook,
 		BlockChain:        tpn.BlockChain,
 		ArwenChangeLocker: tpn.ArwenChangeLocker,
+		VMTracer:          tracingDisabled.NewDisabledVMTracer(),
 	}
 	tpn.SCQueryService, _ = smartContract.NewSCQueryService(argsNewScQueryService)
 	tpn.initBlockProcessor(stateCheckpointModulus)
@@ -709,8 +715,7 @@ func (tpn *TestProcessorNode) initTestNode() {
 		TestHasher,
 		tpn.Messenger,
 		tpn.ShardCoordinator,
-		tpn.OwnAccount.SkTxSign,
-		tpn.OwnAccount.PeerSigHandler,
+		createPeerIDSignerBackend(tpn.OwnAccount.SkTxSign, tpn.OwnAccount.PeerSigHandler),
 		tpn.DataPool.Headers(),
 		tpn.InterceptorsContainer,
 		&testscommon.AlarmSchedulerStub{},
@@ -759,8 +764,7 @@ func (tpn *TestProcessorNode) initTestNodeWithTrieDBAndGasModel(trieStore storag
 		TestHasher,
 		tpn.Messenger,
 		tpn.ShardCoordinator,
-		tpn.OwnAccount.SkTxSign,
-		tpn.OwnAccount.PeerSigHandler,
+		createPeerIDSignerBackend(tpn.OwnAccount.SkTxSign, tpn.OwnAccount.PeerSigHandler),
 		tpn.DataPool.Headers(),
 		tpn.InterceptorsContainer,
 		&testscommon.AlarmSchedulerStub{},
@@ -801,6 +805,7 @@ func (tpn *TestProcessorNode) createFullSCQueryService() {
 		DataPool:           tpn.DataPool,
 		CompiledSCPool:     smartContractsCache,
 		NilCompiledSCStore: true,
+		VMTracer:           tracingDisabled.NewDisabledVMTracer(),
 	}
 
 	if tpn.ShardCoordinator.SelfId() == core.MetachainShardId {
@@ -902,6 +907,7 @@ func (tpn *TestProcessorNode) createFullSCQueryService() {
 		BlockChainHook:    vmFactory.BlockChainHookImpl(),
 		BlockChain:        tpn.BlockChain,
 		ArwenChangeLocker: tpn.ArwenChangeLocker,
+		VMTracer:          tracingDisabled.NewDisabledVMTracer(),
 	}
 	tpn.SCQueryService, _ = smartContract.NewSCQueryService(argsNewScQueryService)
 }
@@ -917,6 +923,7 @@ func (tpn *TestProcessorNode) InitializeProcessors(gasMap map[string]map[string]
 		BlockChainHook:    tpn.BlockchainHook,
 		BlockChain:        tpn.BlockChain,
 		ArwenChangeLocker: tpn.ArwenChangeLocker,
+		VMTracer:          tracingDisabled.NewDisabledVMTracer(),
 	}
 	tpn.SCQueryService, _ = smartContract.NewSCQueryService(argsNewScQueryService)
 	tpn.initBlockProcessor(stateCheckpointModulus)
@@ -925,8 +932,7 @@ func (tpn *TestProcessorNode) InitializeProcessors(gasMap map[string]map[string]
 		TestHasher,
 		tpn.Messenger,
 		tpn.ShardCoordinator,
-		tpn.OwnAccount.SkTxSign,
-		tpn.OwnAccount.PeerSigHandler,
+		createPeerIDSignerBackend(tpn.OwnAccount.SkTxSign, tpn.OwnAccount.PeerSigHandler),
 		tpn.DataPool.Headers(),
 		tpn.InterceptorsContainer,
 		&testscommon.AlarmSchedulerStub{},
@@ -1376,6 +1382,7 @@ func (tpn *TestProcessorNode) initInnerProcessors(gasMap map[string]map[string]u
 		DataPool:           tpn.DataPool,
 		CompiledSCPool:     tpn.DataPool.SmartContracts(),
 		NilCompiledSCStore: true,
+		VMTracer:           tracingDisabled.NewDisabledVMTracer(),
 	}
 	esdtTransferParser, _ := parsers.NewESDTTransferParser(TestMarshalizer)
 	maxGasLimitPerBlock := uint64(0xFFFFFFFFFFFFFFFF)
@@ -1448,12 +1455,14 @@ func (tpn *TestProcessorNode) initInnerProcessors(gasMap map[string]map[string]u
 		PenalizedTooMuchGasEnableEpoch:        tpn.EnableEpochs.PenalizedTooMuchGasEnableEpoch,
 		VMOutputCacher:                        txcache.NewDisabledCache(),
 		ArwenChangeLocker:                     tpn.ArwenChangeLocker,
+		VMTracer:                              tracingDisabled.NewDisabledVMTracer(),
 		BuiltInFunctionOnMetachainEnableEpoch: tpn.EnableEpochs.BuiltInFunctionOnMetaEnableEpoch,
 	}
 	sc, _ := smartContract.NewSmartContractProcessor(argsNewScProcessor)
 	tpn.ScProcessor = smartContract.NewTestScProcessor(sc)
 
 	receiptsHandler, _ := tpn.InterimProcContainer.Get(dataBlock.ReceiptBlock)
+	guardedAccounts, _ := guardian.NewGuardedAccount(TestMarshalizer, tpn.EpochNotifier)
 	argsNewTxProcessor := transaction.ArgsNewTxProcessor{
 		Accounts:                       tpn.AccntState,
 		Hasher:                         TestHasher,
@@ -1469,6 +1478,7 @@ func (tpn *TestProcessorNode) initInnerProcessors(gasMap map[string]map[string]u
 		BadTxForwarder:                 badBlocksHandler,
 		ArgsParser:                     tpn.ArgsParser,
 		ScrForwarder:                   tpn.ScrForwarder,
+		GuardedAccountHandler:          guardedAccounts,
 		EpochNotifier:                  tpn.EpochNotifier,
 		RelayedTxEnableEpoch:           tpn.EnableEpochs.RelayedTransactionsEnableEpoch,
 		PenalizedTooMuchGasEnableEpoch: tpn.EnableEpochs.PenalizedTooMuchGasEnableEpoch,
@@ -1568,6 +1578,7 @@ func (tpn *TestProcessorNode) initMetaInnerProcessors() {
 		DataPool:           tpn.DataPool,
 		CompiledSCPool:     tpn.DataPool.SmartContracts(),
 		NilCompiledSCStore: true,
+		VMTracer:           tracingDisabled.NewDisabledVMTracer(),
 	}
 
 	var signVerifier vm.MessageSignVerifier
@@ -1677,6 +1688,7 @@ func (tpn *TestProcessorNode) initMetaInnerProcessors() {
 		PenalizedTooMuchGasEnableEpoch:        tpn.EnableEpochs.PenalizedTooMuchGasEnableEpoch,
 		VMOutputCacher:                        txcache.NewDisabledCache(),
 		ArwenChangeLocker:                     tpn.ArwenChangeLocker,
+		VMTracer:                              tracingDisabled.NewDisabledVMTracer(),
 		BuiltInFunctionOnMetachainEnableEpoch: tpn.EnableEpochs.BuiltInFunctionOnMetaEnableEpoch,
 	}
 	scProcessor, _ := smartContract.NewSmartContractProcessor(argsNewScProcessor)
@@ -1844,9 +1856,27 @@ func (tpn *TestProcessorNode) addMockVm(blockchainHook vmcommon.BlockchainHook)
 	_ = tpn.VMContainer.Add(factory.InternalTestingVM, mockVM)
 }
 
+func (tpn *TestProcessorNode) initGasPriceEstimator() {
+	argsGasPriceEstimator := gasprice.ArgsGasPriceEstimator{
+		Config: config.GasPriceEstimatorConfig{
+			NumBlocks:                  20,
+			SlowPercentile:             30,
+			NormalPercentile:           60,
+			FastPercentile:             90,
+			FullBlockGasUsedPercentage: 0.8,
+		},
+		EconomicsHandler: tpn.EconomicsData,
+		ShardCoordinator: tpn.ShardCoordinator,
+		TxPool:           tpn.DataPool.Transactions(),
+	}
+	tpn.GasPriceEstimator, _ = gasprice.NewGasPriceEstimator(argsGasPriceEstimator)
+}
+
 func (tpn *TestProcessorNode) initBlockProcessor(stateCheckpointModulus uint) {
 	var err error
 
+	tpn.initGasPriceEstimator()
+
 	if tpn.ShardCoordinator.SelfId() != core.MetachainShardId {
 		tpn.ForkDetector, _ = sync2.NewShardForkDetector(tpn.RoundHandler, tpn.BlockBlackListHandler, tpn.BlockTracker, tpn.NodesSetup.GetStartTime())
 	} else {
@@ -1903,6 +1933,9 @@ func (tpn *TestProcessorNode) initBlockProcessor(stateCheckpointModulus uint) {
 		BlockSizeThrottler: TestBlockSizeThrottler,
 		HistoryRepository:  tpn.HistoryRepository,
 		EpochNotifier:      tpn.EpochNotifier,
+
+		DynamicGasPriceHandler: tpn.EconomicsData,
+		GasPriceTracker:        tpn.GasPriceEstimator,
 	}
 
 	if check.IfNil(tpn.EpochStartNotifier) {
@@ -2034,6 +2067,20 @@ func (tpn *TestProcessorNode) initBlockProcessor(stateCheckpointModulus uint) {
 		epochStartSystemSCProcessor, _ := metachain.NewSystemSCProcessor(argsEpochSystemSC)
 		tpn.EpochStartSystemSCProcessor = epochStartSystemSCProcessor
 
+		argsScheduledCallsExecutor := scheduledCalls.ArgsScheduledCallsExecutor{
+			SystemVM:                systemVM,
+			AccountsDB:              tpn.AccntState,
+			SCRForwarder:            tpn.ScrForwarder,
+			TxFeeHandler:            tpn.FeeAccumulator,
+			ArgumentsParser:         tpn.ArgsParser,
+			Marshalizer:             TestMarshalizer,
+			EpochNotifier:           tpn.EpochNotifier,
+			EndOfEpochCallerAddress: vm.EndOfEpochAddress,
+			SchedulerSCAddress:      vm.SchedulerSCAddress,
+			EpochConfig:             config.EpochConfig{EnableEpochs: tpn.EnableEpochs},
+		}
+		scheduledCallsExecutor, _ := scheduledCalls.NewScheduledCallsExecutor(argsScheduledCallsExecutor)
+
 		arguments := block.ArgMetaProcessor{
 			ArgBaseProcessor:             argumentsBase,
 			SCToProtocol:                 scToProtocolInstance,
@@ -2044,6 +2091,7 @@ func (tpn *TestProcessorNode) initBlockProcessor(stateCheckpointModulus uint) {
 			EpochValidatorInfoCreator:    epochStartValidatorInfo,
 			ValidatorStatisticsProcessor: tpn.ValidatorStatisticsProcessor,
 			EpochSystemSCProcessor:       epochStartSystemSCProcessor,
+			ScheduledCallsExecutor:       scheduledCallsExecutor,
 		}
 
 		tpn.BlockProcessor, err = block.NewMetaProcessor(arguments)
@@ -2660,6 +2708,7 @@ func (tpn *TestProcessorNode) createHeartbeatWithHardforkTrigger(heartbeatPk str
 	cryptoComponents.BlKeyGen = tpn.OwnAccount.KeygenTxSign
 	cryptoComponents.TxKeyGen = TestKeyGenForAccounts
 	cryptoComponents.PeerSignHandler = psh
+	cryptoComponents.SignBackend = createPeerIDSignerBackend(tpn.NodeKeys.Sk, psh)
 
 	networkComponents := GetDefaultNetworkComponents()
 	networkComponents.Messenger = tpn.Messenger
@@ -2838,6 +2887,17 @@ func GetDefaultCryptoComponents() *mock.CryptoComponentsStub {
 		BlKeyGen:        &mock.KeyGenMock{},
 		TxKeyGen:        &mock.KeyGenMock{},
 		MsgSigVerifier:  &testscommon.MessageSignVerifierMock{},
+		SignBackend:     &cryptoMocks.SignerBackendStub{},
+		KeysHolder:      &cryptoMocks.KeysHandlerStub{},
+	}
+}
+
+// createPeerIDSignerBackend returns a signer backend that signs the peer IDs with the provided private key
+func createPeerIDSignerBackend(sk crypto.PrivateKey, psh crypto.PeerSignatureHandler) consensus.SignerBackend {
+	return &cryptoMocks.SignerBackendStub{
+		SignPeerIDCalled: func(_ []byte, pid []byte) ([]byte, error) {
+			return psh.GetPeerSignature(sk, pid)
+		},
 	}
 }
 
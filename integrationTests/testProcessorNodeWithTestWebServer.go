@@ -17,6 +17,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/node/trieIterators/factory"
 	"github.com/ElrondNetwork/elrond-go/process/coordinator"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
+	tracingDisabled "github.com/ElrondNetwork/elrond-go/process/smartContract/tracing/disabled"
 	"github.com/ElrondNetwork/elrond-go/process/transaction"
 	"github.com/ElrondNetwork/elrond-go/process/txsimulator"
 	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
@@ -71,12 +72,13 @@ func (node *TestProcessorNodeWithTestWebServer) DoRequest(request *http.Request)
 }
 
 func createFacadeArg(tpn *TestProcessorNode) nodeFacade.ArgNodeFacade {
-	apiResolver, txSimulator := createFacadeComponents(tpn)
+	apiResolver, txSimulator, txBundleSimulator := createFacadeComponents(tpn)
 
 	return nodeFacade.ArgNodeFacade{
 		Node:                   tpn.Node,
 		ApiResolver:            apiResolver,
 		TxSimulatorProcessor:   txSimulator,
+		TxBundleSimulator:      txBundleSimulator,
 		RestAPIServerDebugMode: false,
 		WsAntifloodConfig: config.WebServerAntifloodConfig{
 			SimultaneousRequests:         1000,
@@ -125,7 +127,7 @@ func createTestApiConfig() config.ApiRoutesConfig {
 	return routesConfig
 }
 
-func createFacadeComponents(tpn *TestProcessorNode) (nodeFacade.ApiResolver, nodeFacade.TransactionSimulatorProcessor) {
+func createFacadeComponents(tpn *TestProcessorNode) (nodeFacade.ApiResolver, nodeFacade.TransactionSimulatorProcessor, nodeFacade.TransactionBundleSimulator) {
 	gasMap := arwenConfig.MakeGasMapForTests()
 	defaults.FillGasMapInternal(gasMap, 1)
 	gasScheduleNotifier := mock.NewGasScheduleNotifierMock(gasMap)
@@ -185,6 +187,12 @@ func createFacadeComponents(tpn *TestProcessorNode) (nodeFacade.ApiResolver, nod
 	delegatedListHandler, err := factory.CreateDelegatedListHandler(args)
 	log.LogIfError(err)
 
+	governanceInfoHandler, err := factory.CreateGovernanceInfoHandler(args)
+	log.LogIfError(err)
+
+	tokenPropertiesHandler, err := factory.CreateTokenPropertiesHandler(args)
+	log.LogIfError(err)
+
 	argsApiResolver := external.ArgNodeApiResolver{
 		SCQueryService:          tpn.SCQueryService,
 		StatusMetricsHandler:    &mock.StatusMetricsStub{},
@@ -192,25 +200,47 @@ func createFacadeComponents(tpn *TestProcessorNode) (nodeFacade.ApiResolver, nod
 		TotalStakedValueHandler: totalStakedValueHandler,
 		DirectStakedListHandler: directStakedListHandler,
 		DelegatedListHandler:    delegatedListHandler,
+		GovernanceInfoHandler:   governanceInfoHandler,
+		TokenPropertiesHandler:  tokenPropertiesHandler,
+		ESDTRegistryHandler:     tpn.HistoryRepository,
+		AddressPubKeyConverter:  TestAddressPubkeyConverter,
+		GasPriceEstimator:       tpn.GasPriceEstimator,
 	}
 
 	apiResolver, err := external.NewNodeApiResolver(argsApiResolver)
 	log.LogIfError(err)
 
+	accountsChangesHandler, err := txsimulator.NewReadOnlyAccountsDB(tpn.AccntState, TestHasher)
+	log.LogIfError(err)
+
 	argSimulator := txsimulator.ArgsTxSimulator{
 		TransactionProcessor:      tpn.TxProcessor,
+		SCRProcessor:              tpn.ScProcessor,
 		IntermediateProcContainer: tpn.InterimProcContainer,
 		AddressPubKeyConverter:    TestAddressPubkeyConverter,
 		ShardCoordinator:          tpn.ShardCoordinator,
 		Marshalizer:               TestMarshalizer,
 		Hasher:                    TestHasher,
 		VMOutputCacher:            &testscommon.CacherMock{},
+		TxLogsProcessor:           &mock.TxLogsProcessorStub{},
+		AccountsChangesHandler:    accountsChangesHandler,
+		VMTracer:                  tracingDisabled.NewDisabledVMTracer(),
 	}
 
 	txSimulator, err := txsimulator.NewTransactionSimulator(argSimulator)
 	log.LogIfError(err)
 
-	return apiResolver, txSimulator
+	argBundleSimulator := txsimulator.ArgsBundleSimulator{
+		ShardSimulators:      []txsimulator.ShardSimulator{txSimulator},
+		ShardCoordinator:     tpn.ShardCoordinator,
+		MaxBundleSize:        20,
+		MaxContinuationSteps: 50,
+	}
+
+	txBundleSimulator, err := txsimulator.NewBundleSimulator(argBundleSimulator)
+	log.LogIfError(err)
+
+	return apiResolver, txSimulator, txBundleSimulator
 }
 
 func createGinServer(facade Facade, apiConfig config.ApiRoutesConfig) *gin.Engine {
@@ -15,6 +15,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/block"
 	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
+	tracingDisabled "github.com/ElrondNetwork/elrond-go/process/smartContract/tracing/disabled"
 	"github.com/ElrondNetwork/elrond-go/process/sync"
 	"github.com/ElrondNetwork/elrond-go/process/transactionLog"
 	"github.com/ElrondNetwork/elrond-go/sharding"
@@ -127,8 +128,7 @@ func (tpn *TestProcessorNode) initTestNodeWithSync() {
 		TestHasher,
 		tpn.Messenger,
 		tpn.ShardCoordinator,
-		tpn.OwnAccount.SkTxSign,
-		tpn.OwnAccount.PeerSigHandler,
+		createPeerIDSignerBackend(tpn.OwnAccount.SkTxSign, tpn.OwnAccount.PeerSigHandler),
 		tpn.DataPool.Headers(),
 		tpn.InterceptorsContainer,
 		&testscommon.AlarmSchedulerStub{},
@@ -142,6 +142,7 @@ func (tpn *TestProcessorNode) initTestNodeWithSync() {
 		BlockChainHook:    tpn.BlockchainHook,
 		BlockChain:        tpn.BlockChain,
 		ArwenChangeLocker: tpn.ArwenChangeLocker,
+		VMTracer:          tracingDisabled.NewDisabledVMTracer(),
 	}
 	tpn.SCQueryService, _ = smartContract.NewSCQueryService(argsNewScQueryService)
 	tpn.addHandlersForCounters()
@@ -166,6 +167,8 @@ func (tpn *TestProcessorNode) addGenesisBlocksIntoStorage() {
 func (tpn *TestProcessorNode) initBlockProcessorWithSync() {
 	var err error
 
+	tpn.initGasPriceEstimator()
+
 	accountsDb := make(map[state.AccountsDbIdentifier]state.AccountsAdapter)
 	accountsDb[state.UserAccountsState] = tpn.AccntState
 	accountsDb[state.PeerAccountsState] = tpn.PeerState
@@ -214,6 +217,9 @@ func (tpn *TestProcessorNode) initBlockProcessorWithSync() {
 		BlockSizeThrottler: TestBlockSizeThrottler,
 		HistoryRepository:  tpn.HistoryRepository,
 		EpochNotifier:      tpn.EpochNotifier,
+
+		DynamicGasPriceHandler: tpn.EconomicsData,
+		GasPriceTracker:        tpn.GasPriceEstimator,
 	}
 
 	if tpn.ShardCoordinator.SelfId() == core.MetachainShardId {
@@ -230,6 +236,7 @@ func (tpn *TestProcessorNode) initBlockProcessorWithSync() {
 			EpochValidatorInfoCreator:    &mock.EpochValidatorInfoCreatorStub{},
 			ValidatorStatisticsProcessor: &mock.ValidatorStatisticsProcessorStub{},
 			EpochSystemSCProcessor:       &mock.EpochStartSystemSCStub{},
+			ScheduledCallsExecutor:       &mock.ScheduledCallsExecutorStub{},
 		}
 
 		tpn.BlockProcessor, err = block.NewMetaProcessor(arguments)
@@ -37,6 +37,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
+	tracingDisabled "github.com/ElrondNetwork/elrond-go/process/smartContract/tracing/disabled"
 	processTransaction "github.com/ElrondNetwork/elrond-go/process/transaction"
 	"github.com/ElrondNetwork/elrond-go/state"
 	"github.com/ElrondNetwork/elrond-go/storage/txcache"
@@ -145,6 +146,7 @@ func SetupTestContext(t *testing.T) *TestContext {
 		BlockChainHook:    context.BlockchainHook,
 		BlockChain:        &mock.BlockChainMock{},
 		ArwenChangeLocker: &sync.RWMutex{},
+		VMTracer:          tracingDisabled.NewDisabledVMTracer(),
 	}
 	context.QueryService, _ = smartContract.NewSCQueryService(argsNewSCQueryService)
 
@@ -252,6 +254,7 @@ func (context *TestContext) initVMAndBlockchainHook() {
 				MaxBatchSize:      100,
 			},
 		},
+		VMTracer: tracingDisabled.NewDisabledVMTracer(),
 	}
 
 	vmFactoryConfig := config.VirtualMachineConfig{
@@ -322,6 +325,7 @@ func (context *TestContext) initTxProcessorWithOneSCExecutorWithVMs() {
 		TxLogsProcessor:   &mock.TxLogsProcessorStub{},
 		EpochNotifier:     forking.NewGenericEpochNotifier(),
 		ArwenChangeLocker: context.ArwenChangeLocker,
+		VMTracer:          tracingDisabled.NewDisabledVMTracer(),
 		VMOutputCacher:    txcache.NewDisabledCache(),
 	}
 	sc, err := smartContract.NewSmartContractProcessor(argsNewSCProcessor)
@@ -343,6 +347,7 @@ func (context *TestContext) initTxProcessorWithOneSCExecutorWithVMs() {
 		BadTxForwarder:                 &mock.IntermediateTransactionHandlerMock{},
 		ArgsParser:                     smartContract.NewArgumentParser(),
 		ScrForwarder:                   &mock.IntermediateTransactionHandlerMock{},
+		GuardedAccountHandler:          &testscommon.GuardedAccountHandlerStub{},
 		RelayedTxEnableEpoch:           0,
 		PenalizedTooMuchGasEnableEpoch: 0,
 		EpochNotifier:                  forking.NewGenericEpochNotifier(),
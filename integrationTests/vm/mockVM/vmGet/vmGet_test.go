@@ -14,6 +14,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/factory"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
+	tracingDisabled "github.com/ElrondNetwork/elrond-go/process/smartContract/tracing/disabled"
 	"github.com/ElrondNetwork/elrond-go/state"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/stretchr/testify/assert"
@@ -38,6 +39,7 @@ func TestVmGetShouldReturnValue(t *testing.T) {
 		BlockChainHook:    &mock.BlockChainHookHandlerMock{},
 		BlockChain:        &mock.BlockChainMock{},
 		ArwenChangeLocker: &sync.RWMutex{},
+		VMTracer:          tracingDisabled.NewDisabledVMTracer(),
 	}
 	service, _ := smartContract.NewSCQueryService(argsNewSCQueryService)
 
@@ -1,3 +1,4 @@
+//go:build cgo
 // +build cgo
 
 package vm
@@ -41,6 +42,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
+	tracingDisabled "github.com/ElrondNetwork/elrond-go/process/smartContract/tracing/disabled"
 	"github.com/ElrondNetwork/elrond-go/process/transaction"
 	"github.com/ElrondNetwork/elrond-go/process/txsimulator"
 	"github.com/ElrondNetwork/elrond-go/sharding"
@@ -119,6 +121,7 @@ type VMTestContext struct {
 	Contract      VMTestAccount
 
 	TxCostHandler external.TransactionCostHandler
+	TxSimulator   txsimulator.ShardSimulator
 }
 
 // Close -
@@ -238,6 +241,7 @@ func (vmTestContext *VMTestContext) GetVMOutputWithTransientVM(funcName string,
 		BlockChainHook:    blockChainHook,
 		BlockChain:        &mock.BlockChainMock{},
 		ArwenChangeLocker: &sync.RWMutex{},
+		VMTracer:          tracingDisabled.NewDisabledVMTracer(),
 	}
 	scQueryService, _ := smartContract.NewSCQueryService(argsNewSCQueryService)
 
@@ -342,7 +346,7 @@ func CreateAccount(accnts state.AccountsAdapter, pubKey []byte, nonce uint64, ba
 	return hashCreated, nil
 }
 
-func createEconomicsData(penalizedTooMuchGasEnableEpoch uint32) (process.EconomicsDataHandler, error) {
+func createEconomicsData(penalizedTooMuchGasEnableEpoch uint32, epochNotifier process.EpochNotifier) (process.EconomicsDataHandler, error) {
 	maxGasLimitPerBlock := strconv.FormatUint(math.MaxUint64, 10)
 	minGasPrice := strconv.FormatUint(1, 10)
 	minGasLimit := strconv.FormatUint(1, 10)
@@ -386,7 +390,7 @@ func createEconomicsData(penalizedTooMuchGasEnableEpoch uint32) (process.Economi
 			},
 		},
 		PenalizedTooMuchGasEnableEpoch: penalizedTooMuchGasEnableEpoch,
-		EpochNotifier:                  &mock.EpochNotifierStub{},
+		EpochNotifier:                  epochNotifier,
 		BuiltInFunctionsCostHandler:    builtInCost,
 	}
 
@@ -416,6 +420,7 @@ func CreateTxProcessorWithOneSCExecutorMockVM(
 		CompiledSCPool:     datapool.SmartContracts(),
 		NilCompiledSCStore: true,
 		ConfigSCStorage:    *defaultStorageConfig(),
+		VMTracer:           tracingDisabled.NewDisabledVMTracer(),
 	}
 
 	blockChainHook, _ := hooks.NewBlockChainHookImpl(args)
@@ -440,7 +445,7 @@ func CreateTxProcessorWithOneSCExecutorMockVM(
 	gasSchedule := make(map[string]map[string]uint64)
 	defaults.FillGasMapInternal(gasSchedule, 1)
 
-	economicsData, err := createEconomicsData(argEnableEpoch.PenalizedTooMuchGasEnableEpoch)
+	economicsData, err := createEconomicsData(argEnableEpoch.PenalizedTooMuchGasEnableEpoch, &mock.EpochNotifierStub{})
 	if err != nil {
 		return nil, err
 	}
@@ -470,6 +475,7 @@ func CreateTxProcessorWithOneSCExecutorMockVM(
 		DeployEnableEpoch:              argEnableEpoch.DeployEnableEpoch,
 		VMOutputCacher:                 txcache.NewDisabledCache(),
 		ArwenChangeLocker:              arwenChangeLocker,
+		VMTracer:                       tracingDisabled.NewDisabledVMTracer(),
 	}
 	scProcessor, _ := smartContract.NewSmartContractProcessor(argsNewSCProcessor)
 
@@ -488,6 +494,7 @@ func CreateTxProcessorWithOneSCExecutorMockVM(
 		BadTxForwarder:                 &mock.IntermediateTransactionHandlerMock{},
 		ArgsParser:                     smartContract.NewArgumentParser(),
 		ScrForwarder:                   &mock.IntermediateTransactionHandlerMock{},
+		GuardedAccountHandler:          &testscommon.GuardedAccountHandlerStub{},
 		EpochNotifier:                  forking.NewGenericEpochNotifier(),
 		PenalizedTooMuchGasEnableEpoch: argEnableEpoch.PenalizedTooMuchGasEnableEpoch,
 		MetaProtectionEnableEpoch:      argEnableEpoch.MetaProtectionEnableEpoch,
@@ -513,6 +520,7 @@ func CreateOneSCExecutorMockVM(accnts state.AccountsAdapter) vmcommon.VMExecutio
 		CompiledSCPool:     datapool.SmartContracts(),
 		NilCompiledSCStore: true,
 		ConfigSCStorage:    *defaultStorageConfig(),
+		VMTracer:           tracingDisabled.NewDisabledVMTracer(),
 	}
 	blockChainHook, _ := hooks.NewBlockChainHookImpl(args)
 	vm, _ := mock.NewOneSCExecutorMockVM(blockChainHook, testHasher)
@@ -560,6 +568,7 @@ func CreateVMAndBlockchainHookAndDataPool(
 		CompiledSCPool:     datapool.SmartContracts(),
 		NilCompiledSCStore: true,
 		ConfigSCStorage:    *defaultStorageConfig(),
+		VMTracer:           tracingDisabled.NewDisabledVMTracer(),
 	}
 
 	esdtTransferParser, _ := parsers.NewESDTTransferParser(testMarshalizer)
@@ -630,9 +639,10 @@ func CreateVMAndBlockchainHookMeta(
 		DataPool:           datapool,
 		CompiledSCPool:     datapool.SmartContracts(),
 		NilCompiledSCStore: true,
+		VMTracer:           tracingDisabled.NewDisabledVMTracer(),
 	}
 
-	economicsData, err := createEconomicsData(0)
+	economicsData, err := createEconomicsData(0, &mock.EpochNotifierStub{})
 	if err != nil {
 		log.LogIfError(err)
 	}
@@ -749,12 +759,16 @@ func CreateTxProcessorWithOneSCExecutorWithVMs(
 	argEnableEpoch ArgEnableEpoch,
 	arwenChangeLocker process.Locker,
 	poolsHolder dataRetriever.PoolsHolder,
+	epochNotifier process.EpochNotifier,
+	vmGasSchedule map[string]map[string]uint64,
+	vmConfig *config.VirtualMachineConfig,
 ) (
 	process.TransactionProcessor,
 	*smartContract.TestScProcessor,
 	process.IntermediateTransactionHandler,
 	process.EconomicsDataHandler,
 	external.TransactionCostHandler,
+	txsimulator.ShardSimulator,
 	error,
 ) {
 	if check.IfNil(poolsHolder) {
@@ -767,21 +781,21 @@ func CreateTxProcessorWithOneSCExecutorWithVMs(
 		ShardCoordinator:   shardCoordinator,
 		BuiltInFunctions:   blockChainHook.GetBuiltinFunctionsContainer(),
 		ArgumentParser:     parsers.NewCallArgsParser(),
-		EpochNotifier:      forking.NewGenericEpochNotifier(),
+		EpochNotifier:      epochNotifier,
 		ESDTTransferParser: esdtTransferParser,
 	}
 	txTypeHandler, _ := coordinator.NewTxTypeHandler(argsTxTypeHandler)
 
 	gasSchedule := make(map[string]map[string]uint64)
 	defaults.FillGasMapInternal(gasSchedule, 1)
-	economicsData, err := createEconomicsData(argEnableEpoch.PenalizedTooMuchGasEnableEpoch)
+	economicsData, err := createEconomicsData(argEnableEpoch.PenalizedTooMuchGasEnableEpoch, epochNotifier)
 	if err != nil {
-		return nil, nil, nil, nil, nil, err
+		return nil, nil, nil, nil, nil, nil, err
 	}
 
-	gasComp, err := preprocess.NewGasComputation(economicsData, txTypeHandler, forking.NewGenericEpochNotifier(), argEnableEpoch.DeployEnableEpoch)
+	gasComp, err := preprocess.NewGasComputation(economicsData, txTypeHandler, epochNotifier, argEnableEpoch.DeployEnableEpoch)
 	if err != nil {
-		return nil, nil, nil, nil, nil, err
+		return nil, nil, nil, nil, nil, nil, err
 	}
 
 	intermediateTxHandler := &mock.IntermediateTransactionHandlerMock{}
@@ -802,17 +816,18 @@ func CreateTxProcessorWithOneSCExecutorWithVMs(
 		GasHandler:                     gasComp,
 		GasSchedule:                    mock.NewGasScheduleNotifierMock(gasSchedule),
 		TxLogsProcessor:                &mock.TxLogsProcessorStub{},
-		EpochNotifier:                  forking.NewGenericEpochNotifier(),
+		EpochNotifier:                  epochNotifier,
 		PenalizedTooMuchGasEnableEpoch: argEnableEpoch.PenalizedTooMuchGasEnableEpoch,
 		DeployEnableEpoch:              argEnableEpoch.DeployEnableEpoch,
 		BuiltinEnableEpoch:             argEnableEpoch.BuiltinEnableEpoch,
 		ArwenChangeLocker:              arwenChangeLocker,
+		VMTracer:                       tracingDisabled.NewDisabledVMTracer(),
 		VMOutputCacher:                 txcache.NewDisabledCache(),
 	}
 
 	scProcessor, err := smartContract.NewSmartContractProcessor(argsNewSCProcessor)
 	if err != nil {
-		return nil, nil, nil, nil, nil, err
+		return nil, nil, nil, nil, nil, nil, err
 	}
 	testScProcessor := smartContract.NewTestScProcessor(scProcessor)
 
@@ -831,20 +846,35 @@ func CreateTxProcessorWithOneSCExecutorWithVMs(
 		BadTxForwarder:                 intermediateTxHandler,
 		ArgsParser:                     smartContract.NewArgumentParser(),
 		ScrForwarder:                   intermediateTxHandler,
-		EpochNotifier:                  forking.NewGenericEpochNotifier(),
+		GuardedAccountHandler:          &testscommon.GuardedAccountHandlerStub{},
+		EpochNotifier:                  epochNotifier,
 		PenalizedTooMuchGasEnableEpoch: argEnableEpoch.PenalizedTooMuchGasEnableEpoch,
 		RelayedTxEnableEpoch:           argEnableEpoch.RelayedTxEnableEpoch,
 		MetaProtectionEnableEpoch:      argEnableEpoch.MetaProtectionEnableEpoch,
 	}
 	txProcessor, err := transaction.NewTxProcessor(argsNewTxProcessor)
 	if err != nil {
-		return nil, nil, nil, nil, nil, err
+		return nil, nil, nil, nil, nil, nil, err
 	}
 
 	// create transaction simulator
-	readOnlyAccountsDB, err := txsimulator.NewReadOnlyAccountsDB(accnts)
+	readOnlyAccountsDB, err := txsimulator.NewReadOnlyAccountsDB(accnts, testHasher)
 	if err != nil {
-		return nil, nil, nil, nil, nil, err
+		return nil, nil, nil, nil, nil, nil, err
+	}
+
+	// the simulation runs on its own VM, configured as the provided one, so the contracts observe the ephemeral state
+	// of the read only accounts
+	if shardCoordinator.SelfId() != core.MetachainShardId {
+		simulationVMContainer, simulationBlockChainHook, _ := CreateVMAndBlockchainHookAndDataPool(
+			readOnlyAccountsDB,
+			vmGasSchedule,
+			vmConfig,
+			shardCoordinator,
+			arwenChangeLocker,
+		)
+		argsNewSCProcessor.VmContainer = simulationVMContainer
+		argsNewSCProcessor.BlockChainHook = simulationBlockChainHook
 	}
 
 	interimProcFactory, err := shard.NewIntermediateProcessorsContainerFactory(
@@ -857,30 +887,30 @@ func CreateTxProcessorWithOneSCExecutorWithVMs(
 		&processDisabled.FeeHandler{},
 	)
 	if err != nil {
-		return nil, nil, nil, nil, nil, err
+		return nil, nil, nil, nil, nil, nil, err
 	}
 
 	interimProcContainer, err := interimProcFactory.Create()
 	if err != nil {
-		return nil, nil, nil, nil, nil, err
+		return nil, nil, nil, nil, nil, nil, err
 	}
 
 	scForwarder, err := interimProcContainer.Get(block.SmartContractResultBlock)
 	if err != nil {
-		return nil, nil, nil, nil, nil, err
+		return nil, nil, nil, nil, nil, nil, err
 	}
 
 	argsNewSCProcessor.ScrForwarder = scForwarder
 
 	receiptTxInterim, err := interimProcContainer.Get(block.ReceiptBlock)
 	if err != nil {
-		return nil, nil, nil, nil, nil, err
+		return nil, nil, nil, nil, nil, nil, err
 	}
 	argsNewTxProcessor.ReceiptForwarder = receiptTxInterim
 
 	badTxInterim, err := interimProcContainer.Get(block.InvalidBlock)
 	if err != nil {
-		return nil, nil, nil, nil, nil, err
+		return nil, nil, nil, nil, nil, nil, err
 	}
 	argsNewSCProcessor.BadTxForwarder = badTxInterim
 	argsNewTxProcessor.BadTxForwarder = badTxInterim
@@ -900,13 +930,16 @@ func CreateTxProcessorWithOneSCExecutorWithVMs(
 		VMOutputCacher:         vmOutputCacher,
 		Marshalizer:            testMarshalizer,
 		Hasher:                 testHasher,
+		TxLogsProcessor:        &mock.TxLogsProcessorStub{},
+		AccountsChangesHandler: readOnlyAccountsDB,
+		VMTracer:               tracingDisabled.NewDisabledVMTracer(),
 	}
 
 	argsNewSCProcessor.VMOutputCacher = txSimulatorProcessorArgs.VMOutputCacher
 
 	scProcessorTxSim, err := smartContract.NewSmartContractProcessor(argsNewSCProcessor)
 	if err != nil {
-		return nil, nil, nil, nil, nil, err
+		return nil, nil, nil, nil, nil, nil, err
 	}
 	argsNewTxProcessor.ScProcessor = scProcessorTxSim
 
@@ -914,14 +947,15 @@ func CreateTxProcessorWithOneSCExecutorWithVMs(
 
 	txSimulatorProcessorArgs.TransactionProcessor, err = transaction.NewTxProcessor(argsNewTxProcessor)
 	if err != nil {
-		return nil, nil, nil, nil, nil, err
+		return nil, nil, nil, nil, nil, nil, err
 	}
 
+	txSimulatorProcessorArgs.SCRProcessor = scProcessorTxSim
 	txSimulatorProcessorArgs.IntermediateProcContainer = interimProcContainer
 
 	txSimulator, err := txsimulator.NewTransactionSimulator(txSimulatorProcessorArgs)
 	if err != nil {
-		return nil, nil, nil, nil, nil, err
+		return nil, nil, nil, nil, nil, nil, err
 	}
 
 	txCostEstimator, err := transaction.NewTransactionCostEstimator(
@@ -932,10 +966,10 @@ func CreateTxProcessorWithOneSCExecutorWithVMs(
 		shardCoordinator,
 	)
 	if err != nil {
-		return nil, nil, nil, nil, nil, err
+		return nil, nil, nil, nil, nil, nil, err
 	}
 
-	return txProcessor, testScProcessor, intermediateTxHandler, economicsData, txCostEstimator, nil
+	return txProcessor, testScProcessor, intermediateTxHandler, economicsData, txCostEstimator, txSimulator, nil
 }
 
 // TestDeployedContractContents -
@@ -994,7 +1028,7 @@ func CreatePreparedTxProcessorAndAccountsWithVMs(
 	vmConfig := createDefaultVMConfig()
 	arwenChangeLocker := &sync.RWMutex{}
 	vmContainer, blockchainHook, pool := CreateVMAndBlockchainHookAndDataPool(accounts, nil, vmConfig, oneShardCoordinator, arwenChangeLocker)
-	txProcessor, testScProcessor, scForwarder, _, _, err := CreateTxProcessorWithOneSCExecutorWithVMs(
+	txProcessor, testScProcessor, scForwarder, _, _, _, err := CreateTxProcessorWithOneSCExecutorWithVMs(
 		accounts,
 		vmContainer,
 		blockchainHook,
@@ -1003,6 +1037,9 @@ func CreatePreparedTxProcessorAndAccountsWithVMs(
 		argEnableEpoch,
 		arwenChangeLocker,
 		pool,
+		forking.NewGenericEpochNotifier(),
+		nil,
+		vmConfig,
 	)
 	if err != nil {
 		return nil, err
@@ -1031,8 +1068,9 @@ func CreatePreparedTxProcessorWithVMsWithShardCoordinator(argEnableEpoch ArgEnab
 	vmConfig := createDefaultVMConfig()
 	arwenChangeLocker := &sync.RWMutex{}
 
+	epochNotifier := forking.NewGenericEpochNotifier()
 	vmContainer, blockchainHook, pool := CreateVMAndBlockchainHookAndDataPool(accounts, nil, vmConfig, shardCoordinator, arwenChangeLocker)
-	txProcessor, scProcessor, scForwarder, economicsData, txCostHandler, err := CreateTxProcessorWithOneSCExecutorWithVMs(
+	txProcessor, scProcessor, scForwarder, economicsData, txCostHandler, txSimulator, err := CreateTxProcessorWithOneSCExecutorWithVMs(
 		accounts,
 		vmContainer,
 		blockchainHook,
@@ -1041,6 +1079,9 @@ func CreatePreparedTxProcessorWithVMsWithShardCoordinator(argEnableEpoch ArgEnab
 		argEnableEpoch,
 		arwenChangeLocker,
 		pool,
+		epochNotifier,
+		nil,
+		vmConfig,
 	)
 	if err != nil {
 		return nil, err
@@ -1057,6 +1098,8 @@ func CreatePreparedTxProcessorWithVMsWithShardCoordinator(argEnableEpoch ArgEnab
 		ShardCoordinator: shardCoordinator,
 		EconomicsData:    economicsData,
 		TxCostHandler:    txCostHandler,
+		TxSimulator:      txSimulator,
+		EpochNotifier:    epochNotifier,
 	}, nil
 }
 
@@ -1074,7 +1117,7 @@ func CreateTxProcessorArwenVMWithGasSchedule(
 	vmConfig := createDefaultVMConfig()
 	arwenChangeLocker := &sync.RWMutex{}
 	vmContainer, blockchainHook, pool := CreateVMAndBlockchainHookAndDataPool(accounts, gasSchedule, vmConfig, oneShardCoordinator, arwenChangeLocker)
-	txProcessor, scProcessor, scForwarder, _, _, err := CreateTxProcessorWithOneSCExecutorWithVMs(
+	txProcessor, scProcessor, scForwarder, _, _, _, err := CreateTxProcessorWithOneSCExecutorWithVMs(
 		accounts,
 		vmContainer,
 		blockchainHook,
@@ -1083,6 +1126,9 @@ func CreateTxProcessorArwenVMWithGasSchedule(
 		argEnableEpoch,
 		arwenChangeLocker,
 		pool,
+		forking.NewGenericEpochNotifier(),
+		gasSchedule,
+		vmConfig,
 	)
 	if err != nil {
 		return nil, err
@@ -1110,7 +1156,7 @@ func CreateTxProcessorArwenWithVMConfig(
 	accounts := CreateInMemoryShardAccountsDB()
 	arwenChangeLocker := &sync.RWMutex{}
 	vmContainer, blockchainHook, pool := CreateVMAndBlockchainHookAndDataPool(accounts, gasSchedule, vmConfig, oneShardCoordinator, arwenChangeLocker)
-	txProcessor, scProcessor, scForwarder, _, _, err := CreateTxProcessorWithOneSCExecutorWithVMs(
+	txProcessor, scProcessor, scForwarder, _, _, _, err := CreateTxProcessorWithOneSCExecutorWithVMs(
 		accounts,
 		vmContainer,
 		blockchainHook,
@@ -1119,6 +1165,9 @@ func CreateTxProcessorArwenWithVMConfig(
 		argEnableEpoch,
 		arwenChangeLocker,
 		pool,
+		forking.NewGenericEpochNotifier(),
+		gasSchedule,
+		vmConfig,
 	)
 	if err != nil {
 		return nil, err
@@ -1275,6 +1324,7 @@ func GetVmOutput(gasSchedule map[string]map[string]uint64, accnts state.Accounts
 		BlockChainHook:    blockChainHook,
 		BlockChain:        &mock.BlockChainMock{},
 		ArwenChangeLocker: &sync.RWMutex{},
+		VMTracer:          tracingDisabled.NewDisabledVMTracer(),
 	}
 	scQueryService, _ := smartContract.NewSCQueryService(argsNewSCQueryService)
 
@@ -1312,6 +1362,7 @@ func ComputeGasLimit(gasSchedule map[string]map[string]uint64, testContext *VMTe
 			},
 		},
 		ArwenChangeLocker: &sync.RWMutex{},
+		VMTracer:          tracingDisabled.NewDisabledVMTracer(),
 	}
 	scQueryService, _ := smartContract.NewSCQueryService(argsNewSCQueryService)
 
@@ -1385,16 +1436,16 @@ func CreatePreparedTxProcessorWithVMsMultiShard(selfShardID uint32, argEnableEpo
 	accounts := CreateInMemoryShardAccountsDB()
 
 	arwenChangeLocker := &sync.RWMutex{}
+	vmConfig := createDefaultVMConfig()
 	var vmContainer process.VirtualMachinesContainer
 	var blockchainHook *hooks.BlockChainHookImpl
 	if selfShardID == core.MetachainShardId {
 		vmContainer, blockchainHook = CreateVMAndBlockchainHookMeta(accounts, nil, shardCoordinator, argEnableEpoch)
 	} else {
-		vmConfig := createDefaultVMConfig()
 		vmContainer, blockchainHook, _ = CreateVMAndBlockchainHookAndDataPool(accounts, nil, vmConfig, shardCoordinator, arwenChangeLocker)
 	}
 
-	txProcessor, scProcessor, scrForwarder, economicsData, _, err := CreateTxProcessorWithOneSCExecutorWithVMs(
+	txProcessor, scProcessor, scrForwarder, economicsData, _, _, err := CreateTxProcessorWithOneSCExecutorWithVMs(
 		accounts,
 		vmContainer,
 		blockchainHook,
@@ -1403,6 +1454,9 @@ func CreatePreparedTxProcessorWithVMsMultiShard(selfShardID uint32, argEnableEpo
 		argEnableEpoch,
 		arwenChangeLocker,
 		nil,
+		forking.NewGenericEpochNotifier(),
+		nil,
+		vmConfig,
 	)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,62 @@
+package txsFee
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/integrationTests/vm"
+	"github.com/ElrondNetwork/elrond-go/integrationTests/vm/arwen"
+	"github.com/ElrondNetwork/elrond-go/process/factory"
+	"github.com/ElrondNetwork/elrond-go/process/txsimulator"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBundleSimulator_DeployAndCallContractInTheSameBundle(t *testing.T) {
+	if testing.Short() {
+		t.Skip("cannot run with -race -short; requires Arwen fix")
+	}
+
+	testContext, err := vm.CreatePreparedTxProcessorWithVMs(vm.ArgEnableEpoch{})
+	require.Nil(t, err)
+	defer testContext.Close()
+
+	bundleSimulator, err := txsimulator.NewBundleSimulator(txsimulator.ArgsBundleSimulator{
+		ShardSimulators:      []txsimulator.ShardSimulator{testContext.TxSimulator},
+		ShardCoordinator:     testContext.ShardCoordinator,
+		MaxBundleSize:        10,
+		MaxContinuationSteps: 10,
+	})
+	require.Nil(t, err)
+
+	owner := []byte("12345678901234567890123456789011")
+	_, _ = vm.CreateAccount(testContext.Accounts, owner, 0, big.NewInt(100000))
+	_, err = testContext.Accounts.Commit()
+	require.Nil(t, err)
+
+	gasPrice := uint64(10)
+	scCode := arwen.GetSCCode("../arwen/testdata/counter/output/counter.wasm")
+	deployTx := vm.CreateTransaction(0, big.NewInt(0), owner, vm.CreateEmptyAddress(), gasPrice, 2000, []byte(arwen.CreateDeployTxData(scCode)))
+	scAddress, _ := testContext.BlockchainHook.NewAddress(owner, 0, factory.ArwenVirtualMachine)
+	callTx := vm.CreateTransaction(1, big.NewInt(0), owner, scAddress, gasPrice, 1000, []byte("increment"))
+
+	results, err := bundleSimulator.ProcessBundle([]*transaction.Transaction{deployTx, callTx})
+	require.Nil(t, err)
+	require.Len(t, results.Transactions, 2)
+	require.Equal(t, transaction.TxStatusSuccess, results.Transactions[0].Status, results.Transactions[0].FailReason)
+	require.Equal(t, transaction.TxStatusSuccess, results.Transactions[1].Status, results.Transactions[1].FailReason)
+
+	// the deployed contract initialized the counter to 1, the call incremented it
+	counterKey := make([]byte, 32)
+	copy(counterKey, "mycounter")
+	scChanges := results.AccountsChanges[hex.EncodeToString(scAddress)]
+	require.NotNil(t, scChanges)
+	require.Equal(t, "02", scChanges.StorageWrites[hex.EncodeToString(counterKey)])
+	require.Equal(t, uint64(2), results.AccountsChanges[hex.EncodeToString(owner)].NonceDelta)
+
+	// the simulation does not touch the real state
+	_, err = testContext.Accounts.GetExistingAccount(scAddress)
+	require.Equal(t, state.ErrAccNotFound, err)
+}
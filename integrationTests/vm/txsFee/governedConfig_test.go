@@ -0,0 +1,70 @@
+// +build !race
+
+// TODO remove build condition above to allow -race -short, after Arwen fix
+
+package txsFee
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go/common/governance"
+	"github.com/ElrondNetwork/elrond-go/integrationTests/vm"
+	"github.com/ElrondNetwork/elrond-go/integrationTests/vm/txsFee/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScCallWithTooMuchGasShouldBePenalizedStartingWithTheGovernedEpoch(t *testing.T) {
+	testContext, err := vm.CreatePreparedTxProcessorWithVMs(vm.ArgEnableEpoch{PenalizedTooMuchGasEnableEpoch: 100})
+	require.Nil(t, err)
+	defer testContext.Close()
+
+	scAddress, _ := utils.DoDeployNoChecks(t, testContext, "../arwen/testdata/counter/output/counter.wasm")
+	utils.CleanAccumulatedIntermediateTransactions(t, testContext)
+
+	testContext.EpochNotifier.SetGovernedConfig(&governance.GovernedConfig{
+		Changes: []*governance.GovernedConfigChange{
+			{
+				Name:        governance.GovernedEnableEpochPrefix + governance.PenalizedTooMuchGasEnableEpoch,
+				Value:       "5",
+				EnableEpoch: 5,
+			},
+		},
+	})
+
+	sndAddr := []byte("12345678901234567890123456789112")
+	senderBalance := big.NewInt(1000000)
+	gasPrice := uint64(10)
+	gasLimit := uint64(10000)
+	_, _ = vm.CreateAccount(testContext.Accounts, sndAddr, 0, senderBalance)
+
+	// before the governed epoch, the economics data, the sc processor and the tx processor all refund the unused gas
+	testContext.EpochNotifier.CheckEpoch(&block.Header{Epoch: 4})
+	testContext.CreateBlockStarted()
+
+	tx := vm.CreateTransaction(0, big.NewInt(0), sndAddr, scAddress, gasPrice, gasLimit, []byte("increment"))
+	_, err = testContext.TxProcessor.ProcessTransaction(tx)
+	require.Nil(t, err)
+	require.Nil(t, testContext.GetLatestError())
+	_, err = testContext.Accounts.Commit()
+	require.Nil(t, err)
+
+	expectedBalance := big.NewInt(0).Sub(senderBalance, big.NewInt(3870))
+	vm.TestAccount(t, testContext.Accounts, sndAddr, 1, expectedBalance)
+	require.Equal(t, big.NewInt(3870), testContext.TxFeeHandler.GetAccumulatedFees())
+
+	// starting with the governed epoch, all of them penalize the unused gas
+	testContext.EpochNotifier.CheckEpoch(&block.Header{Epoch: 5})
+	testContext.CreateBlockStarted()
+
+	tx = vm.CreateTransaction(1, big.NewInt(0), sndAddr, scAddress, gasPrice, gasLimit, []byte("increment"))
+	_, err = testContext.TxProcessor.ProcessTransaction(tx)
+	require.Nil(t, err)
+	_, err = testContext.Accounts.Commit()
+	require.Nil(t, err)
+
+	expectedBalance.Sub(expectedBalance, big.NewInt(100000))
+	vm.TestAccount(t, testContext.Accounts, sndAddr, 2, expectedBalance)
+	require.Equal(t, big.NewInt(100000), testContext.TxFeeHandler.GetAccumulatedFees())
+}
@@ -0,0 +1,18 @@
+package keysManagement
+
+import "errors"
+
+// ErrNilKeyGenerator signals that a nil key generator has been provided
+var ErrNilKeyGenerator = errors.New("nil key generator")
+
+// ErrEmptyPrivateKeys signals that no private key has been provided
+var ErrEmptyPrivateKeys = errors.New("empty private keys")
+
+// ErrDuplicatedKey signals that the same key has been provided more than once
+var ErrDuplicatedKey = errors.New("duplicated key")
+
+// ErrMissingPublicKeyDefinition signals that the provided public key is not managed by the current node
+var ErrMissingPublicKeyDefinition = errors.New("missing public key definition")
+
+// ErrPrivateKeyNotAvailable signals that the private key of a managed public key is held by a remote signer
+var ErrPrivateKeyNotAvailable = errors.New("private key not available locally")
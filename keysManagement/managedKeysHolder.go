@@ -0,0 +1,219 @@
+package keysManagement
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-crypto"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+)
+
+var log = logger.GetOrCreate("keysManagement")
+
+var _ consensus.KeysHandler = (*managedKeysHolder)(nil)
+
+// ArgsManagedKeysHolder holds the arguments needed for creating a managed keys holder
+type ArgsManagedKeysHolder struct {
+	KeyGenerator crypto.KeyGenerator
+	PrivateKeys  [][]byte
+	// PublicKeys are the keys whose private keys are held by a remote signer
+	PublicKeys [][]byte
+}
+
+type managedKey struct {
+	privateKey crypto.PrivateKey
+	metrics    consensus.ManagedKeyMetrics
+}
+
+type managedKeysHolder struct {
+	mut           sync.RWMutex
+	keys          map[string]*managedKey
+	sortedPubKeys [][]byte
+}
+
+// NewManagedKeysHolder creates the component holding all the BLS keys a node is able to sign with
+func NewManagedKeysHolder(args ArgsManagedKeysHolder) (*managedKeysHolder, error) {
+	if check.IfNil(args.KeyGenerator) {
+		return nil, ErrNilKeyGenerator
+	}
+	if len(args.PrivateKeys) == 0 && len(args.PublicKeys) == 0 {
+		return nil, ErrEmptyPrivateKeys
+	}
+
+	holder := &managedKeysHolder{
+		keys:          make(map[string]*managedKey),
+		sortedPubKeys: make([][]byte, 0, len(args.PrivateKeys)+len(args.PublicKeys)),
+	}
+
+	for idx, privateKeyBytes := range args.PrivateKeys {
+		err := holder.addKey(args.KeyGenerator, privateKeyBytes)
+		if err != nil {
+			return nil, fmt.Errorf("%w for key at index %d", err, idx)
+		}
+	}
+
+	for idx, pkBytes := range args.PublicKeys {
+		err := holder.addRemoteKey(args.KeyGenerator, pkBytes)
+		if err != nil {
+			return nil, fmt.Errorf("%w for public key at index %d", err, idx)
+		}
+	}
+
+	sort.Slice(holder.sortedPubKeys, func(i, j int) bool {
+		return bytes.Compare(holder.sortedPubKeys[i], holder.sortedPubKeys[j]) < 0
+	})
+
+	log.Debug("managed keys holder created", "num keys", len(holder.keys))
+
+	return holder, nil
+}
+
+func (holder *managedKeysHolder) addKey(keyGenerator crypto.KeyGenerator, privateKeyBytes []byte) error {
+	privateKey, err := keyGenerator.PrivateKeyFromByteArray(privateKeyBytes)
+	if err != nil {
+		return err
+	}
+
+	pkBytes, err := privateKey.GeneratePublic().ToByteArray()
+	if err != nil {
+		return err
+	}
+
+	return holder.storeKey(pkBytes, privateKey)
+}
+
+func (holder *managedKeysHolder) addRemoteKey(keyGenerator crypto.KeyGenerator, pkBytes []byte) error {
+	err := keyGenerator.CheckPublicKeyValid(pkBytes)
+	if err != nil {
+		return err
+	}
+
+	return holder.storeKey(pkBytes, nil)
+}
+
+func (holder *managedKeysHolder) storeKey(pkBytes []byte, privateKey crypto.PrivateKey) error {
+	_, exists := holder.keys[string(pkBytes)]
+	if exists {
+		return fmt.Errorf("%w, public key %s", ErrDuplicatedKey, hex.EncodeToString(pkBytes))
+	}
+
+	holder.keys[string(pkBytes)] = &managedKey{
+		privateKey: privateKey,
+		metrics: consensus.ManagedKeyMetrics{
+			PubKey: hex.EncodeToString(pkBytes),
+		},
+	}
+	holder.sortedPubKeys = append(holder.sortedPubKeys, pkBytes)
+
+	return nil
+}
+
+// GetPrivateKey returns the private key of the provided public key, if managed by the current node
+func (holder *managedKeysHolder) GetPrivateKey(pkBytes []byte) (crypto.PrivateKey, error) {
+	holder.mut.RLock()
+	defer holder.mut.RUnlock()
+
+	key, found := holder.keys[string(pkBytes)]
+	if !found {
+		return nil, fmt.Errorf("%w, public key %s", ErrMissingPublicKeyDefinition, hex.EncodeToString(pkBytes))
+	}
+	if check.IfNil(key.privateKey) {
+		return nil, fmt.Errorf("%w, public key %s", ErrPrivateKeyNotAvailable, hex.EncodeToString(pkBytes))
+	}
+
+	return key.privateKey, nil
+}
+
+// IsKeyManagedByCurrentNode returns true if the current node is able to sign on behalf of the provided public key
+func (holder *managedKeysHolder) IsKeyManagedByCurrentNode(pkBytes []byte) bool {
+	holder.mut.RLock()
+	defer holder.mut.RUnlock()
+
+	_, found := holder.keys[string(pkBytes)]
+
+	return found
+}
+
+// ManagedKeys returns the sorted public keys managed by the current node
+func (holder *managedKeysHolder) ManagedKeys() [][]byte {
+	holder.mut.RLock()
+	defer holder.mut.RUnlock()
+
+	pubKeys := make([][]byte, 0, len(holder.sortedPubKeys))
+	for _, pkBytes := range holder.sortedPubKeys {
+		pubKeys = append(pubKeys, append([]byte(nil), pkBytes...))
+	}
+
+	return pubKeys
+}
+
+// IncrementRoundsInConsensusGroup increments the number of rounds the provided key was part of the consensus group
+func (holder *managedKeysHolder) IncrementRoundsInConsensusGroup(pkBytes []byte) {
+	holder.updateMetrics(pkBytes, func(metrics *consensus.ManagedKeyMetrics) {
+		metrics.RoundsInConsensusGroup++
+	})
+}
+
+// IncrementRoundsAsLeader increments the number of rounds the provided key was selected as leader
+func (holder *managedKeysHolder) IncrementRoundsAsLeader(pkBytes []byte) {
+	holder.updateMetrics(pkBytes, func(metrics *consensus.ManagedKeyMetrics) {
+		metrics.RoundsAsLeader++
+	})
+}
+
+// IncrementBlocksProposed increments the number of blocks proposed and committed by the provided key
+func (holder *managedKeysHolder) IncrementBlocksProposed(pkBytes []byte) {
+	holder.updateMetrics(pkBytes, func(metrics *consensus.ManagedKeyMetrics) {
+		metrics.BlocksProposed++
+	})
+}
+
+// IncrementSignaturesSent increments the number of signature shares created by the provided key
+func (holder *managedKeysHolder) IncrementSignaturesSent(pkBytes []byte) {
+	holder.updateMetrics(pkBytes, func(metrics *consensus.ManagedKeyMetrics) {
+		metrics.SignaturesSent++
+	})
+}
+
+// IncrementHeartbeatsSent increments the number of heartbeat messages sent on behalf of the provided key
+func (holder *managedKeysHolder) IncrementHeartbeatsSent(pkBytes []byte) {
+	holder.updateMetrics(pkBytes, func(metrics *consensus.ManagedKeyMetrics) {
+		metrics.HeartbeatsSent++
+	})
+}
+
+func (holder *managedKeysHolder) updateMetrics(pkBytes []byte, handler func(metrics *consensus.ManagedKeyMetrics)) {
+	holder.mut.Lock()
+	defer holder.mut.Unlock()
+
+	key, found := holder.keys[string(pkBytes)]
+	if !found {
+		return
+	}
+
+	handler(&key.metrics)
+}
+
+// ManagedKeysMetrics returns a copy of the counters of all managed keys, sorted by public key
+func (holder *managedKeysHolder) ManagedKeysMetrics() []*consensus.ManagedKeyMetrics {
+	holder.mut.RLock()
+	defer holder.mut.RUnlock()
+
+	metrics := make([]*consensus.ManagedKeyMetrics, 0, len(holder.sortedPubKeys))
+	for _, pkBytes := range holder.sortedPubKeys {
+		keyMetrics := holder.keys[string(pkBytes)].metrics
+		metrics = append(metrics, &keyMetrics)
+	}
+
+	return metrics
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (holder *managedKeysHolder) IsInterfaceNil() bool {
+	return holder == nil
+}
@@ -0,0 +1,198 @@
+package keysManagement
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/mcl"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func generateKeys(keyGen crypto.KeyGenerator, numKeys int) ([][]byte, [][]byte) {
+	privateKeys := make([][]byte, 0, numKeys)
+	publicKeys := make([][]byte, 0, numKeys)
+	for i := 0; i < numKeys; i++ {
+		sk, pk := keyGen.GeneratePair()
+		skBytes, _ := sk.ToByteArray()
+		pkBytes, _ := pk.ToByteArray()
+
+		privateKeys = append(privateKeys, skBytes)
+		publicKeys = append(publicKeys, pkBytes)
+	}
+
+	return privateKeys, publicKeys
+}
+
+func createMockArgsManagedKeysHolder(numKeys int) (ArgsManagedKeysHolder, [][]byte) {
+	keyGen := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	privateKeys, publicKeys := generateKeys(keyGen, numKeys)
+
+	return ArgsManagedKeysHolder{
+		KeyGenerator: keyGen,
+		PrivateKeys:  privateKeys,
+	}, publicKeys
+}
+
+func TestNewManagedKeysHolder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil key generator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args, _ := createMockArgsManagedKeysHolder(1)
+		args.KeyGenerator = nil
+		holder, err := NewManagedKeysHolder(args)
+		assert.Equal(t, ErrNilKeyGenerator, err)
+		assert.True(t, check.IfNil(holder))
+	})
+	t.Run("empty private keys should error", func(t *testing.T) {
+		t.Parallel()
+
+		args, _ := createMockArgsManagedKeysHolder(0)
+		holder, err := NewManagedKeysHolder(args)
+		assert.Equal(t, ErrEmptyPrivateKeys, err)
+		assert.True(t, check.IfNil(holder))
+	})
+	t.Run("invalid private key should error", func(t *testing.T) {
+		t.Parallel()
+
+		args, _ := createMockArgsManagedKeysHolder(2)
+		args.PrivateKeys[1] = []byte("invalid")
+		holder, err := NewManagedKeysHolder(args)
+		assert.NotNil(t, err)
+		assert.True(t, check.IfNil(holder))
+	})
+	t.Run("duplicated private key should error", func(t *testing.T) {
+		t.Parallel()
+
+		args, _ := createMockArgsManagedKeysHolder(2)
+		args.PrivateKeys = append(args.PrivateKeys, args.PrivateKeys[0])
+		holder, err := NewManagedKeysHolder(args)
+		assert.True(t, errors.Is(err, ErrDuplicatedKey))
+		assert.True(t, check.IfNil(holder))
+	})
+	t.Run("invalid remote public key should error", func(t *testing.T) {
+		t.Parallel()
+
+		args, _ := createMockArgsManagedKeysHolder(1)
+		args.PublicKeys = [][]byte{[]byte("invalid")}
+		holder, err := NewManagedKeysHolder(args)
+		assert.NotNil(t, err)
+		assert.True(t, check.IfNil(holder))
+	})
+	t.Run("remote public key duplicating a private key should error", func(t *testing.T) {
+		t.Parallel()
+
+		args, publicKeys := createMockArgsManagedKeysHolder(1)
+		args.PublicKeys = publicKeys
+		holder, err := NewManagedKeysHolder(args)
+		assert.True(t, errors.Is(err, ErrDuplicatedKey))
+		assert.True(t, check.IfNil(holder))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args, _ := createMockArgsManagedKeysHolder(3)
+		holder, err := NewManagedKeysHolder(args)
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(holder))
+	})
+}
+
+func TestManagedKeysHolder_GetPrivateKey(t *testing.T) {
+	t.Parallel()
+
+	args, publicKeys := createMockArgsManagedKeysHolder(3)
+	holder, _ := NewManagedKeysHolder(args)
+
+	for idx, pkBytes := range publicKeys {
+		assert.True(t, holder.IsKeyManagedByCurrentNode(pkBytes))
+
+		privateKey, err := holder.GetPrivateKey(pkBytes)
+		require.Nil(t, err)
+		skBytes, _ := privateKey.ToByteArray()
+		assert.Equal(t, args.PrivateKeys[idx], skBytes)
+	}
+
+	assert.False(t, holder.IsKeyManagedByCurrentNode([]byte("missing")))
+	privateKey, err := holder.GetPrivateKey([]byte("missing"))
+	assert.True(t, errors.Is(err, ErrMissingPublicKeyDefinition))
+	assert.Nil(t, privateKey)
+}
+
+func TestManagedKeysHolder_RemoteKeys(t *testing.T) {
+	t.Parallel()
+
+	args, publicKeys := createMockArgsManagedKeysHolder(2)
+	args.PrivateKeys = nil
+	args.PublicKeys = publicKeys
+	holder, err := NewManagedKeysHolder(args)
+	require.Nil(t, err)
+
+	assert.ElementsMatch(t, publicKeys, holder.ManagedKeys())
+	for _, pkBytes := range publicKeys {
+		assert.True(t, holder.IsKeyManagedByCurrentNode(pkBytes))
+
+		privateKey, errGet := holder.GetPrivateKey(pkBytes)
+		assert.True(t, errors.Is(errGet, ErrPrivateKeyNotAvailable))
+		assert.Nil(t, privateKey)
+	}
+}
+
+func TestManagedKeysHolder_ManagedKeysShouldBeSorted(t *testing.T) {
+	t.Parallel()
+
+	args, publicKeys := createMockArgsManagedKeysHolder(5)
+	holder, _ := NewManagedKeysHolder(args)
+
+	managedKeys := holder.ManagedKeys()
+	require.Equal(t, len(publicKeys), len(managedKeys))
+	for i := 1; i < len(managedKeys); i++ {
+		assert.True(t, string(managedKeys[i-1]) < string(managedKeys[i]))
+	}
+	assert.ElementsMatch(t, publicKeys, managedKeys)
+
+	managedKeys[0][0]++
+	assert.True(t, holder.IsKeyManagedByCurrentNode(holder.ManagedKeys()[0]))
+}
+
+func TestManagedKeysHolder_Metrics(t *testing.T) {
+	t.Parallel()
+
+	args, publicKeys := createMockArgsManagedKeysHolder(2)
+	holder, _ := NewManagedKeysHolder(args)
+
+	holder.IncrementRoundsInConsensusGroup(publicKeys[0])
+	holder.IncrementRoundsInConsensusGroup(publicKeys[0])
+	holder.IncrementRoundsAsLeader(publicKeys[0])
+	holder.IncrementBlocksProposed(publicKeys[0])
+	holder.IncrementSignaturesSent(publicKeys[1])
+	holder.IncrementHeartbeatsSent(publicKeys[1])
+	holder.IncrementHeartbeatsSent([]byte("not managed"))
+
+	metrics := holder.ManagedKeysMetrics()
+	require.Equal(t, 2, len(metrics))
+	for _, keyMetrics := range metrics {
+		if keyMetrics.PubKey == hex.EncodeToString(publicKeys[0]) {
+			assert.Equal(t, uint64(2), keyMetrics.RoundsInConsensusGroup)
+			assert.Equal(t, uint64(1), keyMetrics.RoundsAsLeader)
+			assert.Equal(t, uint64(1), keyMetrics.BlocksProposed)
+			assert.Equal(t, uint64(0), keyMetrics.SignaturesSent)
+			assert.Equal(t, uint64(0), keyMetrics.HeartbeatsSent)
+			continue
+		}
+
+		assert.Equal(t, hex.EncodeToString(publicKeys[1]), keyMetrics.PubKey)
+		assert.Equal(t, uint64(0), keyMetrics.RoundsInConsensusGroup)
+		assert.Equal(t, uint64(1), keyMetrics.SignaturesSent)
+		assert.Equal(t, uint64(1), keyMetrics.HeartbeatsSent)
+	}
+
+	metrics[0].BlocksProposed = 100
+	assert.NotEqual(t, uint64(100), holder.ManagedKeysMetrics()[0].BlocksProposed)
+}
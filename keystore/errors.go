@@ -0,0 +1,35 @@
+package keystore
+
+import "errors"
+
+// ErrEmptyPassphrase signals that an empty passphrase was provided
+var ErrEmptyPassphrase = errors.New("empty passphrase")
+
+// ErrEmptyPlainText signals that there is nothing to encrypt
+var ErrEmptyPlainText = errors.New("empty plain text")
+
+// ErrUnknownKDF signals that an unknown key derivation function was requested
+var ErrUnknownKDF = errors.New("unknown key derivation function")
+
+// ErrUnknownCipher signals that the keystore was encrypted with an unknown cipher
+var ErrUnknownCipher = errors.New("unknown cipher")
+
+// ErrUnsupportedVersion signals that the keystore version is not supported
+var ErrUnsupportedVersion = errors.New("unsupported keystore version")
+
+// ErrInvalidKeystore signals that the keystore content is malformed
+var ErrInvalidKeystore = errors.New("invalid keystore")
+
+// ErrDecryptionFailed signals that the keystore could not be decrypted, either because the passphrase is wrong or
+// because the keystore was altered
+var ErrDecryptionFailed = errors.New("keystore decryption failed: wrong passphrase or corrupted keystore")
+
+// ErrNilPassphraseProvider signals that a nil passphrase provider was provided
+var ErrNilPassphraseProvider = errors.New("nil passphrase provider")
+
+// ErrNoPassphraseSource signals that the passphrase is not available in any of the configured sources
+var ErrNoPassphraseSource = errors.New("no passphrase source available: set the environment variable, provide a " +
+	"passphrase file or run the process in an interactive terminal")
+
+// ErrPassphrasesMismatch signals that the passphrase and its confirmation are different
+var ErrPassphrasesMismatch = errors.New("passphrases do not match")
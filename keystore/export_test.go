@@ -0,0 +1,6 @@
+package keystore
+
+// SetPrompt -
+func (pp *passphraseProvider) SetPrompt(prompt func(message string) ([]byte, error)) {
+	pp.prompt = prompt
+}
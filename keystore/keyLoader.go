@@ -0,0 +1,136 @@
+package keystore
+
+import (
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+)
+
+var log = logger.GetOrCreate("keystore")
+
+const pemBlockTypePrefix = "PRIVATE KEY for "
+
+// ArgsKeyLoader is the DTO used to create a new key loader
+type ArgsKeyLoader struct {
+	PassphraseProvider PassphraseProvider
+}
+
+type keyLoader struct {
+	passphraseProvider PassphraseProvider
+
+	mutFiles        sync.Mutex
+	decryptedFiles  map[string][]byte
+	plainTextWarned map[string]struct{}
+}
+
+// NewKeyLoader creates a key loader able to read both the encrypted keystores and the plain text PEM files
+func NewKeyLoader(args ArgsKeyLoader) (*keyLoader, error) {
+	if check.IfNil(args.PassphraseProvider) {
+		return nil, ErrNilPassphraseProvider
+	}
+
+	return &keyLoader{
+		passphraseProvider: args.PassphraseProvider,
+		decryptedFiles:     make(map[string][]byte),
+		plainTextWarned:    make(map[string]struct{}),
+	}, nil
+}
+
+// LoadKey loads the key with the given index from the file found at the provided path. A keystore is decrypted only
+// once, as the keys it holds are usually loaded one by one. Plain text PEM files are still supported for backwards
+// compatibility
+func (kl *keyLoader) LoadKey(relativePath string, skIndex int) ([]byte, string, error) {
+	if skIndex < 0 {
+		return nil, "", core.ErrInvalidIndex
+	}
+
+	pemContent, err := kl.getPemContent(relativePath)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return loadSkPkFromPemContent(pemContent, relativePath, skIndex)
+}
+
+func (kl *keyLoader) getPemContent(relativePath string) ([]byte, error) {
+	kl.mutFiles.Lock()
+	defer kl.mutFiles.Unlock()
+
+	pemContent, found := kl.decryptedFiles[relativePath]
+	if found {
+		return pemContent, nil
+	}
+
+	buff, err := ioutil.ReadFile(relativePath)
+	if err != nil {
+		return nil, err
+	}
+	if !IsKeystore(buff) {
+		kl.warnPlainTextFile(relativePath)
+		return buff, nil
+	}
+
+	passphrase, err := kl.passphraseProvider.Passphrase()
+	if err != nil {
+		return nil, err
+	}
+
+	pemContent, err = Decrypt(buff, passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("%w while reading %s file", err, relativePath)
+	}
+
+	kl.decryptedFiles[relativePath] = pemContent
+
+	return pemContent, nil
+}
+
+func (kl *keyLoader) warnPlainTextFile(relativePath string) {
+	_, warned := kl.plainTextWarned[relativePath]
+	if warned {
+		return
+	}
+
+	kl.plainTextWarned[relativePath] = struct{}{}
+	log.Warn("the keys file is not encrypted, consider converting it to a keystore with the keygenerator tool",
+		"file", relativePath)
+}
+
+// loadSkPkFromPemContent mirrors core.LoadSkPkFromPemFile, working on the already read content
+func loadSkPkFromPemContent(buff []byte, relativePath string, skIndex int) ([]byte, string, error) {
+	if len(buff) == 0 {
+		return nil, "", fmt.Errorf("%w while reading %s file", core.ErrEmptyFile, relativePath)
+	}
+
+	var blkRecovered *pem.Block
+	for i := 0; i <= skIndex; i++ {
+		if len(buff) == 0 {
+			return nil, "", fmt.Errorf("%w while reading %s file, invalid index %d", core.ErrInvalidIndex, relativePath, i)
+		}
+
+		blkRecovered, buff = pem.Decode(buff)
+		if blkRecovered == nil {
+			return nil, "", fmt.Errorf("%w while reading %s file, error decoding", core.ErrPemFileIsInvalid, relativePath)
+		}
+	}
+
+	if blkRecovered == nil {
+		return nil, "", core.ErrNilPemBLock
+	}
+	if !strings.HasPrefix(blkRecovered.Type, pemBlockTypePrefix) {
+		return nil, "", fmt.Errorf("%w missing '%s' in block type", core.ErrPemFileIsInvalid, pemBlockTypePrefix)
+	}
+
+	return blkRecovered.Bytes, blkRecovered.Type[len(pemBlockTypePrefix):], nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (kl *keyLoader) IsInterfaceNil() bool {
+	return kl == nil
+}
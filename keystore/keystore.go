@@ -0,0 +1,227 @@
+package keystore
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	// KDFScrypt is the scrypt key derivation function
+	KDFScrypt = "scrypt"
+	// KDFArgon2id is the argon2id key derivation function
+	KDFArgon2id = "argon2id"
+)
+
+const currentVersion = 1
+const aesGcmCipher = "aes-256-gcm"
+const derivedKeyLength = 32
+const saltLength = 32
+
+const (
+	scryptN           = 1 << 15
+	scryptR           = 8
+	scryptP           = 1
+	argon2Time        = 3
+	argon2MemoryInKiB = 64 * 1024
+	argon2Threads     = 4
+)
+
+// KDFParams holds the parameters of the key derivation function, as stored in the keystore
+type KDFParams struct {
+	Salt    string `json:"salt"`
+	KeyLen  uint32 `json:"keyLen"`
+	N       int    `json:"n,omitempty"`
+	R       int    `json:"r,omitempty"`
+	P       int    `json:"p,omitempty"`
+	Time    uint32 `json:"time,omitempty"`
+	Memory  uint32 `json:"memory,omitempty"`
+	Threads uint8  `json:"threads,omitempty"`
+}
+
+// EncryptedKeystore is the serialized form of a password protected keystore. The plain text is the content of the
+// PEM file holding the keys, so a keystore can hold as many keys as the PEM file it was created from
+type EncryptedKeystore struct {
+	Version    uint32    `json:"version"`
+	KDF        string    `json:"kdf"`
+	KDFParams  KDFParams `json:"kdfParams"`
+	Cipher     string    `json:"cipher"`
+	Nonce      string    `json:"nonce"`
+	CipherText string    `json:"cipherText"`
+}
+
+// Encrypt encrypts the plain text with a key derived from the passphrase using the provided key derivation function
+// and returns the serialized keystore
+func Encrypt(plainText []byte, passphrase []byte, kdf string) ([]byte, error) {
+	if len(plainText) == 0 {
+		return nil, ErrEmptyPlainText
+	}
+	if len(passphrase) == 0 {
+		return nil, ErrEmptyPassphrase
+	}
+
+	salt, err := randomBytes(saltLength)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := createDefaultKDFParams(kdf, salt)
+	if err != nil {
+		return nil, err
+	}
+
+	derivedKey, err := deriveKey(kdf, params, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := createAEAD(derivedKey)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce, err := randomBytes(aead.NonceSize())
+	if err != nil {
+		return nil, err
+	}
+
+	ks := &EncryptedKeystore{
+		Version:    currentVersion,
+		KDF:        kdf,
+		KDFParams:  params,
+		Cipher:     aesGcmCipher,
+		Nonce:      hex.EncodeToString(nonce),
+		CipherText: hex.EncodeToString(aead.Seal(nil, nonce, plainText, nil)),
+	}
+
+	return json.MarshalIndent(ks, "", "    ")
+}
+
+// Decrypt decrypts the serialized keystore with the provided passphrase and returns the plain text
+func Decrypt(data []byte, passphrase []byte) ([]byte, error) {
+	if len(passphrase) == 0 {
+		return nil, ErrEmptyPassphrase
+	}
+
+	ks := &EncryptedKeystore{}
+	err := json.Unmarshal(data, ks)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidKeystore, err.Error())
+	}
+	if ks.Version != currentVersion {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, ks.Version)
+	}
+	if ks.Cipher != aesGcmCipher {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownCipher, ks.Cipher)
+	}
+
+	nonce, err := hex.DecodeString(ks.Nonce)
+	if err != nil {
+		return nil, fmt.Errorf("%w for nonce: %s", ErrInvalidKeystore, err.Error())
+	}
+	cipherText, err := hex.DecodeString(ks.CipherText)
+	if err != nil {
+		return nil, fmt.Errorf("%w for cipher text: %s", ErrInvalidKeystore, err.Error())
+	}
+
+	derivedKey, err := deriveKey(ks.KDF, ks.KDFParams, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := createAEAD(derivedKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(nonce) != aead.NonceSize() {
+		return nil, fmt.Errorf("%w: invalid nonce size", ErrInvalidKeystore)
+	}
+
+	plainText, err := aead.Open(nil, nonce, cipherText, nil)
+	if err != nil {
+		return nil, ErrDecryptionFailed
+	}
+
+	return plainText, nil
+}
+
+// IsKeystore returns true if the provided data is a serialized keystore
+func IsKeystore(data []byte) bool {
+	ks := &EncryptedKeystore{}
+	err := json.Unmarshal(data, ks)
+
+	return err == nil && len(ks.CipherText) > 0
+}
+
+func createDefaultKDFParams(kdf string, salt []byte) (KDFParams, error) {
+	params := KDFParams{
+		Salt:   hex.EncodeToString(salt),
+		KeyLen: derivedKeyLength,
+	}
+
+	switch kdf {
+	case KDFScrypt:
+		params.N = scryptN
+		params.R = scryptR
+		params.P = scryptP
+	case KDFArgon2id:
+		params.Time = argon2Time
+		params.Memory = argon2MemoryInKiB
+		params.Threads = argon2Threads
+	default:
+		return KDFParams{}, fmt.Errorf("%w: %s", ErrUnknownKDF, kdf)
+	}
+
+	return params, nil
+}
+
+func deriveKey(kdf string, params KDFParams, passphrase []byte) ([]byte, error) {
+	salt, err := hex.DecodeString(params.Salt)
+	if err != nil {
+		return nil, fmt.Errorf("%w for salt: %s", ErrInvalidKeystore, err.Error())
+	}
+	if len(salt) == 0 {
+		return nil, fmt.Errorf("%w: empty salt", ErrInvalidKeystore)
+	}
+	if params.KeyLen != derivedKeyLength {
+		return nil, fmt.Errorf("%w: invalid derived key length %d", ErrInvalidKeystore, params.KeyLen)
+	}
+
+	switch kdf {
+	case KDFScrypt:
+		return scrypt.Key(passphrase, salt, params.N, params.R, params.P, int(params.KeyLen))
+	case KDFArgon2id:
+		if params.Time == 0 || params.Memory == 0 || params.Threads == 0 {
+			return nil, fmt.Errorf("%w: invalid argon2id parameters", ErrInvalidKeystore)
+		}
+
+		return argon2.IDKey(passphrase, salt, params.Time, params.Memory, params.Threads, params.KeyLen), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownKDF, kdf)
+	}
+}
+
+func createAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+func randomBytes(length int) ([]byte, error) {
+	buff := make([]byte, length)
+	_, err := rand.Read(buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return buff, nil
+}
@@ -0,0 +1,128 @@
+package keystore
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+// EnvPassphraseVariable is the environment variable read for the keystore passphrase
+const EnvPassphraseVariable = "ELROND_KEYSTORE_PASSPHRASE"
+
+const enterPassphraseMessage = "Enter the keystore passphrase: "
+const confirmPassphraseMessage = "Confirm the keystore passphrase: "
+
+var _ PassphraseProvider = (*passphraseProvider)(nil)
+
+// ArgsPassphraseProvider is the DTO used to create a new passphrase provider
+type ArgsPassphraseProvider struct {
+	EnvVariable    string
+	PassphraseFile string
+	// ConfirmPrompt will ask twice for the passphrase when it is read from the prompt, useful when creating keystores
+	ConfirmPrompt bool
+}
+
+type passphraseProvider struct {
+	envVariable    string
+	passphraseFile string
+	confirmPrompt  bool
+	prompt         func(message string) ([]byte, error)
+
+	mutPassphrase sync.Mutex
+	passphrase    []byte
+}
+
+// NewPassphraseProvider creates a passphrase provider that reads the passphrase from the first available source:
+// the environment variable, the passphrase file and lastly an interactive prompt
+func NewPassphraseProvider(args ArgsPassphraseProvider) *passphraseProvider {
+	return &passphraseProvider{
+		envVariable:    args.EnvVariable,
+		passphraseFile: args.PassphraseFile,
+		confirmPrompt:  args.ConfirmPrompt,
+		prompt:         promptFromTerminal,
+	}
+}
+
+// Passphrase returns the passphrase. The passphrase is read only once, so the user is prompted at most once
+func (pp *passphraseProvider) Passphrase() ([]byte, error) {
+	pp.mutPassphrase.Lock()
+	defer pp.mutPassphrase.Unlock()
+
+	if len(pp.passphrase) > 0 {
+		return pp.passphrase, nil
+	}
+
+	passphrase, err := pp.readPassphrase()
+	if err != nil {
+		return nil, err
+	}
+	if len(passphrase) == 0 {
+		return nil, ErrEmptyPassphrase
+	}
+
+	pp.passphrase = passphrase
+
+	return passphrase, nil
+}
+
+func (pp *passphraseProvider) readPassphrase() ([]byte, error) {
+	if len(pp.envVariable) > 0 {
+		value, found := os.LookupEnv(pp.envVariable)
+		if found {
+			return []byte(value), nil
+		}
+	}
+
+	if len(pp.passphraseFile) > 0 {
+		content, err := ioutil.ReadFile(pp.passphraseFile)
+		if err != nil {
+			return nil, fmt.Errorf("%w while reading the passphrase file", err)
+		}
+
+		return bytes.TrimRight(content, "\r\n"), nil
+	}
+
+	return pp.readFromPrompt()
+}
+
+func (pp *passphraseProvider) readFromPrompt() ([]byte, error) {
+	passphrase, err := pp.prompt(enterPassphraseMessage)
+	if err != nil {
+		return nil, err
+	}
+	if !pp.confirmPrompt {
+		return passphrase, nil
+	}
+
+	confirmation, err := pp.prompt(confirmPassphraseMessage)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(passphrase, confirmation) {
+		return nil, ErrPassphrasesMismatch
+	}
+
+	return passphrase, nil
+}
+
+func promptFromTerminal(message string) ([]byte, error) {
+	fd := int(os.Stdin.Fd())
+	if !terminal.IsTerminal(fd) {
+		return nil, ErrNoPassphraseSource
+	}
+
+	_, _ = fmt.Fprint(os.Stderr, message)
+	passphrase, err := terminal.ReadPassword(fd)
+	_, _ = fmt.Fprintln(os.Stderr)
+
+	return passphrase, err
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pp *passphraseProvider) IsInterfaceNil() bool {
+	return pp == nil
+}
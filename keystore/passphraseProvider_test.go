@@ -0,0 +1,150 @@
+package keystore_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/keystore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPassphraseProvider_FromEnvVariable(t *testing.T) {
+	envVariable := "KEYSTORE_TEST_PASSPHRASE_ENV"
+	_ = os.Setenv(envVariable, "env passphrase")
+	defer func() {
+		_ = os.Unsetenv(envVariable)
+	}()
+
+	provider := keystore.NewPassphraseProvider(keystore.ArgsPassphraseProvider{
+		EnvVariable:    envVariable,
+		PassphraseFile: "missing file",
+	})
+	provider.SetPrompt(func(message string) ([]byte, error) {
+		assert.Fail(t, "should have not prompted")
+		return nil, nil
+	})
+
+	passphrase, err := provider.Passphrase()
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("env passphrase"), passphrase)
+}
+
+func TestPassphraseProvider_FromFile(t *testing.T) {
+	t.Parallel()
+
+	dir, _ := ioutil.TempDir("", "keystore")
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	passphraseFile := filepath.Join(dir, "passphrase")
+	_ = ioutil.WriteFile(passphraseFile, []byte("file passphrase\r\n"), 0600)
+
+	provider := keystore.NewPassphraseProvider(keystore.ArgsPassphraseProvider{
+		EnvVariable:    "KEYSTORE_TEST_UNSET_ENV",
+		PassphraseFile: passphraseFile,
+	})
+
+	passphrase, err := provider.Passphrase()
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("file passphrase"), passphrase)
+}
+
+func TestPassphraseProvider_MissingFileShouldErr(t *testing.T) {
+	t.Parallel()
+
+	provider := keystore.NewPassphraseProvider(keystore.ArgsPassphraseProvider{
+		PassphraseFile: "missing file",
+	})
+
+	passphrase, err := provider.Passphrase()
+	assert.Nil(t, passphrase)
+	assert.NotNil(t, err)
+}
+
+func TestPassphraseProvider_EmptyFileShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dir, _ := ioutil.TempDir("", "keystore")
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	passphraseFile := filepath.Join(dir, "passphrase")
+	_ = ioutil.WriteFile(passphraseFile, []byte("\n"), 0600)
+
+	provider := keystore.NewPassphraseProvider(keystore.ArgsPassphraseProvider{
+		PassphraseFile: passphraseFile,
+	})
+
+	passphrase, err := provider.Passphrase()
+	assert.Nil(t, passphrase)
+	assert.Equal(t, keystore.ErrEmptyPassphrase, err)
+}
+
+func TestPassphraseProvider_FromPromptShouldAskOnce(t *testing.T) {
+	t.Parallel()
+
+	numPrompts := 0
+	provider := keystore.NewPassphraseProvider(keystore.ArgsPassphraseProvider{})
+	provider.SetPrompt(func(message string) ([]byte, error) {
+		numPrompts++
+		return []byte("prompt passphrase"), nil
+	})
+
+	passphrase, err := provider.Passphrase()
+	require.Nil(t, err)
+	assert.Equal(t, []byte("prompt passphrase"), passphrase)
+
+	passphrase, err = provider.Passphrase()
+	require.Nil(t, err)
+	assert.Equal(t, []byte("prompt passphrase"), passphrase)
+	assert.Equal(t, 1, numPrompts)
+}
+
+func TestPassphraseProvider_PromptErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	provider := keystore.NewPassphraseProvider(keystore.ArgsPassphraseProvider{})
+	provider.SetPrompt(func(message string) ([]byte, error) {
+		return nil, expectedErr
+	})
+
+	passphrase, err := provider.Passphrase()
+	assert.Nil(t, passphrase)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestPassphraseProvider_ConfirmPrompt(t *testing.T) {
+	t.Parallel()
+
+	t.Run("matching passphrases", func(t *testing.T) {
+		provider := keystore.NewPassphraseProvider(keystore.ArgsPassphraseProvider{ConfirmPrompt: true})
+		numPrompts := 0
+		provider.SetPrompt(func(message string) ([]byte, error) {
+			numPrompts++
+			return []byte("passphrase"), nil
+		})
+
+		passphrase, err := provider.Passphrase()
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("passphrase"), passphrase)
+		assert.Equal(t, 2, numPrompts)
+	})
+	t.Run("mismatching passphrases", func(t *testing.T) {
+		provider := keystore.NewPassphraseProvider(keystore.ArgsPassphraseProvider{ConfirmPrompt: true})
+		responses := [][]byte{[]byte("passphrase"), []byte("other passphrase")}
+		provider.SetPrompt(func(message string) ([]byte, error) {
+			response := responses[0]
+			responses = responses[1:]
+			return response, nil
+		})
+
+		passphrase, err := provider.Passphrase()
+		assert.Nil(t, passphrase)
+		assert.Equal(t, keystore.ErrPassphrasesMismatch, err)
+	})
+}
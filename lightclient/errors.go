@@ -0,0 +1,69 @@
+package lightclient
+
+import "errors"
+
+// ErrNilDataProvider signals that a nil data provider has been provided
+var ErrNilDataProvider = errors.New("nil data provider")
+
+// ErrNilNodesCoordinator signals that a nil nodes coordinator has been provided
+var ErrNilNodesCoordinator = errors.New("nil nodes coordinator")
+
+// ErrNilHeaderSigVerifier signals that a nil header signature verifier has been provided
+var ErrNilHeaderSigVerifier = errors.New("nil header signature verifier")
+
+// ErrNilHeaderIntegrityVerifier signals that a nil header integrity verifier has been provided
+var ErrNilHeaderIntegrityVerifier = errors.New("nil header integrity verifier")
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilShardCoordinator signals that a nil shard coordinator has been provided
+var ErrNilShardCoordinator = errors.New("nil shard coordinator")
+
+// ErrNilPubkeyConverter signals that a nil public key converter has been provided
+var ErrNilPubkeyConverter = errors.New("nil public key converter")
+
+// ErrEmptyObserverURL signals that an empty observer URL has been provided
+var ErrEmptyObserverURL = errors.New("empty observer URL")
+
+// ErrMissingShardObserver signals that no observer is configured for the shard
+var ErrMissingShardObserver = errors.New("missing shard observer")
+
+// ErrObserverRequestFailed signals that an observer answered the request with an error
+var ErrObserverRequestFailed = errors.New("observer request failed")
+
+// ErrNotEpochStartBlock signals that the received block is not an epoch start block
+var ErrNotEpochStartBlock = errors.New("not an epoch start block")
+
+// ErrWrongEpoch signals that the received block does not belong to the expected epoch
+var ErrWrongEpoch = errors.New("wrong epoch")
+
+// ErrWrongNonce signals that the received block does not have the expected nonce
+var ErrWrongNonce = errors.New("wrong nonce")
+
+// ErrEpochStartChainBroken signals that an epoch start block does not link to the previous epoch start block
+var ErrEpochStartChainBroken = errors.New("epoch start block does not link to the previous epoch start block")
+
+// ErrHeaderChainBroken signals that a block does not link to the previous block
+var ErrHeaderChainBroken = errors.New("block does not link to the previous block")
+
+// ErrMiniBlockHashMismatch signals that a received miniblock does not match the hash from the header
+var ErrMiniBlockHashMismatch = errors.New("miniblock hash mismatch")
+
+// ErrHeaderHashMismatch signals that a received header does not match the expected hash
+var ErrHeaderHashMismatch = errors.New("header hash mismatch")
+
+// ErrMissingNodesConfig signals that the validators set of an epoch could not be computed
+var ErrMissingNodesConfig = errors.New("missing validators set for epoch")
+
+// ErrNotSynced signals that the light client did not verify any block yet
+var ErrNotSynced = errors.New("light client not synced")
+
+// ErrUnknownShardState signals that no verified state root hash is known for the shard
+var ErrUnknownShardState = errors.New("unknown state root hash for shard")
+
+// ErrInvalidProof signals that the provided Merkle proof does not match the verified root hash
+var ErrInvalidProof = errors.New("invalid Merkle proof")
@@ -0,0 +1,227 @@
+package lightclient
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+const (
+	metachainStatusPath    = "/network/status"
+	rawEpochStartBlockPath = "/block/raw/epoch-start/%d"
+	rawBlockByNoncePath    = "/block/raw/by-nonce/%d"
+	rawBlockByHashPath     = "/block/raw/by-hash/%s"
+	rawMiniBlockByHashPath = "/block/raw/miniblock/%s/epoch/%d"
+	accountProofPath       = "/proof/root-hash/%s/address/%s"
+	defaultRequestTimeout  = 30 * time.Second
+	maxErrorMessageLength  = 256
+)
+
+type observerResponse struct {
+	Data  json.RawMessage `json:"data"`
+	Error string          `json:"error"`
+	Code  string          `json:"code"`
+}
+
+type rawBlockResponseData struct {
+	Block     []byte `json:"block"`
+	MiniBlock []byte `json:"miniblock"`
+}
+
+type proofResponseData struct {
+	Proof []string `json:"proof"`
+}
+
+type statusResponseData struct {
+	Status map[string]interface{} `json:"status"`
+}
+
+// ArgsHTTPDataProvider holds the arguments needed to create a data provider requesting full nodes over their REST API
+type ArgsHTTPDataProvider struct {
+	MetaObserverURL        string
+	ShardObserversURLs     map[uint32]string
+	AddressPubkeyConverter core.PubkeyConverter
+	RequestTimeout         time.Duration
+}
+
+type httpDataProvider struct {
+	observersURLs          map[uint32]string
+	addressPubkeyConverter core.PubkeyConverter
+	client                 *http.Client
+}
+
+// NewHTTPDataProvider creates a data provider that requests the raw chain data and the Merkle proofs from the REST
+// API of full nodes. A shard observer is needed only for the account queries on that shard
+func NewHTTPDataProvider(args ArgsHTTPDataProvider) (*httpDataProvider, error) {
+	if len(args.MetaObserverURL) == 0 {
+		return nil, fmt.Errorf("%w for the metachain", ErrEmptyObserverURL)
+	}
+	if check.IfNil(args.AddressPubkeyConverter) {
+		return nil, ErrNilPubkeyConverter
+	}
+
+	observersURLs := make(map[uint32]string, len(args.ShardObserversURLs)+1)
+	for shardID, url := range args.ShardObserversURLs {
+		if len(url) == 0 {
+			return nil, fmt.Errorf("%w for shard %d", ErrEmptyObserverURL, shardID)
+		}
+		observersURLs[shardID] = strings.TrimSuffix(url, "/")
+	}
+	observersURLs[core.MetachainShardId] = strings.TrimSuffix(args.MetaObserverURL, "/")
+
+	requestTimeout := args.RequestTimeout
+	if requestTimeout == 0 {
+		requestTimeout = defaultRequestTimeout
+	}
+
+	return &httpDataProvider{
+		observersURLs:          observersURLs,
+		addressPubkeyConverter: args.AddressPubkeyConverter,
+		client:                 &http.Client{Timeout: requestTimeout},
+	}, nil
+}
+
+// GetMetachainStatus returns the nonce and the epoch of the metachain tip, as seen by the metachain observer
+func (hdp *httpDataProvider) GetMetachainStatus() (*NetworkStatus, error) {
+	response := &statusResponseData{}
+	err := hdp.get(core.MetachainShardId, metachainStatusPath, response)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce, ok := response.Status[common.MetricNonce].(float64)
+	if !ok {
+		return nil, fmt.Errorf("%w: missing %s metric", ErrObserverRequestFailed, common.MetricNonce)
+	}
+	epoch, ok := response.Status[common.MetricEpochNumber].(float64)
+	if !ok {
+		return nil, fmt.Errorf("%w: missing %s metric", ErrObserverRequestFailed, common.MetricEpochNumber)
+	}
+
+	return &NetworkStatus{
+		Nonce: uint64(nonce),
+		Epoch: uint32(epoch),
+	}, nil
+}
+
+// GetRawEpochStartMetaBlock returns the marshalized epoch start meta block of the provided epoch
+func (hdp *httpDataProvider) GetRawEpochStartMetaBlock(epoch uint32) ([]byte, error) {
+	response := &rawBlockResponseData{}
+	err := hdp.get(core.MetachainShardId, fmt.Sprintf(rawEpochStartBlockPath, epoch), response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Block, nil
+}
+
+// GetRawMetaBlockByNonce returns the marshalized meta block with the provided nonce
+func (hdp *httpDataProvider) GetRawMetaBlockByNonce(nonce uint64) ([]byte, error) {
+	response := &rawBlockResponseData{}
+	err := hdp.get(core.MetachainShardId, fmt.Sprintf(rawBlockByNoncePath, nonce), response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Block, nil
+}
+
+// GetRawMetaMiniBlock returns the marshalized metachain miniblock with the provided hash, saved in the provided epoch
+func (hdp *httpDataProvider) GetRawMetaMiniBlock(hash []byte, epoch uint32) ([]byte, error) {
+	response := &rawBlockResponseData{}
+	err := hdp.get(core.MetachainShardId, fmt.Sprintf(rawMiniBlockByHashPath, hex.EncodeToString(hash), epoch), response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.MiniBlock, nil
+}
+
+// GetRawShardBlockByHash returns the marshalized shard block with the provided hash
+func (hdp *httpDataProvider) GetRawShardBlockByHash(shardID uint32, hash []byte) ([]byte, error) {
+	response := &rawBlockResponseData{}
+	err := hdp.get(shardID, fmt.Sprintf(rawBlockByHashPath, hex.EncodeToString(hash)), response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Block, nil
+}
+
+// GetAccountProof returns the Merkle proof of the provided address, computed by a full node of the provided shard
+// against the provided state root hash
+func (hdp *httpDataProvider) GetAccountProof(shardID uint32, rootHash []byte, address []byte) ([][]byte, error) {
+	path := fmt.Sprintf(accountProofPath, hex.EncodeToString(rootHash), hdp.addressPubkeyConverter.Encode(address))
+	response := &proofResponseData{}
+	err := hdp.get(shardID, path, response)
+	if err != nil {
+		return nil, err
+	}
+
+	proof := make([][]byte, 0, len(response.Proof))
+	for _, hexNode := range response.Proof {
+		node, errDecode := hex.DecodeString(hexNode)
+		if errDecode != nil {
+			return nil, fmt.Errorf("%w: %v", ErrObserverRequestFailed, errDecode)
+		}
+		proof = append(proof, node)
+	}
+
+	return proof, nil
+}
+
+func (hdp *httpDataProvider) get(shardID uint32, path string, value interface{}) error {
+	observerURL, ok := hdp.observersURLs[shardID]
+	if !ok {
+		return fmt.Errorf("%w %d", ErrMissingShardObserver, shardID)
+	}
+
+	resp, err := hdp.client.Get(observerURL + path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		errClose := resp.Body.Close()
+		if errClose != nil {
+			log.Debug("close response body", "error", errClose.Error())
+		}
+	}()
+
+	responseBytes, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	response := &observerResponse{}
+	err = json.Unmarshal(responseBytes, response)
+	if err != nil {
+		return fmt.Errorf("%w: %s returned status %d", ErrObserverRequestFailed, path, resp.StatusCode)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%w: %s returned status %d: %s",
+			ErrObserverRequestFailed, path, resp.StatusCode, truncate(response.Error))
+	}
+
+	return json.Unmarshal(response.Data, value)
+}
+
+func truncate(message string) string {
+	if len(message) <= maxErrorMessageLength {
+		return message
+	}
+
+	return message[:maxErrorMessageLength]
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hdp *httpDataProvider) IsInterfaceNil() bool {
+	return hdp == nil
+}
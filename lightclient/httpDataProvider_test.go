@@ -0,0 +1,145 @@
+package lightclient_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/lightclient"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func startObserver(t *testing.T, responses map[string]interface{}) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, ok := responses[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusInternalServerError)
+			_ = json.NewEncoder(w).Encode(shared.GenericAPIResponse{
+				Error: "not found",
+				Code:  shared.ReturnCodeInternalError,
+			})
+			return
+		}
+
+		err := json.NewEncoder(w).Encode(shared.GenericAPIResponse{
+			Data: data,
+			Code: shared.ReturnCodeSuccess,
+		})
+		require.Nil(t, err)
+	}))
+}
+
+func createMockHTTPDataProviderArgs(metaURL string, shardURL string) lightclient.ArgsHTTPDataProvider {
+	return lightclient.ArgsHTTPDataProvider{
+		MetaObserverURL:        metaURL,
+		ShardObserversURLs:     map[uint32]string{0: shardURL},
+		AddressPubkeyConverter: testscommon.NewPubkeyConverterMock(4),
+	}
+}
+
+func TestNewHTTPDataProvider(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty meta observer should err", func(t *testing.T) {
+		hdp, err := lightclient.NewHTTPDataProvider(createMockHTTPDataProviderArgs("", "http://shard"))
+		assert.Nil(t, hdp)
+		assert.True(t, errors.Is(err, lightclient.ErrEmptyObserverURL))
+	})
+	t.Run("empty shard observer should err", func(t *testing.T) {
+		hdp, err := lightclient.NewHTTPDataProvider(createMockHTTPDataProviderArgs("http://meta", ""))
+		assert.Nil(t, hdp)
+		assert.True(t, errors.Is(err, lightclient.ErrEmptyObserverURL))
+	})
+	t.Run("nil pubkey converter should err", func(t *testing.T) {
+		args := createMockHTTPDataProviderArgs("http://meta", "http://shard")
+		args.AddressPubkeyConverter = nil
+		hdp, err := lightclient.NewHTTPDataProvider(args)
+		assert.Nil(t, hdp)
+		assert.Equal(t, lightclient.ErrNilPubkeyConverter, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		hdp, err := lightclient.NewHTTPDataProvider(createMockHTTPDataProviderArgs("http://meta", "http://shard"))
+		assert.Nil(t, err)
+		assert.False(t, hdp.IsInterfaceNil())
+	})
+}
+
+func TestHTTPDataProvider_Requests(t *testing.T) {
+	t.Parallel()
+
+	rawBlock := []byte("raw block")
+	rawMiniBlock := []byte("raw miniblock")
+	proof := [][]byte{[]byte("node 1"), []byte("node 2")}
+	meta := startObserver(t, map[string]interface{}{
+		"/network/status": map[string]interface{}{
+			"status": map[string]interface{}{
+				"erd_nonce":        37,
+				"erd_epoch_number": 3,
+			},
+		},
+		"/block/raw/epoch-start/3":               map[string]interface{}{"block": rawBlock},
+		"/block/raw/by-nonce/37":                 map[string]interface{}{"block": rawBlock},
+		"/block/raw/miniblock/aabb/epoch/3":      map[string]interface{}{"miniblock": rawMiniBlock},
+		"/proof/root-hash/0102/address/61646472": map[string]interface{}{"proof": []string{hex.EncodeToString(proof[0])}},
+	})
+	defer meta.Close()
+	shard := startObserver(t, map[string]interface{}{
+		"/block/raw/by-hash/ccdd": map[string]interface{}{"block": rawBlock},
+		"/proof/root-hash/0102/address/61646472": map[string]interface{}{
+			"proof": []string{hex.EncodeToString(proof[0]), hex.EncodeToString(proof[1])},
+		},
+		"/proof/root-hash/0506/address/61646472": map[string]interface{}{"proof": []string{"not hex"}},
+	})
+	defer shard.Close()
+
+	hdp, err := lightclient.NewHTTPDataProvider(createMockHTTPDataProviderArgs(meta.URL, shard.URL+"/"))
+	require.Nil(t, err)
+
+	status, err := hdp.GetMetachainStatus()
+	require.Nil(t, err)
+	assert.Equal(t, &lightclient.NetworkStatus{Nonce: 37, Epoch: 3}, status)
+
+	buff, err := hdp.GetRawEpochStartMetaBlock(3)
+	require.Nil(t, err)
+	assert.Equal(t, rawBlock, buff)
+
+	buff, err = hdp.GetRawMetaBlockByNonce(37)
+	require.Nil(t, err)
+	assert.Equal(t, rawBlock, buff)
+
+	buff, err = hdp.GetRawMetaMiniBlock([]byte{0xaa, 0xbb}, 3)
+	require.Nil(t, err)
+	assert.Equal(t, rawMiniBlock, buff)
+
+	buff, err = hdp.GetRawShardBlockByHash(0, []byte{0xcc, 0xdd})
+	require.Nil(t, err)
+	assert.Equal(t, rawBlock, buff)
+
+	receivedProof, err := hdp.GetAccountProof(0, []byte{1, 2}, []byte("addr"))
+	require.Nil(t, err)
+	assert.Equal(t, proof, receivedProof)
+
+	receivedProof, err = hdp.GetAccountProof(core.MetachainShardId, []byte{1, 2}, []byte("addr"))
+	require.Nil(t, err)
+	assert.Equal(t, proof[:1], receivedProof)
+
+	receivedProof, err = hdp.GetAccountProof(0, []byte{5, 6}, []byte("addr"))
+	assert.Nil(t, receivedProof)
+	assert.True(t, errors.Is(err, lightclient.ErrObserverRequestFailed))
+
+	buff, err = hdp.GetRawEpochStartMetaBlock(4)
+	assert.Nil(t, buff)
+	assert.True(t, errors.Is(err, lightclient.ErrObserverRequestFailed))
+	assert.Contains(t, err.Error(), "not found")
+
+	buff, err = hdp.GetRawShardBlockByHash(1, []byte{0xcc, 0xdd})
+	assert.Nil(t, buff)
+	assert.True(t, errors.Is(err, lightclient.ErrMissingShardObserver))
+}
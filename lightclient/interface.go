@@ -0,0 +1,31 @@
+package lightclient
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/data"
+)
+
+// NetworkStatus holds the, not yet verified, tip of the metachain as advertised by a full node
+type NetworkStatus struct {
+	Nonce uint64
+	Epoch uint32
+}
+
+// DataProvider defines the operations of a component able to fetch raw chain data and Merkle proofs from full nodes.
+// None of the returned data is trusted, everything is verified by the light client
+type DataProvider interface {
+	GetMetachainStatus() (*NetworkStatus, error)
+	GetRawEpochStartMetaBlock(epoch uint32) ([]byte, error)
+	GetRawMetaBlockByNonce(nonce uint64) ([]byte, error)
+	GetRawMetaMiniBlock(hash []byte, epoch uint32) ([]byte, error)
+	GetRawShardBlockByHash(shardID uint32, hash []byte) ([]byte, error)
+	GetAccountProof(shardID uint32, rootHash []byte, address []byte) ([][]byte, error)
+	IsInterfaceNil() bool
+}
+
+// NodesCoordinator defines the operations of the nodes coordinator needed to follow the validators set changes
+type NodesCoordinator interface {
+	EpochStartPrepare(metaHdr data.HeaderHandler, body data.BodyHandler)
+	EpochStartAction(hdr data.HeaderHandler)
+	ShardIdForEpoch(epoch uint32) (uint32, error)
+	IsInterfaceNil() bool
+}
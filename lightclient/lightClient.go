@@ -0,0 +1,486 @@
+package lightclient
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/state"
+)
+
+var log = logger.GetOrCreate("lightclient")
+
+// ArgsLightClient holds the arguments needed to create a light client
+type ArgsLightClient struct {
+	DataProvider            DataProvider
+	NodesCoordinator        NodesCoordinator
+	HeaderSigVerifier       process.InterceptedHeaderSigVerifier
+	HeaderIntegrityVerifier process.HeaderIntegrityVerifier
+	ShardCoordinator        sharding.Coordinator
+	Marshalizer             marshal.Marshalizer
+	Hasher                  hashing.Hasher
+}
+
+// NotarizedShardHeader holds the latest shard header notarized by a verified metachain block
+type NotarizedShardHeader struct {
+	ShardID    uint32
+	Nonce      uint64
+	HeaderHash []byte
+	RootHash   []byte
+}
+
+// Status holds the state verified so far by the light client
+type Status struct {
+	Epoch                  uint32
+	Nonce                  uint64
+	Round                  uint64
+	HeaderHash             []byte
+	RootHash               []byte
+	LastEpochStartHash     []byte
+	NotarizedShardsHeaders []NotarizedShardHeader
+}
+
+type lightClient struct {
+	dataProvider            DataProvider
+	nodesCoordinator        NodesCoordinator
+	headerSigVerifier       process.InterceptedHeaderSigVerifier
+	headerIntegrityVerifier process.HeaderIntegrityVerifier
+	shardCoordinator        sharding.Coordinator
+	marshalizer             marshal.Marshalizer
+	hasher                  hashing.Hasher
+
+	mutSync            sync.Mutex
+	mutState           sync.RWMutex
+	currentEpoch       uint32
+	lastEpochStartHash []byte
+	tip                *block.MetaBlock
+	tipHash            []byte
+	notarizedShards    map[uint32]*NotarizedShardHeader
+}
+
+// NewLightClient creates a light client that follows only the metachain headers and the validators set changes
+// announced in the epoch start blocks. Every received header is checked against the aggregated signature of its
+// consensus group, so the full nodes the data is requested from do not need to be trusted
+func NewLightClient(args ArgsLightClient) (*lightClient, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lightClient{
+		dataProvider:            args.DataProvider,
+		nodesCoordinator:        args.NodesCoordinator,
+		headerSigVerifier:       args.HeaderSigVerifier,
+		headerIntegrityVerifier: args.HeaderIntegrityVerifier,
+		shardCoordinator:        args.ShardCoordinator,
+		marshalizer:             args.Marshalizer,
+		hasher:                  args.Hasher,
+		notarizedShards:         make(map[uint32]*NotarizedShardHeader),
+	}, nil
+}
+
+func checkArgs(args ArgsLightClient) error {
+	if check.IfNil(args.DataProvider) {
+		return ErrNilDataProvider
+	}
+	if check.IfNil(args.NodesCoordinator) {
+		return ErrNilNodesCoordinator
+	}
+	if check.IfNil(args.HeaderSigVerifier) {
+		return ErrNilHeaderSigVerifier
+	}
+	if check.IfNil(args.HeaderIntegrityVerifier) {
+		return ErrNilHeaderIntegrityVerifier
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return ErrNilShardCoordinator
+	}
+	if check.IfNil(args.Marshalizer) {
+		return ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return ErrNilHasher
+	}
+
+	return nil
+}
+
+// Sync verifies all the epoch start blocks up to the current epoch of the metachain and then moves the verified tip
+// to the latest final metachain block. A block is considered final once a signed block built on top of it is received
+func (lc *lightClient) Sync() error {
+	lc.mutSync.Lock()
+	defer lc.mutSync.Unlock()
+
+	status, err := lc.dataProvider.GetMetachainStatus()
+	if err != nil {
+		return err
+	}
+
+	err = lc.syncEpochStartBlocksUntil(status.Epoch)
+	if err != nil {
+		return err
+	}
+
+	return lc.syncTip(status.Nonce)
+}
+
+func (lc *lightClient) syncEpochStartBlocksUntil(epoch uint32) error {
+	for lc.getCurrentEpoch() < epoch {
+		err := lc.syncNextEpochStartBlock()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (lc *lightClient) syncNextEpochStartBlock() error {
+	lc.mutState.RLock()
+	epoch := lc.currentEpoch + 1
+	lastEpochStartHash := lc.lastEpochStartHash
+	lc.mutState.RUnlock()
+
+	rawMetaBlock, err := lc.dataProvider.GetRawEpochStartMetaBlock(epoch)
+	if err != nil {
+		return err
+	}
+	metaBlock, metaBlockHash, err := lc.unmarshalMetaBlock(rawMetaBlock)
+	if err != nil {
+		return err
+	}
+
+	if metaBlock.Epoch != epoch {
+		return fmt.Errorf("%w: expected %d, got %d", ErrWrongEpoch, epoch, metaBlock.Epoch)
+	}
+	if !metaBlock.IsStartOfEpochBlock() {
+		return fmt.Errorf("%w for epoch %d", ErrNotEpochStartBlock, epoch)
+	}
+	// the first epoch start block links to the genesis block, which is implied by the genesis validators set
+	isLinkedToPrevious := len(lastEpochStartHash) == 0 ||
+		bytes.Equal(metaBlock.EpochStart.Economics.PrevEpochStartHash, lastEpochStartHash)
+	if !isLinkedToPrevious {
+		return fmt.Errorf("%w for epoch %d", ErrEpochStartChainBroken, epoch)
+	}
+
+	err = lc.verifyMetaBlock(metaBlock)
+	if err != nil {
+		return fmt.Errorf("%w for epoch start block of epoch %d", err, epoch)
+	}
+
+	body, err := lc.getValidatorsInfoBody(metaBlock)
+	if err != nil {
+		return err
+	}
+
+	lc.nodesCoordinator.EpochStartPrepare(metaBlock, body)
+	_, err = lc.nodesCoordinator.ShardIdForEpoch(epoch)
+	if err != nil {
+		return fmt.Errorf("%w %d: %v", ErrMissingNodesConfig, epoch, err)
+	}
+	lc.nodesCoordinator.EpochStartAction(metaBlock)
+
+	lc.mutState.Lock()
+	lc.currentEpoch = epoch
+	lc.lastEpochStartHash = metaBlockHash
+	lc.setTipIfNewer(metaBlock, metaBlockHash)
+	for _, shardData := range metaBlock.EpochStart.LastFinalizedHeaders {
+		lc.setNotarizedShardHeaderIfNewer(&NotarizedShardHeader{
+			ShardID:    shardData.ShardID,
+			Nonce:      shardData.Nonce,
+			HeaderHash: shardData.HeaderHash,
+			RootHash:   shardData.RootHash,
+		})
+	}
+	lc.mutState.Unlock()
+
+	log.Info("verified epoch start block",
+		"epoch", epoch,
+		"nonce", metaBlock.Nonce,
+		"hash", metaBlockHash,
+	)
+
+	return nil
+}
+
+func (lc *lightClient) getValidatorsInfoBody(metaBlock *block.MetaBlock) (*block.Body, error) {
+	body := &block.Body{}
+	for _, miniBlockHeader := range metaBlock.MiniBlockHeaders {
+		if miniBlockHeader.Type != block.PeerBlock {
+			continue
+		}
+
+		rawMiniBlock, err := lc.dataProvider.GetRawMetaMiniBlock(miniBlockHeader.Hash, metaBlock.Epoch)
+		if err != nil {
+			return nil, err
+		}
+
+		miniBlock := &block.MiniBlock{}
+		err = lc.marshalizer.Unmarshal(miniBlock, rawMiniBlock)
+		if err != nil {
+			return nil, err
+		}
+
+		miniBlockHash, err := core.CalculateHash(lc.marshalizer, lc.hasher, miniBlock)
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(miniBlockHash, miniBlockHeader.Hash) {
+			return nil, fmt.Errorf("%w: expected %x, got %x", ErrMiniBlockHashMismatch, miniBlockHeader.Hash, miniBlockHash)
+		}
+
+		body.MiniBlocks = append(body.MiniBlocks, miniBlock)
+	}
+
+	return body, nil
+}
+
+func (lc *lightClient) syncTip(nonce uint64) error {
+	if nonce == 0 {
+		return nil
+	}
+
+	candidateNonce := nonce - 1
+	lc.mutState.RLock()
+	isAlreadyVerified := lc.tip != nil && lc.tip.Nonce >= candidateNonce
+	lc.mutState.RUnlock()
+	if isAlreadyVerified {
+		return nil
+	}
+
+	candidate, candidateHash, err := lc.getMetaBlockByNonce(candidateNonce)
+	if err != nil {
+		return err
+	}
+	confirmation, _, err := lc.getMetaBlockByNonce(nonce)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(confirmation.PrevHash, candidateHash) {
+		return fmt.Errorf("%w: nonce %d", ErrHeaderChainBroken, nonce)
+	}
+
+	// the candidate or its confirmation might open an epoch not yet announced by the observer status
+	err = lc.syncEpochStartBlocksUntil(confirmation.Epoch)
+	if err != nil {
+		return err
+	}
+
+	err = lc.verifyRecentMetaBlock(candidate)
+	if err != nil {
+		return err
+	}
+	err = lc.verifyRecentMetaBlock(confirmation)
+	if err != nil {
+		return err
+	}
+
+	lc.mutState.Lock()
+	lc.setTipIfNewer(candidate, candidateHash)
+	for _, shardData := range candidate.ShardInfo {
+		lc.setNotarizedShardHeaderIfNewer(&NotarizedShardHeader{
+			ShardID:    shardData.ShardID,
+			Nonce:      shardData.Nonce,
+			HeaderHash: shardData.HeaderHash,
+		})
+	}
+	lc.mutState.Unlock()
+
+	log.Debug("verified metachain block",
+		"epoch", candidate.Epoch,
+		"nonce", candidate.Nonce,
+		"hash", candidateHash,
+	)
+
+	return nil
+}
+
+func (lc *lightClient) getMetaBlockByNonce(nonce uint64) (*block.MetaBlock, []byte, error) {
+	rawMetaBlock, err := lc.dataProvider.GetRawMetaBlockByNonce(nonce)
+	if err != nil {
+		return nil, nil, err
+	}
+	metaBlock, metaBlockHash, err := lc.unmarshalMetaBlock(rawMetaBlock)
+	if err != nil {
+		return nil, nil, err
+	}
+	if metaBlock.Nonce != nonce {
+		return nil, nil, fmt.Errorf("%w: expected %d, got %d", ErrWrongNonce, nonce, metaBlock.Nonce)
+	}
+
+	return metaBlock, metaBlockHash, nil
+}
+
+// verifyRecentMetaBlock accepts only blocks of the current or of the previous epoch, the previous one being needed
+// when the verified block is followed by the start of a new epoch
+func (lc *lightClient) verifyRecentMetaBlock(metaBlock *block.MetaBlock) error {
+	currentEpoch := lc.getCurrentEpoch()
+	isRecentEpoch := metaBlock.Epoch <= currentEpoch && metaBlock.Epoch+1 >= currentEpoch
+	if !isRecentEpoch {
+		return fmt.Errorf("%w: current epoch %d, got %d for nonce %d", ErrWrongEpoch, currentEpoch, metaBlock.Epoch, metaBlock.Nonce)
+	}
+
+	err := lc.verifyMetaBlock(metaBlock)
+	if err != nil {
+		return fmt.Errorf("%w for nonce %d", err, metaBlock.Nonce)
+	}
+
+	return nil
+}
+
+func (lc *lightClient) verifyMetaBlock(metaBlock *block.MetaBlock) error {
+	err := lc.headerIntegrityVerifier.Verify(metaBlock)
+	if err != nil {
+		return err
+	}
+
+	err = lc.headerSigVerifier.VerifyRandSeedAndLeaderSignature(metaBlock)
+	if err != nil {
+		return err
+	}
+
+	return lc.headerSigVerifier.VerifySignature(metaBlock)
+}
+
+func (lc *lightClient) unmarshalMetaBlock(rawMetaBlock []byte) (*block.MetaBlock, []byte, error) {
+	metaBlock := &block.MetaBlock{}
+	err := lc.marshalizer.Unmarshal(metaBlock, rawMetaBlock)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// the hash is computed over the canonical form so a re-encoded block will not link with its neighbours
+	metaBlockHash, err := core.CalculateHash(lc.marshalizer, lc.hasher, metaBlock)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return metaBlock, metaBlockHash, nil
+}
+
+func (lc *lightClient) setTipIfNewer(metaBlock *block.MetaBlock, metaBlockHash []byte) {
+	if lc.tip != nil && lc.tip.Nonce >= metaBlock.Nonce {
+		return
+	}
+
+	lc.tip = metaBlock
+	lc.tipHash = metaBlockHash
+}
+
+func (lc *lightClient) setNotarizedShardHeaderIfNewer(notarized *NotarizedShardHeader) {
+	existing, ok := lc.notarizedShards[notarized.ShardID]
+	if ok && existing.Nonce >= notarized.Nonce {
+		return
+	}
+
+	lc.notarizedShards[notarized.ShardID] = notarized
+}
+
+func (lc *lightClient) getCurrentEpoch() uint32 {
+	lc.mutState.RLock()
+	defer lc.mutState.RUnlock()
+
+	return lc.currentEpoch
+}
+
+// GetAccount returns the account with the provided address from the latest verified state of its shard. The account
+// is read from a Merkle proof requested from a full node and checked against the verified state root hash. A nil
+// account and a nil error are returned if the proof shows the account does not exist
+func (lc *lightClient) GetAccount(address []byte) (state.UserAccountHandler, error) {
+	shardID := lc.shardCoordinator.ComputeId(address)
+	rootHash, err := lc.getStateRootHash(shardID)
+	if err != nil {
+		return nil, err
+	}
+
+	proof, err := lc.dataProvider.GetAccountProof(shardID, rootHash, address)
+	if err != nil {
+		return nil, err
+	}
+
+	return getAccountFromProof(proof, rootHash, address, lc.marshalizer, lc.hasher)
+}
+
+func (lc *lightClient) getStateRootHash(shardID uint32) ([]byte, error) {
+	lc.mutState.RLock()
+	tip := lc.tip
+	notarized, ok := lc.notarizedShards[shardID]
+	lc.mutState.RUnlock()
+
+	if tip == nil {
+		return nil, ErrNotSynced
+	}
+	if shardID == core.MetachainShardId {
+		return tip.RootHash, nil
+	}
+	if !ok {
+		return nil, fmt.Errorf("%w %d", ErrUnknownShardState, shardID)
+	}
+	if len(notarized.RootHash) > 0 {
+		return notarized.RootHash, nil
+	}
+
+	rawHeader, err := lc.dataProvider.GetRawShardBlockByHash(shardID, notarized.HeaderHash)
+	if err != nil {
+		return nil, err
+	}
+
+	header := &block.Header{}
+	err = lc.marshalizer.Unmarshal(header, rawHeader)
+	if err != nil {
+		return nil, err
+	}
+	headerHash, err := core.CalculateHash(lc.marshalizer, lc.hasher, header)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(headerHash, notarized.HeaderHash) {
+		return nil, fmt.Errorf("%w: shard %d, expected %x, got %x", ErrHeaderHashMismatch, shardID, notarized.HeaderHash, headerHash)
+	}
+
+	lc.mutState.Lock()
+	// the notarized entry is replaced, not altered, when a newer shard header is notarized
+	notarized.RootHash = header.RootHash
+	lc.mutState.Unlock()
+
+	return header.RootHash, nil
+}
+
+// Status returns the state verified so far
+func (lc *lightClient) Status() Status {
+	lc.mutState.RLock()
+	defer lc.mutState.RUnlock()
+
+	status := Status{
+		Epoch:                  lc.currentEpoch,
+		HeaderHash:             lc.tipHash,
+		LastEpochStartHash:     lc.lastEpochStartHash,
+		NotarizedShardsHeaders: make([]NotarizedShardHeader, 0, len(lc.notarizedShards)),
+	}
+	if lc.tip != nil {
+		status.Nonce = lc.tip.Nonce
+		status.Round = lc.tip.Round
+		status.RootHash = lc.tip.RootHash
+	}
+	for shardID := uint32(0); shardID < lc.shardCoordinator.NumberOfShards(); shardID++ {
+		notarized, ok := lc.notarizedShards[shardID]
+		if ok {
+			status.NotarizedShardsHeaders = append(status.NotarizedShardsHeaders, *notarized)
+		}
+	}
+
+	return status
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (lc *lightClient) IsInterfaceNil() bool {
+	return lc == nil
+}
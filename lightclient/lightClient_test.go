@@ -0,0 +1,556 @@
+package lightclient_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go/lightclient"
+	"github.com/ElrondNetwork/elrond-go/lightclient/mock"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testMarshalizer = &testscommon.ProtobufMarshalizerMock{}
+	testHasher      = &testscommon.KeccakMock{}
+)
+
+type testChain struct {
+	metaBlocks      map[uint64][]byte
+	epochStartMetas map[uint32][]byte
+	miniBlocks      map[string][]byte
+	shardBlocks     map[string][]byte
+	status          *lightclient.NetworkStatus
+}
+
+func marshal(t *testing.T, obj interface{}) []byte {
+	buff, err := testMarshalizer.Marshal(obj)
+	require.Nil(t, err)
+
+	return buff
+}
+
+func hash(t *testing.T, obj interface{}) []byte {
+	h, err := core.CalculateHash(testMarshalizer, testHasher, obj)
+	require.Nil(t, err)
+
+	return h
+}
+
+// createTestChain creates the epoch start block of epoch 1 followed by two regular blocks of the same epoch
+func createTestChain(t *testing.T) *testChain {
+	miniBlock := &block.MiniBlock{
+		TxHashes: [][]byte{[]byte("validator info")},
+		Type:     block.PeerBlock,
+	}
+	epochStart := &block.MetaBlock{
+		Nonce:    10,
+		Round:    10,
+		Epoch:    1,
+		RootHash: []byte("meta root hash 10"),
+		MiniBlockHeaders: []block.MiniBlockHeader{
+			{Hash: hash(t, miniBlock), Type: block.PeerBlock},
+			{Hash: []byte("rewards miniblock"), Type: block.RewardsBlock},
+		},
+		EpochStart: block.EpochStart{
+			LastFinalizedHeaders: []block.EpochStartShardData{
+				{ShardID: 0, Nonce: 5, HeaderHash: []byte("shard 0 hash"), RootHash: []byte("shard 0 root hash")},
+			},
+			Economics: block.Economics{
+				TotalSupply:                      big.NewInt(0),
+				TotalToDistribute:                big.NewInt(0),
+				TotalNewlyMinted:                 big.NewInt(0),
+				RewardsPerBlock:                  big.NewInt(0),
+				NodePrice:                        big.NewInt(0),
+				PrevEpochStartHash:               []byte("genesis hash"),
+				RewardsForProtocolSustainability: big.NewInt(0),
+			},
+		},
+		AccumulatedFees:        big.NewInt(0),
+		DeveloperFees:          big.NewInt(0),
+		AccumulatedFeesInEpoch: big.NewInt(0),
+		DevFeesInEpoch:         big.NewInt(0),
+	}
+	block11 := createMetaBlock(11, 1, hash(t, epochStart))
+	block12 := createMetaBlock(12, 1, hash(t, block11))
+
+	return &testChain{
+		metaBlocks: map[uint64][]byte{
+			10: marshal(t, epochStart),
+			11: marshal(t, block11),
+			12: marshal(t, block12),
+		},
+		epochStartMetas: map[uint32][]byte{
+			1: marshal(t, epochStart),
+		},
+		miniBlocks: map[string][]byte{
+			string(hash(t, miniBlock)): marshal(t, miniBlock),
+		},
+		shardBlocks: make(map[string][]byte),
+		status:      &lightclient.NetworkStatus{Nonce: 12, Epoch: 1},
+	}
+}
+
+func createMetaBlock(nonce uint64, epoch uint32, prevHash []byte) *block.MetaBlock {
+	return &block.MetaBlock{
+		Nonce:                  nonce,
+		Round:                  nonce,
+		Epoch:                  epoch,
+		PrevHash:               prevHash,
+		RootHash:               []byte("meta root hash"),
+		AccumulatedFees:        big.NewInt(0),
+		DeveloperFees:          big.NewInt(0),
+		AccumulatedFeesInEpoch: big.NewInt(0),
+		DevFeesInEpoch:         big.NewInt(0),
+	}
+}
+
+func (tc *testChain) dataProvider() *mock.DataProviderStub {
+	return &mock.DataProviderStub{
+		GetMetachainStatusCalled: func() (*lightclient.NetworkStatus, error) {
+			return tc.status, nil
+		},
+		GetRawEpochStartMetaBlockCalled: func(epoch uint32) ([]byte, error) {
+			return get(tc.epochStartMetas[epoch])
+		},
+		GetRawMetaBlockByNonceCalled: func(nonce uint64) ([]byte, error) {
+			return get(tc.metaBlocks[nonce])
+		},
+		GetRawMetaMiniBlockCalled: func(hash []byte, _ uint32) ([]byte, error) {
+			return get(tc.miniBlocks[string(hash)])
+		},
+		GetRawShardBlockByHashCalled: func(_ uint32, hash []byte) ([]byte, error) {
+			return get(tc.shardBlocks[string(hash)])
+		},
+	}
+}
+
+var errNotFound = errors.New("not found")
+
+func get(buff []byte) ([]byte, error) {
+	if len(buff) == 0 {
+		return nil, errNotFound
+	}
+
+	return buff, nil
+}
+
+func createMockArgs(chain *testChain) lightclient.ArgsLightClient {
+	return lightclient.ArgsLightClient{
+		DataProvider:            chain.dataProvider(),
+		NodesCoordinator:        &mock.NodesCoordinatorStub{},
+		HeaderSigVerifier:       &mock.HeaderSigVerifierStub{},
+		HeaderIntegrityVerifier: &mock.HeaderIntegrityVerifierStub{},
+		ShardCoordinator:        testscommon.NewMultiShardsCoordinatorMock(2),
+		Marshalizer:             testMarshalizer,
+		Hasher:                  testHasher,
+	}
+}
+
+func TestNewLightClient(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil data provider should err", func(t *testing.T) {
+		args := createMockArgs(createTestChain(t))
+		args.DataProvider = nil
+		lc, err := lightclient.NewLightClient(args)
+		assert.Nil(t, lc)
+		assert.Equal(t, lightclient.ErrNilDataProvider, err)
+	})
+	t.Run("nil nodes coordinator should err", func(t *testing.T) {
+		args := createMockArgs(createTestChain(t))
+		args.NodesCoordinator = nil
+		lc, err := lightclient.NewLightClient(args)
+		assert.Nil(t, lc)
+		assert.Equal(t, lightclient.ErrNilNodesCoordinator, err)
+	})
+	t.Run("nil header sig verifier should err", func(t *testing.T) {
+		args := createMockArgs(createTestChain(t))
+		args.HeaderSigVerifier = nil
+		lc, err := lightclient.NewLightClient(args)
+		assert.Nil(t, lc)
+		assert.Equal(t, lightclient.ErrNilHeaderSigVerifier, err)
+	})
+	t.Run("nil header integrity verifier should err", func(t *testing.T) {
+		args := createMockArgs(createTestChain(t))
+		args.HeaderIntegrityVerifier = nil
+		lc, err := lightclient.NewLightClient(args)
+		assert.Nil(t, lc)
+		assert.Equal(t, lightclient.ErrNilHeaderIntegrityVerifier, err)
+	})
+	t.Run("nil shard coordinator should err", func(t *testing.T) {
+		args := createMockArgs(createTestChain(t))
+		args.ShardCoordinator = nil
+		lc, err := lightclient.NewLightClient(args)
+		assert.Nil(t, lc)
+		assert.Equal(t, lightclient.ErrNilShardCoordinator, err)
+	})
+	t.Run("nil marshalizer should err", func(t *testing.T) {
+		args := createMockArgs(createTestChain(t))
+		args.Marshalizer = nil
+		lc, err := lightclient.NewLightClient(args)
+		assert.Nil(t, lc)
+		assert.Equal(t, lightclient.ErrNilMarshalizer, err)
+	})
+	t.Run("nil hasher should err", func(t *testing.T) {
+		args := createMockArgs(createTestChain(t))
+		args.Hasher = nil
+		lc, err := lightclient.NewLightClient(args)
+		assert.Nil(t, lc)
+		assert.Equal(t, lightclient.ErrNilHasher, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		lc, err := lightclient.NewLightClient(createMockArgs(createTestChain(t)))
+		assert.Nil(t, err)
+		assert.False(t, lc.IsInterfaceNil())
+	})
+}
+
+func TestLightClient_Sync(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should verify the epoch start block and the final tip", func(t *testing.T) {
+		chain := createTestChain(t)
+		args := createMockArgs(chain)
+		var preparedBody data.BodyHandler
+		epochStartActionCalled := false
+		args.NodesCoordinator = &mock.NodesCoordinatorStub{
+			EpochStartPrepareCalled: func(metaHdr data.HeaderHandler, body data.BodyHandler) {
+				assert.Equal(t, uint32(1), metaHdr.GetEpoch())
+				preparedBody = body
+			},
+			EpochStartActionCalled: func(hdr data.HeaderHandler) {
+				epochStartActionCalled = true
+			},
+		}
+		verifiedNonces := make([]uint64, 0)
+		args.HeaderSigVerifier = &mock.HeaderSigVerifierStub{
+			VerifySignatureCalled: func(header data.HeaderHandler) error {
+				verifiedNonces = append(verifiedNonces, header.GetNonce())
+				return nil
+			},
+		}
+		lc, _ := lightclient.NewLightClient(args)
+
+		err := lc.Sync()
+		require.Nil(t, err)
+
+		require.NotNil(t, preparedBody)
+		body := preparedBody.(*block.Body)
+		require.Equal(t, 1, len(body.MiniBlocks))
+		assert.Equal(t, block.PeerBlock, body.MiniBlocks[0].Type)
+		assert.True(t, epochStartActionCalled)
+		assert.Equal(t, []uint64{10, 11, 12}, verifiedNonces)
+
+		status := lc.Status()
+		assert.Equal(t, uint32(1), status.Epoch)
+		assert.Equal(t, uint64(11), status.Nonce)
+		assert.Equal(t, testHasher.Compute(string(chain.metaBlocks[11])), status.HeaderHash)
+		assert.Equal(t, testHasher.Compute(string(chain.epochStartMetas[1])), status.LastEpochStartHash)
+		assert.Equal(t, []lightclient.NotarizedShardHeader{
+			{ShardID: 0, Nonce: 5, HeaderHash: []byte("shard 0 hash"), RootHash: []byte("shard 0 root hash")},
+		}, status.NotarizedShardsHeaders)
+	})
+	t.Run("miniblock not matching the header should err", func(t *testing.T) {
+		chain := createTestChain(t)
+		for key := range chain.miniBlocks {
+			chain.miniBlocks[key] = marshal(t, &block.MiniBlock{Type: block.PeerBlock})
+		}
+		lc, _ := lightclient.NewLightClient(createMockArgs(chain))
+
+		err := lc.Sync()
+		assert.True(t, errors.Is(err, lightclient.ErrMiniBlockHashMismatch))
+		assert.Equal(t, uint32(0), lc.Status().Epoch)
+	})
+	t.Run("not an epoch start block should err", func(t *testing.T) {
+		chain := createTestChain(t)
+		chain.epochStartMetas[1] = chain.metaBlocks[11]
+		lc, _ := lightclient.NewLightClient(createMockArgs(chain))
+
+		err := lc.Sync()
+		assert.True(t, errors.Is(err, lightclient.ErrNotEpochStartBlock))
+	})
+	t.Run("wrong epoch should err", func(t *testing.T) {
+		chain := createTestChain(t)
+		chain.status.Epoch = 2
+		chain.epochStartMetas[2] = chain.epochStartMetas[1]
+		lc, _ := lightclient.NewLightClient(createMockArgs(chain))
+
+		err := lc.Sync()
+		assert.True(t, errors.Is(err, lightclient.ErrWrongEpoch))
+		assert.Equal(t, uint32(1), lc.Status().Epoch)
+	})
+	t.Run("epoch start block not linked to the previous one should err", func(t *testing.T) {
+		chain := createTestChain(t)
+		chain.status.Epoch = 2
+		epochStart := &block.MetaBlock{}
+		require.Nil(t, testMarshalizer.Unmarshal(epochStart, chain.epochStartMetas[1]))
+		epochStart.Epoch = 2
+		epochStart.Nonce = 100
+		chain.epochStartMetas[2] = marshal(t, epochStart)
+		lc, _ := lightclient.NewLightClient(createMockArgs(chain))
+
+		err := lc.Sync()
+		assert.True(t, errors.Is(err, lightclient.ErrEpochStartChainBroken))
+	})
+	t.Run("invalid signature should err", func(t *testing.T) {
+		chain := createTestChain(t)
+		args := createMockArgs(chain)
+		expectedErr := errors.New("expected error")
+		args.HeaderSigVerifier = &mock.HeaderSigVerifierStub{
+			VerifySignatureCalled: func(header data.HeaderHandler) error {
+				if header.GetNonce() == 12 {
+					return expectedErr
+				}
+				return nil
+			},
+		}
+		lc, _ := lightclient.NewLightClient(args)
+
+		err := lc.Sync()
+		assert.True(t, errors.Is(err, expectedErr))
+		status := lc.Status()
+		assert.Equal(t, uint32(1), status.Epoch)
+		assert.Equal(t, uint64(10), status.Nonce)
+	})
+	t.Run("invalid header integrity should err", func(t *testing.T) {
+		chain := createTestChain(t)
+		args := createMockArgs(chain)
+		expectedErr := errors.New("expected error")
+		args.HeaderIntegrityVerifier = &mock.HeaderIntegrityVerifierStub{
+			VerifyCalled: func(header data.HeaderHandler) error {
+				return expectedErr
+			},
+		}
+		lc, _ := lightclient.NewLightClient(args)
+
+		err := lc.Sync()
+		assert.True(t, errors.Is(err, expectedErr))
+		assert.Equal(t, uint32(0), lc.Status().Epoch)
+	})
+	t.Run("missing validators set should err", func(t *testing.T) {
+		chain := createTestChain(t)
+		args := createMockArgs(chain)
+		args.NodesCoordinator = &mock.NodesCoordinatorStub{
+			ShardIdForEpochCalled: func(epoch uint32) (uint32, error) {
+				return 0, errors.New("missing")
+			},
+		}
+		lc, _ := lightclient.NewLightClient(args)
+
+		err := lc.Sync()
+		assert.True(t, errors.Is(err, lightclient.ErrMissingNodesConfig))
+	})
+	t.Run("tip not confirmed by the next block should err", func(t *testing.T) {
+		chain := createTestChain(t)
+		chain.metaBlocks[12] = marshal(t, createMetaBlock(12, 1, []byte("other hash")))
+		lc, _ := lightclient.NewLightClient(createMockArgs(chain))
+
+		err := lc.Sync()
+		assert.True(t, errors.Is(err, lightclient.ErrHeaderChainBroken))
+		assert.Equal(t, uint64(10), lc.Status().Nonce)
+	})
+	t.Run("block with unexpected nonce should err", func(t *testing.T) {
+		chain := createTestChain(t)
+		chain.metaBlocks[11] = chain.metaBlocks[12]
+		lc, _ := lightclient.NewLightClient(createMockArgs(chain))
+
+		err := lc.Sync()
+		assert.True(t, errors.Is(err, lightclient.ErrWrongNonce))
+	})
+	t.Run("block of an old epoch should err", func(t *testing.T) {
+		chain := createTestChain(t)
+		epochStart := &block.MetaBlock{}
+		require.Nil(t, testMarshalizer.Unmarshal(epochStart, chain.epochStartMetas[1]))
+		epochStartHash := hash(t, epochStart)
+		epochStart.Epoch = 2
+		epochStart.Nonce = 13
+		epochStart.EpochStart.Economics.PrevEpochStartHash = epochStartHash
+		chain.epochStartMetas[2] = marshal(t, epochStart)
+		block20 := createMetaBlock(20, 0, []byte("prev hash"))
+		chain.metaBlocks[20] = marshal(t, block20)
+		chain.metaBlocks[21] = marshal(t, createMetaBlock(21, 0, hash(t, block20)))
+		chain.status = &lightclient.NetworkStatus{Nonce: 21, Epoch: 2}
+		lc, _ := lightclient.NewLightClient(createMockArgs(chain))
+
+		err := lc.Sync()
+		assert.True(t, errors.Is(err, lightclient.ErrWrongEpoch))
+		status := lc.Status()
+		assert.Equal(t, uint32(2), status.Epoch)
+		assert.Equal(t, uint64(13), status.Nonce)
+		assert.Equal(t, hash(t, epochStart), status.LastEpochStartHash)
+	})
+}
+
+func createAccountsTrieWithProof(t *testing.T, address []byte, balance int64) ([]byte, [][]byte) {
+	trieStorage, err := trie.NewTrieStorageManagerWithoutPruning(testscommon.NewMemDbMock())
+	require.Nil(t, err)
+	tr, err := trie.NewTrie(trieStorage, testMarshalizer, testHasher, 5)
+	require.Nil(t, err)
+
+	account, _ := state.NewUserAccount(address)
+	account.Balance = big.NewInt(balance)
+	require.Nil(t, tr.Update(address, marshal(t, account)))
+	require.Nil(t, tr.Update([]byte("other address"), marshal(t, &state.UserAccountData{Balance: big.NewInt(1)})))
+	require.Nil(t, tr.Commit())
+
+	rootHash, err := tr.RootHash()
+	require.Nil(t, err)
+	proof, err := tr.GetProof(address)
+	require.Nil(t, err)
+
+	return rootHash, proof
+}
+
+func TestLightClient_GetAccount(t *testing.T) {
+	t.Parallel()
+
+	address := []byte("address_aaaaaaaaaaaaaaaaaaaaaaaa")
+
+	t.Run("not synced should err", func(t *testing.T) {
+		lc, _ := lightclient.NewLightClient(createMockArgs(createTestChain(t)))
+
+		account, err := lc.GetAccount(address)
+		assert.Nil(t, account)
+		assert.Equal(t, lightclient.ErrNotSynced, err)
+	})
+	t.Run("metachain account should be read from the tip state", func(t *testing.T) {
+		chain := createTestChain(t)
+		rootHash, proof := createAccountsTrieWithProof(t, address, 37)
+		block11 := &block.MetaBlock{}
+		require.Nil(t, testMarshalizer.Unmarshal(block11, chain.metaBlocks[11]))
+		block11.RootHash = rootHash
+		chain.metaBlocks[11] = marshal(t, block11)
+		chain.metaBlocks[12] = marshal(t, createMetaBlock(12, 1, hash(t, block11)))
+
+		args := createMockArgs(chain)
+		args.ShardCoordinator = &testscommon.ShardsCoordinatorMock{
+			NoShards:     2,
+			CurrentShard: core.MetachainShardId,
+		}
+		provider := chain.dataProvider()
+		provider.GetAccountProofCalled = func(shardID uint32, requestedRootHash []byte, requestedAddress []byte) ([][]byte, error) {
+			assert.Equal(t, core.MetachainShardId, shardID)
+			assert.Equal(t, rootHash, requestedRootHash)
+			assert.Equal(t, address, requestedAddress)
+			return proof, nil
+		}
+		args.DataProvider = provider
+		lc, _ := lightclient.NewLightClient(args)
+		require.Nil(t, lc.Sync())
+
+		account, err := lc.GetAccount(address)
+		require.Nil(t, err)
+		assert.Equal(t, big.NewInt(37), account.GetBalance())
+	})
+	t.Run("shard account should be read from the notarized shard header state", func(t *testing.T) {
+		chain := createTestChain(t)
+		rootHash, proof := createAccountsTrieWithProof(t, address, 38)
+		shardHeader := &block.Header{
+			ShardID:         1,
+			Nonce:           7,
+			RootHash:        rootHash,
+			AccumulatedFees: big.NewInt(0),
+			DeveloperFees:   big.NewInt(0),
+		}
+		shardHeaderHash := hash(t, shardHeader)
+		chain.shardBlocks[string(shardHeaderHash)] = marshal(t, shardHeader)
+
+		block11 := &block.MetaBlock{}
+		require.Nil(t, testMarshalizer.Unmarshal(block11, chain.metaBlocks[11]))
+		block11.ShardInfo = []block.ShardData{
+			{ShardID: 1, Nonce: 7, HeaderHash: shardHeaderHash},
+		}
+		chain.metaBlocks[11] = marshal(t, block11)
+		chain.metaBlocks[12] = marshal(t, createMetaBlock(12, 1, hash(t, block11)))
+
+		args := createMockArgs(chain)
+		args.ShardCoordinator = &testscommon.ShardsCoordinatorMock{
+			NoShards:     2,
+			CurrentShard: 1,
+		}
+		numShardHeaderRequests := 0
+		provider := chain.dataProvider()
+		provider.GetRawShardBlockByHashCalled = func(shardID uint32, hash []byte) ([]byte, error) {
+			numShardHeaderRequests++
+			assert.Equal(t, uint32(1), shardID)
+			return get(chain.shardBlocks[string(hash)])
+		}
+		provider.GetAccountProofCalled = func(shardID uint32, requestedRootHash []byte, _ []byte) ([][]byte, error) {
+			assert.Equal(t, uint32(1), shardID)
+			assert.Equal(t, rootHash, requestedRootHash)
+			return proof, nil
+		}
+		args.DataProvider = provider
+		lc, _ := lightclient.NewLightClient(args)
+		require.Nil(t, lc.Sync())
+
+		account, err := lc.GetAccount(address)
+		require.Nil(t, err)
+		assert.Equal(t, big.NewInt(38), account.GetBalance())
+
+		_, err = lc.GetAccount(address)
+		require.Nil(t, err)
+		assert.Equal(t, 1, numShardHeaderRequests)
+	})
+	t.Run("shard header not matching the notarized hash should err", func(t *testing.T) {
+		chain := createTestChain(t)
+		block11 := &block.MetaBlock{}
+		require.Nil(t, testMarshalizer.Unmarshal(block11, chain.metaBlocks[11]))
+		block11.ShardInfo = []block.ShardData{
+			{ShardID: 1, Nonce: 7, HeaderHash: []byte("notarized hash")},
+		}
+		chain.metaBlocks[11] = marshal(t, block11)
+		chain.metaBlocks[12] = marshal(t, createMetaBlock(12, 1, hash(t, block11)))
+		chain.shardBlocks["notarized hash"] = marshal(t, &block.Header{ShardID: 1, Nonce: 7})
+
+		args := createMockArgs(chain)
+		args.ShardCoordinator = &testscommon.ShardsCoordinatorMock{
+			NoShards:     2,
+			CurrentShard: 1,
+		}
+		lc, _ := lightclient.NewLightClient(args)
+		require.Nil(t, lc.Sync())
+
+		account, err := lc.GetAccount(address)
+		assert.Nil(t, account)
+		assert.True(t, errors.Is(err, lightclient.ErrHeaderHashMismatch))
+	})
+	t.Run("shard without notarized header should err", func(t *testing.T) {
+		chain := createTestChain(t)
+		args := createMockArgs(chain)
+		args.ShardCoordinator = &testscommon.ShardsCoordinatorMock{
+			NoShards:     2,
+			CurrentShard: 1,
+		}
+		lc, _ := lightclient.NewLightClient(args)
+		require.Nil(t, lc.Sync())
+
+		account, err := lc.GetAccount(address)
+		assert.Nil(t, account)
+		assert.True(t, errors.Is(err, lightclient.ErrUnknownShardState))
+	})
+	t.Run("shard 0 account should use the epoch start root hash", func(t *testing.T) {
+		chain := createTestChain(t)
+		args := createMockArgs(chain)
+		provider := chain.dataProvider()
+		provider.GetAccountProofCalled = func(shardID uint32, requestedRootHash []byte, _ []byte) ([][]byte, error) {
+			assert.Equal(t, []byte("shard 0 root hash"), requestedRootHash)
+			return nil, errNotFound
+		}
+		args.DataProvider = provider
+		lc, _ := lightclient.NewLightClient(args)
+		require.Nil(t, lc.Sync())
+
+		account, err := lc.GetAccount(address)
+		assert.Nil(t, account)
+		assert.Equal(t, errNotFound, err)
+	})
+}
@@ -0,0 +1,72 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/lightclient"
+
+// DataProviderStub -
+type DataProviderStub struct {
+	GetMetachainStatusCalled        func() (*lightclient.NetworkStatus, error)
+	GetRawEpochStartMetaBlockCalled func(epoch uint32) ([]byte, error)
+	GetRawMetaBlockByNonceCalled    func(nonce uint64) ([]byte, error)
+	GetRawMetaMiniBlockCalled       func(hash []byte, epoch uint32) ([]byte, error)
+	GetRawShardBlockByHashCalled    func(shardID uint32, hash []byte) ([]byte, error)
+	GetAccountProofCalled           func(shardID uint32, rootHash []byte, address []byte) ([][]byte, error)
+}
+
+// GetMetachainStatus -
+func (dps *DataProviderStub) GetMetachainStatus() (*lightclient.NetworkStatus, error) {
+	if dps.GetMetachainStatusCalled != nil {
+		return dps.GetMetachainStatusCalled()
+	}
+
+	return &lightclient.NetworkStatus{}, nil
+}
+
+// GetRawEpochStartMetaBlock -
+func (dps *DataProviderStub) GetRawEpochStartMetaBlock(epoch uint32) ([]byte, error) {
+	if dps.GetRawEpochStartMetaBlockCalled != nil {
+		return dps.GetRawEpochStartMetaBlockCalled(epoch)
+	}
+
+	return nil, nil
+}
+
+// GetRawMetaBlockByNonce -
+func (dps *DataProviderStub) GetRawMetaBlockByNonce(nonce uint64) ([]byte, error) {
+	if dps.GetRawMetaBlockByNonceCalled != nil {
+		return dps.GetRawMetaBlockByNonceCalled(nonce)
+	}
+
+	return nil, nil
+}
+
+// GetRawMetaMiniBlock -
+func (dps *DataProviderStub) GetRawMetaMiniBlock(hash []byte, epoch uint32) ([]byte, error) {
+	if dps.GetRawMetaMiniBlockCalled != nil {
+		return dps.GetRawMetaMiniBlockCalled(hash, epoch)
+	}
+
+	return nil, nil
+}
+
+// GetRawShardBlockByHash -
+func (dps *DataProviderStub) GetRawShardBlockByHash(shardID uint32, hash []byte) ([]byte, error) {
+	if dps.GetRawShardBlockByHashCalled != nil {
+		return dps.GetRawShardBlockByHashCalled(shardID, hash)
+	}
+
+	return nil, nil
+}
+
+// GetAccountProof -
+func (dps *DataProviderStub) GetAccountProof(shardID uint32, rootHash []byte, address []byte) ([][]byte, error) {
+	if dps.GetAccountProofCalled != nil {
+		return dps.GetAccountProofCalled(shardID, rootHash, address)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (dps *DataProviderStub) IsInterfaceNil() bool {
+	return dps == nil
+}
@@ -0,0 +1,38 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go-core/data"
+
+// NodesCoordinatorStub -
+type NodesCoordinatorStub struct {
+	EpochStartPrepareCalled func(metaHdr data.HeaderHandler, body data.BodyHandler)
+	EpochStartActionCalled  func(hdr data.HeaderHandler)
+	ShardIdForEpochCalled   func(epoch uint32) (uint32, error)
+}
+
+// EpochStartPrepare -
+func (ncs *NodesCoordinatorStub) EpochStartPrepare(metaHdr data.HeaderHandler, body data.BodyHandler) {
+	if ncs.EpochStartPrepareCalled != nil {
+		ncs.EpochStartPrepareCalled(metaHdr, body)
+	}
+}
+
+// EpochStartAction -
+func (ncs *NodesCoordinatorStub) EpochStartAction(hdr data.HeaderHandler) {
+	if ncs.EpochStartActionCalled != nil {
+		ncs.EpochStartActionCalled(hdr)
+	}
+}
+
+// ShardIdForEpoch -
+func (ncs *NodesCoordinatorStub) ShardIdForEpoch(epoch uint32) (uint32, error) {
+	if ncs.ShardIdForEpochCalled != nil {
+		return ncs.ShardIdForEpochCalled(epoch)
+	}
+
+	return 0, nil
+}
+
+// IsInterfaceNil -
+func (ncs *NodesCoordinatorStub) IsInterfaceNil() bool {
+	return ncs == nil
+}
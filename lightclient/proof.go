@@ -0,0 +1,65 @@
+package lightclient
+
+import (
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/trie"
+)
+
+const maxTrieLevelInMemory = uint(5)
+
+// getAccountFromProof rebuilds the path of the accounts trie described by the proof and reads the account from it.
+// Each proof node is stored under its own hash, so a node not belonging to the trie with the provided root hash will
+// never be reached. An incomplete path results in an error, while a complete path not leading to the address proves
+// that the account does not exist
+func getAccountFromProof(
+	proof [][]byte,
+	rootHash []byte,
+	address []byte,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+) (state.UserAccountHandler, error) {
+	db := memorydb.New()
+	for _, encodedNode := range proof {
+		err := db.Put(hasher.Compute(string(encodedNode)), encodedNode)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	trieStorage, err := trie.NewTrieStorageManagerWithoutPruning(db)
+	if err != nil {
+		return nil, err
+	}
+	emptyTrie, err := trie.NewTrie(trieStorage, marshalizer, hasher, maxTrieLevelInMemory)
+	if err != nil {
+		return nil, err
+	}
+
+	accountsTrie, err := emptyTrie.Recreate(rootHash)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidProof, err)
+	}
+	value, err := accountsTrie.Get(address)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidProof, err)
+	}
+	if len(value) == 0 {
+		return nil, nil
+	}
+
+	account, err := state.NewUserAccount(address)
+	if err != nil {
+		return nil, err
+	}
+	err = marshalizer.Unmarshal(account, value)
+	if err != nil {
+		return nil, err
+	}
+
+	return account, nil
+}
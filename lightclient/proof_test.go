@@ -0,0 +1,109 @@
+package lightclient
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testMarshalizer = &testscommon.ProtobufMarshalizerMock{}
+	testHasher      = &testscommon.KeccakMock{}
+)
+
+func createAccountsTrie(t *testing.T, balances map[string]int64) (common.Trie, []byte) {
+	trieStorage, err := trie.NewTrieStorageManagerWithoutPruning(testscommon.NewMemDbMock())
+	require.Nil(t, err)
+	tr, err := trie.NewTrie(trieStorage, testMarshalizer, testHasher, 5)
+	require.Nil(t, err)
+
+	for address, balance := range balances {
+		account, _ := state.NewUserAccount([]byte(address))
+		account.Balance = big.NewInt(balance)
+		account.Nonce = uint64(balance)
+		value, errMarshal := testMarshalizer.Marshal(account)
+		require.Nil(t, errMarshal)
+		require.Nil(t, tr.Update([]byte(address), value))
+	}
+	require.Nil(t, tr.Commit())
+
+	rootHash, err := tr.RootHash()
+	require.Nil(t, err)
+
+	return tr, rootHash
+}
+
+func TestGetAccountFromProof(t *testing.T) {
+	t.Parallel()
+
+	balances := map[string]int64{
+		"address_aaaaaaaaaaaaaaaaaaaaaaaa": 10,
+		"address_bbbbbbbbbbbbbbbbbbbbbbbb": 20,
+		"address_cccccccccccccccccccccccc": 30,
+		"other___dddddddddddddddddddddddd": 40,
+	}
+	tr, rootHash := createAccountsTrie(t, balances)
+
+	t.Run("existing account should work", func(t *testing.T) {
+		address := []byte("address_bbbbbbbbbbbbbbbbbbbbbbbb")
+		proof, err := tr.GetProof(address)
+		require.Nil(t, err)
+
+		account, err := getAccountFromProof(proof, rootHash, address, testMarshalizer, testHasher)
+		require.Nil(t, err)
+		assert.Equal(t, address, account.AddressBytes())
+		assert.Equal(t, big.NewInt(20), account.GetBalance())
+		assert.Equal(t, uint64(20), account.GetNonce())
+	})
+	t.Run("path proving the account is missing should return nil", func(t *testing.T) {
+		existingAddress := []byte("address_aaaaaaaaaaaaaaaaaaaaaaaa")
+		singleLeafTrie, rootHash := createAccountsTrie(t, map[string]int64{string(existingAddress): 1})
+		proof, err := singleLeafTrie.GetProof(existingAddress)
+		require.Nil(t, err)
+
+		address := []byte("address_eeeeeeeeeeeeeeeeeeeeeeee")
+
+		account, err := getAccountFromProof(proof, rootHash, address, testMarshalizer, testHasher)
+		assert.Nil(t, err)
+		assert.Nil(t, account)
+	})
+	t.Run("proof for another root hash should err", func(t *testing.T) {
+		address := []byte("address_aaaaaaaaaaaaaaaaaaaaaaaa")
+		otherTrie, _ := createAccountsTrie(t, map[string]int64{string(address): 1000})
+		proof, err := otherTrie.GetProof(address)
+		require.Nil(t, err)
+
+		account, err := getAccountFromProof(proof, rootHash, address, testMarshalizer, testHasher)
+		assert.Nil(t, account)
+		assert.ErrorIs(t, err, ErrInvalidProof)
+	})
+	t.Run("incomplete proof should err", func(t *testing.T) {
+		address := []byte("address_cccccccccccccccccccccccc")
+		proof, err := tr.GetProof(address)
+		require.Nil(t, err)
+		require.True(t, len(proof) > 1)
+
+		account, err := getAccountFromProof(proof[:len(proof)-1], rootHash, address, testMarshalizer, testHasher)
+		assert.Nil(t, account)
+		assert.ErrorIs(t, err, ErrInvalidProof)
+	})
+	t.Run("tampered proof should err", func(t *testing.T) {
+		address := []byte("address_aaaaaaaaaaaaaaaaaaaaaaaa")
+		proof, err := tr.GetProof(address)
+		require.Nil(t, err)
+
+		lastNode := append([]byte{}, proof[len(proof)-1]...)
+		lastNode[len(lastNode)/2]++
+		proof[len(proof)-1] = lastNode
+
+		account, err := getAccountFromProof(proof, rootHash, address, testMarshalizer, testHasher)
+		assert.Nil(t, account)
+		assert.ErrorIs(t, err, ErrInvalidProof)
+	})
+}
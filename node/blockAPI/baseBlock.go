@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/data/api"
 	"github.com/ElrondNetwork/elrond-go-core/data/block"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
@@ -134,6 +135,18 @@ func (bap *baseAPIBlockProcessor) getFromStorerWithEpoch(unit dataRetriever.Unit
 	return storer.GetFromEpoch(key, epoch)
 }
 
+// GetRawEpochStartMetaBlock returns the marshalized epoch start meta block of the provided epoch
+func (bap *baseAPIBlockProcessor) GetRawEpochStartMetaBlock(epoch uint32) ([]byte, error) {
+	epochStartIdentifier := core.EpochStartIdentifier(epoch)
+
+	return bap.getFromStorerWithEpoch(dataRetriever.MetaBlockUnit, []byte(epochStartIdentifier), epoch)
+}
+
+// GetRawMiniBlockByHash returns the marshalized miniblock with the provided hash, saved in the provided epoch
+func (bap *baseAPIBlockProcessor) GetRawMiniBlockByHash(hash []byte, epoch uint32) ([]byte, error) {
+	return bap.getFromStorerWithEpoch(dataRetriever.MiniBlockUnit, hash, epoch)
+}
+
 func (bap *baseAPIBlockProcessor) computeBlockStatus(storerUnit dataRetriever.UnitType, blockAPI *api.Block) (string, error) {
 	nonceToByteSlice := bap.uint64ByteSliceConverter.ToByteSlice(blockAPI.Nonce)
 	headerHash, err := bap.store.Get(storerUnit, nonceToByteSlice)
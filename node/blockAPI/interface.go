@@ -8,4 +8,8 @@ import (
 type APIBlockHandler interface {
 	GetBlockByNonce(nonce uint64, withTxs bool) (*api.Block, error)
 	GetBlockByHash(hash []byte, withTxs bool) (*api.Block, error)
+	GetRawBlockByNonce(nonce uint64) ([]byte, error)
+	GetRawBlockByHash(hash []byte) ([]byte, error)
+	GetRawEpochStartMetaBlock(epoch uint32) ([]byte, error)
+	GetRawMiniBlockByHash(hash []byte, epoch uint32) ([]byte, error)
 }
@@ -34,20 +34,41 @@ func NewMetaApiBlockProcessor(arg *APIBlockProcessorArg) *metaAPIBlockProcessor
 
 // GetBlockByNonce wil return a meta APIBlock by nonce
 func (mbp *metaAPIBlockProcessor) GetBlockByNonce(nonce uint64, withTxs bool) (*api.Block, error) {
+	headerHash, blockBytes, err := mbp.getBlockHashAndBytesByNonce(nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return mbp.convertMetaBlockBytesToAPIBlock(headerHash, blockBytes, withTxs)
+}
+
+// GetRawBlockByNonce will return the marshalized meta block by nonce
+func (mbp *metaAPIBlockProcessor) GetRawBlockByNonce(nonce uint64) ([]byte, error) {
+	_, blockBytes, err := mbp.getBlockHashAndBytesByNonce(nonce)
+
+	return blockBytes, err
+}
+
+// GetRawBlockByHash will return the marshalized meta block by hash
+func (mbp *metaAPIBlockProcessor) GetRawBlockByHash(hash []byte) ([]byte, error) {
+	return mbp.getFromStorer(dataRetriever.MetaBlockUnit, hash)
+}
+
+func (mbp *metaAPIBlockProcessor) getBlockHashAndBytesByNonce(nonce uint64) ([]byte, []byte, error) {
 	storerUnit := dataRetriever.MetaHdrNonceHashDataUnit
 
 	nonceToByteSlice := mbp.uint64ByteSliceConverter.ToByteSlice(nonce)
 	headerHash, err := mbp.store.Get(storerUnit, nonceToByteSlice)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	blockBytes, err := mbp.getFromStorer(dataRetriever.MetaBlockUnit, headerHash)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return mbp.convertMetaBlockBytesToAPIBlock(headerHash, blockBytes, withTxs)
+	return headerHash, blockBytes, nil
 }
 
 // GetBlockByHash will return a shard APIBlock by hash
@@ -380,3 +380,63 @@ func TestMetaAPIBlockProcessor_GetBlockByHashEpochStartBlock(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, expectedBlock, blk)
 }
+
+func TestMetaAPIBlockProcessor_GetRawBlockByNonceAndHash(t *testing.T) {
+	t.Parallel()
+
+	headerHash := []byte("d08089f2ab739520598fd7aeed08c427460fe94f286383047f3f61951afc4e00")
+	headerBytes := []byte("marshalized meta block")
+
+	storerMock := mock.NewStorerMock()
+	_ = storerMock.Put(headerHash, headerBytes)
+
+	metaAPIBlockProcessor := createMockMetaAPIProcessor(
+		headerHash,
+		storerMock,
+		true,
+		false,
+	)
+
+	rawBlock, err := metaAPIBlockProcessor.GetRawBlockByNonce(1)
+	assert.Nil(t, err)
+	assert.Equal(t, headerBytes, rawBlock)
+
+	rawBlock, err = metaAPIBlockProcessor.GetRawBlockByHash(headerHash)
+	assert.Nil(t, err)
+	assert.Equal(t, headerBytes, rawBlock)
+
+	rawBlock, err = metaAPIBlockProcessor.GetRawBlockByHash([]byte("invalidHash"))
+	assert.Nil(t, rawBlock)
+	assert.Error(t, err)
+}
+
+func TestMetaAPIBlockProcessor_GetRawEpochStartMetaBlockAndMiniBlock(t *testing.T) {
+	t.Parallel()
+
+	epochStartBytes := []byte("marshalized epoch start meta block")
+	miniBlockHash := []byte("miniBlockHash")
+	miniBlockBytes := []byte("marshalized miniblock")
+
+	storerMock := mock.NewStorerMock()
+	_ = storerMock.Put([]byte(core.EpochStartIdentifier(3)), epochStartBytes)
+	_ = storerMock.Put(miniBlockHash, miniBlockBytes)
+
+	metaAPIBlockProcessor := createMockMetaAPIProcessor(
+		nil,
+		storerMock,
+		false,
+		true,
+	)
+
+	rawBlock, err := metaAPIBlockProcessor.GetRawEpochStartMetaBlock(3)
+	assert.Nil(t, err)
+	assert.Equal(t, epochStartBytes, rawBlock)
+
+	rawBlock, err = metaAPIBlockProcessor.GetRawEpochStartMetaBlock(4)
+	assert.Nil(t, rawBlock)
+	assert.Error(t, err)
+
+	rawMiniBlock, err := metaAPIBlockProcessor.GetRawMiniBlockByHash(miniBlockHash, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, miniBlockBytes, rawMiniBlock)
+}
@@ -34,20 +34,41 @@ func NewShardApiBlockProcessor(arg *APIBlockProcessorArg) *shardAPIBlockProcesso
 
 // GetBlockByNonce will return a shard APIBlock by nonce
 func (sbp *shardAPIBlockProcessor) GetBlockByNonce(nonce uint64, withTxs bool) (*api.Block, error) {
+	headerHash, blockBytes, err := sbp.getBlockHashAndBytesByNonce(nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return sbp.convertShardBlockBytesToAPIBlock(headerHash, blockBytes, withTxs)
+}
+
+// GetRawBlockByNonce will return the marshalized shard block by nonce
+func (sbp *shardAPIBlockProcessor) GetRawBlockByNonce(nonce uint64) ([]byte, error) {
+	_, blockBytes, err := sbp.getBlockHashAndBytesByNonce(nonce)
+
+	return blockBytes, err
+}
+
+// GetRawBlockByHash will return the marshalized shard block by hash
+func (sbp *shardAPIBlockProcessor) GetRawBlockByHash(hash []byte) ([]byte, error) {
+	return sbp.getFromStorer(dataRetriever.BlockHeaderUnit, hash)
+}
+
+func (sbp *shardAPIBlockProcessor) getBlockHashAndBytesByNonce(nonce uint64) ([]byte, []byte, error) {
 	storerUnit := dataRetriever.ShardHdrNonceHashDataUnit + dataRetriever.UnitType(sbp.selfShardID)
 
 	nonceToByteSlice := sbp.uint64ByteSliceConverter.ToByteSlice(nonce)
 	headerHash, err := sbp.store.Get(storerUnit, nonceToByteSlice)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	blockBytes, err := sbp.getFromStorer(dataRetriever.BlockHeaderUnit, headerHash)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return sbp.convertShardBlockBytesToAPIBlock(headerHash, blockBytes, withTxs)
+	return headerHash, blockBytes, nil
 }
 
 // GetBlockByHash will return a shard APIBlock by hash
@@ -268,3 +268,34 @@ func TestShardAPIBlockProcessor_GetBlockByHashFromHistoryNodeStatusReverted(t *t
 	assert.Nil(t, err)
 	assert.Equal(t, expectedBlock, blk)
 }
+
+func TestShardAPIBlockProcessor_GetRawBlockByNonceAndHash(t *testing.T) {
+	t.Parallel()
+
+	shardID := uint32(3)
+	headerHash := []byte("d08089f2ab739520598fd7aeed08c427460fe94f286383047f3f61951afc4e00")
+	headerBytes := []byte("marshalized shard block")
+
+	storerMock := mock.NewStorerMock()
+	_ = storerMock.Put(headerHash, headerBytes)
+
+	shardAPIBlockProcessor := createMockShardAPIProcessor(
+		shardID,
+		headerHash,
+		storerMock,
+		true,
+		false,
+	)
+
+	rawBlock, err := shardAPIBlockProcessor.GetRawBlockByNonce(1)
+	assert.Nil(t, err)
+	assert.Equal(t, headerBytes, rawBlock)
+
+	rawBlock, err = shardAPIBlockProcessor.GetRawBlockByHash(headerHash)
+	assert.Nil(t, err)
+	assert.Equal(t, headerBytes, rawBlock)
+
+	rawBlock, err = shardAPIBlockProcessor.GetRawBlockByHash([]byte("invalidHash"))
+	assert.Nil(t, rawBlock)
+	assert.Error(t, err)
+}
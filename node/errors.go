@@ -147,3 +147,12 @@ var ErrInvalidESDTRole = errors.New("invalid ESDT role")
 
 // ErrMetachainOnlyEndpoint signals that an endpoint was called, but it is only available for metachain nodes
 var ErrMetachainOnlyEndpoint = errors.New("the endpoint is only available on metachain nodes")
+
+// ErrNilRoundTracer signals that the consensus round tracer is not available
+var ErrNilRoundTracer = errors.New("nil round tracer")
+
+// ErrNilSlashingDetector signals that the slashing detector is not available
+var ErrNilSlashingDetector = errors.New("nil slashing detector")
+
+// ErrNilKeysHandler signals that the managed keys handler is not available
+var ErrNilKeysHandler = errors.New("nil keys handler")
@@ -20,8 +20,26 @@ var ErrNilDirectStakeListHandler = errors.New("nil direct stake list handler")
 // ErrNilDelegatedListHandler signals that a nil delegated list handler has been provided
 var ErrNilDelegatedListHandler = errors.New("nil delegated list handler")
 
+// ErrNilGovernanceInfoHandler signals that a nil governance info handler has been provided
+var ErrNilGovernanceInfoHandler = errors.New("nil governance info handler")
+
+// ErrNilTokenPropertiesHandler signals that a nil token properties handler has been provided
+var ErrNilTokenPropertiesHandler = errors.New("nil token properties handler")
+
+// ErrNilESDTRegistryHandler signals that a nil ESDT registry handler has been provided
+var ErrNilESDTRegistryHandler = errors.New("nil ESDT registry handler")
+
+// ErrNilAddressPubKeyConverter signals that a nil address public key converter has been provided
+var ErrNilAddressPubKeyConverter = errors.New("nil address public key converter")
+
+// ErrTokenInfoNotAvailable signals that the node can not return any information about the requested token
+var ErrTokenInfoNotAvailable = errors.New("token info not available on this node: the properties are returned by metachain nodes, the supply by shard nodes with the ESDT registry enabled")
+
 // ErrNilVmContainer signals that a nil vm container has been provided
 var ErrNilVmContainer = errors.New("nil vm container")
 
 // ErrNilVmFactory signals that a nil vm factory has been provided
 var ErrNilVmFactory = errors.New("nil vm factory")
+
+// ErrNilGasPriceEstimator signals that a nil gas price estimator has been provided
+var ErrNilGasPriceEstimator = errors.New("nil gas price estimator")
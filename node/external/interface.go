@@ -3,13 +3,18 @@ package external
 import (
 	"github.com/ElrondNetwork/elrond-go-core/data/api"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dblookupext/esdtRegistry"
+	trieIteratorsData "github.com/ElrondNetwork/elrond-go/node/trieIterators/data"
 	"github.com/ElrondNetwork/elrond-go/process"
+	gasPriceData "github.com/ElrondNetwork/elrond-go/process/gasprice/data"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
 
 // SCQueryService defines how data should be get from a SC account
 type SCQueryService interface {
 	ExecuteQuery(query *process.SCQuery) (*vmcommon.VMOutput, error)
+	ExecuteQueryWithTrace(query *process.SCQuery) (*vmcommon.VMOutput, *tracing.ExecutionTrace, error)
 	ComputeScCallGasLimit(tx *transaction.Transaction) (uint64, error)
 	Close() error
 	IsInterfaceNil() bool
@@ -50,3 +55,29 @@ type DelegatedListHandler interface {
 	GetDelegatorsList() ([]*api.Delegator, error)
 	IsInterfaceNil() bool
 }
+
+// GovernanceInfoHandler defines the behavior of a component able to return the governance configuration and proposals
+type GovernanceInfoHandler interface {
+	GetGovernanceInfo() (*trieIteratorsData.GovernanceInfo, error)
+	IsInterfaceNil() bool
+}
+
+// TokenPropertiesHandler defines the behavior of a component able to return the properties and the special roles of an ESDT token
+type TokenPropertiesHandler interface {
+	GetTokenProperties(token string) (*trieIteratorsData.TokenProperties, error)
+	GetTokenSpecialRoles(token string) ([]*trieIteratorsData.TokenSpecialRoles, error)
+	IsInterfaceNil() bool
+}
+
+// ESDTRegistryHandler defines the behavior of a component able to return the supply and the holders of an ESDT token
+type ESDTRegistryHandler interface {
+	GetESDTSupply(token string) (*esdtRegistry.TokenSupply, error)
+	GetESDTHolders(token string, offset uint64, limit uint64) ([]*esdtRegistry.TokenHolder, error)
+	IsInterfaceNil() bool
+}
+
+// GasPriceEstimatorHandler defines the behavior of a component able to suggest gas prices
+type GasPriceEstimatorHandler interface {
+	GetGasPriceEstimates() *gasPriceData.GasPriceEstimates
+	IsInterfaceNil() bool
+}
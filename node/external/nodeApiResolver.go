@@ -1,10 +1,14 @@
 package external
 
 import (
+	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/data/api"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	trieIteratorsData "github.com/ElrondNetwork/elrond-go/node/trieIterators/data"
 	"github.com/ElrondNetwork/elrond-go/process"
+	gasPriceData "github.com/ElrondNetwork/elrond-go/process/gasprice/data"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
 
@@ -16,6 +20,11 @@ type ArgNodeApiResolver struct {
 	TotalStakedValueHandler TotalStakedValueHandler
 	DirectStakedListHandler DirectStakedListHandler
 	DelegatedListHandler    DelegatedListHandler
+	GovernanceInfoHandler   GovernanceInfoHandler
+	TokenPropertiesHandler  TokenPropertiesHandler
+	ESDTRegistryHandler     ESDTRegistryHandler
+	AddressPubKeyConverter  core.PubkeyConverter
+	GasPriceEstimator       GasPriceEstimatorHandler
 }
 
 // nodeApiResolver can resolve API requests
@@ -26,6 +35,11 @@ type nodeApiResolver struct {
 	totalStakedValueHandler TotalStakedValueHandler
 	directStakedListHandler DirectStakedListHandler
 	delegatedListHandler    DelegatedListHandler
+	governanceInfoHandler   GovernanceInfoHandler
+	tokenPropertiesHandler  TokenPropertiesHandler
+	esdtRegistryHandler     ESDTRegistryHandler
+	addressPubKeyConverter  core.PubkeyConverter
+	gasPriceEstimator       GasPriceEstimatorHandler
 }
 
 // NewNodeApiResolver creates a new nodeApiResolver instance
@@ -48,6 +62,21 @@ func NewNodeApiResolver(arg ArgNodeApiResolver) (*nodeApiResolver, error) {
 	if check.IfNil(arg.DelegatedListHandler) {
 		return nil, ErrNilDelegatedListHandler
 	}
+	if check.IfNil(arg.GovernanceInfoHandler) {
+		return nil, ErrNilGovernanceInfoHandler
+	}
+	if check.IfNil(arg.TokenPropertiesHandler) {
+		return nil, ErrNilTokenPropertiesHandler
+	}
+	if check.IfNil(arg.ESDTRegistryHandler) {
+		return nil, ErrNilESDTRegistryHandler
+	}
+	if check.IfNil(arg.AddressPubKeyConverter) {
+		return nil, ErrNilAddressPubKeyConverter
+	}
+	if check.IfNil(arg.GasPriceEstimator) {
+		return nil, ErrNilGasPriceEstimator
+	}
 
 	return &nodeApiResolver{
 		scQueryService:          arg.SCQueryService,
@@ -56,6 +85,11 @@ func NewNodeApiResolver(arg ArgNodeApiResolver) (*nodeApiResolver, error) {
 		totalStakedValueHandler: arg.TotalStakedValueHandler,
 		directStakedListHandler: arg.DirectStakedListHandler,
 		delegatedListHandler:    arg.DelegatedListHandler,
+		governanceInfoHandler:   arg.GovernanceInfoHandler,
+		tokenPropertiesHandler:  arg.TokenPropertiesHandler,
+		esdtRegistryHandler:     arg.ESDTRegistryHandler,
+		addressPubKeyConverter:  arg.AddressPubKeyConverter,
+		gasPriceEstimator:       arg.GasPriceEstimator,
 	}, nil
 }
 
@@ -64,6 +98,11 @@ func (nar *nodeApiResolver) ExecuteSCQuery(query *process.SCQuery) (*vmcommon.VM
 	return nar.scQueryService.ExecuteQuery(query)
 }
 
+// ExecuteSCQueryWithTrace retrieves data stored in a SC account through a VM, together with the trace of the execution
+func (nar *nodeApiResolver) ExecuteSCQueryWithTrace(query *process.SCQuery) (*vmcommon.VMOutput, *tracing.ExecutionTrace, error) {
+	return nar.scQueryService.ExecuteQueryWithTrace(query)
+}
+
 // StatusMetrics returns an implementation of the StatusMetricsHandler interface
 func (nar *nodeApiResolver) StatusMetrics() StatusMetricsHandler {
 	return nar.statusMetricsHandler
@@ -94,6 +133,73 @@ func (nar *nodeApiResolver) GetDelegatorsList() ([]*api.Delegator, error) {
 	return nar.delegatedListHandler.GetDelegatorsList()
 }
 
+// GetGovernanceInfo will return the governance configuration and proposals
+func (nar *nodeApiResolver) GetGovernanceInfo() (*trieIteratorsData.GovernanceInfo, error) {
+	return nar.governanceInfoHandler.GetGovernanceInfo()
+}
+
+// GetTokenInfo will return the information the node has about the provided ESDT token: the properties and the special
+// roles on metachain nodes and the supply on shard nodes with the ESDT registry enabled
+func (nar *nodeApiResolver) GetTokenInfo(token string) (*trieIteratorsData.TokenInfo, error) {
+	properties, err := nar.tokenPropertiesHandler.GetTokenProperties(token)
+	if err != nil {
+		return nil, err
+	}
+
+	specialRoles, err := nar.tokenPropertiesHandler.GetTokenSpecialRoles(token)
+	if err != nil {
+		return nil, err
+	}
+
+	supply, err := nar.esdtRegistryHandler.GetESDTSupply(token)
+	if err != nil {
+		return nil, err
+	}
+
+	if properties == nil && supply == nil {
+		return nil, ErrTokenInfoNotAvailable
+	}
+
+	tokenInfo := &trieIteratorsData.TokenInfo{
+		Identifier:   token,
+		Properties:   properties,
+		SpecialRoles: specialRoles,
+	}
+	if supply != nil {
+		tokenInfo.Supply = &trieIteratorsData.TokenSupply{
+			Supply:     supply.Supply.String(),
+			Minted:     supply.Minted.String(),
+			Burned:     supply.Burned.String(),
+			NumHolders: supply.NumHolders,
+		}
+	}
+
+	return tokenInfo, nil
+}
+
+// GetTokenHolders will return a page of the holders of the provided ESDT token from the node's shard
+func (nar *nodeApiResolver) GetTokenHolders(token string, offset uint64, limit uint64) ([]*trieIteratorsData.TokenHolder, error) {
+	holders, err := nar.esdtRegistryHandler.GetESDTHolders(token, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenHolders := make([]*trieIteratorsData.TokenHolder, 0, len(holders))
+	for _, holder := range holders {
+		tokenHolders = append(tokenHolders, &trieIteratorsData.TokenHolder{
+			Address: nar.addressPubKeyConverter.Encode(holder.Address),
+			Balance: holder.Balance.String(),
+		})
+	}
+
+	return tokenHolders, nil
+}
+
+// GetGasPriceEstimates will return the suggested gas prices for slow, normal and fast inclusion
+func (nar *nodeApiResolver) GetGasPriceEstimates() (*gasPriceData.GasPriceEstimates, error) {
+	return nar.gasPriceEstimator.GetGasPriceEstimates(), nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (nar *nodeApiResolver) IsInterfaceNil() bool {
 	return nar == nil
@@ -1,13 +1,17 @@
 package external_test
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/data/api"
+	"github.com/ElrondNetwork/elrond-go/dblookupext/esdtRegistry"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/node/mock"
+	trieIteratorsData "github.com/ElrondNetwork/elrond-go/node/trieIterators/data"
 	"github.com/ElrondNetwork/elrond-go/process"
+	gasPriceData "github.com/ElrondNetwork/elrond-go/process/gasprice/data"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/stretchr/testify/assert"
 )
@@ -20,6 +24,11 @@ func createMockAgrs() external.ArgNodeApiResolver {
 		TotalStakedValueHandler: &mock.StakeValuesProcessorStub{},
 		DirectStakedListHandler: &mock.DirectStakedListProcessorStub{},
 		DelegatedListHandler:    &mock.DelegatedListProcessorStub{},
+		GovernanceInfoHandler:   &mock.GovernanceInfoProcessorStub{},
+		TokenPropertiesHandler:  &mock.TokenPropertiesProcessorStub{},
+		ESDTRegistryHandler:     &mock.ESDTRegistryHandlerStub{},
+		AddressPubKeyConverter:  mock.NewPubkeyConverterMock(32),
+		GasPriceEstimator:       &mock.GasPriceEstimatorStub{},
 	}
 }
 
@@ -89,6 +98,61 @@ func TestNewNodeApiResolver_NilDelegatedListHandler(t *testing.T) {
 	assert.Equal(t, external.ErrNilDelegatedListHandler, err)
 }
 
+func TestNewNodeApiResolver_NilGovernanceInfoHandler(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockAgrs()
+	arg.GovernanceInfoHandler = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilGovernanceInfoHandler, err)
+}
+
+func TestNewNodeApiResolver_NilTokenPropertiesHandler(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockAgrs()
+	arg.TokenPropertiesHandler = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilTokenPropertiesHandler, err)
+}
+
+func TestNewNodeApiResolver_NilESDTRegistryHandler(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockAgrs()
+	arg.ESDTRegistryHandler = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilESDTRegistryHandler, err)
+}
+
+func TestNewNodeApiResolver_NilAddressPubKeyConverter(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockAgrs()
+	arg.AddressPubKeyConverter = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilAddressPubKeyConverter, err)
+}
+
+func TestNewNodeApiResolver_NilGasPriceEstimator(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockAgrs()
+	arg.GasPriceEstimator = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilGasPriceEstimator, err)
+}
+
 func TestNewNodeApiResolver_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -230,6 +294,113 @@ func TestNodeApiResolver_GetDelegatorsList(t *testing.T) {
 	assert.True(t, wasCalled)
 }
 
+func TestNodeApiResolver_GetGovernanceInfo(t *testing.T) {
+	t.Parallel()
+
+	wasCalled := false
+	arg := createMockAgrs()
+	info := &trieIteratorsData.GovernanceInfo{
+		Config: &trieIteratorsData.GovernanceConfig{MinQuorum: "500"},
+	}
+	arg.GovernanceInfoHandler = &mock.GovernanceInfoProcessorStub{
+		GetGovernanceInfoCalled: func() (*trieIteratorsData.GovernanceInfo, error) {
+			wasCalled = true
+			return info, nil
+		},
+	}
+
+	nar, _ := external.NewNodeApiResolver(arg)
+	recoveredInfo, err := nar.GetGovernanceInfo()
+	assert.Nil(t, err)
+	assert.True(t, recoveredInfo == info) //pointer testing
+	assert.True(t, wasCalled)
+}
+
+func TestNodeApiResolver_GetTokenInfoNotAvailableShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nar, _ := external.NewNodeApiResolver(createMockAgrs())
+	tokenInfo, err := nar.GetTokenInfo("TKN-abcdef")
+	assert.Nil(t, tokenInfo)
+	assert.Equal(t, external.ErrTokenInfoNotAvailable, err)
+}
+
+func TestNodeApiResolver_GetTokenInfoFromMetachainShouldReturnProperties(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockAgrs()
+	properties := &trieIteratorsData.TokenProperties{Name: "Token", NumDecimals: 18}
+	specialRoles := []*trieIteratorsData.TokenSpecialRoles{{Address: "erd1alice", Roles: []string{"ESDTRoleLocalMint"}}}
+	arg.TokenPropertiesHandler = &mock.TokenPropertiesProcessorStub{
+		GetTokenPropertiesCalled: func(token string) (*trieIteratorsData.TokenProperties, error) {
+			return properties, nil
+		},
+		GetTokenSpecialRolesCalled: func(token string) ([]*trieIteratorsData.TokenSpecialRoles, error) {
+			return specialRoles, nil
+		},
+	}
+
+	nar, _ := external.NewNodeApiResolver(arg)
+	tokenInfo, err := nar.GetTokenInfo("TKN-abcdef")
+	assert.Nil(t, err)
+	assert.Equal(t, "TKN-abcdef", tokenInfo.Identifier)
+	assert.True(t, tokenInfo.Properties == properties) //pointer testing
+	assert.Equal(t, specialRoles, tokenInfo.SpecialRoles)
+	assert.Nil(t, tokenInfo.Supply)
+}
+
+func TestNodeApiResolver_GetTokenInfoFromShardShouldReturnSupply(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockAgrs()
+	arg.ESDTRegistryHandler = &mock.ESDTRegistryHandlerStub{
+		GetESDTSupplyCalled: func(token string) (*esdtRegistry.TokenSupply, error) {
+			return &esdtRegistry.TokenSupply{
+				Supply:     big.NewInt(90),
+				Minted:     big.NewInt(100),
+				Burned:     big.NewInt(10),
+				NumHolders: 3,
+			}, nil
+		},
+	}
+
+	nar, _ := external.NewNodeApiResolver(arg)
+	tokenInfo, err := nar.GetTokenInfo("TKN-abcdef")
+	assert.Nil(t, err)
+	assert.Nil(t, tokenInfo.Properties)
+	expectedSupply := &trieIteratorsData.TokenSupply{
+		Supply:     "90",
+		Minted:     "100",
+		Burned:     "10",
+		NumHolders: 3,
+	}
+	assert.Equal(t, expectedSupply, tokenInfo.Supply)
+}
+
+func TestNodeApiResolver_GetTokenHolders(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockAgrs()
+	arg.ESDTRegistryHandler = &mock.ESDTRegistryHandlerStub{
+		GetESDTHoldersCalled: func(token string, offset uint64, limit uint64) ([]*esdtRegistry.TokenHolder, error) {
+			assert.Equal(t, uint64(10), offset)
+			assert.Equal(t, uint64(5), limit)
+
+			return []*esdtRegistry.TokenHolder{
+				{Address: []byte("alice"), Balance: big.NewInt(60)},
+			}, nil
+		},
+	}
+
+	nar, _ := external.NewNodeApiResolver(arg)
+	holders, err := nar.GetTokenHolders("TKN-abcdef", 10, 5)
+	assert.Nil(t, err)
+	expectedHolders := []*trieIteratorsData.TokenHolder{
+		{Address: arg.AddressPubKeyConverter.Encode([]byte("alice")), Balance: "60"},
+	}
+	assert.Equal(t, expectedHolders, holders)
+}
+
 func TestNodeApiResolver_GetDirectStakedList(t *testing.T) {
 	t.Parallel()
 
@@ -249,3 +420,24 @@ func TestNodeApiResolver_GetDirectStakedList(t *testing.T) {
 	assert.Equal(t, recoveredDirectStakedValueList, directStakedValueList)
 	assert.True(t, wasCalled)
 }
+
+func TestNodeApiResolver_GetGasPriceEstimates(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockAgrs()
+	estimates := &gasPriceData.GasPriceEstimates{
+		Slow:   1,
+		Normal: 2,
+		Fast:   3,
+	}
+	arg.GasPriceEstimator = &mock.GasPriceEstimatorStub{
+		GetGasPriceEstimatesCalled: func() *gasPriceData.GasPriceEstimates {
+			return estimates
+		},
+	}
+	nar, _ := external.NewNodeApiResolver(arg)
+
+	res, err := nar.GetGasPriceEstimates()
+	assert.Nil(t, err)
+	assert.Equal(t, estimates, res)
+}
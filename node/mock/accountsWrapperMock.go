@@ -98,6 +98,11 @@ func (awm *AccountWrapMock) SetCode(code []byte) {
 	awm.code = code
 }
 
+// GetCode -
+func (awm *AccountWrapMock) GetCode() []byte {
+	return awm.code
+}
+
 // SetCodeMetadata -
 func (awm *AccountWrapMock) SetCodeMetadata(codeMetadata []byte) {
 	awm.codeMetadata = codeMetadata
@@ -0,0 +1,32 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/dblookupext/esdtRegistry"
+
+// ESDTRegistryHandlerStub -
+type ESDTRegistryHandlerStub struct {
+	GetESDTSupplyCalled  func(token string) (*esdtRegistry.TokenSupply, error)
+	GetESDTHoldersCalled func(token string, offset uint64, limit uint64) ([]*esdtRegistry.TokenHolder, error)
+}
+
+// GetESDTSupply -
+func (erhs *ESDTRegistryHandlerStub) GetESDTSupply(token string) (*esdtRegistry.TokenSupply, error) {
+	if erhs.GetESDTSupplyCalled != nil {
+		return erhs.GetESDTSupplyCalled(token)
+	}
+
+	return nil, nil
+}
+
+// GetESDTHolders -
+func (erhs *ESDTRegistryHandlerStub) GetESDTHolders(token string, offset uint64, limit uint64) ([]*esdtRegistry.TokenHolder, error) {
+	if erhs.GetESDTHoldersCalled != nil {
+		return erhs.GetESDTHoldersCalled(token, offset, limit)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (erhs *ESDTRegistryHandlerStub) IsInterfaceNil() bool {
+	return erhs == nil
+}
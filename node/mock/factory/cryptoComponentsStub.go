@@ -4,6 +4,7 @@ import (
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/vm"
 )
 
@@ -21,6 +22,8 @@ type CryptoComponentsMock struct {
 	BlKeyGen        crypto.KeyGenerator
 	TxKeyGen        crypto.KeyGenerator
 	MsgSigVerifier  vm.MessageSignVerifier
+	KeysHolder      consensus.KeysHandler
+	SignBackend     consensus.SignerBackend
 	mutMultiSig     sync.RWMutex
 }
 
@@ -114,6 +117,16 @@ func (ccm *CryptoComponentsMock) MessageSignVerifier() vm.MessageSignVerifier {
 	return ccm.MsgSigVerifier
 }
 
+// KeysHandler -
+func (ccm *CryptoComponentsMock) KeysHandler() consensus.KeysHandler {
+	return ccm.KeysHolder
+}
+
+// SignerBackend -
+func (ccm *CryptoComponentsMock) SignerBackend() consensus.SignerBackend {
+	return ccm.SignBackend
+}
+
 // Clone -
 func (ccm *CryptoComponentsMock) Clone() interface{} {
 	return &CryptoComponentsMock{
@@ -129,6 +142,8 @@ func (ccm *CryptoComponentsMock) Clone() interface{} {
 		BlKeyGen:        ccm.BlKeyGen,
 		TxKeyGen:        ccm.TxKeyGen,
 		MsgSigVerifier:  ccm.MsgSigVerifier,
+		KeysHolder:      ccm.KeysHolder,
+		SignBackend:     ccm.SignBackend,
 		mutMultiSig:     sync.RWMutex{},
 	}
 }
@@ -0,0 +1,22 @@
+package mock
+
+import gasPriceData "github.com/ElrondNetwork/elrond-go/process/gasprice/data"
+
+// GasPriceEstimatorStub -
+type GasPriceEstimatorStub struct {
+	GetGasPriceEstimatesCalled func() *gasPriceData.GasPriceEstimates
+}
+
+// GetGasPriceEstimates -
+func (gpes *GasPriceEstimatorStub) GetGasPriceEstimates() *gasPriceData.GasPriceEstimates {
+	if gpes.GetGasPriceEstimatesCalled != nil {
+		return gpes.GetGasPriceEstimatesCalled()
+	}
+
+	return &gasPriceData.GasPriceEstimates{}
+}
+
+// IsInterfaceNil -
+func (gpes *GasPriceEstimatorStub) IsInterfaceNil() bool {
+	return gpes == nil
+}
@@ -0,0 +1,22 @@
+package mock
+
+import governanceData "github.com/ElrondNetwork/elrond-go/node/trieIterators/data"
+
+// GovernanceInfoProcessorStub -
+type GovernanceInfoProcessorStub struct {
+	GetGovernanceInfoCalled func() (*governanceData.GovernanceInfo, error)
+}
+
+// GetGovernanceInfo -
+func (gips *GovernanceInfoProcessorStub) GetGovernanceInfo() (*governanceData.GovernanceInfo, error) {
+	if gips.GetGovernanceInfoCalled != nil {
+		return gips.GetGovernanceInfoCalled()
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (gips *GovernanceInfoProcessorStub) IsInterfaceNil() bool {
+	return gips == nil
+}
@@ -3,12 +3,14 @@ package mock
 import (
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
 
 // SCQueryServiceStub -
 type SCQueryServiceStub struct {
 	ExecuteQueryCalled           func(*process.SCQuery) (*vmcommon.VMOutput, error)
+	ExecuteQueryWithTraceCalled  func(query *process.SCQuery) (*vmcommon.VMOutput, *tracing.ExecutionTrace, error)
 	ComputeScCallGasLimitHandler func(tx *transaction.Transaction) (uint64, error)
 	CloseCalled                  func() error
 }
@@ -23,6 +25,11 @@ func (serviceStub *SCQueryServiceStub) ComputeScCallGasLimit(tx *transaction.Tra
 	return serviceStub.ComputeScCallGasLimitHandler(tx)
 }
 
+// ExecuteQueryWithTrace -
+func (serviceStub *SCQueryServiceStub) ExecuteQueryWithTrace(query *process.SCQuery) (*vmcommon.VMOutput, *tracing.ExecutionTrace, error) {
+	return serviceStub.ExecuteQueryWithTraceCalled(query)
+}
+
 // Close -
 func (serviceStub *SCQueryServiceStub) Close() error {
 	if serviceStub.CloseCalled != nil {
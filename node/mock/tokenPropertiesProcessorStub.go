@@ -0,0 +1,32 @@
+package mock
+
+import trieIteratorsData "github.com/ElrondNetwork/elrond-go/node/trieIterators/data"
+
+// TokenPropertiesProcessorStub -
+type TokenPropertiesProcessorStub struct {
+	GetTokenPropertiesCalled   func(token string) (*trieIteratorsData.TokenProperties, error)
+	GetTokenSpecialRolesCalled func(token string) ([]*trieIteratorsData.TokenSpecialRoles, error)
+}
+
+// GetTokenProperties -
+func (tpps *TokenPropertiesProcessorStub) GetTokenProperties(token string) (*trieIteratorsData.TokenProperties, error) {
+	if tpps.GetTokenPropertiesCalled != nil {
+		return tpps.GetTokenPropertiesCalled(token)
+	}
+
+	return nil, nil
+}
+
+// GetTokenSpecialRoles -
+func (tpps *TokenPropertiesProcessorStub) GetTokenSpecialRoles(token string) ([]*trieIteratorsData.TokenSpecialRoles, error) {
+	if tpps.GetTokenSpecialRolesCalled != nil {
+		return tpps.GetTokenSpecialRolesCalled(token)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (tpps *TokenPropertiesProcessorStub) IsInterfaceNil() bool {
+	return tpps == nil
+}
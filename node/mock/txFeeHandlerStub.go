@@ -34,6 +34,10 @@ type EconomicsHandlerStub struct {
 	ComputeGasUsedAndFeeBasedOnRefundValueCalled func(tx data.TransactionWithFeeHandler, refundValue *big.Int) (uint64, *big.Int)
 	ComputeTxFeeBasedOnGasUsedCalled             func(tx data.TransactionWithFeeHandler, gasUsed uint64) *big.Int
 	ComputeGasLimitBasedOnBalanceCalled          func(tx data.TransactionWithFeeHandler, balance *big.Int) (uint64, error)
+	IsDynamicMinGasPriceEnabledCalled            func() bool
+	ComputeDynamicMinGasPriceCalled              func(previousPrice uint64, gasUsed uint64) uint64
+	SetDynamicMinGasPriceCalled                  func(price uint64)
+	DynamicMinGasPriceCalled                     func() uint64
 }
 
 // ComputeGasLimitBasedOnBalance -
@@ -230,6 +234,37 @@ func (ehs *EconomicsHandlerStub) ComputeTxFeeBasedOnGasUsed(tx data.TransactionW
 	return big.NewInt(0)
 }
 
+// IsDynamicMinGasPriceEnabled -
+func (ehs *EconomicsHandlerStub) IsDynamicMinGasPriceEnabled() bool {
+	if ehs.IsDynamicMinGasPriceEnabledCalled != nil {
+		return ehs.IsDynamicMinGasPriceEnabledCalled()
+	}
+	return false
+}
+
+// ComputeDynamicMinGasPrice -
+func (ehs *EconomicsHandlerStub) ComputeDynamicMinGasPrice(previousPrice uint64, gasUsed uint64) uint64 {
+	if ehs.ComputeDynamicMinGasPriceCalled != nil {
+		return ehs.ComputeDynamicMinGasPriceCalled(previousPrice, gasUsed)
+	}
+	return 0
+}
+
+// SetDynamicMinGasPrice -
+func (ehs *EconomicsHandlerStub) SetDynamicMinGasPrice(price uint64) {
+	if ehs.SetDynamicMinGasPriceCalled != nil {
+		ehs.SetDynamicMinGasPriceCalled(price)
+	}
+}
+
+// DynamicMinGasPrice -
+func (ehs *EconomicsHandlerStub) DynamicMinGasPrice() uint64 {
+	if ehs.DynamicMinGasPriceCalled != nil {
+		return ehs.DynamicMinGasPriceCalled()
+	}
+	return 0
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ehs *EconomicsHandlerStub) IsInterfaceNil() bool {
 	return ehs == nil
@@ -34,6 +34,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/dataValidators"
 	"github.com/ElrondNetwork/elrond-go/process/factory"
+	"github.com/ElrondNetwork/elrond-go/process/guardian"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	procTx "github.com/ElrondNetwork/elrond-go/process/transaction"
 	"github.com/ElrondNetwork/elrond-go/state"
@@ -774,11 +775,17 @@ func (n *Node) commonTransactionValidation(
 	whiteListRequest process.WhiteListHandler,
 	checkSignature bool,
 ) (process.TxValidator, process.TxValidatorHandler, error) {
+	guardedAccounts, err := guardian.NewGuardedAccount(n.coreComponents.InternalMarshalizer(), n.coreComponents.EpochNotifier())
+	if err != nil {
+		return nil, nil, err
+	}
+
 	txValidator, err := dataValidators.NewTxValidator(
 		n.stateComponents.AccountsAdapter(),
 		n.processComponents.ShardCoordinator(),
 		whiteListRequest,
 		n.coreComponents.AddressPubKeyConverter(),
+		guardedAccounts,
 		common.MaxTxNonceDeltaAllowed,
 	)
 	if err != nil {
@@ -1138,6 +1145,33 @@ func (n *Node) GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error) {
 	return peerInfoSlice, nil
 }
 
+// GetConsensusRounds returns the traces of the latest consensus rounds
+func (n *Node) GetConsensusRounds() ([]consensus.RoundTrace, error) {
+	if check.IfNil(n.consensusComponents) || check.IfNil(n.consensusComponents.RoundTracer()) {
+		return nil, ErrNilRoundTracer
+	}
+
+	return n.consensusComponents.RoundTracer().RecentRounds(), nil
+}
+
+// GetSlashingEvidences returns the collected evidences of validators that signed conflicting data
+func (n *Node) GetSlashingEvidences() ([]*consensus.SlashingEvidence, error) {
+	if check.IfNil(n.consensusComponents) || check.IfNil(n.consensusComponents.SlashingDetector()) {
+		return nil, ErrNilSlashingDetector
+	}
+
+	return n.consensusComponents.SlashingDetector().Evidences(), nil
+}
+
+// GetManagedKeysMetrics returns the activity counters of the validator keys managed by the current node
+func (n *Node) GetManagedKeysMetrics() ([]*consensus.ManagedKeyMetrics, error) {
+	if check.IfNil(n.cryptoComponents) || check.IfNil(n.cryptoComponents.KeysHandler()) {
+		return nil, ErrNilKeysHandler
+	}
+
+	return n.cryptoComponents.KeysHandler().ManagedKeysMetrics(), nil
+}
+
 // GetHardforkTrigger returns the hardfork trigger
 func (n *Node) GetHardforkTrigger() HardforkTrigger {
 	return n.hardforkTrigger
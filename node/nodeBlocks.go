@@ -35,6 +35,56 @@ func (n *Node) GetBlockByNonce(nonce uint64, withTxs bool) (*api.Block, error) {
 	return apiBlockProcessor.GetBlockByNonce(nonce, withTxs)
 }
 
+// GetRawBlockByHash returns the marshalized block for a given hash
+func (n *Node) GetRawBlockByHash(hash string) ([]byte, error) {
+	decodedHash, err := hex.DecodeString(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	apiBlockProcessor, err := n.createAPIBlockProcessor()
+	if err != nil {
+		return nil, err
+	}
+
+	return apiBlockProcessor.GetRawBlockByHash(decodedHash)
+}
+
+// GetRawBlockByNonce returns the marshalized block for a given nonce
+func (n *Node) GetRawBlockByNonce(nonce uint64) ([]byte, error) {
+	apiBlockProcessor, err := n.createAPIBlockProcessor()
+	if err != nil {
+		return nil, err
+	}
+
+	return apiBlockProcessor.GetRawBlockByNonce(nonce)
+}
+
+// GetRawEpochStartMetaBlock returns the marshalized epoch start meta block of the given epoch
+func (n *Node) GetRawEpochStartMetaBlock(epoch uint32) ([]byte, error) {
+	apiBlockProcessor, err := n.createAPIBlockProcessor()
+	if err != nil {
+		return nil, err
+	}
+
+	return apiBlockProcessor.GetRawEpochStartMetaBlock(epoch)
+}
+
+// GetRawMiniBlockByHash returns the marshalized miniblock for a given hash, saved in the given epoch
+func (n *Node) GetRawMiniBlockByHash(hash string, epoch uint32) ([]byte, error) {
+	decodedHash, err := hex.DecodeString(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	apiBlockProcessor, err := n.createAPIBlockProcessor()
+	if err != nil {
+		return nil, err
+	}
+
+	return apiBlockProcessor.GetRawMiniBlockByHash(decodedHash, epoch)
+}
+
 func (n *Node) createAPIBlockProcessor() (blockAPI.APIBlockHandler, error) {
 	statusComputer, err := txstatus.NewStatusComputer(n.processComponents.ShardCoordinator().SelfId(), n.coreComponents.Uint64ByteSliceConverter(), n.dataComponents.StorageService())
 	if err != nil {
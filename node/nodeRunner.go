@@ -33,6 +33,7 @@ import (
 	mainFactory "github.com/ElrondNetwork/elrond-go/factory"
 	"github.com/ElrondNetwork/elrond-go/genesis/parsing"
 	"github.com/ElrondNetwork/elrond-go/health"
+	"github.com/ElrondNetwork/elrond-go/keystore"
 	"github.com/ElrondNetwork/elrond-go/outport"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
@@ -146,6 +147,12 @@ func printEnableEpochs(configs *config.Configs) {
 	log.Debug(readEpochFor("contract global mint and burn"), "epoch", enableEpochs.GlobalMintBurnDisableEpoch)
 	log.Debug(readEpochFor("contract transfer role"), "epoch", enableEpochs.ESDTTransferRoleEnableEpoch)
 	log.Debug(readEpochFor("built in functions on metachain"), "epoch", enableEpochs.BuiltInFunctionOnMetaEnableEpoch)
+	log.Debug(readEpochFor("governed config"), "epoch", enableEpochs.GovernedConfigEnableEpoch)
+	log.Debug(readEpochFor("governance v2"), "epoch", enableEpochs.GovernanceV2EnableEpoch)
+	log.Debug(readEpochFor("multisig system smart contract"), "epoch", enableEpochs.MultisigSCEnableEpoch)
+	log.Debug(readEpochFor("delegation liquid staking"), "epoch", enableEpochs.DelegationLiquidStakingEnableEpoch)
+	log.Debug(readEpochFor("scheduled calls"), "epoch", enableEpochs.ScheduledCallsEnableEpoch)
+	log.Debug(readEpochFor("guardians"), "epoch", enableEpochs.GuardiansEnableEpoch)
 
 	gasSchedule := configs.EpochConfig.GasSchedule
 
@@ -457,6 +464,7 @@ func (nr *nodeRunner) createApiFacade(
 		Node:                   currentNode,
 		ApiResolver:            apiResolver,
 		TxSimulatorProcessor:   currentNode.processComponents.TransactionSimulatorProcessor(),
+		TxBundleSimulator:      currentNode.processComponents.TransactionBundleSimulator(),
 		RestAPIServerDebugMode: flagsConfig.EnableRestAPIServerDebugMode,
 		WsAntifloodConfig:      configs.GeneralConfig.Antiflood.WebServer,
 		FacadeConfig: config.FacadeConfig{
@@ -548,6 +556,7 @@ func (nr *nodeRunner) createMetrics(
 	metrics.SaveStringMetric(managedCoreComponents.StatusHandler(), common.MetricChainId, managedCoreComponents.ChainID())
 	metrics.SaveUint64Metric(managedCoreComponents.StatusHandler(), common.MetricGasPerDataByte, managedCoreComponents.EconomicsData().GasPerDataByte())
 	metrics.SaveUint64Metric(managedCoreComponents.StatusHandler(), common.MetricMinGasPrice, managedCoreComponents.EconomicsData().MinGasPrice())
+	metrics.SaveUint64Metric(managedCoreComponents.StatusHandler(), common.MetricDynamicMinGasPrice, managedCoreComponents.EconomicsData().DynamicMinGasPrice())
 	metrics.SaveUint64Metric(managedCoreComponents.StatusHandler(), common.MetricMinGasLimit, managedCoreComponents.EconomicsData().MinGasLimit())
 	metrics.SaveStringMetric(managedCoreComponents.StatusHandler(), common.MetricRewardsTopUpGradientPoint, managedCoreComponents.EconomicsData().RewardsTopUpGradientPoint().String())
 	metrics.SaveStringMetric(managedCoreComponents.StatusHandler(), common.MetricTopUpFactor, fmt.Sprintf("%g", managedCoreComponents.EconomicsData().RewardsTopUpFactor()))
@@ -896,11 +905,12 @@ func (nr *nodeRunner) CreateManagedProcessComponents(
 	}
 
 	historyRepoFactoryArgs := &dbLookupFactory.ArgsHistoryRepositoryFactory{
-		SelfShardID: managedBootstrapComponents.ShardCoordinator().SelfId(),
-		Config:      configs.GeneralConfig.DbLookupExtensions,
-		Hasher:      managedCoreComponents.Hasher(),
-		Marshalizer: managedCoreComponents.InternalMarshalizer(),
-		Store:       managedDataComponents.StorageService(),
+		SelfShardID:      managedBootstrapComponents.ShardCoordinator().SelfId(),
+		Config:           configs.GeneralConfig.DbLookupExtensions,
+		Hasher:           managedCoreComponents.Hasher(),
+		Marshalizer:      managedCoreComponents.InternalMarshalizer(),
+		Store:            managedDataComponents.StorageService(),
+		ShardCoordinator: managedBootstrapComponents.ShardCoordinator(),
 	}
 	historyRepositoryFactory, err := dbLookupFactory.NewHistoryRepositoryFactory(historyRepoFactoryArgs)
 	if err != nil {
@@ -1177,13 +1187,24 @@ func (nr *nodeRunner) CreateManagedCryptoComponents(
 ) (mainFactory.CryptoComponentsHandler, error) {
 	configs := nr.configs
 	validatorKeyPemFileName := configs.ConfigurationPathsHolder.ValidatorKey
+	keyLoader, err := keystore.NewKeyLoader(keystore.ArgsKeyLoader{
+		PassphraseProvider: keystore.NewPassphraseProvider(keystore.ArgsPassphraseProvider{
+			EnvVariable:    keystore.EnvPassphraseVariable,
+			PassphraseFile: configs.ConfigurationPathsHolder.KeystorePassphraseFile,
+		}),
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	cryptoComponentsHandlerArgs := mainFactory.CryptoComponentsFactoryArgs{
 		ValidatorKeyPemFileName:              validatorKeyPemFileName,
+		AllValidatorKeysPemFileName:          configs.ConfigurationPathsHolder.AllValidatorKeys,
 		SkIndex:                              configs.FlagsConfig.ValidatorKeyIndex,
 		Config:                               *configs.GeneralConfig,
 		CoreComponentsHolder:                 managedCoreComponents,
 		ActivateBLSPubKeyMessageVerification: configs.SystemSCConfig.StakingSystemSCConfig.ActivateBLSPubKeyMessageVerification,
-		KeyLoader:                            &core.KeyLoader{},
+		KeyLoader:                            keyLoader,
 		ImportModeNoSigCheck:                 configs.ImportDbConfig.ImportDbNoSigCheckFlag,
 		IsInImportMode:                       configs.ImportDbConfig.IsImportDBMode,
 	}
@@ -3088,6 +3088,39 @@ func TestNode_GetQueryHandlerShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestNode_GetConsensusRoundsWithoutConsensusComponentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode()
+
+	rounds, err := n.GetConsensusRounds()
+
+	assert.Nil(t, rounds)
+	assert.Equal(t, node.ErrNilRoundTracer, err)
+}
+
+func TestNode_GetSlashingEvidencesWithoutConsensusComponentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode()
+
+	evidences, err := n.GetSlashingEvidences()
+
+	assert.Nil(t, evidences)
+	assert.Equal(t, node.ErrNilSlashingDetector, err)
+}
+
+func TestNode_GetManagedKeysMetricsWithoutCryptoComponentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode()
+
+	metrics, err := n.GetManagedKeysMetrics()
+
+	assert.Nil(t, metrics)
+	assert.Equal(t, node.ErrNilKeysHandler, err)
+}
+
 func TestNode_GetPeerInfoUnknownPeerShouldErr(t *testing.T) {
 	t.Parallel()
 
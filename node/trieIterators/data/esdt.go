@@ -0,0 +1,53 @@
+package data
+
+// TokenProperties holds the properties of an ESDT token, as stored by the ESDT system smart contract
+type TokenProperties struct {
+	Name                     string `json:"name"`
+	Type                     string `json:"type"`
+	Owner                    string `json:"owner"`
+	Minted                   string `json:"minted"`
+	Burned                   string `json:"burned"`
+	NumDecimals              uint64 `json:"numDecimals"`
+	IsPaused                 bool   `json:"isPaused"`
+	CanUpgrade               bool   `json:"canUpgrade"`
+	CanMint                  bool   `json:"canMint"`
+	CanBurn                  bool   `json:"canBurn"`
+	CanChangeOwner           bool   `json:"canChangeOwner"`
+	CanPause                 bool   `json:"canPause"`
+	CanFreeze                bool   `json:"canFreeze"`
+	CanWipe                  bool   `json:"canWipe"`
+	CanAddSpecialRoles       bool   `json:"canAddSpecialRoles"`
+	CanTransferNFTCreateRole bool   `json:"canTransferNFTCreateRole"`
+	NFTCreateStopped         bool   `json:"nftCreateStopped"`
+	NumWiped                 uint64 `json:"numWiped"`
+}
+
+// TokenSpecialRoles holds the special roles an address has for an ESDT token
+type TokenSpecialRoles struct {
+	Address string   `json:"address"`
+	Roles   []string `json:"roles"`
+}
+
+// TokenSupply holds the supply of an ESDT token held by the accounts of a shard, together with the number of holders
+type TokenSupply struct {
+	Supply     string `json:"supply"`
+	Minted     string `json:"minted"`
+	Burned     string `json:"burned"`
+	NumHolders uint64 `json:"numHolders"`
+}
+
+// TokenInfo is the data transfer object which holds the information a node can return about an ESDT token. The
+// properties and the special roles are only returned by metachain nodes, while the supply is only returned by shard
+// nodes which have the ESDT registry enabled
+type TokenInfo struct {
+	Identifier   string               `json:"identifier"`
+	Properties   *TokenProperties     `json:"properties,omitempty"`
+	SpecialRoles []*TokenSpecialRoles `json:"specialRoles,omitempty"`
+	Supply       *TokenSupply         `json:"supply,omitempty"`
+}
+
+// TokenHolder holds an address which holds an ESDT token and its balance
+type TokenHolder struct {
+	Address string `json:"address"`
+	Balance string `json:"balance"`
+}
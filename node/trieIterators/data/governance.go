@@ -0,0 +1,30 @@
+package data
+
+// GovernanceConfig holds the configuration used by the governance system smart contract when tallying the proposals
+type GovernanceConfig struct {
+	MinQuorum        string `json:"minQuorum"`
+	MinPassThreshold string `json:"minPassThreshold"`
+	MinVetoThreshold string `json:"minVetoThreshold"`
+	ProposalFee      string `json:"proposalFee"`
+}
+
+// GovernanceProposal holds a governance proposal together with its tally. The outcome of an open proposal is the one
+// it would have if it was closed at the current state
+type GovernanceProposal struct {
+	Issuer         string `json:"issuer"`
+	CommitHash     string `json:"commitHash"`
+	StartVoteNonce uint64 `json:"startVoteNonce"`
+	EndVoteNonce   uint64 `json:"endVoteNonce"`
+	Yes            string `json:"yes"`
+	No             string `json:"no"`
+	Veto           string `json:"veto"`
+	NumVoters      uint64 `json:"numVoters"`
+	Closed         bool   `json:"closed"`
+	Outcome        string `json:"outcome"`
+}
+
+// GovernanceInfo is the data transfer object which holds the governance configuration and all the proposals
+type GovernanceInfo struct {
+	Config    *GovernanceConfig     `json:"config"`
+	Proposals []*GovernanceProposal `json:"proposals"`
+}
@@ -0,0 +1,26 @@
+package disabled
+
+import (
+	"errors"
+
+	governanceData "github.com/ElrondNetwork/elrond-go/node/trieIterators/data"
+)
+
+var errCannotReturnGovernanceInfoFromShardNode = errors.New("governance info cannot be returned by a shard node")
+
+type governanceInfoProcessor struct{}
+
+// NewDisabledGovernanceInfoProcessor returns a disabled implementation to be used on shard nodes
+func NewDisabledGovernanceInfoProcessor() *governanceInfoProcessor {
+	return &governanceInfoProcessor{}
+}
+
+// GetGovernanceInfo returns the errCannotReturnGovernanceInfoFromShardNode error
+func (gip *governanceInfoProcessor) GetGovernanceInfo() (*governanceData.GovernanceInfo, error) {
+	return nil, errCannotReturnGovernanceInfoFromShardNode
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gip *governanceInfoProcessor) IsInterfaceNil() bool {
+	return gip == nil
+}
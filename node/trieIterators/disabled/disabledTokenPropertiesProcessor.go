@@ -0,0 +1,27 @@
+package disabled
+
+import (
+	esdtData "github.com/ElrondNetwork/elrond-go/node/trieIterators/data"
+)
+
+type tokenPropertiesProcessor struct{}
+
+// NewDisabledTokenPropertiesProcessor returns a disabled implementation to be used on shard nodes
+func NewDisabledTokenPropertiesProcessor() *tokenPropertiesProcessor {
+	return &tokenPropertiesProcessor{}
+}
+
+// GetTokenProperties returns nil as the token properties are only available on metachain nodes
+func (tpp *tokenPropertiesProcessor) GetTokenProperties(_ string) (*esdtData.TokenProperties, error) {
+	return nil, nil
+}
+
+// GetTokenSpecialRoles returns nil as the token special roles are only available on metachain nodes
+func (tpp *tokenPropertiesProcessor) GetTokenSpecialRoles(_ string) ([]*esdtData.TokenSpecialRoles, error) {
+	return nil, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tpp *tokenPropertiesProcessor) IsInterfaceNil() bool {
+	return tpp == nil
+}
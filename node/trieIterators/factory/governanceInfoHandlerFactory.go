@@ -0,0 +1,17 @@
+package factory
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/node/trieIterators"
+	"github.com/ElrondNetwork/elrond-go/node/trieIterators/disabled"
+)
+
+// CreateGovernanceInfoHandler will create a new instance of GovernanceInfoHandler
+func CreateGovernanceInfoHandler(args trieIterators.ArgTrieIteratorProcessor) (external.GovernanceInfoHandler, error) {
+	if args.ShardID != core.MetachainShardId {
+		return disabled.NewDisabledGovernanceInfoProcessor(), nil
+	}
+
+	return trieIterators.NewGovernanceInfoProcessor(args)
+}
@@ -0,0 +1,45 @@
+package factory
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/node/trieIterators"
+	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateGovernanceInfoHandler_Disabled(t *testing.T) {
+	t.Parallel()
+
+	args := trieIterators.ArgTrieIteratorProcessor{
+		ShardID: 0,
+	}
+
+	governanceInfoHandler, err := CreateGovernanceInfoHandler(args)
+	require.Nil(t, err)
+	assert.Equal(t, "*disabled.governanceInfoProcessor", fmt.Sprintf("%T", governanceInfoHandler))
+}
+
+func TestCreateGovernanceInfoHandler_GovernanceInfoProcessor(t *testing.T) {
+	t.Parallel()
+
+	args := trieIterators.ArgTrieIteratorProcessor{
+		ShardID: core.MetachainShardId,
+		Accounts: &trieIterators.AccountsWrapper{
+			Mutex:           &sync.Mutex{},
+			AccountsAdapter: &stateMock.AccountsStub{},
+		},
+		PublicKeyConverter: &mock.PubkeyConverterMock{},
+		BlockChain:         &mock.BlockChainMock{},
+		QueryService:       &mock.SCQueryServiceStub{},
+	}
+
+	governanceInfoHandler, err := CreateGovernanceInfoHandler(args)
+	require.Nil(t, err)
+	assert.Equal(t, "*trieIterators.governanceInfoProcessor", fmt.Sprintf("%T", governanceInfoHandler))
+}
@@ -0,0 +1,17 @@
+package factory
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/node/trieIterators"
+	"github.com/ElrondNetwork/elrond-go/node/trieIterators/disabled"
+)
+
+// CreateTokenPropertiesHandler will create a new instance of TokenPropertiesHandler
+func CreateTokenPropertiesHandler(args trieIterators.ArgTrieIteratorProcessor) (external.TokenPropertiesHandler, error) {
+	if args.ShardID != core.MetachainShardId {
+		return disabled.NewDisabledTokenPropertiesProcessor(), nil
+	}
+
+	return trieIterators.NewTokenPropertiesProcessor(args)
+}
@@ -0,0 +1,45 @@
+package factory
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/node/trieIterators"
+	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateTokenPropertiesHandler_Disabled(t *testing.T) {
+	t.Parallel()
+
+	args := trieIterators.ArgTrieIteratorProcessor{
+		ShardID: 0,
+	}
+
+	tokenPropertiesHandler, err := CreateTokenPropertiesHandler(args)
+	require.Nil(t, err)
+	assert.Equal(t, "*disabled.tokenPropertiesProcessor", fmt.Sprintf("%T", tokenPropertiesHandler))
+}
+
+func TestCreateTokenPropertiesHandler_TokenPropertiesProcessor(t *testing.T) {
+	t.Parallel()
+
+	args := trieIterators.ArgTrieIteratorProcessor{
+		ShardID: core.MetachainShardId,
+		Accounts: &trieIterators.AccountsWrapper{
+			Mutex:           &sync.Mutex{},
+			AccountsAdapter: &stateMock.AccountsStub{},
+		},
+		PublicKeyConverter: &mock.PubkeyConverterMock{},
+		BlockChain:         &mock.BlockChainMock{},
+		QueryService:       &mock.SCQueryServiceStub{},
+	}
+
+	tokenPropertiesHandler, err := CreateTokenPropertiesHandler(args)
+	require.Nil(t, err)
+	assert.Equal(t, "*trieIterators.tokenPropertiesProcessor", fmt.Sprintf("%T", tokenPropertiesHandler))
+}
@@ -0,0 +1,135 @@
+package trieIterators
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	governanceData "github.com/ElrondNetwork/elrond-go/node/trieIterators/data"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+const numGovernanceConfigValues = 4
+const numGovernanceProposalValues = 10
+
+type governanceInfoProcessor struct {
+	queryService       process.SCQueryService
+	publicKeyConverter core.PubkeyConverter
+}
+
+// NewGovernanceInfoProcessor will create a new instance of governanceInfoProcessor
+func NewGovernanceInfoProcessor(arg ArgTrieIteratorProcessor) (*governanceInfoProcessor, error) {
+	err := checkArguments(arg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &governanceInfoProcessor{
+		queryService:       arg.QueryService,
+		publicKeyConverter: arg.PublicKeyConverter,
+	}, nil
+}
+
+// GetGovernanceInfo will return the governance configuration and the proposals, together with their tally. Only the
+// proposals created after the governance v2 activation are returned, as the older ones are not indexed by the contract
+func (gip *governanceInfoProcessor) GetGovernanceInfo() (*governanceData.GovernanceInfo, error) {
+	config, err := gip.getConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	references, err := gip.executeQuery("getProposals")
+	if err != nil {
+		return nil, err
+	}
+
+	proposals := make([]*governanceData.GovernanceProposal, 0, len(references))
+	for _, reference := range references {
+		proposal, errGet := gip.getProposal(reference)
+		if errGet != nil {
+			return nil, errGet
+		}
+
+		proposals = append(proposals, proposal)
+	}
+
+	return &governanceData.GovernanceInfo{
+		Config:    config,
+		Proposals: proposals,
+	}, nil
+}
+
+func (gip *governanceInfoProcessor) getConfig() (*governanceData.GovernanceConfig, error) {
+	returnData, err := gip.executeQuery("getConfig")
+	if err != nil {
+		return nil, err
+	}
+	if len(returnData) != numGovernanceConfigValues {
+		return nil, fmt.Errorf("%w, getConfig function should have returned %d values",
+			epochStart.ErrExecutingSystemScCode, numGovernanceConfigValues)
+	}
+
+	return &governanceData.GovernanceConfig{
+		MinQuorum:        big.NewInt(0).SetBytes(returnData[0]).String(),
+		MinPassThreshold: big.NewInt(0).SetBytes(returnData[1]).String(),
+		MinVetoThreshold: big.NewInt(0).SetBytes(returnData[2]).String(),
+		ProposalFee:      big.NewInt(0).SetBytes(returnData[3]).String(),
+	}, nil
+}
+
+func (gip *governanceInfoProcessor) getProposal(reference []byte) (*governanceData.GovernanceProposal, error) {
+	returnData, err := gip.executeQuery("getProposal", reference)
+	if err != nil {
+		return nil, err
+	}
+	if len(returnData) != numGovernanceProposalValues {
+		return nil, fmt.Errorf("%w, getProposal function should have returned %d values",
+			epochStart.ErrExecutingSystemScCode, numGovernanceProposalValues)
+	}
+
+	issuer := ""
+	if len(returnData[0]) == gip.publicKeyConverter.Len() {
+		issuer = gip.publicKeyConverter.Encode(returnData[0])
+	}
+
+	return &governanceData.GovernanceProposal{
+		Issuer:         issuer,
+		CommitHash:     string(returnData[1]),
+		StartVoteNonce: big.NewInt(0).SetBytes(returnData[2]).Uint64(),
+		EndVoteNonce:   big.NewInt(0).SetBytes(returnData[3]).Uint64(),
+		Yes:            big.NewInt(0).SetBytes(returnData[4]).String(),
+		No:             big.NewInt(0).SetBytes(returnData[5]).String(),
+		Veto:           big.NewInt(0).SetBytes(returnData[6]).String(),
+		NumVoters:      big.NewInt(0).SetBytes(returnData[7]).Uint64(),
+		Closed:         string(returnData[8]) == "true",
+		Outcome:        string(returnData[9]),
+	}, nil
+}
+
+func (gip *governanceInfoProcessor) executeQuery(function string, arguments ...[]byte) ([][]byte, error) {
+	scQuery := &process.SCQuery{
+		ScAddress:  vm.GovernanceSCAddress,
+		FuncName:   function,
+		CallerAddr: vm.GovernanceSCAddress,
+		CallValue:  big.NewInt(0),
+		Arguments:  arguments,
+	}
+
+	vmOutput, err := gip.queryService.ExecuteQuery(scQuery)
+	if err != nil {
+		return nil, err
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return nil, fmt.Errorf("%w, return code: %v, message: %s", epochStart.ErrExecutingSystemScCode, vmOutput.ReturnCode, vmOutput.ReturnMessage)
+	}
+
+	return vmOutput.ReturnData, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gip *governanceInfoProcessor) IsInterfaceNil() bool {
+	return gip == nil
+}
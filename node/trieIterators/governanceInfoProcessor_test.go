@@ -0,0 +1,162 @@
+package trieIterators
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	governanceData "github.com/ElrondNetwork/elrond-go/node/trieIterators/data"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGovernanceInfoProcessor(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.QueryService = nil
+	gip, err := NewGovernanceInfoProcessor(arg)
+	assert.True(t, check.IfNil(gip))
+	assert.Equal(t, ErrNilQueryService, err)
+
+	gip, err = NewGovernanceInfoProcessor(createMockArgs())
+	assert.False(t, check.IfNil(gip))
+	assert.Nil(t, err)
+}
+
+func TestGovernanceInfoProcessor_GetGovernanceInfoQueryErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	arg := createMockArgs()
+	arg.QueryService = &mock.SCQueryServiceStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			return nil, expectedErr
+		},
+	}
+	gip, _ := NewGovernanceInfoProcessor(arg)
+
+	info, err := gip.GetGovernanceInfo()
+	assert.Nil(t, info)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestGovernanceInfoProcessor_GetGovernanceInfoNotOkReturnCodeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.QueryService = &mock.SCQueryServiceStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			return &vmcommon.VMOutput{
+				ReturnCode:    vmcommon.UserError,
+				ReturnMessage: "Governance SC disabled",
+			}, nil
+		},
+	}
+	gip, _ := NewGovernanceInfoProcessor(arg)
+
+	info, err := gip.GetGovernanceInfo()
+	assert.Nil(t, info)
+	assert.True(t, errors.Is(err, epochStart.ErrExecutingSystemScCode))
+}
+
+func TestGovernanceInfoProcessor_GetGovernanceInfoWrongNumberOfValuesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.QueryService = &mock.SCQueryServiceStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			return &vmcommon.VMOutput{
+				ReturnCode: vmcommon.Ok,
+				ReturnData: [][]byte{[]byte("value")},
+			}, nil
+		},
+	}
+	gip, _ := NewGovernanceInfoProcessor(arg)
+
+	info, err := gip.GetGovernanceInfo()
+	assert.Nil(t, info)
+	assert.True(t, errors.Is(err, epochStart.ErrExecutingSystemScCode))
+}
+
+func TestGovernanceInfoProcessor_GetGovernanceInfoShouldWork(t *testing.T) {
+	t.Parallel()
+
+	issuer := bytes.Repeat([]byte("i"), 32)
+	reference := bytes.Repeat([]byte("a"), 40)
+	arg := createMockArgs()
+	arg.PublicKeyConverter = mock.NewPubkeyConverterMock(32)
+	arg.QueryService = &mock.SCQueryServiceStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			require.Equal(t, vm.GovernanceSCAddress, query.ScAddress)
+
+			returnData := make([][]byte, 0)
+			switch query.FuncName {
+			case "getConfig":
+				returnData = [][]byte{
+					big.NewInt(500).Bytes(),
+					big.NewInt(251).Bytes(),
+					big.NewInt(249).Bytes(),
+					big.NewInt(1000).Bytes(),
+				}
+			case "getProposals":
+				returnData = [][]byte{reference}
+			case "getProposal":
+				require.Equal(t, [][]byte{reference}, query.Arguments)
+				returnData = [][]byte{
+					issuer,
+					reference,
+					big.NewInt(10).Bytes(),
+					big.NewInt(20).Bytes(),
+					big.NewInt(600).Bytes(),
+					big.NewInt(100).Bytes(),
+					big.NewInt(0).Bytes(),
+					big.NewInt(3).Bytes(),
+					[]byte("false"),
+					[]byte("passed"),
+				}
+			}
+
+			return &vmcommon.VMOutput{
+				ReturnCode: vmcommon.Ok,
+				ReturnData: returnData,
+			}, nil
+		},
+	}
+	gip, _ := NewGovernanceInfoProcessor(arg)
+
+	info, err := gip.GetGovernanceInfo()
+	require.Nil(t, err)
+
+	expectedInfo := &governanceData.GovernanceInfo{
+		Config: &governanceData.GovernanceConfig{
+			MinQuorum:        "500",
+			MinPassThreshold: "251",
+			MinVetoThreshold: "249",
+			ProposalFee:      "1000",
+		},
+		Proposals: []*governanceData.GovernanceProposal{
+			{
+				Issuer:         hex.EncodeToString(issuer),
+				CommitHash:     string(reference),
+				StartVoteNonce: 10,
+				EndVoteNonce:   20,
+				Yes:            "600",
+				No:             "100",
+				Veto:           "0",
+				NumVoters:      3,
+				Closed:         false,
+				Outcome:        "passed",
+			},
+		},
+	}
+	assert.Equal(t, expectedInfo, info)
+}
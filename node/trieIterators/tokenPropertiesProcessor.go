@@ -0,0 +1,143 @@
+package trieIterators
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	esdtData "github.com/ElrondNetwork/elrond-go/node/trieIterators/data"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+const numTokenPropertiesPositionalValues = 5
+const numTokenPropertiesValues = 18
+const tokenPropertySeparator = "-"
+const specialRoleAddressSeparator = ":"
+const specialRolesSeparator = ","
+
+type tokenPropertiesProcessor struct {
+	queryService       process.SCQueryService
+	publicKeyConverter core.PubkeyConverter
+}
+
+// NewTokenPropertiesProcessor will create a new instance of tokenPropertiesProcessor
+func NewTokenPropertiesProcessor(arg ArgTrieIteratorProcessor) (*tokenPropertiesProcessor, error) {
+	err := checkArguments(arg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tokenPropertiesProcessor{
+		queryService:       arg.QueryService,
+		publicKeyConverter: arg.PublicKeyConverter,
+	}, nil
+}
+
+// GetTokenProperties will return the properties of the provided token, as stored by the ESDT system smart contract
+func (tpp *tokenPropertiesProcessor) GetTokenProperties(token string) (*esdtData.TokenProperties, error) {
+	returnData, err := tpp.executeQuery("getTokenProperties", []byte(token))
+	if err != nil {
+		return nil, err
+	}
+	if len(returnData) != numTokenPropertiesValues {
+		return nil, fmt.Errorf("%w, getTokenProperties function should have returned %d values",
+			epochStart.ErrExecutingSystemScCode, numTokenPropertiesValues)
+	}
+
+	owner := ""
+	if len(returnData[2]) == tpp.publicKeyConverter.Len() {
+		owner = tpp.publicKeyConverter.Encode(returnData[2])
+	}
+
+	flags := make(map[string]string)
+	for _, value := range returnData[numTokenPropertiesPositionalValues:] {
+		keyValue := strings.SplitN(string(value), tokenPropertySeparator, 2)
+		if len(keyValue) != 2 {
+			return nil, fmt.Errorf("%w, invalid token property %s", epochStart.ErrExecutingSystemScCode, value)
+		}
+
+		flags[keyValue[0]] = keyValue[1]
+	}
+
+	numDecimals, _ := strconv.ParseUint(flags["NumDecimals"], 10, 64)
+	numWiped, _ := strconv.ParseUint(flags["NumWiped"], 10, 64)
+
+	return &esdtData.TokenProperties{
+		Name:                     string(returnData[0]),
+		Type:                     string(returnData[1]),
+		Owner:                    owner,
+		Minted:                   string(returnData[3]),
+		Burned:                   string(returnData[4]),
+		NumDecimals:              numDecimals,
+		IsPaused:                 flags["IsPaused"] == "true",
+		CanUpgrade:               flags["CanUpgrade"] == "true",
+		CanMint:                  flags["CanMint"] == "true",
+		CanBurn:                  flags["CanBurn"] == "true",
+		CanChangeOwner:           flags["CanChangeOwner"] == "true",
+		CanPause:                 flags["CanPause"] == "true",
+		CanFreeze:                flags["CanFreeze"] == "true",
+		CanWipe:                  flags["CanWipe"] == "true",
+		CanAddSpecialRoles:       flags["CanAddSpecialRoles"] == "true",
+		CanTransferNFTCreateRole: flags["CanTransferNFTCreateRole"] == "true",
+		NFTCreateStopped:         flags["NFTCreateStopped"] == "true",
+		NumWiped:                 numWiped,
+	}, nil
+}
+
+// GetTokenSpecialRoles will return the addresses which have special roles for the provided token, together with the roles
+func (tpp *tokenPropertiesProcessor) GetTokenSpecialRoles(token string) ([]*esdtData.TokenSpecialRoles, error) {
+	returnData, err := tpp.executeQuery("getSpecialRoles", []byte(token))
+	if err != nil {
+		return nil, err
+	}
+
+	specialRoles := make([]*esdtData.TokenSpecialRoles, 0, len(returnData))
+	for _, value := range returnData {
+		addressRoles := strings.SplitN(string(value), specialRoleAddressSeparator, 2)
+		if len(addressRoles) != 2 {
+			return nil, fmt.Errorf("%w, invalid special roles %s", epochStart.ErrExecutingSystemScCode, value)
+		}
+
+		roles := make([]string, 0)
+		if len(addressRoles[1]) > 0 {
+			roles = strings.Split(addressRoles[1], specialRolesSeparator)
+		}
+
+		specialRoles = append(specialRoles, &esdtData.TokenSpecialRoles{
+			Address: addressRoles[0],
+			Roles:   roles,
+		})
+	}
+
+	return specialRoles, nil
+}
+
+func (tpp *tokenPropertiesProcessor) executeQuery(function string, arguments ...[]byte) ([][]byte, error) {
+	scQuery := &process.SCQuery{
+		ScAddress:  vm.ESDTSCAddress,
+		FuncName:   function,
+		CallerAddr: vm.ESDTSCAddress,
+		CallValue:  big.NewInt(0),
+		Arguments:  arguments,
+	}
+
+	vmOutput, err := tpp.queryService.ExecuteQuery(scQuery)
+	if err != nil {
+		return nil, err
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return nil, fmt.Errorf("%w, return code: %v, message: %s", epochStart.ErrExecutingSystemScCode, vmOutput.ReturnCode, vmOutput.ReturnMessage)
+	}
+
+	return vmOutput.ReturnData, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tpp *tokenPropertiesProcessor) IsInterfaceNil() bool {
+	return tpp == nil
+}
@@ -0,0 +1,197 @@
+package trieIterators
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	esdtData "github.com/ElrondNetwork/elrond-go/node/trieIterators/data"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTokenPropertiesReturnData(owner []byte) [][]byte {
+	return [][]byte{
+		[]byte("Token"),
+		[]byte("FungibleESDT"),
+		owner,
+		[]byte("1000"),
+		[]byte("10"),
+		[]byte("NumDecimals-18"),
+		[]byte("IsPaused-false"),
+		[]byte("CanUpgrade-true"),
+		[]byte("CanMint-true"),
+		[]byte("CanBurn-false"),
+		[]byte("CanChangeOwner-true"),
+		[]byte("CanPause-true"),
+		[]byte("CanFreeze-false"),
+		[]byte("CanWipe-true"),
+		[]byte("CanAddSpecialRoles-true"),
+		[]byte("CanTransferNFTCreateRole-false"),
+		[]byte("NFTCreateStopped-false"),
+		[]byte("NumWiped-2"),
+	}
+}
+
+func TestNewTokenPropertiesProcessor(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.QueryService = nil
+	tpp, err := NewTokenPropertiesProcessor(arg)
+	assert.True(t, check.IfNil(tpp))
+	assert.Equal(t, ErrNilQueryService, err)
+
+	tpp, err = NewTokenPropertiesProcessor(createMockArgs())
+	assert.False(t, check.IfNil(tpp))
+	assert.Nil(t, err)
+}
+
+func TestTokenPropertiesProcessor_GetTokenPropertiesQueryErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	arg := createMockArgs()
+	arg.QueryService = &mock.SCQueryServiceStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			return nil, expectedErr
+		},
+	}
+	tpp, _ := NewTokenPropertiesProcessor(arg)
+
+	properties, err := tpp.GetTokenProperties("TKN-abcdef")
+	assert.Nil(t, properties)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestTokenPropertiesProcessor_GetTokenPropertiesNotOkReturnCodeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.QueryService = &mock.SCQueryServiceStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			return &vmcommon.VMOutput{
+				ReturnCode:    vmcommon.UserError,
+				ReturnMessage: "no ticker with given name",
+			}, nil
+		},
+	}
+	tpp, _ := NewTokenPropertiesProcessor(arg)
+
+	properties, err := tpp.GetTokenProperties("TKN-abcdef")
+	assert.Nil(t, properties)
+	assert.True(t, errors.Is(err, epochStart.ErrExecutingSystemScCode))
+}
+
+func TestTokenPropertiesProcessor_GetTokenPropertiesWrongNumberOfValuesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.QueryService = &mock.SCQueryServiceStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			return &vmcommon.VMOutput{
+				ReturnCode: vmcommon.Ok,
+				ReturnData: [][]byte{[]byte("Token")},
+			}, nil
+		},
+	}
+	tpp, _ := NewTokenPropertiesProcessor(arg)
+
+	properties, err := tpp.GetTokenProperties("TKN-abcdef")
+	assert.Nil(t, properties)
+	assert.True(t, errors.Is(err, epochStart.ErrExecutingSystemScCode))
+}
+
+func TestTokenPropertiesProcessor_GetTokenPropertiesShouldWork(t *testing.T) {
+	t.Parallel()
+
+	owner := []byte("owner")
+	arg := createMockArgs()
+	arg.PublicKeyConverter = mock.NewPubkeyConverterMock(len(owner))
+	arg.QueryService = &mock.SCQueryServiceStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			require.Equal(t, vm.ESDTSCAddress, query.ScAddress)
+			require.Equal(t, "getTokenProperties", query.FuncName)
+			require.Equal(t, [][]byte{[]byte("TKN-abcdef")}, query.Arguments)
+
+			return &vmcommon.VMOutput{
+				ReturnCode: vmcommon.Ok,
+				ReturnData: createTokenPropertiesReturnData(owner),
+			}, nil
+		},
+	}
+	tpp, _ := NewTokenPropertiesProcessor(arg)
+
+	properties, err := tpp.GetTokenProperties("TKN-abcdef")
+	require.Nil(t, err)
+
+	expectedProperties := &esdtData.TokenProperties{
+		Name:               "Token",
+		Type:               "FungibleESDT",
+		Owner:              arg.PublicKeyConverter.Encode(owner),
+		Minted:             "1000",
+		Burned:             "10",
+		NumDecimals:        18,
+		CanUpgrade:         true,
+		CanMint:            true,
+		CanChangeOwner:     true,
+		CanPause:           true,
+		CanWipe:            true,
+		CanAddSpecialRoles: true,
+		NumWiped:           2,
+	}
+	assert.Equal(t, expectedProperties, properties)
+}
+
+func TestTokenPropertiesProcessor_GetTokenSpecialRolesShouldWork(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.QueryService = &mock.SCQueryServiceStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			require.Equal(t, "getSpecialRoles", query.FuncName)
+
+			return &vmcommon.VMOutput{
+				ReturnCode: vmcommon.Ok,
+				ReturnData: [][]byte{
+					[]byte("erd1alice:ESDTRoleLocalMint,ESDTRoleLocalBurn"),
+					[]byte("erd1bob:ESDTRoleNFTCreate"),
+				},
+			}, nil
+		},
+	}
+	tpp, _ := NewTokenPropertiesProcessor(arg)
+
+	specialRoles, err := tpp.GetTokenSpecialRoles("TKN-abcdef")
+	require.Nil(t, err)
+
+	expectedSpecialRoles := []*esdtData.TokenSpecialRoles{
+		{Address: "erd1alice", Roles: []string{"ESDTRoleLocalMint", "ESDTRoleLocalBurn"}},
+		{Address: "erd1bob", Roles: []string{"ESDTRoleNFTCreate"}},
+	}
+	assert.Equal(t, expectedSpecialRoles, specialRoles)
+}
+
+func TestTokenPropertiesProcessor_GetTokenSpecialRolesInvalidValueShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.QueryService = &mock.SCQueryServiceStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			return &vmcommon.VMOutput{
+				ReturnCode: vmcommon.Ok,
+				ReturnData: [][]byte{[]byte("invalid")},
+			}, nil
+		},
+	}
+	tpp, _ := NewTokenPropertiesProcessor(arg)
+
+	specialRoles, err := tpp.GetTokenSpecialRoles("TKN-abcdef")
+	assert.Nil(t, specialRoles)
+	assert.True(t, errors.Is(err, epochStart.ErrExecutingSystemScCode))
+}
@@ -0,0 +1,91 @@
+package memp2p
+
+import (
+	"strings"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+)
+
+const linkDropSalt = "link drop"
+const topicDropSalt = "topic drop"
+const reorderSalt = "reorder"
+
+// TopicRule defines the adversarial behavior applied on the messages sent on the topics that
+// start with a given prefix. The Delay and the ReorderWindow are only applied when the Network
+// has a clock, otherwise the messages are delivered immediately.
+type TopicRule struct {
+	DropProbability float64
+	Delay           time.Duration
+	// ReorderWindow adds to each message an extra delay in the [0, ReorderWindow) interval so that
+	// messages sent in a short succession can arrive in a different order
+	ReorderWindow time.Duration
+	NumDuplicates uint32
+}
+
+// MessageTamperer is able to alter the messages sent by a peer before they reach each receiver.
+// Returning more than one payload will deliver all of them, returning none will drop the message.
+type MessageTamperer interface {
+	Tamper(topic string, buff []byte, receiver core.PeerID) [][]byte
+	IsInterfaceNil() bool
+}
+
+// MessageTampererFunc is a function adapter for the MessageTamperer interface
+type MessageTampererFunc func(topic string, buff []byte, receiver core.PeerID) [][]byte
+
+// Tamper calls the wrapped function
+func (f MessageTampererFunc) Tamper(topic string, buff []byte, receiver core.PeerID) [][]byte {
+	return f(topic, buff, receiver)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (f MessageTampererFunc) IsInterfaceNil() bool {
+	return f == nil
+}
+
+// SetTopicRule sets the adversarial rule for all topics that start with the provided prefix.
+// If more prefixes match a topic, the longest one is used.
+func (network *Network) SetTopicRule(topicPrefix string, rule TopicRule) {
+	network.mutConditions.Lock()
+	network.topicRules[topicPrefix] = rule
+	network.mutConditions.Unlock()
+}
+
+// RemoveTopicRule removes the adversarial rule set for the provided topic prefix
+func (network *Network) RemoveTopicRule(topicPrefix string) {
+	network.mutConditions.Lock()
+	delete(network.topicRules, topicPrefix)
+	network.mutConditions.Unlock()
+}
+
+// SetMessageTamperer sets the tamperer used for all the messages sent by the provided peer. A nil
+// tamperer removes the existing one.
+func (network *Network) SetMessageTamperer(sender core.PeerID, tamperer MessageTamperer) {
+	network.mutConditions.Lock()
+	if tamperer == nil || tamperer.IsInterfaceNil() {
+		delete(network.tamperers, sender)
+	} else {
+		network.tamperers[sender] = tamperer
+	}
+	network.mutConditions.Unlock()
+}
+
+func (network *Network) topicRule(topic string) (TopicRule, bool) {
+	longestPrefix := ""
+	found := false
+	var rule TopicRule
+	for prefix, r := range network.topicRules {
+		if !strings.HasPrefix(topic, prefix) {
+			continue
+		}
+		if found && len(prefix) <= len(longestPrefix) {
+			continue
+		}
+
+		longestPrefix = prefix
+		rule = r
+		found = true
+	}
+
+	return rule, found
+}
@@ -0,0 +1,144 @@
+package memp2p_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/memp2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func createDeterministicNetwork(clock memp2p.TimeProvider) *memp2p.Network {
+	network, _ := memp2p.NewNetworkWithArgs(memp2p.ArgsNetwork{
+		Seed:  5,
+		Clock: clock,
+	})
+
+	return network
+}
+
+func registerRecorder(messenger *memp2p.Messenger, topic string) func() []string {
+	mut := &sync.Mutex{}
+	received := make([]string, 0)
+	_ = messenger.RegisterMessageProcessor(topic, "", &mock.MessageProcessorStub{
+		ProcessMessageCalled: func(message p2p.MessageP2P, _ core.PeerID) error {
+			mut.Lock()
+			received = append(received, string(message.Data()))
+			mut.Unlock()
+
+			return nil
+		},
+	})
+
+	return func() []string {
+		mut.Lock()
+		defer mut.Unlock()
+
+		return append([]string{}, received...)
+	}
+}
+
+func TestNetwork_TopicRuleDropShouldOnlyAffectMatchingTopics(t *testing.T) {
+	t.Parallel()
+
+	network := createDeterministicNetwork(&mock.SyncTimerStub{})
+	peers := createPeersOnTopic(network, 2, "consensus_0")
+	for _, peer := range peers {
+		_ = peer.CreateTopic("transactions", false)
+	}
+
+	network.SetTopicRule("consensus", memp2p.TopicRule{DropProbability: 1})
+	_ = peers[0].BroadcastOnChannelBlocking("", "consensus_0", []byte("header"))
+	_ = peers[0].BroadcastOnChannelBlocking("", "transactions", []byte("tx"))
+	time.Sleep(time.Millisecond * 100)
+	testReceivedMessages(t, peers, map[int]uint64{0: 1, 1: 1})
+
+	network.RemoveTopicRule("consensus")
+	_ = peers[0].BroadcastOnChannelBlocking("", "consensus_0", []byte("header"))
+	time.Sleep(time.Millisecond * 100)
+	testReceivedMessages(t, peers, map[int]uint64{0: 2, 1: 2})
+}
+
+func TestNetwork_TopicRuleDuplicatesShouldDeliverCopies(t *testing.T) {
+	t.Parallel()
+
+	network := createDeterministicNetwork(&mock.SyncTimerStub{})
+	peers := createPeersOnTopic(network, 2, "rocket")
+	network.SetTopicRule("rocket", memp2p.TopicRule{NumDuplicates: 2})
+
+	_ = peers[0].SendToConnectedPeer("rocket", []byte("launch"), peers[1].ID())
+	time.Sleep(time.Millisecond * 100)
+	assert.Equal(t, uint64(3), peers[1].NumMessagesReceived())
+}
+
+func TestNetwork_TopicRuleDelayAndReorderShouldUseTheClock(t *testing.T) {
+	t.Parallel()
+
+	clock := &manualClock{}
+	network := createDeterministicNetwork(clock)
+	peers := createPeersOnTopic(network, 2, "rocket")
+	received := registerRecorder(peers[1], "rocket")
+	network.SetTopicRule("rocket", memp2p.TopicRule{
+		Delay:         time.Second,
+		ReorderWindow: time.Second,
+	})
+
+	numMessages := 20
+	for i := 0; i < numMessages; i++ {
+		_ = peers[0].SendToConnectedPeer("rocket", []byte{byte('a' + i)}, peers[1].ID())
+	}
+	assert.Equal(t, numMessages, network.NumPendingMessages())
+
+	clock.advance(time.Millisecond * 999)
+	assert.Equal(t, 0, network.DeliverPendingMessages())
+
+	clock.advance(time.Second)
+	assert.Equal(t, numMessages, network.DeliverPendingMessages())
+	time.Sleep(time.Millisecond * 100)
+
+	messages := received()
+	assert.Equal(t, numMessages, len(messages))
+	isOrdered := true
+	for i := 1; i < len(messages); i++ {
+		if messages[i] < messages[i-1] {
+			isOrdered = false
+		}
+	}
+	assert.False(t, isOrdered)
+}
+
+func TestNetwork_MessageTampererShouldAlterMessagesPerReceiver(t *testing.T) {
+	t.Parallel()
+
+	network := createDeterministicNetwork(&mock.SyncTimerStub{})
+	peers := createPeersOnTopic(network, 3, "rocket")
+	received1 := registerRecorder(peers[1], "rocket")
+	received2 := registerRecorder(peers[2], "rocket")
+
+	network.SetMessageTamperer(peers[0].ID(), memp2p.MessageTampererFunc(
+		func(topic string, buff []byte, receiver core.PeerID) [][]byte {
+			if receiver == peers[1].ID() {
+				return [][]byte{buff, []byte("conflicting")}
+			}
+			if receiver == peers[2].ID() {
+				return nil
+			}
+
+			return [][]byte{buff}
+		},
+	))
+
+	_ = peers[0].BroadcastOnChannelBlocking("", "rocket", []byte("original"))
+	time.Sleep(time.Millisecond * 100)
+	assert.Equal(t, []string{"original", "conflicting"}, received1())
+	assert.Equal(t, 0, len(received2()))
+
+	network.SetMessageTamperer(peers[0].ID(), nil)
+	_ = peers[0].BroadcastOnChannelBlocking("", "rocket", []byte("original"))
+	time.Sleep(time.Millisecond * 100)
+	assert.Equal(t, 1, len(received2()))
+}
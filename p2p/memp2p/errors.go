@@ -10,3 +10,6 @@ var ErrNotConnectedToNetwork = errors.New("not connected to network")
 
 // ErrReceivingPeerNotConnected signals that the receiving peer of a sending operation is not connected to the network
 var ErrReceivingPeerNotConnected = errors.New("receiving peer not connected to network")
+
+// ErrNilTimeProvider signals that a nil time provider has been provided
+var ErrNilTimeProvider = errors.New("nil time provider")
@@ -0,0 +1,9 @@
+package memp2p
+
+import "time"
+
+// TimeProvider defines the clock used by the Network to schedule delayed deliveries
+type TimeProvider interface {
+	CurrentTime() time.Time
+	IsInterfaceNil() bool
+}
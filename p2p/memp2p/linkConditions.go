@@ -0,0 +1,105 @@
+package memp2p
+
+import (
+	"container/heap"
+	"encoding/binary"
+	"hash/fnv"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+)
+
+const dropResolution = 1000000
+
+// LinkConditions defines how messages travel on a directed link between two peers
+type LinkConditions struct {
+	Latency         time.Duration
+	DropProbability float64
+}
+
+type link struct {
+	from core.PeerID
+	to   core.PeerID
+}
+
+type pendingDelivery struct {
+	deliverAt time.Time
+	index     uint64
+	receiver  *Messenger
+	message   *message
+}
+
+// pendingDeliveries is a min-heap ordered by the delivery time. Deliveries that share the same
+// delivery time are ordered by their scheduling index so that the output is deterministic
+type pendingDeliveries []*pendingDelivery
+
+// Len returns the number of pending deliveries
+func (pd pendingDeliveries) Len() int {
+	return len(pd)
+}
+
+// Less returns true if the delivery from position i should be done before the one from position j
+func (pd pendingDeliveries) Less(i, j int) bool {
+	if pd[i].deliverAt.Equal(pd[j].deliverAt) {
+		return pd[i].index < pd[j].index
+	}
+
+	return pd[i].deliverAt.Before(pd[j].deliverAt)
+}
+
+// Swap swaps the elements from the provided positions
+func (pd pendingDeliveries) Swap(i, j int) {
+	pd[i], pd[j] = pd[j], pd[i]
+}
+
+// Push adds a new pending delivery
+func (pd *pendingDeliveries) Push(x interface{}) {
+	*pd = append(*pd, x.(*pendingDelivery))
+}
+
+// Pop removes and returns the last pending delivery
+func (pd *pendingDeliveries) Pop() interface{} {
+	old := *pd
+	n := len(old)
+	item := old[n-1]
+	old[n-1] = nil
+	*pd = old[:n-1]
+
+	return item
+}
+
+var _ heap.Interface = (*pendingDeliveries)(nil)
+
+// deterministicValue returns a value in the [0, modulo) interval computed only from the seed, the salt, the
+// link and the message sequence number so the result does not depend on the order in which the peers are broadcasting
+func deterministicValue(seed int64, salt string, from core.PeerID, to core.PeerID, seqNo []byte, modulo uint64) uint64 {
+	if modulo == 0 {
+		return 0
+	}
+
+	seedBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(seedBytes, uint64(seed))
+
+	h := fnv.New64a()
+	_, _ = h.Write(seedBytes)
+	_, _ = h.Write([]byte(salt))
+	_, _ = h.Write([]byte(from))
+	_, _ = h.Write([]byte(to))
+	_, _ = h.Write(seqNo)
+
+	return h.Sum64() % modulo
+}
+
+// shouldDrop decides if a message should be dropped with the given probability in a deterministic way
+func shouldDrop(seed int64, salt string, from core.PeerID, to core.PeerID, seqNo []byte, dropProbability float64) bool {
+	if dropProbability <= 0 {
+		return false
+	}
+	if dropProbability >= 1 {
+		return true
+	}
+
+	value := deterministicValue(seed, salt, from, to, seqNo, dropResolution)
+
+	return float64(value) < dropProbability*dropResolution
+}
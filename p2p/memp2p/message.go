@@ -40,6 +40,14 @@ func newMessage(topic string, data []byte, peerID core.PeerID, seqNo uint64) *me
 	}
 }
 
+// withData returns a copy of the message that carries the provided data
+func (msg *message) withData(data []byte) *message {
+	msgCopy := *msg
+	msgCopy.data = data
+
+	return &msgCopy
+}
+
 // From returns the message originator's peer ID
 func (msg *message) From() []byte {
 	return msg.from
@@ -1,8 +1,6 @@
 package memp2p
 
 import (
-	"crypto/rand"
-	"encoding/base64"
 	"fmt"
 	"sync"
 	"sync/atomic"
@@ -17,6 +15,8 @@ const maxQueueSize = 1000
 
 var log = logger.GetOrCreate("p2p/memp2p")
 
+var _ p2p.Messenger = (*Messenger)(nil)
+
 // Messenger is an implementation of the p2p.Messenger interface that
 // uses no real networking code, but instead connects to a network simulated in
 // memory (the Network struct). The Messenger is intended for use
@@ -49,14 +49,12 @@ func NewMessenger(network *Network) (*Messenger, error) {
 		return nil, ErrNilNetwork
 	}
 
-	buff := make([]byte, 32)
-	_, _ = rand.Reader.Read(buff)
-	ID := base64.StdEncoding.EncodeToString(buff)
+	ID := network.generatePeerID()
 	Address := fmt.Sprintf("/memp2p/%s", ID)
 
 	messenger := &Messenger{
 		network:         network,
-		p2pID:           core.PeerID(ID),
+		p2pID:           ID,
 		address:         Address,
 		topics:          make(map[string]struct{}),
 		topicValidators: make(map[string]p2p.MessageProcessor),
@@ -167,13 +165,19 @@ func (messenger *Messenger) ConnectedPeersOnTopic(topic string) []core.PeerID {
 	return filteredPeers
 }
 
+// ConnectedFullHistoryPeersOnTopic returns an empty slice as the in-memory network does not
+// differentiate the full history peers
+func (messenger *Messenger) ConnectedFullHistoryPeersOnTopic(_ string) []core.PeerID {
+	return make([]core.PeerID, 0)
+}
+
 // TrimConnections does nothing, as it is not applicable to the in-memory
 // messenger.
 func (messenger *Messenger) TrimConnections() {
 }
 
 // Bootstrap does nothing, as it is not applicable to the in-memory messenger.
-func (messenger *Messenger) Bootstrap(_ uint32) error {
+func (messenger *Messenger) Bootstrap() error {
 	return nil
 }
 
@@ -247,6 +251,25 @@ func (messenger *Messenger) UnregisterMessageProcessor(topic string, _ string) e
 	return nil
 }
 
+// UnregisterAllMessageProcessors unsets the message processors for all the topics
+func (messenger *Messenger) UnregisterAllMessageProcessors() error {
+	messenger.topicsMutex.Lock()
+	messenger.topicValidators = make(map[string]p2p.MessageProcessor)
+	messenger.topicsMutex.Unlock()
+
+	return nil
+}
+
+// UnjoinAllTopics removes all the topics and their message processors
+func (messenger *Messenger) UnjoinAllTopics() error {
+	messenger.topicsMutex.Lock()
+	messenger.topics = make(map[string]struct{})
+	messenger.topicValidators = make(map[string]p2p.MessageProcessor)
+	messenger.topicsMutex.Unlock()
+
+	return nil
+}
+
 // OutgoingChannelLoadBalancer does nothing, as it is not applicable to the in-memory network.
 func (messenger *Messenger) OutgoingChannelLoadBalancer() p2p.ChannelLoadBalancer {
 	return nil
@@ -291,7 +314,7 @@ func (messenger *Messenger) synchronousBroadcast(topic string, data []byte) erro
 
 	peers := messenger.network.Peers()
 	for _, peer := range peers {
-		peer.receiveMessage(messageObject)
+		messenger.network.deliver(peer, messageObject)
 	}
 
 	return nil
@@ -340,7 +363,7 @@ func (messenger *Messenger) SendToConnectedPeer(topic string, buff []byte, peerI
 			return ErrReceivingPeerNotConnected
 		}
 
-		receivingPeer.receiveMessage(messageObject)
+		messenger.network.deliver(receivingPeer, messageObject)
 
 		return nil
 	}
@@ -392,6 +415,11 @@ func (messenger *Messenger) GetConnectedPeersInfo() *p2p.ConnectedPeersInfo {
 	return nil
 }
 
+// Port returns 0 as the in-memory messenger does not bind to any port
+func (messenger *Messenger) Port() int {
+	return 0
+}
+
 // Close disconnects this Messenger from the network it was connected to.
 func (messenger *Messenger) Close() error {
 	messenger.network.UnregisterPeer(messenger.ID())
@@ -1,32 +1,108 @@
 package memp2p
 
 import (
+	"container/heap"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/hashing/sha256"
 )
 
+// ArgsNetwork is the DTO used to create a Network that has deterministic behavior
+type ArgsNetwork struct {
+	Seed  int64
+	Clock TimeProvider
+}
+
 // Network provides in-memory connectivity for the Messenger
 // struct. It simulates a network where each peer is connected to all the other
 // peers. The peers are connected to the network if they are in the internal
 // `peers` map; otherwise, they are disconnected.
+//
+// The links between peers can be altered by setting link conditions (latency and
+// drop probability) and by splitting the peers into partitions. Delayed messages
+// are only delivered when DeliverPendingMessages is called, so the delivery
+// timeline is fully driven by the provided clock.
 type Network struct {
 	mutex sync.RWMutex
 	peers map[core.PeerID]*Messenger
+
+	deterministic bool
+	seed          int64
+	numPeerIDs    uint64
+	clock         TimeProvider
+
+	mutConditions     sync.RWMutex
+	defaultConditions LinkConditions
+	linkConditions    map[link]LinkConditions
+	partitions        map[core.PeerID]int
+	topicRules        map[string]TopicRule
+	tamperers         map[core.PeerID]MessageTamperer
+
+	mutPending   sync.Mutex
+	pending      pendingDeliveries
+	numScheduled uint64
+	numDropped   uint64
+	numDelivered uint64
 }
 
 // NewNetwork constructs a new Network instance with an empty
 // internal map of peers.
 func NewNetwork() *Network {
 	network := Network{
-		mutex: sync.RWMutex{},
-		peers: make(map[core.PeerID]*Messenger),
+		mutex:          sync.RWMutex{},
+		peers:          make(map[core.PeerID]*Messenger),
+		linkConditions: make(map[link]LinkConditions),
+		partitions:     make(map[core.PeerID]int),
+		topicRules:     make(map[string]TopicRule),
+		tamperers:      make(map[core.PeerID]MessageTamperer),
+		pending:        make(pendingDeliveries, 0),
 	}
 
 	return &network
 }
 
+// NewNetworkWithArgs constructs a new Network instance that generates the peer IDs and
+// takes the drop decisions based on the provided seed. Messages sent over links that have
+// latency are scheduled using the provided clock.
+func NewNetworkWithArgs(args ArgsNetwork) (*Network, error) {
+	if check.IfNil(args.Clock) {
+		return nil, ErrNilTimeProvider
+	}
+
+	network := NewNetwork()
+	network.deterministic = true
+	network.seed = args.Seed
+	network.clock = args.Clock
+
+	return network, nil
+}
+
+func (network *Network) generatePeerID() core.PeerID {
+	buff := make([]byte, 32)
+	if !network.deterministic {
+		_, _ = rand.Reader.Read(buff)
+		return core.PeerID(base64.StdEncoding.EncodeToString(buff))
+	}
+
+	network.mutex.Lock()
+	network.numPeerIDs++
+	index := network.numPeerIDs
+	network.mutex.Unlock()
+
+	binary.BigEndian.PutUint64(buff, uint64(network.seed))
+	binary.BigEndian.PutUint64(buff[8:], index)
+	hashed := sha256.NewSha256().Compute(string(buff))
+
+	return core.PeerID(base64.StdEncoding.EncodeToString(hashed))
+}
+
 // ListAddressesExceptOne provides the addresses of the known peers, except a specified one.
 func (network *Network) ListAddressesExceptOne(peerIDToExclude core.PeerID) []string {
 	network.mutex.RLock()
@@ -88,7 +164,7 @@ func (network *Network) PeerIDs() []core.PeerID {
 	return peerIDsCopy
 }
 
-//PeerIDsExceptOne provides a copy of its internal slice of peerIDs, excluding a specific peer.
+// PeerIDsExceptOne provides a copy of its internal slice of peerIDs, excluding a specific peer.
 func (network *Network) PeerIDsExceptOne(peerIDToExclude core.PeerID) []core.PeerID {
 	network.mutex.RLock()
 	peerIDsCopy := make([]core.PeerID, len(network.peers)-1)
@@ -129,3 +205,224 @@ func (network *Network) IsPeerConnected(peerID core.PeerID) bool {
 	network.mutex.RUnlock()
 	return found
 }
+
+// SetDefaultLinkConditions sets the conditions applied on all links that do not have custom conditions
+func (network *Network) SetDefaultLinkConditions(conditions LinkConditions) {
+	network.mutConditions.Lock()
+	network.defaultConditions = conditions
+	network.mutConditions.Unlock()
+}
+
+// SetLinkConditions sets the conditions applied on the directed link from -> to
+func (network *Network) SetLinkConditions(from core.PeerID, to core.PeerID, conditions LinkConditions) {
+	network.mutConditions.Lock()
+	network.linkConditions[link{from: from, to: to}] = conditions
+	network.mutConditions.Unlock()
+}
+
+// ResetLinkConditions removes all custom and default link conditions, topic rules and message tamperers
+func (network *Network) ResetLinkConditions() {
+	network.mutConditions.Lock()
+	network.defaultConditions = LinkConditions{}
+	network.linkConditions = make(map[link]LinkConditions)
+	network.topicRules = make(map[string]TopicRule)
+	network.tamperers = make(map[core.PeerID]MessageTamperer)
+	network.mutConditions.Unlock()
+}
+
+// Partition splits the network in the provided groups. Peers from different groups will not
+// be able to exchange messages. Peers not present in any group can talk with everyone.
+func (network *Network) Partition(groups ...[]core.PeerID) {
+	network.mutConditions.Lock()
+	network.partitions = make(map[core.PeerID]int)
+	for idx, group := range groups {
+		for _, pid := range group {
+			network.partitions[pid] = idx
+		}
+	}
+	network.mutConditions.Unlock()
+}
+
+// HealPartitions removes all the partitions
+func (network *Network) HealPartitions() {
+	network.mutConditions.Lock()
+	network.partitions = make(map[core.PeerID]int)
+	network.mutConditions.Unlock()
+}
+
+// CanCommunicate returns true if the two peers are in the same partition
+func (network *Network) CanCommunicate(from core.PeerID, to core.PeerID) bool {
+	network.mutConditions.RLock()
+	defer network.mutConditions.RUnlock()
+
+	return network.canCommunicate(from, to)
+}
+
+func (network *Network) canCommunicate(from core.PeerID, to core.PeerID) bool {
+	fromGroup, fromFound := network.partitions[from]
+	toGroup, toFound := network.partitions[to]
+	if !fromFound || !toFound {
+		return true
+	}
+
+	return fromGroup == toGroup
+}
+
+type deliveryRules struct {
+	link     LinkConditions
+	topic    TopicRule
+	tamperer MessageTamperer
+}
+
+func (network *Network) deliveryRules(msg *message, receiver core.PeerID) (deliveryRules, bool) {
+	network.mutConditions.RLock()
+	defer network.mutConditions.RUnlock()
+
+	if !network.canCommunicate(msg.Peer(), receiver) {
+		return deliveryRules{}, false
+	}
+
+	conditions, found := network.linkConditions[link{from: msg.Peer(), to: receiver}]
+	if !found {
+		conditions = network.defaultConditions
+	}
+	rule, _ := network.topicRule(msg.Topic())
+
+	return deliveryRules{
+		link:     conditions,
+		topic:    rule,
+		tamperer: network.tamperers[msg.Peer()],
+	}, true
+}
+
+// deliver sends the message to the receiver applying the partitions, the message tamperers,
+// the topic rules and the link conditions
+func (network *Network) deliver(receiver *Messenger, msg *message) {
+	rules, canCommunicate := network.deliveryRules(msg, receiver.ID())
+	if !canCommunicate {
+		network.markDropped()
+		return
+	}
+
+	messages := []*message{msg}
+	if rules.tamperer != nil {
+		payloads := rules.tamperer.Tamper(msg.Topic(), msg.Data(), receiver.ID())
+		messages = make([]*message, 0, len(payloads))
+		for _, payload := range payloads {
+			messages = append(messages, msg.withData(payload))
+		}
+	}
+	if len(messages) == 0 {
+		network.markDropped()
+		return
+	}
+
+	for _, m := range messages {
+		network.deliverWithRules(receiver, m, rules)
+	}
+}
+
+func (network *Network) deliverWithRules(receiver *Messenger, msg *message, rules deliveryRules) {
+	from := msg.Peer()
+	to := receiver.ID()
+	isDropped := shouldDrop(network.seed, linkDropSalt, from, to, msg.SeqNo(), rules.link.DropProbability) ||
+		shouldDrop(network.seed, topicDropSalt, from, to, msg.SeqNo(), rules.topic.DropProbability)
+	if isDropped {
+		network.markDropped()
+		return
+	}
+
+	delay := rules.link.Latency + rules.topic.Delay
+	if rules.topic.ReorderWindow > 0 {
+		jitter := deterministicValue(network.seed, reorderSalt, from, to, msg.SeqNo(), uint64(rules.topic.ReorderWindow))
+		delay += time.Duration(jitter)
+	}
+
+	numCopies := 1 + int(rules.topic.NumDuplicates)
+	for i := 0; i < numCopies; i++ {
+		network.schedule(receiver, msg, delay)
+	}
+}
+
+func (network *Network) schedule(receiver *Messenger, msg *message, delay time.Duration) {
+	if delay <= 0 || check.IfNil(network.clock) {
+		network.mutPending.Lock()
+		network.numDelivered++
+		network.mutPending.Unlock()
+
+		receiver.receiveMessage(msg)
+		return
+	}
+
+	network.mutPending.Lock()
+	network.numScheduled++
+	heap.Push(&network.pending, &pendingDelivery{
+		deliverAt: network.clock.CurrentTime().Add(delay),
+		index:     network.numScheduled,
+		receiver:  receiver,
+		message:   msg,
+	})
+	network.mutPending.Unlock()
+}
+
+func (network *Network) markDropped() {
+	network.mutPending.Lock()
+	network.numDropped++
+	network.mutPending.Unlock()
+}
+
+// DeliverPendingMessages delivers, in order, all the delayed messages that should have arrived
+// up until the current clock time. Returns the number of delivered messages.
+func (network *Network) DeliverPendingMessages() int {
+	if check.IfNil(network.clock) {
+		return 0
+	}
+
+	now := network.clock.CurrentTime()
+	toDeliver := make([]*pendingDelivery, 0)
+
+	network.mutPending.Lock()
+	for network.pending.Len() > 0 {
+		if network.pending[0].deliverAt.After(now) {
+			break
+		}
+
+		toDeliver = append(toDeliver, heap.Pop(&network.pending).(*pendingDelivery))
+	}
+	network.numDelivered += uint64(len(toDeliver))
+	network.mutPending.Unlock()
+
+	for _, delivery := range toDeliver {
+		if !network.IsPeerConnected(delivery.receiver.ID()) {
+			continue
+		}
+
+		delivery.receiver.receiveMessage(delivery.message)
+	}
+
+	return len(toDeliver)
+}
+
+// NumPendingMessages returns the number of messages waiting to be delivered
+func (network *Network) NumPendingMessages() int {
+	network.mutPending.Lock()
+	defer network.mutPending.Unlock()
+
+	return network.pending.Len()
+}
+
+// NumDroppedMessages returns the number of messages that were dropped because of partitions or link conditions
+func (network *Network) NumDroppedMessages() uint64 {
+	network.mutPending.Lock()
+	defer network.mutPending.Unlock()
+
+	return network.numDropped
+}
+
+// NumDeliveredMessages returns the number of messages handed over to the receivers
+func (network *Network) NumDeliveredMessages() uint64 {
+	network.mutPending.Lock()
+	defer network.mutPending.Unlock()
+
+	return network.numDelivered
+}
@@ -0,0 +1,159 @@
+package memp2p_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/p2p/memp2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type manualClock struct {
+	mut         sync.RWMutex
+	currentTime time.Time
+}
+
+func (mc *manualClock) advance(duration time.Duration) {
+	mc.mut.Lock()
+	mc.currentTime = mc.currentTime.Add(duration)
+	mc.mut.Unlock()
+}
+
+func (mc *manualClock) CurrentTime() time.Time {
+	mc.mut.RLock()
+	defer mc.mut.RUnlock()
+
+	return mc.currentTime
+}
+
+func (mc *manualClock) IsInterfaceNil() bool {
+	return mc == nil
+}
+
+func createPeersOnTopic(network *memp2p.Network, numPeers int, topic string) []*memp2p.Messenger {
+	peers := make([]*memp2p.Messenger, numPeers)
+	for i := 0; i < numPeers; i++ {
+		peer, _ := memp2p.NewMessenger(network)
+		_ = peer.CreateTopic(topic, false)
+		peers[i] = peer
+	}
+
+	return peers
+}
+
+func TestNewNetworkWithArgs_NilClockShouldErr(t *testing.T) {
+	t.Parallel()
+
+	network, err := memp2p.NewNetworkWithArgs(memp2p.ArgsNetwork{})
+	assert.Nil(t, network)
+	assert.Equal(t, memp2p.ErrNilTimeProvider, err)
+}
+
+func TestNewNetworkWithArgs_SameSeedShouldGenerateSamePeerIDs(t *testing.T) {
+	t.Parallel()
+
+	args := memp2p.ArgsNetwork{
+		Seed:  37,
+		Clock: &mock.SyncTimerStub{},
+	}
+	network1, _ := memp2p.NewNetworkWithArgs(args)
+	network2, _ := memp2p.NewNetworkWithArgs(args)
+	args.Seed = 38
+	network3, _ := memp2p.NewNetworkWithArgs(args)
+
+	for i := 0; i < 3; i++ {
+		peer1, _ := memp2p.NewMessenger(network1)
+		peer2, _ := memp2p.NewMessenger(network2)
+		peer3, _ := memp2p.NewMessenger(network3)
+
+		assert.Equal(t, peer1.ID(), peer2.ID())
+		assert.NotEqual(t, peer1.ID(), peer3.ID())
+	}
+}
+
+func TestNetwork_PartitionShouldIsolateGroups(t *testing.T) {
+	t.Parallel()
+
+	network := memp2p.NewNetwork()
+	peers := createPeersOnTopic(network, 4, "rocket")
+
+	network.Partition(
+		[]core.PeerID{peers[0].ID(), peers[1].ID()},
+		[]core.PeerID{peers[2].ID()},
+	)
+	assert.True(t, network.CanCommunicate(peers[0].ID(), peers[1].ID()))
+	assert.False(t, network.CanCommunicate(peers[0].ID(), peers[2].ID()))
+	assert.True(t, network.CanCommunicate(peers[2].ID(), peers[3].ID()))
+
+	_ = peers[0].BroadcastOnChannelBlocking("rocket", "rocket", []byte("launch the rocket"))
+	time.Sleep(time.Millisecond * 100)
+	testReceivedMessages(t, peers, map[int]uint64{0: 1, 1: 1, 2: 0, 3: 1})
+	assert.Equal(t, uint64(1), network.NumDroppedMessages())
+
+	network.HealPartitions()
+	_ = peers[0].BroadcastOnChannelBlocking("rocket", "rocket", []byte("launch another rocket"))
+	time.Sleep(time.Millisecond * 100)
+	testReceivedMessages(t, peers, map[int]uint64{0: 2, 1: 2, 2: 1, 3: 2})
+}
+
+func TestNetwork_LatencyShouldDelayDeliveryUntilClockAdvances(t *testing.T) {
+	t.Parallel()
+
+	clock := &manualClock{}
+	network, _ := memp2p.NewNetworkWithArgs(memp2p.ArgsNetwork{
+		Seed:  1,
+		Clock: clock,
+	})
+	peers := createPeersOnTopic(network, 3, "rocket")
+	network.SetDefaultLinkConditions(memp2p.LinkConditions{Latency: time.Second})
+	network.SetLinkConditions(peers[0].ID(), peers[0].ID(), memp2p.LinkConditions{})
+
+	_ = peers[0].BroadcastOnChannelBlocking("rocket", "rocket", []byte("launch the rocket"))
+	time.Sleep(time.Millisecond * 100)
+	testReceivedMessages(t, peers, map[int]uint64{0: 1, 1: 0, 2: 0})
+	assert.Equal(t, 2, network.NumPendingMessages())
+
+	clock.advance(time.Millisecond * 999)
+	assert.Equal(t, 0, network.DeliverPendingMessages())
+
+	clock.advance(time.Millisecond)
+	assert.Equal(t, 2, network.DeliverPendingMessages())
+	time.Sleep(time.Millisecond * 100)
+	testReceivedMessages(t, peers, map[int]uint64{0: 1, 1: 1, 2: 1})
+	assert.Equal(t, 0, network.NumPendingMessages())
+}
+
+func TestNetwork_DropsShouldBeDeterministicBySeed(t *testing.T) {
+	t.Parallel()
+
+	numMessages := 200
+	runWithSeed := func(seed int64) []uint64 {
+		network, err := memp2p.NewNetworkWithArgs(memp2p.ArgsNetwork{
+			Seed:  seed,
+			Clock: &mock.SyncTimerStub{},
+		})
+		require.Nil(t, err)
+
+		peers := createPeersOnTopic(network, 3, "rocket")
+		network.SetDefaultLinkConditions(memp2p.LinkConditions{DropProbability: 0.5})
+		for i := 0; i < numMessages; i++ {
+			_ = peers[0].BroadcastOnChannelBlocking("rocket", "rocket", []byte("launch the rocket"))
+		}
+		time.Sleep(time.Millisecond * 200)
+
+		received := make([]uint64, len(peers))
+		for idx, peer := range peers {
+			received[idx] = peer.NumMessagesReceived()
+			assert.True(t, received[idx] > 0)
+			assert.True(t, received[idx] < uint64(numMessages))
+		}
+
+		return received
+	}
+
+	assert.Equal(t, runWithSeed(7), runWithSeed(7))
+}
@@ -70,6 +70,9 @@ type ArgBaseProcessor struct {
 	EpochNotifier       process.EpochNotifier
 	VMContainersFactory process.VirtualMachinesContainerFactory
 	VmContainer         process.VirtualMachinesContainer
+
+	DynamicGasPriceHandler process.DynamicGasPriceHandler
+	GasPriceTracker        process.GasPriceTracker
 }
 
 // ArgShardProcessor holds all dependencies required by the process data factory in order to create
@@ -89,6 +92,7 @@ type ArgMetaProcessor struct {
 	EpochRewardsCreator          process.RewardsCreator
 	EpochValidatorInfoCreator    process.EpochStartValidatorInfoCreator
 	EpochSystemSCProcessor       process.EpochStartSystemSCProcessor
+	ScheduledCallsExecutor       process.ScheduledCallsExecutor
 	ValidatorStatisticsProcessor process.ValidatorStatisticsProcessor
 	RewardsV2EnableEpoch         uint32
 }
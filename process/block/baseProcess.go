@@ -84,6 +84,8 @@ type baseProcessor struct {
 	vmContainer        process.VirtualMachinesContainer
 
 	processDataTriesOnCommitEpoch bool
+	dynamicGasPriceHandler        process.DynamicGasPriceHandler
+	gasPriceTracker               process.GasPriceTracker
 }
 
 type bootStorerDataArgs struct {
@@ -433,10 +435,50 @@ func checkProcessorNilParameters(arguments ArgBaseProcessor) error {
 	if check.IfNil(arguments.CoreComponents.StatusHandler()) {
 		return process.ErrNilAppStatusHandler
 	}
+	if check.IfNil(arguments.DynamicGasPriceHandler) {
+		return process.ErrNilDynamicGasPriceHandler
+	}
+	if check.IfNil(arguments.GasPriceTracker) {
+		return process.ErrNilGasPriceTracker
+	}
 
 	return nil
 }
 
+// computeSelfShardGasUsed returns the gas used by the provided body and the gas prices of its transactions. The gas used
+// is computed as the sum of the gas limits of the transactions originating in the self shard, which is the same measure
+// used to limit the block size
+func (bp *baseProcessor) computeSelfShardGasUsed(body *block.Body) (uint64, []uint64) {
+	txs := bp.txCoordinator.GetAllCurrentUsedTxs(block.TxBlock)
+	selfShardID := bp.shardCoordinator.SelfId()
+
+	gasUsed := uint64(0)
+	gasPrices := make([]uint64, 0, len(txs))
+	for _, miniBlock := range body.MiniBlocks {
+		if miniBlock.Type != block.TxBlock || miniBlock.SenderShardID != selfShardID {
+			continue
+		}
+
+		for _, txHash := range miniBlock.TxHashes {
+			tx, ok := txs[string(txHash)]
+			if !ok {
+				continue
+			}
+
+			gasUsed += tx.GetGasLimit()
+			gasPrices = append(gasPrices, tx.GetGasPrice())
+		}
+	}
+
+	return gasUsed, gasPrices
+}
+
+// updateGasPriceTracker notifies the gas used and the gas prices of the committed block
+func (bp *baseProcessor) updateGasPriceTracker(body *block.Body) {
+	gasUsed, gasPrices := bp.computeSelfShardGasUsed(body)
+	bp.gasPriceTracker.AddBlockGasPrices(gasUsed, gasPrices)
+}
+
 func (bp *baseProcessor) createBlockStarted() {
 	bp.hdrsForCurrBlock.resetMissingHdrs()
 	bp.hdrsForCurrBlock.initMaps()
@@ -1246,7 +1288,9 @@ func (bp *baseProcessor) recordBlockInHistory(blockHeaderHash []byte, blockHeade
 	scrResultsFromPool := bp.txCoordinator.GetAllCurrentUsedTxs(block.SmartContractResultBlock)
 	receiptsFromPool := bp.txCoordinator.GetAllCurrentUsedTxs(block.ReceiptBlock)
 
-	err := bp.historyRepo.RecordBlock(blockHeaderHash, blockHeader, blockBody, scrResultsFromPool, receiptsFromPool)
+	logs := bp.txCoordinator.GetAllCurrentLogs()
+
+	err := bp.historyRepo.RecordBlock(blockHeaderHash, blockHeader, blockBody, scrResultsFromPool, receiptsFromPool, logs)
 	if err != nil {
 		log.Error("historyRepo.RecordBlock()", "blockHeaderHash", blockHeaderHash, "error", err.Error())
 	}
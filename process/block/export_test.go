@@ -16,6 +16,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/state"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/ElrondNetwork/elrond-go/testscommon/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/testscommon/economicsmocks"
 	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
 )
 
@@ -128,6 +129,9 @@ func NewShardProcessorEmptyWith3shards(
 			Version:            "softwareVersion",
 			HistoryRepository:  &dblookupext.HistoryRepositoryStub{},
 			EpochNotifier:      &mock.EpochNotifierStub{},
+
+			DynamicGasPriceHandler: &economicsmocks.EconomicsHandlerStub{},
+			GasPriceTracker:        &testscommon.GasPriceTrackerStub{},
 		},
 	}
 	shardProc, err := NewShardProcessor(arguments)
@@ -359,10 +363,22 @@ func (sp *shardProcessor) ApplyBodyToHeader(shardHdr *block.Header, body *block.
 	return sp.applyBodyToHeader(shardHdr, body)
 }
 
+func (sp *shardProcessor) VerifyDynamicMinGasPrice(shardHdr *block.Header, body *block.Body) error {
+	return sp.verifyDynamicMinGasPrice(shardHdr, body)
+}
+
 func (mp *metaProcessor) CreateBlockBody(metaBlock *block.MetaBlock, haveTime func() bool) (data.BodyHandler, error) {
 	return mp.createBlockBody(metaBlock, haveTime)
 }
 
+func (mp *metaProcessor) CreateEpochStartBody(metaBlock *block.MetaBlock) (data.BodyHandler, error) {
+	return mp.createEpochStartBody(metaBlock)
+}
+
+func (mp *metaProcessor) ProcessEpochStartMetaBlock(header *block.MetaBlock, body *block.Body) error {
+	return mp.processEpochStartMetaBlock(header, body)
+}
+
 func (sp *shardProcessor) CreateBlockBody(shardHdr *block.Header, haveTime func() bool) (data.BodyHandler, error) {
 	return sp.createBlockBody(shardHdr, haveTime)
 }
@@ -34,6 +34,7 @@ type metaProcessor struct {
 	epochRewardsCreator          process.RewardsCreator
 	validatorInfoCreator         process.EpochStartValidatorInfoCreator
 	epochSystemSCProcessor       process.EpochStartSystemSCProcessor
+	scheduledCallsExecutor       process.ScheduledCallsExecutor
 	pendingMiniBlocksHandler     process.PendingMiniBlocksHandler
 	validatorStatisticsProcessor process.ValidatorStatisticsProcessor
 	shardsHeadersNonce           *sync.Map
@@ -81,6 +82,9 @@ func NewMetaProcessor(arguments ArgMetaProcessor) (*metaProcessor, error) {
 	if check.IfNil(arguments.EpochSystemSCProcessor) {
 		return nil, process.ErrNilEpochStartSystemSCProcessor
 	}
+	if check.IfNil(arguments.ScheduledCallsExecutor) {
+		return nil, process.ErrNilScheduledCallsExecutor
+	}
 
 	genesisHdr := arguments.DataComponents.Blockchain().GetGenesisHeader()
 	base := &baseProcessor{
@@ -114,6 +118,8 @@ func NewMetaProcessor(arguments ArgMetaProcessor) (*metaProcessor, error) {
 		vmContainerFactory:            arguments.VMContainersFactory,
 		vmContainer:                   arguments.VmContainer,
 		processDataTriesOnCommitEpoch: arguments.Config.Debug.EpochStart.ProcessDataTrieOnCommitEpoch,
+		dynamicGasPriceHandler:        arguments.DynamicGasPriceHandler,
+		gasPriceTracker:               arguments.GasPriceTracker,
 	}
 
 	mp := metaProcessor{
@@ -127,6 +133,7 @@ func NewMetaProcessor(arguments ArgMetaProcessor) (*metaProcessor, error) {
 		validatorStatisticsProcessor: arguments.ValidatorStatisticsProcessor,
 		validatorInfoCreator:         arguments.EpochValidatorInfoCreator,
 		epochSystemSCProcessor:       arguments.EpochSystemSCProcessor,
+		scheduledCallsExecutor:       arguments.ScheduledCallsExecutor,
 		rewardsV2EnableEpoch:         arguments.RewardsV2EnableEpoch,
 	}
 
@@ -320,6 +327,11 @@ func (mp *metaProcessor) ProcessBlock(
 		return err
 	}
 
+	err = mp.scheduledCallsExecutor.ExecuteDueCalls(header)
+	if err != nil {
+		return err
+	}
+
 	err = mp.txCoordinator.ProcessBlockTransaction(body, haveTime)
 	if err != nil {
 		return err
@@ -404,6 +416,10 @@ func (mp *metaProcessor) processEpochStartMetaBlock(
 		}
 	}
 
+	if !bytes.Equal(header.Reserved, mp.epochSystemSCProcessor.GovernedConfigData()) {
+		return process.ErrGovernedConfigMismatch
+	}
+
 	err = mp.epochSystemSCProcessor.ProcessDelegationRewards(body.MiniBlocks, mp.epochRewardsCreator.GetLocalTxCache())
 	if err != nil {
 		return err
@@ -853,6 +869,7 @@ func (mp *metaProcessor) createEpochStartBody(metaBlock *block.MetaBlock) (data.
 		}
 	}
 
+	metaBlock.Reserved = mp.epochSystemSCProcessor.GovernedConfigData()
 	metaBlock.EpochStart.Economics.RewardsForProtocolSustainability.Set(mp.epochRewardsCreator.GetProtocolSustainabilityRewards())
 
 	err = mp.epochSystemSCProcessor.ProcessDelegationRewards(rewardMiniBlocks, mp.epochRewardsCreator.GetLocalTxCache())
@@ -888,6 +905,11 @@ func (mp *metaProcessor) createBlockBody(metaBlock *block.MetaBlock, haveTime fu
 		"nonce", metaBlock.GetNonce(),
 	)
 
+	err := mp.scheduledCallsExecutor.ExecuteDueCalls(metaBlock)
+	if err != nil {
+		return nil, err
+	}
+
 	miniBlocks, err := mp.createMiniBlocks(haveTime)
 	if err != nil {
 		return nil, err
@@ -908,6 +930,7 @@ func (mp *metaProcessor) createMiniBlocks(
 
 	if !haveTime() {
 		log.Debug("metaProcessor.createMiniBlocks", "error", process.ErrTimeIsOut)
+		miniBlocks = append(miniBlocks, mp.txCoordinator.CreatePostProcessMiniBlocks()...)
 		return &block.Body{MiniBlocks: miniBlocks}, nil
 	}
 
@@ -1252,6 +1275,7 @@ func (mp *metaProcessor) CommitBlock(
 	mp.prepareDataForBootStorer(args)
 
 	mp.blockSizeThrottler.Succeed(header.Round)
+	mp.updateGasPriceTracker(body)
 
 	mp.displayPoolsInfo()
 
@@ -24,6 +24,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	dataRetrieverMock "github.com/ElrondNetwork/elrond-go/testscommon/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/testscommon/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/testscommon/economicsmocks"
 	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
 	"github.com/stretchr/testify/assert"
 )
@@ -113,6 +114,9 @@ func createMockMetaArguments(
 			BlockSizeThrottler: &mock.BlockSizeThrottlerStub{},
 			HistoryRepository:  &dblookupext.HistoryRepositoryStub{},
 			EpochNotifier:      &mock.EpochNotifierStub{},
+
+			DynamicGasPriceHandler: &economicsmocks.EconomicsHandlerStub{},
+			GasPriceTracker:        &testscommon.GasPriceTrackerStub{},
 		},
 		SCToProtocol:                 &mock.SCToProtocolStub{},
 		PendingMiniBlocksHandler:     &mock.PendingMiniBlocksHandlerStub{},
@@ -122,6 +126,7 @@ func createMockMetaArguments(
 		EpochValidatorInfoCreator:    &mock.EpochValidatorInfoCreatorStub{},
 		ValidatorStatisticsProcessor: &mock.ValidatorStatisticsProcessorStub{},
 		EpochSystemSCProcessor:       &mock.EpochStartSystemSCStub{},
+		ScheduledCallsExecutor:       &mock.ScheduledCallsExecutorStub{},
 	}
 	return arguments
 }
@@ -366,6 +371,28 @@ func TestNewMetaProcessor_NilBlockSizeThrottlerShouldErr(t *testing.T) {
 	assert.Nil(t, be)
 }
 
+func TestNewMetaProcessor_NilDynamicGasPriceHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockMetaArguments(createMockComponentHolders())
+	arguments.DynamicGasPriceHandler = nil
+
+	be, err := blproc.NewMetaProcessor(arguments)
+	assert.Equal(t, process.ErrNilDynamicGasPriceHandler, err)
+	assert.Nil(t, be)
+}
+
+func TestNewMetaProcessor_NilGasPriceTrackerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockMetaArguments(createMockComponentHolders())
+	arguments.GasPriceTracker = nil
+
+	be, err := blproc.NewMetaProcessor(arguments)
+	assert.Equal(t, process.ErrNilGasPriceTracker, err)
+	assert.Nil(t, be)
+}
+
 func TestNewMetaProcessor_OkValsShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -2768,3 +2795,34 @@ func TestMetaProcessor_CreateAndProcessBlockCallsProcessAfterFirstEpoch(t *testi
 	assert.Nil(t, err)
 	assert.True(t, toggleCalled, calledSaveNodesCoordinator)
 }
+
+func TestMetaProcessor_EpochStartBlockShouldHoldTheGovernedConfig(t *testing.T) {
+	t.Parallel()
+
+	governedConfigData := []byte("governed config")
+	coreComponents, dataComponents, bootstrapComponents, statusComponents := createMockComponentHolders()
+	arguments := createMockMetaArguments(coreComponents, dataComponents, bootstrapComponents, statusComponents)
+	arguments.EpochSystemSCProcessor = &mock.EpochStartSystemSCStub{
+		GovernedConfigDataCalled: func() []byte {
+			return governedConfigData
+		},
+	}
+	mp, _ := blproc.NewMetaProcessor(arguments)
+
+	metaBlock := &block.MetaBlock{
+		Epoch: 1,
+		EpochStart: block.EpochStart{
+			LastFinalizedHeaders: []block.EpochStartShardData{{}},
+			Economics: block.Economics{
+				RewardsForProtocolSustainability: big.NewInt(0),
+			},
+		},
+	}
+	_, err := mp.CreateEpochStartBody(metaBlock)
+	assert.Nil(t, err)
+	assert.Equal(t, governedConfigData, metaBlock.Reserved)
+
+	metaBlock.Reserved = []byte("other governed config")
+	err = mp.ProcessEpochStartMetaBlock(metaBlock, &block.Body{})
+	assert.Equal(t, process.ErrGovernedConfigMismatch, err)
+}
@@ -1073,12 +1073,28 @@ func (txs *transactions) computeSortedTxs(
 
 	sortedTransactionsProvider := createSortedTransactionsProvider(txShardPool)
 	log.Debug("computeSortedTxs.GetSortedTransactions")
-	sortedTxs := sortedTransactionsProvider.GetSortedTransactions()
+	sortedTxs := txs.filterTxsBelowDynamicMinGasPrice(sortedTransactionsProvider.GetSortedTransactions())
 
 	SortTransactionsBySenderAndNonce(sortedTxs)
 	return sortedTxs, nil
 }
 
+// filterTxsBelowDynamicMinGasPrice leaves out of the selection the transactions paying less than the dynamic minimum
+// gas price. They are kept in the pool, as they can be selected once the price decreases
+func (txs *transactions) filterTxsBelowDynamicMinGasPrice(sortedTxs []*txcache.WrappedTransaction) []*txcache.WrappedTransaction {
+	minGasPrice := txs.economicsFee.DynamicMinGasPrice()
+	filteredTxs := make([]*txcache.WrappedTransaction, 0, len(sortedTxs))
+	for _, wrappedTx := range sortedTxs {
+		if wrappedTx.Tx.GetGasPrice() < minGasPrice {
+			continue
+		}
+
+		filteredTxs = append(filteredTxs, wrappedTx)
+	}
+
+	return filteredTxs
+}
+
 // ProcessMiniBlock processes all the transactions from a and saves the processed transactions in local cache complete miniblock
 func (txs *transactions) ProcessMiniBlock(
 	miniBlock *block.MiniBlock,
@@ -1240,3 +1240,47 @@ func TestTransactionsPreprocessor_ProcessMiniBlockShouldWork(t *testing.T) {
 	assert.Equal(t, 0, len(txsToBeReverted))
 	assert.Equal(t, 3, numTxsProcessed)
 }
+
+func TestTransactions_ComputeSortedTxsShouldSkipTxsBelowDynamicMinGasPrice(t *testing.T) {
+	t.Parallel()
+
+	txPool, _ := dataRetrieverMock.CreateTxPool(2, 0)
+	hasher := &mock.HasherMock{}
+	marshalizer := &mock.MarshalizerMock{}
+	feeHandler := feeHandlerMock()
+	feeHandler.DynamicMinGasPriceCalled = func() uint64 {
+		return 10
+	}
+	txs, _ := NewTransactionPreprocessor(
+		txPool,
+		&mock.ChainStorerMock{},
+		hasher,
+		marshalizer,
+		&testscommon.TxProcessorMock{},
+		mock.NewMultiShardsCoordinatorMock(3),
+		&stateMock.AccountsStub{},
+		func(shardID uint32, txHashes [][]byte) {},
+		feeHandler,
+		&mock.GasHandlerMock{},
+		&mock.BlockTrackerMock{},
+		block.TxBlock,
+		createMockPubkeyConverter(),
+		&mock.BlockSizeComputationStub{},
+		&mock.BalanceComputationStub{},
+	)
+	assert.NotNil(t, txs)
+
+	strCache := process.ShardCacherIdentifier(0, 0)
+	for i, gasPrice := range []uint64{5, 10, 15} {
+		newTx := &transaction.Transaction{Nonce: uint64(i), GasPrice: gasPrice}
+		txHash, _ := core.CalculateHash(marshalizer, hasher, newTx)
+		txPool.AddData(txHash, newTx, newTx.Size(), strCache)
+	}
+
+	sortedTxs, err := txs.computeSortedTxs(0, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(sortedTxs))
+	for _, wrappedTx := range sortedTxs {
+		assert.True(t, wrappedTx.Tx.GetGasPrice() >= 10)
+	}
+}
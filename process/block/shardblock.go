@@ -84,6 +84,8 @@ func NewShardProcessor(arguments ArgShardProcessor) (*shardProcessor, error) {
 		vmContainerFactory:            arguments.VMContainersFactory,
 		vmContainer:                   arguments.VmContainer,
 		processDataTriesOnCommitEpoch: arguments.Config.Debug.EpochStart.ProcessDataTrieOnCommitEpoch,
+		dynamicGasPriceHandler:        arguments.DynamicGasPriceHandler,
+		gasPriceTracker:               arguments.GasPriceTracker,
 	}
 
 	sp := shardProcessor{
@@ -277,6 +279,11 @@ func (sp *shardProcessor) ProcessBlock(
 		return err
 	}
 
+	err = sp.verifyDynamicMinGasPrice(header, body)
+	if err != nil {
+		return err
+	}
+
 	if !sp.verifyStateRoot(header.GetRootHash()) {
 		err = process.ErrRootStateDoesNotMatch
 		return err
@@ -349,6 +356,46 @@ func (sp *shardProcessor) RevertStateToBlock(header data.HeaderHandler) error {
 		return err
 	}
 
+	sp.dynamicGasPriceHandler.SetDynamicMinGasPrice(sp.getDynamicMinGasPrice(header))
+
+	return nil
+}
+
+// computeDynamicMinGasPriceData returns the content of the reserved field of a shard header built on top of the current
+// block: the dynamic minimum gas price of the previous header adjusted with the gas used by the provided body. It is
+// empty while the dynamic minimum gas price is not active
+func (sp *shardProcessor) computeDynamicMinGasPriceData(body *block.Body) []byte {
+	if !sp.dynamicGasPriceHandler.IsDynamicMinGasPriceEnabled() {
+		return nil
+	}
+
+	previousPrice := sp.getDynamicMinGasPrice(sp.blockChain.GetCurrentBlockHeader())
+	gasUsed, _ := sp.computeSelfShardGasUsed(body)
+	price := sp.dynamicGasPriceHandler.ComputeDynamicMinGasPrice(previousPrice, gasUsed)
+
+	return sp.uint64Converter.ToByteSlice(price)
+}
+
+// getDynamicMinGasPrice returns the dynamic minimum gas price carried by the provided shard header or 0 if the header
+// does not hold one
+func (sp *shardProcessor) getDynamicMinGasPrice(header data.HeaderHandler) uint64 {
+	if check.IfNil(header) || len(header.GetReserved()) == 0 {
+		return 0
+	}
+
+	price, err := sp.uint64Converter.ToUint64(header.GetReserved())
+	if err != nil {
+		return 0
+	}
+
+	return price
+}
+
+func (sp *shardProcessor) verifyDynamicMinGasPrice(header *block.Header, body *block.Body) error {
+	if !bytes.Equal(header.GetReserved(), sp.computeDynamicMinGasPriceData(body)) {
+		return process.ErrDynamicMinGasPriceMismatch
+	}
+
 	return nil
 }
 
@@ -929,6 +976,8 @@ func (sp *shardProcessor) CommitBlock(
 	)
 
 	sp.blockSizeThrottler.Succeed(header.Round)
+	sp.updateGasPriceTracker(body)
+	sp.dynamicGasPriceHandler.SetDynamicMinGasPrice(sp.getDynamicMinGasPrice(header))
 
 	sp.displayPoolsInfo()
 
@@ -1796,6 +1845,7 @@ func (sp *shardProcessor) applyBodyToHeader(shardHeader *block.Header, body *blo
 	metaBlockHashes := sp.sortHeaderHashesForCurrentBlockByNonce(true)
 	sw.Stop("sortHeaderHashesForCurrentBlockByNonce")
 	shardHeader.MetaBlockHashes = metaBlockHashes[core.MetachainShardId]
+	shardHeader.Reserved = sp.computeDynamicMinGasPriceData(newBody)
 
 	err = sp.txCoordinator.VerifyCreatedMiniBlocks(shardHeader, newBody)
 	if err != nil {
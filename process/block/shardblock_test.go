@@ -18,6 +18,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
 	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/data/typeConverters/uint64ByteSlice"
 	"github.com/ElrondNetwork/elrond-go-core/hashing"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	"github.com/ElrondNetwork/elrond-go/common"
@@ -32,6 +33,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	dataRetrieverMock "github.com/ElrondNetwork/elrond-go/testscommon/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/testscommon/economicsmocks"
 	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/stretchr/testify/assert"
@@ -280,6 +282,30 @@ func TestNewShardProcessor_NilBlockSizeThrottlerShouldErr(t *testing.T) {
 	assert.Nil(t, sp)
 }
 
+func TestNewShardProcessor_NilDynamicGasPriceHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	coreComponents, dataComponents, bootstrapComponents, statusComponents := createComponentHolderMocks()
+	arguments := CreateMockArguments(coreComponents, dataComponents, bootstrapComponents, statusComponents)
+	arguments.DynamicGasPriceHandler = nil
+	sp, err := blproc.NewShardProcessor(arguments)
+
+	assert.Equal(t, process.ErrNilDynamicGasPriceHandler, err)
+	assert.Nil(t, sp)
+}
+
+func TestNewShardProcessor_NilGasPriceTrackerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	coreComponents, dataComponents, bootstrapComponents, statusComponents := createComponentHolderMocks()
+	arguments := CreateMockArguments(coreComponents, dataComponents, bootstrapComponents, statusComponents)
+	arguments.GasPriceTracker = nil
+	sp, err := blproc.NewShardProcessor(arguments)
+
+	assert.Equal(t, process.ErrNilGasPriceTracker, err)
+	assert.Nil(t, sp)
+}
+
 func TestNewShardProcessor_OkValsShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -2224,6 +2250,56 @@ func TestShardProcessor_ApplyBodyToHeaderReturnsOK(t *testing.T) {
 	assert.Equal(t, len(body.MiniBlocks), len(hdr.MiniBlockHeaders))
 }
 
+func TestShardProcessor_ApplyBodyToHeaderShouldSetDynamicMinGasPrice(t *testing.T) {
+	t.Parallel()
+
+	converter := uint64ByteSlice.NewBigEndianConverter()
+	coreComponents, dataComponents, bootstrapComponents, statusComponents := createComponentHolderMocks()
+	coreComponents.UInt64ByteSliceConv = converter
+	_ = dataComponents.BlockChain.SetCurrentBlockHeader(&block.Header{
+		Nonce:    1,
+		Reserved: converter.ToByteSlice(100),
+	})
+	arguments := CreateMockArguments(coreComponents, dataComponents, bootstrapComponents, statusComponents)
+	arguments.DynamicGasPriceHandler = &economicsmocks.EconomicsHandlerStub{
+		IsDynamicMinGasPriceEnabledCalled: func() bool {
+			return true
+		},
+		ComputeDynamicMinGasPriceCalled: func(previousPrice uint64, gasUsed uint64) uint64 {
+			assert.Equal(t, uint64(100), previousPrice)
+			return previousPrice + 10
+		},
+	}
+	bp, _ := blproc.NewShardProcessor(arguments)
+
+	body := &block.Body{}
+	hdr := &block.Header{}
+	_, err := bp.ApplyBodyToHeader(hdr, body)
+	assert.Nil(t, err)
+	assert.Equal(t, converter.ToByteSlice(110), hdr.Reserved)
+
+	err = bp.VerifyDynamicMinGasPrice(hdr, body)
+	assert.Nil(t, err)
+
+	hdr.Reserved = converter.ToByteSlice(120)
+	err = bp.VerifyDynamicMinGasPrice(hdr, body)
+	assert.Equal(t, process.ErrDynamicMinGasPriceMismatch, err)
+}
+
+func TestShardProcessor_VerifyDynamicMinGasPriceNotEnabledShouldRequireEmptyReserved(t *testing.T) {
+	t.Parallel()
+
+	coreComponents, dataComponents, bootstrapComponents, statusComponents := createComponentHolderMocks()
+	arguments := CreateMockArguments(coreComponents, dataComponents, bootstrapComponents, statusComponents)
+	bp, _ := blproc.NewShardProcessor(arguments)
+
+	err := bp.VerifyDynamicMinGasPrice(&block.Header{}, &block.Body{})
+	assert.Nil(t, err)
+
+	err = bp.VerifyDynamicMinGasPrice(&block.Header{Reserved: []byte("price")}, &block.Body{})
+	assert.Equal(t, process.ErrDynamicMinGasPriceMismatch, err)
+}
+
 func TestShardProcessor_CommitBlockShouldRevertAccountStateWhenErr(t *testing.T) {
 	t.Parallel()
 	// set accounts dirty
@@ -5,10 +5,12 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/interceptors/processor"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/state"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
 
 var _ process.TxValidator = (*txValidator)(nil)
@@ -19,6 +21,7 @@ type txValidator struct {
 	shardCoordinator     sharding.Coordinator
 	whiteListHandler     process.WhiteListHandler
 	pubkeyConverter      core.PubkeyConverter
+	guardedAccounts      process.GuardedAccountHandler
 	maxNonceDeltaAllowed int
 }
 
@@ -28,6 +31,7 @@ func NewTxValidator(
 	shardCoordinator sharding.Coordinator,
 	whiteListHandler process.WhiteListHandler,
 	pubkeyConverter core.PubkeyConverter,
+	guardedAccounts process.GuardedAccountHandler,
 	maxNonceDeltaAllowed int,
 ) (*txValidator, error) {
 	if check.IfNil(accounts) {
@@ -42,6 +46,9 @@ func NewTxValidator(
 	if check.IfNil(pubkeyConverter) {
 		return nil, fmt.Errorf("%w in NewTxValidator", process.ErrNilPubkeyConverter)
 	}
+	if check.IfNil(guardedAccounts) {
+		return nil, process.ErrNilGuardedAccountHandler
+	}
 
 	return &txValidator{
 		accounts:             accounts,
@@ -49,6 +56,7 @@ func NewTxValidator(
 		whiteListHandler:     whiteListHandler,
 		maxNonceDeltaAllowed: maxNonceDeltaAllowed,
 		pubkeyConverter:      pubkeyConverter,
+		guardedAccounts:      guardedAccounts,
 	}, nil
 }
 
@@ -113,6 +121,32 @@ func (txv *txValidator) CheckTxValidity(interceptedTx process.TxValidatorHandler
 		)
 	}
 
+	return txv.checkGuardedAccount(interceptedTx, account)
+}
+
+func (txv *txValidator) checkGuardedAccount(interceptedTx process.TxValidatorHandler, account state.UserAccountHandler) error {
+	txHandler, ok := interceptedTx.(processor.InterceptedTransactionHandler)
+	if !ok {
+		return nil
+	}
+	tx, ok := txHandler.Transaction().(*transaction.Transaction)
+	if !ok {
+		return nil
+	}
+
+	userAccount, ok := account.(vmcommon.UserAccountHandler)
+	if !ok {
+		return process.ErrWrongTypeAssertion
+	}
+
+	err := txv.guardedAccounts.CheckGuardedTransaction(userAccount, tx)
+	if err != nil {
+		return fmt.Errorf("%w, for address: %s",
+			err,
+			txv.pubkeyConverter.Encode(account.AddressBytes()),
+		)
+	}
+
 	return nil
 }
 
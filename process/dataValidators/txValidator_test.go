@@ -7,6 +7,8 @@ import (
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/dataValidators"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
@@ -77,6 +79,7 @@ func TestNewTxValidator_NilAccountsShouldErr(t *testing.T) {
 		shardCoordinator,
 		&testscommon.WhiteListHandlerStub{},
 		mock.NewPubkeyConverterMock(32),
+		&testscommon.GuardedAccountHandlerStub{},
 		maxNonceDeltaAllowed,
 	)
 
@@ -94,6 +97,7 @@ func TestNewTxValidator_NilShardCoordinatorShouldErr(t *testing.T) {
 		nil,
 		&testscommon.WhiteListHandlerStub{},
 		mock.NewPubkeyConverterMock(32),
+		&testscommon.GuardedAccountHandlerStub{},
 		maxNonceDeltaAllowed,
 	)
 
@@ -112,6 +116,7 @@ func TestTxValidator_NewValidatorNilWhiteListHandlerShouldErr(t *testing.T) {
 		shardCoordinator,
 		nil,
 		mock.NewPubkeyConverterMock(32),
+		&testscommon.GuardedAccountHandlerStub{},
 		maxNonceDeltaAllowed,
 	)
 
@@ -130,6 +135,7 @@ func TestNewTxValidator_NilPubkeyConverterShouldErr(t *testing.T) {
 		shardCoordinator,
 		&testscommon.WhiteListHandlerStub{},
 		nil,
+		&testscommon.GuardedAccountHandlerStub{},
 		maxNonceDeltaAllowed,
 	)
 
@@ -137,6 +143,25 @@ func TestNewTxValidator_NilPubkeyConverterShouldErr(t *testing.T) {
 	assert.True(t, errors.Is(err, process.ErrNilPubkeyConverter))
 }
 
+func TestNewTxValidator_NilGuardedAccountHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	adb := getAccAdapter(0, big.NewInt(0))
+	shardCoordinator := createMockCoordinator("_", 0)
+	maxNonceDeltaAllowed := 100
+	txValidator, err := dataValidators.NewTxValidator(
+		adb,
+		shardCoordinator,
+		&testscommon.WhiteListHandlerStub{},
+		mock.NewPubkeyConverterMock(32),
+		nil,
+		maxNonceDeltaAllowed,
+	)
+
+	assert.Nil(t, txValidator)
+	assert.Equal(t, process.ErrNilGuardedAccountHandler, err)
+}
+
 func TestNewTxValidator_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -148,6 +173,7 @@ func TestNewTxValidator_ShouldWork(t *testing.T) {
 		shardCoordinator,
 		&testscommon.WhiteListHandlerStub{},
 		mock.NewPubkeyConverterMock(32),
+		&testscommon.GuardedAccountHandlerStub{},
 		maxNonceDeltaAllowed,
 	)
 
@@ -170,6 +196,7 @@ func TestTxValidator_CheckTxValidityTxCrossShardShouldWork(t *testing.T) {
 		shardCoordinator,
 		&testscommon.WhiteListHandlerStub{},
 		mock.NewPubkeyConverterMock(32),
+		&testscommon.GuardedAccountHandlerStub{},
 		maxNonceDeltaAllowed,
 	)
 	assert.Nil(t, err)
@@ -195,6 +222,7 @@ func TestTxValidator_CheckTxValidityAccountNonceIsGreaterThanTxNonceShouldReturn
 		shardCoordinator,
 		&testscommon.WhiteListHandlerStub{},
 		mock.NewPubkeyConverterMock(32),
+		&testscommon.GuardedAccountHandlerStub{},
 		maxNonceDeltaAllowed,
 	)
 	assert.Nil(t, err)
@@ -221,6 +249,7 @@ func TestTxValidator_CheckTxValidityTxNonceIsTooHigh(t *testing.T) {
 		shardCoordinator,
 		&testscommon.WhiteListHandlerStub{},
 		mock.NewPubkeyConverterMock(32),
+		&testscommon.GuardedAccountHandlerStub{},
 		maxNonceDeltaAllowed,
 	)
 	assert.Nil(t, err)
@@ -249,6 +278,7 @@ func TestTxValidator_CheckTxValidityAccountBalanceIsLessThanTxTotalValueShouldRe
 		shardCoordinator,
 		&testscommon.WhiteListHandlerStub{},
 		mock.NewPubkeyConverterMock(32),
+		&testscommon.GuardedAccountHandlerStub{},
 		maxNonceDeltaAllowed,
 	)
 	assert.Nil(t, err)
@@ -276,6 +306,7 @@ func TestTxValidator_CheckTxValidityAccountNotExitsShouldReturnFalse(t *testing.
 		shardCoordinator,
 		&testscommon.WhiteListHandlerStub{},
 		mock.NewPubkeyConverterMock(32),
+		&testscommon.GuardedAccountHandlerStub{},
 		maxNonceDeltaAllowed,
 	)
 
@@ -305,6 +336,7 @@ func TestTxValidator_CheckTxValidityAccountNotExitsButWhiteListedShouldReturnTru
 			},
 		},
 		mock.NewPubkeyConverterMock(32),
+		&testscommon.GuardedAccountHandlerStub{},
 		maxNonceDeltaAllowed,
 	)
 
@@ -339,6 +371,7 @@ func TestTxValidator_CheckTxValidityWrongAccountTypeShouldReturnFalse(t *testing
 		shardCoordinator,
 		&testscommon.WhiteListHandlerStub{},
 		mock.NewPubkeyConverterMock(32),
+		&testscommon.GuardedAccountHandlerStub{},
 		maxNonceDeltaAllowed,
 	)
 
@@ -363,6 +396,7 @@ func TestTxValidator_CheckTxValidityTxIsOkShouldReturnTrue(t *testing.T) {
 		shardCoordinator,
 		&testscommon.WhiteListHandlerStub{},
 		mock.NewPubkeyConverterMock(32),
+		&testscommon.GuardedAccountHandlerStub{},
 		maxNonceDeltaAllowed,
 	)
 
@@ -374,6 +408,54 @@ func TestTxValidator_CheckTxValidityTxIsOkShouldReturnTrue(t *testing.T) {
 	assert.Nil(t, result)
 }
 
+func TestTxValidator_CheckTxValidityGuardedAccountCheckFailsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	adb := getAccAdapter(0, big.NewInt(10))
+	shardCoordinator := createMockCoordinator("_", 0)
+	maxNonceDeltaAllowed := 100
+	tx := &transaction.Transaction{Nonce: 1}
+	txValidator, _ := dataValidators.NewTxValidator(
+		adb,
+		shardCoordinator,
+		&testscommon.WhiteListHandlerStub{},
+		mock.NewPubkeyConverterMock(32),
+		&testscommon.GuardedAccountHandlerStub{
+			CheckGuardedTransactionCalled: func(uah vmcommon.UserAccountHandler, providedTx *transaction.Transaction) error {
+				assert.True(t, tx == providedTx)
+				return expectedErr
+			},
+		},
+		maxNonceDeltaAllowed,
+	)
+
+	addressMock := []byte("address")
+	interceptedTx := &mock.InterceptedTxHandlerStub{
+		SenderShardIdCalled: func() uint32 {
+			return 0
+		},
+		ReceiverShardIdCalled: func() uint32 {
+			return 0
+		},
+		NonceCalled: func() uint64 {
+			return 1
+		},
+		SenderAddressCalled: func() []byte {
+			return addressMock
+		},
+		FeeCalled: func() *big.Int {
+			return big.NewInt(0)
+		},
+		TransactionCalled: func() data.TransactionHandler {
+			return tx
+		},
+	}
+
+	result := txValidator.CheckTxValidity(interceptedTx)
+	assert.True(t, errors.Is(result, expectedErr))
+}
+
 //------- IsInterfaceNil
 
 func TestTxValidator_IsInterfaceNil(t *testing.T) {
@@ -386,6 +468,7 @@ func TestTxValidator_IsInterfaceNil(t *testing.T) {
 		shardCoordinator,
 		&testscommon.WhiteListHandlerStub{},
 		mock.NewPubkeyConverterMock(32),
+		&testscommon.GuardedAccountHandlerStub{},
 		100,
 	)
 	_ = txValidator
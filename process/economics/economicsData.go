@@ -2,9 +2,11 @@ package economics
 
 import (
 	"fmt"
+	"math"
 	"math/big"
 	"sort"
 	"strconv"
+	"strings"
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
@@ -14,6 +16,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/common/governance"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
@@ -24,6 +27,13 @@ var _ process.RewardsHandler = (*economicsData)(nil)
 var _ process.FeeHandler = (*economicsData)(nil)
 
 var epsilon = 0.00000001
+
+const (
+	penalizedTooMuchGasFlag = governance.PenalizedTooMuchGasEnableEpoch
+	gasPriceModifierFlag    = governance.GasPriceModifierEnableEpoch
+	gasPriceModifierSetting = "GasPriceModifier"
+)
+
 var log = logger.GetOrCreate("process/economics")
 
 // economicsData will store information about economics
@@ -37,12 +47,19 @@ type economicsData struct {
 	topUpGradientPoint               *big.Int
 	topUpFactor                      float64
 	mutRewardsSettings               sync.RWMutex
+	feeSettings                      []*feeConfig
+	staticFeeSettings                []*feeConfig
+	activeFeeSetting                 *feeConfig
 	maxGasLimitPerBlock              uint64
 	maxGasLimitPerMetaBlock          uint64
 	gasPerDataByte                   uint64
 	minGasPrice                      uint64
 	gasPriceModifier                 float64
 	minGasLimit                      uint64
+	dynamicMinGasPrice               uint64
+	mutFeeSettings                   sync.RWMutex
+	dynamicMinGasPriceSettings       *dynamicMinGasPriceConfig
+	flagDynamicMinGasPrice           atomic.Flag
 	genesisTotalSupply               *big.Int
 	minInflation                     float64
 	yearSettings                     map[uint32]*config.YearSetting
@@ -51,10 +68,29 @@ type economicsData struct {
 	flagGasPriceModifier             atomic.Flag
 	penalizedTooMuchGasEnableEpoch   uint32
 	gasPriceModifierEnableEpoch      uint32
+	staticEnableEpochs               map[string]uint32
 	statusHandler                    core.AppStatusHandler
 	builtInFunctionsCostHandler      BuiltInFunctionsCostHandler
 }
 
+type feeConfig struct {
+	epochEnable             uint32
+	maxGasLimitPerBlock     uint64
+	maxGasLimitPerMetaBlock uint64
+	gasPerDataByte          uint64
+	minGasPrice             uint64
+	minGasLimit             uint64
+	gasPriceModifier        float64
+}
+
+type dynamicMinGasPriceConfig struct {
+	enabled                 bool
+	enableEpoch             uint32
+	targetGasUsedPercentage float64
+	maxChangeDenominator    uint64
+	maxMinGasPrice          uint64
+}
+
 // ArgsNewEconomicsData defines the arguments needed for new economics economicsData
 type ArgsNewEconomicsData struct {
 	BuiltInFunctionsCostHandler    BuiltInFunctionsCostHandler
@@ -70,18 +106,30 @@ func NewEconomicsData(args ArgsNewEconomicsData) (*economicsData, error) {
 		return nil, process.ErrNilBuiltInFunctionsCostHandler
 	}
 
-	convertedData, err := convertValues(args.Economics)
+	feeConfigs, err := convertFeeSettings(args.Economics.FeeSettings)
 	if err != nil {
 		return nil, err
 	}
 
+	genesisTotalSupply, ok := big.NewInt(0).SetString(args.Economics.GlobalSettings.GenesisTotalSupply, 10)
+	if !ok {
+		return nil, process.ErrInvalidGenesisTotalSupply
+	}
+
 	err = checkValues(args.Economics)
 	if err != nil {
 		return nil, err
 	}
 
-	if convertedData.maxGasLimitPerBlock < convertedData.minGasLimit {
-		return nil, process.ErrInvalidMaxGasLimitPerBlock
+	for _, fc := range feeConfigs {
+		if fc.maxGasLimitPerBlock < fc.minGasLimit {
+			return nil, process.ErrInvalidMaxGasLimitPerBlock
+		}
+	}
+
+	dynamicSettings, err := convertDynamicMinGasPriceSettings(args.Economics.FeeSettings.DynamicMinGasPrice, feeConfigs)
+	if err != nil {
+		return nil, err
 	}
 	if check.IfNil(args.EpochNotifier) {
 		return nil, process.ErrNilEpochNotifier
@@ -106,19 +154,27 @@ func NewEconomicsData(args ArgsNewEconomicsData) (*economicsData, error) {
 		developerPercentage:              rewardsConfigs[0].DeveloperPercentage,
 		topUpFactor:                      rewardsConfigs[0].TopUpFactor,
 		topUpGradientPoint:               topUpGradientPoint,
-		maxGasLimitPerBlock:              convertedData.maxGasLimitPerBlock,
-		maxGasLimitPerMetaBlock:          convertedData.maxGasLimitPerMetaBlock,
-		minGasPrice:                      convertedData.minGasPrice,
-		minGasLimit:                      convertedData.minGasLimit,
-		gasPerDataByte:                   convertedData.gasPerDataByte,
+		feeSettings:                      feeConfigs,
+		staticFeeSettings:                feeConfigs,
+		activeFeeSetting:                 feeConfigs[0],
+		maxGasLimitPerBlock:              feeConfigs[0].maxGasLimitPerBlock,
+		maxGasLimitPerMetaBlock:          feeConfigs[0].maxGasLimitPerMetaBlock,
+		minGasPrice:                      feeConfigs[0].minGasPrice,
+		minGasLimit:                      feeConfigs[0].minGasLimit,
+		gasPerDataByte:                   feeConfigs[0].gasPerDataByte,
+		gasPriceModifier:                 feeConfigs[0].gasPriceModifier,
+		dynamicMinGasPriceSettings:       dynamicSettings,
 		minInflation:                     args.Economics.GlobalSettings.MinimumInflation,
-		genesisTotalSupply:               convertedData.genesisTotalSupply,
+		genesisTotalSupply:               genesisTotalSupply,
 		penalizedTooMuchGasEnableEpoch:   args.PenalizedTooMuchGasEnableEpoch,
 		gasPriceModifierEnableEpoch:      args.GasPriceModifierEnableEpoch,
-		gasPriceModifier:                 args.Economics.FeeSettings.GasPriceModifier,
 		statusHandler:                    statusHandler.NewNilStatusHandler(),
 		builtInFunctionsCostHandler:      args.BuiltInFunctionsCostHandler,
 	}
+	ed.staticEnableEpochs = map[string]uint32{
+		penalizedTooMuchGasFlag: args.PenalizedTooMuchGasEnableEpoch,
+		gasPriceModifierFlag:    args.GasPriceModifierEnableEpoch,
+	}
 	log.Debug("economicsData: enable epoch for penalized too much gas", "epoch", ed.penalizedTooMuchGasEnableEpoch)
 	log.Debug("economicsData: enable epoch for gas price modifier", "epoch", ed.gasPriceModifierEnableEpoch)
 
@@ -135,47 +191,107 @@ func NewEconomicsData(args ArgsNewEconomicsData) (*economicsData, error) {
 	return ed, nil
 }
 
-func convertValues(economics *config.EconomicsConfig) (*economicsData, error) {
+// convertFeeSettings returns the fee settings sorted by their enable epoch. The top level settings are active from
+// genesis, until replaced by the settings for a specific epoch
+func convertFeeSettings(feeSettings config.FeeSettings) ([]*feeConfig, error) {
+	genesisSettings := config.EpochFeeSettings{
+		MaxGasLimitPerBlock:     feeSettings.MaxGasLimitPerBlock,
+		MaxGasLimitPerMetaBlock: feeSettings.MaxGasLimitPerMetaBlock,
+		GasPerDataByte:          feeSettings.GasPerDataByte,
+		MinGasPrice:             feeSettings.MinGasPrice,
+		MinGasLimit:             feeSettings.MinGasLimit,
+		GasPriceModifier:        feeSettings.GasPriceModifier,
+		EpochEnable:             0,
+	}
+
+	feeConfigs := make([]*feeConfig, 0, len(feeSettings.FeeConfigByEpoch)+1)
+	for _, epochSettings := range append([]config.EpochFeeSettings{genesisSettings}, feeSettings.FeeConfigByEpoch...) {
+		fc, err := convertValues(epochSettings)
+		if err != nil {
+			return nil, err
+		}
+
+		feeConfigs = append(feeConfigs, fc)
+	}
+
+	sort.SliceStable(feeConfigs, func(i, j int) bool {
+		return feeConfigs[i].epochEnable < feeConfigs[j].epochEnable
+	})
+
+	return feeConfigs, nil
+}
+
+func convertValues(feeSettings config.EpochFeeSettings) (*feeConfig, error) {
 	conversionBase := 10
 	bitConversionSize := 64
 
-	minGasPrice, err := strconv.ParseUint(economics.FeeSettings.MinGasPrice, conversionBase, bitConversionSize)
+	minGasPrice, err := strconv.ParseUint(feeSettings.MinGasPrice, conversionBase, bitConversionSize)
 	if err != nil {
 		return nil, process.ErrInvalidMinimumGasPrice
 	}
 
-	minGasLimit, err := strconv.ParseUint(economics.FeeSettings.MinGasLimit, conversionBase, bitConversionSize)
+	minGasLimit, err := strconv.ParseUint(feeSettings.MinGasLimit, conversionBase, bitConversionSize)
 	if err != nil {
 		return nil, process.ErrInvalidMinimumGasLimitForTx
 	}
 
-	maxGasLimitPerBlock, err := strconv.ParseUint(economics.FeeSettings.MaxGasLimitPerBlock, conversionBase, bitConversionSize)
+	maxGasLimitPerBlock, err := strconv.ParseUint(feeSettings.MaxGasLimitPerBlock, conversionBase, bitConversionSize)
 	if err != nil {
 		return nil, process.ErrInvalidMaxGasLimitPerBlock
 	}
 
-	maxGasLimitPerMetaBlock, err := strconv.ParseUint(economics.FeeSettings.MaxGasLimitPerMetaBlock, conversionBase, bitConversionSize)
+	maxGasLimitPerMetaBlock, err := strconv.ParseUint(feeSettings.MaxGasLimitPerMetaBlock, conversionBase, bitConversionSize)
 	if err != nil {
 		return nil, process.ErrInvalidMaxGasLimitPerBlock
 	}
 
-	gasPerDataByte, err := strconv.ParseUint(economics.FeeSettings.GasPerDataByte, conversionBase, bitConversionSize)
+	gasPerDataByte, err := strconv.ParseUint(feeSettings.GasPerDataByte, conversionBase, bitConversionSize)
 	if err != nil {
 		return nil, process.ErrInvalidGasPerDataByte
 	}
 
-	genesisTotalSupply, ok := big.NewInt(0).SetString(economics.GlobalSettings.GenesisTotalSupply, conversionBase)
-	if !ok {
-		return nil, process.ErrInvalidGenesisTotalSupply
-	}
-
-	return &economicsData{
+	return &feeConfig{
+		epochEnable:             feeSettings.EpochEnable,
 		minGasPrice:             minGasPrice,
 		minGasLimit:             minGasLimit,
 		maxGasLimitPerBlock:     maxGasLimitPerBlock,
 		maxGasLimitPerMetaBlock: maxGasLimitPerMetaBlock,
 		gasPerDataByte:          gasPerDataByte,
-		genesisTotalSupply:      genesisTotalSupply,
+		gasPriceModifier:        feeSettings.GasPriceModifier,
+	}, nil
+}
+
+func convertDynamicMinGasPriceSettings(
+	settings config.DynamicMinGasPriceSettings,
+	feeConfigs []*feeConfig,
+) (*dynamicMinGasPriceConfig, error) {
+	if !settings.Enabled {
+		return &dynamicMinGasPriceConfig{}, nil
+	}
+
+	if settings.TargetGasUsedPercentage < epsilon || settings.TargetGasUsedPercentage > 1.0 {
+		return nil, process.ErrInvalidTargetGasUsedPercentage
+	}
+	if settings.MaxChangeDenominator == 0 {
+		return nil, process.ErrInvalidMaxChangeDenominator
+	}
+
+	maxMinGasPrice, err := strconv.ParseUint(settings.MaxMinGasPrice, 10, 64)
+	if err != nil {
+		return nil, process.ErrInvalidMaxMinGasPrice
+	}
+	for _, fc := range feeConfigs {
+		if maxMinGasPrice < fc.minGasPrice {
+			return nil, process.ErrInvalidMaxMinGasPrice
+		}
+	}
+
+	return &dynamicMinGasPriceConfig{
+		enabled:                 true,
+		enableEpoch:             settings.EnableEpoch,
+		targetGasUsedPercentage: settings.TargetGasUsedPercentage,
+		maxChangeDenominator:    settings.MaxChangeDenominator,
+		maxMinGasPrice:          maxMinGasPrice,
 	}, nil
 }
 
@@ -211,13 +327,22 @@ func checkValues(economics *config.EconomicsConfig) error {
 		}
 	}
 
-	if economics.FeeSettings.GasPriceModifier > 1.0 || economics.FeeSettings.GasPriceModifier < epsilon {
+	if isGasPriceModifierInvalid(economics.FeeSettings.GasPriceModifier) {
 		return process.ErrInvalidGasModifier
 	}
+	for _, feeSettings := range economics.FeeSettings.FeeConfigByEpoch {
+		if isGasPriceModifierInvalid(feeSettings.GasPriceModifier) {
+			return process.ErrInvalidGasModifier
+		}
+	}
 
 	return nil
 }
 
+func isGasPriceModifierInvalid(gasPriceModifier float64) bool {
+	return gasPriceModifier > 1.0 || gasPriceModifier < epsilon
+}
+
 func isPercentageInvalid(percentage float64) bool {
 	isLessThanZero := percentage < 0.0
 	isGreaterThanOne := percentage > 1.0
@@ -272,6 +397,9 @@ func (ed *economicsData) GenesisTotalSupply() *big.Int {
 
 // MinGasPrice will return min gas price
 func (ed *economicsData) MinGasPrice() uint64 {
+	ed.mutFeeSettings.RLock()
+	defer ed.mutFeeSettings.RUnlock()
+
 	return ed.minGasPrice
 }
 
@@ -279,7 +407,7 @@ func (ed *economicsData) MinGasPrice() uint64 {
 func (ed *economicsData) MinGasPriceForProcessing() uint64 {
 	priceModifier := ed.GasPriceModifier()
 
-	return uint64(float64(ed.minGasPrice) * priceModifier)
+	return uint64(float64(ed.MinGasPrice()) * priceModifier)
 }
 
 // GasPriceModifier will return the gas price modifier
@@ -287,19 +415,123 @@ func (ed *economicsData) GasPriceModifier() float64 {
 	if !ed.flagGasPriceModifier.IsSet() {
 		return 1.0
 	}
+
+	ed.mutFeeSettings.RLock()
+	defer ed.mutFeeSettings.RUnlock()
+
 	return ed.gasPriceModifier
 }
 
 // MinGasLimit will return min gas limit
 func (ed *economicsData) MinGasLimit() uint64 {
+	ed.mutFeeSettings.RLock()
+	defer ed.mutFeeSettings.RUnlock()
+
 	return ed.minGasLimit
 }
 
 // GasPerDataByte will return the gas required for a economicsData byte
 func (ed *economicsData) GasPerDataByte() uint64 {
+	ed.mutFeeSettings.RLock()
+	defer ed.mutFeeSettings.RUnlock()
+
 	return ed.gasPerDataByte
 }
 
+// DynamicMinGasPrice returns the minimum gas price carried by the last committed shard header, which the transactions
+// of the next block must pay. It is only enforced by the sender shard when selecting and processing transactions, the
+// interceptors still checking the configured minimum gas price as the transactions of other shards are checked against
+// the prices of their own headers. It is equal to the configured minimum gas price when the dynamic mechanism is not active
+func (ed *economicsData) DynamicMinGasPrice() uint64 {
+	ed.mutFeeSettings.RLock()
+	defer ed.mutFeeSettings.RUnlock()
+
+	return ed.clampDynamicMinGasPrice(ed.dynamicMinGasPrice)
+}
+
+func (ed *economicsData) clampDynamicMinGasPrice(price uint64) uint64 {
+	if !ed.flagDynamicMinGasPrice.IsSet() || price < ed.minGasPrice {
+		return ed.minGasPrice
+	}
+	if price > ed.dynamicMinGasPriceSettings.maxMinGasPrice {
+		return ed.dynamicMinGasPriceSettings.maxMinGasPrice
+	}
+
+	return price
+}
+
+// IsDynamicMinGasPriceEnabled returns true if the shard headers should carry the dynamic minimum gas price
+func (ed *economicsData) IsDynamicMinGasPriceEnabled() bool {
+	return ed.flagDynamicMinGasPrice.IsSet()
+}
+
+// ComputeDynamicMinGasPrice returns the dynamic minimum gas price of a shard block, starting from the price of the
+// previous block and the gas used by the current one. The price increases when the gas used is above the target and
+// decreases otherwise, by at most 1/MaxChangeDenominator of its value
+func (ed *economicsData) ComputeDynamicMinGasPrice(previousPrice uint64, gasUsed uint64) uint64 {
+	ed.mutFeeSettings.RLock()
+	defer ed.mutFeeSettings.RUnlock()
+
+	settings := ed.dynamicMinGasPriceSettings
+	targetGasUsed := uint64(float64(ed.maxGasLimitPerBlock) * settings.targetGasUsedPercentage)
+	currentPrice := ed.clampDynamicMinGasPrice(previousPrice)
+	newPrice := ed.clampDynamicMinGasPrice(computeNextMinGasPrice(currentPrice, gasUsed, targetGasUsed, settings.maxChangeDenominator))
+
+	log.Trace("economics: dynamic min gas price",
+		"gas used", gasUsed,
+		"target gas used", targetGasUsed,
+		"previous price", currentPrice,
+		"new price", newPrice,
+	)
+
+	return newPrice
+}
+
+// SetDynamicMinGasPrice sets the dynamic minimum gas price read from the last committed shard header
+func (ed *economicsData) SetDynamicMinGasPrice(price uint64) {
+	ed.mutFeeSettings.Lock()
+	ed.dynamicMinGasPrice = price
+	newPrice := ed.clampDynamicMinGasPrice(price)
+	ed.mutFeeSettings.Unlock()
+
+	ed.statusHandler.SetUInt64Value(common.MetricDynamicMinGasPrice, newPrice)
+}
+
+func computeNextMinGasPrice(currentPrice uint64, gasUsed uint64, targetGasUsed uint64, maxChangeDenominator uint64) uint64 {
+	if targetGasUsed == 0 || maxChangeDenominator == 0 || gasUsed == targetGasUsed {
+		return currentPrice
+	}
+
+	difference := gasUsed - targetGasUsed
+	if gasUsed < targetGasUsed {
+		difference = targetGasUsed - gasUsed
+	}
+
+	delta := big.NewInt(0).SetUint64(currentPrice)
+	delta.Mul(delta, big.NewInt(0).SetUint64(difference))
+	delta.Div(delta, big.NewInt(0).SetUint64(targetGasUsed))
+	delta.Div(delta, big.NewInt(0).SetUint64(maxChangeDenominator))
+
+	if gasUsed > targetGasUsed {
+		if delta.Sign() == 0 {
+			delta.SetUint64(1)
+		}
+
+		next := big.NewInt(0).Add(big.NewInt(0).SetUint64(currentPrice), delta)
+		if !next.IsUint64() {
+			return math.MaxUint64
+		}
+
+		return next.Uint64()
+	}
+
+	if delta.Uint64() > currentPrice {
+		return 0
+	}
+
+	return currentPrice - delta.Uint64()
+}
+
 // ComputeMoveBalanceFee computes the provided transaction's fee
 func (ed *economicsData) ComputeMoveBalanceFee(tx data.TransactionWithFeeHandler) *big.Int {
 	if isSmartContractResult(tx) {
@@ -373,7 +605,7 @@ func (ed *economicsData) SplitTxGasInCategories(tx data.TransactionWithFeeHandle
 
 // CheckValidityTxValues checks if the provided transaction is economically correct
 func (ed *economicsData) CheckValidityTxValues(tx data.TransactionWithFeeHandler) error {
-	if ed.minGasPrice > tx.GetGasPrice() {
+	if ed.MinGasPrice() > tx.GetGasPrice() {
 		return process.ErrInsufficientGasPriceInTx
 	}
 
@@ -384,7 +616,11 @@ func (ed *economicsData) CheckValidityTxValues(tx data.TransactionWithFeeHandler
 		}
 	}
 
-	if tx.GetGasLimit() >= ed.maxGasLimitPerBlock {
+	ed.mutFeeSettings.RLock()
+	maxGasLimitPerBlock := ed.maxGasLimitPerBlock
+	ed.mutFeeSettings.RUnlock()
+
+	if tx.GetGasLimit() >= maxGasLimitPerBlock {
 		return process.ErrMoreGasThanGasLimitPerBlock
 	}
 
@@ -402,8 +638,8 @@ func (ed *economicsData) CheckValidityTxValues(tx data.TransactionWithFeeHandler
 
 // MaxGasLimitPerBlock will return maximum gas limit allowed per block
 func (ed *economicsData) MaxGasLimitPerBlock(shardID uint32) uint64 {
-	ed.mutRewardsSettings.RLock()
-	defer ed.mutRewardsSettings.RUnlock()
+	ed.mutFeeSettings.RLock()
+	defer ed.mutFeeSettings.RUnlock()
 
 	if shardID == core.MetachainShardId {
 		return ed.maxGasLimitPerMetaBlock
@@ -453,10 +689,13 @@ func (ed *economicsData) RewardsTopUpFactor() float64 {
 
 // ComputeGasLimit returns the gas limit need by the provided transaction in order to be executed
 func (ed *economicsData) ComputeGasLimit(tx data.TransactionWithFeeHandler) uint64 {
+	ed.mutFeeSettings.RLock()
 	gasLimit := ed.minGasLimit
+	gasPerDataByte := ed.gasPerDataByte
+	ed.mutFeeSettings.RUnlock()
 
 	dataLen := uint64(len(tx.GetData()))
-	gasLimit += dataLen * ed.gasPerDataByte
+	gasLimit += dataLen * gasPerDataByte
 
 	return gasLimit
 }
@@ -522,15 +761,198 @@ func (ed *economicsData) ComputeTxFeeBasedOnGasUsed(tx data.TransactionWithFeeHa
 
 // EpochConfirmed is called whenever a new epoch is confirmed
 func (ed *economicsData) EpochConfirmed(epoch uint32, _ uint64) {
-	ed.flagPenalizedTooMuchGas.Toggle(epoch >= ed.penalizedTooMuchGasEnableEpoch)
+	ed.mutFeeSettings.RLock()
+	penalizedTooMuchGasEnableEpoch := ed.penalizedTooMuchGasEnableEpoch
+	gasPriceModifierEnableEpoch := ed.gasPriceModifierEnableEpoch
+	ed.mutFeeSettings.RUnlock()
+
+	ed.flagPenalizedTooMuchGas.Toggle(epoch >= penalizedTooMuchGasEnableEpoch)
 	log.Debug("economics: penalized too much gas", "enabled", ed.flagPenalizedTooMuchGas.IsSet())
 
-	ed.flagGasPriceModifier.Toggle(epoch >= ed.gasPriceModifierEnableEpoch)
+	ed.setFeeEpochConfig(epoch)
+
+	ed.flagGasPriceModifier.Toggle(epoch >= gasPriceModifierEnableEpoch)
 	log.Debug("economics: gas price modifier", "enabled", ed.flagGasPriceModifier.IsSet())
 	ed.statusHandler.SetStringValue(common.MetricGasPriceModifier, fmt.Sprintf("%g", ed.GasPriceModifier()))
+
+	settings := ed.dynamicMinGasPriceSettings
+	ed.flagDynamicMinGasPrice.Toggle(settings.enabled && epoch >= settings.enableEpoch)
+	log.Debug("economics: dynamic min gas price", "enabled", ed.flagDynamicMinGasPrice.IsSet())
+	ed.statusHandler.SetUInt64Value(common.MetricDynamicMinGasPrice, ed.DynamicMinGasPrice())
+
 	ed.setRewardsEpochConfig(epoch)
 }
 
+func (ed *economicsData) setFeeEpochConfig(currentEpoch uint32) {
+	ed.mutFeeSettings.Lock()
+	defer ed.mutFeeSettings.Unlock()
+
+	feeSetting := ed.feeSettings[0]
+	for _, setting := range ed.feeSettings {
+		if currentEpoch >= setting.epochEnable {
+			feeSetting = setting
+		}
+	}
+
+	if ed.activeFeeSetting == feeSetting {
+		log.Debug("economics: FeeConfig",
+			"epoch", feeSetting.epochEnable,
+			"maxGasLimitPerBlock", ed.maxGasLimitPerBlock,
+			"maxGasLimitPerMetaBlock", ed.maxGasLimitPerMetaBlock,
+			"minGasPrice", ed.minGasPrice,
+			"minGasLimit", ed.minGasLimit,
+			"gasPerDataByte", ed.gasPerDataByte,
+			"gasPriceModifier", ed.gasPriceModifier,
+		)
+		return
+	}
+
+	ed.activeFeeSetting = feeSetting
+	ed.maxGasLimitPerBlock = feeSetting.maxGasLimitPerBlock
+	ed.maxGasLimitPerMetaBlock = feeSetting.maxGasLimitPerMetaBlock
+	ed.minGasPrice = feeSetting.minGasPrice
+	ed.minGasLimit = feeSetting.minGasLimit
+	ed.gasPerDataByte = feeSetting.gasPerDataByte
+	ed.gasPriceModifier = feeSetting.gasPriceModifier
+
+	ed.statusHandler.SetUInt64Value(common.MetricMinGasPrice, feeSetting.minGasPrice)
+	ed.statusHandler.SetUInt64Value(common.MetricMinGasLimit, feeSetting.minGasLimit)
+	ed.statusHandler.SetUInt64Value(common.MetricGasPerDataByte, feeSetting.gasPerDataByte)
+
+	log.Debug("economics: FeeConfig",
+		"epoch", feeSetting.epochEnable,
+		"maxGasLimitPerBlock", ed.maxGasLimitPerBlock,
+		"maxGasLimitPerMetaBlock", ed.maxGasLimitPerMetaBlock,
+		"minGasPrice", ed.minGasPrice,
+		"minGasLimit", ed.minGasLimit,
+		"gasPerDataByte", ed.gasPerDataByte,
+		"gasPriceModifier", ed.gasPriceModifier,
+	)
+}
+
+// GovernedConfigChanged applies the fee settings and the enable epochs changed through governance. The changes are
+// applied over the values from the configuration files and override them starting with their enable epoch
+func (ed *economicsData) GovernedConfigChanged(governedConfig *governance.GovernedConfig) {
+	feeConfigs := ed.computeGovernedFeeSettings(governedConfig.FeeSettingChanges())
+
+	penalizedTooMuchGasEnableEpoch := governedConfig.EnableEpoch(penalizedTooMuchGasFlag, ed.staticEnableEpochs[penalizedTooMuchGasFlag])
+	gasPriceModifierEnableEpoch := governedConfig.EnableEpoch(gasPriceModifierFlag, ed.staticEnableEpochs[gasPriceModifierFlag])
+
+	ed.mutFeeSettings.Lock()
+	ed.feeSettings = feeConfigs
+	ed.penalizedTooMuchGasEnableEpoch = penalizedTooMuchGasEnableEpoch
+	ed.gasPriceModifierEnableEpoch = gasPriceModifierEnableEpoch
+	ed.mutFeeSettings.Unlock()
+
+	log.Debug("economics: governed config changed",
+		"num fee configs", len(feeConfigs),
+		"penalized too much gas enable epoch", penalizedTooMuchGasEnableEpoch,
+		"gas price modifier enable epoch", gasPriceModifierEnableEpoch,
+	)
+}
+
+func (ed *economicsData) computeGovernedFeeSettings(changes []*governance.GovernedConfigChange) []*feeConfig {
+	feeConfigs := make([]*feeConfig, 0, len(ed.staticFeeSettings))
+	for _, fc := range ed.staticFeeSettings {
+		feeConfigCopy := *fc
+		feeConfigs = append(feeConfigs, &feeConfigCopy)
+	}
+
+	for _, change := range changes {
+		updatedFeeConfigs, err := applyFeeSettingChange(feeConfigs, change)
+		if err != nil {
+			log.Warn("economics: invalid governed fee setting change, ignoring",
+				"name", change.Name,
+				"value", change.Value,
+				"epoch", change.EnableEpoch,
+				"error", err,
+			)
+			continue
+		}
+
+		feeConfigs = updatedFeeConfigs
+	}
+
+	return feeConfigs
+}
+
+// applyFeeSettingChange returns a copy of the provided fee configs having the change applied on all the configs enabled
+// starting with the change's enable epoch
+func applyFeeSettingChange(feeConfigs []*feeConfig, change *governance.GovernedConfigChange) ([]*feeConfig, error) {
+	activeConfig := feeConfigs[0]
+	hasConfigForEpoch := false
+	updatedFeeConfigs := make([]*feeConfig, 0, len(feeConfigs)+1)
+	for _, fc := range feeConfigs {
+		if fc.epochEnable <= change.EnableEpoch {
+			activeConfig = fc
+		}
+		hasConfigForEpoch = hasConfigForEpoch || fc.epochEnable == change.EnableEpoch
+
+		feeConfigCopy := *fc
+		updatedFeeConfigs = append(updatedFeeConfigs, &feeConfigCopy)
+	}
+
+	if !hasConfigForEpoch {
+		newConfig := *activeConfig
+		newConfig.epochEnable = change.EnableEpoch
+		updatedFeeConfigs = append(updatedFeeConfigs, &newConfig)
+		sort.SliceStable(updatedFeeConfigs, func(i, j int) bool {
+			return updatedFeeConfigs[i].epochEnable < updatedFeeConfigs[j].epochEnable
+		})
+	}
+
+	setting := strings.TrimPrefix(change.Name, governance.GovernedFeeSettingPrefix)
+	for _, fc := range updatedFeeConfigs {
+		if fc.epochEnable < change.EnableEpoch {
+			continue
+		}
+
+		err := setFeeConfigValue(fc, setting, change.Value)
+		if err != nil {
+			return nil, err
+		}
+		if fc.maxGasLimitPerBlock < fc.minGasLimit {
+			return nil, process.ErrInvalidMaxGasLimitPerBlock
+		}
+	}
+
+	return updatedFeeConfigs, nil
+}
+
+func setFeeConfigValue(fc *feeConfig, setting string, value string) error {
+	if setting == gasPriceModifierSetting {
+		gasPriceModifier, err := strconv.ParseFloat(value, 64)
+		if err != nil || isGasPriceModifierInvalid(gasPriceModifier) {
+			return process.ErrInvalidGasModifier
+		}
+
+		fc.gasPriceModifier = gasPriceModifier
+		return nil
+	}
+
+	uint64Value, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return fmt.Errorf("%w for %s", governance.ErrInvalidGovernedConfigValue, setting)
+	}
+
+	switch setting {
+	case "MaxGasLimitPerBlock":
+		fc.maxGasLimitPerBlock = uint64Value
+	case "MaxGasLimitPerMetaBlock":
+		fc.maxGasLimitPerMetaBlock = uint64Value
+	case "GasPerDataByte":
+		fc.gasPerDataByte = uint64Value
+	case "MinGasPrice":
+		fc.minGasPrice = uint64Value
+	case "MinGasLimit":
+		fc.minGasLimit = uint64Value
+	default:
+		return fmt.Errorf("%w: %s", governance.ErrUnknownGovernedConfigName, setting)
+	}
+
+	return nil
+}
+
 func (ed *economicsData) setRewardsEpochConfig(currentEpoch uint32) {
 	rewardSetting := ed.rewardsSettings[0]
 	for i, setting := range ed.rewardsSettings {
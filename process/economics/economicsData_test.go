@@ -9,6 +9,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/common/governance"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/economics"
@@ -825,3 +826,250 @@ func TestEconomicsData_ComputeGasLimitBasedOnBalance(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, uint64(11894070000), gasLimit)
 }
+
+func TestNewEconomicsData_InvalidFeeConfigByEpochShouldErr(t *testing.T) {
+	t.Parallel()
+
+	epochFeeSettings := func() config.EpochFeeSettings {
+		return config.EpochFeeSettings{
+			MaxGasLimitPerBlock:     "100000",
+			MaxGasLimitPerMetaBlock: "1000000",
+			MinGasPrice:             "1000",
+			MinGasLimit:             "500",
+			GasPerDataByte:          "1",
+			GasPriceModifier:        1,
+			EpochEnable:             2,
+		}
+	}
+
+	args := createArgsForEconomicsData(1)
+	settings := epochFeeSettings()
+	settings.MinGasPrice = "badValue"
+	args.Economics.FeeSettings.FeeConfigByEpoch = []config.EpochFeeSettings{settings}
+	_, err := economics.NewEconomicsData(args)
+	assert.Equal(t, process.ErrInvalidMinimumGasPrice, err)
+
+	settings = epochFeeSettings()
+	settings.GasPriceModifier = 1.1
+	args.Economics.FeeSettings.FeeConfigByEpoch = []config.EpochFeeSettings{settings}
+	_, err = economics.NewEconomicsData(args)
+	assert.Equal(t, process.ErrInvalidGasModifier, err)
+
+	settings = epochFeeSettings()
+	settings.MaxGasLimitPerBlock = "100"
+	args.Economics.FeeSettings.FeeConfigByEpoch = []config.EpochFeeSettings{settings}
+	_, err = economics.NewEconomicsData(args)
+	assert.Equal(t, process.ErrInvalidMaxGasLimitPerBlock, err)
+}
+
+func TestEconomicsData_ConfirmedEpochFeeSettingsChange(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsForEconomicsDataRealFees(&mock.BuiltInCostHandlerStub{})
+	args.Economics.FeeSettings.FeeConfigByEpoch = []config.EpochFeeSettings{
+		{
+			MaxGasLimitPerBlock:     "3000000000",
+			MaxGasLimitPerMetaBlock: "30000000000",
+			MinGasPrice:             "2000000000",
+			MinGasLimit:             "70000",
+			GasPerDataByte:          "2000",
+			GasPriceModifier:        0.02,
+			EpochEnable:             2,
+		},
+	}
+	economicsData, err := economics.NewEconomicsData(args)
+	require.Nil(t, err)
+
+	economicsData.EpochConfirmed(1, 0)
+	assert.Equal(t, uint64(1000000000), economicsData.MinGasPrice())
+	assert.Equal(t, uint64(50000), economicsData.MinGasLimit())
+	assert.Equal(t, uint64(1500), economicsData.GasPerDataByte())
+	assert.Equal(t, uint64(1500000000), economicsData.MaxGasLimitPerBlock(0))
+	assert.Equal(t, uint64(15000000000), economicsData.MaxGasLimitPerBlock(core.MetachainShardId))
+	assert.Equal(t, 0.01, economicsData.GasPriceModifier())
+
+	economicsData.EpochConfirmed(2, 0)
+	assert.Equal(t, uint64(2000000000), economicsData.MinGasPrice())
+	assert.Equal(t, uint64(70000), economicsData.MinGasLimit())
+	assert.Equal(t, uint64(2000), economicsData.GasPerDataByte())
+	assert.Equal(t, uint64(3000000000), economicsData.MaxGasLimitPerBlock(0))
+	assert.Equal(t, uint64(30000000000), economicsData.MaxGasLimitPerBlock(core.MetachainShardId))
+	assert.Equal(t, 0.02, economicsData.GasPriceModifier())
+
+	tx := &transaction.Transaction{
+		GasPrice: 1000000000,
+		GasLimit: 70000,
+		Value:    big.NewInt(0),
+	}
+	err = economicsData.CheckValidityTxValues(tx)
+	assert.Equal(t, process.ErrInsufficientGasPriceInTx, err)
+}
+
+func TestNewEconomicsData_InvalidDynamicMinGasPriceSettingsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dynamicSettings := func() config.DynamicMinGasPriceSettings {
+		return config.DynamicMinGasPriceSettings{
+			Enabled:                 true,
+			TargetGasUsedPercentage: 0.5,
+			MaxChangeDenominator:    8,
+			MaxMinGasPrice:          "100000000000",
+		}
+	}
+
+	args := createArgsForEconomicsDataRealFees(&mock.BuiltInCostHandlerStub{})
+	settings := dynamicSettings()
+	settings.TargetGasUsedPercentage = 0
+	args.Economics.FeeSettings.DynamicMinGasPrice = settings
+	_, err := economics.NewEconomicsData(args)
+	assert.Equal(t, process.ErrInvalidTargetGasUsedPercentage, err)
+
+	settings = dynamicSettings()
+	settings.TargetGasUsedPercentage = 1.1
+	args.Economics.FeeSettings.DynamicMinGasPrice = settings
+	_, err = economics.NewEconomicsData(args)
+	assert.Equal(t, process.ErrInvalidTargetGasUsedPercentage, err)
+
+	settings = dynamicSettings()
+	settings.MaxChangeDenominator = 0
+	args.Economics.FeeSettings.DynamicMinGasPrice = settings
+	_, err = economics.NewEconomicsData(args)
+	assert.Equal(t, process.ErrInvalidMaxChangeDenominator, err)
+
+	settings = dynamicSettings()
+	settings.MaxMinGasPrice = "badValue"
+	args.Economics.FeeSettings.DynamicMinGasPrice = settings
+	_, err = economics.NewEconomicsData(args)
+	assert.Equal(t, process.ErrInvalidMaxMinGasPrice, err)
+
+	settings = dynamicSettings()
+	settings.MaxMinGasPrice = "10"
+	args.Economics.FeeSettings.DynamicMinGasPrice = settings
+	_, err = economics.NewEconomicsData(args)
+	assert.Equal(t, process.ErrInvalidMaxMinGasPrice, err)
+
+	settings = dynamicSettings()
+	settings.Enabled = false
+	settings.MaxChangeDenominator = 0
+	args.Economics.FeeSettings.DynamicMinGasPrice = settings
+	_, err = economics.NewEconomicsData(args)
+	assert.Nil(t, err)
+}
+
+func TestEconomicsData_ComputeDynamicMinGasPrice(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsForEconomicsDataRealFees(&mock.BuiltInCostHandlerStub{})
+	args.Economics.FeeSettings.DynamicMinGasPrice = config.DynamicMinGasPriceSettings{
+		Enabled:                 true,
+		EnableEpoch:             2,
+		TargetGasUsedPercentage: 0.5,
+		MaxChangeDenominator:    8,
+		MaxMinGasPrice:          "1200000000",
+	}
+	economicsData, err := economics.NewEconomicsData(args)
+	require.Nil(t, err)
+
+	minGasPrice := economicsData.MinGasPrice()
+	maxGasLimitPerBlock := economicsData.MaxGasLimitPerBlock(0)
+
+	economicsData.EpochConfirmed(1, 0)
+	assert.False(t, economicsData.IsDynamicMinGasPriceEnabled())
+	assert.Equal(t, minGasPrice, economicsData.ComputeDynamicMinGasPrice(0, maxGasLimitPerBlock))
+
+	economicsData.EpochConfirmed(2, 0)
+	assert.True(t, economicsData.IsDynamicMinGasPriceEnabled())
+
+	price := economicsData.ComputeDynamicMinGasPrice(0, maxGasLimitPerBlock/2)
+	assert.Equal(t, minGasPrice, price)
+
+	price = economicsData.ComputeDynamicMinGasPrice(price, maxGasLimitPerBlock)
+	assert.Equal(t, uint64(1125000000), price)
+
+	price = economicsData.ComputeDynamicMinGasPrice(price, maxGasLimitPerBlock)
+	assert.Equal(t, uint64(1200000000), price)
+
+	price = economicsData.ComputeDynamicMinGasPrice(price, 0)
+	assert.Equal(t, uint64(1050000000), price)
+
+	price = economicsData.ComputeDynamicMinGasPrice(price, 0)
+	assert.Equal(t, minGasPrice, price)
+	assert.Equal(t, minGasPrice, economicsData.MinGasPrice())
+}
+
+func TestEconomicsData_SetDynamicMinGasPrice(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsForEconomicsDataRealFees(&mock.BuiltInCostHandlerStub{})
+	args.Economics.FeeSettings.DynamicMinGasPrice = config.DynamicMinGasPriceSettings{
+		Enabled:                 true,
+		EnableEpoch:             2,
+		TargetGasUsedPercentage: 0.5,
+		MaxChangeDenominator:    8,
+		MaxMinGasPrice:          "1200000000",
+	}
+	economicsData, err := economics.NewEconomicsData(args)
+	require.Nil(t, err)
+
+	minGasPrice := economicsData.MinGasPrice()
+
+	economicsData.SetDynamicMinGasPrice(1100000000)
+	assert.Equal(t, minGasPrice, economicsData.DynamicMinGasPrice())
+
+	economicsData.EpochConfirmed(2, 0)
+	assert.Equal(t, uint64(1100000000), economicsData.DynamicMinGasPrice())
+
+	economicsData.SetDynamicMinGasPrice(0)
+	assert.Equal(t, minGasPrice, economicsData.DynamicMinGasPrice())
+
+	economicsData.SetDynamicMinGasPrice(2000000000)
+	assert.Equal(t, uint64(1200000000), economicsData.DynamicMinGasPrice())
+}
+
+func TestEconomicsData_GovernedConfigChangedShouldOverrideFeeSettings(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsForEconomicsDataRealFees(&mock.BuiltInCostHandlerStub{})
+	args.GasPriceModifierEnableEpoch = 10
+	args.Economics.FeeSettings.FeeConfigByEpoch = []config.EpochFeeSettings{
+		{
+			MaxGasLimitPerBlock:     "3000000000",
+			MaxGasLimitPerMetaBlock: "30000000000",
+			MinGasPrice:             "2000000000",
+			MinGasLimit:             "70000",
+			GasPerDataByte:          "2000",
+			GasPriceModifier:        0.02,
+			EpochEnable:             4,
+		},
+	}
+	economicsData, err := economics.NewEconomicsData(args)
+	require.Nil(t, err)
+
+	economicsData.GovernedConfigChanged(&governance.GovernedConfig{
+		Changes: []*governance.GovernedConfigChange{
+			{Name: "FeeSettings.MinGasLimit", Value: "60000", EnableEpoch: 2},
+			{Name: "FeeSettings.GasPriceModifier", Value: "0.05", EnableEpoch: 3},
+			{Name: "FeeSettings.MaxGasLimitPerBlock", Value: "1", EnableEpoch: 3},
+			{Name: "EnableEpochs.GasPriceModifierEnableEpoch", Value: "3", EnableEpoch: 3},
+		},
+	})
+
+	economicsData.EpochConfirmed(1, 0)
+	assert.Equal(t, uint64(50000), economicsData.MinGasLimit())
+	assert.Equal(t, uint64(1500000000), economicsData.MaxGasLimitPerBlock(0))
+
+	economicsData.EpochConfirmed(2, 0)
+	assert.Equal(t, uint64(60000), economicsData.MinGasLimit())
+	assert.Equal(t, uint64(1000000000), economicsData.MinGasPrice())
+
+	economicsData.EpochConfirmed(3, 0)
+	assert.Equal(t, uint64(60000), economicsData.MinGasLimit())
+	assert.Equal(t, 0.05, economicsData.GasPriceModifier())
+	assert.Equal(t, uint64(1500000000), economicsData.MaxGasLimitPerBlock(0), "invalid change should be ignored")
+
+	economicsData.EpochConfirmed(4, 0)
+	assert.Equal(t, uint64(60000), economicsData.MinGasLimit())
+	assert.Equal(t, uint64(2000000000), economicsData.MinGasPrice())
+	assert.Equal(t, 0.05, economicsData.GasPriceModifier())
+	assert.Equal(t, uint64(3000000000), economicsData.MaxGasLimitPerBlock(0))
+}
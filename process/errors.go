@@ -956,6 +956,9 @@ var ErrNilNumConnectedPeersProvider = errors.New("nil number of connected peers
 // ErrNilLocker signals that a nil locker was provided
 var ErrNilLocker = errors.New("nil locker")
 
+// ErrNilVMTracer signals that a nil VM tracer has been provided
+var ErrNilVMTracer = errors.New("nil VM tracer")
+
 // ErrNilChunksProcessor signals that a nil chunks processor has been provided
 var ErrNilChunksProcessor = errors.New("nil chunks processor")
 
@@ -973,3 +976,55 @@ var ErrNilCurrentNetworkEpochProvider = errors.New("nil current network epoch pr
 
 // ErrNilESDTTransferParser signals that a nil ESDT transfer parser has been provider
 var ErrNilESDTTransferParser = errors.New("nil esdt transfer parser")
+
+// ErrInvalidTargetGasUsedPercentage signals that an invalid target gas used percentage has been provided
+var ErrInvalidTargetGasUsedPercentage = errors.New("invalid target gas used percentage")
+
+// ErrInvalidMaxChangeDenominator signals that an invalid max change denominator has been provided
+var ErrInvalidMaxChangeDenominator = errors.New("invalid max change denominator")
+
+// ErrInvalidMaxMinGasPrice signals that an invalid maximum value for the dynamic minimum gas price has been provided
+var ErrInvalidMaxMinGasPrice = errors.New("invalid max min gas price")
+
+// ErrNilDynamicGasPriceHandler signals that a nil dynamic gas price handler has been provided
+var ErrNilDynamicGasPriceHandler = errors.New("nil dynamic gas price handler")
+
+// ErrDynamicMinGasPriceMismatch signals that the dynamic minimum gas price from the header does not match the computed one
+var ErrDynamicMinGasPriceMismatch = errors.New("dynamic min gas price mismatch")
+
+// ErrNilGasPriceTracker signals that a nil gas price tracker has been provided
+var ErrNilGasPriceTracker = errors.New("nil gas price tracker")
+
+// ErrGovernedConfigMismatch signals that the governed config held by the epoch start meta block does not match
+// the one computed locally
+var ErrGovernedConfigMismatch = errors.New("governed config mismatch")
+
+// ErrNilScheduledCallsExecutor signals that a nil scheduled calls executor has been provided
+var ErrNilScheduledCallsExecutor = errors.New("nil scheduled calls executor")
+
+// ErrNilSystemVM signals that a nil system VM has been provided
+var ErrNilSystemVM = errors.New("nil system VM")
+
+// ErrScheduledCallsExecution signals that the scheduled calls system smart contract execution failed
+var ErrScheduledCallsExecution = errors.New("scheduled calls execution failed")
+
+// ErrNilGuardedAccountHandler signals that a nil guarded account handler has been provided
+var ErrNilGuardedAccountHandler = errors.New("nil guarded account handler")
+
+// ErrInvalidGuardedSignature signals that the signature field of a guarded transaction is malformed
+var ErrInvalidGuardedSignature = errors.New("invalid guarded transaction signature")
+
+// ErrGuardedTransactionNotExpected signals that a guarded transaction was sent by an account without an active guardian
+var ErrGuardedTransactionNotExpected = errors.New("guarded transaction not expected")
+
+// ErrTransactionNotGuarded signals that a transaction of a guarded account is not co-signed by the guardian
+var ErrTransactionNotGuarded = errors.New("transaction of a guarded account is not co-signed by the guardian")
+
+// ErrGuardianMismatch signals that the guardian of the transaction is not the active guardian of the sender
+var ErrGuardianMismatch = errors.New("guardian mismatch")
+
+// ErrBuiltInFunctionCalledWithValue signals that a built-in function was called with value
+var ErrBuiltInFunctionCalledWithValue = errors.New("built-in function called with value")
+
+// ErrOperationNotPermitted signals that the operation is not permitted
+var ErrOperationNotPermitted = errors.New("operation not permitted")
@@ -11,6 +11,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/dataValidators"
 	"github.com/ElrondNetwork/elrond-go/process/factory"
+	"github.com/ElrondNetwork/elrond-go/process/guardian"
 	"github.com/ElrondNetwork/elrond-go/process/interceptors"
 	interceptorFactory "github.com/ElrondNetwork/elrond-go/process/interceptors/factory"
 	"github.com/ElrondNetwork/elrond-go/process/interceptors/processor"
@@ -201,13 +202,20 @@ func (bicf *baseInterceptorsContainerFactory) createOneTxInterceptor(topic strin
 		return nil, process.ErrNilCoreComponentsHolder
 	}
 
-	addrPubKeyConverter := bicf.argInterceptorFactory.CoreComponents.AddressPubKeyConverter()
+	coreComponents := bicf.argInterceptorFactory.CoreComponents
+	addrPubKeyConverter := coreComponents.AddressPubKeyConverter()
+
+	guardedAccounts, err := guardian.NewGuardedAccount(coreComponents.InternalMarshalizer(), coreComponents.EpochNotifier())
+	if err != nil {
+		return nil, err
+	}
 
 	txValidator, err := dataValidators.NewTxValidator(
 		bicf.accounts,
 		bicf.shardCoordinator,
 		bicf.whiteListHandler,
 		addrPubKeyConverter,
+		guardedAccounts,
 		bicf.maxTxNonceDeltaAllowed,
 	)
 	if err != nil {
@@ -14,6 +14,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/factory"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
 	dataRetrieverMock "github.com/ElrondNetwork/elrond-go/testscommon/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/testscommon/economicsmocks"
 	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
@@ -42,6 +43,7 @@ func createMockVMAccountsArguments() hooks.ArgBlockChainHook {
 		DataPool:           datapool,
 		CompiledSCPool:     datapool.SmartContracts(),
 		NilCompiledSCStore: true,
+		VMTracer:           &testscommon.VMTracerStub{},
 	}
 	return arguments
 }
@@ -418,6 +420,9 @@ func FillGasMapMetaChainSystemSCsCosts(value uint64) map[string]uint64 {
 	gasMap["UnBondTokens"] = value
 	gasMap["DelegationMgrOps"] = value
 	gasMap["GetAllNodeStates"] = value
+	gasMap["MultisigCreate"] = value
+	gasMap["MultisigOps"] = value
+	gasMap["SchedulerOps"] = value
 	gasMap["ValidatorToDelegation"] = value
 
 	return gasMap
@@ -1,3 +1,4 @@
+//go:build !race
 // +build !race
 
 // TODO remove build condition above to allow -race -short, after Arwen fix
@@ -45,6 +46,7 @@ func createMockVMAccountsArguments() hooks.ArgBlockChainHook {
 		DataPool:           datapool,
 		CompiledSCPool:     datapool.SmartContracts(),
 		NilCompiledSCStore: true,
+		VMTracer:           &testscommon.VMTracerStub{},
 	}
 	return arguments
 }
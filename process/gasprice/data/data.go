@@ -0,0 +1,14 @@
+package data
+
+// GasPriceEstimates is the data transfer object which holds the suggested gas prices for a transaction to be
+// included in a block of the node's shard
+type GasPriceEstimates struct {
+	Slow             uint64 `json:"slow"`
+	Normal           uint64 `json:"normal"`
+	Fast             uint64 `json:"fast"`
+	MinGasPrice      uint64 `json:"minGasPrice"`
+	ShardID          uint32 `json:"shardID"`
+	NumBlocks        int    `json:"numBlocks"`
+	NumTxsInPool     int    `json:"numTxsInPool"`
+	LastBlockGasUsed uint64 `json:"lastBlockGasUsed"`
+}
@@ -0,0 +1,21 @@
+package gasprice
+
+import "errors"
+
+// ErrNilEconomicsHandler signals that a nil economics handler has been provided
+var ErrNilEconomicsHandler = errors.New("nil economics handler")
+
+// ErrNilShardCoordinator signals that a nil shard coordinator has been provided
+var ErrNilShardCoordinator = errors.New("nil shard coordinator")
+
+// ErrNilTxPool signals that a nil transactions pool has been provided
+var ErrNilTxPool = errors.New("nil transactions pool")
+
+// ErrInvalidNumBlocks signals that an invalid number of blocks has been provided
+var ErrInvalidNumBlocks = errors.New("invalid number of blocks")
+
+// ErrInvalidPercentiles signals that invalid percentiles have been provided
+var ErrInvalidPercentiles = errors.New("invalid percentiles")
+
+// ErrInvalidFullBlockGasUsedPercentage signals that an invalid full block gas used percentage has been provided
+var ErrInvalidFullBlockGasUsedPercentage = errors.New("invalid full block gas used percentage")
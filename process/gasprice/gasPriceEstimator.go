@@ -0,0 +1,239 @@
+package gasprice
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/gasprice/data"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
+)
+
+var _ process.GasPriceTracker = (*gasPriceEstimator)(nil)
+
+var log = logger.GetOrCreate("process/gasprice")
+
+const maxPercentile = 100
+const fastInclusionBlocks = 1
+const normalInclusionBlocks = 3
+
+type txCacheIterator interface {
+	ForEachTransaction(function txcache.ForEachTransaction)
+}
+
+// ArgsGasPriceEstimator holds the arguments needed to create a gas price estimator
+type ArgsGasPriceEstimator struct {
+	Config           config.GasPriceEstimatorConfig
+	EconomicsHandler EconomicsHandler
+	ShardCoordinator sharding.Coordinator
+	TxPool           dataRetriever.ShardedDataCacherNotifier
+}
+
+type pendingTx struct {
+	gasPrice uint64
+	gasLimit uint64
+}
+
+// gasPriceEstimator suggests gas prices based on the transactions included in the last committed blocks of the
+// node's shard and on the transactions still waiting in the pool
+type gasPriceEstimator struct {
+	economicsHandler           EconomicsHandler
+	shardCoordinator           sharding.Coordinator
+	txPool                     dataRetriever.ShardedDataCacherNotifier
+	numBlocks                  int
+	slowPercentile             uint32
+	normalPercentile           uint32
+	fastPercentile             uint32
+	fullBlockGasUsedPercentage float64
+
+	mut              sync.Mutex
+	requiredPrices   []uint64
+	lastBlockGasUsed uint64
+	cachedEstimates  *data.GasPriceEstimates
+}
+
+// NewGasPriceEstimator creates a new gas price estimator instance
+func NewGasPriceEstimator(args ArgsGasPriceEstimator) (*gasPriceEstimator, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gasPriceEstimator{
+		economicsHandler:           args.EconomicsHandler,
+		shardCoordinator:           args.ShardCoordinator,
+		txPool:                     args.TxPool,
+		numBlocks:                  int(args.Config.NumBlocks),
+		slowPercentile:             args.Config.SlowPercentile,
+		normalPercentile:           args.Config.NormalPercentile,
+		fastPercentile:             args.Config.FastPercentile,
+		fullBlockGasUsedPercentage: args.Config.FullBlockGasUsedPercentage,
+		requiredPrices:             make([]uint64, 0, args.Config.NumBlocks),
+	}, nil
+}
+
+func checkArgs(args ArgsGasPriceEstimator) error {
+	if check.IfNil(args.EconomicsHandler) {
+		return ErrNilEconomicsHandler
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return ErrNilShardCoordinator
+	}
+	if check.IfNil(args.TxPool) {
+		return ErrNilTxPool
+	}
+	if args.Config.NumBlocks == 0 {
+		return ErrInvalidNumBlocks
+	}
+	cfg := args.Config
+	isOrdered := cfg.SlowPercentile <= cfg.NormalPercentile && cfg.NormalPercentile <= cfg.FastPercentile
+	if !isOrdered || cfg.FastPercentile > maxPercentile {
+		return ErrInvalidPercentiles
+	}
+	if cfg.FullBlockGasUsedPercentage <= 0 || cfg.FullBlockGasUsedPercentage > 1 {
+		return ErrInvalidFullBlockGasUsedPercentage
+	}
+
+	return nil
+}
+
+// AddBlockGasPrices records the gas prices of the transactions included in a committed block. A block that is not
+// full enough did not push out any transaction, so the minimum gas price was enough for it
+func (gpe *gasPriceEstimator) AddBlockGasPrices(gasUsed uint64, gasPrices []uint64) {
+	maxGasLimit := gpe.economicsHandler.MaxGasLimitPerBlock(gpe.shardCoordinator.SelfId())
+	isFull := float64(gasUsed) >= float64(maxGasLimit)*gpe.fullBlockGasUsedPercentage
+
+	requiredPrice := uint64(0)
+	if isFull && len(gasPrices) > 0 {
+		requiredPrice = gasPrices[0]
+		for _, gasPrice := range gasPrices {
+			if gasPrice < requiredPrice {
+				requiredPrice = gasPrice
+			}
+		}
+	}
+
+	gpe.mut.Lock()
+	defer gpe.mut.Unlock()
+
+	gpe.requiredPrices = append(gpe.requiredPrices, requiredPrice)
+	if len(gpe.requiredPrices) > gpe.numBlocks {
+		gpe.requiredPrices = gpe.requiredPrices[len(gpe.requiredPrices)-gpe.numBlocks:]
+	}
+	gpe.lastBlockGasUsed = gasUsed
+	gpe.cachedEstimates = nil
+}
+
+// GetGasPriceEstimates returns the suggested gas prices for slow, normal and fast inclusion. The estimates are
+// computed at most once per committed block
+func (gpe *gasPriceEstimator) GetGasPriceEstimates() *data.GasPriceEstimates {
+	gpe.mut.Lock()
+	defer gpe.mut.Unlock()
+
+	if gpe.cachedEstimates == nil {
+		gpe.cachedEstimates = gpe.computeEstimates()
+	}
+
+	estimates := *gpe.cachedEstimates
+
+	return &estimates
+}
+
+func (gpe *gasPriceEstimator) computeEstimates() *data.GasPriceEstimates {
+	selfShardID := gpe.shardCoordinator.SelfId()
+	maxGasLimit := gpe.economicsHandler.MaxGasLimitPerBlock(selfShardID)
+	minGasPrice := gpe.economicsHandler.DynamicMinGasPrice()
+
+	requiredPrices := make([]uint64, len(gpe.requiredPrices))
+	copy(requiredPrices, gpe.requiredPrices)
+	sort.Slice(requiredPrices, func(i, j int) bool {
+		return requiredPrices[i] < requiredPrices[j]
+	})
+
+	pendingTxs := gpe.getPendingTxs()
+	sort.Slice(pendingTxs, func(i, j int) bool {
+		return pendingTxs[i].gasPrice > pendingTxs[j].gasPrice
+	})
+
+	slow := core.MaxUint64(minGasPrice, percentile(requiredPrices, gpe.slowPercentile))
+	normal := core.MaxUint64(slow, percentile(requiredPrices, gpe.normalPercentile))
+	normal = core.MaxUint64(normal, clearingGasPrice(pendingTxs, maxGasLimit*normalInclusionBlocks))
+	fast := core.MaxUint64(normal, percentile(requiredPrices, gpe.fastPercentile))
+	fast = core.MaxUint64(fast, clearingGasPrice(pendingTxs, maxGasLimit*fastInclusionBlocks))
+
+	log.Trace("gasPriceEstimator.computeEstimates",
+		"slow", slow,
+		"normal", normal,
+		"fast", fast,
+		"num blocks", len(requiredPrices),
+		"num txs in pool", len(pendingTxs),
+	)
+
+	return &data.GasPriceEstimates{
+		Slow:             slow,
+		Normal:           normal,
+		Fast:             fast,
+		MinGasPrice:      minGasPrice,
+		ShardID:          selfShardID,
+		NumBlocks:        len(requiredPrices),
+		NumTxsInPool:     len(pendingTxs),
+		LastBlockGasUsed: gpe.lastBlockGasUsed,
+	}
+}
+
+func (gpe *gasPriceEstimator) getPendingTxs() []pendingTx {
+	selfShardID := gpe.shardCoordinator.SelfId()
+	if selfShardID == core.MetachainShardId {
+		return make([]pendingTx, 0)
+	}
+
+	cacheID := process.ShardCacherIdentifier(selfShardID, selfShardID)
+	cache, ok := gpe.txPool.ShardDataStore(cacheID).(txCacheIterator)
+	if !ok {
+		return make([]pendingTx, 0)
+	}
+
+	pendingTxs := make([]pendingTx, 0)
+	cache.ForEachTransaction(func(_ []byte, wrappedTx *txcache.WrappedTransaction) {
+		pendingTxs = append(pendingTxs, pendingTx{
+			gasPrice: wrappedTx.Tx.GetGasPrice(),
+			gasLimit: wrappedTx.Tx.GetGasLimit(),
+		})
+	})
+
+	return pendingTxs
+}
+
+// percentile expects the values sorted ascending
+func percentile(values []uint64, p uint32) uint64 {
+	if len(values) == 0 {
+		return 0
+	}
+
+	return values[(len(values)-1)*int(p)/maxPercentile]
+}
+
+// clearingGasPrice returns the gas price of the first pending transaction that does not fit in the provided gas
+// capacity, or 0 if all the pending transactions fit. The pending transactions should be sorted by gas price, descending
+func clearingGasPrice(pendingTxs []pendingTx, gasCapacity uint64) uint64 {
+	cumulatedGas := uint64(0)
+	for _, tx := range pendingTxs {
+		cumulatedGas += tx.gasLimit
+		if cumulatedGas > gasCapacity {
+			return tx.gasPrice
+		}
+	}
+
+	return 0
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gpe *gasPriceEstimator) IsInterfaceNil() bool {
+	return gpe == nil
+}
@@ -0,0 +1,262 @@
+package gasprice_test
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/txpool"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/gasprice"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/economicsmocks"
+	"github.com/ElrondNetwork/elrond-go/testscommon/txcachemocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const minGasPrice = uint64(1000000000)
+const maxGasLimitPerBlock = uint64(1000000)
+
+func createTxPool(t *testing.T) dataRetriever.ShardedDataCacherNotifier {
+	pool, err := txpool.NewShardedTxPool(txpool.ArgShardedTxPool{
+		Config: storageUnit.CacheConfig{
+			Capacity:             1000,
+			SizePerSender:        100,
+			SizeInBytes:          4096000,
+			SizeInBytesPerSender: 409600,
+			Shards:               16,
+		},
+		TxGasHandler: &txcachemocks.TxGasHandlerMock{
+			MinimumGasMove:       50000,
+			MinimumGasPrice:      minGasPrice,
+			GasProcessingDivisor: 100,
+		},
+		NumberOfShards: 1,
+	})
+	require.Nil(t, err)
+
+	return pool
+}
+
+func createMockArgs(t *testing.T) gasprice.ArgsGasPriceEstimator {
+	return gasprice.ArgsGasPriceEstimator{
+		Config: config.GasPriceEstimatorConfig{
+			NumBlocks:                  10,
+			SlowPercentile:             30,
+			NormalPercentile:           60,
+			FastPercentile:             90,
+			FullBlockGasUsedPercentage: 0.8,
+		},
+		EconomicsHandler: &economicsmocks.EconomicsHandlerStub{
+			DynamicMinGasPriceCalled: func() uint64 {
+				return minGasPrice
+			},
+			MaxGasLimitPerBlockCalled: func() uint64 {
+				return maxGasLimitPerBlock
+			},
+		},
+		ShardCoordinator: testscommon.NewMultiShardsCoordinatorMock(1),
+		TxPool:           createTxPool(t),
+	}
+}
+
+func addPendingTx(pool dataRetriever.ShardedDataCacherNotifier, nonce uint64, gasPrice uint64, gasLimit uint64) {
+	tx := &transaction.Transaction{
+		Nonce:    nonce,
+		SndAddr:  []byte("sender"),
+		RcvAddr:  []byte("receiver"),
+		Value:    big.NewInt(0),
+		GasPrice: gasPrice,
+		GasLimit: gasLimit,
+	}
+	pool.AddData([]byte(fmt.Sprintf("hash-%d", nonce)), tx, 100, process.ShardCacherIdentifier(0, 0))
+}
+
+func TestNewGasPriceEstimator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil economics handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(t)
+		args.EconomicsHandler = nil
+		gpe, err := gasprice.NewGasPriceEstimator(args)
+		assert.True(t, check.IfNil(gpe))
+		assert.Equal(t, gasprice.ErrNilEconomicsHandler, err)
+	})
+	t.Run("nil shard coordinator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(t)
+		args.ShardCoordinator = nil
+		gpe, err := gasprice.NewGasPriceEstimator(args)
+		assert.True(t, check.IfNil(gpe))
+		assert.Equal(t, gasprice.ErrNilShardCoordinator, err)
+	})
+	t.Run("nil tx pool should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(t)
+		args.TxPool = nil
+		gpe, err := gasprice.NewGasPriceEstimator(args)
+		assert.True(t, check.IfNil(gpe))
+		assert.Equal(t, gasprice.ErrNilTxPool, err)
+	})
+	t.Run("invalid number of blocks should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(t)
+		args.Config.NumBlocks = 0
+		gpe, err := gasprice.NewGasPriceEstimator(args)
+		assert.True(t, check.IfNil(gpe))
+		assert.Equal(t, gasprice.ErrInvalidNumBlocks, err)
+	})
+	t.Run("invalid percentiles should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(t)
+		args.Config.NormalPercentile = args.Config.FastPercentile + 1
+		gpe, err := gasprice.NewGasPriceEstimator(args)
+		assert.True(t, check.IfNil(gpe))
+		assert.Equal(t, gasprice.ErrInvalidPercentiles, err)
+
+		args = createMockArgs(t)
+		args.Config.FastPercentile = 101
+		gpe, err = gasprice.NewGasPriceEstimator(args)
+		assert.True(t, check.IfNil(gpe))
+		assert.Equal(t, gasprice.ErrInvalidPercentiles, err)
+	})
+	t.Run("invalid full block gas used percentage should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(t)
+		args.Config.FullBlockGasUsedPercentage = 0
+		gpe, err := gasprice.NewGasPriceEstimator(args)
+		assert.True(t, check.IfNil(gpe))
+		assert.Equal(t, gasprice.ErrInvalidFullBlockGasUsedPercentage, err)
+
+		args.Config.FullBlockGasUsedPercentage = 1.1
+		gpe, err = gasprice.NewGasPriceEstimator(args)
+		assert.True(t, check.IfNil(gpe))
+		assert.Equal(t, gasprice.ErrInvalidFullBlockGasUsedPercentage, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		gpe, err := gasprice.NewGasPriceEstimator(createMockArgs(t))
+		assert.False(t, check.IfNil(gpe))
+		assert.Nil(t, err)
+	})
+}
+
+func TestGasPriceEstimator_GetGasPriceEstimatesWithoutDataShouldReturnMinGasPrice(t *testing.T) {
+	t.Parallel()
+
+	gpe, _ := gasprice.NewGasPriceEstimator(createMockArgs(t))
+
+	estimates := gpe.GetGasPriceEstimates()
+	assert.Equal(t, minGasPrice, estimates.Slow)
+	assert.Equal(t, minGasPrice, estimates.Normal)
+	assert.Equal(t, minGasPrice, estimates.Fast)
+	assert.Equal(t, minGasPrice, estimates.MinGasPrice)
+	assert.Equal(t, 0, estimates.NumBlocks)
+}
+
+func TestGasPriceEstimator_BlocksNotFullShouldReturnMinGasPrice(t *testing.T) {
+	t.Parallel()
+
+	gpe, _ := gasprice.NewGasPriceEstimator(createMockArgs(t))
+	for i := 0; i < 5; i++ {
+		gpe.AddBlockGasPrices(maxGasLimitPerBlock/2, []uint64{5 * minGasPrice, 10 * minGasPrice})
+	}
+
+	estimates := gpe.GetGasPriceEstimates()
+	assert.Equal(t, minGasPrice, estimates.Slow)
+	assert.Equal(t, minGasPrice, estimates.Normal)
+	assert.Equal(t, minGasPrice, estimates.Fast)
+	assert.Equal(t, 5, estimates.NumBlocks)
+	assert.Equal(t, maxGasLimitPerBlock/2, estimates.LastBlockGasUsed)
+}
+
+func TestGasPriceEstimator_FullBlocksShouldUsePercentilesOfLowestIncludedPrices(t *testing.T) {
+	t.Parallel()
+
+	gpe, _ := gasprice.NewGasPriceEstimator(createMockArgs(t))
+	for i := uint64(1); i <= 10; i++ {
+		gpe.AddBlockGasPrices(maxGasLimitPerBlock, []uint64{20 * minGasPrice, i * minGasPrice})
+	}
+
+	estimates := gpe.GetGasPriceEstimates()
+	assert.Equal(t, 3*minGasPrice, estimates.Slow)
+	assert.Equal(t, 6*minGasPrice, estimates.Normal)
+	assert.Equal(t, 9*minGasPrice, estimates.Fast)
+	assert.Equal(t, 10, estimates.NumBlocks)
+}
+
+func TestGasPriceEstimator_ShouldKeepOnlyTheLastBlocks(t *testing.T) {
+	t.Parallel()
+
+	gpe, _ := gasprice.NewGasPriceEstimator(createMockArgs(t))
+	for i := 0; i < 10; i++ {
+		gpe.AddBlockGasPrices(maxGasLimitPerBlock, []uint64{5 * minGasPrice})
+	}
+
+	estimates := gpe.GetGasPriceEstimates()
+	assert.Equal(t, 5*minGasPrice, estimates.Slow)
+
+	for i := 0; i < 10; i++ {
+		gpe.AddBlockGasPrices(0, make([]uint64, 0))
+	}
+
+	estimates = gpe.GetGasPriceEstimates()
+	assert.Equal(t, minGasPrice, estimates.Slow)
+	assert.Equal(t, minGasPrice, estimates.Fast)
+	assert.Equal(t, 10, estimates.NumBlocks)
+}
+
+func TestGasPriceEstimator_CongestedPoolShouldRaiseNormalAndFast(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	gasLimit := maxGasLimitPerBlock / 4
+	for i := uint64(0); i < 20; i++ {
+		addPendingTx(args.TxPool, i, (i+2)*minGasPrice, gasLimit)
+	}
+
+	gpe, _ := gasprice.NewGasPriceEstimator(args)
+	estimates := gpe.GetGasPriceEstimates()
+
+	assert.Equal(t, 20, estimates.NumTxsInPool)
+	assert.Equal(t, minGasPrice, estimates.Slow)
+	// 12 txs fit in the next 3 blocks, the 13th one by gas price (descending) is left out
+	assert.Equal(t, 9*minGasPrice, estimates.Normal)
+	// 4 txs fit in the next block, the 5th one by gas price (descending) is left out
+	assert.Equal(t, 17*minGasPrice, estimates.Fast)
+}
+
+func TestGasPriceEstimator_EstimatesAreRecomputedAfterNewBlock(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	gpe, _ := gasprice.NewGasPriceEstimator(args)
+
+	estimates := gpe.GetGasPriceEstimates()
+	assert.Equal(t, minGasPrice, estimates.Fast)
+
+	for i := uint64(0); i < 10; i++ {
+		addPendingTx(args.TxPool, i, 2*minGasPrice, maxGasLimitPerBlock/2)
+	}
+
+	estimates = gpe.GetGasPriceEstimates()
+	assert.Equal(t, minGasPrice, estimates.Fast)
+
+	gpe.AddBlockGasPrices(0, make([]uint64, 0))
+	estimates = gpe.GetGasPriceEstimates()
+	assert.Equal(t, 2*minGasPrice, estimates.Fast)
+}
@@ -0,0 +1,8 @@
+package gasprice
+
+// EconomicsHandler defines the economics data needed by the gas price estimator
+type EconomicsHandler interface {
+	DynamicMinGasPrice() uint64
+	MaxGasLimitPerBlock(shardID uint32) uint64
+	IsInterfaceNil() bool
+}
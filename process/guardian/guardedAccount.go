@@ -0,0 +1,137 @@
+package guardian
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/state"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+var _ process.GuardedAccountHandler = (*guardedAccount)(nil)
+
+var guardiansKey = []byte(core.ElrondProtectedKeyPrefix + "guardians")
+
+type guardedAccount struct {
+	marshalizer   marshal.Marshalizer
+	epochNotifier process.EpochNotifier
+}
+
+// NewGuardedAccount creates the component which reads the guardians of the user accounts
+func NewGuardedAccount(marshalizer marshal.Marshalizer, epochNotifier process.EpochNotifier) (*guardedAccount, error) {
+	if check.IfNil(marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(epochNotifier) {
+		return nil, process.ErrNilEpochNotifier
+	}
+
+	return &guardedAccount{
+		marshalizer:   marshalizer,
+		epochNotifier: epochNotifier,
+	}, nil
+}
+
+// GetActiveGuardian returns the address of the guardian active in the current epoch or nil if the account is not guarded
+func (ga *guardedAccount) GetActiveGuardian(uah vmcommon.UserAccountHandler) ([]byte, error) {
+	if check.IfNil(uah) {
+		return nil, process.ErrNilUserAccount
+	}
+
+	guardians, err := getGuardians(uah, ga.marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	activeGuardian := getActiveGuardian(guardians, ga.epochNotifier.CurrentEpoch())
+	if activeGuardian == nil {
+		return nil, nil
+	}
+
+	return activeGuardian.Address, nil
+}
+
+// CheckGuardedTransaction checks that a transaction sent by a guarded account is co-signed by its active guardian
+// and that the transactions of the other accounts are not marked as guarded. The co-signature itself is verified
+// when the transaction is intercepted
+func (ga *guardedAccount) CheckGuardedTransaction(uah vmcommon.UserAccountHandler, tx *transaction.Transaction) error {
+	activeGuardian, err := ga.GetActiveGuardian(uah)
+	if err != nil {
+		return err
+	}
+
+	isGuarded := IsGuardedTransaction(tx)
+	if len(activeGuardian) == 0 {
+		if isGuarded {
+			return process.ErrGuardedTransactionNotExpected
+		}
+		return nil
+	}
+
+	if !isGuarded {
+		if isSetGuardianCall(tx) {
+			return nil
+		}
+		return process.ErrTransactionNotGuarded
+	}
+
+	guardedSignature, err := ParseGuardedSignature(tx.Signature, len(tx.SndAddr))
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(guardedSignature.GuardianAddress, activeGuardian) {
+		return process.ErrGuardianMismatch
+	}
+
+	return nil
+}
+
+// getActiveGuardian returns the guardian active in the provided epoch, a pending guardian replacing the previous
+// one once its activation epoch is reached
+func getActiveGuardian(guardians *Guardians, epoch uint32) *Guardian {
+	if guardians.Pending != nil && guardians.Pending.ActivationEpoch <= epoch {
+		return guardians.Pending
+	}
+
+	return guardians.Active
+}
+
+func getGuardians(uah vmcommon.UserAccountHandler, marshalizer marshal.Marshalizer) (*Guardians, error) {
+	guardians := &Guardians{}
+	marshaledData, err := uah.AccountDataHandler().RetrieveValue(guardiansKey)
+	if errors.Is(err, state.ErrNilTrie) {
+		return guardians, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(marshaledData) == 0 {
+		return guardians, nil
+	}
+
+	err = marshalizer.Unmarshal(guardians, marshaledData)
+	if err != nil {
+		return nil, err
+	}
+
+	return guardians, nil
+}
+
+func saveGuardians(uah vmcommon.UserAccountHandler, marshalizer marshal.Marshalizer, guardians *Guardians) error {
+	marshaledData, err := marshalizer.Marshal(guardians)
+	if err != nil {
+		return err
+	}
+
+	return uah.AccountDataHandler().SaveKeyValue(guardiansKey, marshaledData)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ga *guardedAccount) IsInterfaceNil() bool {
+	return ga == nil
+}
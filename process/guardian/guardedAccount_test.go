@@ -0,0 +1,144 @@
+package guardian
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/state"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createGuardedUserAccount(t *testing.T, address []byte, guardians *Guardians) vmcommon.UserAccountHandler {
+	account, err := state.NewUserAccount(address)
+	require.Nil(t, err)
+
+	if guardians != nil {
+		err = saveGuardians(account, &mock.MarshalizerMock{}, guardians)
+		require.Nil(t, err)
+	}
+
+	return account
+}
+
+func createGuardedTx(sender []byte, guardianAddress []byte) *transaction.Transaction {
+	return &transaction.Transaction{
+		SndAddr:   sender,
+		RcvAddr:   bytes.Repeat([]byte{9}, len(sender)),
+		Value:     big.NewInt(0),
+		Version:   2,
+		Options:   MaskGuardedTransaction,
+		Signature: CreateGuardedSignature(bytes.Repeat([]byte{7}, 64), guardianAddress, bytes.Repeat([]byte{8}, 64)),
+	}
+}
+
+func TestNewGuardedAccount(t *testing.T) {
+	t.Parallel()
+
+	ga, err := NewGuardedAccount(nil, &mock.EpochNotifierStub{})
+	assert.True(t, check.IfNil(ga))
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+
+	ga, err = NewGuardedAccount(&mock.MarshalizerMock{}, nil)
+	assert.True(t, check.IfNil(ga))
+	assert.Equal(t, process.ErrNilEpochNotifier, err)
+
+	ga, err = NewGuardedAccount(&mock.MarshalizerMock{}, &mock.EpochNotifierStub{})
+	assert.False(t, check.IfNil(ga))
+	assert.Nil(t, err)
+}
+
+func TestGuardedAccount_GetActiveGuardian(t *testing.T) {
+	t.Parallel()
+
+	address := bytes.Repeat([]byte{1}, 32)
+	activeGuardian := bytes.Repeat([]byte{2}, 32)
+	pendingGuardian := bytes.Repeat([]byte{3}, 32)
+	currentEpoch := uint32(5)
+	ga, _ := NewGuardedAccount(&mock.MarshalizerMock{}, &mock.EpochNotifierStub{
+		CurrentEpochCalled: func() uint32 {
+			return currentEpoch
+		},
+	})
+
+	t.Run("nil account should error", func(t *testing.T) {
+		guardian, err := ga.GetActiveGuardian(nil)
+		assert.Nil(t, guardian)
+		assert.Equal(t, process.ErrNilUserAccount, err)
+	})
+	t.Run("account without guardians", func(t *testing.T) {
+		guardian, err := ga.GetActiveGuardian(createGuardedUserAccount(t, address, nil))
+		assert.Nil(t, err)
+		assert.Nil(t, guardian)
+	})
+	t.Run("pending guardian not yet active", func(t *testing.T) {
+		account := createGuardedUserAccount(t, address, &Guardians{
+			Active:  &Guardian{Address: activeGuardian},
+			Pending: &Guardian{Address: pendingGuardian, ActivationEpoch: currentEpoch + 1},
+		})
+		guardian, err := ga.GetActiveGuardian(account)
+		assert.Nil(t, err)
+		assert.Equal(t, activeGuardian, guardian)
+	})
+	t.Run("pending guardian reached the activation epoch", func(t *testing.T) {
+		account := createGuardedUserAccount(t, address, &Guardians{
+			Active:  &Guardian{Address: activeGuardian},
+			Pending: &Guardian{Address: pendingGuardian, ActivationEpoch: currentEpoch},
+		})
+		guardian, err := ga.GetActiveGuardian(account)
+		assert.Nil(t, err)
+		assert.Equal(t, pendingGuardian, guardian)
+	})
+}
+
+func TestGuardedAccount_CheckGuardedTransaction(t *testing.T) {
+	t.Parallel()
+
+	address := bytes.Repeat([]byte{1}, 32)
+	guardianAddress := bytes.Repeat([]byte{2}, 32)
+	ga, _ := NewGuardedAccount(&mock.MarshalizerMock{}, &mock.EpochNotifierStub{})
+	guardedAccount := createGuardedUserAccount(t, address, &Guardians{Active: &Guardian{Address: guardianAddress}})
+	notGuardedAccount := createGuardedUserAccount(t, address, nil)
+
+	t.Run("not guarded account with normal transaction", func(t *testing.T) {
+		tx := &transaction.Transaction{SndAddr: address, Version: 1}
+		assert.Nil(t, ga.CheckGuardedTransaction(notGuardedAccount, tx))
+	})
+	t.Run("not guarded account with guarded transaction should error", func(t *testing.T) {
+		tx := createGuardedTx(address, guardianAddress)
+		assert.Equal(t, process.ErrGuardedTransactionNotExpected, ga.CheckGuardedTransaction(notGuardedAccount, tx))
+	})
+	t.Run("guarded account with normal transaction should error", func(t *testing.T) {
+		tx := &transaction.Transaction{SndAddr: address, Version: 1}
+		assert.Equal(t, process.ErrTransactionNotGuarded, ga.CheckGuardedTransaction(guardedAccount, tx))
+	})
+	t.Run("guarded account with set guardian transaction", func(t *testing.T) {
+		tx := &transaction.Transaction{
+			SndAddr: address,
+			RcvAddr: address,
+			Value:   big.NewInt(0),
+			Data:    []byte("SetGuardian@0303"),
+			Version: 1,
+		}
+		assert.Nil(t, ga.CheckGuardedTransaction(guardedAccount, tx))
+	})
+	t.Run("guarded account with invalid signature field should error", func(t *testing.T) {
+		tx := createGuardedTx(address, guardianAddress)
+		tx.Signature = tx.Signature[1:]
+		assert.Equal(t, process.ErrInvalidGuardedSignature, ga.CheckGuardedTransaction(guardedAccount, tx))
+	})
+	t.Run("guarded account with another guardian should error", func(t *testing.T) {
+		tx := createGuardedTx(address, bytes.Repeat([]byte{3}, 32))
+		assert.Equal(t, process.ErrGuardianMismatch, ga.CheckGuardedTransaction(guardedAccount, tx))
+	})
+	t.Run("guarded account with guarded transaction", func(t *testing.T) {
+		tx := createGuardedTx(address, guardianAddress)
+		assert.Nil(t, ga.CheckGuardedTransaction(guardedAccount, tx))
+	})
+}
@@ -0,0 +1,69 @@
+package guardian
+
+import (
+	"bytes"
+
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// MaskGuardedTransaction is the transaction option bit signaling that the signature field also holds the
+// guardian address and the guardian co-signature
+const MaskGuardedTransaction = uint32(2)
+
+const initialVersionOfTransaction = uint32(1)
+
+// GuardedSignature holds the components of the signature field of a guarded transaction
+type GuardedSignature struct {
+	SenderSignature   []byte
+	GuardianAddress   []byte
+	GuardianSignature []byte
+}
+
+// IsGuardedTransaction returns true if the transaction is co-signed by a guardian
+func IsGuardedTransaction(tx *transaction.Transaction) bool {
+	if tx.Version > initialVersionOfTransaction {
+		return tx.Options&MaskGuardedTransaction > 0
+	}
+
+	return false
+}
+
+// ParseGuardedSignature splits the signature field of a guarded transaction. The field is the concatenation of
+// the sender signature, the guardian address and the guardian signature, both signatures having the same length
+func ParseGuardedSignature(signature []byte, addressLength int) (*GuardedSignature, error) {
+	signaturesLength := len(signature) - addressLength
+	if addressLength <= 0 || signaturesLength <= 0 || signaturesLength%2 != 0 {
+		return nil, process.ErrInvalidGuardedSignature
+	}
+
+	signatureLength := signaturesLength / 2
+	return &GuardedSignature{
+		SenderSignature:   signature[:signatureLength],
+		GuardianAddress:   signature[signatureLength : signatureLength+addressLength],
+		GuardianSignature: signature[signatureLength+addressLength:],
+	}, nil
+}
+
+// CreateGuardedSignature builds the signature field of a guarded transaction
+func CreateGuardedSignature(senderSignature []byte, guardianAddress []byte, guardianSignature []byte) []byte {
+	signature := make([]byte, 0, len(senderSignature)+len(guardianAddress)+len(guardianSignature))
+	signature = append(signature, senderSignature...)
+	signature = append(signature, guardianAddress...)
+	return append(signature, guardianSignature...)
+}
+
+// isSetGuardianCall returns true if the transaction only calls the set guardian built-in function on the sender.
+// Such a transaction is accepted without the co-signature of the active guardian, so a lost guardian key can be
+// replaced, as the new guardian only becomes active after the activation delay
+func isSetGuardianCall(tx *transaction.Transaction) bool {
+	if !bytes.Equal(tx.SndAddr, tx.RcvAddr) {
+		return false
+	}
+	if tx.Value != nil && tx.Value.Sign() != 0 {
+		return false
+	}
+
+	return bytes.HasPrefix(tx.Data, []byte(common.BuiltInFunctionSetGuardian+"@"))
+}
@@ -0,0 +1,84 @@
+package guardian
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsGuardedTransaction(t *testing.T) {
+	t.Parallel()
+
+	assert.False(t, IsGuardedTransaction(&transaction.Transaction{Version: 1, Options: MaskGuardedTransaction}))
+	assert.False(t, IsGuardedTransaction(&transaction.Transaction{Version: 2, Options: 1}))
+	assert.True(t, IsGuardedTransaction(&transaction.Transaction{Version: 2, Options: MaskGuardedTransaction}))
+	assert.True(t, IsGuardedTransaction(&transaction.Transaction{Version: 2, Options: MaskGuardedTransaction | 1}))
+}
+
+func TestParseGuardedSignature(t *testing.T) {
+	t.Parallel()
+
+	senderSignature := bytes.Repeat([]byte{1}, 64)
+	guardianAddress := bytes.Repeat([]byte{2}, 32)
+	guardianSignature := bytes.Repeat([]byte{3}, 64)
+
+	t.Run("invalid lengths should error", func(t *testing.T) {
+		t.Parallel()
+
+		guardedSignature, err := ParseGuardedSignature(append(guardianAddress, 1), 32)
+		assert.Nil(t, guardedSignature)
+		assert.Equal(t, process.ErrInvalidGuardedSignature, err)
+
+		guardedSignature, err = ParseGuardedSignature(guardianAddress, 32)
+		assert.Nil(t, guardedSignature)
+		assert.Equal(t, process.ErrInvalidGuardedSignature, err)
+
+		signature := CreateGuardedSignature(senderSignature, guardianAddress, guardianSignature[1:])
+		guardedSignature, err = ParseGuardedSignature(signature, 32)
+		assert.Nil(t, guardedSignature)
+		assert.Equal(t, process.ErrInvalidGuardedSignature, err)
+
+		guardedSignature, err = ParseGuardedSignature(senderSignature, 0)
+		assert.Nil(t, guardedSignature)
+		assert.Equal(t, process.ErrInvalidGuardedSignature, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		signature := CreateGuardedSignature(senderSignature, guardianAddress, guardianSignature)
+		guardedSignature, err := ParseGuardedSignature(signature, 32)
+		require.Nil(t, err)
+		assert.Equal(t, senderSignature, guardedSignature.SenderSignature)
+		assert.Equal(t, guardianAddress, guardedSignature.GuardianAddress)
+		assert.Equal(t, guardianSignature, guardedSignature.GuardianSignature)
+	})
+}
+
+func TestIsSetGuardianCall(t *testing.T) {
+	t.Parallel()
+
+	address := []byte("address")
+	tx := &transaction.Transaction{
+		SndAddr: address,
+		RcvAddr: address,
+		Value:   big.NewInt(0),
+		Data:    []byte("SetGuardian@0102"),
+	}
+	assert.True(t, isSetGuardianCall(tx))
+
+	tx.Value = big.NewInt(1)
+	assert.False(t, isSetGuardianCall(tx))
+
+	tx.Value = big.NewInt(0)
+	tx.RcvAddr = []byte("other address")
+	assert.False(t, isSetGuardianCall(tx))
+
+	tx.RcvAddr = address
+	tx.Data = []byte("SetGuardianX@0102")
+	assert.False(t, isSetGuardianCall(tx))
+}
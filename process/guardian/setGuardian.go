@@ -0,0 +1,171 @@
+package guardian
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/atomic"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/process"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+var log = logger.GetOrCreate("process/guardian")
+
+// ArgsSetGuardian defines the arguments needed to create the set guardian built-in function
+type ArgsSetGuardian struct {
+	Marshalizer                   marshal.Marshalizer
+	GasSchedule                   core.GasScheduleNotifier
+	EpochNotifier                 vmcommon.EpochNotifier
+	GuardiansEnableEpoch          uint32
+	GuardianActivationEpochsDelay uint32
+}
+
+type setGuardian struct {
+	marshalizer                   marshal.Marshalizer
+	gasCost                       uint64
+	guardianActivationEpochsDelay uint32
+	guardiansEnableEpoch          uint32
+	flagGuardians                 atomic.Flag
+	currentEpoch                  uint32
+	mutExecution                  sync.RWMutex
+}
+
+// NewSetGuardianFunc creates the built-in function which registers the guardian of the calling account. The new
+// guardian replaces the active one only after the activation delay, so the owner of the account can still react,
+// together with the active guardian, if the account key was stolen
+func NewSetGuardianFunc(args ArgsSetGuardian) (*setGuardian, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(args.GasSchedule) {
+		return nil, process.ErrNilGasSchedule
+	}
+	if check.IfNil(args.EpochNotifier) {
+		return nil, process.ErrNilEpochNotifier
+	}
+
+	sg := &setGuardian{
+		marshalizer:                   args.Marshalizer,
+		guardianActivationEpochsDelay: args.GuardianActivationEpochsDelay,
+		guardiansEnableEpoch:          args.GuardiansEnableEpoch,
+	}
+	sg.GasScheduleChange(args.GasSchedule.LatestGasSchedule())
+	log.Debug("setGuardian: enable epoch for guardians", "epoch", sg.guardiansEnableEpoch)
+
+	args.GasSchedule.RegisterNotifyHandler(sg)
+	args.EpochNotifier.RegisterNotifyHandler(sg)
+
+	return sg, nil
+}
+
+// ProcessBuiltinFunction registers the provided address as the pending guardian of the caller
+func (sg *setGuardian) ProcessBuiltinFunction(
+	acntSnd, acntDst vmcommon.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	sg.mutExecution.RLock()
+	defer sg.mutExecution.RUnlock()
+
+	if vmInput == nil {
+		return nil, process.ErrNilVmInput
+	}
+	if len(vmInput.Arguments) != 1 {
+		return nil, process.ErrInvalidArguments
+	}
+	if vmInput.CallValue == nil || vmInput.CallValue.Cmp(big.NewInt(0)) != 0 {
+		return nil, process.ErrBuiltInFunctionCalledWithValue
+	}
+	if vmInput.GasProvided < sg.gasCost {
+		return nil, process.ErrNotEnoughGas
+	}
+	if check.IfNil(acntSnd) || check.IfNil(acntDst) || !bytes.Equal(vmInput.CallerAddr, vmInput.RecipientAddr) {
+		return nil, fmt.Errorf("%w, the guardian can only be set by the account on itself", process.ErrOperationNotPermitted)
+	}
+	if core.IsSmartContractAddress(vmInput.CallerAddr) {
+		return nil, fmt.Errorf("%w, smart contracts can not be guarded", process.ErrOperationNotPermitted)
+	}
+
+	guardianAddress := vmInput.Arguments[0]
+	if len(guardianAddress) != len(vmInput.CallerAddr) {
+		return nil, process.ErrInvalidAddressLength
+	}
+	if bytes.Equal(guardianAddress, vmInput.CallerAddr) {
+		return nil, fmt.Errorf("%w, an account can not be its own guardian", process.ErrOperationNotPermitted)
+	}
+
+	guardians, err := getGuardians(acntDst, sg.marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	guardians.Active = getActiveGuardian(guardians, sg.currentEpoch)
+	guardians.Pending = nil
+	if guardians.Active != nil && bytes.Equal(guardians.Active.Address, guardianAddress) {
+		return nil, fmt.Errorf("%w, the guardian is already active", process.ErrOperationNotPermitted)
+	}
+
+	guardians.Pending = &Guardian{
+		Address:         guardianAddress,
+		ActivationEpoch: sg.currentEpoch + sg.guardianActivationEpochsDelay,
+	}
+	err = saveGuardians(acntDst, sg.marshalizer, guardians)
+	if err != nil {
+		return nil, err
+	}
+
+	logEntry := &vmcommon.LogEntry{
+		Identifier: []byte(common.BuiltInFunctionSetGuardian),
+		Address:    vmInput.CallerAddr,
+		Topics:     [][]byte{guardianAddress, big.NewInt(int64(guardians.Pending.ActivationEpoch)).Bytes()},
+	}
+
+	return &vmcommon.VMOutput{
+		ReturnCode:   vmcommon.Ok,
+		GasRemaining: vmInput.GasProvided - sg.gasCost,
+		Logs:         []*vmcommon.LogEntry{logEntry},
+	}, nil
+}
+
+// SetNewGasConfig is called whenever the gas cost of the built-in functions is changed. The cost of this function
+// is not part of the common built-in functions gas config, so it is updated through GasScheduleChange
+func (sg *setGuardian) SetNewGasConfig(_ *vmcommon.GasCost) {
+}
+
+// GasScheduleChange is called whenever the gas schedule is changed
+func (sg *setGuardian) GasScheduleChange(gasSchedule map[string]map[string]uint64) {
+	builtInCost, ok := gasSchedule[core.BuiltInCostString]
+	if !ok {
+		return
+	}
+
+	sg.mutExecution.Lock()
+	sg.gasCost = builtInCost[common.BuiltInFunctionSetGuardian]
+	sg.mutExecution.Unlock()
+}
+
+// EpochConfirmed is called whenever a new epoch is confirmed
+func (sg *setGuardian) EpochConfirmed(epoch uint32, _ uint64) {
+	sg.mutExecution.Lock()
+	sg.currentEpoch = epoch
+	sg.mutExecution.Unlock()
+
+	sg.flagGuardians.Toggle(epoch >= sg.guardiansEnableEpoch)
+	log.Debug("setGuardian: guardians", "enabled", sg.flagGuardians.IsSet())
+}
+
+// IsActive returns true if the guardians are enabled
+func (sg *setGuardian) IsActive() bool {
+	return sg.flagGuardians.IsSet()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sg *setGuardian) IsInterfaceNil() bool {
+	return sg == nil
+}
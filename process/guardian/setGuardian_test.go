@@ -0,0 +1,185 @@
+package guardian
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const setGuardianCost = 100
+
+func createMockArgsSetGuardian() ArgsSetGuardian {
+	gasMap := map[string]map[string]uint64{
+		core.BuiltInCostString: {
+			common.BuiltInFunctionSetGuardian: setGuardianCost,
+		},
+	}
+
+	return ArgsSetGuardian{
+		Marshalizer:                   &mock.MarshalizerMock{},
+		GasSchedule:                   mock.NewGasScheduleNotifierMock(gasMap),
+		EpochNotifier:                 &mock.EpochNotifierStub{},
+		GuardianActivationEpochsDelay: 10,
+	}
+}
+
+func createSetGuardianInput(caller []byte, guardianAddress []byte) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  caller,
+			Arguments:   [][]byte{guardianAddress},
+			CallValue:   big.NewInt(0),
+			GasProvided: 1000,
+		},
+		RecipientAddr: caller,
+		Function:      common.BuiltInFunctionSetGuardian,
+	}
+}
+
+func TestNewSetGuardianFunc(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSetGuardian()
+	args.Marshalizer = nil
+	sg, err := NewSetGuardianFunc(args)
+	assert.True(t, check.IfNil(sg))
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+
+	args = createMockArgsSetGuardian()
+	args.GasSchedule = nil
+	sg, err = NewSetGuardianFunc(args)
+	assert.True(t, check.IfNil(sg))
+	assert.Equal(t, process.ErrNilGasSchedule, err)
+
+	args = createMockArgsSetGuardian()
+	args.EpochNotifier = nil
+	sg, err = NewSetGuardianFunc(args)
+	assert.True(t, check.IfNil(sg))
+	assert.Equal(t, process.ErrNilEpochNotifier, err)
+
+	args = createMockArgsSetGuardian()
+	sg, err = NewSetGuardianFunc(args)
+	assert.False(t, check.IfNil(sg))
+	assert.Nil(t, err)
+	assert.True(t, sg.IsActive())
+	assert.Equal(t, uint64(setGuardianCost), sg.gasCost)
+}
+
+func TestSetGuardian_EpochConfirmed(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSetGuardian()
+	args.GuardiansEnableEpoch = 2
+	sg, _ := NewSetGuardianFunc(args)
+	assert.False(t, sg.IsActive())
+
+	sg.EpochConfirmed(2, 0)
+	assert.True(t, sg.IsActive())
+}
+
+func TestSetGuardian_ProcessBuiltinFunctionInvalidInputShouldErr(t *testing.T) {
+	t.Parallel()
+
+	caller := bytes.Repeat([]byte{1}, 32)
+	guardianAddress := bytes.Repeat([]byte{2}, 32)
+	account := createGuardedUserAccount(t, caller, nil)
+	sg, _ := NewSetGuardianFunc(createMockArgsSetGuardian())
+
+	vmOutput, err := sg.ProcessBuiltinFunction(account, account, nil)
+	assert.Nil(t, vmOutput)
+	assert.Equal(t, process.ErrNilVmInput, err)
+
+	input := createSetGuardianInput(caller, guardianAddress)
+	input.Arguments = append(input.Arguments, []byte("extra"))
+	vmOutput, err = sg.ProcessBuiltinFunction(account, account, input)
+	assert.Nil(t, vmOutput)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+
+	input = createSetGuardianInput(caller, guardianAddress)
+	input.CallValue = big.NewInt(1)
+	vmOutput, err = sg.ProcessBuiltinFunction(account, account, input)
+	assert.Nil(t, vmOutput)
+	assert.Equal(t, process.ErrBuiltInFunctionCalledWithValue, err)
+
+	input = createSetGuardianInput(caller, guardianAddress)
+	input.GasProvided = setGuardianCost - 1
+	vmOutput, err = sg.ProcessBuiltinFunction(account, account, input)
+	assert.Nil(t, vmOutput)
+	assert.Equal(t, process.ErrNotEnoughGas, err)
+
+	input = createSetGuardianInput(caller, guardianAddress)
+	input.RecipientAddr = guardianAddress
+	vmOutput, err = sg.ProcessBuiltinFunction(account, account, input)
+	assert.Nil(t, vmOutput)
+	assert.True(t, errors.Is(err, process.ErrOperationNotPermitted))
+
+	input = createSetGuardianInput(caller, guardianAddress)
+	vmOutput, err = sg.ProcessBuiltinFunction(nil, account, input)
+	assert.Nil(t, vmOutput)
+	assert.True(t, errors.Is(err, process.ErrOperationNotPermitted))
+
+	scAddress := make([]byte, 32)
+	scAccount := createGuardedUserAccount(t, scAddress, nil)
+	input = createSetGuardianInput(scAddress, guardianAddress)
+	vmOutput, err = sg.ProcessBuiltinFunction(scAccount, scAccount, input)
+	assert.Nil(t, vmOutput)
+	assert.True(t, errors.Is(err, process.ErrOperationNotPermitted))
+
+	input = createSetGuardianInput(caller, guardianAddress[1:])
+	vmOutput, err = sg.ProcessBuiltinFunction(account, account, input)
+	assert.Nil(t, vmOutput)
+	assert.Equal(t, process.ErrInvalidAddressLength, err)
+
+	input = createSetGuardianInput(caller, caller)
+	vmOutput, err = sg.ProcessBuiltinFunction(account, account, input)
+	assert.Nil(t, vmOutput)
+	assert.True(t, errors.Is(err, process.ErrOperationNotPermitted))
+}
+
+func TestSetGuardian_ProcessBuiltinFunctionShouldSetPendingGuardian(t *testing.T) {
+	t.Parallel()
+
+	caller := bytes.Repeat([]byte{1}, 32)
+	firstGuardian := bytes.Repeat([]byte{2}, 32)
+	secondGuardian := bytes.Repeat([]byte{3}, 32)
+	account := createGuardedUserAccount(t, caller, nil)
+	args := createMockArgsSetGuardian()
+	sg, _ := NewSetGuardianFunc(args)
+	sg.EpochConfirmed(5, 0)
+
+	vmOutput, err := sg.ProcessBuiltinFunction(account, account, createSetGuardianInput(caller, firstGuardian))
+	require.Nil(t, err)
+	assert.Equal(t, vmcommon.Ok, vmOutput.ReturnCode)
+	assert.Equal(t, uint64(1000-setGuardianCost), vmOutput.GasRemaining)
+	require.Equal(t, 1, len(vmOutput.Logs))
+	assert.Equal(t, []byte(common.BuiltInFunctionSetGuardian), vmOutput.Logs[0].Identifier)
+	assert.Equal(t, [][]byte{firstGuardian, big.NewInt(15).Bytes()}, vmOutput.Logs[0].Topics)
+
+	guardians, err := getGuardians(account, args.Marshalizer)
+	require.Nil(t, err)
+	assert.Nil(t, guardians.Active)
+	assert.Equal(t, &Guardian{Address: firstGuardian, ActivationEpoch: 15}, guardians.Pending)
+
+	sg.EpochConfirmed(15, 0)
+	vmOutput, err = sg.ProcessBuiltinFunction(account, account, createSetGuardianInput(caller, firstGuardian))
+	assert.Nil(t, vmOutput)
+	assert.True(t, errors.Is(err, process.ErrOperationNotPermitted))
+
+	_, err = sg.ProcessBuiltinFunction(account, account, createSetGuardianInput(caller, secondGuardian))
+	require.Nil(t, err)
+
+	guardians, err = getGuardians(account, args.Marshalizer)
+	require.Nil(t, err)
+	assert.Equal(t, &Guardian{Address: firstGuardian, ActivationEpoch: 15}, guardians.Active)
+	assert.Equal(t, &Guardian{Address: secondGuardian, ActivationEpoch: 25}, guardians.Pending)
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"sort"
 
+	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go/common"
@@ -17,6 +18,7 @@ import (
 
 const wildcard = "*"
 const keySize = 4
+const dynamicMinGasPriceSize = 8
 
 type headerIntegrityVerifier struct {
 	referenceChainID []byte
@@ -142,9 +144,21 @@ func (hdrIntVer *headerIntegrityVerifier) setInCache(epoch uint32, version strin
 	_ = hdrIntVer.versionCache.Put(key, version, len(key)+len(version))
 }
 
+// canHoldGovernedConfig returns true for the epoch start meta blocks, which carry the configuration changes activated
+// through governance in their reserved field
+func canHoldGovernedConfig(hdr data.HeaderHandler) bool {
+	return hdr.GetShardID() == core.MetachainShardId && hdr.IsStartOfEpochBlock()
+}
+
+// canHoldDynamicMinGasPrice returns true if the header is a shard header carrying the dynamic minimum gas price in its
+// reserved field. The value itself is checked by the block processor
+func canHoldDynamicMinGasPrice(hdr data.HeaderHandler) bool {
+	return hdr.GetShardID() != core.MetachainShardId && len(hdr.GetReserved()) == dynamicMinGasPriceSize
+}
+
 // Verify will check the header's fields such as the chain ID or the software version
 func (hdrIntVer *headerIntegrityVerifier) Verify(hdr data.HeaderHandler) error {
-	if len(hdr.GetReserved()) > 0 {
+	if len(hdr.GetReserved()) > 0 && !canHoldGovernedConfig(hdr) && !canHoldDynamicMinGasPrice(hdr) {
 		return process.ErrReservedFieldNotSupportedYet
 	}
 
@@ -174,6 +174,49 @@ func TestHeaderIntegrityVerifier_PopulatedReservedShouldErr(t *testing.T) {
 	require.Equal(t, process.ErrReservedFieldNotSupportedYet, err)
 }
 
+func TestHeaderIntegrityVerifier_PopulatedReservedOnEpochStartMetaBlockShouldNotErr(t *testing.T) {
+	t.Parallel()
+
+	hdr := &block.MetaBlock{
+		Reserved:        []byte("r"),
+		SoftwareVersion: []byte("software"),
+		ChainID:         []byte("chainID"),
+		EpochStart: block.EpochStart{
+			LastFinalizedHeaders: []block.EpochStartShardData{{}},
+		},
+	}
+	hdrIntVer, _ := NewHeaderIntegrityVerifier(
+		[]byte("chainID"),
+		versionsCorrectlyConstructed,
+		"software",
+		&testscommon.CacherStub{},
+	)
+	err := hdrIntVer.Verify(hdr)
+	require.Nil(t, err)
+}
+
+func TestHeaderIntegrityVerifier_PopulatedReservedOnShardHeaderShouldWorkOnlyForDynamicMinGasPrice(t *testing.T) {
+	t.Parallel()
+
+	hdr := &block.Header{
+		Reserved:        []byte("r"),
+		SoftwareVersion: []byte("software"),
+		ChainID:         []byte("chainID"),
+	}
+	hdrIntVer, _ := NewHeaderIntegrityVerifier(
+		[]byte("chainID"),
+		versionsCorrectlyConstructed,
+		"software",
+		&testscommon.CacherStub{},
+	)
+	err := hdrIntVer.Verify(hdr)
+	require.Equal(t, process.ErrReservedFieldNotSupportedYet, err)
+
+	hdr.Reserved = make([]byte, dynamicMinGasPriceSize)
+	err = hdrIntVer.Verify(hdr)
+	require.Nil(t, err)
+}
+
 func TestHeaderIntegrityVerifier_VerifySoftwareVersionEmptyVersionInHeaderShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -17,10 +17,12 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	"github.com/ElrondNetwork/elrond-go-crypto"
 	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/common/governance"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
 	"github.com/ElrondNetwork/elrond-go/process/block/processedMb"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/state"
 	"github.com/ElrondNetwork/elrond-go/storage"
@@ -541,6 +543,21 @@ type ArgumentsParser interface {
 	IsInterfaceNil() bool
 }
 
+// DynamicGasPriceHandler computes the minimum gas price carried by the shard headers from the gas used by each block
+type DynamicGasPriceHandler interface {
+	IsDynamicMinGasPriceEnabled() bool
+	ComputeDynamicMinGasPrice(previousPrice uint64, gasUsed uint64) uint64
+	SetDynamicMinGasPrice(price uint64)
+	IsInterfaceNil() bool
+}
+
+// GasPriceTracker defines the behavior of a component able to track the gas prices of the transactions included
+// in the committed blocks
+type GasPriceTracker interface {
+	AddBlockGasPrices(gasUsed uint64, gasPrices []uint64)
+	IsInterfaceNil() bool
+}
+
 // BlockSizeThrottler defines the functionality of adapting the node to the network speed/latency when it should send a
 // block to its peers which should be received in a limited time frame
 type BlockSizeThrottler interface {
@@ -585,6 +602,7 @@ type feeHandler interface {
 	CheckValidityTxValues(tx data.TransactionWithFeeHandler) error
 	ComputeFeeForProcessing(tx data.TransactionWithFeeHandler, gasToUse uint64) *big.Int
 	MinGasPrice() uint64
+	DynamicMinGasPrice() uint64
 	GasPriceModifier() float64
 	MinGasLimit() uint64
 	SplitTxGasInCategories(tx data.TransactionWithFeeHandler) (uint64, uint64)
@@ -619,6 +637,9 @@ type FeeHandler interface {
 type EconomicsDataHandler interface {
 	rewardsHandler
 	feeHandler
+	IsDynamicMinGasPriceEnabled() bool
+	ComputeDynamicMinGasPrice(previousPrice uint64, gasUsed uint64) uint64
+	SetDynamicMinGasPrice(price uint64)
 	IsInterfaceNil() bool
 }
 
@@ -801,6 +822,15 @@ type P2PAntifloodHandler interface {
 	Close() error
 }
 
+// VMTracer records, while tracing is started, the smart contract executions and the storage reads made by the VM
+type VMTracer interface {
+	StartTracing()
+	StopTracing() []*tracing.ExecutionTrace
+	TraceStorageRead(address []byte, key []byte, value []byte)
+	TraceExecution(vmInput *vmcommon.ContractCallInput, vmOutput *vmcommon.VMOutput, isBuiltInFunction bool, executionErr error)
+	IsInterfaceNil() bool
+}
+
 // PeerValidatorMapper can determine the peer info from a peer id
 type PeerValidatorMapper interface {
 	GetPeerInfo(pid core.PeerID) core.P2PPeerInfo
@@ -810,6 +840,7 @@ type PeerValidatorMapper interface {
 // SCQueryService defines how data should be get from a SC account
 type SCQueryService interface {
 	ExecuteQuery(query *SCQuery) (*vmcommon.VMOutput, error)
+	ExecuteQueryWithTrace(query *SCQuery) (*vmcommon.VMOutput, *tracing.ExecutionTrace, error)
 	ComputeScCallGasLimit(tx *transaction.Transaction) (uint64, error)
 	Close() error
 	IsInterfaceNil() bool
@@ -858,6 +889,21 @@ type EpochStartSystemSCProcessor interface {
 		rewardTxs epochStart.TransactionCacher,
 	) error
 	ToggleUnStakeUnBond(value bool) error
+	GovernedConfigData() []byte
+	IsInterfaceNil() bool
+}
+
+// ScheduledCallsExecutor defines the functionality for the metachain to send the scheduled calls which became due,
+// either by reaching their target or by having their condition hold, to the shards of their destinations
+type ScheduledCallsExecutor interface {
+	ExecuteDueCalls(header data.HeaderHandler) error
+	IsInterfaceNil() bool
+}
+
+// GuardedAccountHandler defines the functionality to read the guardian of an account and to check its transactions
+type GuardedAccountHandler interface {
+	GetActiveGuardian(uah vmcommon.UserAccountHandler) ([]byte, error)
+	CheckGuardedTransaction(uah vmcommon.UserAccountHandler, tx *transaction.Transaction) error
 	IsInterfaceNil() bool
 }
 
@@ -994,6 +1040,7 @@ type EpochNotifier interface {
 	RegisterNotifyHandler(handler vmcommon.EpochSubscriberHandler)
 	CurrentEpoch() uint32
 	CheckEpoch(header data.HeaderHandler)
+	SetGovernedConfig(governedConfig *governance.GovernedConfig)
 	IsInterfaceNil() bool
 }
 
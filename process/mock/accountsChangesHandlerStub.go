@@ -0,0 +1,32 @@
+package mock
+
+import (
+	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
+)
+
+// AccountsChangesHandlerStub -
+type AccountsChangesHandlerStub struct {
+	GetAccountsChangesCalled   func() ([]*txSimData.AccountChanges, error)
+	CleanAccountsChangesCalled func()
+}
+
+// GetAccountsChanges -
+func (stub *AccountsChangesHandlerStub) GetAccountsChanges() ([]*txSimData.AccountChanges, error) {
+	if stub.GetAccountsChangesCalled != nil {
+		return stub.GetAccountsChangesCalled()
+	}
+
+	return make([]*txSimData.AccountChanges, 0), nil
+}
+
+// CleanAccountsChanges -
+func (stub *AccountsChangesHandlerStub) CleanAccountsChanges() {
+	if stub.CleanAccountsChangesCalled != nil {
+		stub.CleanAccountsChangesCalled()
+	}
+}
+
+// IsInterfaceNil -
+func (stub *AccountsChangesHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
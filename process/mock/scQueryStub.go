@@ -3,12 +3,14 @@ package mock
 import (
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
 
 // ScQueryStub -
 type ScQueryStub struct {
 	ExecuteQueryCalled           func(query *process.SCQuery) (*vmcommon.VMOutput, error)
+	ExecuteQueryWithTraceCalled  func(query *process.SCQuery) (*vmcommon.VMOutput, *tracing.ExecutionTrace, error)
 	ComputeScCallGasLimitHandler func(tx *transaction.Transaction) (uint64, error)
 	CloseCalled                  func() error
 }
@@ -29,6 +31,14 @@ func (s *ScQueryStub) ComputeScCallGasLimit(tx *transaction.Transaction) (uint64
 	return 100, nil
 }
 
+// ExecuteQueryWithTrace -
+func (s *ScQueryStub) ExecuteQueryWithTrace(query *process.SCQuery) (*vmcommon.VMOutput, *tracing.ExecutionTrace, error) {
+	if s.ExecuteQueryWithTraceCalled != nil {
+		return s.ExecuteQueryWithTraceCalled(query)
+	}
+	return &vmcommon.VMOutput{}, nil, nil
+}
+
 // Close -
 func (s *ScQueryStub) Close() error {
 	if s.CloseCalled != nil {
@@ -0,0 +1,23 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/data"
+)
+
+// ScheduledCallsExecutorStub -
+type ScheduledCallsExecutorStub struct {
+	ExecuteDueCallsCalled func(header data.HeaderHandler) error
+}
+
+// ExecuteDueCalls -
+func (s *ScheduledCallsExecutorStub) ExecuteDueCalls(header data.HeaderHandler) error {
+	if s.ExecuteDueCallsCalled != nil {
+		return s.ExecuteDueCallsCalled(header)
+	}
+	return nil
+}
+
+// IsInterfaceNil -
+func (s *ScheduledCallsExecutorStub) IsInterfaceNil() bool {
+	return s == nil
+}
@@ -0,0 +1,72 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
+)
+
+// ShardSimulatorStub -
+type ShardSimulatorStub struct {
+	SelfShardIDCalled                       func() uint32
+	StartBundleCalled                       func()
+	SimulateBundleTransactionCalled         func(tx *transaction.Transaction) (*txSimData.SimulationResults, map[string]*smartContractResult.SmartContractResult, error)
+	SimulateBundleSmartContractResultCalled func(scr *smartContractResult.SmartContractResult) (*txSimData.SimulationResults, map[string]*smartContractResult.SmartContractResult, error)
+	GetBundleAccountsChangesCalled          func() (map[string]*txSimData.ApiAccountChanges, error)
+	EndBundleCalled                         func()
+}
+
+// SelfShardID -
+func (stub *ShardSimulatorStub) SelfShardID() uint32 {
+	if stub.SelfShardIDCalled != nil {
+		return stub.SelfShardIDCalled()
+	}
+
+	return 0
+}
+
+// StartBundle -
+func (stub *ShardSimulatorStub) StartBundle() {
+	if stub.StartBundleCalled != nil {
+		stub.StartBundleCalled()
+	}
+}
+
+// SimulateBundleTransaction -
+func (stub *ShardSimulatorStub) SimulateBundleTransaction(tx *transaction.Transaction) (*txSimData.SimulationResults, map[string]*smartContractResult.SmartContractResult, error) {
+	if stub.SimulateBundleTransactionCalled != nil {
+		return stub.SimulateBundleTransactionCalled(tx)
+	}
+
+	return &txSimData.SimulationResults{Status: transaction.TxStatusSuccess}, nil, nil
+}
+
+// SimulateBundleSmartContractResult -
+func (stub *ShardSimulatorStub) SimulateBundleSmartContractResult(scr *smartContractResult.SmartContractResult) (*txSimData.SimulationResults, map[string]*smartContractResult.SmartContractResult, error) {
+	if stub.SimulateBundleSmartContractResultCalled != nil {
+		return stub.SimulateBundleSmartContractResultCalled(scr)
+	}
+
+	return &txSimData.SimulationResults{Status: transaction.TxStatusSuccess}, nil, nil
+}
+
+// GetBundleAccountsChanges -
+func (stub *ShardSimulatorStub) GetBundleAccountsChanges() (map[string]*txSimData.ApiAccountChanges, error) {
+	if stub.GetBundleAccountsChangesCalled != nil {
+		return stub.GetBundleAccountsChangesCalled()
+	}
+
+	return make(map[string]*txSimData.ApiAccountChanges), nil
+}
+
+// EndBundle -
+func (stub *ShardSimulatorStub) EndBundle() {
+	if stub.EndBundleCalled != nil {
+		stub.EndBundleCalled()
+	}
+}
+
+// IsInterfaceNil -
+func (stub *ShardSimulatorStub) IsInterfaceNil() bool {
+	return stub == nil
+}
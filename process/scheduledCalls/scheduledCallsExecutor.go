@@ -0,0 +1,389 @@
+package scheduledCalls
+
+import (
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/atomic"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
+	vmData "github.com/ElrondNetwork/elrond-go-core/data/vm"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+var _ process.ScheduledCallsExecutor = (*scheduledCallsExecutor)(nil)
+
+var log = logger.GetOrCreate("process/scheduledCalls")
+
+const executeScheduledFunction = "executeScheduled"
+const getConditionalCallsFunction = "getConditionalCalls"
+const conditionGasLimit = 10000000
+const maxConditionEvaluationsPerBlock = 10
+
+// ArgsScheduledCallsExecutor is the struct that contains all the components needed to create a new scheduledCallsExecutor
+type ArgsScheduledCallsExecutor struct {
+	SystemVM                vmcommon.VMExecutionHandler
+	AccountsDB              state.AccountsAdapter
+	SCRForwarder            process.IntermediateTransactionHandler
+	TxFeeHandler            process.TransactionFeeHandler
+	ArgumentsParser         process.ArgumentsParser
+	Marshalizer             marshal.Marshalizer
+	EpochNotifier           process.EpochNotifier
+	EndOfEpochCallerAddress []byte
+	SchedulerSCAddress      []byte
+	EpochConfig             config.EpochConfig
+}
+
+// scheduledCallsExecutor fetches the calls which became due from the scheduler system smart contract and sends
+// them as smart contract results, moving their escrowed value and gas out of the scheduler account. The calls are
+// executed by the shard of their destination, which, as for the relayed transactions, refunds the unused gas and,
+// on failure, the value to the sender of the call
+type scheduledCallsExecutor struct {
+	systemVM                  vmcommon.VMExecutionHandler
+	accountsDB                state.AccountsAdapter
+	scrForwarder              process.IntermediateTransactionHandler
+	txFeeHandler              process.TransactionFeeHandler
+	argumentsParser           process.ArgumentsParser
+	marshalizer               marshal.Marshalizer
+	endOfEpochCallerAddress   []byte
+	schedulerSCAddress        []byte
+	scheduledCallsEnableEpoch uint32
+	flagScheduledCalls        atomic.Flag
+}
+
+// NewScheduledCallsExecutor creates a new scheduled calls executor
+func NewScheduledCallsExecutor(args ArgsScheduledCallsExecutor) (*scheduledCallsExecutor, error) {
+	if check.IfNil(args.SystemVM) {
+		return nil, process.ErrNilSystemVM
+	}
+	if check.IfNil(args.AccountsDB) {
+		return nil, process.ErrNilAccountsAdapter
+	}
+	if check.IfNil(args.SCRForwarder) {
+		return nil, process.ErrNilIntermediateTransactionHandler
+	}
+	if check.IfNil(args.TxFeeHandler) {
+		return nil, process.ErrNilEconomicsFeeHandler
+	}
+	if check.IfNil(args.ArgumentsParser) {
+		return nil, process.ErrNilArgumentParser
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(args.EpochNotifier) {
+		return nil, process.ErrNilEpochNotifier
+	}
+	if len(args.EndOfEpochCallerAddress) == 0 {
+		return nil, process.ErrNilSndAddr
+	}
+	if len(args.SchedulerSCAddress) == 0 {
+		return nil, process.ErrNilRcvAddr
+	}
+
+	sce := &scheduledCallsExecutor{
+		systemVM:                  args.SystemVM,
+		accountsDB:                args.AccountsDB,
+		scrForwarder:              args.SCRForwarder,
+		txFeeHandler:              args.TxFeeHandler,
+		argumentsParser:           args.ArgumentsParser,
+		marshalizer:               args.Marshalizer,
+		endOfEpochCallerAddress:   args.EndOfEpochCallerAddress,
+		schedulerSCAddress:        args.SchedulerSCAddress,
+		scheduledCallsEnableEpoch: args.EpochConfig.EnableEpochs.ScheduledCallsEnableEpoch,
+	}
+	log.Debug("scheduledCallsExecutor: enable epoch for scheduled calls", "epoch", sce.scheduledCallsEnableEpoch)
+
+	args.EpochNotifier.RegisterNotifyHandler(sce)
+
+	return sce, nil
+}
+
+// ExecuteDueCalls marks as executed the calls which reached their target nonce or epoch, or whose condition holds,
+// and adds the resulting smart contract results to the intermediate transactions of the block. The target nonces are
+// metachain nonces, as the due calls are fetched while processing the metachain blocks
+func (sce *scheduledCallsExecutor) ExecuteDueCalls(header data.HeaderHandler) error {
+	if check.IfNil(header) {
+		return process.ErrNilHeaderHandler
+	}
+	if !sce.flagScheduledCalls.IsSet() {
+		return nil
+	}
+
+	readyIDs, err := sce.getReadyConditionalCalls(header.GetNonce())
+	if err != nil {
+		return err
+	}
+
+	arguments := [][]byte{
+		big.NewInt(0).SetUint64(header.GetNonce()).Bytes(),
+		big.NewInt(0).SetUint64(uint64(header.GetEpoch())).Bytes(),
+	}
+	vmOutput, err := sce.runSchedulerFunction(executeScheduledFunction, append(arguments, readyIDs...))
+	if err != nil {
+		return err
+	}
+
+	err = sce.processSCOutputAccounts(vmOutput)
+	if err != nil {
+		return err
+	}
+
+	return sce.createScheduledCallsResults(vmOutput.ReturnData)
+}
+
+// getReadyConditionalCalls returns the IDs of the pending conditional calls whose condition holds. The conditions
+// are evaluated as views, the output of the condition calls being discarded. As the evaluations are not paid by the
+// senders of the calls, at most maxConditionEvaluationsPerBlock conditions are evaluated in a block, the evaluated
+// calls rotating with the block nonce so that all the pending calls get evaluated
+func (sce *scheduledCallsExecutor) getReadyConditionalCalls(nonce uint64) ([][]byte, error) {
+	vmOutput, err := sce.runSchedulerFunction(getConditionalCallsFunction, make([][]byte, 0))
+	if err != nil {
+		return nil, err
+	}
+
+	numCalls := uint64(len(vmOutput.ReturnData))
+	numEvaluations := numCalls
+	firstIndex := uint64(0)
+	if numCalls > maxConditionEvaluationsPerBlock {
+		numEvaluations = maxConditionEvaluationsPerBlock
+		firstIndex = (nonce % numCalls) * maxConditionEvaluationsPerBlock % numCalls
+	}
+
+	readyIDs := make([][]byte, 0)
+	for i := uint64(0); i < numEvaluations; i++ {
+		marshaledCall := vmOutput.ReturnData[(firstIndex+i)%numCalls]
+		call := &systemSmartContracts.ScheduledCall{}
+		err = sce.marshalizer.Unmarshal(call, marshaledCall)
+		if err != nil {
+			return nil, err
+		}
+
+		if sce.conditionHolds(call) {
+			readyIDs = append(readyIDs, big.NewInt(0).SetUint64(call.ID).Bytes())
+		}
+	}
+
+	return readyIDs, nil
+}
+
+func (sce *scheduledCallsExecutor) conditionHolds(call *systemSmartContracts.ScheduledCall) bool {
+	function, arguments, err := sce.argumentsParser.ParseCallData(string(call.ConditionCall))
+	if err != nil {
+		log.Trace("scheduledCallsExecutor.conditionHolds: invalid condition call", "id", call.ID, "error", err)
+		return false
+	}
+
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  sce.schedulerSCAddress,
+			Arguments:   arguments,
+			CallValue:   big.NewInt(0),
+			GasProvided: conditionGasLimit,
+		},
+		RecipientAddr: call.ConditionAddress,
+		Function:      function,
+	}
+
+	vmOutput, err := sce.systemVM.RunSmartContractCall(vmInput)
+	if err != nil || vmOutput.ReturnCode != vmcommon.Ok || len(vmOutput.ReturnData) == 0 {
+		return false
+	}
+
+	return big.NewInt(0).SetBytes(vmOutput.ReturnData[0]).Sign() != 0
+}
+
+func (sce *scheduledCallsExecutor) runSchedulerFunction(function string, arguments [][]byte) (*vmcommon.VMOutput, error) {
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr: sce.endOfEpochCallerAddress,
+			Arguments:  arguments,
+			CallValue:  big.NewInt(0),
+		},
+		RecipientAddr: sce.schedulerSCAddress,
+		Function:      function,
+	}
+
+	vmOutput, err := sce.systemVM.RunSmartContractCall(vmInput)
+	if err != nil {
+		return nil, err
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		log.Debug("scheduledCallsExecutor.runSchedulerFunction",
+			"function", function,
+			"return code", vmOutput.ReturnCode,
+			"return message", vmOutput.ReturnMessage,
+		)
+		return nil, process.ErrScheduledCallsExecution
+	}
+
+	return vmOutput, nil
+}
+
+func (sce *scheduledCallsExecutor) createScheduledCallsResults(returnData [][]byte) error {
+	if len(returnData) == 0 {
+		return nil
+	}
+
+	schedulerAccount, err := sce.getUserAccount(sce.schedulerSCAddress)
+	if err != nil {
+		return err
+	}
+
+	scrs := make([]data.TransactionHandler, 0, len(returnData))
+	for _, marshaledCall := range returnData {
+		call := &systemSmartContracts.ScheduledCall{}
+		err = sce.marshalizer.Unmarshal(call, marshaledCall)
+		if err != nil {
+			return err
+		}
+
+		gasFee := big.NewInt(0).SetUint64(call.GasLimit)
+		gasFee.Mul(gasFee, big.NewInt(0).SetUint64(call.GasPrice))
+		escrow := big.NewInt(0).Add(gasFee, call.Value)
+		err = schedulerAccount.SubFromBalance(escrow)
+		if err != nil {
+			return err
+		}
+
+		if call.Status == systemSmartContracts.ScheduledCallExpired {
+			scrs = append(scrs, sce.createRefundResult(call, escrow))
+			log.Trace("scheduled call expired", "id", call.ID, "expiry epoch", call.ExpiryEpoch)
+			continue
+		}
+
+		scrs = append(scrs, sce.createCallResult(call, gasFee))
+		log.Trace("scheduled call sent",
+			"id", call.ID,
+			"target metachain nonce", call.TargetNonce,
+			"target epoch", call.TargetEpoch,
+		)
+	}
+
+	err = sce.accountsDB.SaveAccount(schedulerAccount)
+	if err != nil {
+		return err
+	}
+
+	return sce.scrForwarder.AddIntermediateTransactions(scrs)
+}
+
+// createCallResult creates the smart contract result of a due call. The sender of the call is set as relayer, so
+// that the destination shard sends back to it the unused gas and, on failure, the value of the call. As the gas of
+// the transfers to user accounts is not used by the destination shard, it is accounted here as the fee of the call
+func (sce *scheduledCallsExecutor) createCallResult(
+	call *systemSmartContracts.ScheduledCall,
+	gasFee *big.Int,
+) *smartContractResult.SmartContractResult {
+	scr := &smartContractResult.SmartContractResult{
+		Nonce:          call.ID,
+		Value:          big.NewInt(0).Set(call.Value),
+		RcvAddr:        call.Destination,
+		SndAddr:        sce.schedulerSCAddress,
+		RelayerAddr:    call.Sender,
+		RelayedValue:   big.NewInt(0).Set(call.Value),
+		OriginalSender: call.Sender,
+		Data:           call.Data,
+		GasPrice:       call.GasPrice,
+		PrevTxHash:     call.TxHash,
+		OriginalTxHash: call.TxHash,
+		CallType:       vmData.DirectCall,
+	}
+
+	isSmartContractCall := len(call.Data) > 0 && core.IsSmartContractAddress(call.Destination)
+	if isSmartContractCall {
+		scr.GasLimit = call.GasLimit
+		return scr
+	}
+
+	sce.txFeeHandler.ProcessTransactionFee(gasFee, big.NewInt(0), call.TxHash)
+
+	return scr
+}
+
+func (sce *scheduledCallsExecutor) createRefundResult(
+	call *systemSmartContracts.ScheduledCall,
+	escrow *big.Int,
+) *smartContractResult.SmartContractResult {
+	return &smartContractResult.SmartContractResult{
+		Nonce:          call.ID,
+		Value:          escrow,
+		RcvAddr:        call.Sender,
+		SndAddr:        sce.schedulerSCAddress,
+		OriginalSender: call.Sender,
+		GasPrice:       call.GasPrice,
+		PrevTxHash:     call.TxHash,
+		OriginalTxHash: call.TxHash,
+		CallType:       vmData.DirectCall,
+		ReturnMessage:  []byte("scheduled call expired"),
+	}
+}
+
+func (sce *scheduledCallsExecutor) processSCOutputAccounts(vmOutput *vmcommon.VMOutput) error {
+	outputAccounts := process.SortVMOutputInsideData(vmOutput)
+	for _, outAcc := range outputAccounts {
+		storageUpdates := process.GetSortedStorageUpdates(outAcc)
+		hasBalanceChange := outAcc.BalanceDelta != nil && outAcc.BalanceDelta.Sign() != 0
+		if len(storageUpdates) == 0 && !hasBalanceChange {
+			continue
+		}
+
+		acc, err := sce.getUserAccount(outAcc.Address)
+		if err != nil {
+			return err
+		}
+
+		for _, storeUpdate := range storageUpdates {
+			err = acc.DataTrieTracker().SaveKeyValue(storeUpdate.Offset, storeUpdate.Data)
+			if err != nil {
+				return err
+			}
+		}
+
+		if hasBalanceChange {
+			err = acc.AddToBalance(outAcc.BalanceDelta)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = sce.accountsDB.SaveAccount(acc)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (sce *scheduledCallsExecutor) getUserAccount(address []byte) (state.UserAccountHandler, error) {
+	acnt, err := sce.accountsDB.LoadAccount(address)
+	if err != nil {
+		return nil, err
+	}
+
+	userAccount, ok := acnt.(state.UserAccountHandler)
+	if !ok {
+		return nil, process.ErrWrongTypeAssertion
+	}
+
+	return userAccount, nil
+}
+
+// EpochConfirmed is called whenever a new epoch is confirmed
+func (sce *scheduledCallsExecutor) EpochConfirmed(epoch uint32, _ uint64) {
+	sce.flagScheduledCalls.Toggle(epoch >= sce.scheduledCallsEnableEpoch)
+	log.Debug("scheduledCallsExecutor: scheduled calls", "enabled", sce.flagScheduledCalls.IsSet())
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sce *scheduledCallsExecutor) IsInterfaceNil() bool {
+	return sce == nil
+}
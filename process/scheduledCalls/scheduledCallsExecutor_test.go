@@ -0,0 +1,446 @@
+package scheduledCalls
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract"
+	"github.com/ElrondNetwork/elrond-go/state"
+	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsScheduledCallsExecutor() ArgsScheduledCallsExecutor {
+	return ArgsScheduledCallsExecutor{
+		SystemVM:                &mock.VMExecutionHandlerStub{},
+		AccountsDB:              &stateMock.AccountsStub{},
+		SCRForwarder:            &mock.IntermediateTransactionHandlerMock{},
+		TxFeeHandler:            &mock.FeeAccumulatorStub{},
+		ArgumentsParser:         smartContract.NewArgumentParser(),
+		Marshalizer:             &mock.MarshalizerMock{},
+		EpochNotifier:           &mock.EpochNotifierStub{},
+		EndOfEpochCallerAddress: vm.EndOfEpochAddress,
+		SchedulerSCAddress:      vm.SchedulerSCAddress,
+	}
+}
+
+func TestNewScheduledCallsExecutor(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsScheduledCallsExecutor()
+	args.SystemVM = nil
+	sce, err := NewScheduledCallsExecutor(args)
+	assert.True(t, check.IfNil(sce))
+	assert.Equal(t, process.ErrNilSystemVM, err)
+
+	args = createMockArgsScheduledCallsExecutor()
+	args.AccountsDB = nil
+	sce, err = NewScheduledCallsExecutor(args)
+	assert.True(t, check.IfNil(sce))
+	assert.Equal(t, process.ErrNilAccountsAdapter, err)
+
+	args = createMockArgsScheduledCallsExecutor()
+	args.SCRForwarder = nil
+	sce, err = NewScheduledCallsExecutor(args)
+	assert.True(t, check.IfNil(sce))
+	assert.Equal(t, process.ErrNilIntermediateTransactionHandler, err)
+
+	args = createMockArgsScheduledCallsExecutor()
+	args.TxFeeHandler = nil
+	sce, err = NewScheduledCallsExecutor(args)
+	assert.True(t, check.IfNil(sce))
+	assert.Equal(t, process.ErrNilEconomicsFeeHandler, err)
+
+	args = createMockArgsScheduledCallsExecutor()
+	args.ArgumentsParser = nil
+	sce, err = NewScheduledCallsExecutor(args)
+	assert.True(t, check.IfNil(sce))
+	assert.Equal(t, process.ErrNilArgumentParser, err)
+
+	args = createMockArgsScheduledCallsExecutor()
+	args.SchedulerSCAddress = nil
+	sce, err = NewScheduledCallsExecutor(args)
+	assert.True(t, check.IfNil(sce))
+	assert.Equal(t, process.ErrNilRcvAddr, err)
+
+	args = createMockArgsScheduledCallsExecutor()
+	sce, err = NewScheduledCallsExecutor(args)
+	assert.False(t, check.IfNil(sce))
+	assert.Nil(t, err)
+}
+
+func TestScheduledCallsExecutor_ExecuteDueCallsNotEnabledShouldNotCallTheVM(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsScheduledCallsExecutor()
+	args.EpochConfig.EnableEpochs.ScheduledCallsEnableEpoch = 1
+	args.SystemVM = &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			require.Fail(t, "should not have been called")
+			return nil, nil
+		},
+	}
+	sce, _ := NewScheduledCallsExecutor(args)
+
+	err := sce.ExecuteDueCalls(&block.MetaBlock{Nonce: 5})
+	assert.Nil(t, err)
+}
+
+func TestScheduledCallsExecutor_ExecuteDueCallsFailedCallShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsScheduledCallsExecutor()
+	args.SystemVM = &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			return &vmcommon.VMOutput{ReturnCode: vmcommon.UserError}, nil
+		},
+	}
+	sce, _ := NewScheduledCallsExecutor(args)
+
+	err := sce.ExecuteDueCalls(&block.MetaBlock{Nonce: 5})
+	assert.True(t, errors.Is(err, process.ErrScheduledCallsExecution))
+}
+
+func TestScheduledCallsExecutor_ExecuteDueCallsNothingDueShouldNotChangeState(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsScheduledCallsExecutor()
+	args.SystemVM = &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			return &vmcommon.VMOutput{
+				ReturnCode: vmcommon.Ok,
+				OutputAccounts: map[string]*vmcommon.OutputAccount{
+					string(vm.SchedulerSCAddress): {
+						Address:      vm.SchedulerSCAddress,
+						BalanceDelta: big.NewInt(0),
+					},
+				},
+			}, nil
+		},
+	}
+	args.AccountsDB = &stateMock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			require.Fail(t, "should not have been called")
+			return nil, nil
+		},
+		SaveAccountCalled: func(account vmcommon.AccountHandler) error {
+			require.Fail(t, "should not have been called")
+			return nil
+		},
+	}
+	sce, _ := NewScheduledCallsExecutor(args)
+
+	err := sce.ExecuteDueCalls(&block.MetaBlock{Nonce: 5})
+	assert.Nil(t, err)
+}
+
+func TestScheduledCallsExecutor_ExecuteDueCallsShouldCreateResults(t *testing.T) {
+	t.Parallel()
+
+	sender := bytes.Repeat([]byte{1}, 32)
+	destination := append(make([]byte, 8), bytes.Repeat([]byte{2}, 24)...)
+	call := &systemSmartContracts.ScheduledCall{
+		ID:          7,
+		Sender:      sender,
+		Destination: destination,
+		Value:       big.NewInt(1000),
+		Data:        []byte("doSomething@01"),
+		GasLimit:    50,
+		GasPrice:    10,
+		TargetNonce: 5,
+		TxHash:      []byte("tx hash"),
+		Status:      systemSmartContracts.ScheduledCallExecuted,
+	}
+
+	args := createMockArgsScheduledCallsExecutor()
+	marshaledCall, _ := args.Marshalizer.Marshal(call)
+	args.SystemVM = &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			assert.Equal(t, vm.EndOfEpochAddress, input.CallerAddr)
+			if input.Function == getConditionalCallsFunction {
+				return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}, nil
+			}
+
+			assert.Equal(t, executeScheduledFunction, input.Function)
+			assert.Equal(t, [][]byte{big.NewInt(5).Bytes(), big.NewInt(2).Bytes()}, input.Arguments)
+
+			return &vmcommon.VMOutput{
+				ReturnCode: vmcommon.Ok,
+				ReturnData: [][]byte{marshaledCall},
+			}, nil
+		},
+	}
+	args.TxFeeHandler = &mock.FeeAccumulatorStub{
+		ProcessTransactionFeeCalled: func(cost *big.Int, devFee *big.Int, hash []byte) {
+			require.Fail(t, "the gas of the smart contract calls should be used by the destination shard")
+		},
+	}
+
+	schedulerAccount, _ := state.NewUserAccount(vm.SchedulerSCAddress)
+	_ = schedulerAccount.AddToBalance(big.NewInt(2000))
+	args.AccountsDB = &stateMock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			return schedulerAccount, nil
+		},
+	}
+
+	var sentResults []data.TransactionHandler
+	args.SCRForwarder = &mock.IntermediateTransactionHandlerMock{
+		AddIntermediateTransactionsCalled: func(txs []data.TransactionHandler) error {
+			sentResults = txs
+			return nil
+		},
+	}
+	sce, _ := NewScheduledCallsExecutor(args)
+
+	err := sce.ExecuteDueCalls(&block.MetaBlock{Nonce: 5, Epoch: 2})
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(500), schedulerAccount.GetBalance())
+
+	require.Equal(t, 1, len(sentResults))
+	scr, ok := sentResults[0].(*smartContractResult.SmartContractResult)
+	require.True(t, ok)
+	assert.Equal(t, uint64(7), scr.Nonce)
+	assert.Equal(t, big.NewInt(1000), scr.Value)
+	assert.Equal(t, destination, scr.RcvAddr)
+	assert.Equal(t, vm.SchedulerSCAddress, scr.SndAddr)
+	assert.Equal(t, sender, scr.OriginalSender)
+	assert.Equal(t, sender, scr.RelayerAddr)
+	assert.Equal(t, big.NewInt(1000), scr.RelayedValue)
+	assert.Equal(t, []byte("doSomething@01"), scr.Data)
+	assert.Equal(t, uint64(50), scr.GasLimit)
+	assert.Equal(t, []byte("tx hash"), scr.OriginalTxHash)
+}
+
+func TestScheduledCallsExecutor_ExecuteDueCallsTransferShouldAccountTheGasAsFees(t *testing.T) {
+	t.Parallel()
+
+	sender := bytes.Repeat([]byte{1}, 32)
+	call := &systemSmartContracts.ScheduledCall{
+		ID:          7,
+		Sender:      sender,
+		Destination: bytes.Repeat([]byte{2}, 32),
+		Value:       big.NewInt(1000),
+		GasLimit:    50,
+		GasPrice:    10,
+		TargetEpoch: 2,
+		TxHash:      []byte("tx hash"),
+		Status:      systemSmartContracts.ScheduledCallExecuted,
+	}
+
+	args := createMockArgsScheduledCallsExecutor()
+	marshaledCall, _ := args.Marshalizer.Marshal(call)
+	args.SystemVM = &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			if input.Function == getConditionalCallsFunction {
+				return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}, nil
+			}
+
+			return &vmcommon.VMOutput{
+				ReturnCode: vmcommon.Ok,
+				ReturnData: [][]byte{marshaledCall},
+			}, nil
+		},
+	}
+	accumulatedFees := big.NewInt(0)
+	args.TxFeeHandler = &mock.FeeAccumulatorStub{
+		ProcessTransactionFeeCalled: func(cost *big.Int, devFee *big.Int, hash []byte) {
+			accumulatedFees.Add(accumulatedFees, cost)
+			assert.Equal(t, big.NewInt(0), devFee)
+			assert.Equal(t, []byte("tx hash"), hash)
+		},
+	}
+
+	schedulerAccount, _ := state.NewUserAccount(vm.SchedulerSCAddress)
+	_ = schedulerAccount.AddToBalance(big.NewInt(2000))
+	args.AccountsDB = &stateMock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			return schedulerAccount, nil
+		},
+	}
+
+	var sentResults []data.TransactionHandler
+	args.SCRForwarder = &mock.IntermediateTransactionHandlerMock{
+		AddIntermediateTransactionsCalled: func(txs []data.TransactionHandler) error {
+			sentResults = txs
+			return nil
+		},
+	}
+	sce, _ := NewScheduledCallsExecutor(args)
+
+	err := sce.ExecuteDueCalls(&block.MetaBlock{Nonce: 5, Epoch: 2})
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(500), schedulerAccount.GetBalance())
+	assert.Equal(t, big.NewInt(500), accumulatedFees)
+
+	require.Equal(t, 1, len(sentResults))
+	scr := sentResults[0].(*smartContractResult.SmartContractResult)
+	assert.Equal(t, big.NewInt(1000), scr.Value)
+	assert.Equal(t, uint64(0), scr.GasLimit)
+	assert.Equal(t, sender, scr.RelayerAddr)
+}
+
+func TestScheduledCallsExecutor_ExecuteDueCallsShouldEvaluateConditionsAndRefundExpiredCalls(t *testing.T) {
+	t.Parallel()
+
+	sender := bytes.Repeat([]byte{1}, 32)
+	readyCall := &systemSmartContracts.ScheduledCall{
+		ID:               3,
+		ConditionAddress: vm.StakingSCAddress,
+		ConditionCall:    []byte("isReady@01"),
+		Status:           systemSmartContracts.ScheduledCallPending,
+	}
+	notReadyCall := &systemSmartContracts.ScheduledCall{
+		ID:               4,
+		ConditionAddress: vm.StakingSCAddress,
+		ConditionCall:    []byte("isReady@00"),
+		Status:           systemSmartContracts.ScheduledCallPending,
+	}
+	expiredCall := &systemSmartContracts.ScheduledCall{
+		ID:               4,
+		Sender:           sender,
+		Destination:      bytes.Repeat([]byte{2}, 32),
+		Value:            big.NewInt(1000),
+		GasLimit:         50,
+		GasPrice:         10,
+		TxHash:           []byte("tx hash"),
+		ConditionAddress: vm.StakingSCAddress,
+		ConditionCall:    []byte("isReady@00"),
+		ExpiryEpoch:      1,
+		Status:           systemSmartContracts.ScheduledCallExpired,
+	}
+
+	args := createMockArgsScheduledCallsExecutor()
+	marshaledReadyCall, _ := args.Marshalizer.Marshal(readyCall)
+	marshaledNotReadyCall, _ := args.Marshalizer.Marshal(notReadyCall)
+	marshaledExpiredCall, _ := args.Marshalizer.Marshal(expiredCall)
+	args.SystemVM = &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			switch input.Function {
+			case getConditionalCallsFunction:
+				return &vmcommon.VMOutput{
+					ReturnCode: vmcommon.Ok,
+					ReturnData: [][]byte{marshaledReadyCall, marshaledNotReadyCall},
+				}, nil
+			case "isReady":
+				assert.Equal(t, vm.StakingSCAddress, input.RecipientAddr)
+				assert.Equal(t, vm.SchedulerSCAddress, input.CallerAddr)
+				return &vmcommon.VMOutput{
+					ReturnCode: vmcommon.Ok,
+					ReturnData: input.Arguments,
+					OutputAccounts: map[string]*vmcommon.OutputAccount{
+						string(vm.StakingSCAddress): {Address: vm.StakingSCAddress, BalanceDelta: big.NewInt(1)},
+					},
+				}, nil
+			}
+
+			assert.Equal(t, executeScheduledFunction, input.Function)
+			assert.Equal(t, [][]byte{big.NewInt(5).Bytes(), big.NewInt(2).Bytes(), big.NewInt(3).Bytes()}, input.Arguments)
+			return &vmcommon.VMOutput{
+				ReturnCode: vmcommon.Ok,
+				ReturnData: [][]byte{marshaledExpiredCall},
+			}, nil
+		},
+	}
+	args.TxFeeHandler = &mock.FeeAccumulatorStub{
+		ProcessTransactionFeeCalled: func(cost *big.Int, devFee *big.Int, hash []byte) {
+			require.Fail(t, "the escrow of the expired calls should be refunded")
+		},
+	}
+
+	schedulerAccount, _ := state.NewUserAccount(vm.SchedulerSCAddress)
+	_ = schedulerAccount.AddToBalance(big.NewInt(2000))
+	args.AccountsDB = &stateMock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			require.Equal(t, vm.SchedulerSCAddress, address, "the output of the condition calls should be discarded")
+			return schedulerAccount, nil
+		},
+	}
+
+	var sentResults []data.TransactionHandler
+	args.SCRForwarder = &mock.IntermediateTransactionHandlerMock{
+		AddIntermediateTransactionsCalled: func(txs []data.TransactionHandler) error {
+			sentResults = txs
+			return nil
+		},
+	}
+	sce, _ := NewScheduledCallsExecutor(args)
+
+	err := sce.ExecuteDueCalls(&block.MetaBlock{Nonce: 5, Epoch: 2})
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(500), schedulerAccount.GetBalance())
+
+	require.Equal(t, 1, len(sentResults))
+	scr := sentResults[0].(*smartContractResult.SmartContractResult)
+	assert.Equal(t, big.NewInt(1500), scr.Value)
+	assert.Equal(t, sender, scr.RcvAddr)
+	assert.Equal(t, vm.SchedulerSCAddress, scr.SndAddr)
+	assert.Equal(t, uint64(0), scr.GasLimit)
+	assert.Empty(t, scr.Data)
+}
+
+func TestScheduledCallsExecutor_ExecuteDueCallsShouldLimitTheConditionEvaluations(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsScheduledCallsExecutor()
+	numCalls := 25
+	marshaledCalls := make([][]byte, 0, numCalls)
+	for i := 1; i <= numCalls; i++ {
+		call := &systemSmartContracts.ScheduledCall{
+			ID:               uint64(i),
+			ConditionAddress: vm.StakingSCAddress,
+			ConditionCall:    []byte("isReady@01"),
+			Status:           systemSmartContracts.ScheduledCallPending,
+		}
+		marshaledCall, _ := args.Marshalizer.Marshal(call)
+		marshaledCalls = append(marshaledCalls, marshaledCall)
+	}
+
+	numEvaluations := 0
+	var readyIDs [][]byte
+	args.SystemVM = &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			switch input.Function {
+			case getConditionalCallsFunction:
+				return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok, ReturnData: marshaledCalls}, nil
+			case "isReady":
+				numEvaluations++
+				return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok, ReturnData: input.Arguments}, nil
+			}
+
+			readyIDs = input.Arguments[2:]
+			return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}, nil
+		},
+	}
+	sce, _ := NewScheduledCallsExecutor(args)
+
+	err := sce.ExecuteDueCalls(&block.MetaBlock{Nonce: 1})
+	require.Nil(t, err)
+	assert.Equal(t, maxConditionEvaluationsPerBlock, numEvaluations)
+	require.Equal(t, maxConditionEvaluationsPerBlock, len(readyIDs))
+	assert.Equal(t, big.NewInt(11).Bytes(), readyIDs[0])
+	assert.Equal(t, big.NewInt(20).Bytes(), readyIDs[9])
+
+	// the evaluated calls rotate with the block nonce, wrapping around the pending calls
+	numEvaluations = 0
+	err = sce.ExecuteDueCalls(&block.MetaBlock{Nonce: 2})
+	require.Nil(t, err)
+	assert.Equal(t, maxConditionEvaluationsPerBlock, numEvaluations)
+	require.Equal(t, maxConditionEvaluationsPerBlock, len(readyIDs))
+	assert.Equal(t, big.NewInt(21).Bytes(), readyIDs[0])
+	assert.Equal(t, big.NewInt(25).Bytes(), readyIDs[4])
+	assert.Equal(t, big.NewInt(1).Bytes(), readyIDs[5])
+	assert.Equal(t, big.NewInt(5).Bytes(), readyIDs[9])
+}
@@ -4,7 +4,9 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/guardian"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/state"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
@@ -13,17 +15,19 @@ import (
 
 // ArgsCreateBuiltInFunctionContainer defines the argument structure to create new built in function container
 type ArgsCreateBuiltInFunctionContainer struct {
-	GasSchedule                  core.GasScheduleNotifier
-	MapDNSAddresses              map[string]struct{}
-	EnableUserNameChange         bool
-	Marshalizer                  marshal.Marshalizer
-	Accounts                     state.AccountsAdapter
-	ShardCoordinator             sharding.Coordinator
-	EpochNotifier                vmcommon.EpochNotifier
-	ESDTMultiTransferEnableEpoch uint32
-	ESDTTransferRoleEnableEpoch  uint32
-	GlobalMintBurnDisableEpoch   uint32
-	ESDTTransferMetaEnableEpoch  uint32
+	GasSchedule                   core.GasScheduleNotifier
+	MapDNSAddresses               map[string]struct{}
+	EnableUserNameChange          bool
+	Marshalizer                   marshal.Marshalizer
+	Accounts                      state.AccountsAdapter
+	ShardCoordinator              sharding.Coordinator
+	EpochNotifier                 vmcommon.EpochNotifier
+	ESDTMultiTransferEnableEpoch  uint32
+	ESDTTransferRoleEnableEpoch   uint32
+	GlobalMintBurnDisableEpoch    uint32
+	ESDTTransferMetaEnableEpoch   uint32
+	GuardiansEnableEpoch          uint32
+	GuardianActivationEpochsDelay uint32
 }
 
 // CreateBuiltInFunctionContainer creates a container that will hold all the available built in functions
@@ -78,5 +82,26 @@ func CreateBuiltInFunctionContainer(args ArgsCreateBuiltInFunctionContainer) (vm
 
 	args.GasSchedule.RegisterNotifyHandler(bContainerFactory)
 
+	err = addSetGuardianFunction(container, args)
+	if err != nil {
+		return nil, err
+	}
+
 	return container, nil
 }
+
+func addSetGuardianFunction(container vmcommon.BuiltInFunctionContainer, args ArgsCreateBuiltInFunctionContainer) error {
+	argsSetGuardian := guardian.ArgsSetGuardian{
+		Marshalizer:                   args.Marshalizer,
+		GasSchedule:                   args.GasSchedule,
+		EpochNotifier:                 args.EpochNotifier,
+		GuardiansEnableEpoch:          args.GuardiansEnableEpoch,
+		GuardianActivationEpochsDelay: args.GuardianActivationEpochsDelay,
+	}
+	setGuardianFunc, err := guardian.NewSetGuardianFunc(argsSetGuardian)
+	if err != nil {
+		return err
+	}
+
+	return container.Add(common.BuiltInFunctionSetGuardian, setGuardianFunc)
+}
@@ -51,6 +51,7 @@ type ArgBlockChainHook struct {
 	ConfigSCStorage    config.StorageConfig
 	WorkingDir         string
 	NilCompiledSCStore bool
+	VMTracer           process.VMTracer
 }
 
 // BlockChainHookImpl is a wrapper over AccountsAdapter that satisfy vmcommon.BlockchainHook interface
@@ -63,6 +64,7 @@ type BlockChainHookImpl struct {
 	marshalizer      marshal.Marshalizer
 	uint64Converter  typeConverters.Uint64ByteSliceConverter
 	builtInFunctions vmcommon.BuiltInFunctionContainer
+	vmTracer         process.VMTracer
 
 	mutCurrentHdr sync.RWMutex
 	currentHdr    data.HeaderHandler
@@ -96,6 +98,7 @@ func NewBlockChainHookImpl(
 		configSCStorage:    args.ConfigSCStorage,
 		workingDir:         args.WorkingDir,
 		nilCompiledSCStore: args.NilCompiledSCStore,
+		vmTracer:           args.VMTracer,
 	}
 
 	err = blockChainHookImpl.makeCompiledSCStorage()
@@ -137,6 +140,9 @@ func checkForNil(args ArgBlockChainHook) error {
 	if check.IfNil(args.CompiledSCPool) {
 		return process.ErrNilCacher
 	}
+	if check.IfNil(args.VMTracer) {
+		return process.ErrNilVMTracer
+	}
 
 	return nil
 }
@@ -194,6 +200,8 @@ func (bh *BlockChainHookImpl) GetStorageData(accountAddress []byte, index []byte
 	if err != nil {
 		messages = append(messages, "error")
 		messages = append(messages, err)
+	} else {
+		bh.vmTracer.TraceStorageRead(accountAddress, index, value)
 	}
 	log.Trace("GetStorageData ", messages...)
 	return value, err
@@ -42,6 +42,7 @@ func createMockVMAccountsArguments() hooks.ArgBlockChainHook {
 		DataPool:           datapool,
 		CompiledSCPool:     datapool.SmartContracts(),
 		NilCompiledSCStore: true,
+		VMTracer:           &testscommon.VMTracerStub{},
 	}
 	return arguments
 }
@@ -123,6 +124,17 @@ func TestNewBlockChainHookImpl_NilUint64ConverterShouldErr(t *testing.T) {
 	assert.Equal(t, process.ErrNilUint64Converter, err)
 }
 
+func TestNewBlockChainHookImpl_NilVMTracerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockVMAccountsArguments()
+	args.VMTracer = nil
+	bh, err := hooks.NewBlockChainHookImpl(args)
+
+	assert.Nil(t, bh)
+	assert.Equal(t, process.ErrNilVMTracer, err)
+}
+
 func TestNewBlockChainHookImpl_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -233,12 +245,21 @@ func TestBlockChainHookImpl_GetStorageDataShouldWork(t *testing.T) {
 			return accnt, nil
 		},
 	}
+	var tracedKey, tracedValue []byte
+	args.VMTracer = &testscommon.VMTracerStub{
+		TraceStorageReadCalled: func(address []byte, key []byte, value []byte) {
+			tracedKey = key
+			tracedValue = value
+		},
+	}
 	bh, _ := hooks.NewBlockChainHookImpl(args)
 
 	value, err := bh.GetStorageData(make([]byte, 0), variableIdentifier)
 
 	assert.Nil(t, err)
 	assert.Equal(t, variableValue, value)
+	assert.Equal(t, variableIdentifier, tracedKey)
+	assert.Equal(t, variableValue, tracedValue)
 }
 
 func TestBlockChainHookImpl_NewAddressLengthNoGood(t *testing.T) {
@@ -20,6 +20,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/common/governance"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/state"
@@ -59,6 +60,8 @@ type scProcessor struct {
 	deployEnableEpoch                           uint32
 	builtinEnableEpoch                          uint32
 	penalizedTooMuchGasEnableEpoch              uint32
+	staticPenalizedTooMuchGasEnableEpoch        uint32
+	mutEnableEpochs                             sync.RWMutex
 	repairCallBackEnableEpoch                   uint32
 	stakingV2EnableEpoch                        uint32
 	returnDataToLastTransferEnableEpoch         uint32
@@ -87,6 +90,7 @@ type scProcessor struct {
 	mutGasLock          sync.RWMutex
 	txLogsProcessor     process.TransactionLogProcessor
 	vmOutputCacher      storage.Cacher
+	vmTracer            process.VMTracer
 	isGenesisProcessing bool
 }
 
@@ -120,6 +124,7 @@ type ArgsNewSmartContractProcessor struct {
 	EpochNotifier                               process.EpochNotifier
 	VMOutputCacher                              storage.Cacher
 	ArwenChangeLocker                           process.Locker
+	VMTracer                                    process.VMTracer
 	IsGenesisProcessing                         bool
 }
 
@@ -182,6 +187,9 @@ func NewSmartContractProcessor(args ArgsNewSmartContractProcessor) (*scProcessor
 	if check.IfNil(args.VMOutputCacher) {
 		return nil, process.ErrNilCacher
 	}
+	if check.IfNil(args.VMTracer) {
+		return nil, process.ErrNilVMTracer
+	}
 
 	builtInFuncCost := args.GasSchedule.LatestGasSchedule()[common.BuiltInCost]
 	sc := &scProcessor{
@@ -205,6 +213,7 @@ func NewSmartContractProcessor(args ArgsNewSmartContractProcessor) (*scProcessor
 		builtinEnableEpoch:                    args.BuiltinEnableEpoch,
 		repairCallBackEnableEpoch:             args.RepairCallbackEnableEpoch,
 		penalizedTooMuchGasEnableEpoch:        args.PenalizedTooMuchGasEnableEpoch,
+		staticPenalizedTooMuchGasEnableEpoch:  args.PenalizedTooMuchGasEnableEpoch,
 		isGenesisProcessing:                   args.IsGenesisProcessing,
 		stakingV2EnableEpoch:                  args.StakingV2EnableEpoch,
 		returnDataToLastTransferEnableEpoch:   args.ReturnDataToLastTransferEnableEpoch,
@@ -212,6 +221,7 @@ func NewSmartContractProcessor(args ArgsNewSmartContractProcessor) (*scProcessor
 		builtInFunctionOnMetachainEnableEpoch: args.BuiltInFunctionOnMetachainEnableEpoch,
 		arwenChangeLocker:                     args.ArwenChangeLocker,
 		vmOutputCacher:                        args.VMOutputCacher,
+		vmTracer:                              args.VMTracer,
 
 		incrementSCRNonceInMultiTransferEnableEpoch: args.IncrementSCRNonceInMultiTransferEnableEpoch,
 	}
@@ -397,6 +407,7 @@ func (sc *scProcessor) executeSmartContractCall(
 	var vmOutput *vmcommon.VMOutput
 	vmOutput, err = vmExec.RunSmartContractCall(vmInput)
 	sc.arwenChangeLocker.RUnlock()
+	sc.vmTracer.TraceExecution(vmInput, vmOutput, false, err)
 	if err != nil {
 		log.Debug("run smart contract call error", "error", err.Error())
 		return userErrorVmOutput, sc.ProcessIfError(acntSnd, txHash, tx, err.Error(), []byte(""), snapshot, vmInput.GasLocked)
@@ -962,9 +973,8 @@ func (sc *scProcessor) resolveBuiltInFunctions(
 			ReturnMessage: err.Error(),
 			GasRemaining:  0,
 		}
-
-		return vmOutput, nil
 	}
+	sc.vmTracer.TraceExecution(vmInput, vmOutput, true, nil)
 
 	return vmOutput, nil
 }
@@ -2397,6 +2407,18 @@ func (sc *scProcessor) IsPayable(address []byte) (bool, error) {
 	return sc.blockChainHook.IsPayable(address)
 }
 
+// GovernedConfigChanged applies the penalized too much gas enable epoch changed through governance, so the gas is
+// penalized starting with the same epoch as the one the economics data computes the fees with
+func (sc *scProcessor) GovernedConfigChanged(governedConfig *governance.GovernedConfig) {
+	penalizedTooMuchGasEnableEpoch := governedConfig.EnableEpoch(governance.PenalizedTooMuchGasEnableEpoch, sc.staticPenalizedTooMuchGasEnableEpoch)
+
+	sc.mutEnableEpochs.Lock()
+	sc.penalizedTooMuchGasEnableEpoch = penalizedTooMuchGasEnableEpoch
+	sc.mutEnableEpochs.Unlock()
+
+	log.Debug("scProcessor: governed config changed", "penalized too much gas enable epoch", penalizedTooMuchGasEnableEpoch)
+}
+
 // EpochConfirmed is called whenever a new epoch is confirmed
 func (sc *scProcessor) EpochConfirmed(epoch uint32, _ uint64) {
 	sc.flagDeploy.Toggle(epoch >= sc.deployEnableEpoch)
@@ -2405,7 +2427,11 @@ func (sc *scProcessor) EpochConfirmed(epoch uint32, _ uint64) {
 	sc.flagBuiltin.Toggle(epoch >= sc.builtinEnableEpoch)
 	log.Debug("scProcessor: built in functions", "enabled", sc.flagBuiltin.IsSet())
 
-	sc.flagPenalizedTooMuchGas.Toggle(epoch >= sc.penalizedTooMuchGasEnableEpoch)
+	sc.mutEnableEpochs.RLock()
+	penalizedTooMuchGasEnableEpoch := sc.penalizedTooMuchGasEnableEpoch
+	sc.mutEnableEpochs.RUnlock()
+
+	sc.flagPenalizedTooMuchGas.Toggle(epoch >= penalizedTooMuchGasEnableEpoch)
 	log.Debug("scProcessor: penalized too much gas", "enabled", sc.flagPenalizedTooMuchGas.IsSet())
 
 	sc.flagRepairCallBackData.Toggle(epoch >= sc.repairCallBackEnableEpoch)
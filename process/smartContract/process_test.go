@@ -101,6 +101,7 @@ func createMockSmartContractProcessorArguments() ArgsNewSmartContractProcessor {
 		EpochNotifier:        &mock.EpochNotifierStub{},
 		StakingV2EnableEpoch: 0,
 		ArwenChangeLocker:    &sync.RWMutex{},
+		VMTracer:             &testscommon.VMTracerStub{},
 		VMOutputCacher:       txcache.NewDisabledCache(),
 	}
 }
@@ -328,6 +329,17 @@ func TestNewSmartContractProcessor_NilLockerShouldErr(t *testing.T) {
 	require.Equal(t, process.ErrNilLocker, err)
 }
 
+func TestNewSmartContractProcessor_NilVMTracerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockSmartContractProcessorArguments()
+	arguments.VMTracer = nil
+	sc, err := NewSmartContractProcessor(arguments)
+
+	require.Nil(t, sc)
+	require.Equal(t, process.ErrNilVMTracer, err)
+}
+
 func TestNewSmartContractProcessor_ShouldRegisterNotifiers(t *testing.T) {
 	t.Parallel()
 
@@ -11,6 +11,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	vmData "github.com/ElrondNetwork/elrond-go-core/data/vm"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/ElrondNetwork/elrond-vm-common/parsers"
 )
@@ -27,6 +28,7 @@ type SCQueryService struct {
 	numQueries        int
 	gasForQuery       uint64
 	arwenChangeLocker process.Locker
+	vmTracer          process.VMTracer
 }
 
 // ArgsNewSCQueryService defines the arguments needed for the sc query service
@@ -36,6 +38,7 @@ type ArgsNewSCQueryService struct {
 	BlockChainHook    process.BlockChainHookHandler
 	BlockChain        data.ChainHandler
 	ArwenChangeLocker process.Locker
+	VMTracer          process.VMTracer
 }
 
 // NewSCQueryService returns a new instance of SCQueryService
@@ -57,6 +60,9 @@ func NewSCQueryService(
 	if check.IfNilReflect(args.ArwenChangeLocker) {
 		return nil, process.ErrNilLocker
 	}
+	if check.IfNil(args.VMTracer) {
+		return nil, process.ErrNilVMTracer
+	}
 
 	return &SCQueryService{
 		vmContainer:       args.VmContainer,
@@ -64,6 +70,7 @@ func NewSCQueryService(
 		blockChain:        args.BlockChain,
 		blockChainHook:    args.BlockChainHook,
 		arwenChangeLocker: args.ArwenChangeLocker,
+		vmTracer:          args.VMTracer,
 		gasForQuery:       math.MaxUint64,
 	}, nil
 }
@@ -83,6 +90,31 @@ func (service *SCQueryService) ExecuteQuery(query *process.SCQuery) (*vmcommon.V
 	return service.executeScCall(query, 0)
 }
 
+// ExecuteQueryWithTrace returns the VMOutput resulted upon running the function on the smart contract together with
+// the trace of the execution
+func (service *SCQueryService) ExecuteQueryWithTrace(query *process.SCQuery) (*vmcommon.VMOutput, *tracing.ExecutionTrace, error) {
+	if query.ScAddress == nil {
+		return nil, nil, process.ErrNilScAddress
+	}
+	if len(query.FuncName) == 0 {
+		return nil, nil, process.ErrEmptyFunctionName
+	}
+
+	service.mutRunSc.Lock()
+	defer service.mutRunSc.Unlock()
+
+	service.vmTracer.StartTracing()
+	vmOutput, err := service.executeScCall(query, 0)
+	traces := service.vmTracer.StopTracing()
+
+	var trace *tracing.ExecutionTrace
+	if len(traces) > 0 {
+		trace = traces[0]
+	}
+
+	return vmOutput, trace, err
+}
+
 func (service *SCQueryService) executeScCall(query *process.SCQuery, gasPrice uint64) (*vmcommon.VMOutput, error) {
 	log.Trace("executeScCall", "function", query.FuncName, "numQueries", service.numQueries)
 	service.numQueries++
@@ -100,17 +132,14 @@ func (service *SCQueryService) executeScCall(query *process.SCQuery, gasPrice ui
 	vmInput := service.createVMCallInput(query, gasPrice)
 	vmOutput, err := vm.RunSmartContractCall(vmInput)
 	service.arwenChangeLocker.RUnlock()
-	if err != nil {
-		return nil, err
-	}
-
-	if service.hasRetriableExecutionError(vmOutput) {
+	if err == nil && service.hasRetriableExecutionError(vmOutput) {
 		log.Error("Retriable execution error detected. Will retry (once) executeScCall()", "returnCode", vmOutput.ReturnCode, "returnMessage", vmOutput.ReturnMessage)
 
 		vmOutput, err = vm.RunSmartContractCall(vmInput)
-		if err != nil {
-			return nil, err
-		}
+	}
+	service.vmTracer.TraceExecution(vmInput, vmOutput, false, err)
+	if err != nil {
+		return nil, err
 	}
 
 	return vmOutput, nil
@@ -7,6 +7,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
 
@@ -47,6 +48,16 @@ func (sqsd *scQueryServiceDispatcher) ExecuteQuery(query *process.SCQuery) (*vmc
 	return sqsd.list[index].ExecuteQuery(query)
 }
 
+// ExecuteQueryWithTrace will call this method on one of the element from provided list
+func (sqsd *scQueryServiceDispatcher) ExecuteQueryWithTrace(query *process.SCQuery) (*vmcommon.VMOutput, *tracing.ExecutionTrace, error) {
+	index := sqsd.getNewIndex()
+
+	sqsd.mutList.RLock()
+	defer sqsd.mutList.RUnlock()
+
+	return sqsd.list[index].ExecuteQueryWithTrace(query)
+}
+
 // ComputeScCallGasLimit will call this method on one of the element from provided list
 func (sqsd *scQueryServiceDispatcher) ComputeScCallGasLimit(tx *transaction.Transaction) (uint64, error) {
 	index := sqsd.getNewIndex()
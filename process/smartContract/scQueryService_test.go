@@ -13,6 +13,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -27,6 +29,7 @@ func createMockArgumentsForSCQuery() ArgsNewSCQueryService {
 		BlockChainHook:    &mock.BlockChainHookHandlerMock{},
 		BlockChain:        &mock.BlockChainMock{},
 		ArwenChangeLocker: &sync.RWMutex{},
+		VMTracer:          &testscommon.VMTracerStub{},
 	}
 }
 
@@ -85,6 +88,17 @@ func TestNewSCQueryService_NilArwenLockerShouldErr(t *testing.T) {
 	assert.Equal(t, process.ErrNilLocker, err)
 }
 
+func TestNewSCQueryService_NilVMTracerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForSCQuery()
+	args.VMTracer = nil
+	target, err := NewSCQueryService(args)
+
+	assert.Nil(t, target)
+	assert.Equal(t, process.ErrNilVMTracer, err)
+}
+
 func TestNewSCQueryService_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -224,6 +238,67 @@ func TestExecuteQuery_ReturnsCorrectly(t *testing.T) {
 	assert.Equal(t, d[1], vmOutput.ReturnData[1])
 }
 
+func TestExecuteQueryWithTrace_ShouldReturnTheTraceOfTheExecution(t *testing.T) {
+	t.Parallel()
+
+	expectedOutput := &vmcommon.VMOutput{
+		ReturnCode: vmcommon.Ok,
+		ReturnData: [][]byte{[]byte("90")},
+	}
+	expectedTrace := &tracing.ExecutionTrace{Root: &tracing.CallFrame{Function: "function"}}
+	mockVM := &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (output *vmcommon.VMOutput, e error) {
+			return expectedOutput, nil
+		},
+	}
+
+	isTracing := false
+	tracedExecution := false
+	argsNewSCQuery := createMockArgumentsForSCQuery()
+	argsNewSCQuery.VmContainer = &mock.VMContainerMock{
+		GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
+			return mockVM, nil
+		},
+	}
+	argsNewSCQuery.EconomicsFee = &mock.FeeHandlerStub{
+		MaxGasLimitPerBlockCalled: func() uint64 {
+			return uint64(math.MaxUint64)
+		},
+	}
+	argsNewSCQuery.VMTracer = &testscommon.VMTracerStub{
+		StartTracingCalled: func() {
+			isTracing = true
+		},
+		TraceExecutionCalled: func(vmInput *vmcommon.ContractCallInput, vmOutput *vmcommon.VMOutput, isBuiltInFunction bool, executionErr error) {
+			assert.True(t, isTracing)
+			assert.Equal(t, "function", vmInput.Function)
+			assert.Equal(t, expectedOutput, vmOutput)
+			assert.False(t, isBuiltInFunction)
+			tracedExecution = true
+		},
+		StopTracingCalled: func() []*tracing.ExecutionTrace {
+			isTracing = false
+			return []*tracing.ExecutionTrace{expectedTrace}
+		},
+	}
+
+	target, _ := NewSCQueryService(argsNewSCQuery)
+
+	query := process.SCQuery{
+		ScAddress: []byte(DummyScAddress),
+		FuncName:  "function",
+		Arguments: [][]byte{},
+	}
+
+	vmOutput, trace, err := target.ExecuteQueryWithTrace(&query)
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedOutput, vmOutput)
+	assert.Equal(t, expectedTrace, trace)
+	assert.True(t, tracedExecution)
+	assert.False(t, isTracing)
+}
+
 func TestExecuteQuery_WhenNotOkCodeShouldNotErr(t *testing.T) {
 	t.Parallel()
 
@@ -485,6 +560,7 @@ func TestNewSCQueryService_CloseShouldWork(t *testing.T) {
 		BlockChainHook:    &mock.BlockChainHookHandlerMock{},
 		BlockChain:        &mock.BlockChainMock{},
 		ArwenChangeLocker: &sync.RWMutex{},
+		VMTracer:          &testscommon.VMTracerStub{},
 	}
 
 	target, _ := NewSCQueryService(argsNewSCQueryService)
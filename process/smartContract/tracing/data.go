@@ -0,0 +1,57 @@
+package tracing
+
+const (
+	// FrameTypeCall is the type of a smart contract call executed by the VM
+	FrameTypeCall = "call"
+	// FrameTypeBuiltInFunction is the type of a built in function execution
+	FrameTypeBuiltInFunction = "builtInFunction"
+	// FrameTypeSyncCall is the type of a smart contract executed synchronously during the execution of its parent
+	FrameTypeSyncCall = "syncCall"
+	// FrameTypeAsyncCall is the type of an asynchronous call requested by its parent
+	FrameTypeAsyncCall = "asyncCall"
+	// FrameTypeAsyncCallBack is the type of a callback requested by its parent
+	FrameTypeAsyncCallBack = "asyncCallBack"
+	// FrameTypeTransfer is the type of a value transfer without execution
+	FrameTypeTransfer = "transfer"
+	// FrameTypeTransferAndExecute is the type of a transfer followed by an execution on the receiver
+	FrameTypeTransferAndExecute = "transferAndExecute"
+)
+
+// ExecutionTrace holds the call tree recorded for a traced execution
+type ExecutionTrace struct {
+	TxHash string     `json:"txHash,omitempty"`
+	Root   *CallFrame `json:"root"`
+}
+
+// CallFrame holds the details of a call from the execution tree. Storage accesses and logs are the ones of the frame
+// itself, the nested calls holding their own. The gas consumed by a frame does not include the gas consumed by its
+// synchronous calls, but does include the gas forwarded with its transfers
+type CallFrame struct {
+	Type          string           `json:"type"`
+	Caller        string           `json:"caller,omitempty"`
+	Callee        string           `json:"callee"`
+	Function      string           `json:"function,omitempty"`
+	Arguments     []string         `json:"arguments,omitempty"`
+	Value         string           `json:"value,omitempty"`
+	GasProvided   uint64           `json:"gasProvided"`
+	GasConsumed   uint64           `json:"gasConsumed"`
+	ReturnCode    string           `json:"returnCode,omitempty"`
+	ReturnMessage string           `json:"returnMessage,omitempty"`
+	StorageReads  []*StorageAccess `json:"storageReads,omitempty"`
+	StorageWrites []*StorageAccess `json:"storageWrites,omitempty"`
+	Logs          []*LogEntry      `json:"logs,omitempty"`
+	Calls         []*CallFrame     `json:"calls,omitempty"`
+}
+
+// StorageAccess holds a hex encoded storage key together with its hex encoded value
+type StorageAccess struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// LogEntry holds a log emitted by a call
+type LogEntry struct {
+	Identifier string   `json:"identifier"`
+	Topics     []string `json:"topics,omitempty"`
+	Data       string   `json:"data,omitempty"`
+}
@@ -0,0 +1,36 @@
+package disabled
+
+import (
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+type disabledVMTracer struct {
+}
+
+// NewDisabledVMTracer creates a new instance of disabledVMTracer
+func NewDisabledVMTracer() *disabledVMTracer {
+	return &disabledVMTracer{}
+}
+
+// StartTracing does nothing for this implementation
+func (d *disabledVMTracer) StartTracing() {
+}
+
+// StopTracing returns an empty slice
+func (d *disabledVMTracer) StopTracing() []*tracing.ExecutionTrace {
+	return make([]*tracing.ExecutionTrace, 0)
+}
+
+// TraceStorageRead does nothing for this implementation
+func (d *disabledVMTracer) TraceStorageRead(_ []byte, _ []byte, _ []byte) {
+}
+
+// TraceExecution does nothing for this implementation
+func (d *disabledVMTracer) TraceExecution(_ *vmcommon.ContractCallInput, _ *vmcommon.VMOutput, _ bool, _ error) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (d *disabledVMTracer) IsInterfaceNil() bool {
+	return d == nil
+}
@@ -0,0 +1,6 @@
+package tracing
+
+import "errors"
+
+// ErrNilPubkeyConverter signals that a nil public key converter has been provided
+var ErrNilPubkeyConverter = errors.New("nil pubkey converter")
@@ -0,0 +1,335 @@
+package tracing
+
+import (
+	"encoding/hex"
+	"sort"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	vmData "github.com/ElrondNetwork/elrond-go-core/data/vm"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/ElrondNetwork/elrond-vm-common/parsers"
+)
+
+type callArgsParser interface {
+	ParseData(data string) (string, [][]byte, error)
+}
+
+type storageRead struct {
+	address []byte
+	access  *StorageAccess
+}
+
+// vmTracer records, while tracing is started, the smart contract executions and the storage reads made by the VM.
+// The call tree is rebuilt from the VM input and output: the contracts which consumed gas inside the execution are
+// reported as synchronous calls, while the output transfers are reported as the calls requested by their sender
+type vmTracer struct {
+	mut             sync.Mutex
+	isTracing       bool
+	pubkeyConverter core.PubkeyConverter
+	argsParser      callArgsParser
+	storageReads    []*storageRead
+	traces          []*ExecutionTrace
+	tracesByHash    map[string]*ExecutionTrace
+}
+
+// NewVMTracer creates a new VM tracer
+func NewVMTracer(pubkeyConverter core.PubkeyConverter) (*vmTracer, error) {
+	if check.IfNil(pubkeyConverter) {
+		return nil, ErrNilPubkeyConverter
+	}
+
+	vt := &vmTracer{
+		pubkeyConverter: pubkeyConverter,
+		argsParser:      parsers.NewCallArgsParser(),
+	}
+	vt.reset()
+
+	return vt, nil
+}
+
+// StartTracing drops anything recorded so far and starts recording the executions
+func (vt *vmTracer) StartTracing() {
+	vt.mut.Lock()
+	defer vt.mut.Unlock()
+
+	vt.reset()
+	vt.isTracing = true
+}
+
+// StopTracing stops recording and returns the traces of the executions recorded since StartTracing was called
+func (vt *vmTracer) StopTracing() []*ExecutionTrace {
+	vt.mut.Lock()
+	defer vt.mut.Unlock()
+
+	traces := vt.traces
+	vt.reset()
+	vt.isTracing = false
+
+	return traces
+}
+
+func (vt *vmTracer) reset() {
+	vt.storageReads = make([]*storageRead, 0)
+	vt.traces = make([]*ExecutionTrace, 0)
+	vt.tracesByHash = make(map[string]*ExecutionTrace)
+}
+
+// TraceStorageRead records a storage read. It will be attached to the frame of the account in the next traced execution
+func (vt *vmTracer) TraceStorageRead(address []byte, key []byte, value []byte) {
+	vt.mut.Lock()
+	defer vt.mut.Unlock()
+
+	if !vt.isTracing {
+		return
+	}
+
+	vt.storageReads = append(vt.storageReads, &storageRead{
+		address: address,
+		access: &StorageAccess{
+			Key:   hex.EncodeToString(key),
+			Value: hex.EncodeToString(value),
+		},
+	})
+}
+
+// TraceExecution records an execution. An execution for a transaction hash that has already been traced, as the one
+// following a built in function, is added as a nested call of the first one
+func (vt *vmTracer) TraceExecution(
+	vmInput *vmcommon.ContractCallInput,
+	vmOutput *vmcommon.VMOutput,
+	isBuiltInFunction bool,
+	executionErr error,
+) {
+	vt.mut.Lock()
+	defer vt.mut.Unlock()
+
+	if !vt.isTracing || vmInput == nil {
+		return
+	}
+
+	frameType := FrameTypeCall
+	if isBuiltInFunction {
+		frameType = FrameTypeBuiltInFunction
+	}
+	root := vt.createInputFrame(frameType, vmInput)
+	vt.addOutput(root, vmInput, vmOutput, executionErr)
+
+	txHash := string(vmInput.CurrentTxHash)
+	trace, found := vt.tracesByHash[txHash]
+	if found && len(txHash) > 0 {
+		trace.Root.Calls = append(trace.Root.Calls, root)
+		return
+	}
+
+	trace = &ExecutionTrace{
+		TxHash: hex.EncodeToString(vmInput.CurrentTxHash),
+		Root:   root,
+	}
+	vt.traces = append(vt.traces, trace)
+	vt.tracesByHash[txHash] = trace
+}
+
+func (vt *vmTracer) createInputFrame(frameType string, vmInput *vmcommon.ContractCallInput) *CallFrame {
+	frame := &CallFrame{
+		Type:        frameType,
+		Caller:      vt.encodeAddress(vmInput.CallerAddr),
+		Callee:      vt.encodeAddress(vmInput.RecipientAddr),
+		Function:    vmInput.Function,
+		Arguments:   make([]string, 0, len(vmInput.Arguments)),
+		GasProvided: vmInput.GasProvided,
+	}
+	for _, arg := range vmInput.Arguments {
+		frame.Arguments = append(frame.Arguments, hex.EncodeToString(arg))
+	}
+	if vmInput.CallValue != nil {
+		frame.Value = vmInput.CallValue.String()
+	}
+
+	return frame
+}
+
+func (vt *vmTracer) addOutput(root *CallFrame, vmInput *vmcommon.ContractCallInput, vmOutput *vmcommon.VMOutput, executionErr error) {
+	defer func() {
+		vt.storageReads = make([]*storageRead, 0)
+	}()
+
+	if vmOutput == nil {
+		root.ReturnCode = vmcommon.ExecutionFailed.String()
+		if executionErr != nil {
+			root.ReturnMessage = executionErr.Error()
+		}
+		return
+	}
+
+	root.ReturnCode = vmOutput.ReturnCode.String()
+	root.ReturnMessage = vmOutput.ReturnMessage
+	if vmInput.GasProvided > vmOutput.GasRemaining {
+		root.GasConsumed = vmInput.GasProvided - vmOutput.GasRemaining
+	}
+
+	executingFrames := map[string]*CallFrame{string(vmInput.RecipientAddr): root}
+	outputAccounts := sortOutputAccounts(vmOutput)
+	for _, outAcc := range outputAccounts {
+		if outAcc.GasUsed == 0 || string(outAcc.Address) == string(vmInput.RecipientAddr) {
+			continue
+		}
+
+		syncFrame := &CallFrame{
+			Type:        FrameTypeSyncCall,
+			Callee:      vt.encodeAddress(outAcc.Address),
+			GasConsumed: outAcc.GasUsed,
+		}
+		executingFrames[string(outAcc.Address)] = syncFrame
+		root.Calls = append(root.Calls, syncFrame)
+	}
+	for _, syncFrame := range root.Calls {
+		root.GasConsumed = safeSub(root.GasConsumed, syncFrame.GasConsumed)
+	}
+
+	for _, outAcc := range outputAccounts {
+		frame, isExecuting := executingFrames[string(outAcc.Address)]
+		if isExecuting {
+			frame.StorageWrites = createStorageWrites(outAcc)
+		}
+
+		for i := range outAcc.OutputTransfers {
+			transfer := &outAcc.OutputTransfers[i]
+			transferFrame := vt.createTransferFrame(vmInput.RecipientAddr, outAcc.Address, transfer)
+			parent, found := executingFrames[string(transfer.SenderAddress)]
+			if !found {
+				parent = root
+			}
+			parent.Calls = append(parent.Calls, transferFrame)
+		}
+	}
+
+	for _, logEntry := range vmOutput.Logs {
+		frame, found := executingFrames[string(logEntry.Address)]
+		if !found {
+			frame = root
+		}
+		frame.Logs = append(frame.Logs, createLogEntry(logEntry))
+	}
+
+	for _, read := range vt.storageReads {
+		frame, found := executingFrames[string(read.address)]
+		if !found {
+			continue
+		}
+		frame.StorageReads = append(frame.StorageReads, read.access)
+	}
+}
+
+func (vt *vmTracer) createTransferFrame(defaultSender []byte, receiver []byte, transfer *vmcommon.OutputTransfer) *CallFrame {
+	sender := transfer.SenderAddress
+	if len(sender) == 0 {
+		sender = defaultSender
+	}
+
+	frame := &CallFrame{
+		Type:        getTransferFrameType(transfer),
+		Caller:      vt.encodeAddress(sender),
+		Callee:      vt.encodeAddress(receiver),
+		GasProvided: transfer.GasLimit,
+	}
+	if transfer.Value != nil {
+		frame.Value = transfer.Value.String()
+	}
+	if len(transfer.Data) == 0 {
+		return frame
+	}
+
+	function, args, err := vt.argsParser.ParseData(string(transfer.Data))
+	if err != nil {
+		return frame
+	}
+	frame.Function = function
+	frame.Arguments = make([]string, 0, len(args))
+	for _, arg := range args {
+		frame.Arguments = append(frame.Arguments, hex.EncodeToString(arg))
+	}
+
+	return frame
+}
+
+func (vt *vmTracer) encodeAddress(address []byte) string {
+	if len(address) == 0 {
+		return ""
+	}
+
+	return vt.pubkeyConverter.Encode(address)
+}
+
+func getTransferFrameType(transfer *vmcommon.OutputTransfer) string {
+	switch transfer.CallType {
+	case vmData.AsynchronousCall:
+		return FrameTypeAsyncCall
+	case vmData.AsynchronousCallBack:
+		return FrameTypeAsyncCallBack
+	}
+
+	if len(transfer.Data) == 0 {
+		return FrameTypeTransfer
+	}
+
+	return FrameTypeTransferAndExecute
+}
+
+func sortOutputAccounts(vmOutput *vmcommon.VMOutput) []*vmcommon.OutputAccount {
+	outputAccounts := make([]*vmcommon.OutputAccount, 0, len(vmOutput.OutputAccounts))
+	for _, outAcc := range vmOutput.OutputAccounts {
+		outputAccounts = append(outputAccounts, outAcc)
+	}
+
+	sort.Slice(outputAccounts, func(i, j int) bool {
+		return string(outputAccounts[i].Address) < string(outputAccounts[j].Address)
+	})
+
+	return outputAccounts
+}
+
+func createStorageWrites(outAcc *vmcommon.OutputAccount) []*StorageAccess {
+	keys := make([]string, 0, len(outAcc.StorageUpdates))
+	for key := range outAcc.StorageUpdates {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	writes := make([]*StorageAccess, 0, len(keys))
+	for _, key := range keys {
+		writes = append(writes, &StorageAccess{
+			Key:   hex.EncodeToString([]byte(key)),
+			Value: hex.EncodeToString(outAcc.StorageUpdates[key].Data),
+		})
+	}
+
+	return writes
+}
+
+func createLogEntry(logEntry *vmcommon.LogEntry) *LogEntry {
+	entry := &LogEntry{
+		Identifier: string(logEntry.Identifier),
+		Topics:     make([]string, 0, len(logEntry.Topics)),
+		Data:       hex.EncodeToString(logEntry.Data),
+	}
+	for _, topic := range logEntry.Topics {
+		entry.Topics = append(entry.Topics, hex.EncodeToString(topic))
+	}
+
+	return entry
+}
+
+func safeSub(a uint64, b uint64) uint64 {
+	if a < b {
+		return 0
+	}
+
+	return a - b
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (vt *vmTracer) IsInterfaceNil() bool {
+	return vt == nil
+}
@@ -0,0 +1,205 @@
+package tracing
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/core/pubkeyConverter"
+	vmData "github.com/ElrondNetwork/elrond-go-core/data/vm"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/require"
+)
+
+func createTracer() *vmTracer {
+	converter, _ := pubkeyConverter.NewHexPubkeyConverter(32)
+	vt, _ := NewVMTracer(converter)
+	return vt
+}
+
+func createCallInput(caller string, recipient string, function string, gasProvided uint64) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:    []byte(caller),
+			CallValue:     big.NewInt(0),
+			GasProvided:   gasProvided,
+			CurrentTxHash: []byte("txHash"),
+			Arguments:     [][]byte{[]byte("arg")},
+		},
+		RecipientAddr: []byte(recipient),
+		Function:      function,
+	}
+}
+
+func encode(address string) string {
+	return hex.EncodeToString([]byte(address))
+}
+
+func TestNewVMTracer(t *testing.T) {
+	t.Parallel()
+
+	vt, err := NewVMTracer(nil)
+	require.True(t, check.IfNil(vt))
+	require.Equal(t, ErrNilPubkeyConverter, err)
+
+	converter, _ := pubkeyConverter.NewHexPubkeyConverter(32)
+	vt, err = NewVMTracer(converter)
+	require.False(t, check.IfNil(vt))
+	require.Nil(t, err)
+}
+
+func TestVmTracer_ShouldNotRecordWhenNotTracing(t *testing.T) {
+	t.Parallel()
+
+	vt := createTracer()
+	vt.TraceStorageRead([]byte("contract"), []byte("key"), []byte("value"))
+	vt.TraceExecution(createCallInput("caller", "contract", "function", 100), &vmcommon.VMOutput{}, false, nil)
+
+	vt.StartTracing()
+	traces := vt.StopTracing()
+	require.Empty(t, traces)
+}
+
+func TestVmTracer_TraceExecutionShouldBuildTheCallTree(t *testing.T) {
+	t.Parallel()
+
+	vt := createTracer()
+	vt.StartTracing()
+
+	vt.TraceStorageRead([]byte("contract"), []byte("key"), []byte("value"))
+	vt.TraceStorageRead([]byte("other"), []byte("otherKey"), []byte("otherValue"))
+	vt.TraceStorageRead([]byte("unknown"), []byte("unknownKey"), []byte("unknownValue"))
+
+	vmOutput := &vmcommon.VMOutput{
+		ReturnCode:   vmcommon.Ok,
+		GasRemaining: 20,
+		OutputAccounts: map[string]*vmcommon.OutputAccount{
+			"contract": {
+				Address: []byte("contract"),
+				StorageUpdates: map[string]*vmcommon.StorageUpdate{
+					"key": {Offset: []byte("key"), Data: []byte("newValue")},
+				},
+			},
+			"other": {
+				Address: []byte("other"),
+				GasUsed: 30,
+				OutputTransfers: []vmcommon.OutputTransfer{
+					{
+						Value:         big.NewInt(0),
+						GasLimit:      5,
+						Data:          []byte("callBack@01"),
+						CallType:      vmData.AsynchronousCallBack,
+						SenderAddress: []byte("other"),
+					},
+				},
+			},
+			"receiver": {
+				Address: []byte("receiver"),
+				OutputTransfers: []vmcommon.OutputTransfer{
+					{
+						Value:         big.NewInt(10),
+						SenderAddress: []byte("contract"),
+					},
+				},
+			},
+		},
+		Logs: []*vmcommon.LogEntry{
+			{Identifier: []byte("event"), Address: []byte("other"), Topics: [][]byte{[]byte("topic")}},
+		},
+	}
+	vt.TraceExecution(createCallInput("caller", "contract", "function", 100), vmOutput, false, nil)
+
+	traces := vt.StopTracing()
+	require.Equal(t, 1, len(traces))
+	require.Equal(t, hex.EncodeToString([]byte("txHash")), traces[0].TxHash)
+
+	root := traces[0].Root
+	require.Equal(t, FrameTypeCall, root.Type)
+	require.Equal(t, encode("caller"), root.Caller)
+	require.Equal(t, encode("contract"), root.Callee)
+	require.Equal(t, "function", root.Function)
+	require.Equal(t, []string{hex.EncodeToString([]byte("arg"))}, root.Arguments)
+	require.Equal(t, vmcommon.Ok.String(), root.ReturnCode)
+	require.Equal(t, uint64(100), root.GasProvided)
+	require.Equal(t, uint64(50), root.GasConsumed)
+	require.Equal(t, []*StorageAccess{{Key: hex.EncodeToString([]byte("key")), Value: hex.EncodeToString([]byte("value"))}}, root.StorageReads)
+	require.Equal(t, []*StorageAccess{{Key: hex.EncodeToString([]byte("key")), Value: hex.EncodeToString([]byte("newValue"))}}, root.StorageWrites)
+	require.Equal(t, 2, len(root.Calls))
+
+	syncCall := root.Calls[0]
+	require.Equal(t, FrameTypeSyncCall, syncCall.Type)
+	require.Equal(t, encode("other"), syncCall.Callee)
+	require.Equal(t, uint64(30), syncCall.GasConsumed)
+	require.Equal(t, []*StorageAccess{{Key: hex.EncodeToString([]byte("otherKey")), Value: hex.EncodeToString([]byte("otherValue"))}}, syncCall.StorageReads)
+	require.Equal(t, []*LogEntry{{Identifier: "event", Topics: []string{hex.EncodeToString([]byte("topic"))}}}, syncCall.Logs)
+	require.Equal(t, 1, len(syncCall.Calls))
+
+	callBack := syncCall.Calls[0]
+	require.Equal(t, FrameTypeAsyncCallBack, callBack.Type)
+	require.Equal(t, encode("other"), callBack.Caller)
+	require.Equal(t, encode("other"), callBack.Callee)
+	require.Equal(t, "callBack", callBack.Function)
+	require.Equal(t, []string{"01"}, callBack.Arguments)
+	require.Equal(t, uint64(5), callBack.GasProvided)
+
+	transfer := root.Calls[1]
+	require.Equal(t, FrameTypeTransfer, transfer.Type)
+	require.Equal(t, encode("contract"), transfer.Caller)
+	require.Equal(t, encode("receiver"), transfer.Callee)
+	require.Equal(t, "10", transfer.Value)
+}
+
+func TestVmTracer_TraceExecutionFailedShouldRecordTheError(t *testing.T) {
+	t.Parallel()
+
+	vt := createTracer()
+	vt.StartTracing()
+
+	expectedErr := errors.New("expected error")
+	vt.TraceExecution(createCallInput("caller", "contract", "function", 100), nil, false, expectedErr)
+
+	traces := vt.StopTracing()
+	require.Equal(t, 1, len(traces))
+	require.Equal(t, vmcommon.ExecutionFailed.String(), traces[0].Root.ReturnCode)
+	require.Equal(t, expectedErr.Error(), traces[0].Root.ReturnMessage)
+}
+
+func TestVmTracer_ExecutionAfterBuiltInFunctionShouldBeNested(t *testing.T) {
+	t.Parallel()
+
+	vt := createTracer()
+	vt.StartTracing()
+
+	builtInOutput := &vmcommon.VMOutput{ReturnCode: vmcommon.Ok, GasRemaining: 90}
+	vt.TraceExecution(createCallInput("caller", "contract", "ESDTTransfer", 100), builtInOutput, true, nil)
+	scOutput := &vmcommon.VMOutput{ReturnCode: vmcommon.UserError, ReturnMessage: "user error", GasRemaining: 50}
+	vt.TraceExecution(createCallInput("caller", "contract", "function", 90), scOutput, false, nil)
+
+	traces := vt.StopTracing()
+	require.Equal(t, 1, len(traces))
+
+	root := traces[0].Root
+	require.Equal(t, FrameTypeBuiltInFunction, root.Type)
+	require.Equal(t, "ESDTTransfer", root.Function)
+	require.Equal(t, uint64(10), root.GasConsumed)
+	require.Equal(t, 1, len(root.Calls))
+	require.Equal(t, FrameTypeCall, root.Calls[0].Type)
+	require.Equal(t, "function", root.Calls[0].Function)
+	require.Equal(t, uint64(40), root.Calls[0].GasConsumed)
+	require.Equal(t, vmcommon.UserError.String(), root.Calls[0].ReturnCode)
+	require.Equal(t, "user error", root.Calls[0].ReturnMessage)
+}
+
+func TestVmTracer_StartTracingShouldDropThePreviousRecords(t *testing.T) {
+	t.Parallel()
+
+	vt := createTracer()
+	vt.StartTracing()
+	vt.TraceExecution(createCallInput("caller", "contract", "function", 100), &vmcommon.VMOutput{}, false, nil)
+
+	vt.StartTracing()
+	traces := vt.StopTracing()
+	require.Empty(t, traces)
+}
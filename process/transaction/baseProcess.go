@@ -134,6 +134,10 @@ func (txProc *baseTxProcessor) checkTxValues(
 	if err != nil {
 		return err
 	}
+	// the dynamic minimum gas price is only checked by the sender shard, as each shard carries its own in the headers
+	if tx.GasPrice < txProc.economicsFee.DynamicMinGasPrice() {
+		return process.ErrInsufficientGasPriceInTx
+	}
 
 	var txFee *big.Int
 	if isUserTxOfRelayed {
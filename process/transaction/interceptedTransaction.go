@@ -14,6 +14,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-crypto"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/guardian"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 )
 
@@ -336,17 +337,41 @@ func (inTx *InterceptedTransaction) verifySig(tx *transaction.Transaction) error
 		return err
 	}
 
-	if !inTx.txVersionChecker.IsSignedWithHash(tx) {
-		return inTx.singleSigner.Verify(senderPubKey, buffCopiedTx, tx.Signature)
+	message := buffCopiedTx
+	if inTx.txVersionChecker.IsSignedWithHash(tx) {
+		if !inTx.enableSignedTxWithHash {
+			return process.ErrTransactionSignedWithHashIsNotEnabled
+		}
+
+		message = inTx.txSignHasher.Compute(string(buffCopiedTx))
+	}
+
+	if !guardian.IsGuardedTransaction(tx) {
+		return inTx.singleSigner.Verify(senderPubKey, message, tx.Signature)
 	}
 
-	if !inTx.enableSignedTxWithHash {
-		return process.ErrTransactionSignedWithHashIsNotEnabled
+	return inTx.verifyGuardedSig(tx, senderPubKey, message)
+}
+
+// verifyGuardedSig checks both the sender and the guardian signatures of a guarded transaction. Whether the guardian
+// is the active guardian of the sender is checked against the account state
+func (inTx *InterceptedTransaction) verifyGuardedSig(tx *transaction.Transaction, senderPubKey crypto.PublicKey, message []byte) error {
+	guardedSignature, err := guardian.ParseGuardedSignature(tx.Signature, inTx.pubkeyConv.Len())
+	if err != nil {
+		return err
 	}
 
-	txHash := inTx.txSignHasher.Compute(string(buffCopiedTx))
+	err = inTx.singleSigner.Verify(senderPubKey, message, guardedSignature.SenderSignature)
+	if err != nil {
+		return err
+	}
+
+	guardianPubKey, err := inTx.keyGen.PublicKeyFromByteArray(guardedSignature.GuardianAddress)
+	if err != nil {
+		return err
+	}
 
-	return inTx.singleSigner.Verify(senderPubKey, txHash, tx.Signature)
+	return inTx.singleSigner.Verify(guardianPubKey, message, guardedSignature.GuardianSignature)
 }
 
 // ReceiverShardId returns the receiver shard id
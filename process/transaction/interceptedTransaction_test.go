@@ -16,6 +16,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-crypto"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/guardian"
 	"github.com/ElrondNetwork/elrond-go/process/interceptors"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
@@ -1069,6 +1070,86 @@ func TestInterceptedTransaction_CheckValiditySignedWithHashShoudWork(t *testing.
 	assert.Nil(t, err)
 }
 
+func createInterceptedGuardedTx(guardianSignature []byte) (*transaction.InterceptedTransaction, *[][]byte) {
+	minTxVersion := uint32(1)
+	chainID := []byte("chain")
+	guardianAddress := []byte("34567890123456789012345678901234")
+	tx := &dataTransaction.Transaction{
+		Nonce:     1,
+		Value:     big.NewInt(2),
+		Data:      []byte("data"),
+		GasLimit:  3,
+		GasPrice:  4,
+		RcvAddr:   recvAddress,
+		SndAddr:   senderAddress,
+		Signature: guardian.CreateGuardedSignature(sigOk, guardianAddress, guardianSignature),
+		ChainID:   chainID,
+		Version:   minTxVersion + 1,
+		Options:   guardian.MaskGuardedTransaction,
+	}
+
+	verifiedSignatures := make([][]byte, 0)
+	signer := &mock.SignerMock{
+		VerifyStub: func(public crypto.PublicKey, msg []byte, sig []byte) error {
+			verifiedSignatures = append(verifiedSignatures, sig)
+			if !bytes.Equal(sig, sigOk) {
+				return errSignerMockVerifySigFails
+			}
+			return nil
+		},
+	}
+
+	marshalizer := &mock.MarshalizerMock{}
+	txBuff, _ := marshalizer.Marshal(tx)
+	shardCoordinator := mock.NewMultipleShardsCoordinatorMock()
+	shardCoordinator.CurrentShard = 6
+
+	txi, _ := transaction.NewInterceptedTransaction(
+		txBuff,
+		marshalizer,
+		marshalizer,
+		mock.HasherMock{},
+		createKeyGenMock(),
+		signer,
+		&mock.PubkeyConverterStub{
+			LenCalled: func() int {
+				return 32
+			},
+		},
+		shardCoordinator,
+		createFreeTxFeeHandler(),
+		&testscommon.WhiteListHandlerStub{},
+		&mock.ArgumentParserMock{},
+		chainID,
+		true,
+		mock.HasherMock{},
+		versioning.NewTxVersionChecker(minTxVersion),
+	)
+
+	return txi, &verifiedSignatures
+}
+
+func TestInterceptedTransaction_CheckValidityGuardedTxShouldVerifyBothSignatures(t *testing.T) {
+	t.Parallel()
+
+	txi, verifiedSignatures := createInterceptedGuardedTx(sigOk)
+
+	err := txi.CheckValidity()
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{sigOk, sigOk}, *verifiedSignatures)
+}
+
+func TestInterceptedTransaction_CheckValidityGuardedTxWrongGuardianSignatureShouldErr(t *testing.T) {
+	t.Parallel()
+
+	guardianSignature := []byte("signatur3")
+	txi, verifiedSignatures := createInterceptedGuardedTx(guardianSignature)
+
+	err := txi.CheckValidity()
+	assert.Equal(t, errSignerMockVerifySigFails, err)
+	assert.Equal(t, [][]byte{sigOk, guardianSignature}, *verifiedSignatures)
+}
+
 func TestInterceptedTransaction_OkValsGettersShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"sync"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/atomic"
@@ -17,6 +18,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/hashing"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	"github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common/governance"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/state"
@@ -44,13 +46,19 @@ type txProcessor struct {
 	argsParser                     process.ArgumentsParser
 	scrForwarder                   process.IntermediateTransactionHandler
 	signMarshalizer                marshal.Marshalizer
+	guardedAccounts                process.GuardedAccountHandler
 	flagRelayedTx                  atomic.Flag
 	flagRelayedTxV2                atomic.Flag
 	flagMetaProtection             atomic.Flag
+	flagGuardians                  atomic.Flag
 	relayedTxEnableEpoch           uint32
 	relayedTxV2EnableEpoch         uint32
 	penalizedTooMuchGasEnableEpoch uint32
 	metaProtectionEnableEpoch      uint32
+	guardiansEnableEpoch           uint32
+
+	staticPenalizedTooMuchGasEnableEpoch uint32
+	mutEnableEpochs                      sync.RWMutex
 }
 
 // ArgsNewTxProcessor defines the arguments needed for new tx processor
@@ -69,10 +77,12 @@ type ArgsNewTxProcessor struct {
 	BadTxForwarder                 process.IntermediateTransactionHandler
 	ArgsParser                     process.ArgumentsParser
 	ScrForwarder                   process.IntermediateTransactionHandler
+	GuardedAccountHandler          process.GuardedAccountHandler
 	RelayedTxEnableEpoch           uint32
 	RelayedTxV2EnableEpoch         uint32
 	PenalizedTooMuchGasEnableEpoch uint32
 	MetaProtectionEnableEpoch      uint32
+	GuardiansEnableEpoch           uint32
 	EpochNotifier                  process.EpochNotifier
 }
 
@@ -123,6 +133,9 @@ func NewTxProcessor(args ArgsNewTxProcessor) (*txProcessor, error) {
 	if check.IfNil(args.EpochNotifier) {
 		return nil, process.ErrNilEpochNotifier
 	}
+	if check.IfNil(args.GuardedAccountHandler) {
+		return nil, process.ErrNilGuardedAccountHandler
+	}
 
 	baseTxProcess := &baseTxProcessor{
 		accounts:         args.Accounts,
@@ -143,16 +156,21 @@ func NewTxProcessor(args ArgsNewTxProcessor) (*txProcessor, error) {
 		argsParser:                     args.ArgsParser,
 		scrForwarder:                   args.ScrForwarder,
 		signMarshalizer:                args.SignMarshalizer,
+		guardedAccounts:                args.GuardedAccountHandler,
 		relayedTxEnableEpoch:           args.RelayedTxEnableEpoch,
 		relayedTxV2EnableEpoch:         args.RelayedTxV2EnableEpoch,
 		penalizedTooMuchGasEnableEpoch: args.PenalizedTooMuchGasEnableEpoch,
 		metaProtectionEnableEpoch:      args.MetaProtectionEnableEpoch,
+		guardiansEnableEpoch:           args.GuardiansEnableEpoch,
+
+		staticPenalizedTooMuchGasEnableEpoch: args.PenalizedTooMuchGasEnableEpoch,
 	}
 
 	log.Debug("shardProcess: enable epoch for relayed transactions", "epoch", txProc.relayedTxEnableEpoch)
 	log.Debug("shardProcess: enable epoch for penalized too much gas", "epoch", txProc.penalizedTooMuchGasEnableEpoch)
 	log.Debug("shardProcess: enable epoch for meta protection", "epoch", txProc.metaProtectionEnableEpoch)
 	log.Debug("shardTxProcessor: enable epoch for relayed transactions v2", "epoch", txProc.relayedTxV2EnableEpoch)
+	log.Debug("shardTxProcessor: enable epoch for guardians", "epoch", txProc.guardiansEnableEpoch)
 	args.EpochNotifier.RegisterNotifyHandler(txProc)
 
 	return txProc, nil
@@ -203,6 +221,11 @@ func (txProc *txProcessor) ProcessTransaction(tx *transaction.Transaction) (vmco
 		return vmcommon.UserError, err
 	}
 
+	err = txProc.checkGuardedAccount(tx, acntSnd)
+	if err != nil {
+		return vmcommon.UserError, err
+	}
+
 	switch txType {
 	case process.MoveBalance:
 		err = txProc.processMoveBalance(tx, acntSnd, acntDst, dstShardTxType, false)
@@ -740,6 +763,9 @@ func (txProc *txProcessor) processUserTx(
 	relayerAdr := originalTx.SndAddr
 	txType, dstShardTxType := txProc.txTypeHandler.ComputeTransactionType(userTx)
 	err = txProc.checkTxValues(userTx, acntSnd, acntDst, true)
+	if err == nil {
+		err = txProc.checkGuardedAccount(userTx, acntSnd)
+	}
 	if err != nil {
 		errRemove := txProc.removeValueAndConsumedFeeFromUser(userTx, relayedTxValue)
 		if errRemove != nil {
@@ -938,6 +964,32 @@ func (txProc *txProcessor) executeFailedRelayedUserTx(
 	return nil
 }
 
+// checkGuardedAccount verifies that the transaction of a guarded sender is co-signed by its active guardian
+func (txProc *txProcessor) checkGuardedAccount(tx *transaction.Transaction, acntSnd state.UserAccountHandler) error {
+	if !txProc.flagGuardians.IsSet() || check.IfNil(acntSnd) {
+		return nil
+	}
+
+	userAccount, ok := acntSnd.(vmcommon.UserAccountHandler)
+	if !ok {
+		return process.ErrWrongTypeAssertion
+	}
+
+	return txProc.guardedAccounts.CheckGuardedTransaction(userAccount, tx)
+}
+
+// GovernedConfigChanged applies the penalized too much gas enable epoch changed through governance, so the gas is
+// penalized starting with the same epoch as the one the economics data computes the fees with
+func (txProc *txProcessor) GovernedConfigChanged(governedConfig *governance.GovernedConfig) {
+	penalizedTooMuchGasEnableEpoch := governedConfig.EnableEpoch(governance.PenalizedTooMuchGasEnableEpoch, txProc.staticPenalizedTooMuchGasEnableEpoch)
+
+	txProc.mutEnableEpochs.Lock()
+	txProc.penalizedTooMuchGasEnableEpoch = penalizedTooMuchGasEnableEpoch
+	txProc.mutEnableEpochs.Unlock()
+
+	log.Debug("txProcessor: governed config changed", "penalized too much gas enable epoch", penalizedTooMuchGasEnableEpoch)
+}
+
 // EpochConfirmed is called whenever a new epoch is confirmed
 func (txProc *txProcessor) EpochConfirmed(epoch uint32, _ uint64) {
 	txProc.flagRelayedTx.Toggle(epoch >= txProc.relayedTxEnableEpoch)
@@ -946,11 +998,18 @@ func (txProc *txProcessor) EpochConfirmed(epoch uint32, _ uint64) {
 	txProc.flagRelayedTxV2.Toggle(epoch >= txProc.relayedTxV2EnableEpoch)
 	log.Debug("txProcessor: relayed transactions v2", "enabled", txProc.flagRelayedTxV2.IsSet())
 
-	txProc.flagPenalizedTooMuchGas.Toggle(epoch >= txProc.penalizedTooMuchGasEnableEpoch)
+	txProc.mutEnableEpochs.RLock()
+	penalizedTooMuchGasEnableEpoch := txProc.penalizedTooMuchGasEnableEpoch
+	txProc.mutEnableEpochs.RUnlock()
+
+	txProc.flagPenalizedTooMuchGas.Toggle(epoch >= penalizedTooMuchGasEnableEpoch)
 	log.Debug("txProcessor: penalized too much gas", "enabled", txProc.flagPenalizedTooMuchGas.IsSet())
 
 	txProc.flagMetaProtection.Toggle(epoch >= txProc.metaProtectionEnableEpoch)
 	log.Debug("txProcessor: meta protection", "enabled", txProc.flagMetaProtection.IsSet())
+
+	txProc.flagGuardians.Toggle(epoch >= txProc.guardiansEnableEpoch)
+	log.Debug("txProcessor: guardians", "enabled", txProc.flagGuardians.IsSet())
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
@@ -71,21 +71,22 @@ func createAccountStub(sndAddr, rcvAddr []byte,
 
 func createArgsForTxProcessor() txproc.ArgsNewTxProcessor {
 	args := txproc.ArgsNewTxProcessor{
-		Accounts:         &stateMock.AccountsStub{},
-		Hasher:           mock.HasherMock{},
-		PubkeyConv:       createMockPubkeyConverter(),
-		Marshalizer:      &mock.MarshalizerMock{},
-		SignMarshalizer:  &mock.MarshalizerMock{},
-		ShardCoordinator: mock.NewOneShardCoordinatorMock(),
-		ScProcessor:      &testscommon.SCProcessorMock{},
-		TxFeeHandler:     &mock.FeeAccumulatorStub{},
-		TxTypeHandler:    &testscommon.TxTypeHandlerMock{},
-		EconomicsFee:     feeHandlerMock(),
-		ReceiptForwarder: &mock.IntermediateTransactionHandlerMock{},
-		BadTxForwarder:   &mock.IntermediateTransactionHandlerMock{},
-		ArgsParser:       &mock.ArgumentParserMock{},
-		ScrForwarder:     &mock.IntermediateTransactionHandlerMock{},
-		EpochNotifier:    &mock.EpochNotifierStub{},
+		Accounts:              &stateMock.AccountsStub{},
+		Hasher:                mock.HasherMock{},
+		PubkeyConv:            createMockPubkeyConverter(),
+		Marshalizer:           &mock.MarshalizerMock{},
+		SignMarshalizer:       &mock.MarshalizerMock{},
+		ShardCoordinator:      mock.NewOneShardCoordinatorMock(),
+		ScProcessor:           &testscommon.SCProcessorMock{},
+		TxFeeHandler:          &mock.FeeAccumulatorStub{},
+		TxTypeHandler:         &testscommon.TxTypeHandlerMock{},
+		EconomicsFee:          feeHandlerMock(),
+		ReceiptForwarder:      &mock.IntermediateTransactionHandlerMock{},
+		BadTxForwarder:        &mock.IntermediateTransactionHandlerMock{},
+		ArgsParser:            &mock.ArgumentParserMock{},
+		ScrForwarder:          &mock.IntermediateTransactionHandlerMock{},
+		GuardedAccountHandler: &testscommon.GuardedAccountHandlerStub{},
+		EpochNotifier:         &mock.EpochNotifierStub{},
 	}
 	return args
 }
@@ -262,6 +263,17 @@ func TestNewTxProcessor_NilEpochNotifierShouldErr(t *testing.T) {
 	assert.Nil(t, txProc)
 }
 
+func TestNewTxProcessor_NilGuardedAccountHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsForTxProcessor()
+	args.GuardedAccountHandler = nil
+	txProc, err := txproc.NewTxProcessor(args)
+
+	assert.Equal(t, process.ErrNilGuardedAccountHandler, err)
+	assert.Nil(t, txProc)
+}
+
 func TestNewTxProcessor_OkValsShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -572,6 +584,31 @@ func TestTxProcessor_CheckTxValuesOkValsShouldErr(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestTxProcessor_CheckTxValuesBelowDynamicMinGasPriceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	acnt1, err := state.NewUserAccount([]byte{65})
+	assert.Nil(t, err)
+	acnt1.Balance = big.NewInt(67)
+
+	args := createArgsForTxProcessor()
+	feeHandler := feeHandlerMock()
+	feeHandler.DynamicMinGasPriceCalled = func() uint64 {
+		return 10
+	}
+	args.EconomicsFee = feeHandler
+	execTx, _ := txproc.NewTxProcessor(args)
+
+	err = execTx.CheckTxValues(&transaction.Transaction{Value: big.NewInt(0), GasPrice: 9}, acnt1, nil, false)
+	assert.Equal(t, process.ErrInsufficientGasPriceInTx, err)
+
+	err = execTx.CheckTxValues(&transaction.Transaction{Value: big.NewInt(0), GasPrice: 10}, acnt1, nil, false)
+	assert.Nil(t, err)
+
+	err = execTx.CheckTxValues(&transaction.Transaction{Value: big.NewInt(0), GasPrice: 9}, nil, acnt1, false)
+	assert.Nil(t, err)
+}
+
 //------- increaseNonce
 
 func TestTxProcessor_IncreaseNonceOkValsShouldWork(t *testing.T) {
@@ -992,6 +1029,80 @@ func TestTxProcessor_ProcessOkValsShouldWork(t *testing.T) {
 	assert.Equal(t, 2, saveAccountCalled)
 }
 
+func TestTxProcessor_ProcessTransactionGuardedAccountCheckFailsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tx := transaction.Transaction{}
+	tx.Nonce = 4
+	tx.SndAddr = []byte("SRC")
+	tx.RcvAddr = []byte("DST")
+	tx.Value = big.NewInt(61)
+
+	acntSrc, err := state.NewUserAccount(tx.SndAddr)
+	assert.Nil(t, err)
+	acntDst, err := state.NewUserAccount(tx.RcvAddr)
+	assert.Nil(t, err)
+
+	acntSrc.Nonce = 4
+	acntSrc.Balance = big.NewInt(90)
+	acntDst.Balance = big.NewInt(10)
+
+	checkGuardedTransactionCalled := false
+	args := createArgsForTxProcessor()
+	args.Accounts = createAccountStub(tx.SndAddr, tx.RcvAddr, acntSrc, acntDst)
+	args.GuardedAccountHandler = &testscommon.GuardedAccountHandlerStub{
+		CheckGuardedTransactionCalled: func(uah vmcommon.UserAccountHandler, providedTx *transaction.Transaction) error {
+			checkGuardedTransactionCalled = true
+			assert.Equal(t, &tx, providedTx)
+			return process.ErrTransactionNotGuarded
+		},
+	}
+	execTx, _ := txproc.NewTxProcessor(args)
+
+	returnCode, err := execTx.ProcessTransaction(&tx)
+	assert.Equal(t, process.ErrTransactionNotGuarded, err)
+	assert.Equal(t, vmcommon.UserError, returnCode)
+	assert.True(t, checkGuardedTransactionCalled)
+	assert.Equal(t, uint64(4), acntSrc.Nonce)
+	assert.Equal(t, big.NewInt(90), acntSrc.Balance)
+	assert.Equal(t, big.NewInt(10), acntDst.Balance)
+}
+
+func TestTxProcessor_ProcessTransactionGuardiansNotEnabledShouldNotCheckGuardedAccount(t *testing.T) {
+	t.Parallel()
+
+	tx := transaction.Transaction{}
+	tx.Nonce = 4
+	tx.SndAddr = []byte("SRC")
+	tx.RcvAddr = []byte("DST")
+	tx.Value = big.NewInt(61)
+
+	acntSrc, err := state.NewUserAccount(tx.SndAddr)
+	assert.Nil(t, err)
+	acntDst, err := state.NewUserAccount(tx.RcvAddr)
+	assert.Nil(t, err)
+
+	acntSrc.Nonce = 4
+	acntSrc.Balance = big.NewInt(90)
+	acntDst.Balance = big.NewInt(10)
+
+	args := createArgsForTxProcessor()
+	args.GuardiansEnableEpoch = 1
+	args.Accounts = createAccountStub(tx.SndAddr, tx.RcvAddr, acntSrc, acntDst)
+	args.GuardedAccountHandler = &testscommon.GuardedAccountHandlerStub{
+		CheckGuardedTransactionCalled: func(uah vmcommon.UserAccountHandler, providedTx *transaction.Transaction) error {
+			assert.Fail(t, "should not have been called")
+			return nil
+		},
+	}
+	execTx, _ := txproc.NewTxProcessor(args)
+
+	_, err = execTx.ProcessTransaction(&tx)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(5), acntSrc.Nonce)
+	assert.Equal(t, big.NewInt(71), acntDst.Balance)
+}
+
 func TestTxProcessor_MoveBalanceWithFeesShouldWork(t *testing.T) {
 	saveAccountCalled := 0
 
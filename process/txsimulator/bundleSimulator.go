@@ -0,0 +1,275 @@
+package txsimulator
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+// ArgsBundleSimulator holds the arguments required for creating a new bundle simulator
+type ArgsBundleSimulator struct {
+	ShardSimulators      []ShardSimulator
+	ShardCoordinator     sharding.Coordinator
+	MaxBundleSize        uint32
+	MaxContinuationSteps uint32
+}
+
+type pendingScr struct {
+	hash string
+	scr  *smartContractResult.SmartContractResult
+}
+
+type bundleSimulator struct {
+	mutSimulation        sync.Mutex
+	shardSimulators      map[uint32]ShardSimulator
+	shardCoordinator     sharding.Coordinator
+	maxBundleSize        uint32
+	maxContinuationSteps uint32
+}
+
+// NewBundleSimulator returns a new instance of a bundleSimulator
+func NewBundleSimulator(args ArgsBundleSimulator) (*bundleSimulator, error) {
+	if len(args.ShardSimulators) == 0 {
+		return nil, ErrNoShardSimulator
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, ErrNilShardCoordinator
+	}
+	if args.MaxBundleSize == 0 {
+		return nil, ErrInvalidMaxBundleSize
+	}
+	if args.MaxContinuationSteps == 0 {
+		return nil, ErrInvalidMaxContinuationSteps
+	}
+
+	shardSimulators := make(map[uint32]ShardSimulator, len(args.ShardSimulators))
+	for _, shardSimulator := range args.ShardSimulators {
+		if check.IfNil(shardSimulator) {
+			return nil, ErrNilShardSimulator
+		}
+
+		shardID := shardSimulator.SelfShardID()
+		_, exists := shardSimulators[shardID]
+		if exists {
+			return nil, fmt.Errorf("%w for shard %d", ErrDuplicatedShardSimulator, shardID)
+		}
+		shardSimulators[shardID] = shardSimulator
+	}
+
+	return &bundleSimulator{
+		shardSimulators:      shardSimulators,
+		shardCoordinator:     args.ShardCoordinator,
+		maxBundleSize:        args.MaxBundleSize,
+		maxContinuationSteps: args.MaxContinuationSteps,
+	}, nil
+}
+
+// ProcessBundle will process, in order and on the same ephemeral state, the transactions of the bundle. The smart
+// contract results generated for other shards are followed on the shards the simulator has state for, the ones which
+// can not be followed being reported as pending
+func (bs *bundleSimulator) ProcessBundle(txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error) {
+	if len(txs) == 0 {
+		return nil, ErrEmptyBundle
+	}
+	if len(txs) > int(bs.maxBundleSize) {
+		return nil, fmt.Errorf("%w: %d transactions provided, maximum %d allowed", ErrBundleTooLarge, len(txs), bs.maxBundleSize)
+	}
+
+	bs.mutSimulation.Lock()
+	defer bs.mutSimulation.Unlock()
+
+	for _, shardSimulator := range bs.shardSimulators {
+		shardSimulator.StartBundle()
+	}
+	defer func() {
+		for _, shardSimulator := range bs.shardSimulators {
+			shardSimulator.EndBundle()
+		}
+	}()
+
+	results := &txSimData.BundleSimulationResults{
+		Transactions: make([]*txSimData.SimulationResults, 0, len(txs)),
+	}
+	for _, tx := range txs {
+		txResults, err := bs.simulateTransaction(tx)
+		if err != nil {
+			return nil, err
+		}
+
+		results.Transactions = append(results.Transactions, txResults)
+	}
+
+	accountsChanges := make(map[string]*txSimData.ApiAccountChanges)
+	for _, shardSimulator := range bs.shardSimulators {
+		shardAccountsChanges, err := shardSimulator.GetBundleAccountsChanges()
+		if err != nil {
+			return nil, err
+		}
+
+		for address, accountChanges := range shardAccountsChanges {
+			accountsChanges[address] = accountChanges
+		}
+	}
+	results.AccountsChanges = accountsChanges
+
+	return results, nil
+}
+
+func (bs *bundleSimulator) simulateTransaction(tx *transaction.Transaction) (*txSimData.SimulationResults, error) {
+	shardID := bs.shardCoordinator.ComputeId(tx.SndAddr)
+	shardSimulator, ok := bs.shardSimulators[shardID]
+	if !ok {
+		return nil, fmt.Errorf("%w for sender shard %d", ErrMissingShardSimulator, shardID)
+	}
+
+	txResults, crossShardScrs, err := shardSimulator.SimulateBundleTransaction(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	initializeResultsMaps(txResults)
+	isPending := false
+	receiverShardID := bs.shardCoordinator.ComputeId(tx.RcvAddr)
+	if receiverShardID != shardID && txResults.Status != transaction.TxStatusFail {
+		receiverSimulator, found := bs.shardSimulators[receiverShardID]
+		if !found {
+			isPending = true
+		} else {
+			destinationResults, destinationScrs, errDestination := receiverSimulator.SimulateBundleTransaction(tx)
+			if errDestination != nil {
+				return nil, errDestination
+			}
+
+			if txResults.VMOutput == nil {
+				txResults.VMOutput = destinationResults.VMOutput
+			}
+			mergeStepResults(txResults, destinationResults, fmt.Sprintf("execution on shard %d was not successful", receiverShardID))
+			crossShardScrs = mergeScrs(crossShardScrs, destinationScrs)
+		}
+	}
+
+	queue := sortPendingScrs(crossShardScrs)
+	numSteps := uint32(0)
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		destinationSimulator, found := bs.shardSimulators[bs.shardCoordinator.ComputeId(current.scr.RcvAddr)]
+		if !found || numSteps >= bs.maxContinuationSteps {
+			addPendingScr(txResults, current.hash)
+			continue
+		}
+		numSteps++
+
+		stepResults, generatedScrs, errStep := destinationSimulator.SimulateBundleSmartContractResult(current.scr)
+		if errStep != nil {
+			return nil, errStep
+		}
+
+		defaultFailReason := fmt.Sprintf("smart contract result %s was not executed successfully", hex.EncodeToString([]byte(current.hash)))
+		mergeStepResults(txResults, stepResults, defaultFailReason)
+		queue = append(queue, sortPendingScrs(generatedScrs)...)
+	}
+
+	isPending = isPending || len(txResults.PendingScResults) > 0
+	if isPending && txResults.Status != transaction.TxStatusFail {
+		txResults.Status = transaction.TxStatusPending
+	}
+
+	return txResults, nil
+}
+
+func mergeScrs(
+	first map[string]*smartContractResult.SmartContractResult,
+	second map[string]*smartContractResult.SmartContractResult,
+) map[string]*smartContractResult.SmartContractResult {
+	merged := make(map[string]*smartContractResult.SmartContractResult, len(first)+len(second))
+	for hash, scr := range first {
+		merged[hash] = scr
+	}
+	for hash, scr := range second {
+		merged[hash] = scr
+	}
+
+	return merged
+}
+
+func addPendingScr(txResults *txSimData.SimulationResults, hash string) {
+	hexHash := hex.EncodeToString([]byte(hash))
+	if txResults.PendingScResults == nil {
+		txResults.PendingScResults = make(map[string]*transaction.ApiSmartContractResult)
+	}
+	txResults.PendingScResults[hexHash] = txResults.ScResults[hexHash]
+}
+
+func initializeResultsMaps(results *txSimData.SimulationResults) {
+	if results.ScResults == nil {
+		results.ScResults = make(map[string]*transaction.ApiSmartContractResult)
+	}
+	if results.Receipts == nil {
+		results.Receipts = make(map[string]*transaction.ApiReceipt)
+	}
+	if results.Logs == nil {
+		results.Logs = make(map[string]*transaction.ApiLogs)
+	}
+}
+
+// mergeStepResults adds the results of a followed execution step to the results of the transaction. The first step
+// which does not execute successfully marks the transaction as failed
+func mergeStepResults(txResults *txSimData.SimulationResults, stepResults *txSimData.SimulationResults, defaultFailReason string) {
+	for key, scr := range stepResults.ScResults {
+		txResults.ScResults[key] = scr
+	}
+	for key, rcpt := range stepResults.Receipts {
+		txResults.Receipts[key] = rcpt
+	}
+	for key, txLog := range stepResults.Logs {
+		txResults.Logs[key] = txLog
+	}
+
+	if stepResults.Status == transaction.TxStatusSuccess || txResults.Status == transaction.TxStatusFail {
+		return
+	}
+
+	txResults.Status = transaction.TxStatusFail
+	txResults.FailReason = getStepFailReason(stepResults, defaultFailReason)
+}
+
+func getStepFailReason(stepResults *txSimData.SimulationResults, defaultFailReason string) string {
+	if len(stepResults.FailReason) > 0 {
+		return stepResults.FailReason
+	}
+	if stepResults.VMOutput != nil {
+		return fmt.Sprintf("%s: %s", stepResults.VMOutput.ReturnCode.String(), stepResults.VMOutput.ReturnMessage)
+	}
+
+	return defaultFailReason
+}
+
+func sortPendingScrs(scrs map[string]*smartContractResult.SmartContractResult) []*pendingScr {
+	sorted := make([]*pendingScr, 0, len(scrs))
+	for hash, scr := range scrs {
+		sorted = append(sorted, &pendingScr{
+			hash: hash,
+			scr:  scr,
+		})
+	}
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].hash < sorted[j].hash
+	})
+
+	return sorted
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bs *bundleSimulator) IsInterfaceNil() bool {
+	return bs == nil
+}
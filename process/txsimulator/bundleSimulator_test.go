@@ -0,0 +1,370 @@
+package txsimulator
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBundleSimulator(t *testing.T) {
+	tests := []struct {
+		name     string
+		argsFunc func() ArgsBundleSimulator
+		exError  error
+	}{
+		{
+			name: "NoShardSimulator",
+			argsFunc: func() ArgsBundleSimulator {
+				args := getBundleSimulatorArgs()
+				args.ShardSimulators = nil
+				return args
+			},
+			exError: ErrNoShardSimulator,
+		},
+		{
+			name: "NilShardSimulator",
+			argsFunc: func() ArgsBundleSimulator {
+				args := getBundleSimulatorArgs()
+				args.ShardSimulators = append(args.ShardSimulators, nil)
+				return args
+			},
+			exError: ErrNilShardSimulator,
+		},
+		{
+			name: "DuplicatedShardSimulator",
+			argsFunc: func() ArgsBundleSimulator {
+				args := getBundleSimulatorArgs()
+				args.ShardSimulators = append(args.ShardSimulators, createShardSimulatorStub(0))
+				return args
+			},
+			exError: ErrDuplicatedShardSimulator,
+		},
+		{
+			name: "NilShardCoordinator",
+			argsFunc: func() ArgsBundleSimulator {
+				args := getBundleSimulatorArgs()
+				args.ShardCoordinator = nil
+				return args
+			},
+			exError: ErrNilShardCoordinator,
+		},
+		{
+			name: "InvalidMaxBundleSize",
+			argsFunc: func() ArgsBundleSimulator {
+				args := getBundleSimulatorArgs()
+				args.MaxBundleSize = 0
+				return args
+			},
+			exError: ErrInvalidMaxBundleSize,
+		},
+		{
+			name: "InvalidMaxContinuationSteps",
+			argsFunc: func() ArgsBundleSimulator {
+				args := getBundleSimulatorArgs()
+				args.MaxContinuationSteps = 0
+				return args
+			},
+			exError: ErrInvalidMaxContinuationSteps,
+		},
+		{
+			name: "Ok",
+			argsFunc: func() ArgsBundleSimulator {
+				return getBundleSimulatorArgs()
+			},
+			exError: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bs, err := NewBundleSimulator(tt.argsFunc())
+			require.True(t, errors.Is(err, tt.exError))
+			require.Equal(t, tt.exError == nil, !check.IfNil(bs))
+		})
+	}
+}
+
+func TestBundleSimulator_ProcessBundleInvalidBundleShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := getBundleSimulatorArgs()
+	args.MaxBundleSize = 1
+	bs, _ := NewBundleSimulator(args)
+
+	results, err := bs.ProcessBundle(nil)
+	require.Nil(t, results)
+	require.Equal(t, ErrEmptyBundle, err)
+
+	results, err = bs.ProcessBundle([]*transaction.Transaction{{Nonce: 1}, {Nonce: 2}})
+	require.Nil(t, results)
+	require.True(t, errors.Is(err, ErrBundleTooLarge))
+}
+
+func TestBundleSimulator_ProcessBundleMissingSenderShardShouldErr(t *testing.T) {
+	t.Parallel()
+
+	numEndCalls := 0
+	shardSimulator := createShardSimulatorStub(0)
+	shardSimulator.EndBundleCalled = func() {
+		numEndCalls++
+	}
+	args := getBundleSimulatorArgs()
+	args.ShardSimulators = []ShardSimulator{shardSimulator}
+	bs, _ := NewBundleSimulator(args)
+
+	results, err := bs.ProcessBundle([]*transaction.Transaction{{SndAddr: []byte("1-sender")}})
+	require.Nil(t, results)
+	require.True(t, errors.Is(err, ErrMissingShardSimulator))
+	require.Equal(t, 1, numEndCalls)
+}
+
+func TestBundleSimulator_ProcessBundleShouldFollowTheCrossShardScrs(t *testing.T) {
+	t.Parallel()
+
+	asyncCall := &smartContractResult.SmartContractResult{RcvAddr: []byte("1-contract"), Data: []byte("asyncCall")}
+	callBack := &smartContractResult.SmartContractResult{RcvAddr: []byte("0-sender"), Data: []byte("callBack")}
+
+	calls := make([]string, 0)
+	shardSimulator0 := createShardSimulatorStub(0)
+	shardSimulator0.StartBundleCalled = func() {
+		calls = append(calls, "start 0")
+	}
+	shardSimulator0.SimulateBundleTransactionCalled = func(tx *transaction.Transaction) (*txSimData.SimulationResults, map[string]*smartContractResult.SmartContractResult, error) {
+		calls = append(calls, "tx "+string(tx.Data))
+		if string(tx.Data) == "second" {
+			return &txSimData.SimulationResults{Status: transaction.TxStatusSuccess}, nil, nil
+		}
+
+		return &txSimData.SimulationResults{
+			Status:    transaction.TxStatusSuccess,
+			ScResults: map[string]*transaction.ApiSmartContractResult{hex.EncodeToString([]byte("asyncHash")): {Data: "asyncCall"}},
+		}, map[string]*smartContractResult.SmartContractResult{"asyncHash": asyncCall}, nil
+	}
+	shardSimulator0.SimulateBundleSmartContractResultCalled = func(scr *smartContractResult.SmartContractResult) (*txSimData.SimulationResults, map[string]*smartContractResult.SmartContractResult, error) {
+		calls = append(calls, "scr 0 "+string(scr.Data))
+		return &txSimData.SimulationResults{Status: transaction.TxStatusSuccess}, nil, nil
+	}
+	shardSimulator0.GetBundleAccountsChangesCalled = func() (map[string]*txSimData.ApiAccountChanges, error) {
+		return map[string]*txSimData.ApiAccountChanges{"sender": {Address: "sender", NonceDelta: 2}}, nil
+	}
+	shardSimulator0.EndBundleCalled = func() {
+		calls = append(calls, "end 0")
+	}
+
+	shardSimulator1 := createShardSimulatorStub(1)
+	shardSimulator1.SimulateBundleSmartContractResultCalled = func(scr *smartContractResult.SmartContractResult) (*txSimData.SimulationResults, map[string]*smartContractResult.SmartContractResult, error) {
+		calls = append(calls, "scr 1 "+string(scr.Data))
+		return &txSimData.SimulationResults{
+			Status:    transaction.TxStatusSuccess,
+			ScResults: map[string]*transaction.ApiSmartContractResult{hex.EncodeToString([]byte("callBackHash")): {Data: "callBack"}},
+			Logs:      map[string]*transaction.ApiLogs{hex.EncodeToString([]byte("asyncHash")): {Address: "contract"}},
+		}, map[string]*smartContractResult.SmartContractResult{"callBackHash": callBack}, nil
+	}
+	shardSimulator1.GetBundleAccountsChangesCalled = func() (map[string]*txSimData.ApiAccountChanges, error) {
+		return map[string]*txSimData.ApiAccountChanges{"contract": {Address: "contract"}}, nil
+	}
+
+	args := getBundleSimulatorArgs()
+	args.ShardSimulators = []ShardSimulator{shardSimulator0, shardSimulator1}
+	bs, _ := NewBundleSimulator(args)
+
+	txs := []*transaction.Transaction{
+		{SndAddr: []byte("0-sender"), Data: []byte("first")},
+		{SndAddr: []byte("0-sender"), Data: []byte("second")},
+	}
+	results, err := bs.ProcessBundle(txs)
+	require.NoError(t, err)
+
+	expectedCalls := []string{"start 0", "tx first", "scr 1 asyncCall", "scr 0 callBack", "tx second", "end 0"}
+	require.Equal(t, expectedCalls, calls)
+
+	require.Equal(t, 2, len(results.Transactions))
+	firstResults := results.Transactions[0]
+	require.Equal(t, transaction.TxStatusSuccess, firstResults.Status)
+	require.Equal(t, 2, len(firstResults.ScResults))
+	require.Equal(t, 1, len(firstResults.Logs))
+	require.Equal(t, 0, len(firstResults.PendingScResults))
+	require.Equal(t, transaction.TxStatusSuccess, results.Transactions[1].Status)
+	require.Equal(t, 2, len(results.AccountsChanges))
+	require.Equal(t, uint64(2), results.AccountsChanges["sender"].NonceDelta)
+}
+
+func TestBundleSimulator_ProcessBundleShouldReportTheScrsWhichCanNotBeFollowed(t *testing.T) {
+	t.Parallel()
+
+	crossShardScr := &smartContractResult.SmartContractResult{RcvAddr: []byte("1-contract")}
+	shardSimulator := createShardSimulatorStub(0)
+	shardSimulator.SimulateBundleTransactionCalled = func(_ *transaction.Transaction) (*txSimData.SimulationResults, map[string]*smartContractResult.SmartContractResult, error) {
+		return &txSimData.SimulationResults{
+			Status:    transaction.TxStatusSuccess,
+			ScResults: map[string]*transaction.ApiSmartContractResult{hex.EncodeToString([]byte("scrHash")): {Data: "call"}},
+		}, map[string]*smartContractResult.SmartContractResult{"scrHash": crossShardScr}, nil
+	}
+	args := getBundleSimulatorArgs()
+	args.ShardSimulators = []ShardSimulator{shardSimulator}
+	bs, _ := NewBundleSimulator(args)
+
+	results, err := bs.ProcessBundle([]*transaction.Transaction{{SndAddr: []byte("0-sender")}})
+	require.NoError(t, err)
+	require.Equal(t, transaction.TxStatusPending, results.Transactions[0].Status)
+	expectedPending := map[string]*transaction.ApiSmartContractResult{hex.EncodeToString([]byte("scrHash")): {Data: "call"}}
+	require.Equal(t, expectedPending, results.Transactions[0].PendingScResults)
+}
+
+func TestBundleSimulator_ProcessBundleCrossShardTransactionShouldExecuteOnTheReceiverShard(t *testing.T) {
+	t.Parallel()
+
+	calls := make([]string, 0)
+	shardSimulator0 := createShardSimulatorStub(0)
+	shardSimulator0.SimulateBundleTransactionCalled = func(_ *transaction.Transaction) (*txSimData.SimulationResults, map[string]*smartContractResult.SmartContractResult, error) {
+		calls = append(calls, "tx 0")
+		return &txSimData.SimulationResults{Status: transaction.TxStatusSuccess}, nil, nil
+	}
+	shardSimulator0.SimulateBundleSmartContractResultCalled = func(scr *smartContractResult.SmartContractResult) (*txSimData.SimulationResults, map[string]*smartContractResult.SmartContractResult, error) {
+		calls = append(calls, "scr 0 "+string(scr.Data))
+		return &txSimData.SimulationResults{Status: transaction.TxStatusSuccess}, nil, nil
+	}
+	shardSimulator1 := createShardSimulatorStub(1)
+	shardSimulator1.SimulateBundleTransactionCalled = func(_ *transaction.Transaction) (*txSimData.SimulationResults, map[string]*smartContractResult.SmartContractResult, error) {
+		calls = append(calls, "tx 1")
+		return &txSimData.SimulationResults{
+			Status:   transaction.TxStatusSuccess,
+			VMOutput: &vmcommon.VMOutput{ReturnCode: vmcommon.Ok},
+		}, map[string]*smartContractResult.SmartContractResult{
+			"refundHash": {RcvAddr: []byte("0-sender"), Data: []byte("refund")},
+		}, nil
+	}
+	args := getBundleSimulatorArgs()
+	args.ShardSimulators = []ShardSimulator{shardSimulator0, shardSimulator1}
+	bs, _ := NewBundleSimulator(args)
+
+	results, err := bs.ProcessBundle([]*transaction.Transaction{{SndAddr: []byte("0-sender"), RcvAddr: []byte("1-contract")}})
+	require.NoError(t, err)
+	require.Equal(t, []string{"tx 0", "tx 1", "scr 0 refund"}, calls)
+	require.Equal(t, transaction.TxStatusSuccess, results.Transactions[0].Status)
+	require.Equal(t, vmcommon.Ok, results.Transactions[0].VMOutput.ReturnCode)
+}
+
+func TestBundleSimulator_ProcessBundleCrossShardTransactionWithoutReceiverShardShouldBePending(t *testing.T) {
+	t.Parallel()
+
+	shardSimulator := createShardSimulatorStub(0)
+	shardSimulator.SimulateBundleTransactionCalled = func(_ *transaction.Transaction) (*txSimData.SimulationResults, map[string]*smartContractResult.SmartContractResult, error) {
+		return &txSimData.SimulationResults{Status: transaction.TxStatusSuccess}, nil, nil
+	}
+	args := getBundleSimulatorArgs()
+	args.ShardSimulators = []ShardSimulator{shardSimulator}
+	bs, _ := NewBundleSimulator(args)
+
+	results, err := bs.ProcessBundle([]*transaction.Transaction{{SndAddr: []byte("0-sender"), RcvAddr: []byte("1-contract")}})
+	require.NoError(t, err)
+	require.Equal(t, transaction.TxStatusPending, results.Transactions[0].Status)
+	require.Equal(t, 0, len(results.Transactions[0].PendingScResults))
+}
+
+func TestBundleSimulator_ProcessBundleFailedContinuationShouldMarkTheTransactionAsFailed(t *testing.T) {
+	t.Parallel()
+
+	shardSimulator0 := createShardSimulatorStub(0)
+	shardSimulator0.SimulateBundleTransactionCalled = func(_ *transaction.Transaction) (*txSimData.SimulationResults, map[string]*smartContractResult.SmartContractResult, error) {
+		return &txSimData.SimulationResults{Status: transaction.TxStatusSuccess},
+			map[string]*smartContractResult.SmartContractResult{"scrHash": {RcvAddr: []byte("1-contract")}}, nil
+	}
+	shardSimulator1 := createShardSimulatorStub(1)
+	shardSimulator1.SimulateBundleSmartContractResultCalled = func(_ *smartContractResult.SmartContractResult) (*txSimData.SimulationResults, map[string]*smartContractResult.SmartContractResult, error) {
+		return &txSimData.SimulationResults{
+			Status:   transaction.TxStatusPending,
+			VMOutput: &vmcommon.VMOutput{ReturnCode: vmcommon.UserError, ReturnMessage: "not enough funds"},
+		}, nil, nil
+	}
+	args := getBundleSimulatorArgs()
+	args.ShardSimulators = []ShardSimulator{shardSimulator0, shardSimulator1}
+	bs, _ := NewBundleSimulator(args)
+
+	results, err := bs.ProcessBundle([]*transaction.Transaction{{SndAddr: []byte("0-sender")}})
+	require.NoError(t, err)
+	require.Equal(t, transaction.TxStatusFail, results.Transactions[0].Status)
+	require.Equal(t, "user error: not enough funds", results.Transactions[0].FailReason)
+}
+
+func TestBundleSimulator_ProcessBundleShouldStopAfterMaxContinuationSteps(t *testing.T) {
+	t.Parallel()
+
+	pingPong := func(rcvAddr string) map[string]*smartContractResult.SmartContractResult {
+		return map[string]*smartContractResult.SmartContractResult{rcvAddr: {RcvAddr: []byte(rcvAddr)}}
+	}
+	numSteps := 0
+	shardSimulator0 := createShardSimulatorStub(0)
+	shardSimulator0.SimulateBundleTransactionCalled = func(_ *transaction.Transaction) (*txSimData.SimulationResults, map[string]*smartContractResult.SmartContractResult, error) {
+		return &txSimData.SimulationResults{Status: transaction.TxStatusSuccess}, pingPong("1-contract"), nil
+	}
+	shardSimulator0.SimulateBundleSmartContractResultCalled = func(_ *smartContractResult.SmartContractResult) (*txSimData.SimulationResults, map[string]*smartContractResult.SmartContractResult, error) {
+		numSteps++
+		return &txSimData.SimulationResults{Status: transaction.TxStatusSuccess}, pingPong("1-contract"), nil
+	}
+	shardSimulator1 := createShardSimulatorStub(1)
+	shardSimulator1.SimulateBundleSmartContractResultCalled = func(_ *smartContractResult.SmartContractResult) (*txSimData.SimulationResults, map[string]*smartContractResult.SmartContractResult, error) {
+		numSteps++
+		return &txSimData.SimulationResults{Status: transaction.TxStatusSuccess}, pingPong("0-contract"), nil
+	}
+	args := getBundleSimulatorArgs()
+	args.ShardSimulators = []ShardSimulator{shardSimulator0, shardSimulator1}
+	args.MaxContinuationSteps = 5
+	bs, _ := NewBundleSimulator(args)
+
+	results, err := bs.ProcessBundle([]*transaction.Transaction{{SndAddr: []byte("0-sender")}})
+	require.NoError(t, err)
+	require.Equal(t, 5, numSteps)
+	require.Equal(t, transaction.TxStatusPending, results.Transactions[0].Status)
+	require.Equal(t, 1, len(results.Transactions[0].PendingScResults))
+}
+
+func TestBundleSimulator_ProcessBundleStepErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	shardSimulator := createShardSimulatorStub(0)
+	shardSimulator.SimulateBundleTransactionCalled = func(_ *transaction.Transaction) (*txSimData.SimulationResults, map[string]*smartContractResult.SmartContractResult, error) {
+		return nil, nil, expectedErr
+	}
+	args := getBundleSimulatorArgs()
+	args.ShardSimulators = []ShardSimulator{shardSimulator}
+	bs, _ := NewBundleSimulator(args)
+
+	results, err := bs.ProcessBundle([]*transaction.Transaction{{SndAddr: []byte("0-sender")}})
+	require.Nil(t, results)
+	require.Equal(t, expectedErr, err)
+}
+
+func createShardSimulatorStub(shardID uint32) *mock.ShardSimulatorStub {
+	return &mock.ShardSimulatorStub{
+		SelfShardIDCalled: func() uint32 {
+			return shardID
+		},
+	}
+}
+
+func getBundleSimulatorArgs() ArgsBundleSimulator {
+	shardCoordinator := mock.NewMultiShardsCoordinatorMock(2)
+	shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
+		if len(address) > 0 && address[0] == '1' {
+			return 1
+		}
+		return 0
+	}
+
+	return ArgsBundleSimulator{
+		ShardSimulators:      []ShardSimulator{createShardSimulatorStub(0)},
+		ShardCoordinator:     shardCoordinator,
+		MaxBundleSize:        10,
+		MaxContinuationSteps: 10,
+	}
+}
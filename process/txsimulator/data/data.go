@@ -1,16 +1,47 @@
 package data
 
 import (
+	"math/big"
+
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
 
 // SimulationResults is the data transfer object which will hold results for simulation a transaction's execution
 type SimulationResults struct {
-	Status     transaction.TxStatus                           `json:"status,omitempty"`
-	FailReason string                                         `json:"failReason,omitempty"`
-	ScResults  map[string]*transaction.ApiSmartContractResult `json:"scResults,omitempty"`
-	Receipts   map[string]*transaction.ApiReceipt             `json:"receipts,omitempty"`
-	Hash       string                                         `json:"hash,omitempty"`
-	VMOutput   *vmcommon.VMOutput                             `json:"-"`
+	Status           transaction.TxStatus                           `json:"status,omitempty"`
+	FailReason       string                                         `json:"failReason,omitempty"`
+	ScResults        map[string]*transaction.ApiSmartContractResult `json:"scResults,omitempty"`
+	Receipts         map[string]*transaction.ApiReceipt             `json:"receipts,omitempty"`
+	PendingScResults map[string]*transaction.ApiSmartContractResult `json:"pendingScResults,omitempty"`
+	Logs             map[string]*transaction.ApiLogs                `json:"logs,omitempty"`
+	AccountsChanges  map[string]*ApiAccountChanges                  `json:"accountsChanges,omitempty"`
+	Traces           []*tracing.ExecutionTrace                      `json:"traces,omitempty"`
+	Hash             string                                         `json:"hash,omitempty"`
+	VMOutput         *vmcommon.VMOutput                             `json:"-"`
+}
+
+// ApiAccountChanges is the data transfer object which holds the changes a simulated transaction made on an account.
+// The storage writes are hex encoded and map the written keys to their new values, an empty value meaning a deletion
+type ApiAccountChanges struct {
+	Address       string            `json:"address"`
+	BalanceDelta  string            `json:"balanceDelta"`
+	NonceDelta    uint64            `json:"nonceDelta"`
+	StorageWrites map[string]string `json:"storageWrites,omitempty"`
+}
+
+// AccountChanges holds the changes a simulated transaction made on an account
+type AccountChanges struct {
+	Address       []byte
+	BalanceDelta  *big.Int
+	NonceDelta    uint64
+	StorageWrites map[string][]byte
+}
+
+// BundleSimulationResults is the data transfer object which will hold the results of simulating, in order and on the
+// same ephemeral state, the execution of a bundle of transactions
+type BundleSimulationResults struct {
+	Transactions    []*SimulationResults          `json:"transactions"`
+	AccountsChanges map[string]*ApiAccountChanges `json:"accountsChanges,omitempty"`
 }
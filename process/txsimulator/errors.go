@@ -25,3 +25,42 @@ var ErrNilMarshalizer = errors.New("nil marshalizer provided")
 
 // ErrNilHasher signals that a nil hasher has been provided
 var ErrNilHasher = errors.New("nil hasher provided")
+
+// ErrNilTxLogsProcessor signals that a nil transaction logs processor has been provided
+var ErrNilTxLogsProcessor = errors.New("nil transaction logs processor")
+
+// ErrNilAccountsChangesHandler signals that a nil accounts changes handler has been provided
+var ErrNilAccountsChangesHandler = errors.New("nil accounts changes handler")
+
+// ErrWrongTypeAssertion signals that a wrong type assertion occurred
+var ErrWrongTypeAssertion = errors.New("wrong type assertion")
+
+// ErrNilSCRProcessor signals that a nil smart contract results processor has been provided
+var ErrNilSCRProcessor = errors.New("nil smart contract results processor")
+
+// ErrNoShardSimulator signals that no shard simulator has been provided
+var ErrNoShardSimulator = errors.New("no shard simulator provided")
+
+// ErrNilShardSimulator signals that a nil shard simulator has been provided
+var ErrNilShardSimulator = errors.New("nil shard simulator")
+
+// ErrDuplicatedShardSimulator signals that more than one shard simulator has been provided for the same shard
+var ErrDuplicatedShardSimulator = errors.New("duplicated shard simulator")
+
+// ErrMissingShardSimulator signals that no shard simulator exists for the required shard
+var ErrMissingShardSimulator = errors.New("missing shard simulator")
+
+// ErrInvalidMaxBundleSize signals that an invalid maximum bundle size has been provided
+var ErrInvalidMaxBundleSize = errors.New("invalid maximum bundle size")
+
+// ErrInvalidMaxContinuationSteps signals that an invalid maximum number of continuation steps has been provided
+var ErrInvalidMaxContinuationSteps = errors.New("invalid maximum number of continuation steps")
+
+// ErrEmptyBundle signals that an empty bundle has been provided
+var ErrEmptyBundle = errors.New("empty bundle")
+
+// ErrBundleTooLarge signals that the bundle holds too many transactions
+var ErrBundleTooLarge = errors.New("bundle too large")
+
+// ErrNilVMTracer signals that a nil VM tracer has been provided
+var ErrNilVMTracer = errors.New("nil VM tracer")
@@ -1,7 +1,9 @@
 package txsimulator
 
 import (
+	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
 
@@ -10,3 +12,22 @@ type TransactionProcessor interface {
 	ProcessTransaction(transaction *transaction.Transaction) (vmcommon.ReturnCode, error)
 	IsInterfaceNil() bool
 }
+
+// AccountsChangesHandler defines the operations of a component which tracks the accounts changes of a simulation
+type AccountsChangesHandler interface {
+	GetAccountsChanges() ([]*txSimData.AccountChanges, error)
+	CleanAccountsChanges()
+	IsInterfaceNil() bool
+}
+
+// ShardSimulator defines the operations of a component which simulates, on the ephemeral state of one shard, the
+// execution of the transactions and of the smart contract results of a bundle
+type ShardSimulator interface {
+	SelfShardID() uint32
+	StartBundle()
+	SimulateBundleTransaction(tx *transaction.Transaction) (*txSimData.SimulationResults, map[string]*smartContractResult.SmartContractResult, error)
+	SimulateBundleSmartContractResult(scr *smartContractResult.SmartContractResult) (*txSimData.SimulationResults, map[string]*smartContractResult.SmartContractResult, error)
+	GetBundleAccountsChanges() (map[string]*txSimData.ApiAccountChanges, error)
+	EndBundle()
+	IsInterfaceNil() bool
+}
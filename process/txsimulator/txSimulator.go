@@ -2,9 +2,11 @@ package txsimulator
 
 import (
 	"encoding/hex"
+	"sync"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/block"
 	"github.com/ElrondNetwork/elrond-go-core/data/receipt"
 	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
@@ -21,22 +23,31 @@ import (
 // ArgsTxSimulator holds the arguments required for creating a new transaction simulator
 type ArgsTxSimulator struct {
 	TransactionProcessor      TransactionProcessor
+	SCRProcessor              process.SmartContractResultProcessor
 	IntermediateProcContainer process.IntermediateProcessorContainer
 	AddressPubKeyConverter    core.PubkeyConverter
 	ShardCoordinator          sharding.Coordinator
 	VMOutputCacher            storage.Cacher
 	Hasher                    hashing.Hasher
 	Marshalizer               marshal.Marshalizer
+	TxLogsProcessor           process.TransactionLogProcessor
+	AccountsChangesHandler    AccountsChangesHandler
+	VMTracer                  process.VMTracer
 }
 
 type transactionSimulator struct {
+	mutSimulation          sync.Mutex
 	txProcessor            TransactionProcessor
+	scrProcessor           process.SmartContractResultProcessor
 	intermProcContainer    process.IntermediateProcessorContainer
 	addressPubKeyConverter core.PubkeyConverter
 	shardCoordinator       sharding.Coordinator
 	vmOutputCacher         storage.Cacher
 	hasher                 hashing.Hasher
 	marshalizer            marshal.Marshalizer
+	txLogsProcessor        process.TransactionLogProcessor
+	accountsChangesHandler AccountsChangesHandler
+	vmTracer               process.VMTracer
 }
 
 // NewTransactionSimulator returns a new instance of a transactionSimulator
@@ -44,6 +55,9 @@ func NewTransactionSimulator(args ArgsTxSimulator) (*transactionSimulator, error
 	if check.IfNil(args.TransactionProcessor) {
 		return nil, ErrNilTxSimulatorProcessor
 	}
+	if check.IfNil(args.SCRProcessor) {
+		return nil, ErrNilSCRProcessor
+	}
 	if check.IfNil(args.IntermediateProcContainer) {
 		return nil, ErrNilIntermediateProcessorContainer
 	}
@@ -62,24 +76,124 @@ func NewTransactionSimulator(args ArgsTxSimulator) (*transactionSimulator, error
 	if check.IfNil(args.Hasher) {
 		return nil, ErrNilHasher
 	}
+	if check.IfNil(args.TxLogsProcessor) {
+		return nil, ErrNilTxLogsProcessor
+	}
+	if check.IfNil(args.AccountsChangesHandler) {
+		return nil, ErrNilAccountsChangesHandler
+	}
+	if check.IfNil(args.VMTracer) {
+		return nil, ErrNilVMTracer
+	}
 
 	return &transactionSimulator{
 		txProcessor:            args.TransactionProcessor,
+		scrProcessor:           args.SCRProcessor,
 		intermProcContainer:    args.IntermediateProcContainer,
 		addressPubKeyConverter: args.AddressPubKeyConverter,
 		shardCoordinator:       args.ShardCoordinator,
 		vmOutputCacher:         args.VMOutputCacher,
 		marshalizer:            args.Marshalizer,
 		hasher:                 args.Hasher,
+		txLogsProcessor:        args.TxLogsProcessor,
+		accountsChangesHandler: args.AccountsChangesHandler,
+		vmTracer:               args.VMTracer,
 	}, nil
 }
 
 // ProcessTx will process the transaction in a special environment, where state-writing is not allowed
 func (ts *transactionSimulator) ProcessTx(tx *transaction.Transaction) (*txSimData.SimulationResults, error) {
+	return ts.processTx(tx, false)
+}
+
+// ProcessTxWithTrace will process the transaction in a special environment, where state-writing is not allowed, and
+// will add to the results the traces of the smart contract executions
+func (ts *transactionSimulator) ProcessTxWithTrace(tx *transaction.Transaction) (*txSimData.SimulationResults, error) {
+	return ts.processTx(tx, true)
+}
+
+func (ts *transactionSimulator) processTx(tx *transaction.Transaction, withTrace bool) (*txSimData.SimulationResults, error) {
+	ts.mutSimulation.Lock()
+	defer ts.mutSimulation.Unlock()
+
+	defer ts.accountsChangesHandler.CleanAccountsChanges()
+
+	if withTrace {
+		ts.vmTracer.StartTracing()
+	}
+	results, _, err := ts.simulateStep(tx, func() (vmcommon.ReturnCode, error) {
+		return ts.txProcessor.ProcessTransaction(tx)
+	})
+	if withTrace {
+		traces := ts.vmTracer.StopTracing()
+		if err == nil {
+			results.Traces = traces
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	results.AccountsChanges, err = ts.getAccountsChanges()
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// SelfShardID returns the shard whose state is used by the simulator
+func (ts *transactionSimulator) SelfShardID() uint32 {
+	return ts.shardCoordinator.SelfId()
+}
+
+// StartBundle reserves the simulator for a bundle simulation. The state changes of the transactions and smart contract
+// results processed for the bundle are kept until EndBundle is called
+func (ts *transactionSimulator) StartBundle() {
+	ts.mutSimulation.Lock()
+}
+
+// SimulateBundleTransaction processes a transaction of the started bundle and returns its results together with the
+// generated smart contract results destined to other shards
+func (ts *transactionSimulator) SimulateBundleTransaction(
+	tx *transaction.Transaction,
+) (*txSimData.SimulationResults, map[string]*smartContractResult.SmartContractResult, error) {
+	return ts.simulateStep(tx, func() (vmcommon.ReturnCode, error) {
+		return ts.txProcessor.ProcessTransaction(tx)
+	})
+}
+
+// SimulateBundleSmartContractResult processes a smart contract result generated in the started bundle and returns its
+// results together with the generated smart contract results destined to other shards
+func (ts *transactionSimulator) SimulateBundleSmartContractResult(
+	scr *smartContractResult.SmartContractResult,
+) (*txSimData.SimulationResults, map[string]*smartContractResult.SmartContractResult, error) {
+	return ts.simulateStep(scr, func() (vmcommon.ReturnCode, error) {
+		return ts.scrProcessor.ProcessSmartContractResult(scr)
+	})
+}
+
+// GetBundleAccountsChanges returns the accounts changes made so far by the started bundle
+func (ts *transactionSimulator) GetBundleAccountsChanges() (map[string]*txSimData.ApiAccountChanges, error) {
+	return ts.getAccountsChanges()
+}
+
+// EndBundle drops the state changes of the started bundle and releases the simulator
+func (ts *transactionSimulator) EndBundle() {
+	ts.accountsChangesHandler.CleanAccountsChanges()
+	ts.mutSimulation.Unlock()
+}
+
+func (ts *transactionSimulator) simulateStep(
+	txHandler data.TransactionHandler,
+	processHandler func() (vmcommon.ReturnCode, error),
+) (*txSimData.SimulationResults, map[string]*smartContractResult.SmartContractResult, error) {
+	defer ts.txLogsProcessor.Clean()
+
 	txStatus := transaction.TxStatusPending
 	failReason := ""
 
-	retCode, err := ts.txProcessor.ProcessTransaction(tx)
+	retCode, err := processHandler()
 	if err != nil {
 		failReason = err.Error()
 		txStatus = transaction.TxStatusFail
@@ -94,21 +208,23 @@ func (ts *transactionSimulator) ProcessTx(tx *transaction.Transaction) (*txSimDa
 		FailReason: failReason,
 	}
 
-	err = ts.addIntermediateTxsToResult(results)
+	crossShardScrs, err := ts.addIntermediateTxsToResult(results)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	vmOutput, ok := ts.getVMOutputOfTx(tx)
+	results.Logs = ts.getLogs()
+
+	vmOutput, ok := ts.getVMOutputOfTx(txHandler)
 	if ok {
 		results.VMOutput = vmOutput
 	}
 
-	return results, nil
+	return results, crossShardScrs, nil
 }
 
-func (ts *transactionSimulator) getVMOutputOfTx(tx *transaction.Transaction) (*vmcommon.VMOutput, bool) {
-	txHash, err := core.CalculateHash(ts.marshalizer, ts.hasher, tx)
+func (ts *transactionSimulator) getVMOutputOfTx(txHandler data.TransactionHandler) (*vmcommon.VMOutput, bool) {
+	txHash, err := core.CalculateHash(ts.marshalizer, ts.hasher, txHandler)
 	if err != nil {
 		return nil, false
 	}
@@ -128,7 +244,11 @@ func (ts *transactionSimulator) getVMOutputOfTx(tx *transaction.Transaction) (*v
 	return vmOutput, true
 }
 
-func (ts *transactionSimulator) addIntermediateTxsToResult(result *txSimData.SimulationResults) error {
+// addIntermediateTxsToResult adds the generated smart contract results and receipts to the result and returns the
+// smart contract results destined to other shards, which were not executed
+func (ts *transactionSimulator) addIntermediateTxsToResult(
+	result *txSimData.SimulationResults,
+) (map[string]*smartContractResult.SmartContractResult, error) {
 	defer func() {
 		processorsKeys := ts.intermProcContainer.Keys()
 		for _, procKey := range processorsKeys {
@@ -143,26 +263,31 @@ func (ts *transactionSimulator) addIntermediateTxsToResult(result *txSimData.Sim
 
 	scrForwarder, err := ts.intermProcContainer.Get(block.SmartContractResultBlock)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	scResults := make(map[string]*transaction.ApiSmartContractResult)
+	crossShardScrs := make(map[string]*smartContractResult.SmartContractResult)
 	for hash, value := range scrForwarder.GetAllCurrentFinishedTxs() {
 		scr, ok := value.(*smartContractResult.SmartContractResult)
 		if !ok {
 			continue
 		}
 		scResults[hex.EncodeToString([]byte(hash))] = ts.adaptSmartContractResult(scr)
+
+		if ts.shardCoordinator.ComputeId(scr.RcvAddr) != ts.shardCoordinator.SelfId() {
+			crossShardScrs[hash] = scr
+		}
 	}
 	result.ScResults = scResults
 
 	if ts.shardCoordinator.SelfId() == core.MetachainShardId {
-		return nil
+		return crossShardScrs, nil
 	}
 
 	receiptsForwarder, err := ts.intermProcContainer.Get(block.ReceiptBlock)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	receipts := make(map[string]*transaction.ApiReceipt)
@@ -175,7 +300,67 @@ func (ts *transactionSimulator) addIntermediateTxsToResult(result *txSimData.Sim
 	}
 	result.Receipts = receipts
 
-	return nil
+	return crossShardScrs, nil
+}
+
+func (ts *transactionSimulator) getLogs() map[string]*transaction.ApiLogs {
+	logs := make(map[string]*transaction.ApiLogs)
+	for hash, logHandler := range ts.txLogsProcessor.GetAllCurrentLogs() {
+		txLog, ok := logHandler.(*transaction.Log)
+		if !ok {
+			continue
+		}
+		logs[hex.EncodeToString([]byte(hash))] = ts.adaptLog(txLog)
+	}
+
+	return logs
+}
+
+func (ts *transactionSimulator) getAccountsChanges() (map[string]*txSimData.ApiAccountChanges, error) {
+	accountsChanges, err := ts.accountsChangesHandler.GetAccountsChanges()
+	if err != nil {
+		return nil, err
+	}
+
+	apiAccountsChanges := make(map[string]*txSimData.ApiAccountChanges)
+	for _, accountChanges := range accountsChanges {
+		address := ts.addressPubKeyConverter.Encode(accountChanges.Address)
+		apiAccountsChanges[address] = ts.adaptAccountChanges(address, accountChanges)
+	}
+
+	return apiAccountsChanges, nil
+}
+
+func (ts *transactionSimulator) adaptLog(txLog *transaction.Log) *transaction.ApiLogs {
+	apiLog := &transaction.ApiLogs{
+		Address: ts.addressPubKeyConverter.Encode(txLog.Address),
+		Events:  make([]*transaction.Events, 0, len(txLog.Events)),
+	}
+
+	for _, event := range txLog.Events {
+		apiLog.Events = append(apiLog.Events, &transaction.Events{
+			Address:    ts.addressPubKeyConverter.Encode(event.Address),
+			Identifier: string(event.Identifier),
+			Topics:     event.Topics,
+			Data:       event.Data,
+		})
+	}
+
+	return apiLog
+}
+
+func (ts *transactionSimulator) adaptAccountChanges(address string, accountChanges *txSimData.AccountChanges) *txSimData.ApiAccountChanges {
+	storageWrites := make(map[string]string, len(accountChanges.StorageWrites))
+	for key, value := range accountChanges.StorageWrites {
+		storageWrites[hex.EncodeToString([]byte(key))] = hex.EncodeToString(value)
+	}
+
+	return &txSimData.ApiAccountChanges{
+		Address:       address,
+		BalanceDelta:  accountChanges.BalanceDelta.String(),
+		NonceDelta:    accountChanges.NonceDelta,
+		StorageWrites: storageWrites,
+	}
 }
 
 func (ts *transactionSimulator) adaptSmartContractResult(scr *smartContractResult.SmartContractResult) *transaction.ApiSmartContractResult {
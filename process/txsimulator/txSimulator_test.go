@@ -3,6 +3,7 @@ package txsimulator
 import (
 	"encoding/hex"
 	"errors"
+	"math/big"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
@@ -13,6 +14,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
+	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/ElrondNetwork/elrond-go/storage/txcache"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
@@ -44,6 +47,15 @@ func TestNewTransactionSimulator(t *testing.T) {
 			},
 			exError: ErrNilTxSimulatorProcessor,
 		},
+		{
+			name: "NilSCRProcessor",
+			argsFunc: func() ArgsTxSimulator {
+				args := getTxSimulatorArgs()
+				args.SCRProcessor = nil
+				return args
+			},
+			exError: ErrNilSCRProcessor,
+		},
 		{
 			name: "NilIntermProcessorContainer",
 			argsFunc: func() ArgsTxSimulator {
@@ -89,6 +101,33 @@ func TestNewTransactionSimulator(t *testing.T) {
 			},
 			exError: ErrNilCacher,
 		},
+		{
+			name: "NilTxLogsProcessor",
+			argsFunc: func() ArgsTxSimulator {
+				args := getTxSimulatorArgs()
+				args.TxLogsProcessor = nil
+				return args
+			},
+			exError: ErrNilTxLogsProcessor,
+		},
+		{
+			name: "NilAccountsChangesHandler",
+			argsFunc: func() ArgsTxSimulator {
+				args := getTxSimulatorArgs()
+				args.AccountsChangesHandler = nil
+				return args
+			},
+			exError: ErrNilAccountsChangesHandler,
+		},
+		{
+			name: "NilVMTracer",
+			argsFunc: func() ArgsTxSimulator {
+				args := getTxSimulatorArgs()
+				args.VMTracer = nil
+				return args
+			},
+			exError: ErrNilVMTracer,
+		},
 		{
 			name: "Ok",
 			argsFunc: func() ArgsTxSimulator {
@@ -216,14 +255,233 @@ func TestTransactionSimulator_ProcessTxShouldIncludeScrsAndReceipts(t *testing.T
 	)
 }
 
+func TestTransactionSimulator_ProcessTxShouldIncludeLogsAndAccountsChanges(t *testing.T) {
+	t.Parallel()
+
+	expectedLogs := map[string]data.LogHandler{
+		"txHash": &transaction.Log{
+			Address: []byte("contract"),
+			Events: []*transaction.Event{
+				{
+					Address:    []byte("contract"),
+					Identifier: []byte("transfer"),
+					Topics:     [][]byte{[]byte("topic")},
+					Data:       []byte("data"),
+				},
+			},
+		},
+	}
+	expectedChanges := []*txSimData.AccountChanges{
+		{
+			Address:      []byte("sender"),
+			BalanceDelta: big.NewInt(-50),
+			NonceDelta:   1,
+		},
+		{
+			Address:       []byte("contract"),
+			BalanceDelta:  big.NewInt(10),
+			StorageWrites: map[string][]byte{"key": []byte("value")},
+		},
+	}
+
+	logsCleaned := false
+	changesCleaned := false
+	args := getTxSimulatorArgs()
+	args.IntermediateProcContainer = &mock.IntermProcessorContainerStub{
+		GetCalled: func(key block.Type) (process.IntermediateTransactionHandler, error) {
+			return &mock.IntermediateTransactionHandlerStub{}, nil
+		},
+	}
+	args.TxLogsProcessor = &mock.TxLogsProcessorStub{
+		GetAllCurrentLogsCalled: func() map[string]data.LogHandler {
+			return expectedLogs
+		},
+		CleanCalled: func() {
+			logsCleaned = true
+		},
+	}
+	args.AccountsChangesHandler = &mock.AccountsChangesHandlerStub{
+		GetAccountsChangesCalled: func() ([]*txSimData.AccountChanges, error) {
+			return expectedChanges, nil
+		},
+		CleanAccountsChangesCalled: func() {
+			changesCleaned = true
+		},
+	}
+	ts, _ := NewTransactionSimulator(args)
+
+	results, err := ts.ProcessTx(&transaction.Transaction{Nonce: 37})
+	require.NoError(t, err)
+	require.True(t, logsCleaned)
+	require.True(t, changesCleaned)
+
+	expectedApiLog := &transaction.ApiLogs{
+		Address: hex.EncodeToString([]byte("contract")),
+		Events: []*transaction.Events{
+			{
+				Address:    hex.EncodeToString([]byte("contract")),
+				Identifier: "transfer",
+				Topics:     [][]byte{[]byte("topic")},
+				Data:       []byte("data"),
+			},
+		},
+	}
+	require.Equal(t, expectedApiLog, results.Logs[hex.EncodeToString([]byte("txHash"))])
+
+	senderAddress := hex.EncodeToString([]byte("sender"))
+	contractAddress := hex.EncodeToString([]byte("contract"))
+	require.Equal(t, 2, len(results.AccountsChanges))
+	require.Equal(t, &txSimData.ApiAccountChanges{
+		Address:       senderAddress,
+		BalanceDelta:  "-50",
+		NonceDelta:    1,
+		StorageWrites: map[string]string{},
+	}, results.AccountsChanges[senderAddress])
+	require.Equal(t, &txSimData.ApiAccountChanges{
+		Address:       contractAddress,
+		BalanceDelta:  "10",
+		StorageWrites: map[string]string{hex.EncodeToString([]byte("key")): hex.EncodeToString([]byte("value"))},
+	}, results.AccountsChanges[contractAddress])
+}
+
+func TestTransactionSimulator_ProcessTxAccountsChangesErrShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	args := getTxSimulatorArgs()
+	args.IntermediateProcContainer = &mock.IntermProcessorContainerStub{
+		GetCalled: func(key block.Type) (process.IntermediateTransactionHandler, error) {
+			return &mock.IntermediateTransactionHandlerStub{}, nil
+		},
+	}
+	args.AccountsChangesHandler = &mock.AccountsChangesHandlerStub{
+		GetAccountsChangesCalled: func() ([]*txSimData.AccountChanges, error) {
+			return nil, expectedErr
+		},
+	}
+	ts, _ := NewTransactionSimulator(args)
+
+	results, err := ts.ProcessTx(&transaction.Transaction{Nonce: 37})
+	require.Nil(t, results)
+	require.Equal(t, expectedErr, err)
+}
+
+func TestTransactionSimulator_ProcessTxWithTraceShouldIncludeTheTraces(t *testing.T) {
+	t.Parallel()
+
+	expectedTraces := []*tracing.ExecutionTrace{{TxHash: "hash", Root: &tracing.CallFrame{Function: "function"}}}
+	isTracing := false
+	processedWhileTracing := false
+	args := getTxSimulatorArgs()
+	args.IntermediateProcContainer = &mock.IntermProcessorContainerStub{
+		GetCalled: func(key block.Type) (process.IntermediateTransactionHandler, error) {
+			return &mock.IntermediateTransactionHandlerStub{}, nil
+		},
+	}
+	args.TransactionProcessor = &testscommon.TxProcessorStub{
+		ProcessTransactionCalled: func(transaction *transaction.Transaction) (vmcommon.ReturnCode, error) {
+			processedWhileTracing = isTracing
+			return vmcommon.Ok, nil
+		},
+	}
+	args.VMTracer = &testscommon.VMTracerStub{
+		StartTracingCalled: func() {
+			isTracing = true
+		},
+		StopTracingCalled: func() []*tracing.ExecutionTrace {
+			isTracing = false
+			return expectedTraces
+		},
+	}
+	ts, _ := NewTransactionSimulator(args)
+
+	results, err := ts.ProcessTx(&transaction.Transaction{Nonce: 37})
+	require.NoError(t, err)
+	require.False(t, processedWhileTracing)
+	require.Nil(t, results.Traces)
+
+	results, err = ts.ProcessTxWithTrace(&transaction.Transaction{Nonce: 37})
+	require.NoError(t, err)
+	require.True(t, processedWhileTracing)
+	require.False(t, isTracing)
+	require.Equal(t, expectedTraces, results.Traces)
+}
+
+func TestTransactionSimulator_BundleShouldReturnCrossShardScrsAndKeepTheChanges(t *testing.T) {
+	t.Parallel()
+
+	intraShardScr := &smartContractResult.SmartContractResult{RcvAddr: []byte("intra")}
+	crossShardScr := &smartContractResult.SmartContractResult{RcvAddr: []byte("cross")}
+	shardCoordinator := mock.NewMultiShardsCoordinatorMock(2)
+	shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
+		if string(address) == "cross" {
+			return 1
+		}
+		return 0
+	}
+
+	processedScrs := make([]*smartContractResult.SmartContractResult, 0)
+	numChangesCleaned := 0
+	args := getTxSimulatorArgs()
+	args.ShardCoordinator = shardCoordinator
+	args.SCRProcessor = &testscommon.SmartContractResultsProcessorMock{
+		ProcessSmartContractResultCalled: func(scr *smartContractResult.SmartContractResult) (vmcommon.ReturnCode, error) {
+			processedScrs = append(processedScrs, scr)
+			return vmcommon.Ok, nil
+		},
+	}
+	args.IntermediateProcContainer = &mock.IntermProcessorContainerStub{
+		GetCalled: func(key block.Type) (process.IntermediateTransactionHandler, error) {
+			return &mock.IntermediateTransactionHandlerStub{
+				GetAllCurrentFinishedTxsCalled: func() map[string]data.TransactionHandler {
+					if key != block.SmartContractResultBlock {
+						return nil
+					}
+					return map[string]data.TransactionHandler{
+						"intraHash": intraShardScr,
+						"crossHash": crossShardScr,
+					}
+				},
+			}, nil
+		},
+	}
+	args.AccountsChangesHandler = &mock.AccountsChangesHandlerStub{
+		CleanAccountsChangesCalled: func() {
+			numChangesCleaned++
+		},
+	}
+	ts, _ := NewTransactionSimulator(args)
+	require.Equal(t, uint32(0), ts.SelfShardID())
+
+	ts.StartBundle()
+	results, crossShardScrs, err := ts.SimulateBundleTransaction(&transaction.Transaction{Nonce: 37})
+	require.NoError(t, err)
+	require.Equal(t, transaction.TxStatusSuccess, results.Status)
+	require.Equal(t, 2, len(results.ScResults))
+	require.Equal(t, map[string]*smartContractResult.SmartContractResult{"crossHash": crossShardScr}, crossShardScrs)
+
+	results, _, err = ts.SimulateBundleSmartContractResult(intraShardScr)
+	require.NoError(t, err)
+	require.Equal(t, transaction.TxStatusSuccess, results.Status)
+	require.Equal(t, []*smartContractResult.SmartContractResult{intraShardScr}, processedScrs)
+	require.Equal(t, 0, numChangesCleaned)
+
+	ts.EndBundle()
+	require.Equal(t, 1, numChangesCleaned)
+}
+
 func getTxSimulatorArgs() ArgsTxSimulator {
 	return ArgsTxSimulator{
 		TransactionProcessor:      &testscommon.TxProcessorStub{},
+		SCRProcessor:              &testscommon.SmartContractResultsProcessorMock{},
 		IntermediateProcContainer: &mock.IntermProcessorContainerStub{},
 		AddressPubKeyConverter:    &mock.PubkeyConverterMock{},
 		ShardCoordinator:          mock.NewMultiShardsCoordinatorMock(2),
 		VMOutputCacher:            txcache.NewDisabledCache(),
 		Marshalizer:               &mock.MarshalizerMock{},
 		Hasher:                    &mock.HasherMock{},
+		TxLogsProcessor:           &mock.TxLogsProcessorStub{},
+		AccountsChangesHandler:    &mock.AccountsChangesHandlerStub{},
+		VMTracer:                  &testscommon.VMTracerStub{},
 	}
 }
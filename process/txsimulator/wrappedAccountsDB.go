@@ -1,47 +1,246 @@
 package txsimulator
 
 import (
+	"bytes"
+	"math/big"
+	"sync"
+
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
 	"github.com/ElrondNetwork/elrond-go/common"
+	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
 	"github.com/ElrondNetwork/elrond-go/state"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
 
-// readOnlyAccountsDB is a wrapper over an accounts db which works read-only. write operation are disabled
+type savedAccountEntry struct {
+	address         []byte
+	nonce           uint64
+	balance         *big.Int
+	developerReward *big.Int
+	codeHash        []byte
+	code            []byte
+	codeMetadata    []byte
+	ownerAddress    []byte
+	userName        []byte
+	storageWrites   map[string][]byte
+}
+
+// readOnlyAccountsDB is a wrapper over an accounts db which works read-only. write operation are disabled, the saved
+// accounts are only recorded in a journal which acts as an ephemeral state: the loaded accounts reflect the previously
+// saved ones, so consecutive simulated transactions observe each other's changes, until the journal is cleaned.
+// The code set on the saved accounts is recorded as well, so contracts deployed during a simulation can be called
 type readOnlyAccountsDB struct {
 	originalAccounts state.AccountsAdapter
+	hasher           hashing.Hasher
+	mutJournal       sync.RWMutex
+	journal          []*savedAccountEntry
 }
 
 // NewReadOnlyAccountsDB returns a new instance of readOnlyAccountsDB
-func NewReadOnlyAccountsDB(accountsDB state.AccountsAdapter) (*readOnlyAccountsDB, error) {
+func NewReadOnlyAccountsDB(accountsDB state.AccountsAdapter, hasher hashing.Hasher) (*readOnlyAccountsDB, error) {
 	if check.IfNil(accountsDB) {
 		return nil, ErrNilAccountsAdapter
 	}
+	if check.IfNil(hasher) {
+		return nil, ErrNilHasher
+	}
 
-	return &readOnlyAccountsDB{originalAccounts: accountsDB}, nil
+	return &readOnlyAccountsDB{
+		originalAccounts: accountsDB,
+		hasher:           hasher,
+		journal:          make([]*savedAccountEntry, 0),
+	}, nil
 }
 
-// GetCode returns the code for the given account
+// GetCode returns the code for the given code hash. The code saved during the simulation is searched before the one of
+// the original accounts
 func (r *readOnlyAccountsDB) GetCode(codeHash []byte) []byte {
+	code, found := r.getSavedCode(codeHash)
+	if found {
+		return code
+	}
+
 	return r.originalAccounts.GetCode(codeHash)
 }
 
-// GetExistingAccount will call the original accounts' function with the same name
+func (r *readOnlyAccountsDB) getSavedCode(codeHash []byte) ([]byte, bool) {
+	if len(codeHash) == 0 {
+		return nil, false
+	}
+
+	r.mutJournal.RLock()
+	defer r.mutJournal.RUnlock()
+
+	for i := len(r.journal) - 1; i >= 0; i-- {
+		entry := r.journal[i]
+		if len(entry.code) > 0 && bytes.Equal(entry.codeHash, codeHash) {
+			return entry.code, true
+		}
+	}
+
+	return nil, false
+}
+
+// GetExistingAccount will call the original accounts' function with the same name and will apply the saved changes
+// on the returned account. An account which only exists in the journal is created
 func (r *readOnlyAccountsDB) GetExistingAccount(address []byte) (vmcommon.AccountHandler, error) {
-	return r.originalAccounts.GetExistingAccount(address)
+	account, err := r.originalAccounts.GetExistingAccount(address)
+	if err == state.ErrAccNotFound && r.isAccountSaved(address) {
+		account, err = r.originalAccounts.LoadAccount(address)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return r.applySavedChanges(account)
 }
 
-// LoadAccount will call the original accounts' function with the same name
+// LoadAccount will call the original accounts' function with the same name and will apply the saved changes on the
+// returned account
 func (r *readOnlyAccountsDB) LoadAccount(address []byte) (vmcommon.AccountHandler, error) {
-	return r.originalAccounts.LoadAccount(address)
+	account, err := r.originalAccounts.LoadAccount(address)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.applySavedChanges(account)
 }
 
-// SaveAccount won't do anything as write operations are disabled on this component
-func (r *readOnlyAccountsDB) SaveAccount(_ vmcommon.AccountHandler) error {
+// SaveAccount won't write the account as write operations are disabled on this component. The user account state is
+// only recorded in the journal
+func (r *readOnlyAccountsDB) SaveAccount(account vmcommon.AccountHandler) error {
+	if check.IfNil(account) {
+		return nil
+	}
+	userAccount, ok := account.(state.UserAccountHandler)
+	if !ok {
+		return nil
+	}
+
+	entry := &savedAccountEntry{
+		address:         account.AddressBytes(),
+		nonce:           userAccount.GetNonce(),
+		balance:         big.NewInt(0).Set(userAccount.GetBalance()),
+		developerReward: big.NewInt(0).Set(userAccount.GetDeveloperReward()),
+		codeHash:        userAccount.GetCodeHash(),
+		codeMetadata:    userAccount.GetCodeMetadata(),
+		ownerAddress:    userAccount.GetOwnerAddress(),
+		userName:        userAccount.GetUserName(),
+		storageWrites:   getStorageWrites(userAccount),
+	}
+	if userAccount.HasNewCode() {
+		// the code hash is computed the same way the accounts db does it when the account is saved
+		entry.code = userAccount.GetCode()
+		entry.codeHash = nil
+		if len(entry.code) > 0 {
+			entry.codeHash = r.hasher.Compute(string(entry.code))
+		}
+		userAccount.SetCodeHash(entry.codeHash)
+	}
+
+	r.mutJournal.Lock()
+	r.journal = append(r.journal, entry)
+	r.mutJournal.Unlock()
+
 	return nil
 }
 
+// getStorageWrites copies the dirty data of the account, removing the key and address suffix the data trie tracker
+// appends to the non-empty values
+func getStorageWrites(account state.UserAccountHandler) map[string][]byte {
+	storageWrites := make(map[string][]byte)
+	if check.IfNil(account.DataTrieTracker()) {
+		return storageWrites
+	}
+
+	for key, value := range account.DataTrieTracker().DirtyData() {
+		valueLength := len(value)
+		if valueLength != 0 {
+			valueLength -= len(key) + len(account.AddressBytes())
+		}
+		if valueLength < 0 {
+			valueLength = 0
+		}
+
+		storageWrites[key] = append(make([]byte, 0, valueLength), value[:valueLength]...)
+	}
+
+	return storageWrites
+}
+
+func (r *readOnlyAccountsDB) isAccountSaved(address []byte) bool {
+	r.mutJournal.RLock()
+	defer r.mutJournal.RUnlock()
+
+	for _, entry := range r.journal {
+		if bytes.Equal(entry.address, address) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getSavedState returns the last journal entry of the address together with all the storage writes recorded for it
+func (r *readOnlyAccountsDB) getSavedState(address []byte) (*savedAccountEntry, map[string][]byte) {
+	r.mutJournal.RLock()
+	defer r.mutJournal.RUnlock()
+
+	var lastEntry *savedAccountEntry
+	storageWrites := make(map[string][]byte)
+	for _, entry := range r.journal {
+		if !bytes.Equal(entry.address, address) {
+			continue
+		}
+
+		lastEntry = entry
+		for key, value := range entry.storageWrites {
+			storageWrites[key] = value
+		}
+	}
+
+	return lastEntry, storageWrites
+}
+
+func (r *readOnlyAccountsDB) applySavedChanges(account vmcommon.AccountHandler) (vmcommon.AccountHandler, error) {
+	userAccount, ok := account.(state.UserAccountHandler)
+	if !ok {
+		return account, nil
+	}
+
+	entry, storageWrites := r.getSavedState(account.AddressBytes())
+	if entry == nil {
+		return account, nil
+	}
+
+	err := userAccount.AddToBalance(big.NewInt(0).Sub(entry.balance, userAccount.GetBalance()))
+	if err != nil {
+		return nil, err
+	}
+	if entry.nonce > userAccount.GetNonce() {
+		userAccount.IncreaseNonce(entry.nonce - userAccount.GetNonce())
+	}
+	userAccount.AddToDeveloperReward(big.NewInt(0).Sub(entry.developerReward, userAccount.GetDeveloperReward()))
+	userAccount.SetCodeHash(entry.codeHash)
+	userAccount.SetCodeMetadata(entry.codeMetadata)
+	userAccount.SetOwnerAddress(entry.ownerAddress)
+	userAccount.SetUserName(entry.userName)
+
+	if check.IfNil(userAccount.DataTrieTracker()) {
+		return userAccount, nil
+	}
+	for key, value := range storageWrites {
+		err = userAccount.DataTrieTracker().SaveKeyValue([]byte(key), append(make([]byte, 0, len(value)), value...))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return userAccount, nil
+}
+
 // RemoveAccount won't do anything as write operations are disabled on this component
 func (r *readOnlyAccountsDB) RemoveAccount(_ []byte) error {
 	return nil
@@ -52,16 +251,98 @@ func (r *readOnlyAccountsDB) Commit() ([]byte, error) {
 	return nil, nil
 }
 
-// JournalLen will call the original accounts' function with the same name
+// JournalLen returns the number of accounts saves recorded in the journal
 func (r *readOnlyAccountsDB) JournalLen() int {
-	return r.originalAccounts.JournalLen()
+	r.mutJournal.RLock()
+	defer r.mutJournal.RUnlock()
+
+	return len(r.journal)
 }
 
-// RevertToSnapshot won't do anything as write operations are disabled on this component
-func (r *readOnlyAccountsDB) RevertToSnapshot(_ int) error {
+// RevertToSnapshot drops the accounts saves recorded after the snapshot
+func (r *readOnlyAccountsDB) RevertToSnapshot(snapshot int) error {
+	r.mutJournal.Lock()
+	defer r.mutJournal.Unlock()
+
+	if snapshot > len(r.journal) || snapshot < 0 {
+		return state.ErrSnapshotValueOutOfBounds
+	}
+
+	r.journal = r.journal[:snapshot]
+
 	return nil
 }
 
+// GetAccountsChanges returns the changes of the accounts saved since the last clean, in the order they were first
+// saved. As every load reflects the previously saved state, the deltas are computed between the last save of every
+// account and its original state
+func (r *readOnlyAccountsDB) GetAccountsChanges() ([]*txSimData.AccountChanges, error) {
+	r.mutJournal.RLock()
+	defer r.mutJournal.RUnlock()
+
+	lastEntries := make(map[string]*savedAccountEntry)
+	changesMap := make(map[string]*txSimData.AccountChanges)
+	changes := make([]*txSimData.AccountChanges, 0)
+	for _, entry := range r.journal {
+		lastEntries[string(entry.address)] = entry
+
+		accountChanges, found := changesMap[string(entry.address)]
+		if !found {
+			accountChanges = &txSimData.AccountChanges{
+				Address:       entry.address,
+				BalanceDelta:  big.NewInt(0),
+				StorageWrites: make(map[string][]byte),
+			}
+			changesMap[string(entry.address)] = accountChanges
+			changes = append(changes, accountChanges)
+		}
+
+		for key, value := range entry.storageWrites {
+			accountChanges.StorageWrites[key] = value
+		}
+	}
+
+	for _, accountChanges := range changes {
+		entry := lastEntries[string(accountChanges.Address)]
+
+		originalNonce, originalBalance, err := r.getOriginalNonceAndBalance(accountChanges.Address)
+		if err != nil {
+			return nil, err
+		}
+
+		accountChanges.BalanceDelta.Sub(entry.balance, originalBalance)
+		if entry.nonce > originalNonce {
+			accountChanges.NonceDelta = entry.nonce - originalNonce
+		}
+	}
+
+	return changes, nil
+}
+
+func (r *readOnlyAccountsDB) getOriginalNonceAndBalance(address []byte) (uint64, *big.Int, error) {
+	account, err := r.originalAccounts.GetExistingAccount(address)
+	if err == state.ErrAccNotFound {
+		return 0, big.NewInt(0), nil
+	}
+	if err != nil {
+		return 0, nil, err
+	}
+
+	userAccount, ok := account.(state.UserAccountHandler)
+	if !ok {
+		return 0, nil, ErrWrongTypeAssertion
+	}
+
+	return userAccount.GetNonce(), userAccount.GetBalance(), nil
+}
+
+// CleanAccountsChanges empties the journal of the saved accounts
+func (r *readOnlyAccountsDB) CleanAccountsChanges() {
+	r.mutJournal.Lock()
+	r.journal = make([]*savedAccountEntry, 0)
+	r.mutJournal.Unlock()
+}
+
 // GetNumCheckpoints will call the original accounts' function with the same name
 func (r *readOnlyAccountsDB) GetNumCheckpoints() uint32 {
 	return r.originalAccounts.GetNumCheckpoints()
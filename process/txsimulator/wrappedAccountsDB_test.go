@@ -1,6 +1,8 @@
 package txsimulator
 
 import (
+	"bytes"
+	"math/big"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
@@ -16,15 +18,23 @@ import (
 func TestNewReadOnlyAccountsDB_NilOriginalAccountsDBShouldErr(t *testing.T) {
 	t.Parallel()
 
-	roAccDb, err := NewReadOnlyAccountsDB(nil)
+	roAccDb, err := NewReadOnlyAccountsDB(nil, &mock.HasherMock{})
 	require.True(t, check.IfNil(roAccDb))
 	require.Equal(t, ErrNilAccountsAdapter, err)
 }
 
+func TestNewReadOnlyAccountsDB_NilHasherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	roAccDb, err := NewReadOnlyAccountsDB(&stateMock.AccountsStub{}, nil)
+	require.True(t, check.IfNil(roAccDb))
+	require.Equal(t, ErrNilHasher, err)
+}
+
 func TestNewReadOnlyAccountsDB(t *testing.T) {
 	t.Parallel()
 
-	roAccDb, err := NewReadOnlyAccountsDB(&stateMock.AccountsStub{})
+	roAccDb, err := NewReadOnlyAccountsDB(&stateMock.AccountsStub{}, &mock.HasherMock{})
 	require.False(t, check.IfNil(roAccDb))
 	require.NoError(t, err)
 }
@@ -72,7 +82,7 @@ func TestReadOnlyAccountsDB_WriteOperationsShouldNotCalled(t *testing.T) {
 		},
 	}
 
-	roAccDb, _ := NewReadOnlyAccountsDB(accDb)
+	roAccDb, _ := NewReadOnlyAccountsDB(accDb, &mock.HasherMock{})
 	require.NotNil(t, roAccDb)
 
 	err := roAccDb.SaveAccount(nil)
@@ -106,7 +116,6 @@ func TestReadOnlyAccountsDB_ReadOperationsShouldWork(t *testing.T) {
 	t.Parallel()
 
 	expectedAcc := &mock.AccountWrapMock{}
-	expectedJournalLen := 37
 	expectedRootHash := []byte("root")
 	expectedLeavesChannel := make(chan core.KeyValueHolder)
 	expectedNumCheckpoints := uint32(7)
@@ -118,9 +127,6 @@ func TestReadOnlyAccountsDB_ReadOperationsShouldWork(t *testing.T) {
 		LoadAccountCalled: func(_ []byte) (vmcommon.AccountHandler, error) {
 			return expectedAcc, nil
 		},
-		JournalLenCalled: func() int {
-			return expectedJournalLen
-		},
 		RootHashCalled: func() ([]byte, error) {
 			return expectedRootHash, nil
 		},
@@ -135,7 +141,7 @@ func TestReadOnlyAccountsDB_ReadOperationsShouldWork(t *testing.T) {
 		},
 	}
 
-	roAccDb, _ := NewReadOnlyAccountsDB(accDb)
+	roAccDb, _ := NewReadOnlyAccountsDB(accDb, &mock.HasherMock{})
 	require.NotNil(t, roAccDb)
 
 	actualAcc, err := roAccDb.GetExistingAccount(nil)
@@ -146,9 +152,6 @@ func TestReadOnlyAccountsDB_ReadOperationsShouldWork(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, expectedAcc, actualAcc)
 
-	actualJournalLen := roAccDb.JournalLen()
-	require.Equal(t, expectedJournalLen, actualJournalLen)
-
 	actualRootHash, err := roAccDb.RootHash()
 	require.NoError(t, err)
 	require.Equal(t, expectedRootHash, actualRootHash)
@@ -163,3 +166,158 @@ func TestReadOnlyAccountsDB_ReadOperationsShouldWork(t *testing.T) {
 	actualNumCheckpoints := roAccDb.GetNumCheckpoints()
 	require.Equal(t, expectedNumCheckpoints, actualNumCheckpoints)
 }
+
+func createOriginalUserAccount(address []byte, nonce uint64, balance int64) *stateMock.AccountsStub {
+	return &stateMock.AccountsStub{
+		GetExistingAccountCalled: func(addressContainer []byte) (vmcommon.AccountHandler, error) {
+			if string(addressContainer) != string(address) {
+				return nil, state.ErrAccNotFound
+			}
+
+			acc, _ := state.NewUserAccount(address)
+			acc.IncreaseNonce(nonce)
+			_ = acc.AddToBalance(big.NewInt(balance))
+			return acc, nil
+		},
+		LoadAccountCalled: func(container []byte) (vmcommon.AccountHandler, error) {
+			acc, _ := state.NewUserAccount(container)
+			if string(container) == string(address) {
+				acc.IncreaseNonce(nonce)
+				_ = acc.AddToBalance(big.NewInt(balance))
+			}
+			return acc, nil
+		},
+	}
+}
+
+func TestReadOnlyAccountsDB_SaveAccountShouldRecordTheChanges(t *testing.T) {
+	t.Parallel()
+
+	sender := []byte("sender")
+	contract := []byte("contract")
+	roAccDb, _ := NewReadOnlyAccountsDB(createOriginalUserAccount(sender, 5, 100), &mock.HasherMock{})
+
+	senderAcc, _ := roAccDb.LoadAccount(sender)
+	senderAcc.IncreaseNonce(1)
+	_ = senderAcc.(state.UserAccountHandler).SubFromBalance(big.NewInt(10))
+	_ = roAccDb.SaveAccount(senderAcc)
+
+	// a reloaded account reflects the previous save
+	reloadedSenderAcc, _ := roAccDb.LoadAccount(sender)
+	require.Equal(t, uint64(6), reloadedSenderAcc.GetNonce())
+	require.Equal(t, big.NewInt(90), reloadedSenderAcc.(state.UserAccountHandler).GetBalance())
+	_ = reloadedSenderAcc.(state.UserAccountHandler).SubFromBalance(big.NewInt(15))
+	_ = roAccDb.SaveAccount(reloadedSenderAcc)
+
+	contractAcc, _ := roAccDb.LoadAccount(contract)
+	userContractAcc := contractAcc.(state.UserAccountHandler)
+	_ = userContractAcc.AddToBalance(big.NewInt(20))
+	_ = userContractAcc.DataTrieTracker().SaveKeyValue([]byte("key1"), []byte("value1"))
+	_ = userContractAcc.DataTrieTracker().SaveKeyValue([]byte("key2"), []byte("value2"))
+	_ = roAccDb.SaveAccount(contractAcc)
+
+	// an account created during the simulation can be retrieved as existing
+	existingContractAcc, err := roAccDb.GetExistingAccount(contract)
+	require.NoError(t, err)
+	value, err := existingContractAcc.(state.UserAccountHandler).RetrieveValueFromDataTrieTracker([]byte("key1"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value1"), value)
+	_ = existingContractAcc.(state.UserAccountHandler).DataTrieTracker().SaveKeyValue([]byte("key2"), nil)
+	_ = roAccDb.SaveAccount(existingContractAcc)
+	require.Equal(t, 4, roAccDb.JournalLen())
+
+	changes, err := roAccDb.GetAccountsChanges()
+	require.NoError(t, err)
+	require.Equal(t, 2, len(changes))
+
+	require.Equal(t, sender, changes[0].Address)
+	require.Equal(t, big.NewInt(-25), changes[0].BalanceDelta)
+	require.Equal(t, uint64(1), changes[0].NonceDelta)
+	require.Equal(t, 0, len(changes[0].StorageWrites))
+
+	require.Equal(t, contract, changes[1].Address)
+	require.Equal(t, big.NewInt(20), changes[1].BalanceDelta)
+	require.Equal(t, uint64(0), changes[1].NonceDelta)
+	expectedStorageWrites := map[string][]byte{
+		"key1": []byte("value1"),
+		"key2": {},
+	}
+	require.Equal(t, expectedStorageWrites, changes[1].StorageWrites)
+
+	roAccDb.CleanAccountsChanges()
+	require.Equal(t, 0, roAccDb.JournalLen())
+	changes, err = roAccDb.GetAccountsChanges()
+	require.NoError(t, err)
+	require.Equal(t, 0, len(changes))
+
+	_, err = roAccDb.GetExistingAccount(contract)
+	require.Equal(t, state.ErrAccNotFound, err)
+	senderAcc, _ = roAccDb.LoadAccount(sender)
+	require.Equal(t, uint64(5), senderAcc.GetNonce())
+}
+
+func TestReadOnlyAccountsDB_RevertToSnapshotShouldDropTheLaterChanges(t *testing.T) {
+	t.Parallel()
+
+	roAccDb, _ := NewReadOnlyAccountsDB(&stateMock.AccountsStub{
+		GetExistingAccountCalled: func(_ []byte) (vmcommon.AccountHandler, error) {
+			return nil, state.ErrAccNotFound
+		},
+	}, &mock.HasherMock{})
+
+	firstAcc, _ := state.NewUserAccount([]byte("first"))
+	_ = firstAcc.AddToBalance(big.NewInt(10))
+	_ = roAccDb.SaveAccount(firstAcc)
+	snapshot := roAccDb.JournalLen()
+
+	secondAcc, _ := state.NewUserAccount([]byte("second"))
+	_ = secondAcc.AddToBalance(big.NewInt(10))
+	_ = roAccDb.SaveAccount(secondAcc)
+
+	err := roAccDb.RevertToSnapshot(snapshot + 1)
+	require.Nil(t, err)
+	err = roAccDb.RevertToSnapshot(snapshot + 2)
+	require.Equal(t, state.ErrSnapshotValueOutOfBounds, err)
+
+	err = roAccDb.RevertToSnapshot(snapshot)
+	require.Nil(t, err)
+
+	changes, err := roAccDb.GetAccountsChanges()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(changes))
+	require.Equal(t, []byte("first"), changes[0].Address)
+}
+
+func TestReadOnlyAccountsDB_SaveAccountShouldRecordTheDeployedCode(t *testing.T) {
+	t.Parallel()
+
+	hasher := &mock.HasherMock{}
+	originalCodeHash := []byte("original code hash")
+	contract := []byte("contract")
+	roAccDb, _ := NewReadOnlyAccountsDB(&stateMock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			return state.NewUserAccount(address)
+		},
+		GetCodeCalled: func(codeHash []byte) []byte {
+			if bytes.Equal(codeHash, originalCodeHash) {
+				return []byte("original code")
+			}
+			return nil
+		},
+	}, hasher)
+
+	code := []byte("deployed code")
+	codeHash := hasher.Compute(string(code))
+	contractAcc, _ := roAccDb.LoadAccount(contract)
+	contractAcc.(state.UserAccountHandler).SetCode(code)
+	_ = roAccDb.SaveAccount(contractAcc)
+	require.Equal(t, codeHash, contractAcc.(state.UserAccountHandler).GetCodeHash())
+
+	reloadedContractAcc, _ := roAccDb.LoadAccount(contract)
+	require.Equal(t, codeHash, reloadedContractAcc.(state.UserAccountHandler).GetCodeHash())
+	require.Equal(t, code, roAccDb.GetCode(codeHash))
+	require.Equal(t, []byte("original code"), roAccDb.GetCode(originalCodeHash))
+
+	roAccDb.CleanAccountsChanges()
+	require.Nil(t, roAccDb.GetCode(codeHash))
+}
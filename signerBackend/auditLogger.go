@@ -0,0 +1,92 @@
+package signerBackend
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"sync"
+)
+
+// maxAuditLineSize bounds the length of a line read from the audit log
+const maxAuditLineSize = 1024 * 1024
+
+var _ AuditLogger = (*auditLogger)(nil)
+
+type auditLogger struct {
+	mut    sync.Mutex
+	writer io.Writer
+}
+
+// NewAuditLogger creates an audit logger writing each entry as one JSON line
+func NewAuditLogger(writer io.Writer) (*auditLogger, error) {
+	if writer == nil {
+		return nil, ErrNilWriter
+	}
+
+	return &auditLogger{
+		writer: writer,
+	}, nil
+}
+
+// Log writes the entry to the audit log
+func (al *auditLogger) Log(entry *AuditEntry) error {
+	buff, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	al.mut.Lock()
+	defer al.mut.Unlock()
+
+	_, err = al.writer.Write(append(buff, '\n'))
+
+	return err
+}
+
+// Close closes the underlying writer, if it can be closed
+func (al *auditLogger) Close() error {
+	al.mut.Lock()
+	defer al.mut.Unlock()
+
+	closer, ok := al.writer.(io.Closer)
+	if !ok {
+		return nil
+	}
+
+	return closer.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (al *auditLogger) IsInterfaceNil() bool {
+	return al == nil
+}
+
+// ReadAuditLog reads all the entries of an audit log. A truncated last line, as left by a crash in the middle of a
+// write, is ignored
+func ReadAuditLog(reader io.Reader) ([]*AuditEntry, error) {
+	entries := make([]*AuditEntry, 0)
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 0, 4096), maxAuditLineSize)
+
+	var lastErr error
+	for scanner.Scan() {
+		if lastErr != nil {
+			return nil, lastErr
+		}
+
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+
+		entry := &AuditEntry{}
+		lastErr = json.Unmarshal(line, entry)
+		if lastErr != nil {
+			continue
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, scanner.Err()
+}
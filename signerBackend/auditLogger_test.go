@@ -0,0 +1,78 @@
+package signerBackend
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAuditLogger(t *testing.T) {
+	t.Parallel()
+
+	al, err := NewAuditLogger(nil)
+	assert.Equal(t, ErrNilWriter, err)
+	assert.True(t, check.IfNil(al))
+
+	al, err = NewAuditLogger(&bytes.Buffer{})
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(al))
+}
+
+func TestAuditLogger_LogAndRead(t *testing.T) {
+	t.Parallel()
+
+	buff := &bytes.Buffer{}
+	al, _ := NewAuditLogger(buff)
+
+	entries := []*AuditEntry{
+		{
+			Timestamp:   1,
+			PubKey:      "aa",
+			Type:        BlockHeaderSignType,
+			Round:       2,
+			MessageHash: "bb",
+			Signed:      true,
+		},
+		{
+			Timestamp:   3,
+			PubKey:      "aa",
+			Type:        BlockHeaderSignType,
+			Round:       2,
+			MessageHash: "cc",
+			Error:       ErrSlashableSignature.Error(),
+		},
+	}
+	for _, entry := range entries {
+		require.Nil(t, al.Log(entry))
+	}
+	assert.Equal(t, 2, bytes.Count(buff.Bytes(), []byte("\n")))
+
+	readEntries, err := ReadAuditLog(bytes.NewReader(buff.Bytes()))
+	require.Nil(t, err)
+	assert.Equal(t, entries, readEntries)
+}
+
+func TestReadAuditLog(t *testing.T) {
+	t.Parallel()
+
+	t.Run("truncated last line should be ignored", func(t *testing.T) {
+		t.Parallel()
+
+		content := "{\"pubKey\":\"aa\",\"round\":1,\"signed\":true}\n{\"pubKey\":\"aa\",\"rou"
+		entries, err := ReadAuditLog(bytes.NewBufferString(content))
+		require.Nil(t, err)
+		require.Equal(t, 1, len(entries))
+		assert.Equal(t, int64(1), entries[0].Round)
+	})
+	t.Run("corrupted line in the middle should error", func(t *testing.T) {
+		t.Parallel()
+
+		content := "{\"pubKey\":\"aa\",\"rou\n{\"pubKey\":\"aa\",\"round\":1,\"signed\":true}\n"
+		entries, err := ReadAuditLog(bytes.NewBufferString(content))
+		assert.NotNil(t, err)
+		assert.Nil(t, entries)
+	})
+}
@@ -0,0 +1,48 @@
+package signerBackend
+
+// SignType defines the kind of data a signer is asked to sign
+type SignType string
+
+const (
+	// BlockHeaderSignType is used for the leader signature of a block header
+	BlockHeaderSignType SignType = "blockHeader"
+	// RandSeedSignType is used for the randomness seed of a proposed block
+	RandSeedSignType SignType = "randSeed"
+	// ConsensusDataSignType is used for the signature share of a consensus group member
+	ConsensusDataSignType SignType = "consensusData"
+	// PeerIDSignType is used for the signature binding a BLS key to a p2p identity
+	PeerIDSignType SignType = "peerID"
+)
+
+// SignRequest is the request handled by the signer service
+type SignRequest struct {
+	PubKey  []byte
+	Type    SignType
+	Round   int64
+	Message []byte
+}
+
+// SignResponse is the response of a sign request
+type SignResponse struct {
+	Signature []byte
+}
+
+// PublicKeysRequest is the request for the public keys held by the signer service
+type PublicKeysRequest struct {
+}
+
+// PublicKeysResponse is the response of a public keys request
+type PublicKeysResponse struct {
+	PublicKeys [][]byte
+}
+
+// AuditEntry is one record of the audit log
+type AuditEntry struct {
+	Timestamp   int64    `json:"timestamp"`
+	PubKey      string   `json:"pubKey"`
+	Type        SignType `json:"type"`
+	Round       int64    `json:"round"`
+	MessageHash string   `json:"messageHash"`
+	Signed      bool     `json:"signed"`
+	Error       string   `json:"error,omitempty"`
+}
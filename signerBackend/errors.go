@@ -0,0 +1,57 @@
+package signerBackend
+
+import "errors"
+
+// ErrNilKeysHandler signals that a nil keys handler has been provided
+var ErrNilKeysHandler = errors.New("nil keys handler")
+
+// ErrNilSingleSigner signals that a nil single signer has been provided
+var ErrNilSingleSigner = errors.New("nil single signer")
+
+// ErrNilPeerSignatureHandler signals that a nil peer signature handler has been provided
+var ErrNilPeerSignatureHandler = errors.New("nil peer signature handler")
+
+// ErrNilSignerBackend signals that a nil signer backend has been provided
+var ErrNilSignerBackend = errors.New("nil signer backend")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilAuditLogger signals that a nil audit logger has been provided
+var ErrNilAuditLogger = errors.New("nil audit logger")
+
+// ErrNilWriter signals that a nil writer has been provided
+var ErrNilWriter = errors.New("nil writer")
+
+// ErrNilSignerService signals that a nil signer service has been provided
+var ErrNilSignerService = errors.New("nil signer service")
+
+// ErrNilPublicKey signals that a nil public key has been provided
+var ErrNilPublicKey = errors.New("nil public key")
+
+// ErrInvalidValue signals that an invalid value has been provided
+var ErrInvalidValue = errors.New("invalid value")
+
+// ErrEmptyNetwork signals that an empty network has been provided
+var ErrEmptyNetwork = errors.New("empty network")
+
+// ErrEmptyAddress signals that an empty address has been provided
+var ErrEmptyAddress = errors.New("empty address")
+
+// ErrUnknownSignType signals that the signer was asked to sign an unknown kind of data
+var ErrUnknownSignType = errors.New("unknown sign type")
+
+// ErrSlashableSignature signals that the requested signature would conflict with an already issued one
+var ErrSlashableSignature = errors.New("refused to sign: a different message was already signed for the same round")
+
+// ErrRoundTooOld signals that the requested round is older than the slashing protection history
+var ErrRoundTooOld = errors.New("refused to sign: round is older than the slashing protection history")
+
+// ErrRequestTimeout signals that the remote signer did not answer in time
+var ErrRequestTimeout = errors.New("remote signer request timeout")
+
+// ErrRemoteSigner signals that the remote signer refused or failed the request
+var ErrRemoteSigner = errors.New("remote signer error")
+
+// ErrPrivateKeyNotAvailable signals that the private key is held by the remote signer
+var ErrPrivateKeyNotAvailable = errors.New("private key is held by the remote signer")
@@ -0,0 +1,16 @@
+package signerBackend
+
+import "github.com/ElrondNetwork/elrond-go-crypto"
+
+// PrivateKeysHolder defines the subset of the managed keys holder used by the local signer backend
+type PrivateKeysHolder interface {
+	GetPrivateKey(pkBytes []byte) (crypto.PrivateKey, error)
+	ManagedKeys() [][]byte
+	IsInterfaceNil() bool
+}
+
+// AuditLogger records each signing request handled by the signer service
+type AuditLogger interface {
+	Log(entry *AuditEntry) error
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,86 @@
+package signerBackend
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+)
+
+var _ consensus.SignerBackend = (*localSignerBackend)(nil)
+
+// ArgsLocalSignerBackend is the DTO used to create a new local signer backend
+type ArgsLocalSignerBackend struct {
+	KeysHandler          PrivateKeysHolder
+	SingleSigner         crypto.SingleSigner
+	PeerSignatureHandler crypto.PeerSignatureHandler
+}
+
+type localSignerBackend struct {
+	keysHandler          PrivateKeysHolder
+	singleSigner         crypto.SingleSigner
+	peerSignatureHandler crypto.PeerSignatureHandler
+}
+
+// NewLocalSignerBackend creates a signer backend that signs with the private keys loaded by the current process
+func NewLocalSignerBackend(args ArgsLocalSignerBackend) (*localSignerBackend, error) {
+	if check.IfNil(args.KeysHandler) {
+		return nil, ErrNilKeysHandler
+	}
+	if check.IfNil(args.SingleSigner) {
+		return nil, ErrNilSingleSigner
+	}
+	if check.IfNil(args.PeerSignatureHandler) {
+		return nil, ErrNilPeerSignatureHandler
+	}
+
+	return &localSignerBackend{
+		keysHandler:          args.KeysHandler,
+		singleSigner:         args.SingleSigner,
+		peerSignatureHandler: args.PeerSignatureHandler,
+	}, nil
+}
+
+// SignBlockHeader signs the marshalized header with the provided key
+func (lsb *localSignerBackend) SignBlockHeader(pkBytes []byte, _ int64, marshalizedHeader []byte) ([]byte, error) {
+	return lsb.sign(pkBytes, marshalizedHeader)
+}
+
+// SignRandSeed signs the previous randomness seed with the provided key
+func (lsb *localSignerBackend) SignRandSeed(pkBytes []byte, _ int64, prevRandSeed []byte) ([]byte, error) {
+	return lsb.sign(pkBytes, prevRandSeed)
+}
+
+// SignConsensusData creates the signature share of the provided key. BLS signature shares are plain BLS signatures
+// so the single signer is used
+func (lsb *localSignerBackend) SignConsensusData(pkBytes []byte, _ int64, consensusData []byte) ([]byte, error) {
+	return lsb.sign(pkBytes, consensusData)
+}
+
+// SignPeerID signs the peer ID with the provided key
+func (lsb *localSignerBackend) SignPeerID(pkBytes []byte, pid []byte) ([]byte, error) {
+	privateKey, err := lsb.keysHandler.GetPrivateKey(pkBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return lsb.peerSignatureHandler.GetPeerSignature(privateKey, pid)
+}
+
+func (lsb *localSignerBackend) sign(pkBytes []byte, message []byte) ([]byte, error) {
+	privateKey, err := lsb.keysHandler.GetPrivateKey(pkBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return lsb.singleSigner.Sign(privateKey, message)
+}
+
+// PublicKeys returns the public keys this backend is able to sign with
+func (lsb *localSignerBackend) PublicKeys() ([][]byte, error) {
+	return lsb.keysHandler.ManagedKeys(), nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (lsb *localSignerBackend) IsInterfaceNil() bool {
+	return lsb == nil
+}
@@ -0,0 +1,130 @@
+package signerBackend
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go/testscommon/cryptoMocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsLocalSignerBackend() ArgsLocalSignerBackend {
+	return ArgsLocalSignerBackend{
+		KeysHandler:          &cryptoMocks.KeysHandlerStub{},
+		SingleSigner:         &cryptoMocks.SingleSignerStub{},
+		PeerSignatureHandler: &cryptoMocks.PeerSignatureHandlerStub{},
+	}
+}
+
+func TestNewLocalSignerBackend(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil keys handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsLocalSignerBackend()
+		args.KeysHandler = nil
+		lsb, err := NewLocalSignerBackend(args)
+		assert.Equal(t, ErrNilKeysHandler, err)
+		assert.True(t, check.IfNil(lsb))
+	})
+	t.Run("nil single signer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsLocalSignerBackend()
+		args.SingleSigner = nil
+		lsb, err := NewLocalSignerBackend(args)
+		assert.Equal(t, ErrNilSingleSigner, err)
+		assert.True(t, check.IfNil(lsb))
+	})
+	t.Run("nil peer signature handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsLocalSignerBackend()
+		args.PeerSignatureHandler = nil
+		lsb, err := NewLocalSignerBackend(args)
+		assert.Equal(t, ErrNilPeerSignatureHandler, err)
+		assert.True(t, check.IfNil(lsb))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		lsb, err := NewLocalSignerBackend(createMockArgsLocalSignerBackend())
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(lsb))
+	})
+}
+
+func TestLocalSignerBackend_SignShouldUseTheKeyOfThePublicKey(t *testing.T) {
+	t.Parallel()
+
+	pk := []byte("pk")
+	sk := &cryptoMocks.PrivateKeyStub{}
+	args := createMockArgsLocalSignerBackend()
+	args.KeysHandler = &cryptoMocks.KeysHandlerStub{
+		GetPrivateKeyCalled: func(pkBytes []byte) (crypto.PrivateKey, error) {
+			assert.Equal(t, pk, pkBytes)
+			return sk, nil
+		},
+		ManagedKeysCalled: func() [][]byte {
+			return [][]byte{pk}
+		},
+	}
+	args.SingleSigner = &cryptoMocks.SingleSignerStub{
+		SignCalled: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+			assert.True(t, private == sk)
+			return append([]byte("sig of "), msg...), nil
+		},
+	}
+	args.PeerSignatureHandler = &cryptoMocks.PeerSignatureHandlerStub{
+		GetPeerSignatureCalled: func(key crypto.PrivateKey, pid []byte) ([]byte, error) {
+			assert.True(t, key == sk)
+			return append([]byte("peer sig of "), pid...), nil
+		},
+	}
+	lsb, _ := NewLocalSignerBackend(args)
+
+	sig, err := lsb.SignBlockHeader(pk, 1, []byte("header"))
+	require.Nil(t, err)
+	assert.Equal(t, []byte("sig of header"), sig)
+
+	sig, err = lsb.SignRandSeed(pk, 1, []byte("seed"))
+	require.Nil(t, err)
+	assert.Equal(t, []byte("sig of seed"), sig)
+
+	sig, err = lsb.SignConsensusData(pk, 1, []byte("hash"))
+	require.Nil(t, err)
+	assert.Equal(t, []byte("sig of hash"), sig)
+
+	sig, err = lsb.SignPeerID(pk, []byte("pid"))
+	require.Nil(t, err)
+	assert.Equal(t, []byte("peer sig of pid"), sig)
+
+	publicKeys, err := lsb.PublicKeys()
+	require.Nil(t, err)
+	assert.Equal(t, [][]byte{pk}, publicKeys)
+}
+
+func TestLocalSignerBackend_SignMissingKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("missing key")
+	args := createMockArgsLocalSignerBackend()
+	args.KeysHandler = &cryptoMocks.KeysHandlerStub{
+		GetPrivateKeyCalled: func(pkBytes []byte) (crypto.PrivateKey, error) {
+			return nil, expectedErr
+		},
+	}
+	lsb, _ := NewLocalSignerBackend(args)
+
+	sig, err := lsb.SignConsensusData([]byte("pk"), 1, []byte("hash"))
+	assert.Equal(t, expectedErr, err)
+	assert.Nil(t, sig)
+
+	sig, err = lsb.SignPeerID([]byte("pk"), []byte("pid"))
+	assert.Equal(t, expectedErr, err)
+	assert.Nil(t, sig)
+}
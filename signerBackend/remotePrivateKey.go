@@ -0,0 +1,50 @@
+package signerBackend
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-crypto"
+)
+
+var _ crypto.PrivateKey = (*remotePrivateKey)(nil)
+
+// remotePrivateKey stands in for a private key held by the remote signer. It only gives access to the matching
+// public key, any attempt to use the private key material fails
+type remotePrivateKey struct {
+	publicKey crypto.PublicKey
+}
+
+// NewRemotePrivateKey creates the stand in of the private key matching the provided public key
+func NewRemotePrivateKey(publicKey crypto.PublicKey) (*remotePrivateKey, error) {
+	if check.IfNil(publicKey) {
+		return nil, ErrNilPublicKey
+	}
+
+	return &remotePrivateKey{
+		publicKey: publicKey,
+	}, nil
+}
+
+// ToByteArray returns an error as the private key is held by the remote signer
+func (rpk *remotePrivateKey) ToByteArray() ([]byte, error) {
+	return nil, ErrPrivateKeyNotAvailable
+}
+
+// GeneratePublic returns the public key matching the remote private key
+func (rpk *remotePrivateKey) GeneratePublic() crypto.PublicKey {
+	return rpk.publicKey
+}
+
+// Suite returns the suite of the matching public key
+func (rpk *remotePrivateKey) Suite() crypto.Suite {
+	return rpk.publicKey.Suite()
+}
+
+// Scalar returns nil as the private key is held by the remote signer
+func (rpk *remotePrivateKey) Scalar() crypto.Scalar {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rpk *remotePrivateKey) IsInterfaceNil() bool {
+	return rpk == nil
+}
@@ -0,0 +1,28 @@
+package signerBackend
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/testscommon/cryptoMocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRemotePrivateKey(t *testing.T) {
+	t.Parallel()
+
+	rpk, err := NewRemotePrivateKey(nil)
+	assert.Equal(t, ErrNilPublicKey, err)
+	assert.True(t, check.IfNil(rpk))
+
+	publicKey := &cryptoMocks.PublicKeyStub{}
+	rpk, err = NewRemotePrivateKey(publicKey)
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(rpk))
+	assert.True(t, rpk.GeneratePublic() == publicKey)
+	assert.Nil(t, rpk.Scalar())
+
+	skBytes, err := rpk.ToByteArray()
+	assert.Equal(t, ErrPrivateKeyNotAvailable, err)
+	assert.Nil(t, skBytes)
+}
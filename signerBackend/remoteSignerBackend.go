@@ -0,0 +1,219 @@
+package signerBackend
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/rpc"
+	"net/rpc/jsonrpc"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+)
+
+// maxCachedPeerSignatures bounds the number of peer signatures kept by the remote signer backend. Peer signatures
+// are deterministic and requested for each sent consensus message so they are not asked again to the remote signer
+const maxCachedPeerSignatures = 1000
+
+var _ consensus.SignerBackend = (*remoteSignerBackend)(nil)
+
+// ArgsRemoteSignerBackend is the DTO used to create a new remote signer backend
+type ArgsRemoteSignerBackend struct {
+	Network        string
+	Address        string
+	RequestTimeout time.Duration
+}
+
+type remoteSignerBackend struct {
+	mutClient      sync.Mutex
+	client         *rpc.Client
+	network        string
+	address        string
+	requestTimeout time.Duration
+
+	mutPeerSignatures sync.RWMutex
+	peerSignatures    map[string][]byte
+}
+
+// NewRemoteSignerBackend creates a signer backend forwarding the sign requests to a remote signer. The remote signer
+// has to be reachable at creation time
+func NewRemoteSignerBackend(args ArgsRemoteSignerBackend) (*remoteSignerBackend, error) {
+	if len(args.Network) == 0 {
+		return nil, ErrEmptyNetwork
+	}
+	if len(args.Address) == 0 {
+		return nil, ErrEmptyAddress
+	}
+	if args.RequestTimeout <= 0 {
+		return nil, fmt.Errorf("%w for request timeout", ErrInvalidValue)
+	}
+
+	rsb := &remoteSignerBackend{
+		network:        args.Network,
+		address:        args.Address,
+		requestTimeout: args.RequestTimeout,
+		peerSignatures: make(map[string][]byte),
+	}
+
+	_, err := rsb.getClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return rsb, nil
+}
+
+// SignBlockHeader asks the remote signer to sign the marshalized header
+func (rsb *remoteSignerBackend) SignBlockHeader(pkBytes []byte, round int64, marshalizedHeader []byte) ([]byte, error) {
+	return rsb.sign(pkBytes, BlockHeaderSignType, round, marshalizedHeader)
+}
+
+// SignRandSeed asks the remote signer to sign the previous randomness seed
+func (rsb *remoteSignerBackend) SignRandSeed(pkBytes []byte, round int64, prevRandSeed []byte) ([]byte, error) {
+	return rsb.sign(pkBytes, RandSeedSignType, round, prevRandSeed)
+}
+
+// SignConsensusData asks the remote signer for the signature share of the provided key
+func (rsb *remoteSignerBackend) SignConsensusData(pkBytes []byte, round int64, consensusData []byte) ([]byte, error) {
+	return rsb.sign(pkBytes, ConsensusDataSignType, round, consensusData)
+}
+
+// SignPeerID asks the remote signer to sign the peer ID
+func (rsb *remoteSignerBackend) SignPeerID(pkBytes []byte, pid []byte) ([]byte, error) {
+	cacheKey := string(pkBytes) + string(pid)
+
+	rsb.mutPeerSignatures.RLock()
+	signature, found := rsb.peerSignatures[cacheKey]
+	rsb.mutPeerSignatures.RUnlock()
+	if found {
+		return signature, nil
+	}
+
+	signature, err := rsb.sign(pkBytes, PeerIDSignType, 0, pid)
+	if err != nil {
+		return nil, err
+	}
+
+	rsb.mutPeerSignatures.Lock()
+	if len(rsb.peerSignatures) >= maxCachedPeerSignatures {
+		rsb.peerSignatures = make(map[string][]byte)
+	}
+	rsb.peerSignatures[cacheKey] = signature
+	rsb.mutPeerSignatures.Unlock()
+
+	return signature, nil
+}
+
+func (rsb *remoteSignerBackend) sign(pkBytes []byte, signType SignType, round int64, message []byte) ([]byte, error) {
+	request := &SignRequest{
+		PubKey:  pkBytes,
+		Type:    signType,
+		Round:   round,
+		Message: message,
+	}
+	response := &SignResponse{}
+
+	err := rsb.call(serviceName+".Sign", request, response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Signature, nil
+}
+
+// PublicKeys returns the public keys held by the remote signer
+func (rsb *remoteSignerBackend) PublicKeys() ([][]byte, error) {
+	response := &PublicKeysResponse{}
+	err := rsb.call(serviceName+".PublicKeys", &PublicKeysRequest{}, response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.PublicKeys, nil
+}
+
+func (rsb *remoteSignerBackend) call(method string, request interface{}, response interface{}) error {
+	client, err := rsb.getClient()
+	if err != nil {
+		return err
+	}
+
+	timer := time.NewTimer(rsb.requestTimeout)
+	defer timer.Stop()
+
+	call := client.Go(method, request, response, make(chan *rpc.Call, 1))
+	select {
+	case <-call.Done:
+		err = call.Error
+	case <-timer.C:
+		// the connection is dropped so that a late answer does not get mixed with the next requests
+		rsb.resetClient(client)
+		return ErrRequestTimeout
+	}
+
+	var serverErr rpc.ServerError
+	if errors.As(err, &serverErr) {
+		return fmt.Errorf("%w: %s", ErrRemoteSigner, serverErr.Error())
+	}
+	if err != nil {
+		// connection level errors: a new connection will be opened on the next request
+		rsb.resetClient(client)
+		return err
+	}
+
+	return nil
+}
+
+func (rsb *remoteSignerBackend) getClient() (*rpc.Client, error) {
+	rsb.mutClient.Lock()
+	defer rsb.mutClient.Unlock()
+
+	if rsb.client != nil {
+		return rsb.client, nil
+	}
+
+	conn, err := net.DialTimeout(rsb.network, rsb.address, rsb.requestTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("%w while connecting to the remote signer at %s", err, rsb.address)
+	}
+
+	rsb.client = jsonrpc.NewClient(conn)
+
+	return rsb.client, nil
+}
+
+func (rsb *remoteSignerBackend) resetClient(client *rpc.Client) {
+	rsb.mutClient.Lock()
+	defer rsb.mutClient.Unlock()
+
+	if rsb.client != client {
+		return
+	}
+
+	_ = rsb.client.Close()
+	rsb.client = nil
+}
+
+// Close closes the connection to the remote signer
+func (rsb *remoteSignerBackend) Close() error {
+	rsb.mutClient.Lock()
+	defer rsb.mutClient.Unlock()
+
+	if rsb.client == nil {
+		return nil
+	}
+
+	err := rsb.client.Close()
+	rsb.client = nil
+	if err == rpc.ErrShutdown {
+		return nil
+	}
+
+	return err
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rsb *remoteSignerBackend) IsInterfaceNil() bool {
+	return rsb == nil
+}
@@ -0,0 +1,219 @@
+package signerBackend
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/hashing/sha256"
+	"github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/mcl"
+	mclSig "github.com/ElrondNetwork/elrond-go-crypto/signing/mcl/singlesig"
+	"github.com/ElrondNetwork/elrond-go/keysManagement"
+	"github.com/ElrondNetwork/elrond-go/testscommon/cryptoMocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createSignerServer(t *testing.T, keyGen crypto.KeyGenerator, numKeys int) (*signerServer, [][]byte, *bytes.Buffer) {
+	privateKeys := make([][]byte, 0, numKeys)
+	for i := 0; i < numKeys; i++ {
+		sk, _ := keyGen.GeneratePair()
+		skBytes, _ := sk.ToByteArray()
+		privateKeys = append(privateKeys, skBytes)
+	}
+
+	keysHolder, err := keysManagement.NewManagedKeysHolder(keysManagement.ArgsManagedKeysHolder{
+		KeyGenerator: keyGen,
+		PrivateKeys:  privateKeys,
+	})
+	require.Nil(t, err)
+
+	singleSigner := &mclSig.BlsSingleSigner{}
+	backend, err := NewLocalSignerBackend(ArgsLocalSignerBackend{
+		KeysHandler:  keysHolder,
+		SingleSigner: singleSigner,
+		PeerSignatureHandler: &cryptoMocks.PeerSignatureHandlerStub{
+			GetPeerSignatureCalled: func(key crypto.PrivateKey, pid []byte) ([]byte, error) {
+				return singleSigner.Sign(key, pid)
+			},
+		},
+	})
+	require.Nil(t, err)
+
+	auditBuff := &bytes.Buffer{}
+	auditLogger, _ := NewAuditLogger(auditBuff)
+	service, err := NewSignerService(ArgsSignerService{
+		Backend:         backend,
+		Hasher:          sha256.NewSha256(),
+		AuditLogger:     auditLogger,
+		HistoryInRounds: 100,
+	})
+	require.Nil(t, err)
+
+	server, err := NewSignerServer(ArgsSignerServer{
+		Service: service,
+		Network: "tcp",
+		Address: "127.0.0.1:0",
+	})
+	require.Nil(t, err)
+
+	return server, keysHolder.ManagedKeys(), auditBuff
+}
+
+func TestNewRemoteSignerBackend(t *testing.T) {
+	t.Parallel()
+
+	args := ArgsRemoteSignerBackend{
+		Network:        "tcp",
+		Address:        "127.0.0.1:1",
+		RequestTimeout: time.Second,
+	}
+
+	t.Run("empty network should error", func(t *testing.T) {
+		t.Parallel()
+
+		argsCopy := args
+		argsCopy.Network = ""
+		rsb, err := NewRemoteSignerBackend(argsCopy)
+		assert.Equal(t, ErrEmptyNetwork, err)
+		assert.True(t, check.IfNil(rsb))
+	})
+	t.Run("empty address should error", func(t *testing.T) {
+		t.Parallel()
+
+		argsCopy := args
+		argsCopy.Address = ""
+		rsb, err := NewRemoteSignerBackend(argsCopy)
+		assert.Equal(t, ErrEmptyAddress, err)
+		assert.True(t, check.IfNil(rsb))
+	})
+	t.Run("invalid timeout should error", func(t *testing.T) {
+		t.Parallel()
+
+		argsCopy := args
+		argsCopy.RequestTimeout = 0
+		rsb, err := NewRemoteSignerBackend(argsCopy)
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+		assert.True(t, check.IfNil(rsb))
+	})
+	t.Run("unreachable remote signer should error", func(t *testing.T) {
+		t.Parallel()
+
+		rsb, err := NewRemoteSignerBackend(args)
+		assert.NotNil(t, err)
+		assert.True(t, check.IfNil(rsb))
+	})
+}
+
+func TestRemoteSignerBackend_SignThroughTheSignerServer(t *testing.T) {
+	t.Parallel()
+
+	keyGen := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	server, publicKeys, auditBuff := createSignerServer(t, keyGen, 2)
+	defer func() {
+		_ = server.Close()
+	}()
+
+	rsb, err := NewRemoteSignerBackend(ArgsRemoteSignerBackend{
+		Network:        "tcp",
+		Address:        server.Address(),
+		RequestTimeout: time.Second,
+	})
+	require.Nil(t, err)
+	defer func() {
+		_ = rsb.Close()
+	}()
+
+	remotePublicKeys, err := rsb.PublicKeys()
+	require.Nil(t, err)
+	assert.Equal(t, publicKeys, remotePublicKeys)
+
+	verifier := &mclSig.BlsSingleSigner{}
+	pk, _ := keyGen.PublicKeyFromByteArray(publicKeys[1])
+
+	sig, err := rsb.SignBlockHeader(publicKeys[1], 10, []byte("header"))
+	require.Nil(t, err)
+	assert.Nil(t, verifier.Verify(pk, []byte("header"), sig))
+
+	sig, err = rsb.SignRandSeed(publicKeys[1], 10, []byte("seed"))
+	require.Nil(t, err)
+	assert.Nil(t, verifier.Verify(pk, []byte("seed"), sig))
+
+	sig, err = rsb.SignConsensusData(publicKeys[1], 10, []byte("hash"))
+	require.Nil(t, err)
+	assert.Nil(t, verifier.Verify(pk, []byte("hash"), sig))
+
+	sig, err = rsb.SignPeerID(publicKeys[1], []byte("pid"))
+	require.Nil(t, err)
+	assert.Nil(t, verifier.Verify(pk, []byte("pid"), sig))
+
+	sig, err = rsb.SignBlockHeader(publicKeys[1], 10, []byte("another header"))
+	assert.True(t, errors.Is(err, ErrRemoteSigner))
+	assert.Nil(t, sig)
+
+	sig, err = rsb.SignConsensusData([]byte("unknown key"), 10, []byte("hash"))
+	assert.True(t, errors.Is(err, ErrRemoteSigner))
+	assert.Nil(t, sig)
+
+	entries, err := ReadAuditLog(bytes.NewReader(auditBuff.Bytes()))
+	require.Nil(t, err)
+	assert.Equal(t, 6, len(entries))
+}
+
+func TestRemoteSignerBackend_ShouldReconnect(t *testing.T) {
+	t.Parallel()
+
+	keyGen := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	server, publicKeys, _ := createSignerServer(t, keyGen, 1)
+	defer func() {
+		_ = server.Close()
+	}()
+
+	rsb, err := NewRemoteSignerBackend(ArgsRemoteSignerBackend{
+		Network:        "tcp",
+		Address:        server.Address(),
+		RequestTimeout: time.Second,
+	})
+	require.Nil(t, err)
+
+	// the connection is dropped, the next requests should open a new one
+	rsb.mutClient.Lock()
+	_ = rsb.client.Close()
+	rsb.mutClient.Unlock()
+
+	_, err = rsb.SignRandSeed(publicKeys[0], 1, []byte("seed"))
+	assert.NotNil(t, err)
+
+	_, err = rsb.SignRandSeed(publicKeys[0], 1, []byte("seed"))
+	assert.Nil(t, err)
+}
+
+func TestRemoteSignerBackend_SignPeerIDShouldCache(t *testing.T) {
+	t.Parallel()
+
+	keyGen := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	server, publicKeys, auditBuff := createSignerServer(t, keyGen, 1)
+	defer func() {
+		_ = server.Close()
+	}()
+
+	rsb, err := NewRemoteSignerBackend(ArgsRemoteSignerBackend{
+		Network:        "tcp",
+		Address:        server.Address(),
+		RequestTimeout: time.Second,
+	})
+	require.Nil(t, err)
+
+	sig1, err := rsb.SignPeerID(publicKeys[0], []byte("pid"))
+	require.Nil(t, err)
+	sig2, err := rsb.SignPeerID(publicKeys[0], []byte("pid"))
+	require.Nil(t, err)
+	assert.Equal(t, sig1, sig2)
+
+	entries, _ := ReadAuditLog(bytes.NewReader(auditBuff.Bytes()))
+	assert.Equal(t, 1, len(entries))
+}
@@ -0,0 +1,151 @@
+package signerBackend
+
+import (
+	"net"
+	"net/rpc"
+	"net/rpc/jsonrpc"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+)
+
+// serviceName is the name under which the signer service methods are exposed
+const serviceName = "Signer"
+
+const unixNetwork = "unix"
+
+const acceptRetryDelay = time.Millisecond * 100
+
+// ArgsSignerServer is the DTO used to create a new signer server
+type ArgsSignerServer struct {
+	Service *SignerService
+	Network string
+	Address string
+}
+
+type signerServer struct {
+	mut       sync.Mutex
+	listener  net.Listener
+	rpcServer *rpc.Server
+	conns     map[net.Conn]struct{}
+	closed    bool
+}
+
+// NewSignerServer creates a server exposing the signer service over JSON-RPC on the provided socket. It starts
+// accepting connections right away
+func NewSignerServer(args ArgsSignerServer) (*signerServer, error) {
+	if check.IfNil(args.Service) {
+		return nil, ErrNilSignerService
+	}
+	if len(args.Network) == 0 {
+		return nil, ErrEmptyNetwork
+	}
+	if len(args.Address) == 0 {
+		return nil, ErrEmptyAddress
+	}
+
+	rpcServer := rpc.NewServer()
+	err := rpcServer.RegisterName(serviceName, args.Service)
+	if err != nil {
+		return nil, err
+	}
+
+	if args.Network == unixNetwork {
+		// a socket file left behind by a previous run would make the listen call fail
+		_ = os.Remove(args.Address)
+	}
+
+	listener, err := net.Listen(args.Network, args.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	server := &signerServer{
+		listener:  listener,
+		rpcServer: rpcServer,
+		conns:     make(map[net.Conn]struct{}),
+	}
+	go server.acceptConnections()
+
+	log.Info("signer server started", "network", args.Network, "address", listener.Addr().String())
+
+	return server, nil
+}
+
+func (server *signerServer) acceptConnections() {
+	for {
+		conn, err := server.listener.Accept()
+		if err != nil {
+			if server.isClosed() {
+				return
+			}
+
+			log.Debug("signer server: accept", "error", err)
+			time.Sleep(acceptRetryDelay)
+			continue
+		}
+
+		if !server.addConn(conn) {
+			_ = conn.Close()
+			return
+		}
+
+		go server.serveConn(conn)
+	}
+}
+
+func (server *signerServer) serveConn(conn net.Conn) {
+	server.rpcServer.ServeCodec(jsonrpc.NewServerCodec(conn))
+
+	server.mut.Lock()
+	delete(server.conns, conn)
+	server.mut.Unlock()
+}
+
+func (server *signerServer) addConn(conn net.Conn) bool {
+	server.mut.Lock()
+	defer server.mut.Unlock()
+
+	if server.closed {
+		return false
+	}
+	server.conns[conn] = struct{}{}
+
+	return true
+}
+
+func (server *signerServer) isClosed() bool {
+	server.mut.Lock()
+	defer server.mut.Unlock()
+
+	return server.closed
+}
+
+// Address returns the address the server listens on
+func (server *signerServer) Address() string {
+	return server.listener.Addr().String()
+}
+
+// Close stops accepting new connections and closes the existing ones
+func (server *signerServer) Close() error {
+	server.mut.Lock()
+	defer server.mut.Unlock()
+
+	if server.closed {
+		return nil
+	}
+	server.closed = true
+
+	for conn := range server.conns {
+		_ = conn.Close()
+	}
+
+	return server.listener.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (server *signerServer) IsInterfaceNil() bool {
+	return server == nil
+}
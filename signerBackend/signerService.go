@@ -0,0 +1,176 @@
+package signerBackend
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+)
+
+var log = logger.GetOrCreate("signerBackend")
+
+// ArgsSignerService is the DTO used to create a new signer service
+type ArgsSignerService struct {
+	Backend         consensus.SignerBackend
+	Hasher          hashing.Hasher
+	AuditLogger     AuditLogger
+	HistoryInRounds int64
+	// PastEntries are the entries of a previous audit log, used to restore the slashing protection state
+	PastEntries []*AuditEntry
+}
+
+// SignerService is the service exposed by the remote signer. It guards the signer backend holding the keys with the
+// slashing protection rules and records each request in the audit log
+type SignerService struct {
+	mut         sync.Mutex
+	backend     consensus.SignerBackend
+	hasher      hashing.Hasher
+	auditLogger AuditLogger
+	protector   *slashingProtector
+}
+
+// NewSignerService creates a new signer service
+func NewSignerService(args ArgsSignerService) (*SignerService, error) {
+	if check.IfNil(args.Backend) {
+		return nil, ErrNilSignerBackend
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if check.IfNil(args.AuditLogger) {
+		return nil, ErrNilAuditLogger
+	}
+
+	protector, err := newSlashingProtector(args.HistoryInRounds)
+	if err != nil {
+		return nil, err
+	}
+
+	ss := &SignerService{
+		backend:     args.Backend,
+		hasher:      args.Hasher,
+		auditLogger: args.AuditLogger,
+		protector:   protector,
+	}
+
+	err = ss.restore(args.PastEntries)
+	if err != nil {
+		return nil, err
+	}
+
+	return ss, nil
+}
+
+func (ss *SignerService) restore(entries []*AuditEntry) error {
+	numRestored := 0
+	for _, entry := range entries {
+		if !entry.Signed {
+			continue
+		}
+
+		pkBytes, err := hex.DecodeString(entry.PubKey)
+		if err != nil {
+			return fmt.Errorf("%w while decoding the audit log public key %s", err, entry.PubKey)
+		}
+		messageHash, err := hex.DecodeString(entry.MessageHash)
+		if err != nil {
+			return fmt.Errorf("%w while decoding the audit log message hash %s", err, entry.MessageHash)
+		}
+
+		err = ss.protector.checkAndRecord(pkBytes, entry.Type, entry.Round, messageHash)
+		if err == ErrRoundTooOld {
+			continue
+		}
+		if err != nil {
+			log.Warn("conflicting signatures found in the audit log",
+				"pk", entry.PubKey, "type", entry.Type, "round", entry.Round)
+			continue
+		}
+		numRestored++
+	}
+
+	log.Debug("slashing protection state restored", "num entries", numRestored)
+
+	return nil
+}
+
+// Sign signs the requested message if this does not break the slashing protection rules
+func (ss *SignerService) Sign(request *SignRequest, response *SignResponse) error {
+	ss.mut.Lock()
+	defer ss.mut.Unlock()
+
+	messageHash := ss.hasher.Compute(string(request.Message))
+	signature, err := ss.sign(request, messageHash)
+
+	entry := &AuditEntry{
+		Timestamp:   time.Now().Unix(),
+		PubKey:      hex.EncodeToString(request.PubKey),
+		Type:        request.Type,
+		Round:       request.Round,
+		MessageHash: hex.EncodeToString(messageHash),
+		Signed:      err == nil,
+	}
+	if err != nil {
+		entry.Error = err.Error()
+		log.Debug("sign request refused", "pk", request.PubKey, "type", request.Type, "round", request.Round,
+			"error", err)
+	}
+
+	errLog := ss.auditLogger.Log(entry)
+	if errLog != nil {
+		log.Error("signer service: audit log", "error", errLog)
+		if err == nil {
+			// the signature can not be given away without the audit trail needed to restore the protection state
+			return errLog
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	response.Signature = signature
+
+	return nil
+}
+
+func (ss *SignerService) sign(request *SignRequest, messageHash []byte) ([]byte, error) {
+	err := ss.protector.checkAndRecord(request.PubKey, request.Type, request.Round, messageHash)
+	if err != nil {
+		return nil, err
+	}
+
+	switch request.Type {
+	case BlockHeaderSignType:
+		return ss.backend.SignBlockHeader(request.PubKey, request.Round, request.Message)
+	case RandSeedSignType:
+		return ss.backend.SignRandSeed(request.PubKey, request.Round, request.Message)
+	case ConsensusDataSignType:
+		return ss.backend.SignConsensusData(request.PubKey, request.Round, request.Message)
+	case PeerIDSignType:
+		return ss.backend.SignPeerID(request.PubKey, request.Message)
+	default:
+		return nil, fmt.Errorf("%w %s", ErrUnknownSignType, request.Type)
+	}
+}
+
+// PublicKeys returns the public keys the service is able to sign with
+func (ss *SignerService) PublicKeys(_ *PublicKeysRequest, response *PublicKeysResponse) error {
+	publicKeys, err := ss.backend.PublicKeys()
+	if err != nil {
+		return err
+	}
+
+	response.PublicKeys = publicKeys
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ss *SignerService) IsInterfaceNil() bool {
+	return ss == nil
+}
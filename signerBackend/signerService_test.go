@@ -0,0 +1,195 @@
+package signerBackend
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/hashing/sha256"
+	"github.com/ElrondNetwork/elrond-go/testscommon/cryptoMocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsSignerService() ArgsSignerService {
+	return ArgsSignerService{
+		Backend: &cryptoMocks.SignerBackendStub{
+			SignBlockHeaderCalled: func(pkBytes []byte, round int64, marshalizedHeader []byte) ([]byte, error) {
+				return append([]byte("header sig of "), marshalizedHeader...), nil
+			},
+			SignConsensusDataCalled: func(pkBytes []byte, round int64, consensusData []byte) ([]byte, error) {
+				return append([]byte("share of "), consensusData...), nil
+			},
+		},
+		Hasher:          sha256.NewSha256(),
+		AuditLogger:     &auditLoggerStub{},
+		HistoryInRounds: 100,
+	}
+}
+
+type auditLoggerStub struct {
+	entries []*AuditEntry
+	err     error
+}
+
+func (stub *auditLoggerStub) Log(entry *AuditEntry) error {
+	stub.entries = append(stub.entries, entry)
+	return stub.err
+}
+
+func (stub *auditLoggerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+func TestNewSignerService(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil backend should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSignerService()
+		args.Backend = nil
+		ss, err := NewSignerService(args)
+		assert.Equal(t, ErrNilSignerBackend, err)
+		assert.True(t, check.IfNil(ss))
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSignerService()
+		args.Hasher = nil
+		ss, err := NewSignerService(args)
+		assert.Equal(t, ErrNilHasher, err)
+		assert.True(t, check.IfNil(ss))
+	})
+	t.Run("nil audit logger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSignerService()
+		args.AuditLogger = nil
+		ss, err := NewSignerService(args)
+		assert.Equal(t, ErrNilAuditLogger, err)
+		assert.True(t, check.IfNil(ss))
+	})
+	t.Run("invalid history should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSignerService()
+		args.HistoryInRounds = 0
+		ss, err := NewSignerService(args)
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+		assert.True(t, check.IfNil(ss))
+	})
+	t.Run("invalid past entry should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSignerService()
+		args.PastEntries = []*AuditEntry{{PubKey: "not hex", Signed: true}}
+		ss, err := NewSignerService(args)
+		assert.NotNil(t, err)
+		assert.True(t, check.IfNil(ss))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		ss, err := NewSignerService(createMockArgsSignerService())
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(ss))
+	})
+}
+
+func TestSignerService_SignShouldApplySlashingProtectionAndAudit(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSignerService()
+	auditLogger := &auditLoggerStub{}
+	args.AuditLogger = auditLogger
+	ss, _ := NewSignerService(args)
+
+	request := &SignRequest{
+		PubKey:  []byte("pk"),
+		Type:    BlockHeaderSignType,
+		Round:   7,
+		Message: []byte("header 1"),
+	}
+	response := &SignResponse{}
+	err := ss.Sign(request, response)
+	require.Nil(t, err)
+	assert.Equal(t, []byte("header sig of header 1"), response.Signature)
+
+	request.Message = []byte("header 2")
+	response = &SignResponse{}
+	err = ss.Sign(request, response)
+	assert.Equal(t, ErrSlashableSignature, err)
+	assert.Nil(t, response.Signature)
+
+	require.Equal(t, 2, len(auditLogger.entries))
+	assert.True(t, auditLogger.entries[0].Signed)
+	assert.Equal(t, hex.EncodeToString([]byte("pk")), auditLogger.entries[0].PubKey)
+	assert.Equal(t, int64(7), auditLogger.entries[0].Round)
+	assert.False(t, auditLogger.entries[1].Signed)
+	assert.Equal(t, ErrSlashableSignature.Error(), auditLogger.entries[1].Error)
+}
+
+func TestSignerService_SignUnknownTypeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ss, _ := NewSignerService(createMockArgsSignerService())
+	err := ss.Sign(&SignRequest{Type: "unknown"}, &SignResponse{})
+	assert.True(t, errors.Is(err, ErrUnknownSignType))
+}
+
+func TestSignerService_SignShouldNotReturnSignatureIfAuditFails(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("disk full")
+	args := createMockArgsSignerService()
+	args.AuditLogger = &auditLoggerStub{err: expectedErr}
+	ss, _ := NewSignerService(args)
+
+	response := &SignResponse{}
+	err := ss.Sign(&SignRequest{Type: ConsensusDataSignType, Message: []byte("hash")}, response)
+	assert.Equal(t, expectedErr, err)
+	assert.Nil(t, response.Signature)
+}
+
+func TestSignerService_ShouldRestoreProtectionFromAuditLog(t *testing.T) {
+	t.Parallel()
+
+	buff := &bytes.Buffer{}
+	args := createMockArgsSignerService()
+	args.AuditLogger, _ = NewAuditLogger(buff)
+	ss, _ := NewSignerService(args)
+
+	err := ss.Sign(&SignRequest{PubKey: []byte("pk"), Type: ConsensusDataSignType, Round: 3, Message: []byte("hash 1")}, &SignResponse{})
+	require.Nil(t, err)
+
+	// the signer is restarted
+	args.PastEntries, err = ReadAuditLog(bytes.NewReader(buff.Bytes()))
+	require.Nil(t, err)
+	ss, _ = NewSignerService(args)
+
+	err = ss.Sign(&SignRequest{PubKey: []byte("pk"), Type: ConsensusDataSignType, Round: 3, Message: []byte("hash 2")}, &SignResponse{})
+	assert.Equal(t, ErrSlashableSignature, err)
+	err = ss.Sign(&SignRequest{PubKey: []byte("pk"), Type: ConsensusDataSignType, Round: 3, Message: []byte("hash 1")}, &SignResponse{})
+	assert.Nil(t, err)
+}
+
+func TestSignerService_PublicKeys(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSignerService()
+	args.Backend = &cryptoMocks.SignerBackendStub{
+		PublicKeysCalled: func() ([][]byte, error) {
+			return [][]byte{[]byte("pk1"), []byte("pk2")}, nil
+		},
+	}
+	ss, _ := NewSignerService(args)
+
+	response := &PublicKeysResponse{}
+	err := ss.PublicKeys(&PublicKeysRequest{}, response)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{[]byte("pk1"), []byte("pk2")}, response.PublicKeys)
+}
@@ -0,0 +1,80 @@
+package signerBackend
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+)
+
+const minHistoryInRounds = 1
+
+// slashingProtector remembers the hash of each slashable message signed by a key in a round and refuses to sign a
+// different one. Only the last historyInRounds rounds are remembered, older rounds are refused altogether as the
+// protector can not tell anymore what was signed for them
+type slashingProtector struct {
+	mut             sync.Mutex
+	historyInRounds int64
+	highestRound    int64
+	signedHashes    map[int64]map[string][]byte
+}
+
+func newSlashingProtector(historyInRounds int64) (*slashingProtector, error) {
+	if historyInRounds < minHistoryInRounds {
+		return nil, fmt.Errorf("%w for history in rounds, minimum is %d", ErrInvalidValue, minHistoryInRounds)
+	}
+
+	return &slashingProtector{
+		historyInRounds: historyInRounds,
+		signedHashes:    make(map[int64]map[string][]byte),
+	}, nil
+}
+
+// checkAndRecord returns an error if signing the message hash would be slashable, otherwise it remembers it
+func (sp *slashingProtector) checkAndRecord(pkBytes []byte, signType SignType, round int64, messageHash []byte) error {
+	if !isSlashable(signType) {
+		return nil
+	}
+
+	sp.mut.Lock()
+	defer sp.mut.Unlock()
+
+	if round <= sp.highestRound-sp.historyInRounds {
+		return ErrRoundTooOld
+	}
+
+	key := string(signType) + string(pkBytes)
+	hashesInRound, found := sp.signedHashes[round]
+	if !found {
+		hashesInRound = make(map[string][]byte)
+		sp.signedHashes[round] = hashesInRound
+	}
+
+	signedHash, found := hashesInRound[key]
+	if found && !bytes.Equal(signedHash, messageHash) {
+		return ErrSlashableSignature
+	}
+
+	hashesInRound[key] = messageHash
+	sp.advanceHighestRound(round)
+
+	return nil
+}
+
+func (sp *slashingProtector) advanceHighestRound(round int64) {
+	if round <= sp.highestRound {
+		return
+	}
+
+	sp.highestRound = round
+	for storedRound := range sp.signedHashes {
+		if storedRound <= sp.highestRound-sp.historyInRounds {
+			delete(sp.signedHashes, storedRound)
+		}
+	}
+}
+
+// isSlashable returns true for the signatures that would be slashable if issued twice, on different data,
+// in the same round
+func isSlashable(signType SignType) bool {
+	return signType == BlockHeaderSignType || signType == ConsensusDataSignType
+}
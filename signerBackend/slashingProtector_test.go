@@ -0,0 +1,75 @@
+package signerBackend
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSlashingProtector_InvalidHistoryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sp, err := newSlashingProtector(0)
+	assert.True(t, errors.Is(err, ErrInvalidValue))
+	assert.Nil(t, sp)
+}
+
+func TestSlashingProtector_CheckAndRecord(t *testing.T) {
+	t.Parallel()
+
+	pk := []byte("pk")
+	t.Run("same message in the same round should be signed again", func(t *testing.T) {
+		t.Parallel()
+
+		sp, _ := newSlashingProtector(10)
+		require.Nil(t, sp.checkAndRecord(pk, BlockHeaderSignType, 5, []byte("hash")))
+		assert.Nil(t, sp.checkAndRecord(pk, BlockHeaderSignType, 5, []byte("hash")))
+	})
+	t.Run("different header in the same round should be refused", func(t *testing.T) {
+		t.Parallel()
+
+		sp, _ := newSlashingProtector(10)
+		require.Nil(t, sp.checkAndRecord(pk, BlockHeaderSignType, 5, []byte("hash 1")))
+		assert.Equal(t, ErrSlashableSignature, sp.checkAndRecord(pk, BlockHeaderSignType, 5, []byte("hash 2")))
+		assert.Equal(t, ErrSlashableSignature, sp.checkAndRecord(pk, BlockHeaderSignType, 5, []byte("hash 3")))
+	})
+	t.Run("different consensus data in the same round should be refused", func(t *testing.T) {
+		t.Parallel()
+
+		sp, _ := newSlashingProtector(10)
+		require.Nil(t, sp.checkAndRecord(pk, ConsensusDataSignType, 5, []byte("hash 1")))
+		assert.Equal(t, ErrSlashableSignature, sp.checkAndRecord(pk, ConsensusDataSignType, 5, []byte("hash 2")))
+	})
+	t.Run("different keys, types or rounds should not conflict", func(t *testing.T) {
+		t.Parallel()
+
+		sp, _ := newSlashingProtector(10)
+		require.Nil(t, sp.checkAndRecord(pk, BlockHeaderSignType, 5, []byte("hash 1")))
+		assert.Nil(t, sp.checkAndRecord([]byte("pk 2"), BlockHeaderSignType, 5, []byte("hash 2")))
+		assert.Nil(t, sp.checkAndRecord(pk, ConsensusDataSignType, 5, []byte("hash 2")))
+		assert.Nil(t, sp.checkAndRecord(pk, BlockHeaderSignType, 6, []byte("hash 2")))
+	})
+	t.Run("not slashable types should always be signed", func(t *testing.T) {
+		t.Parallel()
+
+		sp, _ := newSlashingProtector(10)
+		require.Nil(t, sp.checkAndRecord(pk, RandSeedSignType, 5, []byte("hash 1")))
+		assert.Nil(t, sp.checkAndRecord(pk, RandSeedSignType, 5, []byte("hash 2")))
+		assert.Nil(t, sp.checkAndRecord(pk, PeerIDSignType, 0, []byte("hash 1")))
+		assert.Nil(t, sp.checkAndRecord(pk, PeerIDSignType, 0, []byte("hash 2")))
+	})
+	t.Run("rounds older than the history should be refused", func(t *testing.T) {
+		t.Parallel()
+
+		sp, _ := newSlashingProtector(10)
+		require.Nil(t, sp.checkAndRecord(pk, BlockHeaderSignType, 5, []byte("hash 1")))
+		require.Nil(t, sp.checkAndRecord(pk, BlockHeaderSignType, 20, []byte("hash 1")))
+
+		assert.Equal(t, ErrRoundTooOld, sp.checkAndRecord(pk, BlockHeaderSignType, 5, []byte("hash 2")))
+		assert.Equal(t, ErrRoundTooOld, sp.checkAndRecord(pk, BlockHeaderSignType, 10, []byte("hash 2")))
+		assert.Nil(t, sp.checkAndRecord(pk, BlockHeaderSignType, 11, []byte("hash 2")))
+		assert.Equal(t, 2, len(sp.signedHashes))
+	})
+}
@@ -63,6 +63,8 @@ type PeerAccountHandler interface {
 // like balance, developer rewards, owner
 type UserAccountHandler interface {
 	SetCode(code []byte)
+	GetCode() []byte
+	HasNewCode() bool
 	SetCodeMetadata(codeMetadata []byte)
 	GetCodeMetadata() []byte
 	SetCodeHash([]byte)
@@ -145,6 +147,7 @@ type baseAccountHandler interface {
 	IncreaseNonce(nonce uint64)
 	GetNonce() uint64
 	SetCode(code []byte)
+	GetCode() []byte
 	HasNewCode() bool
 	SetCodeMetadata(codeMetadata []byte)
 	GetCodeMetadata() []byte
@@ -153,6 +153,8 @@ func (sm *statusMetrics) EconomicsMetrics() map[string]interface{} {
 	economicsMetrics[common.MetricDevRewardsInEpoch] = sm.loadStringMetric(common.MetricDevRewardsInEpoch)
 	economicsMetrics[common.MetricInflation] = sm.loadStringMetric(common.MetricInflation)
 	economicsMetrics[common.MetricEpochForEconomicsData] = sm.loadUint64Metric(common.MetricEpochForEconomicsData)
+	economicsMetrics[common.MetricMinGasPrice] = sm.loadUint64Metric(common.MetricMinGasPrice)
+	economicsMetrics[common.MetricDynamicMinGasPrice] = sm.loadUint64Metric(common.MetricDynamicMinGasPrice)
 
 	return economicsMetrics
 }
@@ -213,6 +213,12 @@ func (psf *StorageServiceFactory) CreateForShard() (dataRetriever.StorageService
 	}
 	successfullyCreatedStorers = append(successfullyCreatedStorers, statusMetricsStorageUnit)
 
+	slashingEvidenceStorageUnit, err := psf.createSlashingEvidenceStorer()
+	if err != nil {
+		return nil, err
+	}
+	successfullyCreatedStorers = append(successfullyCreatedStorers, slashingEvidenceStorageUnit)
+
 	trieEpochRootHashStorageUnit, err := psf.createTrieEpochRootHashStorerIfNeeded()
 	if err != nil {
 		return nil, err
@@ -247,6 +253,7 @@ func (psf *StorageServiceFactory) CreateForShard() (dataRetriever.StorageService
 	store.AddStorer(dataRetriever.HeartbeatUnit, heartbeatStorageUnit)
 	store.AddStorer(dataRetriever.BootstrapUnit, bootstrapUnit)
 	store.AddStorer(dataRetriever.StatusMetricsUnit, statusMetricsStorageUnit)
+	store.AddStorer(dataRetriever.SlashingEvidenceUnit, slashingEvidenceStorageUnit)
 	store.AddStorer(dataRetriever.ReceiptsUnit, receiptsUnit)
 	store.AddStorer(dataRetriever.TrieEpochRootHashUnit, trieEpochRootHashStorageUnit)
 
@@ -365,6 +372,12 @@ func (psf *StorageServiceFactory) CreateForMeta() (dataRetriever.StorageService,
 	}
 	successfullyCreatedStorers = append(successfullyCreatedStorers, statusMetricsStorageUnit)
 
+	slashingEvidenceStorageUnit, err := psf.createSlashingEvidenceStorer()
+	if err != nil {
+		return nil, err
+	}
+	successfullyCreatedStorers = append(successfullyCreatedStorers, slashingEvidenceStorageUnit)
+
 	trieEpochRootHashStorageUnit, err := psf.createTrieEpochRootHashStorerIfNeeded()
 	if err != nil {
 		return nil, err
@@ -428,6 +441,7 @@ func (psf *StorageServiceFactory) CreateForMeta() (dataRetriever.StorageService,
 	store.AddStorer(dataRetriever.HeartbeatUnit, heartbeatStorageUnit)
 	store.AddStorer(dataRetriever.BootstrapUnit, bootstrapUnit)
 	store.AddStorer(dataRetriever.StatusMetricsUnit, statusMetricsStorageUnit)
+	store.AddStorer(dataRetriever.SlashingEvidenceUnit, slashingEvidenceStorageUnit)
 	store.AddStorer(dataRetriever.ReceiptsUnit, receiptsUnit)
 	store.AddStorer(dataRetriever.TrieEpochRootHashUnit, trieEpochRootHashStorageUnit)
 
@@ -533,6 +547,24 @@ func (psf *StorageServiceFactory) setupDbLookupExtensions(chainStorer *dataRetri
 	createdStorers = append(createdStorers, epochByHashUnit)
 	chainStorer.AddStorer(dataRetriever.EpochByHashUnit, epochByHashUnit)
 
+	if !psf.generalConfig.DbLookupExtensions.ESDTRegistryEnabled {
+		return createdStorers, nil
+	}
+
+	// Create the esdtRegistry (STATIC) storer
+	esdtRegistryConfig := psf.generalConfig.DbLookupExtensions.ESDTRegistryStorageConfig
+	esdtRegistryDbConfig := GetDBFromConfig(esdtRegistryConfig.DB)
+	esdtRegistryDbConfig.FilePath = psf.pathManager.PathForStatic(shardID, esdtRegistryConfig.DB.FilePath)
+	esdtRegistryCacherConfig := GetCacherFromConfig(esdtRegistryConfig.Cache)
+	esdtRegistryBloomFilter := GetBloomFromConfig(esdtRegistryConfig.Bloom)
+	esdtRegistryUnit, err := storageUnit.NewStorageUnitFromConf(esdtRegistryCacherConfig, esdtRegistryDbConfig, esdtRegistryBloomFilter)
+	if err != nil {
+		return createdStorers, err
+	}
+
+	createdStorers = append(createdStorers, esdtRegistryUnit)
+	chainStorer.AddStorer(dataRetriever.ESDTRegistryUnit, esdtRegistryUnit)
+
 	return createdStorers, nil
 }
 
@@ -563,6 +595,17 @@ func (psf *StorageServiceFactory) createPruningStorerArgs(storageConfig config.S
 	return args
 }
 
+func (psf *StorageServiceFactory) createSlashingEvidenceStorer() (storage.Storer, error) {
+	slashingEvidenceDbConfig := GetDBFromConfig(psf.generalConfig.SlashingEvidenceStorage.DB)
+	shardId := core.GetShardIDString(psf.shardCoordinator.SelfId())
+	slashingEvidenceDbConfig.FilePath = psf.pathManager.PathForStatic(shardId, psf.generalConfig.SlashingEvidenceStorage.DB.FilePath)
+
+	return storageUnit.NewStorageUnitFromConf(
+		GetCacherFromConfig(psf.generalConfig.SlashingEvidenceStorage.Cache),
+		slashingEvidenceDbConfig,
+		GetBloomFromConfig(psf.generalConfig.SlashingEvidenceStorage.Bloom))
+}
+
 func (psf *StorageServiceFactory) createTrieEpochRootHashStorerIfNeeded() (storage.Storer, error) {
 	if !psf.createTrieEpochRootHashStorer {
 		return storageUnit.NewNilStorer(), nil
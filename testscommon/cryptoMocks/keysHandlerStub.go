@@ -0,0 +1,95 @@
+package cryptoMocks
+
+import (
+	"github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+)
+
+// KeysHandlerStub -
+type KeysHandlerStub struct {
+	GetPrivateKeyCalled                   func(pkBytes []byte) (crypto.PrivateKey, error)
+	IsKeyManagedByCurrentNodeCalled       func(pkBytes []byte) bool
+	ManagedKeysCalled                     func() [][]byte
+	IncrementRoundsInConsensusGroupCalled func(pkBytes []byte)
+	IncrementRoundsAsLeaderCalled         func(pkBytes []byte)
+	IncrementBlocksProposedCalled         func(pkBytes []byte)
+	IncrementSignaturesSentCalled         func(pkBytes []byte)
+	IncrementHeartbeatsSentCalled         func(pkBytes []byte)
+	ManagedKeysMetricsCalled              func() []*consensus.ManagedKeyMetrics
+}
+
+// GetPrivateKey -
+func (stub *KeysHandlerStub) GetPrivateKey(pkBytes []byte) (crypto.PrivateKey, error) {
+	if stub.GetPrivateKeyCalled != nil {
+		return stub.GetPrivateKeyCalled(pkBytes)
+	}
+
+	return nil, nil
+}
+
+// IsKeyManagedByCurrentNode -
+func (stub *KeysHandlerStub) IsKeyManagedByCurrentNode(pkBytes []byte) bool {
+	if stub.IsKeyManagedByCurrentNodeCalled != nil {
+		return stub.IsKeyManagedByCurrentNodeCalled(pkBytes)
+	}
+
+	return false
+}
+
+// ManagedKeys -
+func (stub *KeysHandlerStub) ManagedKeys() [][]byte {
+	if stub.ManagedKeysCalled != nil {
+		return stub.ManagedKeysCalled()
+	}
+
+	return nil
+}
+
+// IncrementRoundsInConsensusGroup -
+func (stub *KeysHandlerStub) IncrementRoundsInConsensusGroup(pkBytes []byte) {
+	if stub.IncrementRoundsInConsensusGroupCalled != nil {
+		stub.IncrementRoundsInConsensusGroupCalled(pkBytes)
+	}
+}
+
+// IncrementRoundsAsLeader -
+func (stub *KeysHandlerStub) IncrementRoundsAsLeader(pkBytes []byte) {
+	if stub.IncrementRoundsAsLeaderCalled != nil {
+		stub.IncrementRoundsAsLeaderCalled(pkBytes)
+	}
+}
+
+// IncrementBlocksProposed -
+func (stub *KeysHandlerStub) IncrementBlocksProposed(pkBytes []byte) {
+	if stub.IncrementBlocksProposedCalled != nil {
+		stub.IncrementBlocksProposedCalled(pkBytes)
+	}
+}
+
+// IncrementSignaturesSent -
+func (stub *KeysHandlerStub) IncrementSignaturesSent(pkBytes []byte) {
+	if stub.IncrementSignaturesSentCalled != nil {
+		stub.IncrementSignaturesSentCalled(pkBytes)
+	}
+}
+
+// IncrementHeartbeatsSent -
+func (stub *KeysHandlerStub) IncrementHeartbeatsSent(pkBytes []byte) {
+	if stub.IncrementHeartbeatsSentCalled != nil {
+		stub.IncrementHeartbeatsSentCalled(pkBytes)
+	}
+}
+
+// ManagedKeysMetrics -
+func (stub *KeysHandlerStub) ManagedKeysMetrics() []*consensus.ManagedKeyMetrics {
+	if stub.ManagedKeysMetricsCalled != nil {
+		return stub.ManagedKeysMetricsCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *KeysHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
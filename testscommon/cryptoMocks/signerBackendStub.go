@@ -0,0 +1,60 @@
+package cryptoMocks
+
+// SignerBackendStub -
+type SignerBackendStub struct {
+	SignBlockHeaderCalled   func(pkBytes []byte, round int64, marshalizedHeader []byte) ([]byte, error)
+	SignRandSeedCalled      func(pkBytes []byte, round int64, prevRandSeed []byte) ([]byte, error)
+	SignConsensusDataCalled func(pkBytes []byte, round int64, consensusData []byte) ([]byte, error)
+	SignPeerIDCalled        func(pkBytes []byte, pid []byte) ([]byte, error)
+	PublicKeysCalled        func() ([][]byte, error)
+}
+
+// SignBlockHeader -
+func (stub *SignerBackendStub) SignBlockHeader(pkBytes []byte, round int64, marshalizedHeader []byte) ([]byte, error) {
+	if stub.SignBlockHeaderCalled != nil {
+		return stub.SignBlockHeaderCalled(pkBytes, round, marshalizedHeader)
+	}
+
+	return nil, nil
+}
+
+// SignRandSeed -
+func (stub *SignerBackendStub) SignRandSeed(pkBytes []byte, round int64, prevRandSeed []byte) ([]byte, error) {
+	if stub.SignRandSeedCalled != nil {
+		return stub.SignRandSeedCalled(pkBytes, round, prevRandSeed)
+	}
+
+	return nil, nil
+}
+
+// SignConsensusData -
+func (stub *SignerBackendStub) SignConsensusData(pkBytes []byte, round int64, consensusData []byte) ([]byte, error) {
+	if stub.SignConsensusDataCalled != nil {
+		return stub.SignConsensusDataCalled(pkBytes, round, consensusData)
+	}
+
+	return nil, nil
+}
+
+// SignPeerID -
+func (stub *SignerBackendStub) SignPeerID(pkBytes []byte, pid []byte) ([]byte, error) {
+	if stub.SignPeerIDCalled != nil {
+		return stub.SignPeerIDCalled(pkBytes, pid)
+	}
+
+	return nil, nil
+}
+
+// PublicKeys -
+func (stub *SignerBackendStub) PublicKeys() ([][]byte, error) {
+	if stub.PublicKeysCalled != nil {
+		return stub.PublicKeysCalled()
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (stub *SignerBackendStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -5,15 +5,18 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/dblookupext/esdtRegistry"
 )
 
 // HistoryRepositoryStub -
 type HistoryRepositoryStub struct {
-	RecordBlockCalled                  func(blockHeaderHash []byte, blockHeader data.HeaderHandler, blockBody data.BodyHandler, scrsPool map[string]data.TransactionHandler, receipts map[string]data.TransactionHandler) error
+	RecordBlockCalled                  func(blockHeaderHash []byte, blockHeader data.HeaderHandler, blockBody data.BodyHandler, scrsPool map[string]data.TransactionHandler, receipts map[string]data.TransactionHandler, logs map[string]data.LogHandler) error
 	OnNotarizedBlocksCalled            func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte)
 	GetMiniblockMetadataByTxHashCalled func(hash []byte) (*dblookupext.MiniblockMetadata, error)
 	GetEpochByHashCalled               func(hash []byte) (uint32, error)
 	GetEventsHashesByTxHashCalled      func(hash []byte, epoch uint32) (*dblookupext.ResultsHashesByTxHash, error)
+	GetESDTSupplyCalled                func(token string) (*esdtRegistry.TokenSupply, error)
+	GetESDTHoldersCalled               func(token string, offset uint64, limit uint64) ([]*esdtRegistry.TokenHolder, error)
 	IsEnabledCalled                    func() bool
 }
 
@@ -24,9 +27,10 @@ func (hp *HistoryRepositoryStub) RecordBlock(
 	blockBody data.BodyHandler,
 	scrsPool map[string]data.TransactionHandler,
 	receipts map[string]data.TransactionHandler,
+	logs map[string]data.LogHandler,
 ) error {
 	if hp.RecordBlockCalled != nil {
-		return hp.RecordBlockCalled(blockHeaderHash, blockHeader, blockBody, scrsPool, receipts)
+		return hp.RecordBlockCalled(blockHeaderHash, blockHeader, blockBody, scrsPool, receipts, logs)
 	}
 	return nil
 }
@@ -67,6 +71,22 @@ func (hp *HistoryRepositoryStub) GetResultsHashesByTxHash(hash []byte, epoch uin
 	return nil, nil
 }
 
+// GetESDTSupply -
+func (hp *HistoryRepositoryStub) GetESDTSupply(token string) (*esdtRegistry.TokenSupply, error) {
+	if hp.GetESDTSupplyCalled != nil {
+		return hp.GetESDTSupplyCalled(token)
+	}
+	return nil, nil
+}
+
+// GetESDTHolders -
+func (hp *HistoryRepositoryStub) GetESDTHolders(token string, offset uint64, limit uint64) ([]*esdtRegistry.TokenHolder, error) {
+	if hp.GetESDTHoldersCalled != nil {
+		return hp.GetESDTHoldersCalled(token, offset, limit)
+	}
+	return nil, nil
+}
+
 // IsInterfaceNil -
 func (hp *HistoryRepositoryStub) IsInterfaceNil() bool {
 	return hp == nil
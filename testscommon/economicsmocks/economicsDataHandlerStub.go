@@ -34,6 +34,10 @@ type EconomicsHandlerStub struct {
 	ComputeGasUsedAndFeeBasedOnRefundValueCalled func(tx data.TransactionWithFeeHandler, refundValue *big.Int) (uint64, *big.Int)
 	ComputeTxFeeBasedOnGasUsedCalled             func(tx data.TransactionWithFeeHandler, gasUsed uint64) *big.Int
 	ComputeGasLimitBasedOnBalanceCalled          func(tx data.TransactionWithFeeHandler, balance *big.Int) (uint64, error)
+	IsDynamicMinGasPriceEnabledCalled            func() bool
+	ComputeDynamicMinGasPriceCalled              func(previousPrice uint64, gasUsed uint64) uint64
+	SetDynamicMinGasPriceCalled                  func(price uint64)
+	DynamicMinGasPriceCalled                     func() uint64
 }
 
 // ComputeFeeForProcessing -
@@ -256,6 +260,37 @@ func (e *EconomicsHandlerStub) ComputeTxFeeBasedOnGasUsed(tx data.TransactionWit
 	return nil
 }
 
+// IsDynamicMinGasPriceEnabled -
+func (e *EconomicsHandlerStub) IsDynamicMinGasPriceEnabled() bool {
+	if e.IsDynamicMinGasPriceEnabledCalled != nil {
+		return e.IsDynamicMinGasPriceEnabledCalled()
+	}
+	return false
+}
+
+// ComputeDynamicMinGasPrice -
+func (e *EconomicsHandlerStub) ComputeDynamicMinGasPrice(previousPrice uint64, gasUsed uint64) uint64 {
+	if e.ComputeDynamicMinGasPriceCalled != nil {
+		return e.ComputeDynamicMinGasPriceCalled(previousPrice, gasUsed)
+	}
+	return 0
+}
+
+// SetDynamicMinGasPrice -
+func (e *EconomicsHandlerStub) SetDynamicMinGasPrice(price uint64) {
+	if e.SetDynamicMinGasPriceCalled != nil {
+		e.SetDynamicMinGasPriceCalled(price)
+	}
+}
+
+// DynamicMinGasPrice -
+func (e *EconomicsHandlerStub) DynamicMinGasPrice() uint64 {
+	if e.DynamicMinGasPriceCalled != nil {
+		return e.DynamicMinGasPriceCalled()
+	}
+	return 0
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (e *EconomicsHandlerStub) IsInterfaceNil() bool {
 	return e == nil
@@ -36,6 +36,10 @@ type EconomicsHandlerMock struct {
 	ComputeGasUsedAndFeeBasedOnRefundValueCalled func(tx data.TransactionWithFeeHandler, refundValue *big.Int) (uint64, *big.Int)
 	ComputeTxFeeBasedOnGasUsedCalled             func(tx data.TransactionWithFeeHandler, gasUsed uint64) *big.Int
 	ComputeGasLimitBasedOnBalanceCalled          func(tx data.TransactionWithFeeHandler, balance *big.Int) (uint64, error)
+	IsDynamicMinGasPriceEnabledCalled            func() bool
+	ComputeDynamicMinGasPriceCalled              func(previousPrice uint64, gasUsed uint64) uint64
+	SetDynamicMinGasPriceCalled                  func(price uint64)
+	DynamicMinGasPriceCalled                     func() uint64
 }
 
 // LeaderPercentage -
@@ -240,6 +244,37 @@ func (ehm *EconomicsHandlerMock) ComputeTxFeeBasedOnGasUsed(tx data.TransactionW
 	return big.NewInt(0)
 }
 
+// IsDynamicMinGasPriceEnabled -
+func (ehm *EconomicsHandlerMock) IsDynamicMinGasPriceEnabled() bool {
+	if ehm.IsDynamicMinGasPriceEnabledCalled != nil {
+		return ehm.IsDynamicMinGasPriceEnabledCalled()
+	}
+	return false
+}
+
+// ComputeDynamicMinGasPrice -
+func (ehm *EconomicsHandlerMock) ComputeDynamicMinGasPrice(previousPrice uint64, gasUsed uint64) uint64 {
+	if ehm.ComputeDynamicMinGasPriceCalled != nil {
+		return ehm.ComputeDynamicMinGasPriceCalled(previousPrice, gasUsed)
+	}
+	return 0
+}
+
+// SetDynamicMinGasPrice -
+func (ehm *EconomicsHandlerMock) SetDynamicMinGasPrice(price uint64) {
+	if ehm.SetDynamicMinGasPriceCalled != nil {
+		ehm.SetDynamicMinGasPriceCalled(price)
+	}
+}
+
+// DynamicMinGasPrice -
+func (ehm *EconomicsHandlerMock) DynamicMinGasPrice() uint64 {
+	if ehm.DynamicMinGasPriceCalled != nil {
+		return ehm.DynamicMinGasPriceCalled()
+	}
+	return 0
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ehm *EconomicsHandlerMock) IsInterfaceNil() bool {
 	return ehm == nil
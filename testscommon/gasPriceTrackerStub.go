@@ -0,0 +1,18 @@
+package testscommon
+
+// GasPriceTrackerStub -
+type GasPriceTrackerStub struct {
+	AddBlockGasPricesCalled func(gasUsed uint64, gasPrices []uint64)
+}
+
+// AddBlockGasPrices -
+func (stub *GasPriceTrackerStub) AddBlockGasPrices(gasUsed uint64, gasPrices []uint64) {
+	if stub.AddBlockGasPricesCalled != nil {
+		stub.AddBlockGasPricesCalled(gasUsed, gasPrices)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *GasPriceTrackerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -40,6 +40,13 @@ func GetGeneralConfig() config.Config {
 		},
 		Consensus: config.ConsensusConfig{
 			Type: "bls",
+			SlashingDetector: config.SlashingDetectorConfig{
+				NumRoundsToKeep:    10,
+				EvidenceTxGasLimit: 50000000,
+			},
+			SignerBackend: config.SignerBackendConfig{
+				Type: "local",
+			},
 		},
 		ValidatorStatistics: config.ValidatorStatisticsConfig{
 			CacheRefreshIntervalInSec: uint32(100),
@@ -253,6 +260,16 @@ func GetGeneralConfig() config.Config {
 				MaxOpenFiles:      10,
 			},
 		},
+		SlashingEvidenceStorage: config.StorageConfig{
+			Cache: getLRUCacheConfig(),
+			DB: config.DBConfig{
+				FilePath:          AddTimestampSuffix("SlashingEvidenceStorageDB"),
+				Type:              string(storageUnit.MemoryDB),
+				BatchDelaySeconds: 30,
+				MaxBatchSize:      6,
+				MaxOpenFiles:      10,
+			},
+		},
 		SmartContractsStorage: config.StorageConfig{
 			Cache: getLRUCacheConfig(),
 			DB: config.DBConfig{
@@ -356,6 +373,17 @@ func GetGeneralConfig() config.Config {
 			Capacity: 10000,
 			Name:     "VMOutputCacher",
 		},
+		GasPriceEstimator: config.GasPriceEstimatorConfig{
+			NumBlocks:                  20,
+			SlowPercentile:             30,
+			NormalPercentile:           60,
+			FastPercentile:             90,
+			FullBlockGasUsedPercentage: 0.8,
+		},
+		TxBundleSimulator: config.TxBundleSimulatorConfig{
+			MaxBundleSize:        20,
+			MaxContinuationSteps: 50,
+		},
 	}
 }
 
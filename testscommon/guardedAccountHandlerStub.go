@@ -0,0 +1,33 @@
+package testscommon
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+// GuardedAccountHandlerStub -
+type GuardedAccountHandlerStub struct {
+	GetActiveGuardianCalled       func(uah vmcommon.UserAccountHandler) ([]byte, error)
+	CheckGuardedTransactionCalled func(uah vmcommon.UserAccountHandler, tx *transaction.Transaction) error
+}
+
+// GetActiveGuardian -
+func (gahs *GuardedAccountHandlerStub) GetActiveGuardian(uah vmcommon.UserAccountHandler) ([]byte, error) {
+	if gahs.GetActiveGuardianCalled != nil {
+		return gahs.GetActiveGuardianCalled(uah)
+	}
+	return nil, nil
+}
+
+// CheckGuardedTransaction -
+func (gahs *GuardedAccountHandlerStub) CheckGuardedTransaction(uah vmcommon.UserAccountHandler, tx *transaction.Transaction) error {
+	if gahs.CheckGuardedTransactionCalled != nil {
+		return gahs.CheckGuardedTransactionCalled(uah, tx)
+	}
+	return nil
+}
+
+// IsInterfaceNil -
+func (gahs *GuardedAccountHandlerStub) IsInterfaceNil() bool {
+	return gahs == nil
+}
@@ -109,6 +109,11 @@ func (awm *AccountWrapMock) SetCode(code []byte) {
 	awm.code = code
 }
 
+// GetCode -
+func (awm *AccountWrapMock) GetCode() []byte {
+	return awm.code
+}
+
 // RetrieveValueFromDataTrieTracker -
 func (awm *AccountWrapMock) RetrieveValueFromDataTrieTracker(key []byte) ([]byte, error) {
 	return awm.trackableDataTrie.RetrieveValue(key)
@@ -98,6 +98,11 @@ func (u *UserAccountStub) SetCode(_ []byte) {
 
 }
 
+// GetCode -
+func (u *UserAccountStub) GetCode() []byte {
+	return nil
+}
+
 // SetCodeMetadata -
 func (u *UserAccountStub) SetCodeMetadata(_ []byte) {
 }
@@ -0,0 +1,49 @@
+package testscommon
+
+import (
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+// VMTracerStub -
+type VMTracerStub struct {
+	StartTracingCalled     func()
+	StopTracingCalled      func() []*tracing.ExecutionTrace
+	TraceStorageReadCalled func(address []byte, key []byte, value []byte)
+	TraceExecutionCalled   func(vmInput *vmcommon.ContractCallInput, vmOutput *vmcommon.VMOutput, isBuiltInFunction bool, executionErr error)
+}
+
+// StartTracing -
+func (vts *VMTracerStub) StartTracing() {
+	if vts.StartTracingCalled != nil {
+		vts.StartTracingCalled()
+	}
+}
+
+// StopTracing -
+func (vts *VMTracerStub) StopTracing() []*tracing.ExecutionTrace {
+	if vts.StopTracingCalled != nil {
+		return vts.StopTracingCalled()
+	}
+
+	return nil
+}
+
+// TraceStorageRead -
+func (vts *VMTracerStub) TraceStorageRead(address []byte, key []byte, value []byte) {
+	if vts.TraceStorageReadCalled != nil {
+		vts.TraceStorageReadCalled(address, key, value)
+	}
+}
+
+// TraceExecution -
+func (vts *VMTracerStub) TraceExecution(vmInput *vmcommon.ContractCallInput, vmOutput *vmcommon.VMOutput, isBuiltInFunction bool, executionErr error) {
+	if vts.TraceExecutionCalled != nil {
+		vts.TraceExecutionCalled(vmInput, vmOutput, isBuiltInFunction, executionErr)
+	}
+}
+
+// IsInterfaceNil -
+func (vts *VMTracerStub) IsInterfaceNil() bool {
+	return vts == nil
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/dataValidators"
 	"github.com/ElrondNetwork/elrond-go/process/factory"
+	"github.com/ElrondNetwork/elrond-go/process/guardian"
 	"github.com/ElrondNetwork/elrond-go/process/interceptors"
 	interceptorFactory "github.com/ElrondNetwork/elrond-go/process/interceptors/factory"
 	"github.com/ElrondNetwork/elrond-go/process/interceptors/processor"
@@ -488,11 +489,20 @@ func (ficf *fullSyncInterceptorsContainerFactory) generateTxInterceptors() error
 }
 
 func (ficf *fullSyncInterceptorsContainerFactory) createOneTxInterceptor(topic string) (process.Interceptor, error) {
+	guardedAccounts, err := guardian.NewGuardedAccount(
+		ficf.argInterceptorFactory.CoreComponents.InternalMarshalizer(),
+		ficf.argInterceptorFactory.CoreComponents.EpochNotifier(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	txValidator, err := dataValidators.NewTxValidator(
 		ficf.accounts,
 		ficf.shardCoordinator,
 		ficf.whiteListHandler,
 		ficf.addressPubkeyConv,
+		guardedAccounts,
 		ficf.maxTxNonceDeltaAllowed,
 	)
 	if err != nil {
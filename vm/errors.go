@@ -247,3 +247,6 @@ var ErrProposalNotFound = errors.New("proposal was not found in storage")
 
 // ErrInvalidNumOfInitialWhiteListedAddress signals that 0 initial whiteListed addresses were provided to the governance contract
 var ErrInvalidNumOfInitialWhiteListedAddress = errors.New("0 initial whiteListed addresses provided to the governance contract")
+
+// ErrInsufficientMultisigFunds signals that the multisig account does not hold enough funds for the requested transfer
+var ErrInsufficientMultisigFunds = errors.New("insufficient funds in the multisig account")
@@ -240,6 +240,7 @@ func (scf *systemSCFactory) createGovernanceContract() (vm.SystemSmartContract,
 		GovernanceSCAddress:         vm.GovernanceSCAddress,
 		DelegationMgrSCAddress:      vm.DelegationManagerSCAddress,
 		ValidatorSCAddress:          vm.ValidatorSCAddress,
+		EndOfEpochAddress:           vm.EndOfEpochAddress,
 		EpochNotifier:               scf.epochNotifier,
 		EpochConfig:                 *scf.epochConfig,
 		InitialWhiteListedAddresses: [][]byte{firstWhitelistAddress},
@@ -262,6 +263,7 @@ func (scf *systemSCFactory) createDelegationContract() (vm.SystemSmartContract,
 		EpochNotifier:          scf.epochNotifier,
 		EndOfEpochAddress:      vm.EndOfEpochAddress,
 		GovernanceSCAddress:    vm.GovernanceSCAddress,
+		ESDTSCAddress:          vm.ESDTSCAddress,
 		EpochConfig:            *scf.epochConfig,
 	}
 	delegation, err := systemSmartContracts.NewDelegationSystemSC(argsDelegation)
@@ -291,6 +293,33 @@ func (scf *systemSCFactory) createDelegationManagerContract() (vm.SystemSmartCon
 	return delegationManager, err
 }
 
+func (scf *systemSCFactory) createMultisigContract() (vm.SystemSmartContract, error) {
+	argsMultisig := systemSmartContracts.ArgsNewMultisig{
+		Eei:               scf.systemEI,
+		GasCost:           scf.gasCost,
+		Marshalizer:       scf.marshalizer,
+		MultisigSCAddress: vm.MultisigSCAddress,
+		EpochNotifier:     scf.epochNotifier,
+		EpochConfig:       *scf.epochConfig,
+	}
+	multisig, err := systemSmartContracts.NewMultisigSystemSC(argsMultisig)
+	return multisig, err
+}
+
+func (scf *systemSCFactory) createSchedulerContract() (vm.SystemSmartContract, error) {
+	argsScheduler := systemSmartContracts.ArgsNewScheduler{
+		Eei:                scf.systemEI,
+		GasCost:            scf.gasCost,
+		Marshalizer:        scf.marshalizer,
+		SchedulerSCAddress: vm.SchedulerSCAddress,
+		EndOfEpochAddress:  vm.EndOfEpochAddress,
+		EpochNotifier:      scf.epochNotifier,
+		EpochConfig:        *scf.epochConfig,
+	}
+	scheduler, err := systemSmartContracts.NewSchedulerSystemSC(argsScheduler)
+	return scheduler, err
+}
+
 // CreateForGenesis instantiates all the system smart contracts and returns a container containing them to be used in the genesis process
 func (scf *systemSCFactory) CreateForGenesis() (vm.SystemSCContainer, error) {
 	staking, err := scf.createStakingContract()
@@ -368,6 +397,26 @@ func (scf *systemSCFactory) Create() (vm.SystemSCContainer, error) {
 		return nil, err
 	}
 
+	multisig, err := scf.createMultisigContract()
+	if err != nil {
+		return nil, err
+	}
+
+	err = scf.systemSCsContainer.Add(vm.MultisigSCAddress, multisig)
+	if err != nil {
+		return nil, err
+	}
+
+	scheduler, err := scf.createSchedulerContract()
+	if err != nil {
+		return nil, err
+	}
+
+	err = scf.systemSCsContainer.Add(vm.SchedulerSCAddress, scheduler)
+	if err != nil {
+		return nil, err
+	}
+
 	err = scf.systemEI.SetSystemSCContainer(scf.systemSCsContainer)
 	if err != nil {
 		return nil, err
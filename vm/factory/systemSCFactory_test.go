@@ -277,7 +277,7 @@ func TestSystemSCFactory_Create(t *testing.T) {
 	container, err := scFactory.Create()
 	assert.Nil(t, err)
 	require.NotNil(t, container)
-	assert.Equal(t, 6, container.Len())
+	assert.Equal(t, 8, container.Len())
 }
 
 func TestSystemSCFactory_CreateForGenesis(t *testing.T) {
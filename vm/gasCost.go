@@ -34,6 +34,9 @@ type MetaChainSystemSCsCost struct {
 	DelegationMgrOps      uint64
 	ValidatorToDelegation uint64
 	GetAllNodeStates      uint64
+	MultisigCreate        uint64
+	MultisigOps           uint64
+	SchedulerOps          uint64
 }
 
 // BuiltInCost defines cost for built-in methods
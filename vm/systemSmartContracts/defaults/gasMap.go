@@ -45,6 +45,7 @@ func FillGasMapBuiltInCosts(value uint64) map[string]uint64 {
 	gasMap["ESDTNFTAddUri"] = value
 	gasMap["ESDTNFTUpdateAttributes"] = value
 	gasMap["ESDTNFTMultiTransfer"] = value
+	gasMap["SetGuardian"] = value
 
 	return gasMap
 }
@@ -72,6 +73,9 @@ func FillGasMapMetaChainSystemSCsCosts(value uint64) map[string]uint64 {
 	gasMap["UnBondTokens"] = value
 	gasMap["DelegationMgrOps"] = value
 	gasMap["GetAllNodeStates"] = value
+	gasMap["MultisigCreate"] = value
+	gasMap["MultisigOps"] = value
+	gasMap["SchedulerOps"] = value
 	gasMap["ValidatorToDelegation"] = value
 
 	return gasMap
@@ -24,6 +24,7 @@ const lastFundKey = "lastFund"
 const globalFundKey = "globalFund"
 const serviceFeeKey = "serviceFee"
 const totalActiveKey = "totalActive"
+const liquidStakingKey = "liquidStaking"
 const rewardKeyPrefix = "reward"
 const fundKeyPrefix = "fund"
 const maxNumOfUnStakedFunds = 50
@@ -47,6 +48,7 @@ type delegation struct {
 	validatorSCAddr                    []byte
 	endOfEpochAddr                     []byte
 	governanceSCAddr                   []byte
+	esdtSCAddr                         []byte
 	gasCost                            vm.GasCost
 	marshalizer                        marshal.Marshalizer
 	delegationEnabled                  atomic.Flag
@@ -64,6 +66,8 @@ type delegation struct {
 	validatorToDelegationEnableEpoch   uint32
 	flagReDelegateBelowMinCheck        atomic.Flag
 	reDelegateBelowMinCheckEnableEpoch uint32
+	flagLiquidStaking                  atomic.Flag
+	liquidStakingEnableEpoch           uint32
 }
 
 // ArgsNewDelegation defines the arguments to create the delegation smart contract
@@ -78,6 +82,7 @@ type ArgsNewDelegation struct {
 	ValidatorSCAddress     []byte
 	EndOfEpochAddress      []byte
 	GovernanceSCAddress    []byte
+	ESDTSCAddress          []byte
 	GasCost                vm.GasCost
 	Marshalizer            marshal.Marshalizer
 	EpochNotifier          vm.EpochNotifier
@@ -100,6 +105,9 @@ func NewDelegationSystemSC(args ArgsNewDelegation) (*delegation, error) {
 	if len(args.GovernanceSCAddress) < 1 {
 		return nil, fmt.Errorf("%w for governance sc address", vm.ErrInvalidAddress)
 	}
+	if len(args.ESDTSCAddress) < 1 {
+		return nil, fmt.Errorf("%w for esdt sc address", vm.ErrInvalidAddress)
+	}
 	if check.IfNil(args.Marshalizer) {
 		return nil, vm.ErrNilMarshalizer
 	}
@@ -131,15 +139,18 @@ func NewDelegationSystemSC(args ArgsNewDelegation) (*delegation, error) {
 		unBondPeriodInEpochs:               args.StakingSCConfig.UnBondPeriodInEpochs,
 		endOfEpochAddr:                     args.EndOfEpochAddress,
 		governanceSCAddr:                   args.GovernanceSCAddress,
+		esdtSCAddr:                         args.ESDTSCAddress,
 		stakingV2EnableEpoch:               args.EpochConfig.EnableEpochs.StakingV2EnableEpoch,
 		stakingV2Enabled:                   atomic.Flag{},
 		validatorToDelegationEnableEpoch:   args.EpochConfig.EnableEpochs.ValidatorToDelegationEnableEpoch,
 		reDelegateBelowMinCheckEnableEpoch: args.EpochConfig.EnableEpochs.ReDelegateBelowMinCheckEnableEpoch,
+		liquidStakingEnableEpoch:           args.EpochConfig.EnableEpochs.DelegationLiquidStakingEnableEpoch,
 	}
 	log.Debug("delegation: enable epoch for delegation smart contract", "epoch", d.enableDelegationEpoch)
 	log.Debug("delegation: enable epoch for staking v2", "epoch", d.stakingV2EnableEpoch)
 	log.Debug("delegation: enable epoch for validator to delegation", "epoch", d.validatorToDelegationEnableEpoch)
 	log.Debug("delegation: enable epoch for re-delegate below minimum check", "epoch", d.reDelegateBelowMinCheckEnableEpoch)
+	log.Debug("delegation: enable epoch for liquid staking", "epoch", d.liquidStakingEnableEpoch)
 
 	var okValue bool
 
@@ -181,6 +192,10 @@ func (d *delegation) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCo
 	}
 
 	if len(args.ESDTTransfers) > 0 {
+		if args.Function == "unDelegate" && d.flagLiquidStaking.IsSet() {
+			return d.unDelegateLiquidStaking(args)
+		}
+
 		d.eei.AddReturnMessage("cannot transfer ESDT to system SCs")
 		return vmcommon.UserError
 	}
@@ -270,6 +285,14 @@ func (d *delegation) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCo
 		return d.setMetaData(args)
 	case "getMetaData":
 		return d.getMetaData(args)
+	case "setLiquidStakingToken":
+		return d.setLiquidStakingToken(args)
+	case "reDelegateLiquidStakingRewards":
+		return d.reDelegateLiquidStakingRewards(args)
+	case "getLiquidStakingData":
+		return d.getLiquidStakingData(args)
+	case "getLiquidStakingReceiptsValue":
+		return d.getLiquidStakingReceiptsValue(args)
 	}
 
 	d.eei.AddReturnMessage(args.Function + " is an unknown function")
@@ -1329,6 +1352,15 @@ func (d *delegation) reDelegateRewards(args *vmcommon.ContractCallInput) vmcommo
 		return vmcommon.UserError
 	}
 
+	liquidStaking, err := d.getLiquidStakingForDelegator(args.CallerAddr)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if liquidStaking != nil {
+		return d.reDelegateRewardsAsLiquidStaking(args, liquidStaking, delegator, dConfig)
+	}
+
 	err = d.checkAndUpdateOwnerInitialFunds(dConfig, args.CallerAddr, delegator.UnClaimedRewards)
 	if err != nil {
 		d.eei.AddReturnMessage(err.Error())
@@ -1493,6 +1525,15 @@ func (d *delegation) delegate(args *vmcommon.ContractCallInput) vmcommon.ReturnC
 		return vmcommon.UserError
 	}
 
+	liquidStaking, err := d.getLiquidStakingForDelegator(args.CallerAddr)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if liquidStaking != nil {
+		return d.delegateLiquidStaking(liquidStaking, args.CallValue, args.CallValue, args.CallerAddr, args.RecipientAddr, dStatus, true)
+	}
+
 	return d.delegateUser(args.CallValue, args.CallValue, args.CallerAddr, args.RecipientAddr, dStatus)
 }
 
@@ -2346,6 +2387,10 @@ func (d *delegation) checkArgumentsForUserViewFunc(args *vmcommon.ContractCallIn
 }
 
 func (d *delegation) getUserActiveStake(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if d.flagLiquidStaking.IsSet() && len(args.Arguments) == 2 {
+		return d.getUserActiveStakeWithLiquidStaking(args)
+	}
+
 	delegator, returnCode := d.checkArgumentsForUserViewFunc(args)
 	if returnCode != vmcommon.Ok {
 		return returnCode
@@ -2847,6 +2892,9 @@ func (d *delegation) EpochConfirmed(epoch uint32, _ uint64) {
 
 	d.flagReDelegateBelowMinCheck.Toggle(epoch >= d.reDelegateBelowMinCheckEnableEpoch)
 	log.Debug("delegationSC: re-delegate below minimum check", "enabled", d.flagReDelegateBelowMinCheck.IsSet())
+
+	d.flagLiquidStaking.Toggle(epoch >= d.liquidStakingEnableEpoch)
+	log.Debug("delegationSC: liquid staking", "enabled", d.flagLiquidStaking.IsSet())
 }
 
 // CanUseContract returns true if contract can be used
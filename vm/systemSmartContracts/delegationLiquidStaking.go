@@ -0,0 +1,700 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. liquidStaking.proto
+package systemSmartContracts
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+// The liquid staked value of all the receipt holders is kept in a single delegator entry, the liquid staking pool,
+// saved under the address of the delegation contract itself. The receipts are minted at the pool exchange rate, so
+// the rewards earned by the pool and re-delegated into it increase the stake each receipt is worth.
+
+func (d *delegation) setLiquidStakingToken(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !d.flagLiquidStaking.IsSet() {
+		d.eei.AddReturnMessage("invalid function to call")
+		return vmcommon.UserError
+	}
+	returnCode := d.checkOwnerCallValueGasAndDuplicates(args)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+	if len(args.Arguments) != 1 {
+		d.eei.AddReturnMessage("invalid number of arguments")
+		return vmcommon.UserError
+	}
+
+	liquidStaking, err := d.getLiquidStakingDataFromStorage()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if len(liquidStaking.TokenID) > 0 {
+		d.eei.AddReturnMessage("liquid staking token already set")
+		return vmcommon.UserError
+	}
+
+	tokenID := args.Arguments[0]
+	marshaledData := d.eei.GetStorageFromAddress(d.esdtSCAddr, tokenID)
+	if len(marshaledData) == 0 {
+		d.eei.AddReturnMessage("liquid staking token does not exist")
+		return vmcommon.UserError
+	}
+	token := &ESDTData{}
+	err = d.marshalizer.Unmarshal(token, marshaledData)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if string(token.TokenType) != core.FungibleESDT {
+		d.eei.AddReturnMessage("liquid staking token must be fungible")
+		return vmcommon.UserError
+	}
+	// only the delegation contract can manage the token, so no one else is able to mint receipts
+	if !bytes.Equal(token.OwnerAddress, args.RecipientAddr) {
+		d.eei.AddReturnMessage("liquid staking token must be owned by the delegation contract")
+		return vmcommon.UserError
+	}
+	// the receipts minted before the token is set, or by other addresses, would unDelegate the stake of the delegators
+	if computeTokenCirculatingSupply(token).Cmp(zero) != 0 {
+		d.eei.AddReturnMessage("liquid staking token must not have a circulating supply")
+		return vmcommon.UserError
+	}
+	if len(token.SpecialRoles) > 0 {
+		d.eei.AddReturnMessage("liquid staking token must not have special roles")
+		return vmcommon.UserError
+	}
+	if token.Mintable || token.CanAddSpecialRoles {
+		d.eei.AddReturnMessage("liquid staking token must not be mintable or allow adding special roles")
+		return vmcommon.UserError
+	}
+
+	liquidStaking.TokenID = tokenID
+	err = d.saveLiquidStakingData(liquidStaking)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+// getLiquidStakingForDelegator returns the liquid staking data if the delegated value of the provided address has to
+// be liquid staked, or nil otherwise. The owner funds are never liquid staked as they must remain locked in the contract.
+func (d *delegation) getLiquidStakingForDelegator(address []byte) (*LiquidStakingData, error) {
+	if !d.flagLiquidStaking.IsSet() || d.isOwner(address) {
+		return nil, nil
+	}
+
+	liquidStaking, err := d.getLiquidStakingDataFromStorage()
+	if err != nil {
+		return nil, err
+	}
+	if len(liquidStaking.TokenID) == 0 {
+		return nil, nil
+	}
+
+	return liquidStaking, nil
+}
+
+func (d *delegation) reDelegateRewardsAsLiquidStaking(
+	args *vmcommon.ContractCallInput,
+	liquidStaking *LiquidStakingData,
+	delegator *DelegatorData,
+	dConfig *DelegationConfig,
+) vmcommon.ReturnCode {
+	if delegator.UnClaimedRewards.Cmp(zero) <= 0 {
+		d.eei.AddReturnMessage("delegate value must be higher than 0")
+		return vmcommon.UserError
+	}
+
+	delegateValue := big.NewInt(0).Set(delegator.UnClaimedRewards)
+	delegator.TotalCumulatedRewards.Add(delegator.TotalCumulatedRewards, delegator.UnClaimedRewards)
+	delegator.UnClaimedRewards.SetUint64(0)
+
+	err := d.saveDelegatorData(args.CallerAddr, delegator)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	dStatus, err := d.getDelegationStatus()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	return d.delegateLiquidStaking(liquidStaking, delegateValue, delegateValue, args.CallerAddr, args.RecipientAddr,
+		dStatus, dConfig.CheckCapOnReDelegateRewards)
+}
+
+func (d *delegation) delegateLiquidStaking(
+	liquidStaking *LiquidStakingData,
+	delegateValue *big.Int,
+	callValue *big.Int,
+	callerAddr []byte,
+	scAddress []byte,
+	dStatus *DelegationContractStatus,
+	checkDelegationCap bool,
+) vmcommon.ReturnCode {
+	dConfig, err := d.getDelegationContractConfig()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	globalFund, err := d.getGlobalFundData()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	isNew, pool, poolRewards, err := d.getLiquidStakingPoolWithRewards(scAddress)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	poolActiveValue, err := d.getActiveFundValue(pool)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	// the pool rewards are re-delegated before minting, so the new receipts do not get a share of them
+	poolActiveValue.Add(poolActiveValue, poolRewards)
+	receiptValue := computeLiquidStakingReceipts(delegateValue, liquidStaking.Supply, poolActiveValue)
+	if receiptValue.Cmp(zero) <= 0 {
+		d.eei.AddReturnMessage("delegate value too low to mint liquid staking receipts")
+		return vmcommon.UserError
+	}
+
+	totalDelegateValue := big.NewInt(0).Add(delegateValue, poolRewards)
+	totalCallValue := big.NewInt(0).Add(callValue, poolRewards)
+	returnCode := d.finishDelegateUser(globalFund, pool, dConfig, dStatus, scAddress, scAddress,
+		totalDelegateValue, totalCallValue, isNew, checkDelegationCap)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	liquidStaking.Supply.Add(liquidStaking.Supply, receiptValue)
+	err = d.saveLiquidStakingData(liquidStaking)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	err = d.mintLiquidStakingReceipts(liquidStaking.TokenID, receiptValue, callerAddr, scAddress)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+func (d *delegation) reDelegateLiquidStakingRewards(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !d.flagLiquidStaking.IsSet() {
+		d.eei.AddReturnMessage("invalid function to call")
+		return vmcommon.UserError
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		d.eei.AddReturnMessage(vm.ErrCallValueMustBeZero.Error())
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 0 {
+		d.eei.AddReturnMessage("must be called without arguments")
+		return vmcommon.UserError
+	}
+	err := d.eei.UseGas(d.gasCost.MetaChainSystemSCsCost.DelegationOps)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.OutOfGas
+	}
+
+	isNew, pool, poolRewards, err := d.getLiquidStakingPoolWithRewards(args.RecipientAddr)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if isNew || poolRewards.Cmp(zero) <= 0 {
+		d.eei.AddReturnMessage("no liquid staking rewards to re-delegate")
+		return vmcommon.UserError
+	}
+
+	dConfig, err := d.getDelegationContractConfig()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	globalFund, err := d.getGlobalFundData()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	dStatus, err := d.getDelegationStatus()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	return d.finishDelegateUser(globalFund, pool, dConfig, dStatus, args.RecipientAddr, args.RecipientAddr,
+		poolRewards, poolRewards, false, dConfig.CheckCapOnReDelegateRewards)
+}
+
+// getLiquidStakingPoolWithRewards returns the liquid staking pool with its rewards moved to the cumulated rewards, as
+// they are going to be re-delegated into the pool
+func (d *delegation) getLiquidStakingPoolWithRewards(scAddress []byte) (bool, *DelegatorData, *big.Int, error) {
+	isNew, pool, err := d.getOrCreateDelegatorData(scAddress)
+	if err != nil {
+		return false, nil, nil, err
+	}
+
+	err = d.computeAndUpdateRewards(scAddress, pool)
+	if err != nil {
+		return false, nil, nil, err
+	}
+	if len(pool.ActiveFund) == 0 {
+		pool.RewardsCheckpoint = d.eei.BlockChainHook().CurrentEpoch() + 1
+	}
+
+	poolRewards := big.NewInt(0).Set(pool.UnClaimedRewards)
+	pool.TotalCumulatedRewards.Add(pool.TotalCumulatedRewards, poolRewards)
+	pool.UnClaimedRewards.SetUint64(0)
+
+	return isNew, pool, poolRewards, nil
+}
+
+func (d *delegation) unDelegateLiquidStaking(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	err := d.eei.UseGas(d.gasCost.MetaChainSystemSCsCost.DelegationOps)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.OutOfGas
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		d.eei.AddReturnMessage(vm.ErrCallValueMustBeZero.Error())
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 0 {
+		d.eei.AddReturnMessage("liquid staking unDelegate must be called without arguments")
+		return vmcommon.FunctionWrongSignature
+	}
+
+	liquidStaking, err := d.getLiquidStakingDataFromStorage()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if len(args.ESDTTransfers) != 1 || len(liquidStaking.TokenID) == 0 ||
+		!bytes.Equal(args.ESDTTransfers[0].ESDTTokenName, liquidStaking.TokenID) || args.ESDTTransfers[0].ESDTTokenNonce != 0 {
+		d.eei.AddReturnMessage("only the liquid staking receipts can be transferred to unDelegate")
+		return vmcommon.UserError
+	}
+	receiptValue := args.ESDTTransfers[0].ESDTValue
+	if receiptValue.Cmp(zero) <= 0 || receiptValue.Cmp(liquidStaking.Supply) > 0 {
+		d.eei.AddReturnMessage("invalid liquid staking receipts value")
+		return vmcommon.UserError
+	}
+	if isStakeLocked(d.eei, d.governanceSCAddr, args.CallerAddr) {
+		d.eei.AddReturnMessage("stake is locked for voting")
+		return vmcommon.UserError
+	}
+
+	isNew, pool, err := d.getOrCreateDelegatorData(args.RecipientAddr)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if isNew || len(pool.ActiveFund) == 0 {
+		d.eei.AddReturnMessage("no liquid staked funds")
+		return vmcommon.UserError
+	}
+	err = d.computeAndUpdateRewards(args.RecipientAddr, pool)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	poolFund, err := d.getFund(pool.ActiveFund)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	valueToUnDelegate := computeLiquidStakingValue(receiptValue, liquidStaking.Supply, poolFund.Value)
+	if valueToUnDelegate.Cmp(zero) <= 0 {
+		d.eei.AddReturnMessage("liquid staking receipts value too low to unDelegate")
+		return vmcommon.UserError
+	}
+
+	returnData, returnCode := d.executeOnValidatorSCWithValueInArgs(args.RecipientAddr, "unStakeTokens", valueToUnDelegate)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+	actualUnStake, err := d.resolveUnStakedUnBondResponse(returnData, valueToUnDelegate)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	// the receipts are burnt entirely, so partial unStakes are not accepted
+	if actualUnStake.Cmp(valueToUnDelegate) != 0 {
+		d.eei.AddReturnMessage("could not unStake the whole liquid staked value")
+		return vmcommon.UserError
+	}
+
+	poolFund.Value.Sub(poolFund.Value, valueToUnDelegate)
+	err = d.saveFund(pool.ActiveFund, poolFund)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if poolFund.Value.Cmp(zero) == 0 {
+		pool.ActiveFund = nil
+	}
+	err = d.saveDelegatorData(args.RecipientAddr, pool)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	returnCode = d.addLiquidStakingUnStakedFund(args.CallerAddr, valueToUnDelegate)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	globalFund, err := d.getGlobalFundData()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	globalFund.TotalActive.Sub(globalFund.TotalActive, valueToUnDelegate)
+	globalFund.TotalUnStaked.Add(globalFund.TotalUnStaked, valueToUnDelegate)
+	err = d.saveGlobalFundData(globalFund)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	liquidStaking.Supply.Sub(liquidStaking.Supply, receiptValue)
+	err = d.saveLiquidStakingData(liquidStaking)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	err = d.burnLiquidStakingReceipts(liquidStaking.TokenID, receiptValue)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+// mintLiquidStakingReceipts mints the receipts as the ESDT system SC does: the minted value is added to the token data
+// kept by the ESDT system SC and the receipts are transferred from the metachain to the destination
+func (d *delegation) mintLiquidStakingReceipts(tokenID []byte, value *big.Int, destination []byte, scAddress []byte) error {
+	token, err := d.getLiquidStakingToken(tokenID)
+	if err != nil {
+		return err
+	}
+
+	if token.MintedValue == nil {
+		token.MintedValue = big.NewInt(0)
+	}
+	token.MintedValue.Add(token.MintedValue, value)
+	err = d.saveLiquidStakingToken(tokenID, token)
+	if err != nil {
+		return err
+	}
+
+	esdtTransferData := core.BuiltInFunctionESDTTransfer + "@" + hex.EncodeToString(tokenID) + "@" + hex.EncodeToString(value.Bytes())
+	return d.eei.Transfer(destination, scAddress, big.NewInt(0), []byte(esdtTransferData), 0)
+}
+
+// burnLiquidStakingReceipts burns the receipts transferred to the metachain as the ESDT system SC does: the burnt value
+// is added to the token data kept by the ESDT system SC, so the receipts leave the circulating supply of the token
+func (d *delegation) burnLiquidStakingReceipts(tokenID []byte, value *big.Int) error {
+	token, err := d.getLiquidStakingToken(tokenID)
+	if err != nil {
+		return err
+	}
+
+	if token.BurntValue == nil {
+		token.BurntValue = big.NewInt(0)
+	}
+	token.BurntValue.Add(token.BurntValue, value)
+	return d.saveLiquidStakingToken(tokenID, token)
+}
+
+func (d *delegation) getLiquidStakingToken(tokenID []byte) (*ESDTData, error) {
+	marshaledData := d.eei.GetStorageFromAddress(d.esdtSCAddr, tokenID)
+	if len(marshaledData) == 0 {
+		return nil, vm.ErrNoTickerWithGivenName
+	}
+
+	token := &ESDTData{}
+	err := d.marshalizer.Unmarshal(token, marshaledData)
+	return token, err
+}
+
+func (d *delegation) saveLiquidStakingToken(tokenID []byte, token *ESDTData) error {
+	marshaledData, err := d.marshalizer.Marshal(token)
+	if err != nil {
+		return err
+	}
+
+	d.eei.SetStorageForAddress(d.esdtSCAddr, tokenID, marshaledData)
+	return nil
+}
+
+func (d *delegation) addLiquidStakingUnStakedFund(address []byte, unStakeValue *big.Int) vmcommon.ReturnCode {
+	isNew, delegator, err := d.getOrCreateDelegatorData(address)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if isNew {
+		delegator.RewardsCheckpoint = d.eei.BlockChainHook().CurrentEpoch() + 1
+
+		dStatus, errGet := d.getDelegationStatus()
+		if errGet != nil {
+			d.eei.AddReturnMessage(errGet.Error())
+			return vmcommon.UserError
+		}
+		dStatus.NumUsers++
+		err = d.saveDelegationStatus(dStatus)
+		if err != nil {
+			d.eei.AddReturnMessage(err.Error())
+			return vmcommon.UserError
+		}
+	}
+
+	err = d.addNewUnStakedFund(address, delegator, unStakeValue)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if len(delegator.UnStakedFunds) > maxNumOfUnStakedFunds {
+		d.eei.AddReturnMessage("number of unDelegate limit reached, withDraw required")
+		return vmcommon.UserError
+	}
+
+	err = d.saveDelegatorData(address, delegator)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+func (d *delegation) getLiquidStakingData(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !d.flagLiquidStaking.IsSet() {
+		d.eei.AddReturnMessage("invalid function to call")
+		return vmcommon.UserError
+	}
+	returnCode := d.checkArgumentsForGeneralViewFunc(args)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	liquidStaking, err := d.getLiquidStakingDataFromStorage()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	poolActiveValue, err := d.getLiquidStakingPoolActiveValue(args.RecipientAddr)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	d.eei.Finish(liquidStaking.TokenID)
+	d.eei.Finish(liquidStaking.Supply.Bytes())
+	d.eei.Finish(poolActiveValue.Bytes())
+
+	return vmcommon.Ok
+}
+
+// getLiquidStakingReceiptsValue returns the stake the provided quantity of liquid staking receipts is worth. The
+// receipts balances are held in the holders' shards, out of the reach of the metachain, so the quantity is provided as
+// argument.
+func (d *delegation) getLiquidStakingReceiptsValue(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !d.flagLiquidStaking.IsSet() {
+		d.eei.AddReturnMessage("invalid function to call")
+		return vmcommon.UserError
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		d.eei.AddReturnMessage(vm.ErrCallValueMustBeZero.Error())
+		return vmcommon.UserError
+	}
+	err := d.eei.UseGas(d.gasCost.MetaChainSystemSCsCost.DelegationOps)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.OutOfGas
+	}
+	if len(args.Arguments) != 1 {
+		d.eei.AddReturnMessage(vm.ErrInvalidNumOfArguments.Error())
+		return vmcommon.UserError
+	}
+
+	liquidStaking, err := d.getLiquidStakingDataFromStorage()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	poolActiveValue, err := d.getLiquidStakingPoolActiveValue(args.RecipientAddr)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	receiptValue := big.NewInt(0).SetBytes(args.Arguments[0])
+	if receiptValue.Cmp(liquidStaking.Supply) > 0 {
+		d.eei.AddReturnMessage("invalid liquid staking receipts value")
+		return vmcommon.UserError
+	}
+	d.eei.Finish(computeLiquidStakingValue(receiptValue, liquidStaking.Supply, poolActiveValue).Bytes())
+
+	return vmcommon.Ok
+}
+
+// getUserActiveStakeWithLiquidStaking returns the active stake kept in the contract storage for the delegator together
+// with the stake of the liquid staking receipts the delegator holds. The receipts balance is held in the delegator's
+// shard, so it is provided by the caller as the second argument.
+func (d *delegation) getUserActiveStakeWithLiquidStaking(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Cmp(zero) != 0 {
+		d.eei.AddReturnMessage(vm.ErrCallValueMustBeZero.Error())
+		return vmcommon.UserError
+	}
+	err := d.eei.UseGas(d.gasCost.MetaChainSystemSCsCost.DelegationOps)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.OutOfGas
+	}
+	if len(args.Arguments) != 2 {
+		d.eei.AddReturnMessage(vm.ErrInvalidNumOfArguments.Error())
+		return vmcommon.UserError
+	}
+
+	// the holders which only received receipts have no delegator entry, so new delegators are accepted
+	_, delegator, err := d.getOrCreateDelegatorData(args.Arguments[0])
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	activeStake, err := d.getActiveFundValue(delegator)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	liquidStaking, err := d.getLiquidStakingDataFromStorage()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	poolActiveValue, err := d.getLiquidStakingPoolActiveValue(args.RecipientAddr)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	receiptValue := big.NewInt(0).SetBytes(args.Arguments[1])
+	if receiptValue.Cmp(liquidStaking.Supply) > 0 {
+		d.eei.AddReturnMessage("invalid liquid staking receipts value")
+		return vmcommon.UserError
+	}
+	activeStake.Add(activeStake, computeLiquidStakingValue(receiptValue, liquidStaking.Supply, poolActiveValue))
+	d.eei.Finish(activeStake.Bytes())
+
+	return vmcommon.Ok
+}
+
+func (d *delegation) getLiquidStakingPoolActiveValue(scAddress []byte) (*big.Int, error) {
+	_, pool, err := d.getOrCreateDelegatorData(scAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.getActiveFundValue(pool)
+}
+
+func (d *delegation) getActiveFundValue(delegator *DelegatorData) (*big.Int, error) {
+	if len(delegator.ActiveFund) == 0 {
+		return big.NewInt(0), nil
+	}
+
+	fund, err := d.getFund(delegator.ActiveFund)
+	if err != nil {
+		return nil, err
+	}
+
+	return big.NewInt(0).Set(fund.Value), nil
+}
+
+// computeTokenCirculatingSupply returns the minted value of the token which was not burnt yet
+func computeTokenCirculatingSupply(token *ESDTData) *big.Int {
+	supply := big.NewInt(0)
+	if token.MintedValue != nil {
+		supply.Add(supply, token.MintedValue)
+	}
+	if token.BurntValue != nil {
+		supply.Sub(supply, token.BurntValue)
+	}
+
+	return supply
+}
+
+// computeLiquidStakingReceipts returns the receipts to be minted for the delegated value: value * supply / poolValue
+func computeLiquidStakingReceipts(delegateValue *big.Int, supply *big.Int, poolValue *big.Int) *big.Int {
+	if supply.Cmp(zero) == 0 || poolValue.Cmp(zero) == 0 {
+		return big.NewInt(0).Set(delegateValue)
+	}
+
+	receipts := big.NewInt(0).Mul(delegateValue, supply)
+	return receipts.Div(receipts, poolValue)
+}
+
+// computeLiquidStakingValue returns the stake the provided receipts are worth: receipts * poolValue / supply
+func computeLiquidStakingValue(receiptValue *big.Int, supply *big.Int, poolValue *big.Int) *big.Int {
+	if supply.Cmp(zero) == 0 {
+		return big.NewInt(0)
+	}
+
+	value := big.NewInt(0).Mul(receiptValue, poolValue)
+	return value.Div(value, supply)
+}
+
+func (d *delegation) getLiquidStakingDataFromStorage() (*LiquidStakingData, error) {
+	liquidStaking := &LiquidStakingData{
+		Supply: big.NewInt(0),
+	}
+	marshaledData := d.eei.GetStorage([]byte(liquidStakingKey))
+	if len(marshaledData) == 0 {
+		return liquidStaking, nil
+	}
+
+	err := d.marshalizer.Unmarshal(liquidStaking, marshaledData)
+	if err != nil {
+		return nil, err
+	}
+	if liquidStaking.Supply == nil {
+		liquidStaking.Supply = big.NewInt(0)
+	}
+
+	return liquidStaking, nil
+}
+
+func (d *delegation) saveLiquidStakingData(liquidStaking *LiquidStakingData) error {
+	marshaledData, err := d.marshalizer.Marshal(liquidStaking)
+	if err != nil {
+		return err
+	}
+
+	d.eei.SetStorage([]byte(liquidStakingKey), marshaledData)
+	return nil
+}
@@ -0,0 +1,460 @@
+package systemSmartContracts
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
+	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/mock"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var liquidStakingTokenID = []byte("LSTAKE-abcdef")
+
+// the delegator data is saved under the delegator address, so the owner must not collide with the owner storage key
+var liquidStakingOwner = []byte("delegationOwner")
+
+func createDelegationWithLiquidStakingToken(t *testing.T) (*delegation, *vmContext) {
+	args := createMockArgumentsForDelegation()
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{},
+		hooks.NewVMCryptoHook(),
+		&mock.ArgumentParserMock{},
+		&stateMock.AccountsStub{},
+		&mock.RaterMock{},
+	)
+	args.Eei = eei
+	addValidatorAndStakingScToVmContext(eei)
+	createDelegationManagerConfig(eei, args.Marshalizer, big.NewInt(10))
+
+	d, err := NewDelegationSystemSC(args)
+	require.Nil(t, err)
+
+	eei.SetStorage([]byte(ownerKey), liquidStakingOwner)
+	_ = d.saveDelegationStatus(&DelegationContractStatus{})
+	_ = d.saveDelegationContractConfig(&DelegationConfig{
+		MaxDelegationCap:  big.NewInt(0),
+		InitialOwnerFunds: big.NewInt(100),
+	})
+	_ = d.saveGlobalFundData(&GlobalFundData{
+		TotalActive:   big.NewInt(0),
+		TotalUnStaked: big.NewInt(0),
+	})
+	saveESDTTokenForLiquidStaking(d, liquidStakingTokenID, core.FungibleESDT, []byte("addr"))
+
+	vmInput := getDefaultVmInputForFunc("setLiquidStakingToken", [][]byte{liquidStakingTokenID})
+	vmInput.CallerAddr = liquidStakingOwner
+	require.Equal(t, vmcommon.Ok, d.Execute(vmInput))
+
+	return d, eei
+}
+
+func saveESDTTokenForLiquidStaking(d *delegation, tokenID []byte, tokenType string, owner []byte) {
+	saveESDTDataForLiquidStaking(d, tokenID, &ESDTData{
+		OwnerAddress: owner,
+		TokenType:    []byte(tokenType),
+	})
+}
+
+func saveESDTDataForLiquidStaking(d *delegation, tokenID []byte, token *ESDTData) {
+	marshaledData, _ := d.marshalizer.Marshal(token)
+	d.eei.SetStorageForAddress(vm.ESDTSCAddress, tokenID, marshaledData)
+}
+
+func delegateForLiquidStaking(d *delegation, eei *vmContext, delegator []byte, value int64) vmcommon.ReturnCode {
+	resetLiquidStakingOutput(eei)
+	vmInput := getDefaultVmInputForFunc("delegate", [][]byte{})
+	vmInput.CallerAddr = delegator
+	vmInput.CallValue = big.NewInt(value)
+
+	return d.Execute(vmInput)
+}
+
+func resetLiquidStakingOutput(eei *vmContext) {
+	eei.outputAccounts = make(map[string]*vmcommon.OutputAccount)
+	eei.output = make([][]byte, 0)
+	eei.returnMessage = ""
+}
+
+func checkLiquidStakingReceiptsMinted(t *testing.T, eei *vmContext, delegator []byte, value int64) {
+	outAcc := eei.outputAccounts[string(delegator)]
+	require.NotNil(t, outAcc)
+	require.Equal(t, 1, len(outAcc.OutputTransfers))
+
+	expectedData := core.BuiltInFunctionESDTTransfer + "@" + hex.EncodeToString(liquidStakingTokenID) + "@" + hex.EncodeToString(big.NewInt(value).Bytes())
+	assert.Equal(t, []byte(expectedData), outAcc.OutputTransfers[0].Data)
+	assert.Equal(t, big.NewInt(0), outAcc.OutputTransfers[0].Value)
+}
+
+func TestDelegation_ExecuteSetLiquidStakingTokenUserErrors(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForDelegation()
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{},
+		hooks.NewVMCryptoHook(),
+		&mock.ArgumentParserMock{},
+		&stateMock.AccountsStub{},
+		&mock.RaterMock{},
+	)
+	args.Eei = eei
+	d, _ := NewDelegationSystemSC(args)
+	eei.SetStorage([]byte(ownerKey), []byte("owner"))
+
+	vmInput := getDefaultVmInputForFunc("setLiquidStakingToken", [][]byte{liquidStakingTokenID})
+	vmInput.CallerAddr = []byte("not owner")
+	output := d.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.Equal(t, "only owner can call this method", eei.returnMessage)
+
+	eei.returnMessage = ""
+	vmInput.CallerAddr = []byte("owner")
+	vmInput.Arguments = [][]byte{}
+	output = d.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.Equal(t, "invalid number of arguments", eei.returnMessage)
+
+	eei.returnMessage = ""
+	vmInput.Arguments = [][]byte{liquidStakingTokenID}
+	output = d.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.Equal(t, "liquid staking token does not exist", eei.returnMessage)
+
+	eei.returnMessage = ""
+	saveESDTTokenForLiquidStaking(d, liquidStakingTokenID, core.NonFungibleESDT, vmInput.RecipientAddr)
+	output = d.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.Equal(t, "liquid staking token must be fungible", eei.returnMessage)
+
+	eei.returnMessage = ""
+	saveESDTTokenForLiquidStaking(d, liquidStakingTokenID, core.FungibleESDT, []byte("owner"))
+	output = d.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.Equal(t, "liquid staking token must be owned by the delegation contract", eei.returnMessage)
+
+	eei.returnMessage = ""
+	saveESDTDataForLiquidStaking(d, liquidStakingTokenID, &ESDTData{
+		OwnerAddress: vmInput.RecipientAddr,
+		TokenType:    []byte(core.FungibleESDT),
+		MintedValue:  big.NewInt(100),
+		BurntValue:   big.NewInt(40),
+	})
+	output = d.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.Equal(t, "liquid staking token must not have a circulating supply", eei.returnMessage)
+
+	eei.returnMessage = ""
+	saveESDTDataForLiquidStaking(d, liquidStakingTokenID, &ESDTData{
+		OwnerAddress: vmInput.RecipientAddr,
+		TokenType:    []byte(core.FungibleESDT),
+		SpecialRoles: []*ESDTRoles{{Address: []byte("minter"), Roles: [][]byte{[]byte(core.ESDTRoleLocalMint)}}},
+	})
+	output = d.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.Equal(t, "liquid staking token must not have special roles", eei.returnMessage)
+
+	eei.returnMessage = ""
+	saveESDTDataForLiquidStaking(d, liquidStakingTokenID, &ESDTData{
+		OwnerAddress: vmInput.RecipientAddr,
+		TokenType:    []byte(core.FungibleESDT),
+		Mintable:     true,
+	})
+	output = d.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.Equal(t, "liquid staking token must not be mintable or allow adding special roles", eei.returnMessage)
+
+	eei.returnMessage = ""
+	saveESDTDataForLiquidStaking(d, liquidStakingTokenID, &ESDTData{
+		OwnerAddress:       vmInput.RecipientAddr,
+		TokenType:          []byte(core.FungibleESDT),
+		CanAddSpecialRoles: true,
+	})
+	output = d.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.Equal(t, "liquid staking token must not be mintable or allow adding special roles", eei.returnMessage)
+
+	eei.returnMessage = ""
+	saveESDTDataForLiquidStaking(d, liquidStakingTokenID, &ESDTData{
+		OwnerAddress: vmInput.RecipientAddr,
+		TokenType:    []byte(core.FungibleESDT),
+		MintedValue:  big.NewInt(100),
+		BurntValue:   big.NewInt(100),
+	})
+	output = d.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+
+	output = d.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.Equal(t, "liquid staking token already set", eei.returnMessage)
+
+	eei.returnMessage = ""
+	d.liquidStakingEnableEpoch = 1
+	d.EpochConfirmed(0, 0)
+	output = d.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.Equal(t, "invalid function to call", eei.returnMessage)
+}
+
+func TestDelegation_ExecuteDelegateWithLiquidStakingMintsReceipts(t *testing.T) {
+	t.Parallel()
+
+	delegator1 := []byte("delegator1")
+	delegator2 := []byte("delegator2")
+	d, eei := createDelegationWithLiquidStakingToken(t)
+
+	output := delegateForLiquidStaking(d, eei, delegator1, 15)
+	require.Equal(t, vmcommon.Ok, output)
+	checkLiquidStakingReceiptsMinted(t, eei, delegator1, 15)
+
+	_, dData, _ := d.getOrCreateDelegatorData(delegator1)
+	assert.Equal(t, 0, len(dData.ActiveFund))
+
+	_, pool, _ := d.getOrCreateDelegatorData([]byte("addr"))
+	poolFund, _ := d.getFund(pool.ActiveFund)
+	assert.Equal(t, big.NewInt(15), poolFund.Value)
+
+	// the pool stake doubles from rewards, so the receipts are worth twice the delegated value
+	poolFund.Value.SetInt64(30)
+	_ = d.saveFund(pool.ActiveFund, poolFund)
+
+	output = delegateForLiquidStaking(d, eei, delegator2, 20)
+	require.Equal(t, vmcommon.Ok, output)
+	checkLiquidStakingReceiptsMinted(t, eei, delegator2, 10)
+
+	liquidStaking, _ := d.getLiquidStakingDataFromStorage()
+	assert.Equal(t, big.NewInt(25), liquidStaking.Supply)
+
+	token, _ := d.getLiquidStakingToken(liquidStakingTokenID)
+	assert.Equal(t, big.NewInt(25), computeTokenCirculatingSupply(token))
+	assert.Equal(t, big.NewInt(25), token.MintedValue)
+
+	poolFund, _ = d.getFund(pool.ActiveFund)
+	assert.Equal(t, big.NewInt(50), poolFund.Value)
+
+	globalFund, _ := d.getGlobalFundData()
+	assert.Equal(t, big.NewInt(35), globalFund.TotalActive)
+
+	resetLiquidStakingOutput(eei)
+	vmInput := getDefaultVmInputForFunc("getLiquidStakingData", [][]byte{})
+	output = d.Execute(vmInput)
+	require.Equal(t, vmcommon.Ok, output)
+	assert.Equal(t, [][]byte{liquidStakingTokenID, {25}, {50}}, eei.output)
+}
+
+func TestDelegation_ExecuteDelegateAsOwnerWithLiquidStakingDoesNotMintReceipts(t *testing.T) {
+	t.Parallel()
+
+	d, eei := createDelegationWithLiquidStakingToken(t)
+
+	output := delegateForLiquidStaking(d, eei, liquidStakingOwner, 15)
+	require.Equal(t, vmcommon.Ok, output)
+
+	outAcc := eei.outputAccounts[string(liquidStakingOwner)]
+	assert.Nil(t, outAcc)
+
+	_, dData, _ := d.getOrCreateDelegatorData(liquidStakingOwner)
+	fund, _ := d.getFund(dData.ActiveFund)
+	assert.Equal(t, big.NewInt(15), fund.Value)
+
+	liquidStaking, _ := d.getLiquidStakingDataFromStorage()
+	assert.Equal(t, big.NewInt(0), liquidStaking.Supply)
+}
+
+func TestDelegation_ExecuteUnDelegateWithLiquidStakingReceipts(t *testing.T) {
+	t.Parallel()
+
+	delegator1 := []byte("delegator1")
+	d, eei := createDelegationWithLiquidStakingToken(t)
+
+	output := delegateForLiquidStaking(d, eei, delegator1, 100)
+	require.Equal(t, vmcommon.Ok, output)
+
+	resetLiquidStakingOutput(eei)
+	vmInput := getDefaultVmInputForFunc("unDelegate", [][]byte{})
+	vmInput.CallerAddr = delegator1
+	vmInput.ESDTTransfers = []*vmcommon.ESDTTransfer{{ESDTTokenName: []byte("OTHER-abcdef"), ESDTValue: big.NewInt(40)}}
+	output = d.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.Equal(t, "only the liquid staking receipts can be transferred to unDelegate", eei.returnMessage)
+
+	resetLiquidStakingOutput(eei)
+	vmInput.ESDTTransfers = []*vmcommon.ESDTTransfer{{ESDTTokenName: liquidStakingTokenID, ESDTValue: big.NewInt(101)}}
+	output = d.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.Equal(t, "invalid liquid staking receipts value", eei.returnMessage)
+
+	resetLiquidStakingOutput(eei)
+	vmInput.ESDTTransfers = []*vmcommon.ESDTTransfer{{ESDTTokenName: liquidStakingTokenID, ESDTValue: big.NewInt(40)}}
+	output = d.Execute(vmInput)
+	require.Equal(t, vmcommon.Ok, output)
+
+	_, dData, _ := d.getOrCreateDelegatorData(delegator1)
+	require.Equal(t, 1, len(dData.UnStakedFunds))
+	unStakedFund, _ := d.getFund(dData.UnStakedFunds[0])
+	assert.Equal(t, big.NewInt(40), unStakedFund.Value)
+	assert.Equal(t, unStaked, unStakedFund.Type)
+
+	_, pool, _ := d.getOrCreateDelegatorData(vmInput.RecipientAddr)
+	poolFund, _ := d.getFund(pool.ActiveFund)
+	assert.Equal(t, big.NewInt(60), poolFund.Value)
+
+	globalFund, _ := d.getGlobalFundData()
+	assert.Equal(t, big.NewInt(60), globalFund.TotalActive)
+	assert.Equal(t, big.NewInt(40), globalFund.TotalUnStaked)
+
+	liquidStaking, _ := d.getLiquidStakingDataFromStorage()
+	assert.Equal(t, big.NewInt(60), liquidStaking.Supply)
+
+	token, _ := d.getLiquidStakingToken(liquidStakingTokenID)
+	assert.Equal(t, big.NewInt(60), computeTokenCirculatingSupply(token))
+	assert.Equal(t, big.NewInt(40), token.BurntValue)
+}
+
+func TestDelegation_ExecuteUnDelegateWithPreMintedReceiptsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	delegator1 := []byte("delegator1")
+	args := createMockArgumentsForDelegation()
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{},
+		hooks.NewVMCryptoHook(),
+		&mock.ArgumentParserMock{},
+		&stateMock.AccountsStub{},
+		&mock.RaterMock{},
+	)
+	args.Eei = eei
+	addValidatorAndStakingScToVmContext(eei)
+	createDelegationManagerConfig(eei, args.Marshalizer, big.NewInt(10))
+
+	d, _ := NewDelegationSystemSC(args)
+	eei.SetStorage([]byte(ownerKey), liquidStakingOwner)
+	_ = d.saveDelegationStatus(&DelegationContractStatus{})
+	_ = d.saveDelegationContractConfig(&DelegationConfig{
+		MaxDelegationCap:  big.NewInt(0),
+		InitialOwnerFunds: big.NewInt(100),
+	})
+	_ = d.saveGlobalFundData(&GlobalFundData{
+		TotalActive:   big.NewInt(0),
+		TotalUnStaked: big.NewInt(0),
+	})
+
+	// the owner minted receipts before transferring the token ownership to the delegation contract
+	saveESDTDataForLiquidStaking(d, liquidStakingTokenID, &ESDTData{
+		OwnerAddress: []byte("addr"),
+		TokenType:    []byte(core.FungibleESDT),
+		MintedValue:  big.NewInt(1000),
+		BurntValue:   big.NewInt(0),
+	})
+	vmInput := getDefaultVmInputForFunc("setLiquidStakingToken", [][]byte{liquidStakingTokenID})
+	vmInput.CallerAddr = liquidStakingOwner
+	require.Equal(t, vmcommon.UserError, d.Execute(vmInput))
+	require.Equal(t, "liquid staking token must not have a circulating supply", eei.returnMessage)
+
+	output := delegateForLiquidStaking(d, eei, delegator1, 100)
+	require.Equal(t, vmcommon.Ok, output)
+
+	resetLiquidStakingOutput(eei)
+	vmInput = getDefaultVmInputForFunc("unDelegate", [][]byte{})
+	vmInput.CallerAddr = liquidStakingOwner
+	vmInput.ESDTTransfers = []*vmcommon.ESDTTransfer{{ESDTTokenName: liquidStakingTokenID, ESDTValue: big.NewInt(100)}}
+	output = d.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.Equal(t, "only the liquid staking receipts can be transferred to unDelegate", eei.returnMessage)
+
+	_, dData, _ := d.getOrCreateDelegatorData(delegator1)
+	fund, _ := d.getFund(dData.ActiveFund)
+	assert.Equal(t, big.NewInt(100), fund.Value)
+
+	globalFund, _ := d.getGlobalFundData()
+	assert.Equal(t, big.NewInt(100), globalFund.TotalActive)
+	assert.Equal(t, big.NewInt(0), globalFund.TotalUnStaked)
+}
+
+func TestDelegation_ExecuteGetLiquidStakingReceiptsValue(t *testing.T) {
+	t.Parallel()
+
+	delegator1 := []byte("delegator1")
+	d, eei := createDelegationWithLiquidStakingToken(t)
+
+	output := delegateForLiquidStaking(d, eei, delegator1, 20)
+	require.Equal(t, vmcommon.Ok, output)
+
+	_, pool, _ := d.getOrCreateDelegatorData([]byte("addr"))
+	poolFund, _ := d.getFund(pool.ActiveFund)
+	poolFund.Value.SetInt64(60)
+	_ = d.saveFund(pool.ActiveFund, poolFund)
+
+	resetLiquidStakingOutput(eei)
+	vmInput := getDefaultVmInputForFunc("getLiquidStakingReceiptsValue", [][]byte{{10}})
+	output = d.Execute(vmInput)
+	require.Equal(t, vmcommon.Ok, output)
+	assert.Equal(t, [][]byte{{30}}, eei.output)
+
+	resetLiquidStakingOutput(eei)
+	vmInput.Arguments = [][]byte{{21}}
+	output = d.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.Equal(t, "invalid liquid staking receipts value", eei.returnMessage)
+
+	resetLiquidStakingOutput(eei)
+	vmInput.Arguments = [][]byte{delegator1, {10}}
+	output = d.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.Equal(t, vm.ErrInvalidNumOfArguments.Error(), eei.returnMessage)
+
+	// the active stake of the liquid staking delegators is held by the receipts, so their balance has to be provided
+	resetLiquidStakingOutput(eei)
+	vmInput = getDefaultVmInputForFunc("getUserActiveStake", [][]byte{delegator1})
+	output = d.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.Equal(t, "view function works only for existing delegators", eei.returnMessage)
+}
+
+func TestDelegation_ExecuteGetUserActiveStakeWithLiquidStakingReceipts(t *testing.T) {
+	t.Parallel()
+
+	delegator1 := []byte("delegator1")
+	d, eei := createDelegationWithLiquidStakingToken(t)
+
+	output := delegateForLiquidStaking(d, eei, delegator1, 20)
+	require.Equal(t, vmcommon.Ok, output)
+	output = delegateForLiquidStaking(d, eei, liquidStakingOwner, 15)
+	require.Equal(t, vmcommon.Ok, output)
+
+	_, pool, _ := d.getOrCreateDelegatorData([]byte("addr"))
+	poolFund, _ := d.getFund(pool.ActiveFund)
+	poolFund.Value.SetInt64(60)
+	_ = d.saveFund(pool.ActiveFund, poolFund)
+
+	// a holder which only received receipts has no delegator entry
+	resetLiquidStakingOutput(eei)
+	vmInput := getDefaultVmInputForFunc("getUserActiveStake", [][]byte{[]byte("holder"), {10}})
+	output = d.Execute(vmInput)
+	require.Equal(t, vmcommon.Ok, output)
+	assert.Equal(t, [][]byte{{30}}, eei.output)
+
+	// the stake kept in the contract storage is added to the stake of the receipts
+	resetLiquidStakingOutput(eei)
+	vmInput.Arguments = [][]byte{liquidStakingOwner, {4}}
+	output = d.Execute(vmInput)
+	require.Equal(t, vmcommon.Ok, output)
+	assert.Equal(t, [][]byte{{27}}, eei.output)
+
+	resetLiquidStakingOutput(eei)
+	vmInput.Arguments = [][]byte{liquidStakingOwner, {21}}
+	output = d.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.Equal(t, "invalid liquid staking receipts value", eei.returnMessage)
+
+	resetLiquidStakingOutput(eei)
+	vmInput.Arguments = [][]byte{liquidStakingOwner}
+	output = d.Execute(vmInput)
+	require.Equal(t, vmcommon.Ok, output)
+	assert.Equal(t, [][]byte{{15}}, eei.output)
+}
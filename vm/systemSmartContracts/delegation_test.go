@@ -44,6 +44,7 @@ func createMockArgumentsForDelegation() ArgsNewDelegation {
 		EpochNotifier:          &mock.EpochNotifierStub{},
 		EndOfEpochAddress:      vm.EndOfEpochAddress,
 		GovernanceSCAddress:    vm.GovernanceSCAddress,
+		ESDTSCAddress:          vm.ESDTSCAddress,
 	}
 }
 
@@ -166,6 +167,18 @@ func TestNewDelegationSystemSC_InvalidDelegationMgrSCAddrShouldErr(t *testing.T)
 	assert.Equal(t, expectedErr, err)
 }
 
+func TestNewDelegationSystemSC_InvalidESDTSCAddrShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := fmt.Errorf("%w for esdt sc address", vm.ErrInvalidAddress)
+	args := createMockArgumentsForDelegation()
+	args.ESDTSCAddress = []byte{}
+
+	d, err := NewDelegationSystemSC(args)
+	assert.Nil(t, d)
+	assert.Equal(t, expectedErr, err)
+}
+
 func TestNewDelegationSystemSC_NilMarshalizerShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"math"
 	"math/big"
+	"strconv"
+	"strings"
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
@@ -13,6 +15,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/hashing"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common/governance"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/vm"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
@@ -29,6 +32,15 @@ const noString = "no"
 const vetoString = "veto"
 const hardForkEpochGracePeriod = 2
 const commitHashLength = 40
+const proposalsCountKey = "proposalsCount"
+const proposalIndexPrefix = "proposalIndex_"
+const outcomePassed = "passed"
+const outcomeRejected = "rejected"
+const outcomeVetoed = "vetoed"
+const outcomeQuorumNotReached = "quorumNotReached"
+const configChangePrefix = "configChange_"
+const pendingConfigChangesKey = "pendingConfigChanges"
+const governedConfigKey = "governedConfig"
 
 // ArgsNewGovernanceContract defines the arguments needed for the on-chain governance contract
 type ArgsNewGovernanceContract struct {
@@ -40,6 +52,7 @@ type ArgsNewGovernanceContract struct {
 	GovernanceSCAddress         []byte
 	DelegationMgrSCAddress      []byte
 	ValidatorSCAddress          []byte
+	EndOfEpochAddress           []byte
 	InitialWhiteListedAddresses [][]byte
 	EpochNotifier               vm.EpochNotifier
 	EpochConfig                 config.EpochConfig
@@ -53,12 +66,17 @@ type governanceContract struct {
 	governanceSCAddress         []byte
 	delegationMgrSCAddress      []byte
 	validatorSCAddress          []byte
+	endOfEpochAddress           []byte
 	marshalizer                 marshal.Marshalizer
 	hasher                      hashing.Hasher
 	governanceConfig            config.GovernanceSystemSCConfig
 	initialWhiteListedAddresses [][]byte
 	enabledEpoch                uint32
 	flagEnabled                 atomic.Flag
+	governedConfigEnableEpoch   uint32
+	flagGovernedConfig          atomic.Flag
+	governanceV2EnableEpoch     uint32
+	flagGovernanceV2            atomic.Flag
 	mutExecution                sync.RWMutex
 }
 
@@ -92,21 +110,29 @@ func NewGovernanceContract(args ArgsNewGovernanceContract) (*governanceContract,
 	if len(args.GovernanceSCAddress) < 1 {
 		return nil, fmt.Errorf("%w for governance sc address", vm.ErrInvalidAddress)
 	}
+	if len(args.EndOfEpochAddress) < 1 {
+		return nil, fmt.Errorf("%w for end of epoch address", vm.ErrInvalidAddress)
+	}
 
 	g := &governanceContract{
-		eei:                    args.Eei,
-		gasCost:                args.GasCost,
-		baseProposalCost:       baseProposalCost,
-		ownerAddress:           nil,
-		governanceSCAddress:    args.GovernanceSCAddress,
-		delegationMgrSCAddress: args.DelegationMgrSCAddress,
-		validatorSCAddress:     args.ValidatorSCAddress,
-		marshalizer:            args.Marshalizer,
-		hasher:                 args.Hasher,
-		governanceConfig:       args.GovernanceConfig,
-		enabledEpoch:           args.EpochConfig.EnableEpochs.GovernanceEnableEpoch,
+		eei:                       args.Eei,
+		gasCost:                   args.GasCost,
+		baseProposalCost:          baseProposalCost,
+		ownerAddress:              nil,
+		governanceSCAddress:       args.GovernanceSCAddress,
+		delegationMgrSCAddress:    args.DelegationMgrSCAddress,
+		validatorSCAddress:        args.ValidatorSCAddress,
+		endOfEpochAddress:         args.EndOfEpochAddress,
+		marshalizer:               args.Marshalizer,
+		hasher:                    args.Hasher,
+		governanceConfig:          args.GovernanceConfig,
+		enabledEpoch:              args.EpochConfig.EnableEpochs.GovernanceEnableEpoch,
+		governedConfigEnableEpoch: args.EpochConfig.EnableEpochs.GovernedConfigEnableEpoch,
+		governanceV2EnableEpoch:   args.EpochConfig.EnableEpochs.GovernanceV2EnableEpoch,
 	}
 	log.Debug("governance: enable epoch for governance", "epoch", g.enabledEpoch)
+	log.Debug("governance: enable epoch for governed config", "epoch", g.governedConfigEnableEpoch)
+	log.Debug("governance: enable epoch for governance v2", "epoch", g.governanceV2EnableEpoch)
 
 	err := g.validateInitialWhiteListedAddresses(args.InitialWhiteListedAddresses)
 	if err != nil {
@@ -160,12 +186,24 @@ func (g *governanceContract) Execute(args *vmcommon.ContractCallInput) vmcommon.
 		return g.hardForkProposal(args)
 	case "changeConfig":
 		return g.changeConfig(args)
+	case "configChangeProposal":
+		return g.configChangeProposal(args)
+	case "activateConfigChanges":
+		return g.activateConfigChanges(args)
 	case "closeProposal":
 		return g.closeProposal(args)
 	case "getValidatorVotingPower":
 		return g.getValidatorVotingPower(args)
 	case "getBalanceVotingPower":
 		return g.getBalanceVotingPower(args)
+	case "getConfig":
+		return g.viewConfig(args)
+	case "getProposals":
+		return g.viewProposals(args)
+	case "getProposal":
+		return g.viewProposal(args)
+	case "getVoteSet":
+		return g.viewVoteSet(args)
 	}
 
 	g.eei.AddReturnMessage("invalid method to call")
@@ -274,6 +312,7 @@ func (g *governanceContract) proposal(args *vmcommon.ContractCallInput) vmcommon
 		g.eei.AddReturnMessage("saveGeneralProposal " + err.Error())
 		return vmcommon.UserError
 	}
+	g.addProposalToIndex(commitHash)
 
 	return vmcommon.Ok
 }
@@ -852,7 +891,7 @@ func (g *governanceContract) closeProposal(args *vmcommon.ContractCallInput) vmc
 	}
 
 	generalProposal.Closed = true
-	err = g.computeEndResults(generalProposal)
+	outcome, err := g.computeEndResults(generalProposal)
 	if err != nil {
 		g.eei.AddReturnMessage("computeEndResults error" + err.Error())
 		return vmcommon.UserError
@@ -866,9 +905,221 @@ func (g *governanceContract) closeProposal(args *vmcommon.ContractCallInput) vmc
 		return vmcommon.UserError
 	}
 
+	if generalProposal.Passed {
+		err = g.addPendingConfigChange(proposal)
+		if err != nil {
+			g.eei.AddReturnMessage("addPendingConfigChange error " + err.Error())
+			return vmcommon.UserError
+		}
+	}
+
+	if g.flagGovernanceV2.IsSet() {
+		g.eei.Finish([]byte(outcome))
+	}
+
+	return vmcommon.Ok
+}
+
+// configChangeProposal creates a new proposal which, once passed, changes one of the governed configuration values
+//  args.Arguments[0] - proposal reference (github commit)
+//  args.Arguments[1] - start vote nonce
+//  args.Arguments[2] - end vote nonce
+//  args.Arguments[3] - governed configuration name (EnableEpochs.PenalizedTooMuchGasEnableEpoch,
+//                      EnableEpochs.GasPriceModifierEnableEpoch or FeeSettings.<setting name>)
+//  args.Arguments[4] - new value
+func (g *governanceContract) configChangeProposal(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !g.flagGovernedConfig.IsSet() {
+		g.eei.AddReturnMessage("config change proposals are not enabled")
+		return vmcommon.UserError
+	}
+	if args.CallValue.Cmp(g.baseProposalCost) != 0 {
+		g.eei.AddReturnMessage("invalid proposal cost, expected " + g.baseProposalCost.String())
+		return vmcommon.OutOfFunds
+	}
+	err := g.eei.UseGas(g.gasCost.MetaChainSystemSCsCost.Proposal)
+	if err != nil {
+		g.eei.AddReturnMessage("not enough gas")
+		return vmcommon.OutOfGas
+	}
+	if len(args.Arguments) != 5 {
+		g.eei.AddReturnMessage("invalid number of arguments, expected 5")
+		return vmcommon.FunctionWrongSignature
+	}
+	if !g.isWhiteListed(args.CallerAddr) {
+		g.eei.AddReturnMessage("called address is not whiteListed")
+		return vmcommon.UserError
+	}
+	commitHash := args.Arguments[0]
+	if len(commitHash) != commitHashLength {
+		g.eei.AddReturnMessage(fmt.Sprintf("invalid github commit length, wanted exactly %d", commitHashLength))
+		return vmcommon.UserError
+	}
+	if g.proposalExists(commitHash) {
+		g.eei.AddReturnMessage("proposal already exists")
+		return vmcommon.UserError
+	}
+
+	startVoteNonce, endVoteNonce, err := g.startEndNonceFromArguments(args.Arguments[1], args.Arguments[2])
+	if err != nil {
+		g.eei.AddReturnMessage("invalid start/end vote nonce " + err.Error())
+		return vmcommon.UserError
+	}
+
+	configChange := &governance.GovernedConfigChange{
+		Name:  string(args.Arguments[3]),
+		Value: string(args.Arguments[4]),
+	}
+	err = governance.CheckGovernedConfigChange(configChange.Name, configChange.Value)
+	if err != nil {
+		g.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if strings.HasPrefix(configChange.Name, governance.GovernedEnableEpochPrefix) {
+		enableEpoch, _ := strconv.ParseUint(configChange.Value, 10, 32)
+		if uint32(enableEpoch) <= g.eei.BlockChainHook().CurrentEpoch() {
+			g.eei.AddReturnMessage("invalid enable epoch, should be greater than the current epoch")
+			return vmcommon.UserError
+		}
+	}
+
+	marshaledData, err := g.marshalizer.Marshal(configChange)
+	if err != nil {
+		g.eei.AddReturnMessage("marshal config change " + err.Error())
+		return vmcommon.UserError
+	}
+	g.eei.SetStorage(append([]byte(configChangePrefix), commitHash...), marshaledData)
+
+	generalProposal := &GeneralProposal{
+		IssuerAddress:  args.CallerAddr,
+		CommitHash:     commitHash,
+		StartVoteNonce: startVoteNonce,
+		EndVoteNonce:   endVoteNonce,
+		Yes:            big.NewInt(0),
+		No:             big.NewInt(0),
+		Veto:           big.NewInt(0),
+		Passed:         false,
+		Votes:          make([][]byte, 0),
+	}
+	err = g.saveGeneralProposal(commitHash, generalProposal)
+	if err != nil {
+		log.Warn("saveGeneralProposal", "err", err)
+		g.eei.AddReturnMessage("saveGeneralProposal " + err.Error())
+		return vmcommon.UserError
+	}
+	g.addProposalToIndex(commitHash)
+
 	return vmcommon.Ok
 }
 
+// activateConfigChanges is called at each epoch start. It activates the configuration changes of the proposals passed
+//  since the previous call, starting with the next epoch, and returns all the activated configuration changes
+func (g *governanceContract) activateConfigChanges(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !bytes.Equal(args.CallerAddr, g.endOfEpochAddress) {
+		g.eei.AddReturnMessage("activateConfigChanges can be called only by the end of epoch address")
+		return vmcommon.UserError
+	}
+	if !g.flagGovernedConfig.IsSet() {
+		g.eei.AddReturnMessage("config change proposals are not enabled")
+		return vmcommon.UserError
+	}
+
+	pendingConfig, err := g.getGovernedConfig([]byte(pendingConfigChangesKey))
+	if err != nil {
+		g.eei.AddReturnMessage("getGovernedConfig error " + err.Error())
+		return vmcommon.UserError
+	}
+	activeConfig, err := g.getGovernedConfig([]byte(governedConfigKey))
+	if err != nil {
+		g.eei.AddReturnMessage("getGovernedConfig error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	if len(pendingConfig.Changes) > 0 {
+		nextEpoch := g.eei.BlockChainHook().CurrentEpoch() + 1
+		for _, configChange := range pendingConfig.Changes {
+			configChange.EnableEpoch = nextEpoch
+			if strings.HasPrefix(configChange.Name, governance.GovernedEnableEpochPrefix) {
+				requestedEpoch, _ := strconv.ParseUint(configChange.Value, 10, 32)
+				if uint32(requestedEpoch) > nextEpoch {
+					configChange.EnableEpoch = uint32(requestedEpoch)
+				}
+			}
+
+			activeConfig.Changes = append(activeConfig.Changes, configChange)
+		}
+
+		err = g.saveGovernedConfig([]byte(governedConfigKey), activeConfig)
+		if err != nil {
+			g.eei.AddReturnMessage("saveGovernedConfig error " + err.Error())
+			return vmcommon.UserError
+		}
+		g.eei.SetStorage([]byte(pendingConfigChangesKey), nil)
+	}
+
+	if len(activeConfig.Changes) == 0 {
+		return vmcommon.Ok
+	}
+
+	marshaledData, err := g.marshalizer.Marshal(activeConfig)
+	if err != nil {
+		g.eei.AddReturnMessage("marshal governed config " + err.Error())
+		return vmcommon.UserError
+	}
+	g.eei.Finish(marshaledData)
+
+	return vmcommon.Ok
+}
+
+// addPendingConfigChange queues the configuration change of a passed proposal, if any, until the next epoch start
+func (g *governanceContract) addPendingConfigChange(reference []byte) error {
+	marshaledData := g.eei.GetStorage(append([]byte(configChangePrefix), reference...))
+	if len(marshaledData) == 0 {
+		return nil
+	}
+
+	configChange := &governance.GovernedConfigChange{}
+	err := g.marshalizer.Unmarshal(configChange, marshaledData)
+	if err != nil {
+		return err
+	}
+
+	pendingConfig, err := g.getGovernedConfig([]byte(pendingConfigChangesKey))
+	if err != nil {
+		return err
+	}
+	pendingConfig.Changes = append(pendingConfig.Changes, configChange)
+
+	return g.saveGovernedConfig([]byte(pendingConfigChangesKey), pendingConfig)
+}
+
+func (g *governanceContract) getGovernedConfig(key []byte) (*governance.GovernedConfig, error) {
+	governedConfig := &governance.GovernedConfig{
+		Changes: make([]*governance.GovernedConfigChange, 0),
+	}
+	marshaledData := g.eei.GetStorage(key)
+	if len(marshaledData) == 0 {
+		return governedConfig, nil
+	}
+
+	err := g.marshalizer.Unmarshal(governedConfig, marshaledData)
+	if err != nil {
+		return nil, err
+	}
+
+	return governedConfig, nil
+}
+
+func (g *governanceContract) saveGovernedConfig(key []byte, governedConfig *governance.GovernedConfig) error {
+	marshaledData, err := g.marshalizer.Marshal(governedConfig)
+	if err != nil {
+		return err
+	}
+
+	g.eei.SetStorage(key, marshaledData)
+
+	return nil
+}
+
 //TODO: the problem is that voteKey has to be short - as these kind of lists can't be longer than 1MB
 func (g *governanceContract) deleteAllVotes(proposal *GeneralProposal) {
 	for _, address := range proposal.Votes {
@@ -950,6 +1201,172 @@ func (g *governanceContract) getBalanceVotingPower(args *vmcommon.ContractCallIn
 	return vmcommon.Ok
 }
 
+func (g *governanceContract) checkArgumentsForView(args *vmcommon.ContractCallInput, numArguments int) vmcommon.ReturnCode {
+	if !g.flagGovernanceV2.IsSet() {
+		g.eei.AddReturnMessage("invalid method to call")
+		return vmcommon.UserError
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		g.eei.AddReturnMessage(vm.TransactionValueMustBeZero)
+		return vmcommon.UserError
+	}
+	err := g.eei.UseGas(g.gasCost.MetaChainSystemSCsCost.Vote)
+	if err != nil {
+		g.eei.AddReturnMessage("not enough gas")
+		return vmcommon.OutOfGas
+	}
+	if len(args.Arguments) != numArguments {
+		g.eei.AddReturnMessage(fmt.Sprintf("invalid number of arguments, expected %d", numArguments))
+		return vmcommon.FunctionWrongSignature
+	}
+
+	return vmcommon.Ok
+}
+
+// viewConfig returns the active configuration: min quorum, min pass threshold, min veto threshold and proposal fee
+func (g *governanceContract) viewConfig(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	returnCode := g.checkArgumentsForView(args, 0)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	scConfig, err := g.getConfig()
+	if err != nil {
+		g.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	g.eei.Finish(scConfig.MinQuorum.Bytes())
+	g.eei.Finish(scConfig.MinPassThreshold.Bytes())
+	g.eei.Finish(scConfig.MinVetoThreshold.Bytes())
+	g.eei.Finish(scConfig.ProposalFee.Bytes())
+
+	return vmcommon.Ok
+}
+
+// viewProposals returns the references of the proposals, in the order they were created. The proposals are indexed
+// starting with the activation of governance v2, so the ones created before are not returned
+func (g *governanceContract) viewProposals(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	returnCode := g.checkArgumentsForView(args, 0)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	numProposals := g.getNumProposals()
+	for i := uint64(0); i < numProposals; i++ {
+		g.eei.Finish(g.eei.GetStorage(getProposalIndexKey(i)))
+	}
+
+	return vmcommon.Ok
+}
+
+// viewProposal returns a proposal together with its tally. For an open proposal the outcome is the one it would have
+//  if it was closed now. Accepts a single parameter:
+//  args.Arguments[0] - proposal reference
+func (g *governanceContract) viewProposal(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	returnCode := g.checkArgumentsForView(args, 1)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	generalProposal, err := g.getGeneralProposal(args.Arguments[0])
+	if err != nil {
+		g.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	scConfig, err := g.getConfig()
+	if err != nil {
+		g.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	outcome := tallyProposal(generalProposal, scConfig)
+	if generalProposal.Closed {
+		outcome = getClosedProposalOutcome(generalProposal, outcome)
+	}
+
+	closed := "false"
+	if generalProposal.Closed {
+		closed = "true"
+	}
+
+	g.eei.Finish(generalProposal.IssuerAddress)
+	g.eei.Finish(generalProposal.CommitHash)
+	g.eei.Finish(big.NewInt(0).SetUint64(generalProposal.StartVoteNonce).Bytes())
+	g.eei.Finish(big.NewInt(0).SetUint64(generalProposal.EndVoteNonce).Bytes())
+	g.eei.Finish(generalProposal.Yes.Bytes())
+	g.eei.Finish(generalProposal.No.Bytes())
+	g.eei.Finish(generalProposal.Veto.Bytes())
+	g.eei.Finish(big.NewInt(int64(len(generalProposal.Votes))).Bytes())
+	g.eei.Finish([]byte(closed))
+	g.eei.Finish([]byte(outcome))
+
+	return vmcommon.Ok
+}
+
+// getClosedProposalOutcome returns the outcome saved at closing time. The config might have changed in the meantime,
+//  so a recomputed outcome is only used to explain why the proposal did not pass
+func getClosedProposalOutcome(proposal *GeneralProposal, recomputedOutcome string) string {
+	if proposal.Passed {
+		return outcomePassed
+	}
+	if recomputedOutcome == outcomePassed {
+		return outcomeRejected
+	}
+
+	return recomputedOutcome
+}
+
+// viewVoteSet returns the votes cast by an address on a proposal: used power, used balance, the totals for each
+//  option and then, for each vote, its option, power, balance and the address it was delegated to. The votes are
+//  deleted when the proposal is closed. Accepts 2 parameters:
+//  args.Arguments[0] - proposal reference
+//  args.Arguments[1] - voter address
+func (g *governanceContract) viewVoteSet(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	returnCode := g.checkArgumentsForView(args, 2)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	voteSet, err := g.getOrCreateVoteSet(getVoteItemKey(args.Arguments[0], args.Arguments[1]))
+	if err != nil {
+		g.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	g.eei.Finish(voteSet.UsedPower.Bytes())
+	g.eei.Finish(voteSet.UsedBalance.Bytes())
+	g.eei.Finish(voteSet.TotalYes.Bytes())
+	g.eei.Finish(voteSet.TotalNo.Bytes())
+	g.eei.Finish(voteSet.TotalVeto.Bytes())
+	for _, voteItem := range voteSet.VoteItems {
+		g.eei.Finish([]byte(voteTypeToString(voteItem.Value)))
+		g.eei.Finish(voteItem.Power.Bytes())
+		g.eei.Finish(voteItem.Balance.Bytes())
+		g.eei.Finish(voteItem.DelegatedTo)
+	}
+
+	return vmcommon.Ok
+}
+
+func (g *governanceContract) addProposalToIndex(reference []byte) {
+	if !g.flagGovernanceV2.IsSet() {
+		return
+	}
+
+	numProposals := g.getNumProposals()
+	g.eei.SetStorage(getProposalIndexKey(numProposals), reference)
+	g.eei.SetStorage([]byte(proposalsCountKey), big.NewInt(0).SetUint64(numProposals+1).Bytes())
+}
+
+func (g *governanceContract) getNumProposals() uint64 {
+	return big.NewInt(0).SetBytes(g.eei.GetStorage([]byte(proposalsCountKey))).Uint64()
+}
+
+func getProposalIndexKey(index uint64) []byte {
+	return append([]byte(proposalIndexPrefix), big.NewInt(0).SetUint64(index).Bytes()...)
+}
+
 // saveGeneralProposal saves a proposal into the storage
 func (g *governanceContract) saveGeneralProposal(reference []byte, generalProposal *GeneralProposal) error {
 	marshaledData, err := g.marshalizer.Marshal(generalProposal)
@@ -1209,6 +1626,18 @@ func (g *governanceContract) castVoteType(vote string) (VoteValueType, error) {
 	}
 }
 
+// voteTypeToString returns the string used when voting for a vote option
+func voteTypeToString(vote VoteValueType) string {
+	switch vote {
+	case Yes:
+		return yesString
+	case No:
+		return noString
+	default:
+		return vetoString
+	}
+}
+
 // getOrCreateVoteSet returns the vote data from storage for a given proposer/validator pair.
 //  If no vote data exists, it returns a new instance of VoteSet
 func (g *governanceContract) getOrCreateVoteSet(key []byte) (*VoteSet, error) {
@@ -1381,33 +1810,38 @@ func (g *governanceContract) nonceFromBytes(nonce []byte) (*big.Int, error) {
 	return voteNonce, nil
 }
 
-// computeEndResults computes if a proposal has passed or not based on votes accumulated
-func (g *governanceContract) computeEndResults(proposal *GeneralProposal) error {
+// computeEndResults computes if a proposal has passed or not based on votes accumulated and returns the outcome
+func (g *governanceContract) computeEndResults(proposal *GeneralProposal) (string, error) {
 	baseConfig, err := g.getConfig()
 	if err != nil {
-		return err
+		return "", err
 	}
 
+	outcome := tallyProposal(proposal, baseConfig)
+	proposal.Passed = outcome == outcomePassed
+
+	return outcome, nil
+}
+
+// tallyProposal applies the quorum, veto and pass rules on the votes accumulated by a proposal. The quorum is checked
+//  against all the votes, including the veto ones
+func tallyProposal(proposal *GeneralProposal, baseConfig *GovernanceConfigV2) string {
 	totalVotes := big.NewInt(0).Add(proposal.Yes, proposal.No)
 	totalVotes.Add(totalVotes, proposal.Veto)
 
 	if totalVotes.Cmp(baseConfig.MinQuorum) == -1 {
-		proposal.Passed = false
-		return nil
+		return outcomeQuorumNotReached
 	}
 
 	if proposal.Veto.Cmp(baseConfig.MinVetoThreshold) >= 0 {
-		proposal.Passed = false
-		return nil
+		return outcomeVetoed
 	}
 
 	if proposal.Yes.Cmp(baseConfig.MinPassThreshold) >= 0 && proposal.Yes.Cmp(proposal.No) == 1 {
-		proposal.Passed = true
-		return nil
+		return outcomePassed
 	}
 
-	proposal.Passed = false
-	return nil
+	return outcomeRejected
 }
 
 // convertV2Config converts the passed config file to the correct V2 typed GovernanceConfig
@@ -1441,6 +1875,12 @@ func (g *governanceContract) convertV2Config(config config.GovernanceSystemSCCon
 func (g *governanceContract) EpochConfirmed(epoch uint32, _ uint64) {
 	g.flagEnabled.Toggle(epoch >= g.enabledEpoch)
 	log.Debug("governance contract", "enabled", g.flagEnabled.IsSet())
+
+	g.flagGovernedConfig.Toggle(epoch >= g.governedConfigEnableEpoch)
+	log.Debug("governance contract: governed config", "enabled", g.flagGovernedConfig.IsSet())
+
+	g.flagGovernanceV2.Toggle(epoch >= g.governanceV2EnableEpoch)
+	log.Debug("governance contract: governance v2", "enabled", g.flagGovernanceV2.IsSet())
 }
 
 // CanUseContract returns true if contract is enabled
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/common/governance"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/vm"
 	"github.com/ElrondNetwork/elrond-go/vm/mock"
@@ -38,6 +39,7 @@ func createMockGovernanceArgs() ArgsNewGovernanceContract {
 		GovernanceSCAddress:         vm.GovernanceSCAddress,
 		DelegationMgrSCAddress:      vm.DelegationManagerSCAddress,
 		ValidatorSCAddress:          vm.ValidatorSCAddress,
+		EndOfEpochAddress:           vm.EndOfEpochAddress,
 		EpochNotifier:               &mock.EpochNotifierStub{},
 		InitialWhiteListedAddresses: [][]byte{vm.GovernanceSCAddress},
 	}
@@ -143,6 +145,17 @@ func TestNewGovernanceContract_InvalidGovernanceAddress(t *testing.T) {
 	require.True(t, errors.Is(err, vm.ErrInvalidAddress))
 }
 
+func TestNewGovernanceContract_InvalidEndOfEpochAddress(t *testing.T) {
+	t.Parallel()
+
+	args := createMockGovernanceArgs()
+	args.EndOfEpochAddress = nil
+
+	gsc, err := NewGovernanceContract(args)
+	require.Nil(t, gsc)
+	require.True(t, errors.Is(err, vm.ErrInvalidAddress))
+}
+
 func TestNewGovernanceContract_InvalidWhiteList(t *testing.T) {
 	t.Parallel()
 
@@ -3233,8 +3246,9 @@ func TestComputeEndResults(t *testing.T) {
 		No:   big.NewInt(0),
 		Veto: big.NewInt(0),
 	}
-	err := gsc.computeEndResults(didNotPassQuorum)
+	outcome, err := gsc.computeEndResults(didNotPassQuorum)
 	require.Nil(t, err)
+	require.Equal(t, outcomeQuorumNotReached, outcome)
 	require.False(t, didNotPassQuorum.Passed)
 
 	didNotPassVotes := &GeneralProposal{
@@ -3242,8 +3256,9 @@ func TestComputeEndResults(t *testing.T) {
 		No:   big.NewInt(50),
 		Veto: big.NewInt(0),
 	}
-	err = gsc.computeEndResults(didNotPassVotes)
+	outcome, err = gsc.computeEndResults(didNotPassVotes)
 	require.Nil(t, err)
+	require.Equal(t, outcomeRejected, outcome)
 	require.False(t, didNotPassVotes.Passed)
 
 	didNotPassVotes2 := &GeneralProposal{
@@ -3251,8 +3266,9 @@ func TestComputeEndResults(t *testing.T) {
 		No:   big.NewInt(51),
 		Veto: big.NewInt(0),
 	}
-	err = gsc.computeEndResults(didNotPassVotes2)
+	outcome, err = gsc.computeEndResults(didNotPassVotes2)
 	require.Nil(t, err)
+	require.Equal(t, outcomeRejected, outcome)
 	require.False(t, didNotPassVotes2.Passed)
 
 	didNotPassVeto := &GeneralProposal{
@@ -3260,8 +3276,9 @@ func TestComputeEndResults(t *testing.T) {
 		No:   big.NewInt(50),
 		Veto: big.NewInt(30),
 	}
-	err = gsc.computeEndResults(didNotPassVeto)
+	outcome, err = gsc.computeEndResults(didNotPassVeto)
 	require.Nil(t, err)
+	require.Equal(t, outcomeVetoed, outcome)
 	require.False(t, didNotPassVeto.Passed)
 
 	pass := &GeneralProposal{
@@ -3269,11 +3286,214 @@ func TestComputeEndResults(t *testing.T) {
 		No:   big.NewInt(50),
 		Veto: big.NewInt(29),
 	}
-	err = gsc.computeEndResults(pass)
+	outcome, err = gsc.computeEndResults(pass)
 	require.Nil(t, err)
+	require.Equal(t, outcomePassed, outcome)
 	require.True(t, pass.Passed)
 }
 
+func createGovernanceStorageEei(storage map[string][]byte, finishedData *[][]byte, currentNonce *uint64) *mock.SystemEIStub {
+	return &mock.SystemEIStub{
+		BlockChainHookCalled: func() vm.BlockchainHook {
+			return &mock.BlockChainHookStub{
+				CurrentNonceCalled: func() uint64 {
+					return *currentNonce
+				},
+			}
+		},
+		GetStorageCalled: func(key []byte) []byte {
+			return storage[string(key)]
+		},
+		SetStorageCalled: func(key []byte, value []byte) {
+			storage[string(key)] = value
+		},
+		FinishCalled: func(value []byte) {
+			*finishedData = append(*finishedData, value)
+		},
+	}
+}
+
+func TestGovernanceContract_ViewsOnProposals(t *testing.T) {
+	t.Parallel()
+
+	storage := make(map[string][]byte)
+	finishedData := make([][]byte, 0)
+	args := createMockGovernanceArgs()
+	currentNonce := uint64(0)
+	args.Eei = createGovernanceStorageEei(storage, &finishedData, &currentNonce)
+	gsc, _ := NewGovernanceContract(args)
+
+	retCode := gsc.Execute(createVMInput(zero, "initV2", vm.GovernanceSCAddress, vm.GovernanceSCAddress, nil))
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	firstProposal := bytes.Repeat([]byte("a"), commitHashLength)
+	secondProposal := bytes.Repeat([]byte("b"), commitHashLength)
+	for _, reference := range [][]byte{firstProposal, secondProposal} {
+		callInputArgs := [][]byte{reference, []byte("1"), []byte("10")}
+		callInput := createVMInput(big.NewInt(500), "proposal", vm.GovernanceSCAddress, vm.GovernanceSCAddress, callInputArgs)
+		retCode = gsc.Execute(callInput)
+		require.Equal(t, vmcommon.Ok, retCode)
+	}
+
+	retCode = gsc.Execute(createVMInput(zero, "getProposals", vm.GovernanceSCAddress, vm.GovernanceSCAddress, nil))
+	require.Equal(t, vmcommon.Ok, retCode)
+	require.Equal(t, [][]byte{firstProposal, secondProposal}, finishedData)
+
+	voter := []byte("voter")
+	voteSet := gsc.getEmptyVoteSet()
+	proposal, _ := gsc.getGeneralProposal(secondProposal)
+	err := gsc.addNewVote(voter, &VoteDetails{Value: Yes, Power: big.NewInt(60), Balance: big.NewInt(0)}, voteSet, proposal)
+	require.Nil(t, err)
+
+	finishedData = finishedData[:0]
+	retCode = gsc.Execute(createVMInput(zero, "getProposal", vm.GovernanceSCAddress, vm.GovernanceSCAddress, [][]byte{secondProposal}))
+	require.Equal(t, vmcommon.Ok, retCode)
+	expectedProposal := [][]byte{
+		vm.GovernanceSCAddress,
+		secondProposal,
+		big.NewInt(1).Bytes(),
+		big.NewInt(10).Bytes(),
+		big.NewInt(60).Bytes(),
+		big.NewInt(0).Bytes(),
+		big.NewInt(0).Bytes(),
+		big.NewInt(1).Bytes(),
+		[]byte("false"),
+		[]byte(outcomePassed),
+	}
+	require.Equal(t, expectedProposal, finishedData)
+
+	finishedData = finishedData[:0]
+	retCode = gsc.Execute(createVMInput(zero, "getVoteSet", vm.GovernanceSCAddress, vm.GovernanceSCAddress, [][]byte{secondProposal, voter}))
+	require.Equal(t, vmcommon.Ok, retCode)
+	expectedVoteSet := [][]byte{
+		big.NewInt(60).Bytes(),
+		big.NewInt(0).Bytes(),
+		big.NewInt(60).Bytes(),
+		big.NewInt(0).Bytes(),
+		big.NewInt(0).Bytes(),
+		[]byte(yesString),
+		big.NewInt(60).Bytes(),
+		big.NewInt(0).Bytes(),
+		nil,
+	}
+	require.Equal(t, expectedVoteSet, finishedData)
+
+	finishedData = finishedData[:0]
+	retCode = gsc.Execute(createVMInput(zero, "getConfig", vm.GovernanceSCAddress, vm.GovernanceSCAddress, nil))
+	require.Equal(t, vmcommon.Ok, retCode)
+	expectedConfig := [][]byte{
+		big.NewInt(50).Bytes(),
+		big.NewInt(50).Bytes(),
+		big.NewInt(50).Bytes(),
+		big.NewInt(500).Bytes(),
+	}
+	require.Equal(t, expectedConfig, finishedData)
+}
+
+func TestGovernanceContract_CloseProposalShouldReturnTheOutcome(t *testing.T) {
+	t.Parallel()
+
+	storage := make(map[string][]byte)
+	finishedData := make([][]byte, 0)
+	args := createMockGovernanceArgs()
+	currentNonce := uint64(0)
+	args.Eei = createGovernanceStorageEei(storage, &finishedData, &currentNonce)
+	gsc, _ := NewGovernanceContract(args)
+
+	retCode := gsc.Execute(createVMInput(zero, "initV2", vm.GovernanceSCAddress, vm.GovernanceSCAddress, nil))
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	reference := bytes.Repeat([]byte("a"), commitHashLength)
+	callInputArgs := [][]byte{reference, []byte("1"), []byte("4")}
+	retCode = gsc.Execute(createVMInput(big.NewInt(500), "proposal", vm.GovernanceSCAddress, vm.GovernanceSCAddress, callInputArgs))
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	proposal, _ := gsc.getGeneralProposal(reference)
+	err := gsc.addNewVote([]byte("voter"), &VoteDetails{Value: Veto, Power: big.NewInt(60), Balance: big.NewInt(0)}, gsc.getEmptyVoteSet(), proposal)
+	require.Nil(t, err)
+
+	currentNonce = 4
+	retCode = gsc.Execute(createVMInput(zero, "closeProposal", vm.GovernanceSCAddress, vm.GovernanceSCAddress, [][]byte{reference}))
+	require.Equal(t, vmcommon.Ok, retCode)
+	require.Equal(t, [][]byte{[]byte(outcomeVetoed)}, finishedData)
+
+	finishedData = finishedData[:0]
+	retCode = gsc.Execute(createVMInput(zero, "getProposal", vm.GovernanceSCAddress, vm.GovernanceSCAddress, [][]byte{reference}))
+	require.Equal(t, vmcommon.Ok, retCode)
+	require.Equal(t, []byte("true"), finishedData[8])
+	require.Equal(t, []byte(outcomeVetoed), finishedData[9])
+}
+
+func TestGovernanceContract_GovernanceV2NotEnabled(t *testing.T) {
+	t.Parallel()
+
+	storage := make(map[string][]byte)
+	finishedData := make([][]byte, 0)
+	args := createMockGovernanceArgs()
+	args.EpochConfig.EnableEpochs.GovernanceV2EnableEpoch = 1
+	currentNonce := uint64(0)
+	args.Eei = createGovernanceStorageEei(storage, &finishedData, &currentNonce)
+	gsc, _ := NewGovernanceContract(args)
+
+	retCode := gsc.Execute(createVMInput(zero, "initV2", vm.GovernanceSCAddress, vm.GovernanceSCAddress, nil))
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	reference := bytes.Repeat([]byte("a"), commitHashLength)
+	callInputArgs := [][]byte{reference, []byte("1"), []byte("4")}
+	retCode = gsc.Execute(createVMInput(big.NewInt(500), "proposal", vm.GovernanceSCAddress, vm.GovernanceSCAddress, callInputArgs))
+	require.Equal(t, vmcommon.Ok, retCode)
+	_, found := storage[proposalsCountKey]
+	require.False(t, found)
+	_, found = storage[string(getProposalIndexKey(0))]
+	require.False(t, found)
+
+	for _, function := range []string{"getConfig", "getProposals"} {
+		retCode = gsc.Execute(createVMInput(zero, function, vm.GovernanceSCAddress, vm.GovernanceSCAddress, nil))
+		require.Equal(t, vmcommon.UserError, retCode)
+	}
+	retCode = gsc.Execute(createVMInput(zero, "getProposal", vm.GovernanceSCAddress, vm.GovernanceSCAddress, [][]byte{reference}))
+	require.Equal(t, vmcommon.UserError, retCode)
+	retCode = gsc.Execute(createVMInput(zero, "getVoteSet", vm.GovernanceSCAddress, vm.GovernanceSCAddress, [][]byte{reference, []byte("voter")}))
+	require.Equal(t, vmcommon.UserError, retCode)
+
+	currentNonce = 4
+	retCode = gsc.Execute(createVMInput(zero, "closeProposal", vm.GovernanceSCAddress, vm.GovernanceSCAddress, [][]byte{reference}))
+	require.Equal(t, vmcommon.Ok, retCode)
+	require.Equal(t, 0, len(finishedData))
+
+	gsc.EpochConfirmed(1, 0)
+	retCode = gsc.Execute(createVMInput(zero, "getProposals", vm.GovernanceSCAddress, vm.GovernanceSCAddress, nil))
+	require.Equal(t, vmcommon.Ok, retCode)
+	require.Equal(t, 0, len(finishedData))
+}
+
+func TestGovernanceContract_ViewsWrongArguments(t *testing.T) {
+	t.Parallel()
+
+	args := createMockGovernanceArgs()
+	gsc, _ := NewGovernanceContract(args)
+
+	retCode := gsc.Execute(createVMInput(big.NewInt(1), "getProposals", vm.GovernanceSCAddress, vm.GovernanceSCAddress, nil))
+	require.Equal(t, vmcommon.UserError, retCode)
+
+	retCode = gsc.Execute(createVMInput(zero, "getProposal", vm.GovernanceSCAddress, vm.GovernanceSCAddress, nil))
+	require.Equal(t, vmcommon.FunctionWrongSignature, retCode)
+
+	retCode = gsc.Execute(createVMInput(zero, "getVoteSet", vm.GovernanceSCAddress, vm.GovernanceSCAddress, [][]byte{[]byte("reference")}))
+	require.Equal(t, vmcommon.FunctionWrongSignature, retCode)
+
+	retCode = gsc.Execute(createVMInput(zero, "getProposal", vm.GovernanceSCAddress, vm.GovernanceSCAddress, [][]byte{[]byte("missing")}))
+	require.Equal(t, vmcommon.UserError, retCode)
+}
+
+func TestGetClosedProposalOutcome(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, outcomePassed, getClosedProposalOutcome(&GeneralProposal{Passed: true}, outcomeVetoed))
+	require.Equal(t, outcomeRejected, getClosedProposalOutcome(&GeneralProposal{Passed: false}, outcomePassed))
+	require.Equal(t, outcomeQuorumNotReached, getClosedProposalOutcome(&GeneralProposal{Passed: false}, outcomeQuorumNotReached))
+}
+
 func createMockStorer(callerAddress []byte, proposalIdentifier []byte, proposal *GeneralProposal) *mock.SystemEIStub {
 	return &mock.SystemEIStub{
 		GetStorageCalled: func(key []byte) []byte {
@@ -3315,3 +3535,121 @@ func createMockStorer(callerAddress []byte, proposalIdentifier []byte, proposal
 		},
 	}
 }
+
+func TestGovernanceContract_ConfigChangeProposalShouldBeActivatedAfterPassing(t *testing.T) {
+	t.Parallel()
+
+	storage := make(map[string][]byte)
+	finishedData := make([][]byte, 0)
+	args := createMockGovernanceArgs()
+	currentNonce := uint64(0)
+	currentEpoch := uint32(3)
+	eei := createGovernanceStorageEei(storage, &finishedData, &currentNonce)
+	eei.BlockChainHookCalled = func() vm.BlockchainHook {
+		return &mock.BlockChainHookStub{
+			CurrentNonceCalled: func() uint64 {
+				return currentNonce
+			},
+			CurrentEpochCalled: func() uint32 {
+				return currentEpoch
+			},
+		}
+	}
+	args.Eei = eei
+	gsc, _ := NewGovernanceContract(args)
+
+	retCode := gsc.Execute(createVMInput(zero, "initV2", vm.GovernanceSCAddress, vm.GovernanceSCAddress, nil))
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	feeReference := bytes.Repeat([]byte("a"), commitHashLength)
+	flagReference := bytes.Repeat([]byte("b"), commitHashLength)
+	rejectedReference := bytes.Repeat([]byte("c"), commitHashLength)
+	proposals := map[string][][]byte{
+		string(feeReference):      {feeReference, []byte("1"), []byte("4"), []byte("FeeSettings.MinGasPrice"), []byte("2000")},
+		string(flagReference):     {flagReference, []byte("1"), []byte("4"), []byte("EnableEpochs.GasPriceModifierEnableEpoch"), []byte("10")},
+		string(rejectedReference): {rejectedReference, []byte("1"), []byte("4"), []byte("FeeSettings.MinGasLimit"), []byte("100")},
+	}
+	for _, reference := range [][]byte{feeReference, flagReference, rejectedReference} {
+		callInput := createVMInput(big.NewInt(500), "configChangeProposal", vm.GovernanceSCAddress, vm.GovernanceSCAddress, proposals[string(reference)])
+		retCode = gsc.Execute(callInput)
+		require.Equal(t, vmcommon.Ok, retCode)
+	}
+
+	for _, reference := range [][]byte{feeReference, flagReference} {
+		proposal, _ := gsc.getGeneralProposal(reference)
+		err := gsc.addNewVote([]byte("voter"), &VoteDetails{Value: Yes, Power: big.NewInt(60), Balance: big.NewInt(0)}, gsc.getEmptyVoteSet(), proposal)
+		require.Nil(t, err)
+	}
+
+	currentNonce = 4
+	for _, reference := range [][]byte{feeReference, flagReference, rejectedReference} {
+		retCode = gsc.Execute(createVMInput(zero, "closeProposal", vm.GovernanceSCAddress, vm.GovernanceSCAddress, [][]byte{reference}))
+		require.Equal(t, vmcommon.Ok, retCode)
+	}
+
+	retCode = gsc.Execute(createVMInput(zero, "activateConfigChanges", vm.GovernanceSCAddress, vm.GovernanceSCAddress, nil))
+	require.Equal(t, vmcommon.UserError, retCode)
+
+	finishedData = finishedData[:0]
+	retCode = gsc.Execute(createVMInput(zero, "activateConfigChanges", vm.EndOfEpochAddress, vm.GovernanceSCAddress, nil))
+	require.Equal(t, vmcommon.Ok, retCode)
+	require.Equal(t, 1, len(finishedData))
+
+	governedConfig := &governance.GovernedConfig{}
+	err := args.Marshalizer.Unmarshal(governedConfig, finishedData[0])
+	require.Nil(t, err)
+	expectedConfig := &governance.GovernedConfig{
+		Changes: []*governance.GovernedConfigChange{
+			{Name: "FeeSettings.MinGasPrice", Value: "2000", EnableEpoch: 4},
+			{Name: "EnableEpochs.GasPriceModifierEnableEpoch", Value: "10", EnableEpoch: 10},
+		},
+	}
+	require.Equal(t, expectedConfig, governedConfig)
+	require.Equal(t, 0, len(storage[pendingConfigChangesKey]))
+
+	// the activated changes are returned at each epoch start
+	currentEpoch = 4
+	finishedData = finishedData[:0]
+	retCode = gsc.Execute(createVMInput(zero, "activateConfigChanges", vm.EndOfEpochAddress, vm.GovernanceSCAddress, nil))
+	require.Equal(t, vmcommon.Ok, retCode)
+	require.Equal(t, 1, len(finishedData))
+	governedConfig = &governance.GovernedConfig{}
+	_ = args.Marshalizer.Unmarshal(governedConfig, finishedData[0])
+	require.Equal(t, expectedConfig, governedConfig)
+}
+
+func TestGovernanceContract_ConfigChangeProposalInvalidChangeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	storage := make(map[string][]byte)
+	finishedData := make([][]byte, 0)
+	args := createMockGovernanceArgs()
+	currentNonce := uint64(0)
+	eei := createGovernanceStorageEei(storage, &finishedData, &currentNonce)
+	eei.BlockChainHookCalled = func() vm.BlockchainHook {
+		return &mock.BlockChainHookStub{
+			CurrentEpochCalled: func() uint32 {
+				return 10
+			},
+		}
+	}
+	args.Eei = eei
+	gsc, _ := NewGovernanceContract(args)
+
+	retCode := gsc.Execute(createVMInput(zero, "initV2", vm.GovernanceSCAddress, vm.GovernanceSCAddress, nil))
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	reference := bytes.Repeat([]byte("a"), commitHashLength)
+	invalidChanges := [][][]byte{
+		{[]byte("Unknown.Setting"), []byte("1")},
+		{[]byte("FeeSettings.MinGasPrice"), []byte("abc")},
+		{[]byte("EnableEpochs.GasPriceModifierEnableEpoch"), []byte("10")},
+		{[]byte("EnableEpochs.ESDTEnableEpoch"), []byte("20")},
+	}
+	for _, change := range invalidChanges {
+		callInputArgs := [][]byte{reference, []byte("1"), []byte("4"), change[0], change[1]}
+		retCode = gsc.Execute(createVMInput(big.NewInt(500), "configChangeProposal", vm.GovernanceSCAddress, vm.GovernanceSCAddress, callInputArgs))
+		require.Equal(t, vmcommon.UserError, retCode)
+	}
+	require.False(t, gsc.proposalExists(reference))
+}
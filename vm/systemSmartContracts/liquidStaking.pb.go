@@ -0,0 +1,458 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: liquidStaking.proto
+
+package systemSmartContracts
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	github_com_ElrondNetwork_elrond_go_core_data "github.com/ElrondNetwork/elrond-go-core/data"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_big "math/big"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type LiquidStakingData struct {
+	TokenID []byte        `protobuf:"bytes,1,opt,name=TokenID,proto3" json:"TokenID"`
+	Supply  *math_big.Int `protobuf:"bytes,2,opt,name=Supply,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go-core/data.BigIntCaster" json:"Supply"`
+}
+
+func (m *LiquidStakingData) Reset()      { *m = LiquidStakingData{} }
+func (*LiquidStakingData) ProtoMessage() {}
+func (*LiquidStakingData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba9d71ac181fc9d8, []int{0}
+}
+func (m *LiquidStakingData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidStakingData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *LiquidStakingData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidStakingData.Merge(m, src)
+}
+func (m *LiquidStakingData) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidStakingData) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidStakingData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidStakingData proto.InternalMessageInfo
+
+func (m *LiquidStakingData) GetTokenID() []byte {
+	if m != nil {
+		return m.TokenID
+	}
+	return nil
+}
+
+func (m *LiquidStakingData) GetSupply() *math_big.Int {
+	if m != nil {
+		return m.Supply
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*LiquidStakingData)(nil), "proto.LiquidStakingData")
+}
+
+func init() { proto.RegisterFile("liquidStaking.proto", fileDescriptor_ba9d71ac181fc9d8) }
+
+var fileDescriptor_ba9d71ac181fc9d8 = []byte{
+	// 288 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0xb1, 0x4e, 0xeb, 0x30,
+	0x14, 0x86, 0xed, 0x2b, 0xdd, 0x22, 0x05, 0x16, 0x0a, 0x43, 0xc5, 0x70, 0x8a, 0x90, 0x90, 0x58,
+	0x9a, 0x0c, 0x8c, 0x6c, 0x6d, 0x41, 0xaa, 0x84, 0x3a, 0xb4, 0x9d, 0xd8, 0x9c, 0xc6, 0xb8, 0x56,
+	0x12, 0x3b, 0x38, 0x27, 0x42, 0xdd, 0x78, 0x04, 0x1e, 0xa3, 0xe2, 0x49, 0x18, 0x33, 0x66, 0x2a,
+	0xc4, 0x59, 0x50, 0xa7, 0x3e, 0x02, 0x92, 0x69, 0xa5, 0x4e, 0xfe, 0xbf, 0x4f, 0x3e, 0xbf, 0x8e,
+	0x8e, 0x77, 0x96, 0xc8, 0x97, 0x42, 0x46, 0x53, 0x64, 0xb1, 0x54, 0xc2, 0xcf, 0x8c, 0x46, 0xdd,
+	0xfe, 0xef, 0x9e, 0x8b, 0x9e, 0x90, 0xb8, 0x28, 0x42, 0x7f, 0xae, 0xd3, 0x40, 0x68, 0xa1, 0x03,
+	0xa7, 0xc3, 0xe2, 0xd9, 0x91, 0x03, 0x97, 0xfe, 0xa6, 0xae, 0x56, 0xd4, 0x3b, 0x7d, 0x3c, 0x6c,
+	0x1b, 0x32, 0x64, 0xed, 0x6b, 0xef, 0x68, 0xa6, 0x63, 0xae, 0x46, 0xc3, 0x0e, 0xbd, 0xa4, 0x37,
+	0x27, 0xfd, 0xe3, 0xcd, 0xba, 0xbb, 0x57, 0x93, 0x7d, 0x68, 0x27, 0x5e, 0x6b, 0x5a, 0x64, 0x59,
+	0xb2, 0xec, 0xfc, 0x73, 0xbf, 0x66, 0x9b, 0x75, 0x77, 0x67, 0x3e, 0xbe, 0xba, 0x0f, 0x29, 0xc3,
+	0x45, 0x10, 0x4a, 0xe1, 0x8f, 0x14, 0xde, 0x1d, 0xac, 0x75, 0x9f, 0x18, 0xad, 0xa2, 0x31, 0xc7,
+	0x57, 0x6d, 0xe2, 0x80, 0x3b, 0xea, 0x09, 0xdd, 0x9b, 0x6b, 0xc3, 0x83, 0x88, 0x21, 0xf3, 0xfb,
+	0x52, 0x8c, 0x14, 0x0e, 0x58, 0x8e, 0xdc, 0x4c, 0x76, 0x8d, 0xfd, 0x71, 0x59, 0x03, 0xa9, 0x6a,
+	0x20, 0xdb, 0x1a, 0xe8, 0x9b, 0x05, 0xba, 0xb2, 0x40, 0x3f, 0x2d, 0xd0, 0xd2, 0x02, 0xad, 0x2c,
+	0xd0, 0x6f, 0x0b, 0xf4, 0xc7, 0x02, 0xd9, 0x5a, 0xa0, 0xef, 0x0d, 0x90, 0xb2, 0x01, 0x52, 0x35,
+	0x40, 0x9e, 0xce, 0xf3, 0x65, 0x8e, 0x3c, 0x9d, 0xa6, 0xcc, 0xe0, 0x40, 0x2b, 0x34, 0x6c, 0x8e,
+	0x79, 0xd8, 0x72, 0x17, 0xb8, 0xfd, 0x1d, 0x00, 0x79, 0x85, 0x10, 0xce, 0x4e, 0x01, 0x00, 0x00,
+}
+
+func (this *LiquidStakingData) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LiquidStakingData)
+	if !ok {
+		that2, ok := that.(LiquidStakingData)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.TokenID, that1.TokenID) {
+		return false
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_core_data.BigIntCaster{}
+		if !__caster.Equal(this.Supply, that1.Supply) {
+			return false
+		}
+	}
+	return true
+}
+func (this *LiquidStakingData) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&systemSmartContracts.LiquidStakingData{")
+	s = append(s, "TokenID: "+fmt.Sprintf("%#v", this.TokenID)+",\n")
+	s = append(s, "Supply: "+fmt.Sprintf("%#v", this.Supply)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringLiquidStaking(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *LiquidStakingData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidStakingData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidStakingData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_core_data.BigIntCaster{}
+		size := __caster.Size(m.Supply)
+		i -= size
+		if _, err := __caster.MarshalTo(m.Supply, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.TokenID) > 0 {
+		i -= len(m.TokenID)
+		copy(dAtA[i:], m.TokenID)
+		i = encodeVarintLiquidStaking(dAtA, i, uint64(len(m.TokenID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLiquidStaking(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLiquidStaking(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *LiquidStakingData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenID)
+	if l > 0 {
+		n += 1 + l + sovLiquidStaking(uint64(l))
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_core_data.BigIntCaster{}
+		l = __caster.Size(m.Supply)
+		n += 1 + l + sovLiquidStaking(uint64(l))
+	}
+	return n
+}
+
+func sovLiquidStaking(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLiquidStaking(x uint64) (n int) {
+	return sovLiquidStaking(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *LiquidStakingData) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LiquidStakingData{`,
+		`TokenID:` + fmt.Sprintf("%v", this.TokenID) + `,`,
+		`Supply:` + fmt.Sprintf("%v", this.Supply) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringLiquidStaking(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *LiquidStakingData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidStakingData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidStakingData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLiquidStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenID = append(m.TokenID[:0], dAtA[iNdEx:postIndex]...)
+			if m.TokenID == nil {
+				m.TokenID = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLiquidStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_core_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.Supply = tmp
+				}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLiquidStaking
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthLiquidStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipLiquidStaking(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowLiquidStaking
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLiquidStaking
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLiquidStaking
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthLiquidStaking
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupLiquidStaking
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthLiquidStaking
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthLiquidStaking        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowLiquidStaking          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupLiquidStaking = fmt.Errorf("proto: unexpected end of group")
+)
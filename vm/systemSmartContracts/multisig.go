@@ -0,0 +1,999 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. multisig.proto
+package systemSmartContracts
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/atomic"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+const multisigManagementKey = "multisigManagement"
+const multisigConfigKey = "multisigConfig"
+const multisigActionPrefix = "action_"
+const maxMultisigBoardSize = 100
+
+type multisig struct {
+	eei                 vm.SystemEI
+	multisigSCAddress   []byte
+	gasCost             vm.GasCost
+	marshalizer         marshal.Marshalizer
+	multisigEnabled     atomic.Flag
+	multisigEnableEpoch uint32
+	mutExecution        sync.RWMutex
+}
+
+// ArgsNewMultisig defines the arguments to create the multisig system smart contract
+type ArgsNewMultisig struct {
+	Eei               vm.SystemEI
+	GasCost           vm.GasCost
+	Marshalizer       marshal.Marshalizer
+	MultisigSCAddress []byte
+	EpochNotifier     vm.EpochNotifier
+	EpochConfig       config.EpochConfig
+}
+
+// NewMultisigSystemSC creates a new multisig system SC. The same contract manages the creation of the multisig
+// accounts, when called on the multisig system SC address, and executes the multisig accounts' functions, when
+// called on a multisig account address
+func NewMultisigSystemSC(args ArgsNewMultisig) (*multisig, error) {
+	if check.IfNil(args.Eei) {
+		return nil, vm.ErrNilSystemEnvironmentInterface
+	}
+	if len(args.MultisigSCAddress) < 1 {
+		return nil, fmt.Errorf("%w for multisig sc address", vm.ErrInvalidAddress)
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, vm.ErrNilMarshalizer
+	}
+	if check.IfNil(args.EpochNotifier) {
+		return nil, vm.ErrNilEpochNotifier
+	}
+
+	m := &multisig{
+		eei:                 args.Eei,
+		multisigSCAddress:   args.MultisigSCAddress,
+		gasCost:             args.GasCost,
+		marshalizer:         args.Marshalizer,
+		multisigEnabled:     atomic.Flag{},
+		multisigEnableEpoch: args.EpochConfig.EnableEpochs.MultisigSCEnableEpoch,
+	}
+	log.Debug("multisig: enable epoch for multisig", "epoch", m.multisigEnableEpoch)
+
+	args.EpochNotifier.RegisterNotifyHandler(m)
+
+	return m, nil
+}
+
+// Execute calls one of the functions from the multisig contract and runs the code according to the input
+func (m *multisig) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	m.mutExecution.RLock()
+	defer m.mutExecution.RUnlock()
+
+	err := CheckIfNil(args)
+	if err != nil {
+		m.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	if !m.multisigEnabled.IsSet() {
+		m.eei.AddReturnMessage("multisig contract is not enabled")
+		return vmcommon.UserError
+	}
+
+	if len(args.ESDTTransfers) > 0 {
+		m.eei.AddReturnMessage("cannot transfer ESDT to system SCs")
+		return vmcommon.UserError
+	}
+
+	if bytes.Equal(args.RecipientAddr, m.multisigSCAddress) {
+		return m.executeManagerFunction(args)
+	}
+
+	return m.executeAccountFunction(args)
+}
+
+func (m *multisig) executeManagerFunction(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	switch args.Function {
+	case "createMultisig":
+		return m.createMultisig(args)
+	case "getMultisigAccounts":
+		return m.getMultisigAccounts(args)
+	}
+
+	m.eei.AddReturnMessage("invalid function to call")
+	return vmcommon.UserError
+}
+
+func (m *multisig) executeAccountFunction(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	switch args.Function {
+	case core.SCDeployInitFunctionName:
+		return m.init(args)
+	case "deposit":
+		return m.deposit(args)
+	case "proposeAddBoardMember":
+		return m.proposeBoardMemberChange(args, ActionAddBoardMember)
+	case "proposeRemoveBoardMember":
+		return m.proposeBoardMemberChange(args, ActionRemoveBoardMember)
+	case "proposeChangeQuorum":
+		return m.proposeChangeQuorum(args)
+	case "proposeTransferExecute":
+		return m.proposeTransferExecute(args)
+	case "sign":
+		return m.sign(args)
+	case "unsign":
+		return m.unsign(args)
+	case "performAction":
+		return m.performAction(args)
+	case "discardAction":
+		return m.discardAction(args)
+	case "getQuorum":
+		return m.getQuorum(args)
+	case "getBoardMembers":
+		return m.getBoardMembers(args)
+	case "getPendingActionIds":
+		return m.getPendingActionIds(args)
+	case "getActionData":
+		return m.getActionData(args)
+	case "getActionSigners":
+		return m.getActionSigners(args)
+	case "getActionValidSignerCount":
+		return m.getActionValidSignerCount(args)
+	case "quorumReached":
+		return m.quorumReached(args)
+	}
+
+	m.eei.AddReturnMessage("invalid function to call")
+	return vmcommon.UserError
+}
+
+func (m *multisig) createMultisig(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if len(args.Arguments) < 2 {
+		m.eei.AddReturnMessage("wrong number of arguments")
+		return vmcommon.FunctionWrongSignature
+	}
+
+	err := m.eei.UseGas(m.gasCost.MetaChainSystemSCsCost.MultisigCreate)
+	if err != nil {
+		m.eei.AddReturnMessage(err.Error())
+		return vmcommon.OutOfGas
+	}
+
+	quorum := big.NewInt(0).SetBytes(args.Arguments[0]).Uint64()
+	board := args.Arguments[1:]
+	err = m.checkBoard(board, len(args.CallerAddr))
+	if err != nil {
+		m.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	err = checkQuorum(quorum, len(board))
+	if err != nil {
+		m.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	management, err := m.getMultisigManagement()
+	if err != nil {
+		m.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	newAddress := createNewAddress(management.LastAddress)
+	returnCode, err := m.eei.DeploySystemSC(m.multisigSCAddress, newAddress, args.CallerAddr, core.SCDeployInitFunctionName, args.CallValue, args.Arguments)
+	if err != nil {
+		m.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	management.NumOfAccounts++
+	management.LastAddress = newAddress
+	err = m.saveMultisigManagement(management)
+	if err != nil {
+		m.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	for _, member := range board {
+		err = m.addAccountToMember(member, newAddress)
+		if err != nil {
+			m.eei.AddReturnMessage(err.Error())
+			return vmcommon.UserError
+		}
+	}
+
+	m.eei.Finish(newAddress)
+
+	return vmcommon.Ok
+}
+
+func (m *multisig) checkBoard(board [][]byte, addressLength int) error {
+	if len(board) == 0 || len(board) > maxMultisigBoardSize {
+		return fmt.Errorf("%w, the board must have between 1 and %d members", vm.ErrInvalidArgument, maxMultisigBoardSize)
+	}
+
+	uniqueMembers := make(map[string]struct{}, len(board))
+	for _, member := range board {
+		if len(member) != addressLength {
+			return fmt.Errorf("%w for board member", vm.ErrInvalidAddress)
+		}
+		if bytes.Equal(member, m.multisigSCAddress) {
+			return fmt.Errorf("%w, the multisig system SC can not be a board member", vm.ErrInvalidAddress)
+		}
+
+		uniqueMembers[string(member)] = struct{}{}
+	}
+	if len(uniqueMembers) != len(board) {
+		return vm.ErrDuplicatesFoundInArguments
+	}
+
+	return nil
+}
+
+func checkQuorum(quorum uint64, numBoardMembers int) error {
+	if quorum == 0 || quorum > uint64(numBoardMembers) {
+		return fmt.Errorf("%w, the quorum must be between 1 and the number of board members", vm.ErrInvalidArgument)
+	}
+
+	return nil
+}
+
+func (m *multisig) getMultisigAccounts(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Cmp(zero) != 0 {
+		m.eei.AddReturnMessage(vm.ErrCallValueMustBeZero.Error())
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 1 {
+		m.eei.AddReturnMessage(vm.ErrInvalidNumOfArguments.Error())
+		return vmcommon.UserError
+	}
+	err := m.eei.UseGas(m.gasCost.MetaChainSystemSCsCost.MultisigOps)
+	if err != nil {
+		m.eei.AddReturnMessage(err.Error())
+		return vmcommon.OutOfGas
+	}
+
+	accountsList, err := m.getMemberAccounts(args.Arguments[0])
+	if err != nil {
+		m.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	for _, address := range accountsList.Addresses {
+		m.eei.Finish(address)
+	}
+
+	return vmcommon.Ok
+}
+
+func (m *multisig) init(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if len(m.eei.GetStorage([]byte(multisigConfigKey))) > 0 {
+		m.eei.AddReturnMessage("multisig account already initialized")
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) < 2 {
+		m.eei.AddReturnMessage("wrong number of arguments")
+		return vmcommon.FunctionWrongSignature
+	}
+
+	multisigConfig := &MultisigConfig{
+		Quorum:           uint32(big.NewInt(0).SetBytes(args.Arguments[0]).Uint64()),
+		Board:            args.Arguments[1:],
+		LastActionID:     0,
+		PendingActionIDs: make([]uint64, 0),
+	}
+	err := m.saveMultisigConfig(multisigConfig)
+	if err != nil {
+		m.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+func (m *multisig) deposit(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if len(args.Arguments) != 0 {
+		m.eei.AddReturnMessage(vm.ErrInvalidNumOfArguments.Error())
+		return vmcommon.UserError
+	}
+	err := m.eei.UseGas(m.gasCost.MetaChainSystemSCsCost.MultisigOps)
+	if err != nil {
+		m.eei.AddReturnMessage(err.Error())
+		return vmcommon.OutOfGas
+	}
+
+	_, err = m.getMultisigConfig()
+	if err != nil {
+		m.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+func (m *multisig) checkBoardMemberCall(args *vmcommon.ContractCallInput, numArguments int) (*MultisigConfig, vmcommon.ReturnCode) {
+	if args.CallValue.Cmp(zero) != 0 {
+		m.eei.AddReturnMessage(vm.ErrCallValueMustBeZero.Error())
+		return nil, vmcommon.UserError
+	}
+	if numArguments >= 0 && len(args.Arguments) != numArguments {
+		m.eei.AddReturnMessage(vm.ErrInvalidNumOfArguments.Error())
+		return nil, vmcommon.UserError
+	}
+	err := m.eei.UseGas(m.gasCost.MetaChainSystemSCsCost.MultisigOps)
+	if err != nil {
+		m.eei.AddReturnMessage(err.Error())
+		return nil, vmcommon.OutOfGas
+	}
+
+	multisigConfig, err := m.getMultisigConfig()
+	if err != nil {
+		m.eei.AddReturnMessage(err.Error())
+		return nil, vmcommon.UserError
+	}
+	if !isBoardMember(multisigConfig, args.CallerAddr) {
+		m.eei.AddReturnMessage("only board members can call this function")
+		return nil, vmcommon.UserError
+	}
+
+	return multisigConfig, vmcommon.Ok
+}
+
+func (m *multisig) proposeBoardMemberChange(args *vmcommon.ContractCallInput, actionType MultisigActionType) vmcommon.ReturnCode {
+	multisigConfig, returnCode := m.checkBoardMemberCall(args, 1)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	address := args.Arguments[0]
+	if len(address) != len(args.CallerAddr) {
+		m.eei.AddReturnMessage(vm.ErrInvalidAddress.Error())
+		return vmcommon.UserError
+	}
+
+	action := &MultisigAction{
+		Type:    actionType,
+		Address: address,
+	}
+	return m.propose(args.CallerAddr, multisigConfig, action)
+}
+
+func (m *multisig) proposeChangeQuorum(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	multisigConfig, returnCode := m.checkBoardMemberCall(args, 1)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	quorum := big.NewInt(0).SetBytes(args.Arguments[0]).Uint64()
+	err := checkQuorum(quorum, len(multisigConfig.Board))
+	if err != nil {
+		m.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	action := &MultisigAction{
+		Type:   ActionChangeQuorum,
+		Quorum: uint32(quorum),
+	}
+	return m.propose(args.CallerAddr, multisigConfig, action)
+}
+
+func (m *multisig) proposeTransferExecute(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if len(args.Arguments) < 3 {
+		m.eei.AddReturnMessage(vm.ErrInvalidNumOfArguments.Error())
+		return vmcommon.FunctionWrongSignature
+	}
+	multisigConfig, returnCode := m.checkBoardMemberCall(args, -1)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	destination := args.Arguments[0]
+	if len(destination) != len(args.CallerAddr) {
+		m.eei.AddReturnMessage(vm.ErrInvalidAddress.Error())
+		return vmcommon.UserError
+	}
+
+	value := big.NewInt(0).SetBytes(args.Arguments[1])
+	gasLimit := big.NewInt(0).SetBytes(args.Arguments[2])
+	if !gasLimit.IsUint64() {
+		m.eei.AddReturnMessage("invalid gas limit")
+		return vmcommon.UserError
+	}
+
+	action := &MultisigAction{
+		Type:      ActionTransferExecute,
+		Address:   destination,
+		Value:     value,
+		GasLimit:  gasLimit.Uint64(),
+		Arguments: make([][]byte, 0),
+	}
+	if len(args.Arguments) > 3 {
+		action.Function = args.Arguments[3]
+		action.Arguments = args.Arguments[4:]
+	}
+	if len(action.Function) == 0 && value.Cmp(zero) == 0 {
+		m.eei.AddReturnMessage("nothing to transfer or execute")
+		return vmcommon.UserError
+	}
+
+	return m.propose(args.CallerAddr, multisigConfig, action)
+}
+
+func (m *multisig) propose(proposer []byte, multisigConfig *MultisigConfig, action *MultisigAction) vmcommon.ReturnCode {
+	multisigConfig.LastActionID++
+	actionID := multisigConfig.LastActionID
+
+	if action.Value == nil {
+		action.Value = big.NewInt(0)
+	}
+	action.Proposer = proposer
+	action.Signers = [][]byte{proposer}
+
+	err := m.saveAction(actionID, action)
+	if err != nil {
+		m.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	multisigConfig.PendingActionIDs = append(multisigConfig.PendingActionIDs, actionID)
+	err = m.saveMultisigConfig(multisigConfig)
+	if err != nil {
+		m.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	m.eei.Finish(big.NewInt(0).SetUint64(actionID).Bytes())
+
+	return vmcommon.Ok
+}
+
+func (m *multisig) sign(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	_, returnCode := m.checkBoardMemberCall(args, 1)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	actionID, action, err := m.getActionFromArgument(args.Arguments[0])
+	if err != nil {
+		m.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if isSigner(action, args.CallerAddr) {
+		m.eei.AddReturnMessage("action already signed")
+		return vmcommon.UserError
+	}
+
+	action.Signers = append(action.Signers, args.CallerAddr)
+	err = m.saveAction(actionID, action)
+	if err != nil {
+		m.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+func (m *multisig) unsign(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	_, returnCode := m.checkBoardMemberCall(args, 1)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	actionID, action, err := m.getActionFromArgument(args.Arguments[0])
+	if err != nil {
+		m.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if !isSigner(action, args.CallerAddr) {
+		m.eei.AddReturnMessage("action was not signed")
+		return vmcommon.UserError
+	}
+
+	action.Signers = removeAddress(action.Signers, args.CallerAddr)
+	err = m.saveAction(actionID, action)
+	if err != nil {
+		m.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+func (m *multisig) performAction(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	multisigConfig, returnCode := m.checkBoardMemberCall(args, 1)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	actionID, action, err := m.getActionFromArgument(args.Arguments[0])
+	if err != nil {
+		m.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if computeValidSignerCount(multisigConfig, action) < uint64(multisigConfig.Quorum) {
+		m.eei.AddReturnMessage("quorum has not been reached")
+		return vmcommon.UserError
+	}
+
+	switch action.Type {
+	case ActionAddBoardMember:
+		err = m.performAddBoardMember(args.RecipientAddr, multisigConfig, action)
+	case ActionRemoveBoardMember:
+		err = m.performRemoveBoardMember(args.RecipientAddr, multisigConfig, action)
+	case ActionChangeQuorum:
+		err = checkQuorum(uint64(action.Quorum), len(multisigConfig.Board))
+		multisigConfig.Quorum = action.Quorum
+	case ActionTransferExecute:
+		err = m.performTransferExecute(args, action)
+	default:
+		err = fmt.Errorf("%w, unknown action type %d", vm.ErrInvalidArgument, action.Type)
+	}
+	if err != nil {
+		m.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	err = m.removeAction(multisigConfig, actionID)
+	if err != nil {
+		m.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+func (m *multisig) performAddBoardMember(multisigAddress []byte, multisigConfig *MultisigConfig, action *MultisigAction) error {
+	if isBoardMember(multisigConfig, action.Address) {
+		return fmt.Errorf("%w, address is already a board member", vm.ErrInvalidArgument)
+	}
+	if len(multisigConfig.Board) >= maxMultisigBoardSize {
+		return fmt.Errorf("%w, the board can not have more than %d members", vm.ErrInvalidArgument, maxMultisigBoardSize)
+	}
+
+	multisigConfig.Board = append(multisigConfig.Board, action.Address)
+
+	return m.addAccountToMember(action.Address, multisigAddress)
+}
+
+func (m *multisig) performRemoveBoardMember(multisigAddress []byte, multisigConfig *MultisigConfig, action *MultisigAction) error {
+	if !isBoardMember(multisigConfig, action.Address) {
+		return fmt.Errorf("%w, address is not a board member", vm.ErrInvalidArgument)
+	}
+	if len(multisigConfig.Board) <= int(multisigConfig.Quorum) {
+		return fmt.Errorf("%w, the board can not have less members than the quorum", vm.ErrInvalidArgument)
+	}
+
+	multisigConfig.Board = removeAddress(multisigConfig.Board, action.Address)
+
+	return m.removeAccountFromMember(action.Address, multisigAddress)
+}
+
+func (m *multisig) performTransferExecute(args *vmcommon.ContractCallInput, action *MultisigAction) error {
+	availableBalance := big.NewInt(0).Set(args.CallValue)
+	account, err := m.eei.BlockChainHook().GetUserAccount(args.RecipientAddr)
+	if err == nil && account.GetBalance() != nil {
+		availableBalance.Add(availableBalance, account.GetBalance())
+	}
+	if availableBalance.Cmp(action.Value) < 0 {
+		return vm.ErrInsufficientMultisigFunds
+	}
+
+	err = m.eei.UseGas(action.GasLimit)
+	if err != nil {
+		return err
+	}
+
+	var data []byte
+	if len(action.Function) > 0 {
+		txData := string(action.Function)
+		for _, argument := range action.Arguments {
+			txData += "@" + hex.EncodeToString(argument)
+		}
+		data = []byte(txData)
+	}
+
+	return m.eei.Transfer(action.Address, args.RecipientAddr, action.Value, data, action.GasLimit)
+}
+
+func (m *multisig) discardAction(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	multisigConfig, returnCode := m.checkBoardMemberCall(args, 1)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	actionID, action, err := m.getActionFromArgument(args.Arguments[0])
+	if err != nil {
+		m.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if computeValidSignerCount(multisigConfig, action) > 0 {
+		m.eei.AddReturnMessage("can not discard an action with valid signatures")
+		return vmcommon.UserError
+	}
+
+	err = m.removeAction(multisigConfig, actionID)
+	if err != nil {
+		m.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+func (m *multisig) checkViewCall(args *vmcommon.ContractCallInput, numArguments int) (*MultisigConfig, vmcommon.ReturnCode) {
+	if args.CallValue.Cmp(zero) != 0 {
+		m.eei.AddReturnMessage(vm.ErrCallValueMustBeZero.Error())
+		return nil, vmcommon.UserError
+	}
+	if len(args.Arguments) != numArguments {
+		m.eei.AddReturnMessage(vm.ErrInvalidNumOfArguments.Error())
+		return nil, vmcommon.UserError
+	}
+	err := m.eei.UseGas(m.gasCost.MetaChainSystemSCsCost.MultisigOps)
+	if err != nil {
+		m.eei.AddReturnMessage(err.Error())
+		return nil, vmcommon.OutOfGas
+	}
+
+	multisigConfig, err := m.getMultisigConfig()
+	if err != nil {
+		m.eei.AddReturnMessage(err.Error())
+		return nil, vmcommon.UserError
+	}
+
+	return multisigConfig, vmcommon.Ok
+}
+
+func (m *multisig) getQuorum(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	multisigConfig, returnCode := m.checkViewCall(args, 0)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	m.eei.Finish(big.NewInt(0).SetUint64(uint64(multisigConfig.Quorum)).Bytes())
+
+	return vmcommon.Ok
+}
+
+func (m *multisig) getBoardMembers(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	multisigConfig, returnCode := m.checkViewCall(args, 0)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	for _, member := range multisigConfig.Board {
+		m.eei.Finish(member)
+	}
+
+	return vmcommon.Ok
+}
+
+func (m *multisig) getPendingActionIds(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	multisigConfig, returnCode := m.checkViewCall(args, 0)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	for _, actionID := range multisigConfig.PendingActionIDs {
+		m.eei.Finish(big.NewInt(0).SetUint64(actionID).Bytes())
+	}
+
+	return vmcommon.Ok
+}
+
+func (m *multisig) getActionData(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	_, returnCode := m.checkViewCall(args, 1)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	_, action, err := m.getActionFromArgument(args.Arguments[0])
+	if err != nil {
+		m.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	m.eei.Finish([]byte(action.Type.String()))
+	m.eei.Finish(action.Proposer)
+	switch action.Type {
+	case ActionAddBoardMember, ActionRemoveBoardMember:
+		m.eei.Finish(action.Address)
+	case ActionChangeQuorum:
+		m.eei.Finish(big.NewInt(0).SetUint64(uint64(action.Quorum)).Bytes())
+	case ActionTransferExecute:
+		m.eei.Finish(action.Address)
+		m.eei.Finish(action.Value.Bytes())
+		m.eei.Finish(big.NewInt(0).SetUint64(action.GasLimit).Bytes())
+		m.eei.Finish(action.Function)
+		for _, argument := range action.Arguments {
+			m.eei.Finish(argument)
+		}
+	}
+
+	return vmcommon.Ok
+}
+
+func (m *multisig) getActionSigners(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	_, returnCode := m.checkViewCall(args, 1)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	_, action, err := m.getActionFromArgument(args.Arguments[0])
+	if err != nil {
+		m.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	for _, signer := range action.Signers {
+		m.eei.Finish(signer)
+	}
+
+	return vmcommon.Ok
+}
+
+func (m *multisig) getActionValidSignerCount(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	multisigConfig, returnCode := m.checkViewCall(args, 1)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	_, action, err := m.getActionFromArgument(args.Arguments[0])
+	if err != nil {
+		m.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	m.eei.Finish(big.NewInt(0).SetUint64(computeValidSignerCount(multisigConfig, action)).Bytes())
+
+	return vmcommon.Ok
+}
+
+func (m *multisig) quorumReached(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	multisigConfig, returnCode := m.checkViewCall(args, 1)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	_, action, err := m.getActionFromArgument(args.Arguments[0])
+	if err != nil {
+		m.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	if computeValidSignerCount(multisigConfig, action) >= uint64(multisigConfig.Quorum) {
+		m.eei.Finish([]byte("true"))
+	} else {
+		m.eei.Finish([]byte("false"))
+	}
+
+	return vmcommon.Ok
+}
+
+func isBoardMember(multisigConfig *MultisigConfig, address []byte) bool {
+	return containsAddress(multisigConfig.Board, address)
+}
+
+func isSigner(action *MultisigAction, address []byte) bool {
+	return containsAddress(action.Signers, address)
+}
+
+// computeValidSignerCount returns the number of signers which are still board members, as the board might have
+// changed since the action was signed
+func computeValidSignerCount(multisigConfig *MultisigConfig, action *MultisigAction) uint64 {
+	numValidSigners := uint64(0)
+	for _, signer := range action.Signers {
+		if isBoardMember(multisigConfig, signer) {
+			numValidSigners++
+		}
+	}
+
+	return numValidSigners
+}
+
+func containsAddress(addresses [][]byte, address []byte) bool {
+	for _, existing := range addresses {
+		if bytes.Equal(existing, address) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func removeAddress(addresses [][]byte, address []byte) [][]byte {
+	remaining := make([][]byte, 0, len(addresses))
+	for _, existing := range addresses {
+		if !bytes.Equal(existing, address) {
+			remaining = append(remaining, existing)
+		}
+	}
+
+	return remaining
+}
+
+func createActionKey(actionID uint64) []byte {
+	return append([]byte(multisigActionPrefix), big.NewInt(0).SetUint64(actionID).Bytes()...)
+}
+
+func (m *multisig) getActionFromArgument(argument []byte) (uint64, *MultisigAction, error) {
+	actionIDAsBigInt := big.NewInt(0).SetBytes(argument)
+	if !actionIDAsBigInt.IsUint64() {
+		return 0, nil, fmt.Errorf("%w, invalid action id", vm.ErrInvalidArgument)
+	}
+
+	actionID := actionIDAsBigInt.Uint64()
+	marshaledData := m.eei.GetStorage(createActionKey(actionID))
+	if len(marshaledData) == 0 {
+		return 0, nil, fmt.Errorf("%w, action %d does not exist", vm.ErrInvalidArgument, actionID)
+	}
+
+	action := &MultisigAction{}
+	err := m.marshalizer.Unmarshal(action, marshaledData)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return actionID, action, nil
+}
+
+func (m *multisig) saveAction(actionID uint64, action *MultisigAction) error {
+	marshaledData, err := m.marshalizer.Marshal(action)
+	if err != nil {
+		return err
+	}
+
+	m.eei.SetStorage(createActionKey(actionID), marshaledData)
+	return nil
+}
+
+func (m *multisig) removeAction(multisigConfig *MultisigConfig, actionID uint64) error {
+	pendingActionIDs := make([]uint64, 0, len(multisigConfig.PendingActionIDs))
+	for _, pendingActionID := range multisigConfig.PendingActionIDs {
+		if pendingActionID != actionID {
+			pendingActionIDs = append(pendingActionIDs, pendingActionID)
+		}
+	}
+	multisigConfig.PendingActionIDs = pendingActionIDs
+
+	m.eei.SetStorage(createActionKey(actionID), nil)
+	return m.saveMultisigConfig(multisigConfig)
+}
+
+func (m *multisig) getMultisigConfig() (*MultisigConfig, error) {
+	marshaledData := m.eei.GetStorage([]byte(multisigConfigKey))
+	if len(marshaledData) == 0 {
+		return nil, fmt.Errorf("%w, multisig account config is missing", vm.ErrDataNotFoundUnderKey)
+	}
+
+	multisigConfig := &MultisigConfig{}
+	err := m.marshalizer.Unmarshal(multisigConfig, marshaledData)
+	if err != nil {
+		return nil, err
+	}
+
+	return multisigConfig, nil
+}
+
+func (m *multisig) saveMultisigConfig(multisigConfig *MultisigConfig) error {
+	marshaledData, err := m.marshalizer.Marshal(multisigConfig)
+	if err != nil {
+		return err
+	}
+
+	m.eei.SetStorage([]byte(multisigConfigKey), marshaledData)
+	return nil
+}
+
+func (m *multisig) getMultisigManagement() (*MultisigManagement, error) {
+	marshaledData := m.eei.GetStorageFromAddress(m.multisigSCAddress, []byte(multisigManagementKey))
+	if len(marshaledData) == 0 {
+		return &MultisigManagement{
+			NumOfAccounts: 0,
+			LastAddress:   m.multisigSCAddress,
+		}, nil
+	}
+
+	management := &MultisigManagement{}
+	err := m.marshalizer.Unmarshal(management, marshaledData)
+	if err != nil {
+		return nil, err
+	}
+
+	return management, nil
+}
+
+func (m *multisig) saveMultisigManagement(management *MultisigManagement) error {
+	marshaledData, err := m.marshalizer.Marshal(management)
+	if err != nil {
+		return err
+	}
+
+	m.eei.SetStorageForAddress(m.multisigSCAddress, []byte(multisigManagementKey), marshaledData)
+	return nil
+}
+
+func (m *multisig) getMemberAccounts(member []byte) (*MultisigAccountsList, error) {
+	accountsList := &MultisigAccountsList{Addresses: make([][]byte, 0)}
+	marshaledData := m.eei.GetStorageFromAddress(m.multisigSCAddress, member)
+	if len(marshaledData) == 0 {
+		return accountsList, nil
+	}
+
+	err := m.marshalizer.Unmarshal(accountsList, marshaledData)
+	if err != nil {
+		return nil, err
+	}
+
+	return accountsList, nil
+}
+
+func (m *multisig) saveMemberAccounts(member []byte, accountsList *MultisigAccountsList) error {
+	if len(accountsList.Addresses) == 0 {
+		m.eei.SetStorageForAddress(m.multisigSCAddress, member, nil)
+		return nil
+	}
+
+	marshaledData, err := m.marshalizer.Marshal(accountsList)
+	if err != nil {
+		return err
+	}
+
+	m.eei.SetStorageForAddress(m.multisigSCAddress, member, marshaledData)
+	return nil
+}
+
+func (m *multisig) addAccountToMember(member []byte, multisigAddress []byte) error {
+	accountsList, err := m.getMemberAccounts(member)
+	if err != nil {
+		return err
+	}
+
+	accountsList.Addresses = append(accountsList.Addresses, multisigAddress)
+	return m.saveMemberAccounts(member, accountsList)
+}
+
+func (m *multisig) removeAccountFromMember(member []byte, multisigAddress []byte) error {
+	accountsList, err := m.getMemberAccounts(member)
+	if err != nil {
+		return err
+	}
+
+	accountsList.Addresses = removeAddress(accountsList.Addresses, multisigAddress)
+	return m.saveMemberAccounts(member, accountsList)
+}
+
+// EpochConfirmed is called whenever a new epoch is confirmed
+func (m *multisig) EpochConfirmed(epoch uint32, _ uint64) {
+	m.multisigEnabled.Toggle(epoch >= m.multisigEnableEpoch)
+	log.Debug("multisigSC: multisig", "enabled", m.multisigEnabled.IsSet())
+}
+
+// CanUseContract returns true if contract can be used
+func (m *multisig) CanUseContract() bool {
+	return m.multisigEnabled.IsSet()
+}
+
+// SetNewGasCost is called whenever a gas cost was changed
+func (m *multisig) SetNewGasCost(gasCost vm.GasCost) {
+	m.mutExecution.Lock()
+	m.gasCost = gasCost
+	m.mutExecution.Unlock()
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (m *multisig) IsInterfaceNil() bool {
+	return m == nil
+}
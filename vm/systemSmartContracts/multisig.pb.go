@@ -0,0 +1,1782 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: multisig.proto
+
+package systemSmartContracts
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	github_com_ElrondNetwork_elrond_go_core_data "github.com/ElrondNetwork/elrond-go-core/data"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_big "math/big"
+	math_bits "math/bits"
+	reflect "reflect"
+	strconv "strconv"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type MultisigActionType int32
+
+const (
+	ActionNothing           MultisigActionType = 0
+	ActionAddBoardMember    MultisigActionType = 1
+	ActionRemoveBoardMember MultisigActionType = 2
+	ActionChangeQuorum      MultisigActionType = 3
+	ActionTransferExecute   MultisigActionType = 4
+)
+
+var MultisigActionType_name = map[int32]string{
+	0: "ActionNothing",
+	1: "ActionAddBoardMember",
+	2: "ActionRemoveBoardMember",
+	3: "ActionChangeQuorum",
+	4: "ActionTransferExecute",
+}
+
+var MultisigActionType_value = map[string]int32{
+	"ActionNothing":           0,
+	"ActionAddBoardMember":    1,
+	"ActionRemoveBoardMember": 2,
+	"ActionChangeQuorum":      3,
+	"ActionTransferExecute":   4,
+}
+
+func (MultisigActionType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{0}
+}
+
+type MultisigManagement struct {
+	NumOfAccounts uint32 `protobuf:"varint,1,opt,name=NumOfAccounts,proto3" json:"NumOfAccounts"`
+	LastAddress   []byte `protobuf:"bytes,2,opt,name=LastAddress,proto3" json:"LastAddress"`
+}
+
+func (m *MultisigManagement) Reset()      { *m = MultisigManagement{} }
+func (*MultisigManagement) ProtoMessage() {}
+func (*MultisigManagement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{0}
+}
+func (m *MultisigManagement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultisigManagement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MultisigManagement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultisigManagement.Merge(m, src)
+}
+func (m *MultisigManagement) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultisigManagement) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultisigManagement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultisigManagement proto.InternalMessageInfo
+
+func (m *MultisigManagement) GetNumOfAccounts() uint32 {
+	if m != nil {
+		return m.NumOfAccounts
+	}
+	return 0
+}
+
+func (m *MultisigManagement) GetLastAddress() []byte {
+	if m != nil {
+		return m.LastAddress
+	}
+	return nil
+}
+
+type MultisigAccountsList struct {
+	Addresses [][]byte `protobuf:"bytes,1,rep,name=Addresses,proto3" json:"Addresses"`
+}
+
+func (m *MultisigAccountsList) Reset()      { *m = MultisigAccountsList{} }
+func (*MultisigAccountsList) ProtoMessage() {}
+func (*MultisigAccountsList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{1}
+}
+func (m *MultisigAccountsList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultisigAccountsList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MultisigAccountsList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultisigAccountsList.Merge(m, src)
+}
+func (m *MultisigAccountsList) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultisigAccountsList) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultisigAccountsList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultisigAccountsList proto.InternalMessageInfo
+
+func (m *MultisigAccountsList) GetAddresses() [][]byte {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+type MultisigConfig struct {
+	Quorum           uint32   `protobuf:"varint,1,opt,name=Quorum,proto3" json:"Quorum"`
+	Board            [][]byte `protobuf:"bytes,2,rep,name=Board,proto3" json:"Board"`
+	LastActionID     uint64   `protobuf:"varint,3,opt,name=LastActionID,proto3" json:"LastActionID"`
+	PendingActionIDs []uint64 `protobuf:"varint,4,rep,packed,name=PendingActionIDs,proto3" json:"PendingActionIDs"`
+}
+
+func (m *MultisigConfig) Reset()      { *m = MultisigConfig{} }
+func (*MultisigConfig) ProtoMessage() {}
+func (*MultisigConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{2}
+}
+func (m *MultisigConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultisigConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MultisigConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultisigConfig.Merge(m, src)
+}
+func (m *MultisigConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultisigConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultisigConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultisigConfig proto.InternalMessageInfo
+
+func (m *MultisigConfig) GetQuorum() uint32 {
+	if m != nil {
+		return m.Quorum
+	}
+	return 0
+}
+
+func (m *MultisigConfig) GetBoard() [][]byte {
+	if m != nil {
+		return m.Board
+	}
+	return nil
+}
+
+func (m *MultisigConfig) GetLastActionID() uint64 {
+	if m != nil {
+		return m.LastActionID
+	}
+	return 0
+}
+
+func (m *MultisigConfig) GetPendingActionIDs() []uint64 {
+	if m != nil {
+		return m.PendingActionIDs
+	}
+	return nil
+}
+
+type MultisigAction struct {
+	Type      MultisigActionType `protobuf:"varint,1,opt,name=Type,proto3,enum=proto.MultisigActionType" json:"Type"`
+	Proposer  []byte             `protobuf:"bytes,2,opt,name=Proposer,proto3" json:"Proposer"`
+	Address   []byte             `protobuf:"bytes,3,opt,name=Address,proto3" json:"Address"`
+	Quorum    uint32             `protobuf:"varint,4,opt,name=Quorum,proto3" json:"Quorum"`
+	Value     *math_big.Int      `protobuf:"bytes,5,opt,name=Value,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go-core/data.BigIntCaster" json:"Value"`
+	GasLimit  uint64             `protobuf:"varint,6,opt,name=GasLimit,proto3" json:"GasLimit"`
+	Function  []byte             `protobuf:"bytes,7,opt,name=Function,proto3" json:"Function"`
+	Arguments [][]byte           `protobuf:"bytes,8,rep,name=Arguments,proto3" json:"Arguments"`
+	Signers   [][]byte           `protobuf:"bytes,9,rep,name=Signers,proto3" json:"Signers"`
+}
+
+func (m *MultisigAction) Reset()      { *m = MultisigAction{} }
+func (*MultisigAction) ProtoMessage() {}
+func (*MultisigAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{3}
+}
+func (m *MultisigAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultisigAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MultisigAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultisigAction.Merge(m, src)
+}
+func (m *MultisigAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultisigAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultisigAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultisigAction proto.InternalMessageInfo
+
+func (m *MultisigAction) GetType() MultisigActionType {
+	if m != nil {
+		return m.Type
+	}
+	return ActionNothing
+}
+
+func (m *MultisigAction) GetProposer() []byte {
+	if m != nil {
+		return m.Proposer
+	}
+	return nil
+}
+
+func (m *MultisigAction) GetAddress() []byte {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *MultisigAction) GetQuorum() uint32 {
+	if m != nil {
+		return m.Quorum
+	}
+	return 0
+}
+
+func (m *MultisigAction) GetValue() *math_big.Int {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *MultisigAction) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *MultisigAction) GetFunction() []byte {
+	if m != nil {
+		return m.Function
+	}
+	return nil
+}
+
+func (m *MultisigAction) GetArguments() [][]byte {
+	if m != nil {
+		return m.Arguments
+	}
+	return nil
+}
+
+func (m *MultisigAction) GetSigners() [][]byte {
+	if m != nil {
+		return m.Signers
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("proto.MultisigActionType", MultisigActionType_name, MultisigActionType_value)
+	proto.RegisterType((*MultisigManagement)(nil), "proto.MultisigManagement")
+	proto.RegisterType((*MultisigAccountsList)(nil), "proto.MultisigAccountsList")
+	proto.RegisterType((*MultisigConfig)(nil), "proto.MultisigConfig")
+	proto.RegisterType((*MultisigAction)(nil), "proto.MultisigAction")
+}
+
+func init() { proto.RegisterFile("multisig.proto", fileDescriptor_62b8b91adf3febfa) }
+
+var fileDescriptor_62b8b91adf3febfa = []byte{
+	// 670 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0x4d, 0x6f, 0xd3, 0x30,
+	0x18, 0xc7, 0xeb, 0xb5, 0xdd, 0x8b, 0xd7, 0x8e, 0xce, 0x2a, 0x90, 0x81, 0x94, 0x54, 0x95, 0x90,
+	0x2a, 0xd0, 0x5a, 0xf1, 0x22, 0xed, 0xc0, 0x85, 0xa5, 0x6c, 0x68, 0xd2, 0x56, 0x86, 0x87, 0x38,
+	0x70, 0x4b, 0x1b, 0xd7, 0xb5, 0x58, 0xec, 0xc9, 0x76, 0x80, 0xdd, 0xf6, 0x11, 0xe0, 0x5b, 0x20,
+	0x3e, 0x09, 0x17, 0xa4, 0x71, 0xdb, 0x29, 0xb0, 0xec, 0x82, 0x72, 0xda, 0x47, 0x40, 0x75, 0x92,
+	0xbe, 0x68, 0xe2, 0x52, 0xff, 0x9f, 0xdf, 0xf3, 0xf4, 0x89, 0x9f, 0x17, 0xc3, 0xb5, 0x20, 0x3c,
+	0xd6, 0x4c, 0x31, 0xda, 0x3e, 0x91, 0x42, 0x0b, 0x54, 0x36, 0xc7, 0xbd, 0x4d, 0xca, 0xf4, 0x28,
+	0xec, 0xb7, 0x07, 0x22, 0xe8, 0x50, 0x41, 0x45, 0xc7, 0xe0, 0x7e, 0x38, 0x34, 0x96, 0x31, 0x8c,
+	0x4a, 0xff, 0xd5, 0x3c, 0x03, 0x10, 0x1d, 0x64, 0x89, 0x0e, 0x3c, 0xee, 0x51, 0x12, 0x10, 0xae,
+	0xd1, 0x16, 0xac, 0xf6, 0xc2, 0xe0, 0xf5, 0x70, 0x7b, 0x30, 0x10, 0x21, 0xd7, 0xca, 0x02, 0x0d,
+	0xd0, 0xaa, 0xba, 0xeb, 0x49, 0xe4, 0xcc, 0x3b, 0xf0, 0xbc, 0x89, 0x1e, 0xc3, 0xd5, 0x7d, 0x4f,
+	0xe9, 0x6d, 0xdf, 0x97, 0x44, 0x29, 0x6b, 0xa1, 0x01, 0x5a, 0x15, 0xf7, 0x56, 0x12, 0x39, 0xb3,
+	0x18, 0xcf, 0x1a, 0xcd, 0x2e, 0xac, 0xe7, 0x37, 0xc8, 0xd3, 0xec, 0x33, 0xa5, 0xd1, 0x23, 0xb8,
+	0x92, 0x85, 0x90, 0xf1, 0xf7, 0x8b, 0xad, 0x8a, 0x5b, 0x4d, 0x22, 0x67, 0x0a, 0xf1, 0x54, 0x36,
+	0x7f, 0x02, 0xb8, 0x96, 0x67, 0xe9, 0x0a, 0x3e, 0x64, 0x14, 0x35, 0xe1, 0xe2, 0x9b, 0x50, 0xc8,
+	0x30, 0xc8, 0x2e, 0x0f, 0x93, 0xc8, 0xc9, 0x08, 0xce, 0x4e, 0xe4, 0xc0, 0xb2, 0x2b, 0x3c, 0xe9,
+	0x5b, 0x0b, 0x26, 0xff, 0x4a, 0x12, 0x39, 0x29, 0xc0, 0xe9, 0x81, 0x9e, 0xc1, 0x8a, 0xb9, 0xeb,
+	0x40, 0x33, 0xc1, 0xf7, 0x5e, 0x5a, 0xc5, 0x06, 0x68, 0x95, 0xdc, 0x5a, 0x12, 0x39, 0x73, 0x1c,
+	0xcf, 0x59, 0xe8, 0x05, 0xac, 0x1d, 0x12, 0xee, 0x33, 0x4e, 0x73, 0xa4, 0xac, 0x52, 0xa3, 0xd8,
+	0x2a, 0xb9, 0xf5, 0x24, 0x72, 0x6e, 0xf8, 0xf0, 0x0d, 0xd2, 0xfc, 0x55, 0x9c, 0xd6, 0x93, 0x52,
+	0xb4, 0x05, 0x4b, 0x6f, 0x4f, 0x4f, 0x88, 0xa9, 0x66, 0xed, 0xc9, 0x46, 0x3a, 0xc0, 0xf6, 0x7c,
+	0xd0, 0x38, 0xc0, 0x5d, 0x4e, 0x22, 0xc7, 0x84, 0x62, 0xf3, 0x8b, 0x5a, 0x70, 0xf9, 0x50, 0x8a,
+	0x13, 0xa1, 0x88, 0xcc, 0x06, 0x52, 0x49, 0x22, 0x67, 0xc2, 0xf0, 0x44, 0xa1, 0x07, 0x70, 0x29,
+	0x9f, 0x5c, 0xd1, 0x04, 0xae, 0x26, 0x91, 0x93, 0x23, 0x9c, 0x8b, 0x99, 0xce, 0x96, 0xfe, 0xdb,
+	0x59, 0x06, 0xcb, 0xef, 0xbc, 0xe3, 0x90, 0x58, 0x65, 0x93, 0xe8, 0x68, 0xdc, 0x59, 0x03, 0xbe,
+	0xff, 0x76, 0x76, 0x03, 0x4f, 0x8f, 0x3a, 0x7d, 0x46, 0xdb, 0x7b, 0x5c, 0x3f, 0x9f, 0x59, 0xd8,
+	0x9d, 0x63, 0x29, 0xb8, 0xdf, 0x23, 0xfa, 0x93, 0x90, 0x1f, 0x3a, 0xc4, 0x58, 0x9b, 0x54, 0x6c,
+	0x0e, 0x84, 0x24, 0x1d, 0xdf, 0xd3, 0x5e, 0xdb, 0x65, 0x74, 0x8f, 0xeb, 0xae, 0xa7, 0x34, 0x91,
+	0x38, 0x4d, 0x38, 0xae, 0xef, 0x95, 0xa7, 0xf6, 0x59, 0xc0, 0xb4, 0xb5, 0x68, 0xe6, 0x63, 0xea,
+	0xcb, 0x19, 0x9e, 0xa8, 0x71, 0xe4, 0x6e, 0xc8, 0x4d, 0xa7, 0xac, 0xa5, 0x69, 0x27, 0x72, 0x86,
+	0x27, 0xca, 0x2c, 0x9f, 0xa4, 0xe1, 0xf8, 0x31, 0x28, 0x6b, 0x79, 0x66, 0xf9, 0x72, 0x88, 0xa7,
+	0x72, 0xdc, 0xb6, 0x23, 0x46, 0x39, 0x91, 0xca, 0x5a, 0x69, 0x14, 0xf3, 0xb6, 0x65, 0x08, 0xe7,
+	0xe2, 0xe1, 0xd7, 0x99, 0xb7, 0x36, 0x1d, 0x17, 0x5a, 0x87, 0xd5, 0xd4, 0xea, 0x09, 0x3d, 0x62,
+	0x9c, 0xd6, 0x0a, 0xc8, 0x82, 0xf5, 0x14, 0x6d, 0xfb, 0xbe, 0xd9, 0xc3, 0x03, 0x12, 0xf4, 0x89,
+	0xac, 0x01, 0x74, 0x1f, 0xde, 0x4d, 0x3d, 0x98, 0x04, 0xe2, 0x23, 0x99, 0x75, 0x2e, 0xa0, 0x3b,
+	0x10, 0xa5, 0xce, 0xee, 0xc8, 0xe3, 0x94, 0xa4, 0x93, 0xa8, 0x15, 0xd1, 0x06, 0xbc, 0x9d, 0x7d,
+	0x4f, 0x7a, 0x5c, 0x0d, 0x89, 0xdc, 0xf9, 0x4c, 0x06, 0xa1, 0x26, 0xb5, 0x92, 0xdb, 0x3b, 0xbf,
+	0xb4, 0x0b, 0x17, 0x97, 0x76, 0xe1, 0xfa, 0xd2, 0x06, 0x67, 0xb1, 0x0d, 0xbe, 0xc5, 0x36, 0xf8,
+	0x11, 0xdb, 0xe0, 0x3c, 0xb6, 0xc1, 0x45, 0x6c, 0x83, 0x3f, 0xb1, 0x0d, 0xfe, 0xc6, 0x76, 0xe1,
+	0x3a, 0xb6, 0xc1, 0x97, 0x2b, 0xbb, 0x70, 0x7e, 0x65, 0x17, 0x2e, 0xae, 0xec, 0xc2, 0xfb, 0xba,
+	0x3a, 0x55, 0x9a, 0x04, 0x47, 0x81, 0x27, 0x75, 0x57, 0x70, 0x2d, 0xbd, 0x81, 0x56, 0xfd, 0x45,
+	0xb3, 0x95, 0x4f, 0xff, 0x0d, 0x00, 0x5a, 0x3f, 0x87, 0x77, 0x9e, 0x04, 0x00, 0x00,
+}
+
+func (x MultisigActionType) String() string {
+	s, ok := MultisigActionType_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (this *MultisigManagement) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MultisigManagement)
+	if !ok {
+		that2, ok := that.(MultisigManagement)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NumOfAccounts != that1.NumOfAccounts {
+		return false
+	}
+	if !bytes.Equal(this.LastAddress, that1.LastAddress) {
+		return false
+	}
+	return true
+}
+func (this *MultisigAccountsList) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MultisigAccountsList)
+	if !ok {
+		that2, ok := that.(MultisigAccountsList)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Addresses) != len(that1.Addresses) {
+		return false
+	}
+	for i := range this.Addresses {
+		if !bytes.Equal(this.Addresses[i], that1.Addresses[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *MultisigConfig) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MultisigConfig)
+	if !ok {
+		that2, ok := that.(MultisigConfig)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Quorum != that1.Quorum {
+		return false
+	}
+	if len(this.Board) != len(that1.Board) {
+		return false
+	}
+	for i := range this.Board {
+		if !bytes.Equal(this.Board[i], that1.Board[i]) {
+			return false
+		}
+	}
+	if this.LastActionID != that1.LastActionID {
+		return false
+	}
+	if len(this.PendingActionIDs) != len(that1.PendingActionIDs) {
+		return false
+	}
+	for i := range this.PendingActionIDs {
+		if this.PendingActionIDs[i] != that1.PendingActionIDs[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *MultisigAction) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MultisigAction)
+	if !ok {
+		that2, ok := that.(MultisigAction)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if !bytes.Equal(this.Proposer, that1.Proposer) {
+		return false
+	}
+	if !bytes.Equal(this.Address, that1.Address) {
+		return false
+	}
+	if this.Quorum != that1.Quorum {
+		return false
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_core_data.BigIntCaster{}
+		if !__caster.Equal(this.Value, that1.Value) {
+			return false
+		}
+	}
+	if this.GasLimit != that1.GasLimit {
+		return false
+	}
+	if !bytes.Equal(this.Function, that1.Function) {
+		return false
+	}
+	if len(this.Arguments) != len(that1.Arguments) {
+		return false
+	}
+	for i := range this.Arguments {
+		if !bytes.Equal(this.Arguments[i], that1.Arguments[i]) {
+			return false
+		}
+	}
+	if len(this.Signers) != len(that1.Signers) {
+		return false
+	}
+	for i := range this.Signers {
+		if !bytes.Equal(this.Signers[i], that1.Signers[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *MultisigManagement) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&systemSmartContracts.MultisigManagement{")
+	s = append(s, "NumOfAccounts: "+fmt.Sprintf("%#v", this.NumOfAccounts)+",\n")
+	s = append(s, "LastAddress: "+fmt.Sprintf("%#v", this.LastAddress)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *MultisigAccountsList) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&systemSmartContracts.MultisigAccountsList{")
+	s = append(s, "Addresses: "+fmt.Sprintf("%#v", this.Addresses)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *MultisigConfig) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&systemSmartContracts.MultisigConfig{")
+	s = append(s, "Quorum: "+fmt.Sprintf("%#v", this.Quorum)+",\n")
+	s = append(s, "Board: "+fmt.Sprintf("%#v", this.Board)+",\n")
+	s = append(s, "LastActionID: "+fmt.Sprintf("%#v", this.LastActionID)+",\n")
+	s = append(s, "PendingActionIDs: "+fmt.Sprintf("%#v", this.PendingActionIDs)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *MultisigAction) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 13)
+	s = append(s, "&systemSmartContracts.MultisigAction{")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "Proposer: "+fmt.Sprintf("%#v", this.Proposer)+",\n")
+	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
+	s = append(s, "Quorum: "+fmt.Sprintf("%#v", this.Quorum)+",\n")
+	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
+	s = append(s, "GasLimit: "+fmt.Sprintf("%#v", this.GasLimit)+",\n")
+	s = append(s, "Function: "+fmt.Sprintf("%#v", this.Function)+",\n")
+	s = append(s, "Arguments: "+fmt.Sprintf("%#v", this.Arguments)+",\n")
+	s = append(s, "Signers: "+fmt.Sprintf("%#v", this.Signers)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringMultisig(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *MultisigManagement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultisigManagement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultisigManagement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LastAddress) > 0 {
+		i -= len(m.LastAddress)
+		copy(dAtA[i:], m.LastAddress)
+		i = encodeVarintMultisig(dAtA, i, uint64(len(m.LastAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.NumOfAccounts != 0 {
+		i = encodeVarintMultisig(dAtA, i, uint64(m.NumOfAccounts))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MultisigAccountsList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultisigAccountsList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultisigAccountsList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintMultisig(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MultisigConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultisigConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultisigConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingActionIDs) > 0 {
+		dAtA2 := make([]byte, len(m.PendingActionIDs)*10)
+		var j1 int
+		for _, num := range m.PendingActionIDs {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintMultisig(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.LastActionID != 0 {
+		i = encodeVarintMultisig(dAtA, i, uint64(m.LastActionID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Board) > 0 {
+		for iNdEx := len(m.Board) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Board[iNdEx])
+			copy(dAtA[i:], m.Board[iNdEx])
+			i = encodeVarintMultisig(dAtA, i, uint64(len(m.Board[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Quorum != 0 {
+		i = encodeVarintMultisig(dAtA, i, uint64(m.Quorum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MultisigAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultisigAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultisigAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintMultisig(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Arguments) > 0 {
+		for iNdEx := len(m.Arguments) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Arguments[iNdEx])
+			copy(dAtA[i:], m.Arguments[iNdEx])
+			i = encodeVarintMultisig(dAtA, i, uint64(len(m.Arguments[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Function) > 0 {
+		i -= len(m.Function)
+		copy(dAtA[i:], m.Function)
+		i = encodeVarintMultisig(dAtA, i, uint64(len(m.Function)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintMultisig(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_core_data.BigIntCaster{}
+		size := __caster.Size(m.Value)
+		i -= size
+		if _, err := __caster.MarshalTo(m.Value, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMultisig(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Quorum != 0 {
+		i = encodeVarintMultisig(dAtA, i, uint64(m.Quorum))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMultisig(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintMultisig(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintMultisig(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMultisig(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMultisig(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MultisigManagement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumOfAccounts != 0 {
+		n += 1 + sovMultisig(uint64(m.NumOfAccounts))
+	}
+	l = len(m.LastAddress)
+	if l > 0 {
+		n += 1 + l + sovMultisig(uint64(l))
+	}
+	return n
+}
+
+func (m *MultisigAccountsList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, b := range m.Addresses {
+			l = len(b)
+			n += 1 + l + sovMultisig(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MultisigConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Quorum != 0 {
+		n += 1 + sovMultisig(uint64(m.Quorum))
+	}
+	if len(m.Board) > 0 {
+		for _, b := range m.Board {
+			l = len(b)
+			n += 1 + l + sovMultisig(uint64(l))
+		}
+	}
+	if m.LastActionID != 0 {
+		n += 1 + sovMultisig(uint64(m.LastActionID))
+	}
+	if len(m.PendingActionIDs) > 0 {
+		l = 0
+		for _, e := range m.PendingActionIDs {
+			l += sovMultisig(uint64(e))
+		}
+		n += 1 + sovMultisig(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MultisigAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovMultisig(uint64(m.Type))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovMultisig(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMultisig(uint64(l))
+	}
+	if m.Quorum != 0 {
+		n += 1 + sovMultisig(uint64(m.Quorum))
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_core_data.BigIntCaster{}
+		l = __caster.Size(m.Value)
+		n += 1 + l + sovMultisig(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovMultisig(uint64(m.GasLimit))
+	}
+	l = len(m.Function)
+	if l > 0 {
+		n += 1 + l + sovMultisig(uint64(l))
+	}
+	if len(m.Arguments) > 0 {
+		for _, b := range m.Arguments {
+			l = len(b)
+			n += 1 + l + sovMultisig(uint64(l))
+		}
+	}
+	if len(m.Signers) > 0 {
+		for _, b := range m.Signers {
+			l = len(b)
+			n += 1 + l + sovMultisig(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovMultisig(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMultisig(x uint64) (n int) {
+	return sovMultisig(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *MultisigManagement) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MultisigManagement{`,
+		`NumOfAccounts:` + fmt.Sprintf("%v", this.NumOfAccounts) + `,`,
+		`LastAddress:` + fmt.Sprintf("%v", this.LastAddress) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MultisigAccountsList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MultisigAccountsList{`,
+		`Addresses:` + fmt.Sprintf("%v", this.Addresses) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MultisigConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MultisigConfig{`,
+		`Quorum:` + fmt.Sprintf("%v", this.Quorum) + `,`,
+		`Board:` + fmt.Sprintf("%v", this.Board) + `,`,
+		`LastActionID:` + fmt.Sprintf("%v", this.LastActionID) + `,`,
+		`PendingActionIDs:` + fmt.Sprintf("%v", this.PendingActionIDs) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MultisigAction) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MultisigAction{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Proposer:` + fmt.Sprintf("%v", this.Proposer) + `,`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`Quorum:` + fmt.Sprintf("%v", this.Quorum) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`GasLimit:` + fmt.Sprintf("%v", this.GasLimit) + `,`,
+		`Function:` + fmt.Sprintf("%v", this.Function) + `,`,
+		`Arguments:` + fmt.Sprintf("%v", this.Arguments) + `,`,
+		`Signers:` + fmt.Sprintf("%v", this.Signers) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringMultisig(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *MultisigManagement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMultisig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultisigManagement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultisigManagement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumOfAccounts", wireType)
+			}
+			m.NumOfAccounts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumOfAccounts |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastAddress = append(m.LastAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.LastAddress == nil {
+				m.LastAddress = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultisig(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultisigAccountsList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMultisig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultisigAccountsList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultisigAccountsList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, make([]byte, postIndex-iNdEx))
+			copy(m.Addresses[len(m.Addresses)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultisig(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultisigConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMultisig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultisigConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultisigConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			m.Quorum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quorum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Board", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Board = append(m.Board, make([]byte, postIndex-iNdEx))
+			copy(m.Board[len(m.Board)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastActionID", wireType)
+			}
+			m.LastActionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastActionID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMultisig
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PendingActionIDs = append(m.PendingActionIDs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMultisig
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMultisig
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthMultisig
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PendingActionIDs) == 0 {
+					m.PendingActionIDs = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMultisig
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PendingActionIDs = append(m.PendingActionIDs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingActionIDs", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultisig(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultisigAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMultisig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultisigAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultisigAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= MultisigActionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = append(m.Proposer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proposer == nil {
+				m.Proposer = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			m.Quorum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quorum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_core_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.Value = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Function", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Function = append(m.Function[:0], dAtA[iNdEx:postIndex]...)
+			if m.Function == nil {
+				m.Function = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Arguments = append(m.Arguments, make([]byte, postIndex-iNdEx))
+			copy(m.Arguments[len(m.Arguments)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, make([]byte, postIndex-iNdEx))
+			copy(m.Signers[len(m.Signers)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultisig(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMultisig(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMultisig
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMultisig
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMultisig
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMultisig
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMultisig        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMultisig          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMultisig = fmt.Errorf("proto: unexpected end of group")
+)
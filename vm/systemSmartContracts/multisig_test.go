@@ -0,0 +1,515 @@
+package systemSmartContracts
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
+	"github.com/ElrondNetwork/elrond-go/state"
+	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/mock"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/ElrondNetwork/elrond-vm-common/parsers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	boardMember1 = bytes.Repeat([]byte{1}, 32)
+	boardMember2 = bytes.Repeat([]byte{2}, 32)
+	boardMember3 = bytes.Repeat([]byte{3}, 32)
+	outsider     = bytes.Repeat([]byte{9}, 32)
+)
+
+func createMockArgumentsForMultisig() ArgsNewMultisig {
+	return ArgsNewMultisig{
+		Eei:               &mock.SystemEIStub{},
+		GasCost:           vm.GasCost{MetaChainSystemSCsCost: vm.MetaChainSystemSCsCost{MultisigCreate: 10, MultisigOps: 1}},
+		Marshalizer:       &mock.MarshalizerMock{},
+		MultisigSCAddress: vm.MultisigSCAddress,
+		EpochNotifier:     &mock.EpochNotifierStub{},
+		EpochConfig: config.EpochConfig{
+			EnableEpochs: config.EnableEpochs{
+				MultisigSCEnableEpoch: 0,
+			},
+		},
+	}
+}
+
+func createMultisigWithVMContext(blockChainHook *mock.BlockChainHookStub) (*multisig, *vmContext) {
+	eei, _ := NewVMContext(
+		blockChainHook,
+		hooks.NewVMCryptoHook(),
+		parsers.NewCallArgsParser(),
+		&stateMock.AccountsStub{},
+		&mock.RaterMock{},
+	)
+
+	args := createMockArgumentsForMultisig()
+	args.Eei = eei
+	m, _ := NewMultisigSystemSC(args)
+	m.EpochConfirmed(0, 0)
+
+	_ = eei.SetSystemSCContainer(&mock.SystemSCContainerStub{
+		GetCalled: func(key []byte) (vm.SystemSmartContract, error) {
+			if bytes.Equal(key, vm.MultisigSCAddress) {
+				return m, nil
+			}
+			return nil, vm.ErrUnknownSystemSmartContract
+		},
+	})
+
+	return m, eei
+}
+
+func getDefaultVmInputForMultisig(caller []byte, recipient []byte, funcName string, args [][]byte) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr: caller,
+			Arguments:  args,
+			CallValue:  big.NewInt(0),
+		},
+		RecipientAddr: recipient,
+		Function:      funcName,
+	}
+}
+
+// executeOnMultisig keeps the storage updates from the previous calls, as they would have been saved in the state
+func executeOnMultisig(m *multisig, eei *vmContext, vmInput *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	eei.outputAccounts = make(map[string]*vmcommon.OutputAccount)
+	eei.output = make([][]byte, 0)
+	eei.returnMessage = ""
+	eei.SetSCAddress(vmInput.RecipientAddr)
+	eei.SetGasProvided(1000000)
+
+	return m.Execute(vmInput)
+}
+
+func createTestMultisigAccount(t *testing.T, m *multisig, eei *vmContext, quorum int64, board ...[]byte) []byte {
+	arguments := append([][]byte{big.NewInt(quorum).Bytes()}, board...)
+	vmInput := getDefaultVmInputForMultisig(boardMember1, vm.MultisigSCAddress, "createMultisig", arguments)
+	retCode := executeOnMultisig(m, eei, vmInput)
+	require.Equal(t, vmcommon.Ok, retCode)
+	require.Equal(t, 1, len(eei.output))
+
+	return eei.output[0]
+}
+
+func proposeOnMultisig(t *testing.T, m *multisig, eei *vmContext, multisigAddress []byte, proposer []byte, function string, args ...[]byte) []byte {
+	vmInput := getDefaultVmInputForMultisig(proposer, multisigAddress, function, args)
+	retCode := executeOnMultisig(m, eei, vmInput)
+	require.Equal(t, vmcommon.Ok, retCode)
+	require.Equal(t, 1, len(eei.output))
+
+	return eei.output[0]
+}
+
+func viewOnMultisig(t *testing.T, m *multisig, eei *vmContext, multisigAddress []byte, function string, args ...[]byte) [][]byte {
+	vmInput := getDefaultVmInputForMultisig(multisigAddress, multisigAddress, function, args)
+	retCode := executeOnMultisig(m, eei, vmInput)
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	return eei.output
+}
+
+func TestNewMultisigSystemSC(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForMultisig()
+	args.Eei = nil
+	m, err := NewMultisigSystemSC(args)
+	assert.Nil(t, m)
+	assert.Equal(t, vm.ErrNilSystemEnvironmentInterface, err)
+
+	args = createMockArgumentsForMultisig()
+	args.MultisigSCAddress = nil
+	m, err = NewMultisigSystemSC(args)
+	assert.Nil(t, m)
+	assert.True(t, errors.Is(err, vm.ErrInvalidAddress))
+
+	args = createMockArgumentsForMultisig()
+	args.Marshalizer = nil
+	m, err = NewMultisigSystemSC(args)
+	assert.Nil(t, m)
+	assert.Equal(t, vm.ErrNilMarshalizer, err)
+
+	args = createMockArgumentsForMultisig()
+	args.EpochNotifier = nil
+	m, err = NewMultisigSystemSC(args)
+	assert.Nil(t, m)
+	assert.Equal(t, vm.ErrNilEpochNotifier, err)
+
+	registerHandlerCalled := false
+	args = createMockArgumentsForMultisig()
+	args.EpochNotifier = &mock.EpochNotifierStub{
+		RegisterNotifyHandlerCalled: func(handler vmcommon.EpochSubscriberHandler) {
+			registerHandlerCalled = true
+		},
+	}
+	m, err = NewMultisigSystemSC(args)
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(m))
+	assert.True(t, registerHandlerCalled)
+}
+
+func TestMultisig_EpochConfirmed(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForMultisig()
+	args.EpochConfig.EnableEpochs.MultisigSCEnableEpoch = 5
+	m, _ := NewMultisigSystemSC(args)
+
+	m.EpochConfirmed(4, 0)
+	assert.False(t, m.CanUseContract())
+
+	m.EpochConfirmed(5, 0)
+	assert.True(t, m.CanUseContract())
+}
+
+func TestMultisig_ExecuteInvalidInputShouldErr(t *testing.T) {
+	t.Parallel()
+
+	m, eei := createMultisigWithVMContext(&mock.BlockChainHookStub{})
+
+	retCode := m.Execute(nil)
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	vmInput := getDefaultVmInputForMultisig(boardMember1, vm.MultisigSCAddress, "createMultisig", nil)
+	vmInput.ESDTTransfers = []*vmcommon.ESDTTransfer{{ESDTValue: big.NewInt(1)}}
+	retCode = executeOnMultisig(m, eei, vmInput)
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Equal(t, "cannot transfer ESDT to system SCs", eei.returnMessage)
+
+	vmInput = getDefaultVmInputForMultisig(boardMember1, vm.MultisigSCAddress, "sign", nil)
+	retCode = executeOnMultisig(m, eei, vmInput)
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Equal(t, "invalid function to call", eei.returnMessage)
+
+	m.multisigEnableEpoch = 1
+	m.EpochConfirmed(0, 0)
+	vmInput = getDefaultVmInputForMultisig(boardMember1, vm.MultisigSCAddress, "createMultisig", nil)
+	retCode = executeOnMultisig(m, eei, vmInput)
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Equal(t, "multisig contract is not enabled", eei.returnMessage)
+}
+
+func TestMultisig_CreateMultisigInvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	m, eei := createMultisigWithVMContext(&mock.BlockChainHookStub{})
+
+	vmInput := getDefaultVmInputForMultisig(boardMember1, vm.MultisigSCAddress, "createMultisig", [][]byte{{1}})
+	retCode := executeOnMultisig(m, eei, vmInput)
+	assert.Equal(t, vmcommon.FunctionWrongSignature, retCode)
+
+	vmInput.Arguments = [][]byte{{0}, boardMember1, boardMember2}
+	retCode = executeOnMultisig(m, eei, vmInput)
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Contains(t, eei.returnMessage, vm.ErrInvalidArgument.Error())
+
+	vmInput.Arguments = [][]byte{{3}, boardMember1, boardMember2}
+	retCode = executeOnMultisig(m, eei, vmInput)
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Contains(t, eei.returnMessage, vm.ErrInvalidArgument.Error())
+
+	vmInput.Arguments = [][]byte{{1}, boardMember1, boardMember1}
+	retCode = executeOnMultisig(m, eei, vmInput)
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Equal(t, vm.ErrDuplicatesFoundInArguments.Error(), eei.returnMessage)
+
+	vmInput.Arguments = [][]byte{{1}, boardMember1, []byte("short")}
+	retCode = executeOnMultisig(m, eei, vmInput)
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Contains(t, eei.returnMessage, vm.ErrInvalidAddress.Error())
+
+	vmInput.Arguments = [][]byte{{1}, vm.MultisigSCAddress}
+	retCode = executeOnMultisig(m, eei, vmInput)
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Contains(t, eei.returnMessage, vm.ErrInvalidAddress.Error())
+}
+
+func TestMultisig_CreateMultisigShouldWork(t *testing.T) {
+	t.Parallel()
+
+	m, eei := createMultisigWithVMContext(&mock.BlockChainHookStub{})
+
+	vmInput := getDefaultVmInputForMultisig(boardMember1, vm.MultisigSCAddress, "createMultisig", [][]byte{{2}, boardMember1, boardMember2, boardMember3})
+	vmInput.CallValue = big.NewInt(100)
+	retCode := executeOnMultisig(m, eei, vmInput)
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	expectedAddress := createNewAddress(vm.MultisigSCAddress)
+	assert.Equal(t, [][]byte{expectedAddress}, eei.output)
+
+	outAcc := eei.outputAccounts[string(expectedAddress)]
+	assert.Equal(t, vm.MultisigSCAddress, outAcc.Code)
+	assert.Equal(t, boardMember1, outAcc.CodeDeployerAddress)
+	assert.Equal(t, big.NewInt(100), outAcc.BalanceDelta)
+
+	management, _ := m.getMultisigManagement()
+	assert.Equal(t, uint32(1), management.NumOfAccounts)
+	assert.Equal(t, expectedAddress, management.LastAddress)
+
+	secondAddress := createTestMultisigAccount(t, m, eei, 1, boardMember2)
+	assert.Equal(t, createNewAddress(expectedAddress), secondAddress)
+
+	output := viewOnMultisig(t, m, eei, vm.MultisigSCAddress, "getMultisigAccounts", boardMember2)
+	assert.Equal(t, [][]byte{expectedAddress, secondAddress}, output)
+
+	output = viewOnMultisig(t, m, eei, vm.MultisigSCAddress, "getMultisigAccounts", boardMember3)
+	assert.Equal(t, [][]byte{expectedAddress}, output)
+
+	output = viewOnMultisig(t, m, eei, expectedAddress, "getQuorum")
+	assert.Equal(t, [][]byte{{2}}, output)
+
+	output = viewOnMultisig(t, m, eei, expectedAddress, "getBoardMembers")
+	assert.Equal(t, [][]byte{boardMember1, boardMember2, boardMember3}, output)
+}
+
+func TestMultisig_InitOnExistingAccountShouldErr(t *testing.T) {
+	t.Parallel()
+
+	m, eei := createMultisigWithVMContext(&mock.BlockChainHookStub{})
+	multisigAddress := createTestMultisigAccount(t, m, eei, 1, boardMember1)
+
+	vmInput := getDefaultVmInputForMultisig(boardMember1, multisigAddress, core.SCDeployInitFunctionName, [][]byte{{1}, boardMember2})
+	retCode := executeOnMultisig(m, eei, vmInput)
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Equal(t, "multisig account already initialized", eei.returnMessage)
+}
+
+func TestMultisig_ProposeShouldCheckCallerAndArguments(t *testing.T) {
+	t.Parallel()
+
+	m, eei := createMultisigWithVMContext(&mock.BlockChainHookStub{})
+	multisigAddress := createTestMultisigAccount(t, m, eei, 2, boardMember1, boardMember2)
+
+	vmInput := getDefaultVmInputForMultisig(outsider, multisigAddress, "proposeAddBoardMember", [][]byte{boardMember3})
+	retCode := executeOnMultisig(m, eei, vmInput)
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Equal(t, "only board members can call this function", eei.returnMessage)
+
+	vmInput = getDefaultVmInputForMultisig(boardMember1, multisigAddress, "proposeAddBoardMember", [][]byte{boardMember3})
+	vmInput.CallValue = big.NewInt(1)
+	retCode = executeOnMultisig(m, eei, vmInput)
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Equal(t, vm.ErrCallValueMustBeZero.Error(), eei.returnMessage)
+
+	vmInput = getDefaultVmInputForMultisig(boardMember1, multisigAddress, "proposeChangeQuorum", [][]byte{{3}})
+	retCode = executeOnMultisig(m, eei, vmInput)
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Contains(t, eei.returnMessage, vm.ErrInvalidArgument.Error())
+
+	vmInput = getDefaultVmInputForMultisig(boardMember1, multisigAddress, "proposeTransferExecute", [][]byte{outsider, {0}, {0}})
+	retCode = executeOnMultisig(m, eei, vmInput)
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Equal(t, "nothing to transfer or execute", eei.returnMessage)
+
+	vmInput = getDefaultVmInputForMultisig(boardMember1, multisigAddress, "proposeTransferExecute", [][]byte{outsider, {1}})
+	retCode = executeOnMultisig(m, eei, vmInput)
+	assert.Equal(t, vmcommon.FunctionWrongSignature, retCode)
+}
+
+func TestMultisig_TransferExecuteShouldWork(t *testing.T) {
+	t.Parallel()
+
+	blockChainHook := &mock.BlockChainHookStub{}
+	m, eei := createMultisigWithVMContext(blockChainHook)
+	multisigAddress := createTestMultisigAccount(t, m, eei, 2, boardMember1, boardMember2, boardMember3)
+
+	vmInput := getDefaultVmInputForMultisig(outsider, multisigAddress, "deposit", nil)
+	vmInput.CallValue = big.NewInt(500)
+	retCode := executeOnMultisig(m, eei, vmInput)
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	blockChainHook.GetUserAccountCalled = func(address []byte) (vmcommon.UserAccountHandler, error) {
+		account, _ := state.NewUserAccount(address)
+		_ = account.AddToBalance(big.NewInt(500))
+		return account, nil
+	}
+
+	actionID := proposeOnMultisig(t, m, eei, multisigAddress, boardMember1, "proposeTransferExecute",
+		outsider, big.NewInt(50).Bytes(), big.NewInt(1000).Bytes(), []byte("claim"), []byte{1, 2})
+	assert.Equal(t, []byte{1}, actionID)
+
+	output := viewOnMultisig(t, m, eei, multisigAddress, "getActionData", actionID)
+	expectedActionData := [][]byte{
+		[]byte(ActionTransferExecute.String()),
+		boardMember1,
+		outsider,
+		big.NewInt(50).Bytes(),
+		big.NewInt(1000).Bytes(),
+		[]byte("claim"),
+		{1, 2},
+	}
+	assert.Equal(t, expectedActionData, output)
+
+	output = viewOnMultisig(t, m, eei, multisigAddress, "quorumReached", actionID)
+	assert.Equal(t, [][]byte{[]byte("false")}, output)
+
+	vmInput = getDefaultVmInputForMultisig(boardMember3, multisigAddress, "performAction", [][]byte{actionID})
+	retCode = executeOnMultisig(m, eei, vmInput)
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Equal(t, "quorum has not been reached", eei.returnMessage)
+
+	vmInput = getDefaultVmInputForMultisig(boardMember1, multisigAddress, "sign", [][]byte{actionID})
+	retCode = executeOnMultisig(m, eei, vmInput)
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Equal(t, "action already signed", eei.returnMessage)
+
+	vmInput = getDefaultVmInputForMultisig(boardMember2, multisigAddress, "sign", [][]byte{actionID})
+	retCode = executeOnMultisig(m, eei, vmInput)
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	output = viewOnMultisig(t, m, eei, multisigAddress, "getActionSigners", actionID)
+	assert.Equal(t, [][]byte{boardMember1, boardMember2}, output)
+
+	output = viewOnMultisig(t, m, eei, multisigAddress, "quorumReached", actionID)
+	assert.Equal(t, [][]byte{[]byte("true")}, output)
+
+	vmInput = getDefaultVmInputForMultisig(boardMember3, multisigAddress, "performAction", [][]byte{actionID})
+	retCode = executeOnMultisig(m, eei, vmInput)
+	require.Equal(t, vmcommon.Ok, retCode)
+	assert.Equal(t, uint64(1000000-1-1000), eei.GasLeft())
+
+	destination := eei.outputAccounts[string(outsider)]
+	require.NotNil(t, destination)
+	assert.Equal(t, big.NewInt(50), destination.BalanceDelta)
+	require.Equal(t, 1, len(destination.OutputTransfers))
+	assert.Equal(t, []byte("claim@0102"), destination.OutputTransfers[0].Data)
+	assert.Equal(t, uint64(1000), destination.OutputTransfers[0].GasLimit)
+	assert.Equal(t, big.NewInt(-50), eei.outputAccounts[string(multisigAddress)].BalanceDelta)
+
+	output = viewOnMultisig(t, m, eei, multisigAddress, "getPendingActionIds")
+	assert.Empty(t, output)
+
+	vmInput = getDefaultVmInputForMultisig(boardMember3, multisigAddress, "performAction", [][]byte{actionID})
+	retCode = executeOnMultisig(m, eei, vmInput)
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Contains(t, eei.returnMessage, "does not exist")
+}
+
+func TestMultisig_TransferExecuteNotEnoughFundsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	blockChainHook := &mock.BlockChainHookStub{}
+	m, eei := createMultisigWithVMContext(blockChainHook)
+	multisigAddress := createTestMultisigAccount(t, m, eei, 1, boardMember1)
+
+	actionID := proposeOnMultisig(t, m, eei, multisigAddress, boardMember1, "proposeTransferExecute",
+		outsider, big.NewInt(50).Bytes(), big.NewInt(0).Bytes())
+
+	vmInput := getDefaultVmInputForMultisig(boardMember1, multisigAddress, "performAction", [][]byte{actionID})
+	retCode := executeOnMultisig(m, eei, vmInput)
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Equal(t, vm.ErrInsufficientMultisigFunds.Error(), eei.returnMessage)
+
+	blockChainHook.GetUserAccountCalled = func(address []byte) (vmcommon.UserAccountHandler, error) {
+		account, _ := state.NewUserAccount(address)
+		_ = account.AddToBalance(big.NewInt(50))
+		return account, nil
+	}
+	retCode = executeOnMultisig(m, eei, vmInput)
+	assert.Equal(t, vmcommon.Ok, retCode)
+}
+
+func TestMultisig_UnsignAndDiscardAction(t *testing.T) {
+	t.Parallel()
+
+	m, eei := createMultisigWithVMContext(&mock.BlockChainHookStub{})
+	multisigAddress := createTestMultisigAccount(t, m, eei, 2, boardMember1, boardMember2)
+
+	actionID := proposeOnMultisig(t, m, eei, multisigAddress, boardMember1, "proposeChangeQuorum", []byte{1})
+
+	vmInput := getDefaultVmInputForMultisig(boardMember2, multisigAddress, "discardAction", [][]byte{actionID})
+	retCode := executeOnMultisig(m, eei, vmInput)
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Equal(t, "can not discard an action with valid signatures", eei.returnMessage)
+
+	vmInput = getDefaultVmInputForMultisig(boardMember2, multisigAddress, "unsign", [][]byte{actionID})
+	retCode = executeOnMultisig(m, eei, vmInput)
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Equal(t, "action was not signed", eei.returnMessage)
+
+	vmInput = getDefaultVmInputForMultisig(boardMember1, multisigAddress, "unsign", [][]byte{actionID})
+	retCode = executeOnMultisig(m, eei, vmInput)
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	output := viewOnMultisig(t, m, eei, multisigAddress, "getActionValidSignerCount", actionID)
+	assert.Equal(t, [][]byte{{}}, output)
+
+	vmInput = getDefaultVmInputForMultisig(boardMember2, multisigAddress, "discardAction", [][]byte{actionID})
+	retCode = executeOnMultisig(m, eei, vmInput)
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	output = viewOnMultisig(t, m, eei, multisigAddress, "getPendingActionIds")
+	assert.Empty(t, output)
+}
+
+func TestMultisig_BoardAndQuorumChangesShouldWork(t *testing.T) {
+	t.Parallel()
+
+	m, eei := createMultisigWithVMContext(&mock.BlockChainHookStub{})
+	multisigAddress := createTestMultisigAccount(t, m, eei, 1, boardMember1, boardMember2)
+
+	performAction := func(actionID []byte) vmcommon.ReturnCode {
+		vmInput := getDefaultVmInputForMultisig(boardMember1, multisigAddress, "performAction", [][]byte{actionID})
+		return executeOnMultisig(m, eei, vmInput)
+	}
+
+	actionID := proposeOnMultisig(t, m, eei, multisigAddress, boardMember1, "proposeAddBoardMember", boardMember3)
+	require.Equal(t, vmcommon.Ok, performAction(actionID))
+
+	output := viewOnMultisig(t, m, eei, multisigAddress, "getBoardMembers")
+	assert.Equal(t, [][]byte{boardMember1, boardMember2, boardMember3}, output)
+	output = viewOnMultisig(t, m, eei, vm.MultisigSCAddress, "getMultisigAccounts", boardMember3)
+	assert.Equal(t, [][]byte{multisigAddress}, output)
+
+	actionID = proposeOnMultisig(t, m, eei, multisigAddress, boardMember1, "proposeAddBoardMember", boardMember3)
+	assert.Equal(t, vmcommon.UserError, performAction(actionID))
+	assert.Contains(t, eei.returnMessage, "already a board member")
+
+	actionID = proposeOnMultisig(t, m, eei, multisigAddress, boardMember1, "proposeChangeQuorum", []byte{3})
+	require.Equal(t, vmcommon.Ok, performAction(actionID))
+	output = viewOnMultisig(t, m, eei, multisigAddress, "getQuorum")
+	assert.Equal(t, [][]byte{{3}}, output)
+
+	// the signature of a removed board member is no longer taken into account
+	transferActionID := proposeOnMultisig(t, m, eei, multisigAddress, boardMember3, "proposeTransferExecute", outsider, []byte{1}, []byte{})
+	output = viewOnMultisig(t, m, eei, multisigAddress, "getActionValidSignerCount", transferActionID)
+	assert.Equal(t, [][]byte{{1}}, output)
+
+	actionID = proposeOnMultisig(t, m, eei, multisigAddress, boardMember1, "proposeRemoveBoardMember", boardMember3)
+	for _, signer := range [][]byte{boardMember2, boardMember3} {
+		vmInput := getDefaultVmInputForMultisig(signer, multisigAddress, "sign", [][]byte{actionID})
+		require.Equal(t, vmcommon.Ok, executeOnMultisig(m, eei, vmInput))
+	}
+	assert.Equal(t, vmcommon.UserError, performAction(actionID))
+	assert.Contains(t, eei.returnMessage, "less members than the quorum")
+
+	quorumActionID := proposeOnMultisig(t, m, eei, multisigAddress, boardMember1, "proposeChangeQuorum", []byte{2})
+	for _, signer := range [][]byte{boardMember2, boardMember3} {
+		vmInput := getDefaultVmInputForMultisig(signer, multisigAddress, "sign", [][]byte{quorumActionID})
+		require.Equal(t, vmcommon.Ok, executeOnMultisig(m, eei, vmInput))
+	}
+	require.Equal(t, vmcommon.Ok, performAction(quorumActionID))
+	require.Equal(t, vmcommon.Ok, performAction(actionID))
+
+	output = viewOnMultisig(t, m, eei, multisigAddress, "getBoardMembers")
+	assert.Equal(t, [][]byte{boardMember1, boardMember2}, output)
+	output = viewOnMultisig(t, m, eei, vm.MultisigSCAddress, "getMultisigAccounts", boardMember3)
+	assert.Empty(t, output)
+	output = viewOnMultisig(t, m, eei, multisigAddress, "getActionValidSignerCount", transferActionID)
+	assert.Equal(t, [][]byte{{}}, output)
+
+	vmInput := getDefaultVmInputForMultisig(boardMember3, multisigAddress, "sign", [][]byte{transferActionID})
+	retCode := executeOnMultisig(m, eei, vmInput)
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Equal(t, "only board members can call this function", eei.returnMessage)
+}
@@ -0,0 +1,739 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. scheduler.proto
+package systemSmartContracts
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/atomic"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+const schedulerStatusKey = "schedulerStatus"
+const scheduledCallPrefix = "call_"
+const scheduledNoncePrefix = "nonce_"
+const scheduledEpochPrefix = "epoch_"
+const conditionalCallsKey = "conditional"
+const maxScheduledCallsPerTarget = 100
+const maxConditionalCalls = 100
+const maxScheduledCallGasLimit = 600000000
+
+var metachainShardIdentifier = []byte{255}
+
+type scheduler struct {
+	eei                       vm.SystemEI
+	schedulerSCAddress        []byte
+	endOfEpochAddress         []byte
+	gasCost                   vm.GasCost
+	marshalizer               marshal.Marshalizer
+	scheduledCallsEnabled     atomic.Flag
+	scheduledCallsEnableEpoch uint32
+	mutExecution              sync.RWMutex
+}
+
+// ArgsNewScheduler defines the arguments to create the scheduled calls system smart contract
+type ArgsNewScheduler struct {
+	Eei                vm.SystemEI
+	GasCost            vm.GasCost
+	Marshalizer        marshal.Marshalizer
+	SchedulerSCAddress []byte
+	EndOfEpochAddress  []byte
+	EpochNotifier      vm.EpochNotifier
+	EpochConfig        config.EpochConfig
+}
+
+// NewSchedulerSystemSC creates a new scheduled calls system SC. The contract escrows the value and the gas of the
+// scheduled calls, while the metachain block processor fetches the due calls at their target nonce or epoch, or once
+// their condition holds, and sends them as smart contract results to be executed by the shard of their destination
+func NewSchedulerSystemSC(args ArgsNewScheduler) (*scheduler, error) {
+	if check.IfNil(args.Eei) {
+		return nil, vm.ErrNilSystemEnvironmentInterface
+	}
+	if len(args.SchedulerSCAddress) < 1 {
+		return nil, fmt.Errorf("%w for scheduler sc address", vm.ErrInvalidAddress)
+	}
+	if len(args.EndOfEpochAddress) < 1 {
+		return nil, fmt.Errorf("%w for end of epoch address", vm.ErrInvalidAddress)
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, vm.ErrNilMarshalizer
+	}
+	if check.IfNil(args.EpochNotifier) {
+		return nil, vm.ErrNilEpochNotifier
+	}
+
+	s := &scheduler{
+		eei:                       args.Eei,
+		schedulerSCAddress:        args.SchedulerSCAddress,
+		endOfEpochAddress:         args.EndOfEpochAddress,
+		gasCost:                   args.GasCost,
+		marshalizer:               args.Marshalizer,
+		scheduledCallsEnabled:     atomic.Flag{},
+		scheduledCallsEnableEpoch: args.EpochConfig.EnableEpochs.ScheduledCallsEnableEpoch,
+	}
+	log.Debug("scheduler: enable epoch for scheduled calls", "epoch", s.scheduledCallsEnableEpoch)
+
+	args.EpochNotifier.RegisterNotifyHandler(s)
+
+	return s, nil
+}
+
+// Execute calls one of the functions from the scheduler contract and runs the code according to the input
+func (s *scheduler) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	s.mutExecution.RLock()
+	defer s.mutExecution.RUnlock()
+
+	err := CheckIfNil(args)
+	if err != nil {
+		s.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	if !s.scheduledCallsEnabled.IsSet() {
+		s.eei.AddReturnMessage("scheduler contract is not enabled")
+		return vmcommon.UserError
+	}
+
+	if len(args.ESDTTransfers) > 0 {
+		s.eei.AddReturnMessage("cannot transfer ESDT to system SCs")
+		return vmcommon.UserError
+	}
+
+	switch args.Function {
+	case "scheduleAtNonce":
+		return s.schedule(args, true)
+	case "scheduleAtEpoch":
+		return s.schedule(args, false)
+	case "scheduleWhen":
+		return s.scheduleWhen(args)
+	case "cancel":
+		return s.cancel(args)
+	case "executeScheduled":
+		return s.executeScheduled(args)
+	case "getConditionalCalls":
+		return s.getConditionalCalls(args)
+	case "getScheduledCall":
+		return s.getScheduledCall(args)
+	case "getScheduledCallsForNonce":
+		return s.getScheduledCallsForTarget(args, scheduledNoncePrefix)
+	case "getScheduledCallsForEpoch":
+		return s.getScheduledCallsForTarget(args, scheduledEpochPrefix)
+	}
+
+	s.eei.AddReturnMessage("invalid function to call")
+	return vmcommon.UserError
+}
+
+// schedule saves a new call which will be sent at the target nonce or epoch. The target nonce is a metachain block
+// nonce, not a nonce of the destination shard, as the due calls are sent while processing the metachain blocks. The
+// call value escrows the value to be transferred together with the gas limit of the call, at the gas price of the
+// scheduling transaction
+func (s *scheduler) schedule(args *vmcommon.ContractCallInput, isNonceTarget bool) vmcommon.ReturnCode {
+	if len(args.Arguments) < 3 {
+		s.eei.AddReturnMessage(vm.ErrInvalidNumOfArguments.Error())
+		return vmcommon.FunctionWrongSignature
+	}
+	err := s.eei.UseGas(s.gasCost.MetaChainSystemSCsCost.SchedulerOps)
+	if err != nil {
+		s.eei.AddReturnMessage(err.Error())
+		return vmcommon.OutOfGas
+	}
+
+	call, returnCode := s.createScheduledCall(args, args.Arguments[0], args.Arguments[2], args.Arguments[3:])
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	target := big.NewInt(0).SetBytes(args.Arguments[1])
+	targetKey, returnCode := s.checkTarget(target, isNonceTarget, call)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	return s.saveNewScheduledCall(targetKey, call, maxScheduledCallsPerTarget)
+}
+
+// scheduleWhen saves a new call which will be sent once the view function given by the condition call returns a
+// non-zero value on the condition address, which must be a system smart contract. As only a part of the pending
+// conditions are evaluated in each metachain block, the call may be sent a few blocks after its condition holds. If
+// the condition does not hold until the end of the expiry epoch, the escrowed value and gas are sent back to the sender
+func (s *scheduler) scheduleWhen(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if len(args.Arguments) < 5 {
+		s.eei.AddReturnMessage(vm.ErrInvalidNumOfArguments.Error())
+		return vmcommon.FunctionWrongSignature
+	}
+	err := s.eei.UseGas(s.gasCost.MetaChainSystemSCsCost.SchedulerOps)
+	if err != nil {
+		s.eei.AddReturnMessage(err.Error())
+		return vmcommon.OutOfGas
+	}
+
+	call, returnCode := s.createScheduledCall(args, args.Arguments[0], args.Arguments[4], args.Arguments[5:])
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	conditionAddress := args.Arguments[1]
+	isSystemSC := len(conditionAddress) == len(args.CallerAddr) && core.IsSmartContractOnMetachain(metachainShardIdentifier, conditionAddress)
+	if !isSystemSC || bytes.Equal(conditionAddress, s.schedulerSCAddress) {
+		s.eei.AddReturnMessage("condition address must be a system smart contract other than the scheduler")
+		return vmcommon.UserError
+	}
+	conditionCall := args.Arguments[2]
+	if len(conditionCall) == 0 {
+		s.eei.AddReturnMessage("empty condition call")
+		return vmcommon.UserError
+	}
+	expiryEpoch := big.NewInt(0).SetBytes(args.Arguments[3])
+	if !expiryEpoch.IsUint64() || expiryEpoch.Uint64() > uint64(^uint32(0)) || uint32(expiryEpoch.Uint64()) <= s.eei.BlockChainHook().CurrentEpoch() {
+		s.eei.AddReturnMessage("expiry epoch must be in the future")
+		return vmcommon.UserError
+	}
+
+	call.ConditionAddress = conditionAddress
+	call.ConditionCall = conditionCall
+	call.ExpiryEpoch = uint32(expiryEpoch.Uint64())
+
+	return s.saveNewScheduledCall([]byte(conditionalCallsKey), call, maxConditionalCalls)
+}
+
+func (s *scheduler) createScheduledCall(
+	args *vmcommon.ContractCallInput,
+	destination []byte,
+	gasLimitArgument []byte,
+	dataArguments [][]byte,
+) (*ScheduledCall, vmcommon.ReturnCode) {
+	if len(destination) != len(args.CallerAddr) {
+		s.eei.AddReturnMessage(vm.ErrInvalidAddress.Error())
+		return nil, vmcommon.UserError
+	}
+	if core.IsSmartContractOnMetachain(metachainShardIdentifier, destination) {
+		s.eei.AddReturnMessage("scheduled calls can not target system smart contracts")
+		return nil, vmcommon.UserError
+	}
+
+	gasLimit := big.NewInt(0).SetBytes(gasLimitArgument)
+	if !gasLimit.IsUint64() || gasLimit.Uint64() > maxScheduledCallGasLimit {
+		s.eei.AddReturnMessage("invalid gas limit")
+		return nil, vmcommon.UserError
+	}
+
+	gasFee := big.NewInt(0).Mul(gasLimit, big.NewInt(0).SetUint64(args.GasPrice))
+	value := big.NewInt(0).Sub(args.CallValue, gasFee)
+	if value.Cmp(zero) < 0 {
+		s.eei.AddReturnMessage("call value does not cover the gas of the scheduled call")
+		return nil, vmcommon.UserError
+	}
+
+	call := &ScheduledCall{
+		Sender:      args.CallerAddr,
+		Destination: destination,
+		Value:       value,
+		GasLimit:    gasLimit.Uint64(),
+		GasPrice:    args.GasPrice,
+		TxHash:      args.CurrentTxHash,
+		Status:      ScheduledCallPending,
+	}
+	if len(dataArguments) > 0 {
+		txData := string(dataArguments[0])
+		for _, argument := range dataArguments[1:] {
+			txData += "@" + hex.EncodeToString(argument)
+		}
+		call.Data = []byte(txData)
+	}
+	if len(call.Data) == 0 && value.Cmp(zero) == 0 {
+		s.eei.AddReturnMessage("nothing to transfer or execute")
+		return nil, vmcommon.UserError
+	}
+
+	return call, vmcommon.Ok
+}
+
+func (s *scheduler) saveNewScheduledCall(listKey []byte, call *ScheduledCall, maxCalls int) vmcommon.ReturnCode {
+	callsList, err := s.getScheduledCallsList(listKey)
+	if err != nil {
+		s.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if len(callsList.IDs) >= maxCalls {
+		s.eei.AddReturnMessage("too many calls scheduled for the same target")
+		return vmcommon.UserError
+	}
+
+	status, err := s.getSchedulerStatus()
+	if err != nil {
+		s.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	status.LastCallID++
+	call.ID = status.LastCallID
+
+	callsList.IDs = append(callsList.IDs, call.ID)
+	err = s.saveScheduledCallsList(listKey, callsList)
+	if err != nil {
+		s.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	err = s.saveScheduledCall(call)
+	if err != nil {
+		s.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	err = s.saveSchedulerStatus(status)
+	if err != nil {
+		s.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	s.eei.Finish(big.NewInt(0).SetUint64(call.ID).Bytes())
+
+	return vmcommon.Ok
+}
+
+// checkTarget validates the target against the current block of the metachain, where the scheduler is executed
+func (s *scheduler) checkTarget(target *big.Int, isNonceTarget bool, call *ScheduledCall) ([]byte, vmcommon.ReturnCode) {
+	if isNonceTarget {
+		if !target.IsUint64() || target.Uint64() <= s.eei.BlockChainHook().CurrentNonce() {
+			s.eei.AddReturnMessage("target nonce must be a future metachain nonce")
+			return nil, vmcommon.UserError
+		}
+
+		call.TargetNonce = target.Uint64()
+		return createScheduledNonceKey(call.TargetNonce), vmcommon.Ok
+	}
+
+	if !target.IsUint64() || target.Uint64() > uint64(^uint32(0)) || uint32(target.Uint64()) <= s.eei.BlockChainHook().CurrentEpoch() {
+		s.eei.AddReturnMessage("target epoch must be in the future")
+		return nil, vmcommon.UserError
+	}
+
+	call.TargetEpoch = uint32(target.Uint64())
+	return createScheduledEpochKey(call.TargetEpoch), vmcommon.Ok
+}
+
+func (s *scheduler) cancel(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Cmp(zero) != 0 {
+		s.eei.AddReturnMessage(vm.ErrCallValueMustBeZero.Error())
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 1 {
+		s.eei.AddReturnMessage(vm.ErrInvalidNumOfArguments.Error())
+		return vmcommon.UserError
+	}
+	err := s.eei.UseGas(s.gasCost.MetaChainSystemSCsCost.SchedulerOps)
+	if err != nil {
+		s.eei.AddReturnMessage(err.Error())
+		return vmcommon.OutOfGas
+	}
+
+	call, err := s.getScheduledCallFromStorage(big.NewInt(0).SetBytes(args.Arguments[0]).Uint64())
+	if err != nil {
+		s.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if !bytes.Equal(call.Sender, args.CallerAddr) {
+		s.eei.AddReturnMessage("only the sender can cancel a scheduled call")
+		return vmcommon.UserError
+	}
+	if call.Status != ScheduledCallPending {
+		s.eei.AddReturnMessage("only pending scheduled calls can be canceled")
+		return vmcommon.UserError
+	}
+
+	targetKey := createScheduledCallListKey(call)
+	callsList, err := s.getScheduledCallsList(targetKey)
+	if err != nil {
+		s.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	callsList.IDs = removeScheduledCallID(callsList.IDs, call.ID)
+	err = s.saveScheduledCallsList(targetKey, callsList)
+	if err != nil {
+		s.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	call.Status = ScheduledCallCanceled
+	err = s.saveScheduledCall(call)
+	if err != nil {
+		s.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	err = s.eei.Transfer(call.Sender, args.RecipientAddr, computeScheduledCallEscrow(call), nil, 0)
+	if err != nil {
+		s.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+// executeScheduled marks as executed all the pending calls which reached their target and returns them in order.
+// Besides the calls targeting the current nonce and epoch, the ones targeting the previous nonce are also checked
+// as the epoch start blocks do not execute scheduled calls. The arguments following the nonce and the epoch are the
+// IDs of the conditional calls whose condition holds, as evaluated by the caller, while the conditional calls past
+// their expiry epoch are marked as expired and returned as well. The caller is responsible for sending the calls,
+// or for refunding the expired ones, and for moving their escrow out of the scheduler account
+func (s *scheduler) executeScheduled(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !bytes.Equal(args.CallerAddr, s.endOfEpochAddress) {
+		s.eei.AddReturnMessage("executeScheduled function not allowed to be called by address " + string(args.CallerAddr))
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) < 2 {
+		s.eei.AddReturnMessage(vm.ErrInvalidNumOfArguments.Error())
+		return vmcommon.UserError
+	}
+
+	nonce := big.NewInt(0).SetBytes(args.Arguments[0]).Uint64()
+	epoch := uint32(big.NewInt(0).SetBytes(args.Arguments[1]).Uint64())
+
+	targetKeys := [][]byte{createScheduledEpochKey(epoch)}
+	if nonce > 0 {
+		targetKeys = append(targetKeys, createScheduledNonceKey(nonce-1))
+	}
+	targetKeys = append(targetKeys, createScheduledNonceKey(nonce))
+
+	for _, targetKey := range targetKeys {
+		err := s.executeScheduledCallsForTarget(targetKey, nonce)
+		if err != nil {
+			s.eei.AddReturnMessage(err.Error())
+			return vmcommon.UserError
+		}
+	}
+
+	err := s.executeConditionalCalls(args.Arguments[2:], nonce, epoch)
+	if err != nil {
+		s.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+func (s *scheduler) executeScheduledCallsForTarget(targetKey []byte, nonce uint64) error {
+	callsList, err := s.getScheduledCallsList(targetKey)
+	if err != nil {
+		return err
+	}
+	if len(callsList.IDs) == 0 {
+		return nil
+	}
+
+	for _, id := range callsList.IDs {
+		call, errGet := s.getScheduledCallFromStorage(id)
+		if errGet != nil {
+			return errGet
+		}
+		if call.Status != ScheduledCallPending {
+			continue
+		}
+
+		err = s.finishScheduledCall(call, ScheduledCallExecuted, nonce)
+		if err != nil {
+			return err
+		}
+	}
+
+	s.eei.SetStorage(targetKey, nil)
+
+	return nil
+}
+
+func (s *scheduler) executeConditionalCalls(readyIDs [][]byte, nonce uint64, epoch uint32) error {
+	callsList, err := s.getScheduledCallsList([]byte(conditionalCallsKey))
+	if err != nil {
+		return err
+	}
+	if len(callsList.IDs) == 0 {
+		return nil
+	}
+
+	readyCalls := make(map[uint64]struct{}, len(readyIDs))
+	for _, id := range readyIDs {
+		readyCalls[big.NewInt(0).SetBytes(id).Uint64()] = struct{}{}
+	}
+
+	pendingIDs := make([]uint64, 0, len(callsList.IDs))
+	for _, id := range callsList.IDs {
+		call, errGet := s.getScheduledCallFromStorage(id)
+		if errGet != nil {
+			return errGet
+		}
+
+		_, isReady := readyCalls[id]
+		switch {
+		case isReady:
+			err = s.finishScheduledCall(call, ScheduledCallExecuted, nonce)
+		case epoch > call.ExpiryEpoch:
+			err = s.finishScheduledCall(call, ScheduledCallExpired, nonce)
+		default:
+			pendingIDs = append(pendingIDs, id)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	callsList.IDs = pendingIDs
+	return s.saveScheduledCallsList([]byte(conditionalCallsKey), callsList)
+}
+
+func (s *scheduler) finishScheduledCall(call *ScheduledCall, status ScheduledCallStatus, nonce uint64) error {
+	call.Status = status
+	call.ExecutedNonce = nonce
+	err := s.saveScheduledCall(call)
+	if err != nil {
+		return err
+	}
+
+	marshaledData, err := s.marshalizer.Marshal(call)
+	if err != nil {
+		return err
+	}
+	s.eei.Finish(marshaledData)
+
+	return nil
+}
+
+// getConditionalCalls returns the pending conditional calls, so that the caller can evaluate their conditions
+func (s *scheduler) getConditionalCalls(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !bytes.Equal(args.CallerAddr, s.endOfEpochAddress) {
+		s.eei.AddReturnMessage("getConditionalCalls function not allowed to be called by address " + string(args.CallerAddr))
+		return vmcommon.UserError
+	}
+
+	callsList, err := s.getScheduledCallsList([]byte(conditionalCallsKey))
+	if err != nil {
+		s.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	for _, id := range callsList.IDs {
+		marshaledData := s.eei.GetStorage(createScheduledCallKey(id))
+		if len(marshaledData) == 0 {
+			s.eei.AddReturnMessage(fmt.Sprintf("%s, scheduled call %d not found", vm.ErrInvalidArgument.Error(), id))
+			return vmcommon.UserError
+		}
+		s.eei.Finish(marshaledData)
+	}
+
+	return vmcommon.Ok
+}
+
+func (s *scheduler) getScheduledCall(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	returnCode := s.checkViewCall(args)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	call, err := s.getScheduledCallFromStorage(big.NewInt(0).SetBytes(args.Arguments[0]).Uint64())
+	if err != nil {
+		s.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	s.eei.Finish(call.Sender)
+	s.eei.Finish(call.Destination)
+	s.eei.Finish(call.Value.Bytes())
+	s.eei.Finish(call.Data)
+	s.eei.Finish(big.NewInt(0).SetUint64(call.GasLimit).Bytes())
+	s.eei.Finish(big.NewInt(0).SetUint64(call.TargetNonce).Bytes())
+	s.eei.Finish(big.NewInt(0).SetUint64(uint64(call.TargetEpoch)).Bytes())
+	s.eei.Finish([]byte(call.Status.String()))
+	s.eei.Finish(big.NewInt(0).SetUint64(call.ExecutedNonce).Bytes())
+	s.eei.Finish(call.ConditionAddress)
+	s.eei.Finish(call.ConditionCall)
+	s.eei.Finish(big.NewInt(0).SetUint64(uint64(call.ExpiryEpoch)).Bytes())
+
+	return vmcommon.Ok
+}
+
+func (s *scheduler) getScheduledCallsForTarget(args *vmcommon.ContractCallInput, prefix string) vmcommon.ReturnCode {
+	returnCode := s.checkViewCall(args)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	target := big.NewInt(0).SetBytes(args.Arguments[0]).Uint64()
+	targetKey := append([]byte(prefix), big.NewInt(0).SetUint64(target).Bytes()...)
+	callsList, err := s.getScheduledCallsList(targetKey)
+	if err != nil {
+		s.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	for _, id := range callsList.IDs {
+		s.eei.Finish(big.NewInt(0).SetUint64(id).Bytes())
+	}
+
+	return vmcommon.Ok
+}
+
+func (s *scheduler) checkViewCall(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Cmp(zero) != 0 {
+		s.eei.AddReturnMessage(vm.ErrCallValueMustBeZero.Error())
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 1 {
+		s.eei.AddReturnMessage(vm.ErrInvalidNumOfArguments.Error())
+		return vmcommon.UserError
+	}
+	err := s.eei.UseGas(s.gasCost.MetaChainSystemSCsCost.SchedulerOps)
+	if err != nil {
+		s.eei.AddReturnMessage(err.Error())
+		return vmcommon.OutOfGas
+	}
+
+	return vmcommon.Ok
+}
+
+func computeScheduledCallEscrow(call *ScheduledCall) *big.Int {
+	escrow := big.NewInt(0).SetUint64(call.GasLimit)
+	escrow.Mul(escrow, big.NewInt(0).SetUint64(call.GasPrice))
+	return escrow.Add(escrow, call.Value)
+}
+
+func removeScheduledCallID(ids []uint64, id uint64) []uint64 {
+	for i, currentID := range ids {
+		if currentID == id {
+			return append(ids[:i], ids[i+1:]...)
+		}
+	}
+
+	return ids
+}
+
+func createScheduledCallListKey(call *ScheduledCall) []byte {
+	if len(call.ConditionAddress) > 0 {
+		return []byte(conditionalCallsKey)
+	}
+	if call.TargetNonce > 0 {
+		return createScheduledNonceKey(call.TargetNonce)
+	}
+
+	return createScheduledEpochKey(call.TargetEpoch)
+}
+
+func createScheduledCallKey(id uint64) []byte {
+	return append([]byte(scheduledCallPrefix), big.NewInt(0).SetUint64(id).Bytes()...)
+}
+
+func createScheduledNonceKey(nonce uint64) []byte {
+	return append([]byte(scheduledNoncePrefix), big.NewInt(0).SetUint64(nonce).Bytes()...)
+}
+
+func createScheduledEpochKey(epoch uint32) []byte {
+	return append([]byte(scheduledEpochPrefix), big.NewInt(0).SetUint64(uint64(epoch)).Bytes()...)
+}
+
+func (s *scheduler) getScheduledCallFromStorage(id uint64) (*ScheduledCall, error) {
+	marshaledData := s.eei.GetStorage(createScheduledCallKey(id))
+	if len(marshaledData) == 0 {
+		return nil, fmt.Errorf("%w, scheduled call %d not found", vm.ErrInvalidArgument, id)
+	}
+
+	call := &ScheduledCall{}
+	err := s.marshalizer.Unmarshal(call, marshaledData)
+	if err != nil {
+		return nil, err
+	}
+
+	return call, nil
+}
+
+func (s *scheduler) saveScheduledCall(call *ScheduledCall) error {
+	marshaledData, err := s.marshalizer.Marshal(call)
+	if err != nil {
+		return err
+	}
+
+	s.eei.SetStorage(createScheduledCallKey(call.ID), marshaledData)
+	return nil
+}
+
+func (s *scheduler) getScheduledCallsList(targetKey []byte) (*ScheduledCallsList, error) {
+	callsList := &ScheduledCallsList{IDs: make([]uint64, 0)}
+	marshaledData := s.eei.GetStorage(targetKey)
+	if len(marshaledData) == 0 {
+		return callsList, nil
+	}
+
+	err := s.marshalizer.Unmarshal(callsList, marshaledData)
+	if err != nil {
+		return nil, err
+	}
+
+	return callsList, nil
+}
+
+func (s *scheduler) saveScheduledCallsList(targetKey []byte, callsList *ScheduledCallsList) error {
+	if len(callsList.IDs) == 0 {
+		s.eei.SetStorage(targetKey, nil)
+		return nil
+	}
+
+	marshaledData, err := s.marshalizer.Marshal(callsList)
+	if err != nil {
+		return err
+	}
+
+	s.eei.SetStorage(targetKey, marshaledData)
+	return nil
+}
+
+func (s *scheduler) getSchedulerStatus() (*SchedulerStatus, error) {
+	status := &SchedulerStatus{}
+	marshaledData := s.eei.GetStorage([]byte(schedulerStatusKey))
+	if len(marshaledData) == 0 {
+		return status, nil
+	}
+
+	err := s.marshalizer.Unmarshal(status, marshaledData)
+	if err != nil {
+		return nil, err
+	}
+
+	return status, nil
+}
+
+func (s *scheduler) saveSchedulerStatus(status *SchedulerStatus) error {
+	marshaledData, err := s.marshalizer.Marshal(status)
+	if err != nil {
+		return err
+	}
+
+	s.eei.SetStorage([]byte(schedulerStatusKey), marshaledData)
+	return nil
+}
+
+// EpochConfirmed is called whenever a new epoch is confirmed
+func (s *scheduler) EpochConfirmed(epoch uint32, _ uint64) {
+	s.scheduledCallsEnabled.Toggle(epoch >= s.scheduledCallsEnableEpoch)
+	log.Debug("schedulerSC: scheduled calls", "enabled", s.scheduledCallsEnabled.IsSet())
+}
+
+// CanUseContract returns true if contract can be used
+func (s *scheduler) CanUseContract() bool {
+	return s.scheduledCallsEnabled.IsSet()
+}
+
+// SetNewGasCost is called whenever a gas cost was changed
+func (s *scheduler) SetNewGasCost(gasCost vm.GasCost) {
+	s.mutExecution.Lock()
+	s.gasCost = gasCost
+	s.mutExecution.Unlock()
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (s *scheduler) IsInterfaceNil() bool {
+	return s == nil
+}
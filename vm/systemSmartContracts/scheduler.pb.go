@@ -0,0 +1,1598 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: scheduler.proto
+
+package systemSmartContracts
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	github_com_ElrondNetwork_elrond_go_core_data "github.com/ElrondNetwork/elrond-go-core/data"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_big "math/big"
+	math_bits "math/bits"
+	reflect "reflect"
+	strconv "strconv"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type ScheduledCallStatus int32
+
+const (
+	ScheduledCallPending  ScheduledCallStatus = 0
+	ScheduledCallExecuted ScheduledCallStatus = 1
+	ScheduledCallCanceled ScheduledCallStatus = 2
+	ScheduledCallExpired  ScheduledCallStatus = 3
+)
+
+var ScheduledCallStatus_name = map[int32]string{
+	0: "ScheduledCallPending",
+	1: "ScheduledCallExecuted",
+	2: "ScheduledCallCanceled",
+	3: "ScheduledCallExpired",
+}
+
+var ScheduledCallStatus_value = map[string]int32{
+	"ScheduledCallPending":  0,
+	"ScheduledCallExecuted": 1,
+	"ScheduledCallCanceled": 2,
+	"ScheduledCallExpired":  3,
+}
+
+func (ScheduledCallStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2b3fc28395a6d9c5, []int{0}
+}
+
+type SchedulerStatus struct {
+	LastCallID uint64 `protobuf:"varint,1,opt,name=LastCallID,proto3" json:"LastCallID"`
+}
+
+func (m *SchedulerStatus) Reset()      { *m = SchedulerStatus{} }
+func (*SchedulerStatus) ProtoMessage() {}
+func (*SchedulerStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b3fc28395a6d9c5, []int{0}
+}
+func (m *SchedulerStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SchedulerStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SchedulerStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SchedulerStatus.Merge(m, src)
+}
+func (m *SchedulerStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *SchedulerStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_SchedulerStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SchedulerStatus proto.InternalMessageInfo
+
+func (m *SchedulerStatus) GetLastCallID() uint64 {
+	if m != nil {
+		return m.LastCallID
+	}
+	return 0
+}
+
+type ScheduledCallsList struct {
+	IDs []uint64 `protobuf:"varint,1,rep,packed,name=IDs,proto3" json:"IDs"`
+}
+
+func (m *ScheduledCallsList) Reset()      { *m = ScheduledCallsList{} }
+func (*ScheduledCallsList) ProtoMessage() {}
+func (*ScheduledCallsList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b3fc28395a6d9c5, []int{1}
+}
+func (m *ScheduledCallsList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledCallsList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ScheduledCallsList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledCallsList.Merge(m, src)
+}
+func (m *ScheduledCallsList) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledCallsList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledCallsList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledCallsList proto.InternalMessageInfo
+
+func (m *ScheduledCallsList) GetIDs() []uint64 {
+	if m != nil {
+		return m.IDs
+	}
+	return nil
+}
+
+type ScheduledCall struct {
+	ID               uint64              `protobuf:"varint,1,opt,name=ID,proto3" json:"ID"`
+	Sender           []byte              `protobuf:"bytes,2,opt,name=Sender,proto3" json:"Sender"`
+	Destination      []byte              `protobuf:"bytes,3,opt,name=Destination,proto3" json:"Destination"`
+	Value            *math_big.Int       `protobuf:"bytes,4,opt,name=Value,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go-core/data.BigIntCaster" json:"Value"`
+	Data             []byte              `protobuf:"bytes,5,opt,name=Data,proto3" json:"Data"`
+	GasLimit         uint64              `protobuf:"varint,6,opt,name=GasLimit,proto3" json:"GasLimit"`
+	GasPrice         uint64              `protobuf:"varint,7,opt,name=GasPrice,proto3" json:"GasPrice"`
+	TargetNonce      uint64              `protobuf:"varint,8,opt,name=TargetNonce,proto3" json:"TargetNonce"`
+	TargetEpoch      uint32              `protobuf:"varint,9,opt,name=TargetEpoch,proto3" json:"TargetEpoch"`
+	TxHash           []byte              `protobuf:"bytes,10,opt,name=TxHash,proto3" json:"TxHash"`
+	Status           ScheduledCallStatus `protobuf:"varint,11,opt,name=Status,proto3,enum=proto.ScheduledCallStatus" json:"Status"`
+	ExecutedNonce    uint64              `protobuf:"varint,12,opt,name=ExecutedNonce,proto3" json:"ExecutedNonce"`
+	ConditionAddress []byte              `protobuf:"bytes,13,opt,name=ConditionAddress,proto3" json:"ConditionAddress"`
+	ConditionCall    []byte              `protobuf:"bytes,14,opt,name=ConditionCall,proto3" json:"ConditionCall"`
+	ExpiryEpoch      uint32              `protobuf:"varint,15,opt,name=ExpiryEpoch,proto3" json:"ExpiryEpoch"`
+}
+
+func (m *ScheduledCall) Reset()      { *m = ScheduledCall{} }
+func (*ScheduledCall) ProtoMessage() {}
+func (*ScheduledCall) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b3fc28395a6d9c5, []int{2}
+}
+func (m *ScheduledCall) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledCall) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ScheduledCall) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledCall.Merge(m, src)
+}
+func (m *ScheduledCall) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledCall) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledCall.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledCall proto.InternalMessageInfo
+
+func (m *ScheduledCall) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *ScheduledCall) GetSender() []byte {
+	if m != nil {
+		return m.Sender
+	}
+	return nil
+}
+
+func (m *ScheduledCall) GetDestination() []byte {
+	if m != nil {
+		return m.Destination
+	}
+	return nil
+}
+
+func (m *ScheduledCall) GetValue() *math_big.Int {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *ScheduledCall) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *ScheduledCall) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *ScheduledCall) GetGasPrice() uint64 {
+	if m != nil {
+		return m.GasPrice
+	}
+	return 0
+}
+
+func (m *ScheduledCall) GetTargetNonce() uint64 {
+	if m != nil {
+		return m.TargetNonce
+	}
+	return 0
+}
+
+func (m *ScheduledCall) GetTargetEpoch() uint32 {
+	if m != nil {
+		return m.TargetEpoch
+	}
+	return 0
+}
+
+func (m *ScheduledCall) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *ScheduledCall) GetStatus() ScheduledCallStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ScheduledCallPending
+}
+
+func (m *ScheduledCall) GetExecutedNonce() uint64 {
+	if m != nil {
+		return m.ExecutedNonce
+	}
+	return 0
+}
+
+func (m *ScheduledCall) GetConditionAddress() []byte {
+	if m != nil {
+		return m.ConditionAddress
+	}
+	return nil
+}
+
+func (m *ScheduledCall) GetConditionCall() []byte {
+	if m != nil {
+		return m.ConditionCall
+	}
+	return nil
+}
+
+func (m *ScheduledCall) GetExpiryEpoch() uint32 {
+	if m != nil {
+		return m.ExpiryEpoch
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("proto.ScheduledCallStatus", ScheduledCallStatus_name, ScheduledCallStatus_value)
+	proto.RegisterType((*SchedulerStatus)(nil), "proto.SchedulerStatus")
+	proto.RegisterType((*ScheduledCallsList)(nil), "proto.ScheduledCallsList")
+	proto.RegisterType((*ScheduledCall)(nil), "proto.ScheduledCall")
+}
+
+func init() { proto.RegisterFile("scheduler.proto", fileDescriptor_2b3fc28395a6d9c5) }
+
+var fileDescriptor_2b3fc28395a6d9c5 = []byte{
+	// 645 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcd, 0x6e, 0x13, 0x31,
+	0x10, 0xc7, 0xe3, 0x24, 0x4d, 0x8b, 0xdb, 0x34, 0xc1, 0x14, 0xe4, 0x56, 0xc8, 0x1b, 0xe5, 0x14,
+	0x21, 0x35, 0x11, 0x70, 0xe0, 0x80, 0x84, 0x68, 0x3e, 0x80, 0x48, 0x55, 0x55, 0x39, 0x15, 0x07,
+	0x6e, 0xce, 0xae, 0xd9, 0x58, 0x24, 0xeb, 0xca, 0x76, 0x44, 0x7b, 0x82, 0x07, 0xe0, 0xc0, 0x63,
+	0x20, 0x9e, 0x84, 0x63, 0x8f, 0x3d, 0x2d, 0x74, 0x7b, 0x41, 0x7b, 0xea, 0x23, 0xa0, 0xf5, 0xa6,
+	0xe9, 0xae, 0xc2, 0x65, 0x3d, 0xff, 0xdf, 0xcc, 0x78, 0x3c, 0x33, 0x0b, 0x6b, 0xda, 0x9d, 0x70,
+	0x6f, 0x3e, 0xe5, 0xaa, 0x7d, 0xaa, 0xa4, 0x91, 0x68, 0xcd, 0x1e, 0x7b, 0xfb, 0xbe, 0x30, 0x93,
+	0xf9, 0xb8, 0xed, 0xca, 0x59, 0xc7, 0x97, 0xbe, 0xec, 0x58, 0x3c, 0x9e, 0x7f, 0xb4, 0xca, 0x0a,
+	0x6b, 0xa5, 0x59, 0xcd, 0x03, 0x58, 0x1b, 0xdd, 0x5e, 0x34, 0x32, 0xcc, 0xcc, 0x35, 0x6a, 0x43,
+	0x78, 0xc8, 0xb4, 0xe9, 0xb1, 0xe9, 0x74, 0xd8, 0xc7, 0xa0, 0x01, 0x5a, 0xe5, 0xee, 0x76, 0x1c,
+	0x3a, 0x19, 0x4a, 0x33, 0x76, 0xb3, 0x03, 0xd1, 0xed, 0x15, 0x5e, 0x82, 0xf4, 0xa1, 0xd0, 0x06,
+	0xed, 0xc2, 0xd2, 0xb0, 0xaf, 0x31, 0x68, 0x94, 0x5a, 0xe5, 0xee, 0x7a, 0x1c, 0x3a, 0x89, 0xa4,
+	0xc9, 0xa7, 0xf9, 0xad, 0x02, 0xab, 0xb9, 0x0c, 0xf4, 0x08, 0x16, 0x97, 0xa5, 0x2a, 0x71, 0xe8,
+	0x14, 0x87, 0x7d, 0x5a, 0x1c, 0xf6, 0x51, 0x13, 0x56, 0x46, 0x3c, 0xf0, 0xb8, 0xc2, 0xc5, 0x06,
+	0x68, 0x6d, 0x75, 0x61, 0x1c, 0x3a, 0x0b, 0x42, 0x17, 0x27, 0x7a, 0x0a, 0x37, 0xfb, 0x5c, 0x1b,
+	0x11, 0x30, 0x23, 0x64, 0x80, 0x4b, 0x36, 0xb0, 0x16, 0x87, 0x4e, 0x16, 0xd3, 0xac, 0x40, 0x02,
+	0xae, 0xbd, 0x67, 0xd3, 0x39, 0xc7, 0x65, 0x1b, 0x3c, 0x8a, 0x43, 0x27, 0x05, 0x3f, 0x7f, 0x3b,
+	0x6f, 0x66, 0xcc, 0x4c, 0x3a, 0x63, 0xe1, 0xb7, 0x87, 0x81, 0x79, 0x99, 0x19, 0xe6, 0x60, 0xaa,
+	0x64, 0xe0, 0x1d, 0x71, 0xf3, 0x59, 0xaa, 0x4f, 0x1d, 0x6e, 0xd5, 0xbe, 0x2f, 0xf7, 0x5d, 0xa9,
+	0x78, 0xc7, 0x63, 0x86, 0xb5, 0xbb, 0xc2, 0x1f, 0x06, 0xa6, 0xc7, 0xb4, 0xe1, 0x8a, 0xa6, 0x17,
+	0xa2, 0xc7, 0xb0, 0xdc, 0x67, 0x86, 0xe1, 0x35, 0x5b, 0x69, 0x23, 0x0e, 0x1d, 0xab, 0xa9, 0xfd,
+	0xa2, 0x16, 0xdc, 0x78, 0xcb, 0xf4, 0xa1, 0x98, 0x09, 0x83, 0x2b, 0xb6, 0xfb, 0xad, 0x38, 0x74,
+	0x96, 0x8c, 0x2e, 0xad, 0x45, 0xe4, 0xb1, 0x12, 0x2e, 0xc7, 0xeb, 0xb9, 0x48, 0xcb, 0xe8, 0xd2,
+	0x4a, 0xe6, 0x71, 0xc2, 0x94, 0xcf, 0xcd, 0x91, 0x0c, 0x5c, 0x8e, 0x37, 0x6c, 0xb0, 0x9d, 0x47,
+	0x06, 0xd3, 0xac, 0xb8, 0x4b, 0x19, 0x9c, 0x4a, 0x77, 0x82, 0xef, 0x35, 0x40, 0xab, 0x9a, 0x4d,
+	0xb1, 0x98, 0x66, 0x45, 0xb2, 0x99, 0x93, 0xb3, 0x77, 0x4c, 0x4f, 0x30, 0xbc, 0xdb, 0x4c, 0x4a,
+	0xe8, 0xe2, 0x44, 0xaf, 0x60, 0x25, 0xfd, 0xa5, 0xf0, 0x66, 0x03, 0xb4, 0xb6, 0x9f, 0xed, 0xa5,
+	0xff, 0x5c, 0x3b, 0xb7, 0xfb, 0x34, 0x62, 0xb1, 0x59, 0x6b, 0xd3, 0xc5, 0x89, 0x5e, 0xc0, 0xea,
+	0xe0, 0x8c, 0xbb, 0x73, 0xc3, 0xbd, 0xb4, 0x97, 0x2d, 0xdb, 0xcb, 0xfd, 0x38, 0x74, 0xf2, 0x0e,
+	0x9a, 0x97, 0xe8, 0x35, 0xac, 0xf7, 0x64, 0xe0, 0x89, 0x64, 0xd9, 0x07, 0x9e, 0xa7, 0xb8, 0xd6,
+	0xb8, 0x6a, 0x9f, 0xb9, 0x13, 0x87, 0xce, 0x8a, 0x8f, 0xae, 0x90, 0xa4, 0xf4, 0x92, 0x25, 0xaf,
+	0xc4, 0xdb, 0x36, 0xdd, 0x96, 0xce, 0x39, 0x68, 0x5e, 0x26, 0xa3, 0x1c, 0x9c, 0x9d, 0x0a, 0x75,
+	0x9e, 0x8e, 0xb2, 0x76, 0x37, 0xca, 0x0c, 0xa6, 0x59, 0xf1, 0xe4, 0x0b, 0x7c, 0xf0, 0x9f, 0x89,
+	0x20, 0x0c, 0x77, 0x72, 0xf8, 0x98, 0x07, 0x9e, 0x08, 0xfc, 0x7a, 0x01, 0xed, 0xc2, 0x87, 0x39,
+	0xcf, 0x6d, 0xf3, 0x75, 0xb0, 0xe2, 0xea, 0xb1, 0xc0, 0xe5, 0x53, 0xee, 0xd5, 0x8b, 0x2b, 0xf7,
+	0xd9, 0x27, 0x70, 0xaf, 0x5e, 0xea, 0x1e, 0x5d, 0x5c, 0x91, 0xc2, 0xe5, 0x15, 0x29, 0xdc, 0x5c,
+	0x11, 0xf0, 0x35, 0x22, 0xe0, 0x47, 0x44, 0xc0, 0xaf, 0x88, 0x80, 0x8b, 0x88, 0x80, 0xcb, 0x88,
+	0x80, 0x3f, 0x11, 0x01, 0x7f, 0x23, 0x52, 0xb8, 0x89, 0x08, 0xf8, 0x7e, 0x4d, 0x0a, 0x17, 0xd7,
+	0xa4, 0x70, 0x79, 0x4d, 0x0a, 0x1f, 0x76, 0xf4, 0xb9, 0x36, 0x7c, 0x36, 0x9a, 0x31, 0x65, 0x7a,
+	0x32, 0x30, 0x8a, 0xb9, 0x46, 0x8f, 0x2b, 0x76, 0xcd, 0xcf, 0xff, 0x0d, 0x00, 0xa4, 0xb6, 0xc6,
+	0x37, 0xa3, 0x04, 0x00, 0x00,
+}
+
+func (x ScheduledCallStatus) String() string {
+	s, ok := ScheduledCallStatus_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (this *SchedulerStatus) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SchedulerStatus)
+	if !ok {
+		that2, ok := that.(SchedulerStatus)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.LastCallID != that1.LastCallID {
+		return false
+	}
+	return true
+}
+func (this *ScheduledCallsList) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ScheduledCallsList)
+	if !ok {
+		that2, ok := that.(ScheduledCallsList)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.IDs) != len(that1.IDs) {
+		return false
+	}
+	for i := range this.IDs {
+		if this.IDs[i] != that1.IDs[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *ScheduledCall) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ScheduledCall)
+	if !ok {
+		that2, ok := that.(ScheduledCall)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ID != that1.ID {
+		return false
+	}
+	if !bytes.Equal(this.Sender, that1.Sender) {
+		return false
+	}
+	if !bytes.Equal(this.Destination, that1.Destination) {
+		return false
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_core_data.BigIntCaster{}
+		if !__caster.Equal(this.Value, that1.Value) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
+	if this.GasLimit != that1.GasLimit {
+		return false
+	}
+	if this.GasPrice != that1.GasPrice {
+		return false
+	}
+	if this.TargetNonce != that1.TargetNonce {
+		return false
+	}
+	if this.TargetEpoch != that1.TargetEpoch {
+		return false
+	}
+	if !bytes.Equal(this.TxHash, that1.TxHash) {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	if this.ExecutedNonce != that1.ExecutedNonce {
+		return false
+	}
+	if !bytes.Equal(this.ConditionAddress, that1.ConditionAddress) {
+		return false
+	}
+	if !bytes.Equal(this.ConditionCall, that1.ConditionCall) {
+		return false
+	}
+	if this.ExpiryEpoch != that1.ExpiryEpoch {
+		return false
+	}
+	return true
+}
+func (this *SchedulerStatus) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&systemSmartContracts.SchedulerStatus{")
+	s = append(s, "LastCallID: "+fmt.Sprintf("%#v", this.LastCallID)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ScheduledCallsList) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&systemSmartContracts.ScheduledCallsList{")
+	s = append(s, "IDs: "+fmt.Sprintf("%#v", this.IDs)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ScheduledCall) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 19)
+	s = append(s, "&systemSmartContracts.ScheduledCall{")
+	s = append(s, "ID: "+fmt.Sprintf("%#v", this.ID)+",\n")
+	s = append(s, "Sender: "+fmt.Sprintf("%#v", this.Sender)+",\n")
+	s = append(s, "Destination: "+fmt.Sprintf("%#v", this.Destination)+",\n")
+	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
+	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
+	s = append(s, "GasLimit: "+fmt.Sprintf("%#v", this.GasLimit)+",\n")
+	s = append(s, "GasPrice: "+fmt.Sprintf("%#v", this.GasPrice)+",\n")
+	s = append(s, "TargetNonce: "+fmt.Sprintf("%#v", this.TargetNonce)+",\n")
+	s = append(s, "TargetEpoch: "+fmt.Sprintf("%#v", this.TargetEpoch)+",\n")
+	s = append(s, "TxHash: "+fmt.Sprintf("%#v", this.TxHash)+",\n")
+	s = append(s, "Status: "+fmt.Sprintf("%#v", this.Status)+",\n")
+	s = append(s, "ExecutedNonce: "+fmt.Sprintf("%#v", this.ExecutedNonce)+",\n")
+	s = append(s, "ConditionAddress: "+fmt.Sprintf("%#v", this.ConditionAddress)+",\n")
+	s = append(s, "ConditionCall: "+fmt.Sprintf("%#v", this.ConditionCall)+",\n")
+	s = append(s, "ExpiryEpoch: "+fmt.Sprintf("%#v", this.ExpiryEpoch)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringScheduler(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *SchedulerStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SchedulerStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SchedulerStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastCallID != 0 {
+		i = encodeVarintScheduler(dAtA, i, uint64(m.LastCallID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledCallsList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledCallsList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledCallsList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IDs) > 0 {
+		dAtA2 := make([]byte, len(m.IDs)*10)
+		var j1 int
+		for _, num := range m.IDs {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintScheduler(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledCall) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledCall) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryEpoch != 0 {
+		i = encodeVarintScheduler(dAtA, i, uint64(m.ExpiryEpoch))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.ConditionCall) > 0 {
+		i -= len(m.ConditionCall)
+		copy(dAtA[i:], m.ConditionCall)
+		i = encodeVarintScheduler(dAtA, i, uint64(len(m.ConditionCall)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.ConditionAddress) > 0 {
+		i -= len(m.ConditionAddress)
+		copy(dAtA[i:], m.ConditionAddress)
+		i = encodeVarintScheduler(dAtA, i, uint64(len(m.ConditionAddress)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.ExecutedNonce != 0 {
+		i = encodeVarintScheduler(dAtA, i, uint64(m.ExecutedNonce))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Status != 0 {
+		i = encodeVarintScheduler(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintScheduler(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.TargetEpoch != 0 {
+		i = encodeVarintScheduler(dAtA, i, uint64(m.TargetEpoch))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.TargetNonce != 0 {
+		i = encodeVarintScheduler(dAtA, i, uint64(m.TargetNonce))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.GasPrice != 0 {
+		i = encodeVarintScheduler(dAtA, i, uint64(m.GasPrice))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintScheduler(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintScheduler(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_core_data.BigIntCaster{}
+		size := __caster.Size(m.Value)
+		i -= size
+		if _, err := __caster.MarshalTo(m.Value, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintScheduler(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintScheduler(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintScheduler(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintScheduler(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintScheduler(dAtA []byte, offset int, v uint64) int {
+	offset -= sovScheduler(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SchedulerStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastCallID != 0 {
+		n += 1 + sovScheduler(uint64(m.LastCallID))
+	}
+	return n
+}
+
+func (m *ScheduledCallsList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.IDs) > 0 {
+		l = 0
+		for _, e := range m.IDs {
+			l += sovScheduler(uint64(e))
+		}
+		n += 1 + sovScheduler(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *ScheduledCall) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovScheduler(uint64(m.ID))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovScheduler(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovScheduler(uint64(l))
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_core_data.BigIntCaster{}
+		l = __caster.Size(m.Value)
+		n += 1 + l + sovScheduler(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovScheduler(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovScheduler(uint64(m.GasLimit))
+	}
+	if m.GasPrice != 0 {
+		n += 1 + sovScheduler(uint64(m.GasPrice))
+	}
+	if m.TargetNonce != 0 {
+		n += 1 + sovScheduler(uint64(m.TargetNonce))
+	}
+	if m.TargetEpoch != 0 {
+		n += 1 + sovScheduler(uint64(m.TargetEpoch))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovScheduler(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovScheduler(uint64(m.Status))
+	}
+	if m.ExecutedNonce != 0 {
+		n += 1 + sovScheduler(uint64(m.ExecutedNonce))
+	}
+	l = len(m.ConditionAddress)
+	if l > 0 {
+		n += 1 + l + sovScheduler(uint64(l))
+	}
+	l = len(m.ConditionCall)
+	if l > 0 {
+		n += 1 + l + sovScheduler(uint64(l))
+	}
+	if m.ExpiryEpoch != 0 {
+		n += 1 + sovScheduler(uint64(m.ExpiryEpoch))
+	}
+	return n
+}
+
+func sovScheduler(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozScheduler(x uint64) (n int) {
+	return sovScheduler(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *SchedulerStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SchedulerStatus{`,
+		`LastCallID:` + fmt.Sprintf("%v", this.LastCallID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ScheduledCallsList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ScheduledCallsList{`,
+		`IDs:` + fmt.Sprintf("%v", this.IDs) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ScheduledCall) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ScheduledCall{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Sender:` + fmt.Sprintf("%v", this.Sender) + `,`,
+		`Destination:` + fmt.Sprintf("%v", this.Destination) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`GasLimit:` + fmt.Sprintf("%v", this.GasLimit) + `,`,
+		`GasPrice:` + fmt.Sprintf("%v", this.GasPrice) + `,`,
+		`TargetNonce:` + fmt.Sprintf("%v", this.TargetNonce) + `,`,
+		`TargetEpoch:` + fmt.Sprintf("%v", this.TargetEpoch) + `,`,
+		`TxHash:` + fmt.Sprintf("%v", this.TxHash) + `,`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`ExecutedNonce:` + fmt.Sprintf("%v", this.ExecutedNonce) + `,`,
+		`ConditionAddress:` + fmt.Sprintf("%v", this.ConditionAddress) + `,`,
+		`ConditionCall:` + fmt.Sprintf("%v", this.ConditionCall) + `,`,
+		`ExpiryEpoch:` + fmt.Sprintf("%v", this.ExpiryEpoch) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringScheduler(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *SchedulerStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScheduler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SchedulerStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SchedulerStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCallID", wireType)
+			}
+			m.LastCallID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastCallID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScheduler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledCallsList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScheduler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledCallsList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledCallsList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowScheduler
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.IDs = append(m.IDs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowScheduler
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthScheduler
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthScheduler
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.IDs) == 0 {
+					m.IDs = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowScheduler
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.IDs = append(m.IDs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field IDs", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScheduler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledCall) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScheduler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledCall: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledCall: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sender == nil {
+				m.Sender = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = append(m.Destination[:0], dAtA[iNdEx:postIndex]...)
+			if m.Destination == nil {
+				m.Destination = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_core_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.Value = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+			}
+			m.GasPrice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasPrice |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetNonce", wireType)
+			}
+			m.TargetNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetEpoch", wireType)
+			}
+			m.TargetEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetEpoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxHash == nil {
+				m.TxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ScheduledCallStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedNonce", wireType)
+			}
+			m.ExecutedNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutedNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConditionAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConditionAddress = append(m.ConditionAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ConditionAddress == nil {
+				m.ConditionAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConditionCall", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConditionCall = append(m.ConditionCall[:0], dAtA[iNdEx:postIndex]...)
+			if m.ConditionCall == nil {
+				m.ConditionCall = []byte{}
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryEpoch", wireType)
+			}
+			m.ExpiryEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryEpoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScheduler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipScheduler(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowScheduler
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthScheduler
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupScheduler
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthScheduler
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthScheduler        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowScheduler          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupScheduler = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,292 @@
+package systemSmartContracts
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
+	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/mock"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/ElrondNetwork/elrond-vm-common/parsers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	scheduledCallSender      = bytes.Repeat([]byte{1}, 32)
+	scheduledCallDestination = bytes.Repeat([]byte{2}, 32)
+)
+
+func createMockArgumentsForScheduler() ArgsNewScheduler {
+	return ArgsNewScheduler{
+		Eei:                &mock.SystemEIStub{},
+		GasCost:            vm.GasCost{MetaChainSystemSCsCost: vm.MetaChainSystemSCsCost{SchedulerOps: 1}},
+		Marshalizer:        &mock.MarshalizerMock{},
+		SchedulerSCAddress: vm.SchedulerSCAddress,
+		EndOfEpochAddress:  vm.EndOfEpochAddress,
+		EpochNotifier:      &mock.EpochNotifierStub{},
+		EpochConfig: config.EpochConfig{
+			EnableEpochs: config.EnableEpochs{
+				ScheduledCallsEnableEpoch: 0,
+			},
+		},
+	}
+}
+
+func createSchedulerWithVMContext(blockChainHook *mock.BlockChainHookStub) (*scheduler, *vmContext) {
+	eei, _ := NewVMContext(
+		blockChainHook,
+		hooks.NewVMCryptoHook(),
+		parsers.NewCallArgsParser(),
+		&stateMock.AccountsStub{},
+		&mock.RaterMock{},
+	)
+
+	args := createMockArgumentsForScheduler()
+	args.Eei = eei
+	s, _ := NewSchedulerSystemSC(args)
+	s.EpochConfirmed(0, 0)
+
+	return s, eei
+}
+
+// executeOnScheduler keeps the storage updates from the previous calls, as they would have been saved in the state
+func executeOnScheduler(s *scheduler, eei *vmContext, caller []byte, function string, callValue *big.Int, args ...[]byte) vmcommon.ReturnCode {
+	eei.outputAccounts = make(map[string]*vmcommon.OutputAccount)
+	eei.output = make([][]byte, 0)
+	eei.returnMessage = ""
+	eei.SetSCAddress(vm.SchedulerSCAddress)
+	eei.SetGasProvided(1000000)
+
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr: caller,
+			Arguments:  args,
+			CallValue:  callValue,
+			GasPrice:   10,
+		},
+		RecipientAddr: vm.SchedulerSCAddress,
+		Function:      function,
+	}
+
+	return s.Execute(vmInput)
+}
+
+func TestNewSchedulerSystemSC(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForScheduler()
+	args.Eei = nil
+	s, err := NewSchedulerSystemSC(args)
+	assert.True(t, check.IfNil(s))
+	assert.Equal(t, vm.ErrNilSystemEnvironmentInterface, err)
+
+	args = createMockArgumentsForScheduler()
+	args.EndOfEpochAddress = nil
+	s, err = NewSchedulerSystemSC(args)
+	assert.True(t, check.IfNil(s))
+	assert.True(t, errors.Is(err, vm.ErrInvalidAddress))
+
+	args = createMockArgumentsForScheduler()
+	args.Marshalizer = nil
+	s, err = NewSchedulerSystemSC(args)
+	assert.True(t, check.IfNil(s))
+	assert.Equal(t, vm.ErrNilMarshalizer, err)
+
+	args = createMockArgumentsForScheduler()
+	s, err = NewSchedulerSystemSC(args)
+	assert.False(t, check.IfNil(s))
+	assert.Nil(t, err)
+}
+
+func TestScheduler_ScheduleShouldValidateTarget(t *testing.T) {
+	t.Parallel()
+
+	s, eei := createSchedulerWithVMContext(&mock.BlockChainHookStub{
+		CurrentNonceCalled: func() uint64 {
+			return 10
+		},
+	})
+
+	retCode := executeOnScheduler(s, eei, scheduledCallSender, "scheduleAtNonce", big.NewInt(1000),
+		scheduledCallDestination, big.NewInt(10).Bytes(), big.NewInt(50).Bytes())
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Equal(t, "target nonce must be a future metachain nonce", eei.returnMessage)
+
+	retCode = executeOnScheduler(s, eei, scheduledCallSender, "scheduleAtNonce", big.NewInt(499),
+		scheduledCallDestination, big.NewInt(11).Bytes(), big.NewInt(50).Bytes())
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Equal(t, "call value does not cover the gas of the scheduled call", eei.returnMessage)
+
+	retCode = executeOnScheduler(s, eei, scheduledCallSender, "scheduleAtNonce", big.NewInt(1000),
+		vm.StakingSCAddress, big.NewInt(11).Bytes(), big.NewInt(50).Bytes())
+	assert.Equal(t, vmcommon.UserError, retCode)
+}
+
+func TestScheduler_ExecuteScheduledShouldReturnDueCallsOnce(t *testing.T) {
+	t.Parallel()
+
+	s, eei := createSchedulerWithVMContext(&mock.BlockChainHookStub{})
+
+	retCode := executeOnScheduler(s, eei, scheduledCallSender, "scheduleAtNonce", big.NewInt(1500),
+		scheduledCallDestination, big.NewInt(5).Bytes(), big.NewInt(50).Bytes(), []byte("doSomething"), []byte{1})
+	require.Equal(t, vmcommon.Ok, retCode)
+	retCode = executeOnScheduler(s, eei, scheduledCallSender, "scheduleAtEpoch", big.NewInt(600),
+		scheduledCallDestination, big.NewInt(2).Bytes(), big.NewInt(50).Bytes())
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	retCode = executeOnScheduler(s, eei, scheduledCallSender, "executeScheduled", big.NewInt(0),
+		big.NewInt(5).Bytes(), big.NewInt(0).Bytes())
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	retCode = executeOnScheduler(s, eei, vm.EndOfEpochAddress, "executeScheduled", big.NewInt(0),
+		big.NewInt(4).Bytes(), big.NewInt(0).Bytes())
+	require.Equal(t, vmcommon.Ok, retCode)
+	assert.Equal(t, 0, len(eei.output))
+	assert.Equal(t, 0, len(eei.outputAccounts))
+
+	retCode = executeOnScheduler(s, eei, vm.EndOfEpochAddress, "executeScheduled", big.NewInt(0),
+		big.NewInt(6).Bytes(), big.NewInt(0).Bytes())
+	require.Equal(t, vmcommon.Ok, retCode)
+	require.Equal(t, 1, len(eei.output))
+
+	call := &ScheduledCall{}
+	err := s.marshalizer.Unmarshal(call, eei.output[0])
+	require.Nil(t, err)
+	assert.Equal(t, uint64(1), call.ID)
+	assert.Equal(t, scheduledCallDestination, call.Destination)
+	assert.Equal(t, big.NewInt(1000), call.Value)
+	assert.Equal(t, []byte("doSomething@01"), call.Data)
+	assert.Equal(t, ScheduledCallExecuted, call.Status)
+	assert.Equal(t, uint64(6), call.ExecutedNonce)
+
+	retCode = executeOnScheduler(s, eei, vm.EndOfEpochAddress, "executeScheduled", big.NewInt(0),
+		big.NewInt(7).Bytes(), big.NewInt(2).Bytes())
+	require.Equal(t, vmcommon.Ok, retCode)
+	require.Equal(t, 1, len(eei.output))
+	err = s.marshalizer.Unmarshal(call, eei.output[0])
+	require.Nil(t, err)
+	assert.Equal(t, uint64(2), call.ID)
+	assert.Equal(t, uint32(2), call.TargetEpoch)
+}
+
+func TestScheduler_CancelShouldRefundEscrow(t *testing.T) {
+	t.Parallel()
+
+	s, eei := createSchedulerWithVMContext(&mock.BlockChainHookStub{})
+
+	retCode := executeOnScheduler(s, eei, scheduledCallSender, "scheduleAtNonce", big.NewInt(1500),
+		scheduledCallDestination, big.NewInt(5).Bytes(), big.NewInt(50).Bytes())
+	require.Equal(t, vmcommon.Ok, retCode)
+	id := eei.output[0]
+
+	retCode = executeOnScheduler(s, eei, outsider, "cancel", big.NewInt(0), id)
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	retCode = executeOnScheduler(s, eei, scheduledCallSender, "cancel", big.NewInt(0), id)
+	require.Equal(t, vmcommon.Ok, retCode)
+	assert.Equal(t, big.NewInt(1500), eei.outputAccounts[string(scheduledCallSender)].BalanceDelta)
+
+	retCode = executeOnScheduler(s, eei, scheduledCallSender, "cancel", big.NewInt(0), id)
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	retCode = executeOnScheduler(s, eei, vm.EndOfEpochAddress, "executeScheduled", big.NewInt(0),
+		big.NewInt(5).Bytes(), big.NewInt(0).Bytes())
+	require.Equal(t, vmcommon.Ok, retCode)
+	assert.Equal(t, 0, len(eei.output))
+}
+
+func TestScheduler_ScheduleWhenShouldValidateCondition(t *testing.T) {
+	t.Parallel()
+
+	s, eei := createSchedulerWithVMContext(&mock.BlockChainHookStub{
+		CurrentEpochCalled: func() uint32 {
+			return 2
+		},
+	})
+
+	retCode := executeOnScheduler(s, eei, scheduledCallSender, "scheduleWhen", big.NewInt(1000),
+		scheduledCallDestination, scheduledCallDestination, []byte("isReady"), big.NewInt(3).Bytes(), big.NewInt(50).Bytes())
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Equal(t, "condition address must be a system smart contract other than the scheduler", eei.returnMessage)
+
+	retCode = executeOnScheduler(s, eei, scheduledCallSender, "scheduleWhen", big.NewInt(1000),
+		scheduledCallDestination, vm.SchedulerSCAddress, []byte("isReady"), big.NewInt(3).Bytes(), big.NewInt(50).Bytes())
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Equal(t, "condition address must be a system smart contract other than the scheduler", eei.returnMessage)
+
+	retCode = executeOnScheduler(s, eei, scheduledCallSender, "scheduleWhen", big.NewInt(1000),
+		scheduledCallDestination, vm.StakingSCAddress, []byte{}, big.NewInt(3).Bytes(), big.NewInt(50).Bytes())
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Equal(t, "empty condition call", eei.returnMessage)
+
+	retCode = executeOnScheduler(s, eei, scheduledCallSender, "scheduleWhen", big.NewInt(1000),
+		scheduledCallDestination, vm.StakingSCAddress, []byte("isReady"), big.NewInt(2).Bytes(), big.NewInt(50).Bytes())
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Equal(t, "expiry epoch must be in the future", eei.returnMessage)
+
+	retCode = executeOnScheduler(s, eei, scheduledCallSender, "scheduleWhen", big.NewInt(1000),
+		scheduledCallDestination, vm.StakingSCAddress, []byte("isReady"), big.NewInt(3).Bytes(), big.NewInt(50).Bytes())
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	retCode = executeOnScheduler(s, eei, scheduledCallSender, "getScheduledCall", big.NewInt(0), big.NewInt(1).Bytes())
+	require.Equal(t, vmcommon.Ok, retCode)
+	require.Equal(t, 12, len(eei.output))
+	assert.Equal(t, vm.StakingSCAddress, eei.output[9])
+	assert.Equal(t, []byte("isReady"), eei.output[10])
+	assert.Equal(t, big.NewInt(3).Bytes(), eei.output[11])
+}
+
+func TestScheduler_ExecuteScheduledShouldReturnReadyAndExpiredConditionalCalls(t *testing.T) {
+	t.Parallel()
+
+	s, eei := createSchedulerWithVMContext(&mock.BlockChainHookStub{})
+
+	for i := 0; i < 3; i++ {
+		retCode := executeOnScheduler(s, eei, scheduledCallSender, "scheduleWhen", big.NewInt(1000),
+			scheduledCallDestination, vm.StakingSCAddress, []byte("isReady"), big.NewInt(2).Bytes(), big.NewInt(50).Bytes())
+		require.Equal(t, vmcommon.Ok, retCode)
+	}
+
+	retCode := executeOnScheduler(s, eei, scheduledCallSender, "getConditionalCalls", big.NewInt(0))
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	retCode = executeOnScheduler(s, eei, vm.EndOfEpochAddress, "getConditionalCalls", big.NewInt(0))
+	require.Equal(t, vmcommon.Ok, retCode)
+	require.Equal(t, 3, len(eei.output))
+
+	retCode = executeOnScheduler(s, eei, vm.EndOfEpochAddress, "executeScheduled", big.NewInt(0),
+		big.NewInt(5).Bytes(), big.NewInt(2).Bytes(), big.NewInt(2).Bytes())
+	require.Equal(t, vmcommon.Ok, retCode)
+	require.Equal(t, 1, len(eei.output))
+
+	call := &ScheduledCall{}
+	err := s.marshalizer.Unmarshal(call, eei.output[0])
+	require.Nil(t, err)
+	assert.Equal(t, uint64(2), call.ID)
+	assert.Equal(t, ScheduledCallExecuted, call.Status)
+
+	retCode = executeOnScheduler(s, eei, scheduledCallSender, "cancel", big.NewInt(0), big.NewInt(1).Bytes())
+	require.Equal(t, vmcommon.Ok, retCode)
+	assert.Equal(t, big.NewInt(1000), eei.outputAccounts[string(scheduledCallSender)].BalanceDelta)
+
+	retCode = executeOnScheduler(s, eei, vm.EndOfEpochAddress, "executeScheduled", big.NewInt(0),
+		big.NewInt(6).Bytes(), big.NewInt(3).Bytes())
+	require.Equal(t, vmcommon.Ok, retCode)
+	require.Equal(t, 1, len(eei.output))
+
+	err = s.marshalizer.Unmarshal(call, eei.output[0])
+	require.Nil(t, err)
+	assert.Equal(t, uint64(3), call.ID)
+	assert.Equal(t, ScheduledCallExpired, call.Status)
+
+	retCode = executeOnScheduler(s, eei, vm.EndOfEpochAddress, "getConditionalCalls", big.NewInt(0))
+	require.Equal(t, vmcommon.Ok, retCode)
+	assert.Equal(t, 0, len(eei.output))
+}